@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"os"
 	"syscall"
+	"time"
 
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/server"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/server/cookies"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/watcher"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/bootstrap"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/bootstrap/btc_bootstrap"
@@ -18,6 +20,7 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/registry"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
 	log "github.com/sirupsen/logrus"
 )
@@ -32,6 +35,8 @@ type Application struct {
 	btcRegistry       *registry.Bitcoin
 	dbRegistry        *registry.Database
 	messagingRegistry *registry.Messaging
+	secretsRotator    *secrets.Rotator
+	rateLimiter       entities.RateLimiter
 	runningServices   []entities.Closeable
 	doneChannel       chan os.Signal
 }
@@ -41,6 +46,11 @@ func NewApplication(initCtx context.Context, env environment.Environment, timeou
 	if err != nil {
 		log.Fatal("Error getting secret loader:", err)
 	}
+	managementSecrets, err := secretLoader.LoadManagementSecrets(initCtx)
+	if err != nil {
+		log.Fatal("Error loading management secrets: ", err)
+	}
+	env.Management = managementEnvWithSecrets(env.Management, managementSecrets)
 
 	rskClient, err := bootstrap.Rootstock(initCtx, env)
 	if err != nil {
@@ -79,7 +89,7 @@ func NewApplication(initCtx context.Context, env environment.Environment, timeou
 		log.Fatal("Error creating Rootstock registry:", err)
 	}
 
-	messagingRegistry := registry.NewMessagingRegistry(initCtx, env, rskClient, btcConnection, externalClients)
+	messagingRegistry := registry.NewMessagingRegistry(initCtx, env, rskClient, btcConnection, externalClients, dbRegistry.AlertRepository, dbRegistry.EventRepository)
 	liquidityProvider := registry.NewLiquidityProvider(dbRegistry, rootstockRegistry, btcRegistry, messagingRegistry)
 	mutexes := environment.NewApplicationMutexes()
 
@@ -95,10 +105,47 @@ func NewApplication(initCtx context.Context, env environment.Environment, timeou
 		dbRegistry:        dbRegistry,
 		messagingRegistry: messagingRegistry,
 		watcherRegistry:   watcherRegistry,
+		secretsRotator:    createSecretsRotator(env, timeouts, secretLoader, walletFactory),
+		rateLimiter:       registry.NewRateLimiter(env, dbConnection),
 		runningServices:   make([]entities.Closeable, 0),
 	}
 }
 
+// createSecretsRotator returns the service that reloads the secrets that can be rotated without restarting the server,
+// nil is returned if the reload is disabled
+func createSecretsRotator(
+	env environment.Environment,
+	timeouts environment.ApplicationTimeouts,
+	secretLoader secrets.SecretLoader,
+	walletFactory wallet.AbstractFactory,
+) *secrets.Rotator {
+	if env.SecretsReload == 0 {
+		return nil
+	}
+	ticker := utils.NewTickerWrapper(time.Duration(env.SecretsReload) * time.Second)
+	rotator := secrets.NewRotator(secretLoader, ticker, timeouts.WatcherValidation.Seconds())
+	if rotatable, ok := walletFactory.(wallet.RotatableFactory); ok {
+		rotator.AddHandler("keystore", rotatable.RotateSecrets)
+	}
+	if env.Management.EnableManagementApi {
+		rotator.AddHandler("management", func(ctx context.Context, loader secrets.SecretLoader) error {
+			managementSecrets, err := loader.LoadManagementSecrets(ctx)
+			if err != nil {
+				return err
+			}
+			return cookies.RotateSessionKeys(managementEnvWithSecrets(env.Management, managementSecrets))
+		})
+	}
+	return rotator
+}
+
+func managementEnvWithSecrets(env environment.ManagementEnv, managementSecrets secrets.ManagementSecrets) environment.ManagementEnv {
+	env.SessionAuthKey = managementSecrets.SessionAuthKey
+	env.SessionEncryptionKey = managementSecrets.SessionEncryptionKey
+	env.SessionTokenAuthKey = managementSecrets.SessionTokenAuthKey
+	return env
+}
+
 func createExternalRpc(ctx context.Context, env environment.Environment) (registry.ExternalRpc, error) {
 	externalRskSources, err := bootstrap.ExternalRskSources(ctx, env)
 	if err != nil {
@@ -119,7 +166,7 @@ func createExternalRpc(ctx context.Context, env environment.Environment) (regist
 	}, nil
 }
 
-func (app *Application) Run(env environment.Environment, logLevel log.Level) {
+func (app *Application) Run(logLevel log.Level) {
 	app.addRunningService(app.dbRegistry.Connection)
 	app.addRunningService(app.rskRegistry.Client)
 	app.addRunningService(app.btcRegistry.RpcConnection)
@@ -150,7 +197,12 @@ func (app *Application) Run(env environment.Environment, logLevel log.Level) {
 		go w.Start()
 	}
 
-	applicationServer, done := server.NewServer(env, app.useCaseRegistry, logLevel, app.timeouts)
+	if app.secretsRotator != nil {
+		app.addRunningService(app.secretsRotator)
+		go app.secretsRotator.Start()
+	}
+
+	applicationServer, done := server.NewServer(app.env, app.useCaseRegistry, app.rateLimiter, logLevel, app.timeouts)
 	app.doneChannel = done
 	app.addRunningService(applicationServer)
 	go applicationServer.Start()
@@ -172,8 +224,10 @@ func (app *Application) prepareWatchers() ([]watcher.Watcher, error) {
 		app.watcherRegistry.PenalizationAlertWatcher,
 		app.watcherRegistry.PegoutBridgeWatcher,
 		app.watcherRegistry.BtcReleaseWatcher,
+		app.watcherRegistry.QuoteWebhookWatcher,
 		app.watcherRegistry.QuoteMetricsWatcher,
 		app.watcherRegistry.AssetReportWatcher,
+		app.watcherRegistry.LiquidityLedgerWatcher,
 	}
 
 	if app.env.Eclipse.Enabled {
@@ -181,6 +235,14 @@ func (app *Application) prepareWatchers() ([]watcher.Watcher, error) {
 		watchers = append(watchers, app.watcherRegistry.BitcoinEclipseWatcher)
 	}
 
+	if app.env.ReportSnapshot.Enabled {
+		watchers = append(watchers, app.watcherRegistry.ReportSnapshotWatcher)
+	}
+
+	if app.env.AssetDrift.Enabled {
+		watchers = append(watchers, app.watcherRegistry.AssetDriftWatcher)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), app.timeouts.WatcherPreparation.Seconds())
 	defer cancel()
 	for _, w := range watchers {
@@ -42,7 +42,7 @@ func main() {
 	log.Info("Application initialized successfully")
 	cancel()
 	log.Info("Starting application...")
-	app.Run(*env, logLevel)
+	app.Run(logLevel)
 	app.ShutdownServices()
 }
 
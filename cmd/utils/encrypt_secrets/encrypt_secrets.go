@@ -0,0 +1,107 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/rsksmart/liquidity-provider-server/cmd/utils/scripts"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment/secrets"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+)
+
+type EncryptSecretsScriptInput struct {
+	InputFile   string `validate:"required,filepath"`
+	OutputFile  string `validate:"required,filepath"`
+	KeyFile     string `validate:"required,filepath"`
+	GenerateKey bool
+}
+
+func main() {
+	const errorCode = 2
+	scripts.SetUsageMessage(
+		"This script encrypts a JSON file with the LPS secrets so it can be used with the file secret source. " +
+			"The input file must be a JSON object whose values are strings, e.g. {\"keystore\": \"...\", \"keystore_password\": \"...\"}.",
+	)
+	defer scripts.EnableSecureBuffers()()
+
+	scriptInput := new(EncryptSecretsScriptInput)
+	ReadEncryptSecretsScriptInput(scriptInput)
+	if err := ParseEncryptSecretsScriptInput(flag.Parse, scriptInput); err != nil {
+		scripts.ExitWithError(errorCode, "Error parsing input", err)
+	}
+	if err := EncryptSecrets(scriptInput); err != nil {
+		scripts.ExitWithError(errorCode, "Error encrypting secrets", err)
+	}
+	fmt.Println("Secrets encrypted successfully in", scriptInput.OutputFile)
+}
+
+func ReadEncryptSecretsScriptInput(scriptInput *EncryptSecretsScriptInput) {
+	flag.StringVar(&scriptInput.InputFile, "input-file", "", "Path to the plain JSON file with the secrets to encrypt")
+	flag.StringVar(&scriptInput.OutputFile, "output-file", "", "Path where the encrypted secrets file will be written")
+	flag.StringVar(&scriptInput.KeyFile, "key-file", "", "Path to the file with the hex encoded 32 bytes key used to encrypt the secrets")
+	flag.BoolVar(&scriptInput.GenerateKey, "generate-key", false, "Generate a new random key and write it in the key file. The key file must not exist")
+}
+
+func ParseEncryptSecretsScriptInput(parse scripts.ParseFunc, scriptInput *EncryptSecretsScriptInput) error {
+	parse()
+	if err := validator.New(validator.WithRequiredStructEnabled()).Struct(scriptInput); err != nil {
+		return fmt.Errorf("invalid input: %w", err)
+	} else if scriptInput.InputFile == scriptInput.OutputFile {
+		return errors.New("output file must be different from the input file")
+	}
+	return nil
+}
+
+func EncryptSecrets(scriptInput *EncryptSecretsScriptInput) error {
+	key, err := getKey(scriptInput)
+	if err != nil {
+		return err
+	}
+	defer clear(key)
+
+	plainContent, err := os.ReadFile(scriptInput.InputFile)
+	if err != nil {
+		return fmt.Errorf("error reading input file: %w", err)
+	}
+	defer clear(plainContent)
+	plainSecrets := make(map[string]string)
+	if err = json.Unmarshal(plainContent, &plainSecrets); err != nil {
+		return fmt.Errorf("error parsing input file: %w", err)
+	}
+
+	encryptedContent, err := secrets.EncryptSecretsFile(plainSecrets, key)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(scriptInput.OutputFile, encryptedContent, 0600)
+}
+
+func getKey(scriptInput *EncryptSecretsScriptInput) ([]byte, error) {
+	if !scriptInput.GenerateKey {
+		keyContent, err := os.ReadFile(scriptInput.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading key file: %w", err)
+		}
+		return utils.DecodeKey(strings.TrimSpace(string(keyContent)), secrets.SecretsFileKeyLength)
+	}
+
+	key, err := utils.GetRandomBytes(secrets.SecretsFileKeyLength)
+	if err != nil {
+		return nil, err
+	}
+	keyFile, err := os.OpenFile(scriptInput.KeyFile, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0400)
+	if err != nil {
+		return nil, fmt.Errorf("error creating key file: %w", err)
+	}
+	defer func() { _ = keyFile.Close() }()
+	if _, err = keyFile.WriteString(hex.EncodeToString(key)); err != nil {
+		return nil, fmt.Errorf("error writing key file: %w", err)
+	}
+	return key, nil
+}
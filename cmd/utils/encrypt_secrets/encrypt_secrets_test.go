@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadEncryptSecretsScriptInput(t *testing.T) {
+	flag.CommandLine = flag.NewFlagSet("", flag.ExitOnError)
+	input := new(EncryptSecretsScriptInput)
+	ReadEncryptSecretsScriptInput(input)
+	require.NoError(t, flag.CommandLine.Parse([]string{
+		"-input-file", "secrets.json",
+		"-output-file", "secrets.enc.json",
+		"-key-file", "secrets.key",
+		"-generate-key",
+	}))
+	assert.Equal(t, EncryptSecretsScriptInput{InputFile: "secrets.json", OutputFile: "secrets.enc.json", KeyFile: "secrets.key", GenerateKey: true}, *input)
+}
+
+func TestParseEncryptSecretsScriptInput(t *testing.T) {
+	parse := func() {}
+	t.Run("should validate required fields", func(t *testing.T) {
+		err := ParseEncryptSecretsScriptInput(parse, &EncryptSecretsScriptInput{InputFile: "secrets.json"})
+		require.ErrorContains(t, err, "invalid input")
+	})
+	t.Run("should not overwrite the input file", func(t *testing.T) {
+		err := ParseEncryptSecretsScriptInput(parse, &EncryptSecretsScriptInput{InputFile: "secrets.json", OutputFile: "secrets.json", KeyFile: "secrets.key"})
+		require.ErrorContains(t, err, "output file must be different from the input file")
+	})
+	t.Run("should accept valid input", func(t *testing.T) {
+		err := ParseEncryptSecretsScriptInput(parse, &EncryptSecretsScriptInput{InputFile: "secrets.json", OutputFile: "secrets.enc.json", KeyFile: "secrets.key"})
+		require.NoError(t, err)
+	})
+}
+
+func TestEncryptSecrets(t *testing.T) {
+	dir := t.TempDir()
+	input := &EncryptSecretsScriptInput{
+		InputFile:   filepath.Join(dir, "secrets.json"),
+		OutputFile:  filepath.Join(dir, "secrets.enc.json"),
+		KeyFile:     filepath.Join(dir, "secrets.key"),
+		GenerateKey: true,
+	}
+	require.NoError(t, os.WriteFile(input.InputFile, []byte(`{"keystore_password":"password"}`), 0600))
+
+	t.Run("should generate the key and encrypt the file", func(t *testing.T) {
+		require.NoError(t, EncryptSecrets(input))
+		keyContent, err := os.ReadFile(input.KeyFile)
+		require.NoError(t, err)
+		key, err := hex.DecodeString(string(keyContent))
+		require.NoError(t, err)
+		content, err := os.ReadFile(input.OutputFile)
+		require.NoError(t, err)
+		decrypted, err := secrets.DecryptSecretsFile(content, key)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"keystore_password": "password"}, decrypted)
+	})
+	t.Run("should not overwrite an existing key", func(t *testing.T) {
+		require.ErrorContains(t, EncryptSecrets(input), "error creating key file")
+	})
+	t.Run("should use the existing key", func(t *testing.T) {
+		input.GenerateKey = false
+		require.NoError(t, os.WriteFile(input.InputFile, []byte(`{"keystore_password":"rotated"}`), 0600))
+		require.NoError(t, EncryptSecrets(input))
+		keyContent, err := os.ReadFile(input.KeyFile)
+		require.NoError(t, err)
+		key, err := hex.DecodeString(string(keyContent))
+		require.NoError(t, err)
+		content, err := os.ReadFile(input.OutputFile)
+		require.NoError(t, err)
+		decrypted, err := secrets.DecryptSecretsFile(content, key)
+		require.NoError(t, err)
+		assert.Equal(t, "rotated", decrypted["keystore_password"])
+	})
+	t.Run("should reject invalid input files", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(input.InputFile, []byte(`{"keystore":{"version":3}}`), 0600))
+		require.ErrorContains(t, EncryptSecrets(input), "error parsing input file")
+	})
+}
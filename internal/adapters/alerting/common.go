@@ -0,0 +1,88 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/utils"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+)
+
+const defaultSeverity = alerts.AlertSeverityError
+
+func alertSeverity(alert alerts.Alert) alerts.AlertSeverity {
+	if !alert.Severity.IsValid() {
+		return defaultSeverity
+	}
+	return alert.Severity
+}
+
+// renderText builds a human-readable version of the alert, with the message followed by the
+// structured fields that are present in the alert
+func renderText(alert alerts.Alert) string {
+	builder := &strings.Builder{}
+	builder.WriteString(alert.Message)
+	details := make([]string, 0)
+	if alert.QuoteHash != "" {
+		details = append(details, "Quote hash: "+alert.QuoteHash)
+	}
+	amountNames := make([]string, 0, len(alert.Amounts))
+	for name := range alert.Amounts {
+		amountNames = append(amountNames, name)
+	}
+	sort.Strings(amountNames)
+	for _, name := range amountNames {
+		if amount := alert.Amounts[name]; amount != nil {
+			details = append(details, fmt.Sprintf("Amount (%s): %s wei", name, amount.String()))
+		}
+	}
+	if alert.NodeType != "" {
+		details = append(details, "Node type: "+alert.NodeType)
+	}
+	if alert.BlockNumber != 0 {
+		details = append(details, fmt.Sprintf("Block number: %d", alert.BlockNumber))
+	}
+	if alert.BlockHash != "" {
+		details = append(details, "Block hash: "+alert.BlockHash)
+	}
+	if !alert.Timestamp.IsZero() {
+		details = append(details, "Timestamp: "+alert.Timestamp.UTC().Format(time.RFC3339))
+	}
+	if len(details) > 0 {
+		builder.WriteString("\n\n")
+		builder.WriteString(strings.Join(details, "\n"))
+	}
+	return builder.String()
+}
+
+func postJson(ctx context.Context, client utils.HttpClient, url string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	defer utils.CloseBodyIfExists(res)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		message, readErr := io.ReadAll(res.Body)
+		if readErr != nil {
+			return readErr
+		}
+		return fmt.Errorf("unexpected response (%d) from %s: %s", res.StatusCode, url, message)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package alerting
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	log "github.com/sirupsen/logrus"
+)
+
+// HistoryAlertSender records every alert delivered by the wrapped sender in the alert history and uses
+// that history to avoid notifying the same condition more than once per dedup window. Resolved alerts
+// are only delivered if the last delivered alert for the same condition was a firing one. The history is
+// read once per condition, after that the last delivered alert is kept in memory, so the checks that send a
+// resolved alert on every run don't read the history each time
+type HistoryAlertSender struct {
+	sender        alerts.AlertSender
+	repository    alerts.AlertRepository
+	defaultWindow time.Duration
+	windows       map[string]time.Duration
+	lastMutex     sync.Mutex
+	// last has the last delivered alert of each dedup key, a nil record means nothing was delivered for that key
+	last map[string]*alerts.AlertRecord
+}
+
+// NewHistoryAlertSender creates a HistoryAlertSender. The windows map contains the dedup window for
+// specific subjects, the subjects that are not present use the default window
+func NewHistoryAlertSender(
+	sender alerts.AlertSender,
+	repository alerts.AlertRepository,
+	defaultWindow time.Duration,
+	windows map[string]time.Duration,
+) alerts.AlertSender {
+	if windows == nil {
+		windows = make(map[string]time.Duration)
+	}
+	return &HistoryAlertSender{
+		sender:        sender,
+		repository:    repository,
+		defaultWindow: defaultWindow,
+		windows:       windows,
+		last:          make(map[string]*alerts.AlertRecord),
+	}
+}
+
+func (sender *HistoryAlertSender) SendAlert(ctx context.Context, alert alerts.Alert, recipient []string) error {
+	if err := alert.Validate(); err != nil {
+		return err
+	}
+	if alert.Status == "" {
+		alert.Status = alerts.AlertStatusFiring
+	}
+	if alert.Timestamp.IsZero() {
+		alert.Timestamp = time.Now()
+	}
+
+	dedupKey := alert.DedupKey()
+	last, err := sender.lastDeliveredAlert(ctx, dedupKey)
+	if err != nil && alert.IsResolved() {
+		log.Error("Error reading alert history, resolved alert will be discarded: ", err)
+		return nil
+	} else if err != nil {
+		log.Error("Error reading alert history, alert will be sent without deduplication: ", err)
+	} else if sender.shouldSuppress(alert, last) {
+		log.Debugf("Alert %q (%s) suppressed by alert history", alert.Subject, alert.Status)
+		return nil
+	}
+
+	sendErr := sender.sender.SendAlert(ctx, alert, recipient)
+	record := alerts.AlertRecord{
+		Alert:      alert,
+		DedupKey:   dedupKey,
+		Recipients: recipient,
+		Delivered:  sendErr == nil,
+	}
+	if record.Recipients == nil {
+		record.Recipients = make([]string, 0)
+	}
+	if sendErr != nil {
+		record.Error = sendErr.Error()
+	} else {
+		sender.setLastDeliveredAlert(dedupKey, &record)
+	}
+	if err = sender.repository.InsertAlert(ctx, record); err != nil {
+		log.Error("Error storing alert in history: ", err)
+	}
+	return sendErr
+}
+
+// lastDeliveredAlert returns the last delivered alert of the dedup key, the history is only read the first
+// time the key is used
+func (sender *HistoryAlertSender) lastDeliveredAlert(ctx context.Context, dedupKey string) (*alerts.AlertRecord, error) {
+	sender.lastMutex.Lock()
+	last, ok := sender.last[dedupKey]
+	sender.lastMutex.Unlock()
+	if ok {
+		return last, nil
+	}
+	last, err := sender.repository.GetLastDeliveredAlert(ctx, dedupKey)
+	if err != nil {
+		return nil, err
+	}
+	sender.setLastDeliveredAlert(dedupKey, last)
+	return last, nil
+}
+
+func (sender *HistoryAlertSender) setLastDeliveredAlert(dedupKey string, record *alerts.AlertRecord) {
+	sender.lastMutex.Lock()
+	defer sender.lastMutex.Unlock()
+	sender.last[dedupKey] = record
+}
+
+func (sender *HistoryAlertSender) shouldSuppress(alert alerts.Alert, last *alerts.AlertRecord) bool {
+	if alert.IsResolved() {
+		return last == nil || last.IsResolved()
+	}
+	if last == nil || last.IsResolved() {
+		return false
+	}
+	window, ok := sender.windows[alert.Subject]
+	if !ok {
+		window = sender.defaultWindow
+	}
+	return alert.Timestamp.Sub(last.Timestamp) < window
+}
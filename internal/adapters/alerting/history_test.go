@@ -0,0 +1,203 @@
+package alerting_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/alerting"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestHistoryAlertSender_SendAlert(t *testing.T) {
+	now := time.Now()
+	liquidityAlert := alerts.Alert{
+		Kind:      alerts.AlertKindPeginOutOfLiquidity,
+		Subject:   alerts.AlertSubjectPeginOutOfLiquidity,
+		Severity:  alerts.AlertSeverityCritical,
+		Status:    alerts.AlertStatusFiring,
+		Message:   "out of liquidity",
+		Amounts:   map[string]*entities.Wei{alerts.AlertAmountRequiredLiquidity: entities.NewWei(1)},
+		Timestamp: now,
+	}
+	resolvedAlert := liquidityAlert
+	resolvedAlert.Status = alerts.AlertStatusResolved
+	recordOf := func(alert alerts.Alert, timestamp time.Time) *alerts.AlertRecord {
+		alert.Timestamp = timestamp
+		return &alerts.AlertRecord{Alert: alert, DedupKey: alert.DedupKey(), Recipients: []string{toAddress}, Delivered: true}
+	}
+	const window = time.Hour
+
+	t.Run("should send and record the alert when there is no previous alert", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		sender := &mocks.AlertSenderMock{}
+		repository.EXPECT().GetLastDeliveredAlert(mock.Anything, "pegin_out_of_liquidity").Return(nil, nil).Once()
+		sender.On("SendAlert", mock.Anything, liquidityAlert, []string{toAddress}).Return(nil).Once()
+		repository.EXPECT().InsertAlert(mock.Anything, alerts.AlertRecord{
+			Alert:      liquidityAlert,
+			DedupKey:   "pegin_out_of_liquidity",
+			Recipients: []string{toAddress},
+			Delivered:  true,
+		}).Return(nil).Once()
+		historySender := alerting.NewHistoryAlertSender(sender, repository, window, nil)
+		err := historySender.SendAlert(context.Background(), liquidityAlert, []string{toAddress})
+		require.NoError(t, err)
+		sender.AssertExpectations(t)
+	})
+	t.Run("should suppress firing alert inside the dedup window", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		sender := &mocks.AlertSenderMock{}
+		repository.EXPECT().GetLastDeliveredAlert(mock.Anything, mock.Anything).
+			Return(recordOf(liquidityAlert, now.Add(-30*time.Minute)), nil).Once()
+		historySender := alerting.NewHistoryAlertSender(sender, repository, window, nil)
+		err := historySender.SendAlert(context.Background(), liquidityAlert, []string{toAddress})
+		require.NoError(t, err)
+		sender.AssertNotCalled(t, "SendAlert")
+		repository.AssertNotCalled(t, "InsertAlert")
+	})
+	t.Run("should send firing alert after the dedup window", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		sender := &mocks.AlertSenderMock{}
+		repository.EXPECT().GetLastDeliveredAlert(mock.Anything, mock.Anything).
+			Return(recordOf(liquidityAlert, now.Add(-2*time.Hour)), nil).Once()
+		sender.On("SendAlert", mock.Anything, liquidityAlert, mock.Anything).Return(nil).Once()
+		repository.EXPECT().InsertAlert(mock.Anything, mock.Anything).Return(nil).Once()
+		historySender := alerting.NewHistoryAlertSender(sender, repository, window, nil)
+		err := historySender.SendAlert(context.Background(), liquidityAlert, []string{toAddress})
+		require.NoError(t, err)
+		sender.AssertExpectations(t)
+	})
+	t.Run("should use the dedup window of the subject", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		sender := &mocks.AlertSenderMock{}
+		repository.EXPECT().GetLastDeliveredAlert(mock.Anything, mock.Anything).
+			Return(recordOf(liquidityAlert, now.Add(-30*time.Minute)), nil).Once()
+		sender.On("SendAlert", mock.Anything, liquidityAlert, mock.Anything).Return(nil).Once()
+		repository.EXPECT().InsertAlert(mock.Anything, mock.Anything).Return(nil).Once()
+		historySender := alerting.NewHistoryAlertSender(sender, repository, window, map[string]time.Duration{
+			alerts.AlertSubjectPeginOutOfLiquidity: 10 * time.Minute,
+		})
+		err := historySender.SendAlert(context.Background(), liquidityAlert, []string{toAddress})
+		require.NoError(t, err)
+		sender.AssertExpectations(t)
+	})
+	t.Run("should send firing alert if the condition was resolved", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		sender := &mocks.AlertSenderMock{}
+		repository.EXPECT().GetLastDeliveredAlert(mock.Anything, mock.Anything).
+			Return(recordOf(resolvedAlert, now.Add(-time.Minute)), nil).Once()
+		sender.On("SendAlert", mock.Anything, liquidityAlert, mock.Anything).Return(nil).Once()
+		repository.EXPECT().InsertAlert(mock.Anything, mock.Anything).Return(nil).Once()
+		historySender := alerting.NewHistoryAlertSender(sender, repository, window, nil)
+		err := historySender.SendAlert(context.Background(), liquidityAlert, []string{toAddress})
+		require.NoError(t, err)
+		sender.AssertExpectations(t)
+	})
+	t.Run("should send resolved alert only if the condition was firing", func(t *testing.T) {
+		for _, last := range []*alerts.AlertRecord{nil, recordOf(resolvedAlert, now.Add(-time.Minute))} {
+			repository := mocks.NewAlertRepositoryMock(t)
+			sender := &mocks.AlertSenderMock{}
+			repository.EXPECT().GetLastDeliveredAlert(mock.Anything, mock.Anything).Return(last, nil).Once()
+			historySender := alerting.NewHistoryAlertSender(sender, repository, window, nil)
+			err := historySender.SendAlert(context.Background(), resolvedAlert, []string{toAddress})
+			require.NoError(t, err)
+			sender.AssertNotCalled(t, "SendAlert")
+		}
+		repository := mocks.NewAlertRepositoryMock(t)
+		sender := &mocks.AlertSenderMock{}
+		repository.EXPECT().GetLastDeliveredAlert(mock.Anything, mock.Anything).
+			Return(recordOf(liquidityAlert, now.Add(-time.Minute)), nil).Once()
+		sender.On("SendAlert", mock.Anything, resolvedAlert, mock.Anything).Return(nil).Once()
+		repository.EXPECT().InsertAlert(mock.Anything, mock.MatchedBy(func(record alerts.AlertRecord) bool {
+			return record.IsResolved() && record.Delivered
+		})).Return(nil).Once()
+		historySender := alerting.NewHistoryAlertSender(sender, repository, window, nil)
+		err := historySender.SendAlert(context.Background(), resolvedAlert, []string{toAddress})
+		require.NoError(t, err)
+		sender.AssertExpectations(t)
+	})
+	t.Run("should record failed deliveries", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		sender := &mocks.AlertSenderMock{}
+		repository.EXPECT().GetLastDeliveredAlert(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		sender.On("SendAlert", mock.Anything, mock.Anything, mock.Anything).Return(assert.AnError).Once()
+		repository.EXPECT().InsertAlert(mock.Anything, mock.MatchedBy(func(record alerts.AlertRecord) bool {
+			return !record.Delivered && record.Error == assert.AnError.Error() && record.Recipients != nil
+		})).Return(nil).Once()
+		historySender := alerting.NewHistoryAlertSender(sender, repository, window, nil)
+		err := historySender.SendAlert(context.Background(), liquidityAlert, nil)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+	t.Run("should send alert when history is not available", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		sender := &mocks.AlertSenderMock{}
+		repository.EXPECT().GetLastDeliveredAlert(mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		sender.On("SendAlert", mock.Anything, liquidityAlert, mock.Anything).Return(nil).Once()
+		repository.EXPECT().InsertAlert(mock.Anything, mock.Anything).Return(assert.AnError).Once()
+		historySender := alerting.NewHistoryAlertSender(sender, repository, window, nil)
+		err := historySender.SendAlert(context.Background(), liquidityAlert, []string{toAddress})
+		require.NoError(t, err)
+		sender.AssertExpectations(t)
+	})
+	t.Run("should discard resolved alert when history is not available", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		sender := &mocks.AlertSenderMock{}
+		repository.EXPECT().GetLastDeliveredAlert(mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		historySender := alerting.NewHistoryAlertSender(sender, repository, window, nil)
+		err := historySender.SendAlert(context.Background(), resolvedAlert, []string{toAddress})
+		require.NoError(t, err)
+		sender.AssertNotCalled(t, "SendAlert")
+	})
+	t.Run("should read the history only once per condition", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		sender := &mocks.AlertSenderMock{}
+		repository.EXPECT().GetLastDeliveredAlert(mock.Anything, "pegin_out_of_liquidity").Return(nil, nil).Once()
+		sender.On("SendAlert", mock.Anything, liquidityAlert, mock.Anything).Return(nil).Once()
+		sender.On("SendAlert", mock.Anything, resolvedAlert, mock.Anything).Return(nil).Once()
+		repository.EXPECT().InsertAlert(mock.Anything, mock.Anything).Return(nil).Twice()
+		historySender := alerting.NewHistoryAlertSender(sender, repository, window, nil)
+		for _, alert := range []alerts.Alert{resolvedAlert, resolvedAlert, liquidityAlert, liquidityAlert, resolvedAlert, resolvedAlert} {
+			require.NoError(t, historySender.SendAlert(context.Background(), alert, []string{toAddress}))
+		}
+		sender.AssertExpectations(t)
+	})
+	t.Run("should read the history again if it was not available", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		sender := &mocks.AlertSenderMock{}
+		repository.EXPECT().GetLastDeliveredAlert(mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		repository.EXPECT().GetLastDeliveredAlert(mock.Anything, mock.Anything).
+			Return(recordOf(liquidityAlert, now.Add(-time.Minute)), nil).Once()
+		sender.On("SendAlert", mock.Anything, resolvedAlert, mock.Anything).Return(nil).Once()
+		repository.EXPECT().InsertAlert(mock.Anything, mock.Anything).Return(nil).Once()
+		historySender := alerting.NewHistoryAlertSender(sender, repository, window, nil)
+		for i := 0; i < 3; i++ {
+			require.NoError(t, historySender.SendAlert(context.Background(), resolvedAlert, []string{toAddress}))
+		}
+		sender.AssertExpectations(t)
+	})
+	t.Run("should fill status and timestamp of the alert", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		sender := &mocks.AlertSenderMock{}
+		repository.EXPECT().GetLastDeliveredAlert(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		sender.On("SendAlert", mock.Anything, mock.MatchedBy(func(alert alerts.Alert) bool {
+			return alert.Status == alerts.AlertStatusFiring && !alert.Timestamp.IsZero()
+		}), mock.Anything).Return(nil).Once()
+		repository.EXPECT().InsertAlert(mock.Anything, mock.Anything).Return(nil).Once()
+		historySender := alerting.NewHistoryAlertSender(sender, repository, window, nil)
+		err := historySender.SendAlert(context.Background(), alerts.Alert{Subject: subject}, nil)
+		require.NoError(t, err)
+		sender.AssertExpectations(t)
+	})
+	t.Run("should return error on empty subject", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		historySender := alerting.NewHistoryAlertSender(&mocks.AlertSenderMock{}, repository, window, nil)
+		err := historySender.SendAlert(context.Background(), alerts.Alert{}, nil)
+		require.ErrorIs(t, err, alerts.EmptyAlertSubjectError)
+	})
+}
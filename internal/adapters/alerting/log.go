@@ -2,7 +2,6 @@ package alerting
 
 import (
 	"context"
-	"errors"
 	"strings"
 
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
@@ -15,13 +14,13 @@ func NewLogAlertSender() alerts.AlertSender {
 	return &LogAlertSender{}
 }
 
-func (sender *LogAlertSender) SendAlert(ctx context.Context, subject, body string, recipient []string) error {
-	if strings.TrimSpace(subject) == "" {
-		return errors.New("alert subject cannot be empty")
+func (sender *LogAlertSender) SendAlert(ctx context.Context, alert alerts.Alert, recipient []string) error {
+	if err := alert.Validate(); err != nil {
+		return err
 	}
 
 	recipients := strings.Join(recipient, ", ")
-	log.Infof("Alert! - Subject: %s | Recipients: %s | Body: %s", subject, recipients, body)
+	log.Infof("Alert! - Subject: %s | Recipients: %s | Body: %s", alert.Subject, recipients, renderText(alert))
 
 	return nil
 }
@@ -3,8 +3,11 @@ package alerting_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/alerting"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
 	"github.com/rsksmart/liquidity-provider-server/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,7 +24,7 @@ func TestLogAlertSender_SendAlert_SingleRecipient(t *testing.T) {
 	sender := alerting.NewLogAlertSender()
 	assertLogContains := test.AssertLogContains(t, "Alert! - Subject: Test Alert Subject | Recipients: admin@example.com | Body: Test alert body message")
 
-	err := sender.SendAlert(context.Background(), testSubject, testBody, []string{testRecipient1})
+	err := sender.SendAlert(context.Background(), newAlert(testSubject, testBody), []string{testRecipient1})
 
 	require.NoError(t, err)
 	assert.True(t, assertLogContains())
@@ -31,7 +34,7 @@ func TestLogAlertSender_SendAlert_MultipleRecipients(t *testing.T) {
 	sender := alerting.NewLogAlertSender()
 	assertLogContains := test.AssertLogContains(t, "Alert! - Subject: Test Alert Subject | Recipients: admin@example.com, support@example.com | Body: Test alert body message")
 
-	err := sender.SendAlert(context.Background(), testSubject, testBody, []string{testRecipient1, testRecipient2})
+	err := sender.SendAlert(context.Background(), newAlert(testSubject, testBody), []string{testRecipient1, testRecipient2})
 
 	require.NoError(t, err)
 	assert.True(t, assertLogContains())
@@ -41,7 +44,7 @@ func TestLogAlertSender_SendAlert_EmptyRecipients(t *testing.T) {
 	sender := alerting.NewLogAlertSender()
 	assertLogContains := test.AssertLogContains(t, "Alert! - Subject: Test Alert Subject | Recipients:  | Body: Test alert body message")
 
-	err := sender.SendAlert(context.Background(), testSubject, testBody, []string{})
+	err := sender.SendAlert(context.Background(), newAlert(testSubject, testBody), []string{})
 
 	require.NoError(t, err)
 	assert.True(t, assertLogContains())
@@ -51,7 +54,7 @@ func TestLogAlertSender_SendAlert_NilRecipients(t *testing.T) {
 	sender := alerting.NewLogAlertSender()
 	assertLogContains := test.AssertLogContains(t, "Alert! - Subject: Test Alert Subject | Recipients:  | Body: Test alert body message")
 
-	err := sender.SendAlert(context.Background(), testSubject, testBody, nil)
+	err := sender.SendAlert(context.Background(), newAlert(testSubject, testBody), nil)
 
 	require.NoError(t, err)
 	assert.True(t, assertLogContains())
@@ -60,7 +63,7 @@ func TestLogAlertSender_SendAlert_NilRecipients(t *testing.T) {
 func TestLogAlertSender_SendAlert_EmptySubject(t *testing.T) {
 	sender := alerting.NewLogAlertSender()
 
-	err := sender.SendAlert(context.Background(), "", testBody, []string{testRecipient1})
+	err := sender.SendAlert(context.Background(), newAlert("", testBody), []string{testRecipient1})
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "alert subject cannot be empty")
@@ -69,7 +72,7 @@ func TestLogAlertSender_SendAlert_EmptySubject(t *testing.T) {
 func TestLogAlertSender_SendAlert_WhitespaceOnlySubject(t *testing.T) {
 	sender := alerting.NewLogAlertSender()
 
-	err := sender.SendAlert(context.Background(), "   \t\n   ", testBody, []string{testRecipient1})
+	err := sender.SendAlert(context.Background(), newAlert("   \t\n   ", testBody), []string{testRecipient1})
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "alert subject cannot be empty")
@@ -79,7 +82,7 @@ func TestLogAlertSender_SendAlert_ValidSubjectEmptyBody(t *testing.T) {
 	sender := alerting.NewLogAlertSender()
 	assertLogContains := test.AssertLogContains(t, "Alert! - Subject: Test Alert Subject | Recipients: admin@example.com | Body:")
 
-	err := sender.SendAlert(context.Background(), testSubject, "", []string{testRecipient1})
+	err := sender.SendAlert(context.Background(), newAlert(testSubject, ""), []string{testRecipient1})
 
 	require.NoError(t, err)
 	assert.True(t, assertLogContains())
@@ -91,7 +94,7 @@ func TestLogAlertSender_SendAlert_SpecialCharacters(t *testing.T) {
 	sender := alerting.NewLogAlertSender()
 	assertLogContains := test.AssertLogContains(t, "Alert: System Error! (Critical)")
 
-	err := sender.SendAlert(context.Background(), subjectWithSpecialChars, bodyWithSpecialChars, []string{testRecipient1})
+	err := sender.SendAlert(context.Background(), newAlert(subjectWithSpecialChars, bodyWithSpecialChars), []string{testRecipient1})
 
 	require.NoError(t, err)
 	assert.True(t, assertLogContains())
@@ -103,7 +106,7 @@ func TestLogAlertSender_SendAlert_LongContent(t *testing.T) {
 	sender := alerting.NewLogAlertSender()
 	assertLogContains := test.AssertLogContains(t, longSubject)
 
-	err := sender.SendAlert(context.Background(), longSubject, longBody, []string{testRecipient1})
+	err := sender.SendAlert(context.Background(), newAlert(longSubject, longBody), []string{testRecipient1})
 
 	require.NoError(t, err)
 	assert.True(t, assertLogContains())
@@ -114,7 +117,7 @@ func TestLogAlertSender_SendAlert_JSONBody(t *testing.T) {
 	sender := alerting.NewLogAlertSender()
 	assertLogContains := test.AssertLogContains(t, "Alert! - Subject: Test Alert Subject")
 
-	err := sender.SendAlert(context.Background(), testSubject, jsonBody, []string{testRecipient1})
+	err := sender.SendAlert(context.Background(), newAlert(testSubject, jsonBody), []string{testRecipient1})
 
 	require.NoError(t, err)
 	assert.True(t, assertLogContains())
@@ -130,7 +133,32 @@ func TestLogAlertSender_AlwaysSucceeds_WithValidSubject(t *testing.T) {
 
 	// Test multiple calls to ensure it always returns nil when subject is valid
 	for i := 0; i < 5; i++ {
-		err := sender.SendAlert(context.Background(), testSubject, testBody, []string{testRecipient1})
+		err := sender.SendAlert(context.Background(), newAlert(testSubject, testBody), []string{testRecipient1})
 		require.NoError(t, err)
 	}
 }
+
+func newAlert(subject, message string) alerts.Alert {
+	return alerts.Alert{Subject: subject, Message: message}
+}
+
+func TestLogAlertSender_SendAlert_StructuredFields(t *testing.T) {
+	sender := alerting.NewLogAlertSender()
+	alert := alerts.Alert{
+		Kind:        alerts.AlertKindPenalization,
+		Subject:     testSubject,
+		Message:     testBody,
+		QuoteHash:   "0x1234",
+		Amounts:     map[string]*entities.Wei{alerts.AlertAmountPenalty: entities.NewWei(500)},
+		NodeType:    entities.NodeTypeBitcoin,
+		BlockNumber: 10,
+		BlockHash:   "0xabcd",
+		Timestamp:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	assertLogContains := test.AssertLogContains(t, `Body: Test alert body message\n\nQuote hash: 0x1234\nAmount (penalty): 500 wei\nNode type: bitcoin\nBlock number: 10\nBlock hash: 0xabcd\nTimestamp: 2024-01-02T03:04:05Z`)
+
+	err := sender.SendAlert(context.Background(), alert, []string{testRecipient1})
+
+	require.NoError(t, err)
+	assert.True(t, assertLogContains())
+}
@@ -0,0 +1,82 @@
+package alerting
+
+import (
+	"context"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/utils"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	DefaultPagerDutyEventsUrl = "https://events.pagerduty.com/v2/enqueue"
+	pagerDutyTriggerAction    = "trigger"
+	pagerDutyResolveAction    = "resolve"
+)
+
+type pagerDutyEvent struct {
+	RoutingKey  string           `json:"routing_key"`
+	EventAction string           `json:"event_action"`
+	DedupKey    string           `json:"dedup_key"`
+	Payload     pagerDutyPayload `json:"payload"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string       `json:"summary"`
+	Source        string       `json:"source"`
+	Severity      string       `json:"severity"`
+	Timestamp     string       `json:"timestamp"`
+	Class         string       `json:"class"`
+	CustomDetails alerts.Alert `json:"custom_details"`
+}
+
+// PagerDutyAlertSender triggers an event in the PagerDuty Events API v2 (or any compatible API) for each alert.
+// The recipients are ignored since the escalation is defined by the routing key service. The alert dedup key is
+// used as the incident key, so a resolved alert resolves the incident opened by the firing one
+type PagerDutyAlertSender struct {
+	client     utils.HttpClient
+	url        string
+	routingKey string
+	source     string
+}
+
+func NewPagerDutyAlertSender(client utils.HttpClient, url, routingKey, source string) alerts.AlertSender {
+	if url == "" {
+		url = DefaultPagerDutyEventsUrl
+	}
+	return &PagerDutyAlertSender{client: client, url: url, routingKey: routingKey, source: source}
+}
+
+func (sender *PagerDutyAlertSender) SendAlert(ctx context.Context, alert alerts.Alert, recipient []string) error {
+	if err := alert.Validate(); err != nil {
+		return err
+	}
+	timestamp := alert.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	alert.Severity = alertSeverity(alert)
+	action := pagerDutyTriggerAction
+	if alert.IsResolved() {
+		action = pagerDutyResolveAction
+	}
+	event := pagerDutyEvent{
+		RoutingKey:  sender.routingKey,
+		EventAction: action,
+		DedupKey:    alert.DedupKey(),
+		Payload: pagerDutyPayload{
+			Summary:       alert.Subject,
+			Source:        sender.source,
+			Severity:      string(alert.Severity),
+			Timestamp:     timestamp.UTC().Format(time.RFC3339),
+			Class:         string(alert.Kind),
+			CustomDetails: alert,
+		},
+	}
+	if err := postJson(ctx, sender.client, sender.url, event); err != nil {
+		return err
+	}
+	log.Info("Alert sent to PagerDuty")
+	return nil
+}
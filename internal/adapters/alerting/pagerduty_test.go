@@ -0,0 +1,83 @@
+package alerting_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/alerting"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	routingKey = "routing-key"
+	source     = "test provider"
+)
+
+func TestPagerDutyAlertSender_SendAlert(t *testing.T) {
+	var received struct {
+		RoutingKey  string `json:"routing_key"`
+		EventAction string `json:"event_action"`
+		DedupKey    string `json:"dedup_key"`
+		Payload     struct {
+			Summary       string         `json:"summary"`
+			Source        string         `json:"source"`
+			Severity      string         `json:"severity"`
+			Timestamp     string         `json:"timestamp"`
+			Class         string         `json:"class"`
+			CustomDetails map[string]any `json:"custom_details"`
+		} `json:"payload"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sender := alerting.NewPagerDutyAlertSender(server.Client(), server.URL, routingKey, source)
+	err := sender.SendAlert(context.Background(), penalizationAlert, []string{toAddress})
+	require.NoError(t, err)
+	assert.Equal(t, routingKey, received.RoutingKey)
+	assert.Equal(t, "trigger", received.EventAction)
+	assert.Equal(t, "penalization:0x1234", received.DedupKey)
+	assert.Equal(t, subject, received.Payload.Summary)
+	assert.Equal(t, source, received.Payload.Source)
+	assert.Equal(t, "critical", received.Payload.Severity)
+	assert.Equal(t, "2024-01-02T03:04:05Z", received.Payload.Timestamp)
+	assert.Equal(t, "penalization", received.Payload.Class)
+	assert.Equal(t, "0x1234", received.Payload.CustomDetails["quoteHash"])
+	assert.Equal(t, map[string]any{"penalty": float64(500)}, received.Payload.CustomDetails["amounts"])
+
+	resolved := penalizationAlert
+	resolved.Status = alerts.AlertStatusResolved
+	err = sender.SendAlert(context.Background(), resolved, []string{toAddress})
+	require.NoError(t, err)
+	assert.Equal(t, "resolve", received.EventAction)
+	assert.Equal(t, "penalization:0x1234", received.DedupKey)
+}
+
+func TestNewPagerDutyAlertSender_DefaultUrl(t *testing.T) {
+	sender := alerting.NewPagerDutyAlertSender(http.DefaultClient, "", routingKey, source)
+	assert.NotNil(t, sender)
+	assert.Equal(t, "https://events.pagerduty.com/v2/enqueue", alerting.DefaultPagerDutyEventsUrl)
+}
+
+func TestPagerDutyAlertSender_SendAlert_ErrorHandling(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status":"invalid event"}`))
+	}))
+	defer server.Close()
+
+	sender := alerting.NewPagerDutyAlertSender(server.Client(), server.URL, routingKey, source)
+	err := sender.SendAlert(context.Background(), penalizationAlert, nil)
+	require.ErrorContains(t, err, "unexpected response (400)")
+
+	err = sender.SendAlert(context.Background(), alerts.Alert{Subject: "\t"}, nil)
+	require.ErrorIs(t, err, alerts.EmptyAlertSubjectError)
+}
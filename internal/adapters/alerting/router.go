@@ -0,0 +1,56 @@
+package alerting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	log "github.com/sirupsen/logrus"
+)
+
+// AlertRoute defines the senders that should deliver the alerts of a subject. If the severity is set,
+// it overrides the severity defined by the alert emitter
+type AlertRoute struct {
+	Severity alerts.AlertSeverity
+	Senders  []alerts.AlertSender
+}
+
+// RoutingAlertSender dispatches each alert to the senders configured for its subject. If the subject
+// doesn't have a specific route, the fallback route is used
+type RoutingAlertSender struct {
+	routes   map[string]AlertRoute
+	fallback AlertRoute
+}
+
+func NewRoutingAlertSender(routes map[string]AlertRoute, fallback AlertRoute) alerts.AlertSender {
+	if routes == nil {
+		routes = make(map[string]AlertRoute)
+	}
+	return &RoutingAlertSender{routes: routes, fallback: fallback}
+}
+
+func (sender *RoutingAlertSender) SendAlert(ctx context.Context, alert alerts.Alert, recipient []string) error {
+	if err := alert.Validate(); err != nil {
+		return err
+	}
+	route, ok := sender.routes[alert.Subject]
+	if !ok {
+		route = sender.fallback
+	}
+	if len(route.Senders) == 0 {
+		log.Warnf("No alert senders configured for subject %q, alert discarded", alert.Subject)
+		return nil
+	}
+
+	if route.Severity.IsValid() {
+		alert.Severity = route.Severity
+	}
+	var err error
+	for _, routeSender := range route.Senders {
+		if sendErr := routeSender.SendAlert(ctx, alert, recipient); sendErr != nil {
+			err = errors.Join(err, fmt.Errorf("%T: %w", routeSender, sendErr))
+		}
+	}
+	return err
+}
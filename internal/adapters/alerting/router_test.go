@@ -0,0 +1,78 @@
+package alerting_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/alerting"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutingAlertSender_SendAlert(t *testing.T) {
+	withSeverity := func(severity alerts.AlertSeverity) any {
+		return mock.MatchedBy(func(alert alerts.Alert) bool {
+			return alert.Severity == severity && alert.QuoteHash == penalizationAlert.QuoteHash
+		})
+	}
+	t.Run("should route alert to every sender of its subject overriding its severity", func(t *testing.T) {
+		firstSender := &mocks.AlertSenderMock{}
+		firstSender.On("SendAlert", mock.Anything, withSeverity(alerts.AlertSeverityWarning), []string{toAddress}).Return(nil).Once()
+		secondSender := &mocks.AlertSenderMock{}
+		secondSender.On("SendAlert", mock.Anything, withSeverity(alerts.AlertSeverityWarning), []string{toAddress}).Return(nil).Once()
+		fallbackSender := &mocks.AlertSenderMock{}
+		sender := alerting.NewRoutingAlertSender(map[string]alerting.AlertRoute{
+			subject: {Severity: alerts.AlertSeverityWarning, Senders: []alerts.AlertSender{firstSender, secondSender}},
+		}, alerting.AlertRoute{Senders: []alerts.AlertSender{fallbackSender}})
+		err := sender.SendAlert(context.Background(), penalizationAlert, []string{toAddress})
+		require.NoError(t, err)
+		firstSender.AssertExpectations(t)
+		secondSender.AssertExpectations(t)
+		fallbackSender.AssertNotCalled(t, "SendAlert")
+	})
+	t.Run("should keep alert severity when route doesn't define one", func(t *testing.T) {
+		routeSender := &mocks.AlertSenderMock{}
+		routeSender.On("SendAlert", mock.Anything, withSeverity(alerts.AlertSeverityCritical), mock.Anything).Return(nil).Once()
+		sender := alerting.NewRoutingAlertSender(map[string]alerting.AlertRoute{
+			subject: {Senders: []alerts.AlertSender{routeSender}},
+		}, alerting.AlertRoute{})
+		err := sender.SendAlert(context.Background(), penalizationAlert, nil)
+		require.NoError(t, err)
+		routeSender.AssertExpectations(t)
+	})
+	t.Run("should use fallback route for subjects without route", func(t *testing.T) {
+		fallbackSender := &mocks.AlertSenderMock{}
+		fallbackSender.On("SendAlert", mock.Anything, withSeverity(alerts.AlertSeverityInfo), mock.Anything).Return(nil).Once()
+		sender := alerting.NewRoutingAlertSender(nil, alerting.AlertRoute{Severity: alerts.AlertSeverityInfo, Senders: []alerts.AlertSender{fallbackSender}})
+		err := sender.SendAlert(context.Background(), penalizationAlert, nil)
+		require.NoError(t, err)
+		fallbackSender.AssertExpectations(t)
+	})
+	t.Run("should not fail when route has no senders", func(t *testing.T) {
+		sender := alerting.NewRoutingAlertSender(nil, alerting.AlertRoute{})
+		err := sender.SendAlert(context.Background(), penalizationAlert, nil)
+		require.NoError(t, err)
+	})
+	t.Run("should return error on empty subject", func(t *testing.T) {
+		sender := alerting.NewRoutingAlertSender(nil, alerting.AlertRoute{})
+		err := sender.SendAlert(context.Background(), alerts.Alert{}, nil)
+		require.ErrorIs(t, err, alerts.EmptyAlertSubjectError)
+	})
+}
+
+func TestRoutingAlertSender_SendAlert_ErrorHandling(t *testing.T) {
+	failingSender := &mocks.AlertSenderMock{}
+	failingSender.On("SendAlert", mock.Anything, mock.Anything, mock.Anything).Return(assert.AnError).Once()
+	workingSender := &mocks.AlertSenderMock{}
+	workingSender.On("SendAlert", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	sender := alerting.NewRoutingAlertSender(map[string]alerting.AlertRoute{
+		subject: {Senders: []alerts.AlertSender{failingSender, workingSender}},
+	}, alerting.AlertRoute{})
+	err := sender.SendAlert(context.Background(), penalizationAlert, nil)
+	require.ErrorIs(t, err, assert.AnError)
+	failingSender.AssertExpectations(t)
+	workingSender.AssertExpectations(t)
+}
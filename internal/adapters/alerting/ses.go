@@ -22,7 +22,12 @@ func NewSesAlertSender(sesClient sesClient, from string) alerts.AlertSender {
 	return &SesAlertSender{sesClient: sesClient, from: from}
 }
 
-func (sender *SesAlertSender) SendAlert(ctx context.Context, subject, body string, recipient []string) error {
+func (sender *SesAlertSender) SendAlert(ctx context.Context, alert alerts.Alert, recipient []string) error {
+	if err := alert.Validate(); err != nil {
+		return err
+	}
+	subject := alert.Subject
+	body := renderText(alert)
 	log.Info("Sending alert to liquidity provider")
 	result, err := sender.sesClient.SendEmail(ctx, &ses.SendEmailInput{
 		Destination: &sesTypes.Destination{
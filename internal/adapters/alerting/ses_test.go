@@ -5,6 +5,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ses"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/alerting"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
 	"github.com/rsksmart/liquidity-provider-server/test"
 	"github.com/rsksmart/liquidity-provider-server/test/mocks"
 	"github.com/stretchr/testify/assert"
@@ -30,7 +31,7 @@ func TestSesAlertSender_SendAlert(t *testing.T) {
 	})).Return(&ses.SendEmailOutput{MessageId: aws.String("msgId")}, nil)
 
 	sender := alerting.NewSesAlertSender(client, fromAddress)
-	err := sender.SendAlert(context.Background(), subject, body, []string{toAddress})
+	err := sender.SendAlert(context.Background(), alerts.Alert{Subject: subject, Message: body}, []string{toAddress})
 	require.NoError(t, err)
 	client.AssertExpectations(t)
 }
@@ -40,7 +41,15 @@ func TestSesAlertSender_SendAlert_ErrorHandling(t *testing.T) {
 
 	client.On("SendEmail", test.AnyCtx, mock.Anything).Return(nil, assert.AnError)
 	sender := alerting.NewSesAlertSender(client, fromAddress)
-	err := sender.SendAlert(context.Background(), subject, body, []string{toAddress})
+	err := sender.SendAlert(context.Background(), alerts.Alert{Subject: subject, Message: body}, []string{toAddress})
 	require.Error(t, err)
 	client.AssertExpectations(t)
 }
+
+func TestSesAlertSender_SendAlert_EmptySubject(t *testing.T) {
+	client := &mocks.SesClientMock{}
+	sender := alerting.NewSesAlertSender(client, fromAddress)
+	err := sender.SendAlert(context.Background(), alerts.Alert{Message: body}, []string{toAddress})
+	require.ErrorIs(t, err, alerts.EmptyAlertSubjectError)
+	client.AssertNotCalled(t, "SendEmail")
+}
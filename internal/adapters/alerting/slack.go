@@ -0,0 +1,44 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/utils"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	log "github.com/sirupsen/logrus"
+)
+
+const slackMessageTemplate = "*[%s] %s*\n%s"
+
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+// SlackAlertSender sends the alerts to a Slack compatible incoming webhook. The recipients are
+// ignored since the destination channel is defined by the webhook itself
+type SlackAlertSender struct {
+	client     utils.HttpClient
+	webhookUrl string
+}
+
+func NewSlackAlertSender(client utils.HttpClient, webhookUrl string) alerts.AlertSender {
+	return &SlackAlertSender{client: client, webhookUrl: webhookUrl}
+}
+
+func (sender *SlackAlertSender) SendAlert(ctx context.Context, alert alerts.Alert, recipient []string) error {
+	if err := alert.Validate(); err != nil {
+		return err
+	}
+	label := strings.ToUpper(string(alertSeverity(alert)))
+	if alert.IsResolved() {
+		label = strings.ToUpper(string(alerts.AlertStatusResolved))
+	}
+	payload := slackPayload{Text: fmt.Sprintf(slackMessageTemplate, label, alert.Subject, renderText(alert))}
+	if err := postJson(ctx, sender.client, sender.webhookUrl, payload); err != nil {
+		return err
+	}
+	log.Info("Alert sent to Slack")
+	return nil
+}
@@ -0,0 +1,53 @@
+package alerting_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/alerting"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlackAlertSender_SendAlert(t *testing.T) {
+	var received map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	sender := alerting.NewSlackAlertSender(server.Client(), server.URL)
+	err := sender.SendAlert(context.Background(), penalizationAlert, []string{toAddress})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"text": "*[CRITICAL] any subject*\nany body\n\nQuote hash: 0x1234\nAmount (penalty): 500 wei\nTimestamp: 2024-01-02T03:04:05Z",
+	}, received)
+
+	resolved := penalizationAlert
+	resolved.Status = alerts.AlertStatusResolved
+	err = sender.SendAlert(context.Background(), resolved, []string{toAddress})
+	require.NoError(t, err)
+	assert.Contains(t, received["text"], "*[RESOLVED] any subject*\n")
+}
+
+func TestSlackAlertSender_SendAlert_ErrorHandling(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("invalid_token"))
+	}))
+	defer server.Close()
+
+	sender := alerting.NewSlackAlertSender(server.Client(), server.URL)
+	err := sender.SendAlert(context.Background(), penalizationAlert, nil)
+	require.ErrorContains(t, err, "unexpected response (403)")
+	require.ErrorContains(t, err, "invalid_token")
+
+	err = sender.SendAlert(context.Background(), alerts.Alert{Message: body}, nil)
+	require.ErrorIs(t, err, alerts.EmptyAlertSubjectError)
+}
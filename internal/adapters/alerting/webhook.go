@@ -0,0 +1,40 @@
+package alerting
+
+import (
+	"context"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/utils"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	log "github.com/sirupsen/logrus"
+)
+
+type webhookPayload struct {
+	alerts.Alert
+	Recipients []string `json:"recipients"`
+}
+
+// WebhookAlertSender sends the alerts as a JSON document to a generic HTTP endpoint
+type WebhookAlertSender struct {
+	client utils.HttpClient
+	url    string
+}
+
+func NewWebhookAlertSender(client utils.HttpClient, url string) alerts.AlertSender {
+	return &WebhookAlertSender{client: client, url: url}
+}
+
+func (sender *WebhookAlertSender) SendAlert(ctx context.Context, alert alerts.Alert, recipient []string) error {
+	if err := alert.Validate(); err != nil {
+		return err
+	}
+	if recipient == nil {
+		recipient = make([]string, 0)
+	}
+	alert.Severity = alertSeverity(alert)
+	payload := webhookPayload{Alert: alert, Recipients: recipient}
+	if err := postJson(ctx, sender.client, sender.url, payload); err != nil {
+		return err
+	}
+	log.Info("Alert sent to webhook")
+	return nil
+}
@@ -0,0 +1,91 @@
+package alerting_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/alerting"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var penalizationAlert = alerts.Alert{
+	Kind:      alerts.AlertKindPenalization,
+	Subject:   subject,
+	Severity:  alerts.AlertSeverityCritical,
+	Message:   body,
+	QuoteHash: "0x1234",
+	Amounts:   map[string]*entities.Wei{alerts.AlertAmountPenalty: entities.NewWei(500)},
+	Timestamp: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+}
+
+func TestWebhookAlertSender_SendAlert(t *testing.T) {
+	var received map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sender := alerting.NewWebhookAlertSender(server.Client(), server.URL)
+	err := sender.SendAlert(context.Background(), penalizationAlert, []string{toAddress})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"kind":       "penalization",
+		"subject":    subject,
+		"severity":   "critical",
+		"message":    body,
+		"quoteHash":  "0x1234",
+		"amounts":    map[string]any{"penalty": float64(500)},
+		"timestamp":  "2024-01-02T03:04:05Z",
+		"recipients": []any{toAddress},
+	}, received)
+}
+
+func TestWebhookAlertSender_SendAlert_DefaultSeverity(t *testing.T) {
+	var received map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	sender := alerting.NewWebhookAlertSender(server.Client(), server.URL)
+	err := sender.SendAlert(context.Background(), alerts.Alert{Subject: subject, Message: body}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "error", received["severity"])
+	assert.Equal(t, []any{}, received["recipients"])
+	assert.NotContains(t, received, "quoteHash")
+	assert.NotContains(t, received, "amounts")
+}
+
+func TestWebhookAlertSender_SendAlert_ErrorHandling(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte("webhook failure"))
+	}))
+	defer server.Close()
+
+	sender := alerting.NewWebhookAlertSender(server.Client(), server.URL)
+	t.Run("should return error on non 2xx response", func(t *testing.T) {
+		err := sender.SendAlert(context.Background(), penalizationAlert, []string{toAddress})
+		require.ErrorContains(t, err, "unexpected response (500)")
+		require.ErrorContains(t, err, "webhook failure")
+	})
+	t.Run("should return error on empty subject", func(t *testing.T) {
+		err := sender.SendAlert(context.Background(), alerts.Alert{Subject: " "}, []string{toAddress})
+		require.ErrorIs(t, err, alerts.EmptyAlertSubjectError)
+	})
+	t.Run("should return error when server is unreachable", func(t *testing.T) {
+		unreachable := alerting.NewWebhookAlertSender(server.Client(), "http://127.0.0.1:1")
+		err := unreachable.SendAlert(context.Background(), penalizationAlert, []string{toAddress})
+		require.Error(t, err)
+	})
+}
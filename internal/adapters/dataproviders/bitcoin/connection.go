@@ -12,6 +12,7 @@ type Connection struct {
 	NetworkParams *chaincfg.Params
 	client        btcclient.ClientAdapter
 	WalletId      string
+	feePolicy     FeePolicy
 }
 
 // NewWalletConnection creates a new Connection with a walletId. This connection will use the walletId
@@ -30,6 +31,11 @@ func NewConnection(networkParams *chaincfg.Params, client btcclient.ClientAdapte
 	return &Connection{NetworkParams: networkParams, client: client}
 }
 
+// SetFeePolicy sets the FeePolicy used by the wallets that create transactions through this Connection
+func (c *Connection) SetFeePolicy(policy FeePolicy) {
+	c.feePolicy = policy
+}
+
 func (c *Connection) Shutdown(endChannel chan<- bool) {
 	c.client.Disconnect()
 	endChannel <- true
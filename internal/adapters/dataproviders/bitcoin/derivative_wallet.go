@@ -1,20 +1,15 @@
 package bitcoin
 
 import (
-	"bytes"
-	"encoding/hex"
 	"errors"
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/btcutil"
-	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
-	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/bitcoin/btcclient"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock/account"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
-	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -47,203 +42,63 @@ func NewDerivativeWallet(
 }
 
 func (wallet *DerivativeWallet) initWallet() error {
-	const addressVerificationErrorTemplate = "error while verifying wallet has address: %w"
-	var err error
-	var info *btcjson.GetWalletInfoResult
-	var btcAddress btcutil.Address
-	var addressInfo *btcjson.GetAddressInfoResult
-
-	if info, err = wallet.conn.client.GetWalletInfo(); err != nil || info.WalletName != wallet.conn.WalletId {
-		if info, err = wallet.createWallet(); err != nil {
-			return err
-		}
-	}
-	_, ok := info.Scanning.Value.(btcjson.ScanProgress)
-	if ok {
-		return errors.New("wallet is still scanning, please wait for the scan to finish before initializing the server again")
-	}
-
-	if btcAddress, err = wallet.rskAccount.BtcAddress(); err != nil {
-		return fmt.Errorf(addressVerificationErrorTemplate, err)
-	}
-
-	if addressInfo, err = wallet.conn.client.GetAddressInfo(btcAddress.EncodeAddress()); err != nil {
-		return fmt.Errorf(addressVerificationErrorTemplate, err)
-	} else if !addressInfo.Solvable || !addressInfo.IsWatchOnly {
-		return wallet.importPublicKey()
-	}
-
-	return nil
-}
-
-func (wallet *DerivativeWallet) createWallet() (*btcjson.GetWalletInfoResult, error) {
-	if _, err := wallet.conn.client.LoadWallet(wallet.conn.WalletId); err == nil {
-		return wallet.conn.client.GetWalletInfo()
-	}
-	log.Infof("Wallet not found to be loaded, creating wallet %s...", wallet.conn.WalletId)
-	err := wallet.conn.client.CreateReadonlyWallet(btcclient.ReadonlyWalletRequest{
-		WalletName:         wallet.conn.WalletId,
-		DisablePrivateKeys: true,
-		Blank:              true,
-		AvoidReuse:         false,
-		Descriptors:        false,
-	})
-
+	btcAddress, err := wallet.rskAccount.BtcAddress()
 	if err != nil {
-		return nil, fmt.Errorf("error while creating %s wallet: %w", wallet.conn.WalletId, err)
+		return fmt.Errorf("error while verifying wallet has address: %w", err)
 	}
-	return wallet.conn.client.GetWalletInfo()
-}
-
-func (wallet *DerivativeWallet) importPublicKey() error {
-	const errorTemplate = "error while importing public key: %w"
 	pubKey, err := wallet.rskAccount.BtcPubKey()
 	if err != nil {
-		return fmt.Errorf(errorTemplate, err)
-	}
-	err = wallet.conn.client.ImportPubKey(pubKey)
-	if err != nil {
-		return fmt.Errorf(errorTemplate, err)
+		return fmt.Errorf("error while importing public key: %w", err)
 	}
-	err = wallet.conn.client.ImportAddressRescan(wallet.Address(), "", true)
-	if err != nil {
-		return fmt.Errorf(errorTemplate, err)
-	}
-	return errors.New("public key imported, rescan started, please wait for the rescan process to finish before initializing the server again")
+	return initSingleKeyWallet(wallet.conn, btcAddress, pubKey)
 }
 
 func (wallet *DerivativeWallet) EstimateTxFees(toAddress string, value *entities.Wei) (blockchain.BtcFeeEstimation, error) {
-	const quoteHashLength = 32
-
-	if _, err := btcutil.DecodeAddress(toAddress, wallet.conn.NetworkParams); err != nil {
-		return blockchain.BtcFeeEstimation{}, err
-	}
-	if err := EnsureLoadedBtcWallet(wallet.conn); err != nil {
-		return blockchain.BtcFeeEstimation{}, err
-	}
-
-	amountInSatoshi, _ := value.ToSatoshi().Int64()
-	output := []btcjson.PsbtOutput{
-		{toAddress: btcutil.Amount(amountInSatoshi).ToUnit(btcutil.AmountBTC)},
-		{"data": hex.EncodeToString(make([]byte, quoteHashLength))}, // quote hash output
-	}
-
-	feeRate, err := wallet.estimateFeeRate()
-	if err != nil {
-		return blockchain.BtcFeeEstimation{}, err
-	}
 	changeAddress, err := wallet.rskAccount.BtcAddress()
 	if err != nil {
 		return blockchain.BtcFeeEstimation{}, err
 	}
-
-	opts := btcjson.WalletCreateFundedPsbtOpts{
-		ChangeAddress:   btcjson.String(changeAddress.EncodeAddress()),
-		ChangePosition:  btcjson.Int64(changePosition),
-		IncludeWatching: btcjson.Bool(true),
-		FeeRate:         feeRate,
-	}
-
-	simulatedTx, err := wallet.conn.client.WalletCreateFundedPsbt(nil, output, nil, &opts, nil)
-	if err != nil {
-		return blockchain.BtcFeeEstimation{}, err
-	}
-	btcFee, err := btcutil.NewAmount(simulatedTx.Fee)
-	if err != nil {
-		return blockchain.BtcFeeEstimation{}, err
-	}
-	satoshiFee := btcFee.ToUnit(btcutil.AmountSatoshi)
-	return blockchain.BtcFeeEstimation{
-		Value:   entities.SatoshiToWei(uint64(satoshiFee)),
-		FeeRate: utils.NewBigFloat64(*feeRate),
-	}, nil
+	return estimateSingleKeyTxFees(wallet.conn, changeAddress, toAddress, value)
 }
 
 func (wallet *DerivativeWallet) GetBalance() (*entities.Wei, error) {
-	var amount btcutil.Amount
-	balance := new(entities.Wei)
-
 	btcAddress, err := wallet.rskAccount.BtcAddress()
 	if err != nil {
 		return nil, err
 	}
-	if err = EnsureLoadedBtcWallet(wallet.conn); err != nil {
-		return nil, err
-	}
-
-	utxos, err := wallet.conn.client.ListUnspentMinMaxAddresses(
-		MinConfirmationsForUtxos,
-		MaxConfirmationsForUtxos,
-		[]btcutil.Address{btcAddress},
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, utxo := range utxos {
-		if amount, err = btcutil.NewAmount(utxo.Amount); err != nil {
-			return nil, err
-		}
-		if utxo.Confirmations > 0 {
-			balance.Add(balance, entities.SatoshiToWei(uint64(amount.ToUnit(btcutil.AmountSatoshi))))
-		}
-	}
-	return balance, nil
+	return getSingleKeyBalance(wallet.conn, btcAddress)
 }
 
 func (wallet *DerivativeWallet) SendWithOpReturn(address string, value *entities.Wei, opReturnContent []byte) (blockchain.BitcoinTransactionResult, error) {
-	if err := EnsureLoadedBtcWallet(wallet.conn); err != nil {
-		return blockchain.BitcoinTransactionResult{}, err
-	}
-
-	rawTx, err := wallet.buildRawTransactionWithOpReturn(address, value, opReturnContent)
-	if err != nil {
-		return blockchain.BitcoinTransactionResult{}, err
-	}
-
-	opts, err := wallet.buildFundRawTransactionOpts()
-	if err != nil {
-		return blockchain.BitcoinTransactionResult{}, err
-	}
-	fundedTx, err := wallet.conn.client.FundRawTransaction(rawTx, opts, nil)
-	if err != nil {
-		return blockchain.BitcoinTransactionResult{}, err
-	}
-
-	signedTx, err := wallet.signFundedTransaction(fundedTx)
+	changeAddress, err := wallet.rskAccount.BtcAddress()
 	if err != nil {
 		return blockchain.BitcoinTransactionResult{}, err
 	}
+	return sendSingleKeyTransaction(wallet.conn, changeAddress, address, value, opReturnContent, wallet.signFundedTransaction)
+}
 
-	log.Infof("Sending %v BTC to %s\n", value.ToRbtc(), address)
-	txHash, err := wallet.conn.client.SendRawTransaction(signedTx, false)
+func (wallet *DerivativeWallet) BumpFee(txHash string, maxFee *entities.Wei) (blockchain.BitcoinTransactionResult, error) {
+	changeAddress, err := wallet.rskAccount.BtcAddress()
 	if err != nil {
 		return blockchain.BitcoinTransactionResult{}, err
 	}
-
-	// Convert fee from satoshis to wei
-	feeSatoshis := uint64(fundedTx.Fee)
-	feeWei := entities.SatoshiToWei(feeSatoshis)
-
-	return blockchain.BitcoinTransactionResult{
-		Hash: txHash.String(),
-		Fee:  feeWei,
-	}, nil
+	return bumpSingleKeyTransactionFee(wallet.conn, changeAddress, txHash, maxFee, wallet.signFundedTransaction)
 }
 
 func (wallet *DerivativeWallet) CreateUnfundedTransactionWithOpReturn(address string, value *entities.Wei, opReturnContent []byte) ([]byte, error) {
-	rawTx, err := wallet.buildRawTransactionWithOpReturn(address, value, opReturnContent)
-	if err != nil {
-		return nil, err
-	}
+	return createUnfundedTransactionWithOpReturn(wallet.conn, address, value, opReturnContent)
+}
 
-	var buf bytes.Buffer
-	err = rawTx.Serialize(&buf)
+func (wallet *DerivativeWallet) SendBatchWithOpReturn(payments []blockchain.BitcoinPayment) (blockchain.BitcoinTransactionResult, error) {
+	changeAddress, err := wallet.rskAccount.BtcAddress()
 	if err != nil {
-		return nil, err
+		return blockchain.BitcoinTransactionResult{}, err
 	}
+	return sendSingleKeyBatchTransaction(wallet.conn, changeAddress, payments, wallet.signFundedTransaction)
+}
 
-	return buf.Bytes(), nil
+func (wallet *DerivativeWallet) CreateUnfundedBatchTransactionWithOpReturn(payments []blockchain.BitcoinPayment) ([]byte, error) {
+	return createUnfundedBatchTransactionWithOpReturn(wallet.conn, payments)
 }
 
 func (wallet *DerivativeWallet) ImportAddress(address string) error {
@@ -270,45 +125,6 @@ func (wallet *DerivativeWallet) Unlock() error {
 	return errors.New("derivative wallet does not support unlocking as it is a watch-only wallet")
 }
 
-func (wallet *DerivativeWallet) estimateFeeRate() (*float64, error) {
-	const (
-		confirmationTargetForEstimation = 1
-		minimumEstimatedConfirmations   = 2
-		extraFeeMultiplier              = 0.1
-		estimationMaxDecimals           = 8
-	)
-	estimationResult, err := wallet.conn.client.EstimateSmartFee(confirmationTargetForEstimation, &btcjson.EstimateModeEconomical)
-	if err != nil {
-		return nil, err
-	} else if len(estimationResult.Errors) != 0 {
-		return nil, errors.New(estimationResult.Errors[0])
-	}
-	// add 10% to the fee rate if result still over the target for the estimation
-	if estimationResult.Blocks > confirmationTargetForEstimation && estimationResult.Blocks != minimumEstimatedConfirmations {
-		return btcjson.Float64(utils.RoundToNDecimals(*estimationResult.FeeRate+*estimationResult.FeeRate*extraFeeMultiplier, estimationMaxDecimals)), nil
-	}
-	return btcjson.Float64(utils.RoundToNDecimals(*estimationResult.FeeRate, estimationMaxDecimals)), nil
-}
-
-func (wallet *DerivativeWallet) buildFundRawTransactionOpts() (btcjson.FundRawTransactionOpts, error) {
-	feeRate, err := wallet.estimateFeeRate()
-	if err != nil {
-		return btcjson.FundRawTransactionOpts{}, err
-	}
-	changeAddress, err := wallet.rskAccount.BtcAddress()
-	if err != nil {
-		return btcjson.FundRawTransactionOpts{}, err
-	}
-	return btcjson.FundRawTransactionOpts{
-		ChangeAddress:   btcjson.String(changeAddress.EncodeAddress()),
-		ChangePosition:  btcjson.Int(changePosition),
-		IncludeWatching: btcjson.Bool(true),
-		LockUnspents:    btcjson.Bool(true),
-		FeeRate:         feeRate,
-		Replaceable:     btcjson.Bool(true),
-	}, nil
-}
-
 func (wallet *DerivativeWallet) Shutdown(closeChannel chan<- bool) {
 	wallet.conn.Shutdown(closeChannel)
 }
@@ -331,25 +147,3 @@ func (wallet *DerivativeWallet) signFundedTransaction(fundedTx *btcjson.FundRawT
 	}
 	return signedTx, nil
 }
-
-func (wallet *DerivativeWallet) buildRawTransactionWithOpReturn(address string, value *entities.Wei, opReturnContent []byte) (*wire.MsgTx, error) {
-	decodedAddress, err := btcutil.DecodeAddress(address, wallet.conn.NetworkParams)
-	if err != nil {
-		return nil, err
-	}
-
-	satoshis, _ := value.ToSatoshi().Float64()
-	output := map[btcutil.Address]btcutil.Amount{decodedAddress: btcutil.Amount(satoshis)}
-	rawTx, err := wallet.conn.client.CreateRawTransaction(nil, output, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	opReturnScript, err := txscript.NullDataScript(opReturnContent)
-	if err != nil {
-		return nil, err
-	}
-	rawTx.AddTxOut(wire.NewTxOut(0, opReturnScript))
-
-	return rawTx, nil
-}
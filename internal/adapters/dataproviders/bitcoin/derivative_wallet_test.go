@@ -214,6 +214,7 @@ func TestDerivativeWallet(t *testing.T) {
 	t.Run("EstimateTxFees", func(t *testing.T) {
 		t.Run("Success", func(t *testing.T) { testEstimateFees(t, rskAccount, existingAddressInfo) })
 		t.Run("Add extra value if estimation blocks is higher than the target", func(t *testing.T) { testEstimateFeesExtra(t, rskAccount, existingAddressInfo) })
+		t.Run("Use the fee policy of the connection", func(t *testing.T) { testEstimateFeesWithFeePolicy(t, rskAccount, existingAddressInfo) })
 		t.Run("Error handling tx fees estimation", func(t *testing.T) {
 			cases := derivativeWalletEstimateTxFeesErrorSetups(rskAccount)
 			for _, testCase := range cases {
@@ -441,6 +442,35 @@ func testEstimateFees(t *testing.T, rskAccount *account.RskAccount, addressInfo
 	client.AssertExpectations(t)
 }
 
+func testEstimateFeesWithFeePolicy(t *testing.T, rskAccount *account.RskAccount, addressInfo *btcjson.GetAddressInfoResult) {
+	client := &mocks.ClientAdapterMock{}
+	amount := entities.NewWei(5000000000000000)
+	client.On("GetWalletInfo").Return(&btcjson.GetWalletInfoResult{WalletName: bitcoin.DerivativeWalletId, Scanning: btcjson.ScanningOrFalse{Value: false}}, nil).Twice()
+	client.On("GetAddressInfo", btcAddress).Return(addressInfo, nil).Once()
+	client.On("WalletCreateFundedPsbt",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		&btcjson.WalletCreateFundedPsbtOpts{
+			ChangeAddress:   btcjson.String(btcAddress),
+			ChangePosition:  btcjson.Int64(changePosition),
+			IncludeWatching: btcjson.Bool(true),
+			FeeRate:         btcjson.Float64(0.0002),
+		},
+		mock.Anything,
+	).Return(&btcjson.WalletCreateFundedPsbtResult{Fee: 0.0006}, nil).Once()
+	conn := bitcoin.NewWalletConnection(&chaincfg.TestNet3Params, client, bitcoin.DerivativeWalletId)
+	conn.SetFeePolicy(bitcoin.FeePolicy{FeeRate: bitcoin.NewFixedFeeRateStrategy(20), QuoteMarginPercentage: 15})
+	wallet, err := bitcoin.NewDerivativeWallet(conn, rskAccount)
+	require.NoError(t, err)
+	fee, err := wallet.EstimateTxFees(testnetAddress, amount)
+	require.NoError(t, err)
+	assert.Equal(t, entities.NewWei(690000000000000), fee.Value)
+	assert.Equal(t, utils.NewBigFloat64(0.0002), fee.FeeRate)
+	client.AssertNotCalled(t, "EstimateSmartFee", mock.Anything, mock.Anything)
+	client.AssertExpectations(t)
+}
+
 func testEstimateFeesExtra(t *testing.T, rskAccount *account.RskAccount, addressInfo *btcjson.GetAddressInfoResult) {
 	client := &mocks.ClientAdapterMock{}
 	amount := entities.NewWei(5000000000000000)
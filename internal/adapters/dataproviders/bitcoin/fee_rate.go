@@ -0,0 +1,90 @@
+package bitcoin
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+)
+
+const (
+	// DefaultFeeRateTarget is the amount of blocks in which the transactions are expected to be confirmed
+	DefaultFeeRateTarget = 1
+	// satoshiPerVbyteToBtcPerKvb converts sat/vB to BTC/kvB: 1000 vB per kvB / 10^8 sat per BTC
+	satoshiPerVbyteToBtcPerKvb = 1e-5
+	feeRateMaxDecimals         = 8
+	percentageDivisor          = 100
+)
+
+// FeePolicy defines how the fee of the transactions created by a wallet is calculated
+type FeePolicy struct {
+	// FeeRate is the source of the fee rate used to estimate and to fund the transactions. If nil, the
+	// fee rate estimated by the node for DefaultFeeRateTarget blocks is used
+	FeeRate blockchain.BtcFeeRateStrategy
+	// QuoteMarginPercentage is added to the fee estimations used to quote, but not to the fee rate of
+	// the transactions that are actually sent
+	QuoteMarginPercentage uint64
+}
+
+func (policy FeePolicy) applyQuoteMargin(fee *entities.Wei) *entities.Wei {
+	if policy.QuoteMarginPercentage == 0 {
+		return fee
+	}
+	result := new(big.Int).Mul(fee.AsBigInt(), new(big.Int).SetUint64(percentageDivisor+policy.QuoteMarginPercentage))
+	return entities.NewBigWei(result.Div(result, big.NewInt(percentageDivisor)))
+}
+
+// NodeFeeRateStrategy uses the estimatesmartfee RPC method of the bitcoin node
+type NodeFeeRateStrategy struct {
+	conn   *Connection
+	target int64
+}
+
+func NewNodeFeeRateStrategy(conn *Connection, target uint64) *NodeFeeRateStrategy {
+	return &NodeFeeRateStrategy{conn: conn, target: int64(target)}
+}
+
+func (strategy *NodeFeeRateStrategy) FeeRate() (float64, error) {
+	const (
+		minimumEstimatedConfirmations = 2
+		extraFeeMultiplier            = 0.1
+	)
+	estimationResult, err := strategy.conn.client.EstimateSmartFee(strategy.target, &btcjson.EstimateModeEconomical)
+	if err != nil {
+		return 0, err
+	} else if len(estimationResult.Errors) != 0 {
+		return 0, errors.New(estimationResult.Errors[0])
+	} else if estimationResult.FeeRate == nil {
+		return 0, errors.New("node didn't return a fee rate estimation")
+	}
+	// add 10% to the fee rate if result still over the target for the estimation
+	if estimationResult.Blocks > strategy.target && estimationResult.Blocks != minimumEstimatedConfirmations {
+		return utils.RoundToNDecimals(*estimationResult.FeeRate+*estimationResult.FeeRate*extraFeeMultiplier, feeRateMaxDecimals), nil
+	}
+	return utils.RoundToNDecimals(*estimationResult.FeeRate, feeRateMaxDecimals), nil
+}
+
+// FixedFeeRateStrategy always uses the same fee rate
+type FixedFeeRateStrategy struct {
+	satoshiPerVbyte uint64
+}
+
+func NewFixedFeeRateStrategy(satoshiPerVbyte uint64) *FixedFeeRateStrategy {
+	return &FixedFeeRateStrategy{satoshiPerVbyte: satoshiPerVbyte}
+}
+
+func (strategy *FixedFeeRateStrategy) FeeRate() (float64, error) {
+	if strategy.satoshiPerVbyte == 0 {
+		return 0, errors.New("fixed fee rate must be positive")
+	}
+	return SatoshiPerVbyteToBtcPerKvb(float64(strategy.satoshiPerVbyte)), nil
+}
+
+// SatoshiPerVbyteToBtcPerKvb converts a fee rate from sat/vB, the unit used by most fee estimation services,
+// to BTC/kvB, the unit used by the node RPC methods
+func SatoshiPerVbyteToBtcPerKvb(feeRate float64) float64 {
+	return utils.RoundToNDecimals(feeRate*satoshiPerVbyteToBtcPerKvb, feeRateMaxDecimals)
+}
@@ -0,0 +1,61 @@
+package bitcoin_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/bitcoin"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeFeeRateStrategy_FeeRate(t *testing.T) {
+	t.Run("Should use the configured target", func(t *testing.T) {
+		client := &mocks.ClientAdapterMock{}
+		client.On("EstimateSmartFee", int64(6), &btcjson.EstimateModeEconomical).Return(&btcjson.EstimateSmartFeeResult{FeeRate: btcjson.Float64(0.00005), Blocks: 6}, nil).Once()
+		strategy := bitcoin.NewNodeFeeRateStrategy(bitcoin.NewConnection(&chaincfg.TestNet3Params, client), 6)
+		feeRate, err := strategy.FeeRate()
+		require.NoError(t, err)
+		assert.InDelta(t, 0.00005, feeRate, 0)
+		client.AssertExpectations(t)
+	})
+	t.Run("Should add 10% if the estimation is over the target", func(t *testing.T) {
+		client := &mocks.ClientAdapterMock{}
+		client.On("EstimateSmartFee", int64(3), &btcjson.EstimateModeEconomical).Return(&btcjson.EstimateSmartFeeResult{FeeRate: btcjson.Float64(0.0001), Blocks: 5}, nil).Once()
+		strategy := bitcoin.NewNodeFeeRateStrategy(bitcoin.NewConnection(&chaincfg.TestNet3Params, client), 3)
+		feeRate, err := strategy.FeeRate()
+		require.NoError(t, err)
+		assert.InDelta(t, 0.00011, feeRate, 0)
+		client.AssertExpectations(t)
+	})
+	t.Run("Should handle estimation errors", func(t *testing.T) {
+		client := &mocks.ClientAdapterMock{}
+		strategy := bitcoin.NewNodeFeeRateStrategy(bitcoin.NewConnection(&chaincfg.TestNet3Params, client), 1)
+		client.On("EstimateSmartFee", int64(1), &btcjson.EstimateModeEconomical).Return(nil, assert.AnError).Once()
+		_, err := strategy.FeeRate()
+		require.ErrorIs(t, err, assert.AnError)
+		client.On("EstimateSmartFee", int64(1), &btcjson.EstimateModeEconomical).Return(&btcjson.EstimateSmartFeeResult{Errors: []string{"Insufficient data"}}, nil).Once()
+		_, err = strategy.FeeRate()
+		require.ErrorContains(t, err, "Insufficient data")
+		client.On("EstimateSmartFee", int64(1), &btcjson.EstimateModeEconomical).Return(&btcjson.EstimateSmartFeeResult{}, nil).Once()
+		_, err = strategy.FeeRate()
+		require.Error(t, err)
+		client.AssertExpectations(t)
+	})
+}
+
+func TestFixedFeeRateStrategy_FeeRate(t *testing.T) {
+	feeRate, err := bitcoin.NewFixedFeeRateStrategy(25).FeeRate()
+	require.NoError(t, err)
+	assert.InDelta(t, 0.00025, feeRate, 0)
+	_, err = bitcoin.NewFixedFeeRateStrategy(0).FeeRate()
+	require.Error(t, err)
+}
+
+func TestSatoshiPerVbyteToBtcPerKvb(t *testing.T) {
+	assert.InDelta(t, 0.00001, bitcoin.SatoshiPerVbyteToBtcPerKvb(1), 0)
+	assert.InDelta(t, 0.0000125, bitcoin.SatoshiPerVbyteToBtcPerKvb(1.25), 0)
+	assert.InDelta(t, 0.0015, bitcoin.SatoshiPerVbyteToBtcPerKvb(150), 0)
+}
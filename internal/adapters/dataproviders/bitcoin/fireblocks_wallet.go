@@ -0,0 +1,150 @@
+package bitcoin
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/fireblocks"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+)
+
+const FireblocksWalletId = "fireblocks-wallet"
+
+// FireblocksWallet is a BitcoinWallet whose key is held by Fireblocks. The node tracks the P2PKH address of the
+// vault asset key as watch-only to fund the transactions, and the inputs are signed through a raw signing
+// transaction of the vault account, so the key is never present in the LPS host
+type FireblocksWallet struct {
+	conn           *Connection
+	fireblocks     *fireblocks.Client
+	asset          fireblocks.VaultAsset
+	pubKey         *btcec.PublicKey
+	address        *btcutil.AddressPubKey
+	signingTimeout time.Duration
+}
+
+func NewFireblocksWallet(
+	ctx context.Context,
+	conn *Connection,
+	fireblocksClient *fireblocks.Client,
+	asset fireblocks.VaultAsset,
+	signingTimeout time.Duration,
+) (blockchain.BitcoinWallet, error) {
+	if conn.WalletId != FireblocksWalletId {
+		return nil, errors.New("fireblocks wallet can only be created with wallet id " + FireblocksWalletId)
+	}
+	pubKeyBytes, err := fireblocksClient.GetPublicKey(ctx, asset.VaultAccountId, asset.AssetId)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := btcec.ParsePubKey(pubKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key for asset %s: %w", asset.AssetId, err)
+	}
+	address, err := btcutil.NewAddressPubKey(pubKey.SerializeCompressed(), conn.NetworkParams)
+	if err != nil {
+		return nil, err
+	}
+	wallet := &FireblocksWallet{
+		conn:           conn,
+		fireblocks:     fireblocksClient,
+		asset:          asset,
+		pubKey:         pubKey,
+		address:        address,
+		signingTimeout: signingTimeout,
+	}
+	if err = initSingleKeyWallet(conn, address, hex.EncodeToString(pubKey.SerializeCompressed())); err != nil {
+		return nil, err
+	}
+	return wallet, nil
+}
+
+func (wallet *FireblocksWallet) EstimateTxFees(toAddress string, value *entities.Wei) (blockchain.BtcFeeEstimation, error) {
+	return estimateSingleKeyTxFees(wallet.conn, wallet.address, toAddress, value)
+}
+
+func (wallet *FireblocksWallet) GetBalance() (*entities.Wei, error) {
+	return getSingleKeyBalance(wallet.conn, wallet.address)
+}
+
+func (wallet *FireblocksWallet) SendWithOpReturn(address string, value *entities.Wei, opReturnContent []byte) (blockchain.BitcoinTransactionResult, error) {
+	return sendSingleKeyTransaction(wallet.conn, wallet.address, address, value, opReturnContent, wallet.signFundedTransaction)
+}
+
+func (wallet *FireblocksWallet) BumpFee(txHash string, maxFee *entities.Wei) (blockchain.BitcoinTransactionResult, error) {
+	return bumpSingleKeyTransactionFee(wallet.conn, wallet.address, txHash, maxFee, wallet.signFundedTransaction)
+}
+
+func (wallet *FireblocksWallet) CreateUnfundedTransactionWithOpReturn(address string, value *entities.Wei, opReturnContent []byte) ([]byte, error) {
+	return createUnfundedTransactionWithOpReturn(wallet.conn, address, value, opReturnContent)
+}
+
+func (wallet *FireblocksWallet) SendBatchWithOpReturn(payments []blockchain.BitcoinPayment) (blockchain.BitcoinTransactionResult, error) {
+	return sendSingleKeyBatchTransaction(wallet.conn, wallet.address, payments, wallet.signFundedTransaction)
+}
+
+func (wallet *FireblocksWallet) CreateUnfundedBatchTransactionWithOpReturn(payments []blockchain.BitcoinPayment) ([]byte, error) {
+	return createUnfundedBatchTransactionWithOpReturn(wallet.conn, payments)
+}
+
+func (wallet *FireblocksWallet) ImportAddress(address string) error {
+	return errors.New("address importing is not supported in this type of wallet")
+}
+
+func (wallet *FireblocksWallet) GetTransactions(address string) ([]blockchain.BitcoinTransactionInformation, error) {
+	if err := EnsureLoadedBtcWallet(wallet.conn); err != nil {
+		return nil, err
+	}
+	return getTransactionsToAddress(address, wallet.conn.NetworkParams, wallet.conn.client)
+}
+
+func (wallet *FireblocksWallet) Address() string {
+	return wallet.address.EncodeAddress()
+}
+
+func (wallet *FireblocksWallet) Unlock() error {
+	return errors.New("fireblocks wallet does not support unlocking as its key is held by fireblocks")
+}
+
+func (wallet *FireblocksWallet) Shutdown(closeChannel chan<- bool) {
+	wallet.conn.Shutdown(closeChannel)
+}
+
+// signFundedTransaction signs all the inputs of the transaction in a single raw signing transaction
+func (wallet *FireblocksWallet) signFundedTransaction(fundedTx *btcjson.FundRawTransactionResult) (*wire.MsgTx, error) {
+	tx := fundedTx.Transaction.Copy()
+	hashes, err := singleKeySignatureHashes(tx, wallet.address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), wallet.signingTimeout)
+	defer cancel()
+	signatures, err := wallet.fireblocks.RawSign(ctx, fireblocks.RawSignRequest{
+		VaultAccountId: wallet.asset.VaultAccountId,
+		AssetId:        wallet.asset.AssetId,
+		Note:           "LPS BTC transaction " + tx.TxHash().String(),
+		Hashes:         hashes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var signatureBytes []byte
+	for i, signature := range signatures {
+		if signatureBytes, err = signature.Bytes(); err != nil {
+			return nil, err
+		}
+		if tx.TxIn[i].SignatureScript, err = buildSingleKeySignatureScript(wallet.pubKey, signatureBytes, hashes[i]); err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
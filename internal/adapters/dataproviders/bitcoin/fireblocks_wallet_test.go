@@ -0,0 +1,205 @@
+package bitcoin_test
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/bitcoin"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/fireblocks"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/test/fireblocks_stub"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const fireblocksBtcAsset = "BTC_TEST"
+
+var fireblocksAsset = fireblocks.VaultAsset{VaultAccountId: fireblocks_stub.VaultAccountId, AssetId: fireblocksBtcAsset}
+
+type fireblocksWalletTestSetup struct {
+	server  *fireblocks_stub.Server
+	client  *fireblocks.Client
+	key     *btcec.PrivateKey
+	address string
+}
+
+func newFireblocksWalletTestSetup(t *testing.T) fireblocksWalletTestSetup {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	server := fireblocks_stub.NewServer(t, map[string]*btcec.PrivateKey{fireblocksBtcAsset: key})
+	client, err := fireblocks.NewClient(http.DefaultClient, server.URL, fireblocks_stub.ApiKey, server.PrivateKey(), time.Millisecond)
+	require.NoError(t, err)
+	address, err := btcutil.NewAddressPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+	return fireblocksWalletTestSetup{server: server, client: client, key: key, address: address.EncodeAddress()}
+}
+
+func (setup fireblocksWalletTestSetup) newWallet(t *testing.T, client *mocks.ClientAdapterMock) blockchain.BitcoinWallet {
+	client.On("GetWalletInfo").Return(&btcjson.GetWalletInfoResult{WalletName: bitcoin.FireblocksWalletId, Scanning: btcjson.ScanningOrFalse{Value: false}}, nil).Once()
+	addressInfo := new(btcjson.GetAddressInfoResult)
+	require.NoError(t, addressInfo.UnmarshalJSON([]byte(`{"solvable":true,"iswatchonly":true}`)))
+	client.On("GetAddressInfo", setup.address).Return(addressInfo, nil).Once()
+	wallet, err := bitcoin.NewFireblocksWallet(
+		context.Background(),
+		bitcoin.NewWalletConnection(&chaincfg.TestNet3Params, client, bitcoin.FireblocksWalletId),
+		setup.client,
+		fireblocksAsset,
+		time.Second,
+	)
+	require.NoError(t, err)
+	return wallet
+}
+
+func TestNewFireblocksWallet(t *testing.T) {
+	setup := newFireblocksWalletTestSetup(t)
+	t.Run("should create the wallet with the address of the vault asset key", func(t *testing.T) {
+		client := &mocks.ClientAdapterMock{}
+		wallet := setup.newWallet(t, client)
+		assert.Equal(t, setup.address, wallet.Address())
+		client.AssertExpectations(t)
+	})
+	t.Run("should validate the wallet id", func(t *testing.T) {
+		wallet, err := bitcoin.NewFireblocksWallet(
+			context.Background(),
+			bitcoin.NewWalletConnection(&chaincfg.TestNet3Params, &mocks.ClientAdapterMock{}, bitcoin.DerivativeWalletId),
+			setup.client,
+			fireblocksAsset,
+			time.Second,
+		)
+		require.ErrorContains(t, err, "fireblocks wallet can only be created with wallet id")
+		assert.Nil(t, wallet)
+	})
+	t.Run("should import the public key if the address is not tracked", func(t *testing.T) {
+		client := &mocks.ClientAdapterMock{}
+		client.On("GetWalletInfo").Return(&btcjson.GetWalletInfoResult{WalletName: bitcoin.FireblocksWalletId, Scanning: btcjson.ScanningOrFalse{Value: false}}, nil).Once()
+		client.On("GetAddressInfo", setup.address).Return(&btcjson.GetAddressInfoResult{}, nil).Once()
+		client.On("ImportPubKey", hex.EncodeToString(setup.key.PubKey().SerializeCompressed())).Return(nil).Once()
+		client.On("ImportAddressRescan", setup.address, "", true).Return(nil).Once()
+		wallet, err := bitcoin.NewFireblocksWallet(
+			context.Background(),
+			bitcoin.NewWalletConnection(&chaincfg.TestNet3Params, client, bitcoin.FireblocksWalletId),
+			setup.client,
+			fireblocksAsset,
+			time.Second,
+		)
+		require.ErrorContains(t, err, "public key imported, rescan started")
+		assert.Nil(t, wallet)
+		client.AssertExpectations(t)
+	})
+	t.Run("should return error if the public key can't be obtained", func(t *testing.T) {
+		wallet, err := bitcoin.NewFireblocksWallet(
+			context.Background(),
+			bitcoin.NewWalletConnection(&chaincfg.TestNet3Params, &mocks.ClientAdapterMock{}, bitcoin.FireblocksWalletId),
+			setup.client,
+			fireblocks.VaultAsset{VaultAccountId: fireblocks_stub.VaultAccountId, AssetId: "BTC"},
+			time.Second,
+		)
+		require.ErrorContains(t, err, "Asset not found")
+		assert.Nil(t, wallet)
+	})
+}
+
+// nolint:funlen
+func TestFireblocksWallet_SendWithOpReturn(t *testing.T) {
+	const feeRate = 0.0001
+	opReturn := []byte{0x01, 0x02}
+	value := entities.NewWei(600000000000000000)
+	setup := newFireblocksWalletTestSetup(t)
+	walletAddress, err := btcutil.DecodeAddress(setup.address, &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+	walletScript, err := txscript.PayToAddrScript(walletAddress)
+	require.NoError(t, err)
+
+	buildFundedTx := func(t *testing.T) *wire.MsgTx {
+		tx := wire.NewMsgTx(wire.TxVersion)
+		for i := byte(1); i <= 2; i++ {
+			previousHash, hashErr := chainhash.NewHash(append(make([]byte, 31), i))
+			require.NoError(t, hashErr)
+			tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(previousHash, uint32(i)), nil, nil))
+		}
+		tx.AddTxOut(wire.NewTxOut(60000000, walletScript))
+		return tx
+	}
+	setupClient := func(t *testing.T, client *mocks.ClientAdapterMock, fundedTx *wire.MsgTx) {
+		client.On("GetWalletInfo").Return(&btcjson.GetWalletInfoResult{WalletName: bitcoin.FireblocksWalletId}, nil).Once()
+		client.On("CreateRawTransaction", ([]btcjson.TransactionInput)(nil), mock.Anything, (*int64)(nil)).Return(wire.NewMsgTx(wire.TxVersion), nil).Once()
+		client.On("EstimateSmartFee", int64(1), &btcjson.EstimateModeEconomical).Return(&btcjson.EstimateSmartFeeResult{FeeRate: btcjson.Float64(feeRate), Blocks: 1}, nil).Once()
+		client.On("FundRawTransaction", mock.Anything, mock.MatchedBy(func(opts btcjson.FundRawTransactionOpts) bool {
+			return *opts.ChangeAddress == setup.address && *opts.IncludeWatching
+		}), (*bool)(nil)).Return(&btcjson.FundRawTransactionResult{Transaction: fundedTx, Fee: 500}, nil).Once()
+	}
+
+	t.Run("should sign every input with the vault key", func(t *testing.T) {
+		client := &mocks.ClientAdapterMock{}
+		wallet := setup.newWallet(t, client)
+		fundedTx := buildFundedTx(t)
+		setupClient(t, client, fundedTx)
+		var sentTx *wire.MsgTx
+		client.On("SendRawTransaction", mock.AnythingOfType("*wire.MsgTx"), false).Return(chainhash.NewHashFromStr(testnetTestTxHash)).Run(func(args mock.Arguments) {
+			sentTx = args.Get(0).(*wire.MsgTx)
+		}).Once()
+
+		result, sendErr := wallet.SendWithOpReturn(testnetAddress, value, opReturn)
+		require.NoError(t, sendErr)
+		assert.Equal(t, testnetTestTxHash, result.Hash)
+		assert.Equal(t, entities.SatoshiToWei(500), result.Fee)
+		require.NotNil(t, sentTx)
+		require.Len(t, sentTx.TxIn, 2)
+		for i := range sentTx.TxIn {
+			engine, engineErr := txscript.NewEngine(walletScript, sentTx, i, txscript.StandardVerifyFlags, nil, nil, 0, txscript.NewCannedPrevOutputFetcher(walletScript, 0))
+			require.NoError(t, engineErr)
+			require.NoError(t, engine.Execute())
+		}
+		assert.Empty(t, fundedTx.TxIn[0].SignatureScript, "the funded transaction must not be modified")
+		messages := setup.server.Requests[len(setup.server.Requests)-1]["extraParameters"].(map[string]any)["rawMessageData"].(map[string]any)["messages"]
+		assert.Len(t, messages, 2)
+		client.AssertExpectations(t)
+	})
+	t.Run("should not send the transaction if the signature is rejected", func(t *testing.T) {
+		client := &mocks.ClientAdapterMock{}
+		wallet := setup.newWallet(t, client)
+		setupClient(t, client, buildFundedTx(t))
+		setup.server.FinalStatus = string(fireblocks.TransactionStatusBlocked)
+		defer func() { setup.server.FinalStatus = "" }()
+
+		result, sendErr := wallet.SendWithOpReturn(testnetAddress, value, opReturn)
+		require.ErrorIs(t, sendErr, fireblocks.TransactionNotCompletedError)
+		assert.Empty(t, result)
+		client.AssertNotCalled(t, "SendRawTransaction", mock.Anything, mock.Anything)
+		client.AssertExpectations(t)
+	})
+}
+
+func TestFireblocksWallet_GetBalance(t *testing.T) {
+	setup := newFireblocksWalletTestSetup(t)
+	client := &mocks.ClientAdapterMock{}
+	wallet := setup.newWallet(t, client)
+	client.On("GetWalletInfo").Return(&btcjson.GetWalletInfoResult{WalletName: bitcoin.FireblocksWalletId}, nil).Once()
+	client.On("ListUnspentMinMaxAddresses", bitcoin.MinConfirmationsForUtxos, bitcoin.MaxConfirmationsForUtxos, mock.MatchedBy(func(addresses []btcutil.Address) bool {
+		return len(addresses) == 1 && addresses[0].EncodeAddress() == setup.address
+	})).Return([]btcjson.ListUnspentResult{{Amount: 0.5, Confirmations: 1}, {Amount: 0.1, Confirmations: 0}}, nil).Once()
+	balance, err := wallet.GetBalance()
+	require.NoError(t, err)
+	assert.Equal(t, entities.SatoshiToWei(50000000), balance)
+	client.AssertExpectations(t)
+}
+
+func TestFireblocksWallet_UnsupportedOperations(t *testing.T) {
+	setup := newFireblocksWalletTestSetup(t)
+	wallet := setup.newWallet(t, &mocks.ClientAdapterMock{})
+	require.Error(t, wallet.Unlock())
+	require.Error(t, wallet.ImportAddress(testnetAddress))
+}
@@ -0,0 +1,79 @@
+package mempool_space
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/bitcoin"
+	dataproviders_utils "github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/utils"
+)
+
+const (
+	halfHourBlocks = 3
+	hourBlocks     = 6
+)
+
+type recommendedFeesApiResponse struct {
+	FastestFee  float64 `json:"fastestFee"`
+	HalfHourFee float64 `json:"halfHourFee"`
+	HourFee     float64 `json:"hourFee"`
+	EconomyFee  float64 `json:"economyFee"`
+	MinimumFee  float64 `json:"minimumFee"`
+}
+
+// MempoolSpaceFeeRateStrategy uses the recommended fees of the MempoolSpace API. The recommendation is chosen
+// based on the amount of blocks of the confirmation target: 1 block uses the fastest fee, up to 3 blocks the
+// half hour fee, up to 6 blocks the hour fee and more than 6 blocks the economy fee
+type MempoolSpaceFeeRateStrategy struct {
+	url    string
+	client dataproviders_utils.HttpClient
+	target uint64
+}
+
+func NewMempoolSpaceFeeRateStrategy(client dataproviders_utils.HttpClient, url string, target uint64) *MempoolSpaceFeeRateStrategy {
+	return &MempoolSpaceFeeRateStrategy{
+		client: client,
+		url:    strings.TrimSuffix(url, "/"),
+		target: target,
+	}
+}
+
+func (strategy *MempoolSpaceFeeRateStrategy) FeeRate() (float64, error) {
+	const feeRateError = "error getting recommended fees: %w"
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, strategy.url+"/v1/fees/recommended", nil)
+	if err != nil {
+		return 0, fmt.Errorf(feeRateError, err)
+	}
+	res, err := strategy.client.Do(req)
+	defer closeBody(res)
+	if err != nil {
+		return 0, fmt.Errorf(feeRateError, err)
+	} else if err = parseErrorIfPresent(res); err != nil {
+		return 0, fmt.Errorf(feeRateError, err)
+	}
+
+	var result recommendedFeesApiResponse
+	if err = json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return 0, fmt.Errorf(feeRateError, err)
+	}
+
+	var satoshiPerVbyte float64
+	switch {
+	case strategy.target <= 1:
+		satoshiPerVbyte = result.FastestFee
+	case strategy.target <= halfHourBlocks:
+		satoshiPerVbyte = result.HalfHourFee
+	case strategy.target <= hourBlocks:
+		satoshiPerVbyte = result.HourFee
+	default:
+		satoshiPerVbyte = result.EconomyFee
+	}
+	satoshiPerVbyte = max(satoshiPerVbyte, result.MinimumFee)
+	if satoshiPerVbyte <= 0 {
+		return 0, fmt.Errorf(feeRateError, fmt.Errorf("invalid fee rate %v", satoshiPerVbyte))
+	}
+	return bitcoin.SatoshiPerVbyteToBtcPerKvb(satoshiPerVbyte), nil
+}
@@ -0,0 +1,79 @@
+package mempool_space_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/bitcoin/mempool_space"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const recommendedFeesResponse = `{"fastestFee":20,"halfHourFee":15,"hourFee":10,"economyFee":4,"minimumFee":2}`
+
+func TestMempoolSpaceFeeRateStrategy_FeeRate(t *testing.T) {
+	cases := []struct {
+		target   uint64
+		expected float64
+	}{
+		{target: 1, expected: 0.0002},
+		{target: 2, expected: 0.00015},
+		{target: 3, expected: 0.00015},
+		{target: 6, expected: 0.0001},
+		{target: 144, expected: 0.00004},
+	}
+	for _, c := range cases {
+		client := &mocks.HttpClientMock{}
+		client.EXPECT().Do(mock.MatchedBy(func(r *http.Request) bool {
+			return assert.Equal(t, testnetUrl+"/v1/fees/recommended", r.URL.String())
+		})).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(recommendedFeesResponse)),
+		}, nil).Once()
+		strategy := mempool_space.NewMempoolSpaceFeeRateStrategy(client, testnetUrl+"/", c.target)
+		feeRate, err := strategy.FeeRate()
+		require.NoError(t, err)
+		assert.InDelta(t, c.expected, feeRate, 0)
+		client.AssertExpectations(t)
+	}
+}
+
+func TestMempoolSpaceFeeRateStrategy_FeeRate_ErrorHandling(t *testing.T) {
+	t.Run("should handle error in http request", func(t *testing.T) {
+		client := &mocks.HttpClientMock{}
+		client.EXPECT().Do(mock.Anything).Return(nil, assert.AnError).Once()
+		_, err := mempool_space.NewMempoolSpaceFeeRateStrategy(client, testnetUrl, 1).FeeRate()
+		require.ErrorIs(t, err, assert.AnError)
+	})
+	t.Run("should handle error response", func(t *testing.T) {
+		client := &mocks.HttpClientMock{}
+		client.EXPECT().Do(mock.Anything).Return(&http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Body:       io.NopCloser(bytes.NewBufferString("internal error")),
+		}, nil).Once()
+		_, err := mempool_space.NewMempoolSpaceFeeRateStrategy(client, testnetUrl, 1).FeeRate()
+		require.ErrorContains(t, err, "server error response (500)")
+	})
+	t.Run("should handle invalid response", func(t *testing.T) {
+		client := &mocks.HttpClientMock{}
+		client.EXPECT().Do(mock.Anything).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString("not json")),
+		}, nil).Once()
+		_, err := mempool_space.NewMempoolSpaceFeeRateStrategy(client, testnetUrl, 1).FeeRate()
+		require.ErrorContains(t, err, "error getting recommended fees")
+	})
+	t.Run("should reject a zero fee rate", func(t *testing.T) {
+		client := &mocks.HttpClientMock{}
+		client.EXPECT().Do(mock.Anything).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"fastestFee":0}`)),
+		}, nil).Once()
+		_, err := mempool_space.NewMempoolSpaceFeeRateStrategy(client, testnetUrl, 1).FeeRate()
+		require.ErrorContains(t, err, "invalid fee rate")
+	})
+}
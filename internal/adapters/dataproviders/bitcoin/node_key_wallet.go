@@ -0,0 +1,104 @@
+package bitcoin
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+)
+
+const NodeKeyWalletId = "lps-node-key-wallet"
+
+// NodeKeyWallet is a BitcoinWallet whose key is held by the node wallet, so the LPS never has access to it. It is
+// used with the external signers, since they can only sign Ethereum transactions and messages and not the
+// signature hashes of the BTC transactions. The payments are made from a single address of the node wallet and the
+// change goes back to that address
+type NodeKeyWallet struct {
+	conn    *Connection
+	address btcutil.Address
+}
+
+func NewNodeKeyWallet(conn *Connection, address string) (blockchain.BitcoinWallet, error) {
+	if conn.WalletId != NodeKeyWalletId {
+		return nil, errors.New("node key wallet can only be created with wallet id " + NodeKeyWalletId)
+	}
+	decodedAddress, err := btcutil.DecodeAddress(address, conn.NetworkParams)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node wallet address: %w", err)
+	}
+	if err = EnsureLoadedBtcWallet(conn); err != nil {
+		return nil, err
+	}
+	addressInfo, err := conn.client.GetAddressInfo(decodedAddress.EncodeAddress())
+	if err != nil {
+		return nil, fmt.Errorf("error while verifying wallet has address: %w", err)
+	} else if !addressInfo.IsMine || addressInfo.IsWatchOnly {
+		return nil, fmt.Errorf("the key of %s is not held by the node wallet %s", address, conn.WalletId)
+	}
+	return &NodeKeyWallet{conn: conn, address: decodedAddress}, nil
+}
+
+func (wallet *NodeKeyWallet) EstimateTxFees(toAddress string, value *entities.Wei) (blockchain.BtcFeeEstimation, error) {
+	return estimateSingleKeyTxFees(wallet.conn, wallet.address, toAddress, value)
+}
+
+func (wallet *NodeKeyWallet) GetBalance() (*entities.Wei, error) {
+	return getSingleKeyBalance(wallet.conn, wallet.address)
+}
+
+func (wallet *NodeKeyWallet) SendWithOpReturn(address string, value *entities.Wei, opReturnContent []byte) (blockchain.BitcoinTransactionResult, error) {
+	return sendSingleKeyTransaction(wallet.conn, wallet.address, address, value, opReturnContent, wallet.signFundedTransaction)
+}
+
+func (wallet *NodeKeyWallet) BumpFee(txHash string, maxFee *entities.Wei) (blockchain.BitcoinTransactionResult, error) {
+	return bumpSingleKeyTransactionFee(wallet.conn, wallet.address, txHash, maxFee, wallet.signFundedTransaction)
+}
+
+func (wallet *NodeKeyWallet) CreateUnfundedTransactionWithOpReturn(address string, value *entities.Wei, opReturnContent []byte) ([]byte, error) {
+	return createUnfundedTransactionWithOpReturn(wallet.conn, address, value, opReturnContent)
+}
+
+func (wallet *NodeKeyWallet) SendBatchWithOpReturn(payments []blockchain.BitcoinPayment) (blockchain.BitcoinTransactionResult, error) {
+	return sendSingleKeyBatchTransaction(wallet.conn, wallet.address, payments, wallet.signFundedTransaction)
+}
+
+func (wallet *NodeKeyWallet) CreateUnfundedBatchTransactionWithOpReturn(payments []blockchain.BitcoinPayment) ([]byte, error) {
+	return createUnfundedBatchTransactionWithOpReturn(wallet.conn, payments)
+}
+
+func (wallet *NodeKeyWallet) ImportAddress(address string) error {
+	return errors.New("address importing is not supported in this type of wallet")
+}
+
+func (wallet *NodeKeyWallet) GetTransactions(address string) ([]blockchain.BitcoinTransactionInformation, error) {
+	if err := EnsureLoadedBtcWallet(wallet.conn); err != nil {
+		return nil, err
+	}
+	return getTransactionsToAddress(address, wallet.conn.NetworkParams, wallet.conn.client)
+}
+
+func (wallet *NodeKeyWallet) Address() string {
+	return wallet.address.EncodeAddress()
+}
+
+func (wallet *NodeKeyWallet) Unlock() error {
+	return errors.New("node key wallet does not support unlocking as its key is managed by the node")
+}
+
+func (wallet *NodeKeyWallet) Shutdown(closeChannel chan<- bool) {
+	wallet.conn.Shutdown(closeChannel)
+}
+
+func (wallet *NodeKeyWallet) signFundedTransaction(fundedTx *btcjson.FundRawTransactionResult) (*wire.MsgTx, error) {
+	signedTx, complete, err := wallet.conn.client.SignRawTransactionWithWallet(fundedTx.Transaction)
+	if err != nil {
+		return nil, err
+	} else if !complete {
+		return nil, errors.New("node wallet couldn't sign all the inputs of the transaction")
+	}
+	return signedTx, nil
+}
@@ -0,0 +1,107 @@
+package bitcoin_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/bitcoin"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func nodeKeyAddressInfo(isMine, isWatchOnly bool) *btcjson.GetAddressInfoResult {
+	addressInfo := new(btcjson.GetAddressInfoResult)
+	addressInfo.IsMine = isMine
+	addressInfo.IsWatchOnly = isWatchOnly
+	addressInfo.Solvable = true
+	return addressInfo
+}
+
+func newNodeKeyWallet(t *testing.T, client *mocks.ClientAdapterMock) blockchain.BitcoinWallet {
+	client.On("GetWalletInfo").Return(&btcjson.GetWalletInfoResult{WalletName: bitcoin.NodeKeyWalletId}, nil).Once()
+	client.On("GetAddressInfo", testnetAddress).Return(nodeKeyAddressInfo(true, false), nil).Once()
+	wallet, err := bitcoin.NewNodeKeyWallet(bitcoin.NewWalletConnection(&chaincfg.TestNet3Params, client, bitcoin.NodeKeyWalletId), testnetAddress)
+	require.NoError(t, err)
+	return wallet
+}
+
+func TestNewNodeKeyWallet(t *testing.T) {
+	t.Run("should create the wallet with an address of the node wallet", func(t *testing.T) {
+		client := &mocks.ClientAdapterMock{}
+		wallet := newNodeKeyWallet(t, client)
+		assert.Equal(t, testnetAddress, wallet.Address())
+		client.AssertExpectations(t)
+	})
+	t.Run("should validate the wallet id", func(t *testing.T) {
+		wallet, err := bitcoin.NewNodeKeyWallet(bitcoin.NewWalletConnection(&chaincfg.TestNet3Params, &mocks.ClientAdapterMock{}, bitcoin.DerivativeWalletId), testnetAddress)
+		require.ErrorContains(t, err, "node key wallet can only be created with wallet id")
+		assert.Nil(t, wallet)
+	})
+	t.Run("should validate the address", func(t *testing.T) {
+		wallet, err := bitcoin.NewNodeKeyWallet(bitcoin.NewWalletConnection(&chaincfg.TestNet3Params, &mocks.ClientAdapterMock{}, bitcoin.NodeKeyWalletId), mainnetAddress)
+		require.ErrorContains(t, err, "invalid node wallet address")
+		assert.Nil(t, wallet)
+	})
+	t.Run("should reject addresses whose key is not in the node wallet", func(t *testing.T) {
+		for _, addressInfo := range []*btcjson.GetAddressInfoResult{nodeKeyAddressInfo(false, false), nodeKeyAddressInfo(true, true)} {
+			client := &mocks.ClientAdapterMock{}
+			client.On("GetWalletInfo").Return(&btcjson.GetWalletInfoResult{WalletName: bitcoin.NodeKeyWalletId}, nil).Once()
+			client.On("GetAddressInfo", testnetAddress).Return(addressInfo, nil).Once()
+			wallet, err := bitcoin.NewNodeKeyWallet(bitcoin.NewWalletConnection(&chaincfg.TestNet3Params, client, bitcoin.NodeKeyWalletId), testnetAddress)
+			require.ErrorContains(t, err, "is not held by the node wallet")
+			assert.Nil(t, wallet)
+		}
+	})
+}
+
+func TestNodeKeyWallet_SendWithOpReturn(t *testing.T) {
+	value := entities.NewWei(600000000000000000)
+	fundedTx := wire.NewMsgTx(wire.TxVersion)
+	signedTx := wire.NewMsgTx(wire.TxVersion)
+	signedTx.LockTime = 1
+	setupClient := func(client *mocks.ClientAdapterMock) {
+		client.On("GetWalletInfo").Return(&btcjson.GetWalletInfoResult{WalletName: bitcoin.NodeKeyWalletId}, nil).Once()
+		client.On("CreateRawTransaction", ([]btcjson.TransactionInput)(nil), mock.Anything, (*int64)(nil)).Return(wire.NewMsgTx(wire.TxVersion), nil).Once()
+		client.On("EstimateSmartFee", int64(1), &btcjson.EstimateModeEconomical).Return(&btcjson.EstimateSmartFeeResult{FeeRate: btcjson.Float64(0.0001), Blocks: 1}, nil).Once()
+		client.On("FundRawTransaction", mock.Anything, mock.MatchedBy(func(opts btcjson.FundRawTransactionOpts) bool {
+			return *opts.ChangeAddress == testnetAddress
+		}), (*bool)(nil)).Return(&btcjson.FundRawTransactionResult{Transaction: fundedTx, Fee: 500}, nil).Once()
+	}
+	t.Run("should sign the transaction with the node wallet", func(t *testing.T) {
+		client := &mocks.ClientAdapterMock{}
+		wallet := newNodeKeyWallet(t, client)
+		setupClient(client)
+		client.On("SignRawTransactionWithWallet", fundedTx).Return(signedTx, true, nil).Once()
+		client.On("SendRawTransaction", signedTx, false).Return(chainhash.NewHashFromStr(testnetTestTxHash)).Once()
+		result, err := wallet.SendWithOpReturn(testnetAddress, value, []byte{0x01})
+		require.NoError(t, err)
+		assert.Equal(t, testnetTestTxHash, result.Hash)
+		client.AssertExpectations(t)
+	})
+	t.Run("should not send incomplete transactions", func(t *testing.T) {
+		client := &mocks.ClientAdapterMock{}
+		wallet := newNodeKeyWallet(t, client)
+		setupClient(client)
+		client.On("SignRawTransactionWithWallet", fundedTx).Return(signedTx, false, nil).Once()
+		result, err := wallet.SendWithOpReturn(testnetAddress, value, []byte{0x01})
+		require.ErrorContains(t, err, "couldn't sign all the inputs")
+		assert.Empty(t, result)
+		client.AssertNotCalled(t, "SendRawTransaction", mock.Anything, mock.Anything)
+	})
+	t.Run("should handle signing error", func(t *testing.T) {
+		client := &mocks.ClientAdapterMock{}
+		wallet := newNodeKeyWallet(t, client)
+		setupClient(client)
+		client.On("SignRawTransactionWithWallet", fundedTx).Return(nil, false, assert.AnError).Once()
+		result, err := wallet.SendWithOpReturn(testnetAddress, value, []byte{0x01})
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Empty(t, result)
+	})
+}
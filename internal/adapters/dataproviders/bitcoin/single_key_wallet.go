@@ -0,0 +1,510 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/bitcoin/btcclient"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// The functions of this file are shared by the wallets that control a single address whose private key is not
+// in the node. The node only tracks the public key (watch-only) to fund the transactions, and the signature is
+// done by each wallet implementation
+
+type fundedTransactionSigner func(fundedTx *btcjson.FundRawTransactionResult) (*wire.MsgTx, error)
+
+func initSingleKeyWallet(conn *Connection, address btcutil.Address, pubKey string) error {
+	const addressVerificationErrorTemplate = "error while verifying wallet has address: %w"
+	var err error
+	var info *btcjson.GetWalletInfoResult
+	var addressInfo *btcjson.GetAddressInfoResult
+
+	if info, err = conn.client.GetWalletInfo(); err != nil || info.WalletName != conn.WalletId {
+		if info, err = createSingleKeyWallet(conn); err != nil {
+			return err
+		}
+	}
+	_, ok := info.Scanning.Value.(btcjson.ScanProgress)
+	if ok {
+		return errors.New("wallet is still scanning, please wait for the scan to finish before initializing the server again")
+	}
+
+	if addressInfo, err = conn.client.GetAddressInfo(address.EncodeAddress()); err != nil {
+		return fmt.Errorf(addressVerificationErrorTemplate, err)
+	} else if !addressInfo.Solvable || !addressInfo.IsWatchOnly {
+		return importSingleKeyPublicKey(conn, address, pubKey)
+	}
+
+	return nil
+}
+
+func createSingleKeyWallet(conn *Connection) (*btcjson.GetWalletInfoResult, error) {
+	if _, err := conn.client.LoadWallet(conn.WalletId); err == nil {
+		return conn.client.GetWalletInfo()
+	}
+	log.Infof("Wallet not found to be loaded, creating wallet %s...", conn.WalletId)
+	err := conn.client.CreateReadonlyWallet(btcclient.ReadonlyWalletRequest{
+		WalletName:         conn.WalletId,
+		DisablePrivateKeys: true,
+		Blank:              true,
+		AvoidReuse:         false,
+		Descriptors:        false,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error while creating %s wallet: %w", conn.WalletId, err)
+	}
+	return conn.client.GetWalletInfo()
+}
+
+func importSingleKeyPublicKey(conn *Connection, address btcutil.Address, pubKey string) error {
+	const errorTemplate = "error while importing public key: %w"
+	err := conn.client.ImportPubKey(pubKey)
+	if err != nil {
+		return fmt.Errorf(errorTemplate, err)
+	}
+	err = conn.client.ImportAddressRescan(address.EncodeAddress(), "", true)
+	if err != nil {
+		return fmt.Errorf(errorTemplate, err)
+	}
+	return errors.New("public key imported, rescan started, please wait for the rescan process to finish before initializing the server again")
+}
+
+func estimateSingleKeyTxFees(conn *Connection, changeAddress btcutil.Address, toAddress string, value *entities.Wei) (blockchain.BtcFeeEstimation, error) {
+	const quoteHashLength = 32
+
+	if _, err := btcutil.DecodeAddress(toAddress, conn.NetworkParams); err != nil {
+		return blockchain.BtcFeeEstimation{}, err
+	}
+	if err := EnsureLoadedBtcWallet(conn); err != nil {
+		return blockchain.BtcFeeEstimation{}, err
+	}
+
+	amountInSatoshi, _ := value.ToSatoshi().Int64()
+	output := []btcjson.PsbtOutput{
+		{toAddress: btcutil.Amount(amountInSatoshi).ToUnit(btcutil.AmountBTC)},
+		{"data": hex.EncodeToString(make([]byte, quoteHashLength))}, // quote hash output
+	}
+
+	feeRate, err := estimateFeeRate(conn)
+	if err != nil {
+		return blockchain.BtcFeeEstimation{}, err
+	}
+
+	opts := btcjson.WalletCreateFundedPsbtOpts{
+		ChangeAddress:   btcjson.String(changeAddress.EncodeAddress()),
+		ChangePosition:  btcjson.Int64(changePosition),
+		IncludeWatching: btcjson.Bool(true),
+		FeeRate:         feeRate,
+	}
+
+	simulatedTx, err := conn.client.WalletCreateFundedPsbt(nil, output, nil, &opts, nil)
+	if err != nil {
+		return blockchain.BtcFeeEstimation{}, err
+	}
+	btcFee, err := btcutil.NewAmount(simulatedTx.Fee)
+	if err != nil {
+		return blockchain.BtcFeeEstimation{}, err
+	}
+	satoshiFee := btcFee.ToUnit(btcutil.AmountSatoshi)
+	return blockchain.BtcFeeEstimation{
+		Value:   conn.feePolicy.applyQuoteMargin(entities.SatoshiToWei(uint64(satoshiFee))),
+		FeeRate: utils.NewBigFloat64(*feeRate),
+	}, nil
+}
+
+func getSingleKeyBalance(conn *Connection, address btcutil.Address) (*entities.Wei, error) {
+	var amount btcutil.Amount
+	balance := new(entities.Wei)
+
+	if err := EnsureLoadedBtcWallet(conn); err != nil {
+		return nil, err
+	}
+
+	utxos, err := conn.client.ListUnspentMinMaxAddresses(
+		MinConfirmationsForUtxos,
+		MaxConfirmationsForUtxos,
+		[]btcutil.Address{address},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, utxo := range utxos {
+		if amount, err = btcutil.NewAmount(utxo.Amount); err != nil {
+			return nil, err
+		}
+		if utxo.Confirmations > 0 {
+			balance.Add(balance, entities.SatoshiToWei(uint64(amount.ToUnit(btcutil.AmountSatoshi))))
+		}
+	}
+	return balance, nil
+}
+
+func sendSingleKeyTransaction(
+	conn *Connection,
+	changeAddress btcutil.Address,
+	address string,
+	value *entities.Wei,
+	opReturnContent []byte,
+	sign fundedTransactionSigner,
+) (blockchain.BitcoinTransactionResult, error) {
+	if err := EnsureLoadedBtcWallet(conn); err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+
+	rawTx, err := buildRawTransactionWithOpReturn(conn, address, value, opReturnContent)
+	if err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+	return fundAndSendSingleKeyTransaction(conn, changeAddress, rawTx, changePosition, sign, fmt.Sprintf("Sending %v BTC to %s", value.ToRbtc(), address))
+}
+
+func sendSingleKeyBatchTransaction(
+	conn *Connection,
+	changeAddress btcutil.Address,
+	payments []blockchain.BitcoinPayment,
+	sign fundedTransactionSigner,
+) (blockchain.BitcoinTransactionResult, error) {
+	if err := EnsureLoadedBtcWallet(conn); err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+
+	rawTx, err := buildBatchRawTransactionWithOpReturn(conn, payments)
+	if err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+	// each payment has two outputs, so the change goes after all of them
+	return fundAndSendSingleKeyTransaction(conn, changeAddress, rawTx, len(rawTx.TxOut), sign, fmt.Sprintf("Sending %d payments in a single transaction", len(payments)))
+}
+
+func fundAndSendSingleKeyTransaction(
+	conn *Connection,
+	changeAddress btcutil.Address,
+	rawTx *wire.MsgTx,
+	changeOutputPosition int,
+	sign fundedTransactionSigner,
+	logMessage string,
+) (blockchain.BitcoinTransactionResult, error) {
+	opts, err := buildFundRawTransactionOpts(conn, changeAddress, changeOutputPosition)
+	if err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+	fundedTx, err := conn.client.FundRawTransaction(rawTx, opts, nil)
+	if err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+
+	signedTx, err := sign(fundedTx)
+	if err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+
+	log.Infoln(logMessage)
+	txHash, err := conn.client.SendRawTransaction(signedTx, false)
+	if err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+
+	// Convert fee from satoshis to wei
+	feeSatoshis := uint64(fundedTx.Fee)
+	feeWei := entities.SatoshiToWei(feeSatoshis)
+
+	return blockchain.BitcoinTransactionResult{
+		Hash: txHash.String(),
+		Fee:  feeWei,
+	}, nil
+}
+
+// bumpSingleKeyTransactionFee replaces an unconfirmed transaction of the wallet (RBF). The replacement spends the
+// same inputs and pays the same outputs, the extra fee is taken from the change output. The new fee is the one of
+// the current fee rate, but never less than the minimum increment required by the nodes to accept a replacement.
+// The transaction is not replaced if it already pays the current fee rate
+func bumpSingleKeyTransactionFee(
+	conn *Connection,
+	changeAddress btcutil.Address,
+	txHash string,
+	maxFee *entities.Wei,
+	sign fundedTransactionSigner,
+) (blockchain.BitcoinTransactionResult, error) {
+	const (
+		// incrementalRelayFee is the default minimum fee rate increment in sat/vB for a replacement to be relayed
+		incrementalRelayFee                  = 1
+		satoshiPerBtcPerKvbToSatoshiPerVbyte = 1e5
+	)
+	if err := EnsureLoadedBtcWallet(conn); err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+	originalTx, err := getUnconfirmedWalletTransaction(conn, txHash)
+	if err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+	originalFee, err := getWalletTransactionFee(conn, originalTx)
+	if err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+	// the encoded address is used since the change of the funded transactions is sent to the P2PKH address
+	// even if the wallet address is a public key
+	encodedChangeAddress, err := btcutil.DecodeAddress(changeAddress.EncodeAddress(), conn.NetworkParams)
+	if err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+	changeScript, err := txscript.PayToAddrScript(encodedChangeAddress)
+	if err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+	// the change is the last output since the transactions might have more than one payment
+	changeIndex := len(originalTx.TxOut) - 1
+	if changeIndex <= 0 || !bytes.Equal(originalTx.TxOut[changeIndex].PkScript, changeScript) {
+		return blockchain.BitcoinTransactionResult{}, fmt.Errorf("transaction %s doesn't have a change output to bump its fee", txHash)
+	}
+
+	feeRate, err := estimateFeeRate(conn)
+	if err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+	// one extra byte per input since the size of the new signatures might be different
+	virtualSize := mempool.GetTxVirtualSize(btcutil.NewTx(originalTx)) + int64(len(originalTx.TxIn))
+	currentFee := int64(math.Ceil(float64(virtualSize) * *feeRate * satoshiPerBtcPerKvbToSatoshiPerVbyte))
+	if currentFee <= originalFee {
+		return blockchain.BitcoinTransactionResult{}, fmt.Errorf("%w: %s", blockchain.BtcFeeAlreadyCurrentError, txHash)
+	}
+	newFee := max(currentFee, originalFee+virtualSize*incrementalRelayFee)
+	if maxFee != nil && entities.SatoshiToWei(uint64(newFee)).Cmp(maxFee) > 0 {
+		return blockchain.BitcoinTransactionResult{}, fmt.Errorf("fee of the replacement of %s (%d sat) exceeds the maximum fee", txHash, newFee)
+	}
+
+	replacement := originalTx.Copy()
+	for _, input := range replacement.TxIn {
+		input.SignatureScript = nil
+		input.Witness = nil
+	}
+	replacement.TxOut[changeIndex].Value -= newFee - originalFee
+	if replacement.TxOut[changeIndex].Value < 0 || mempool.IsDust(replacement.TxOut[changeIndex], mempool.DefaultMinRelayTxFee) {
+		return blockchain.BitcoinTransactionResult{}, fmt.Errorf("change of transaction %s is not enough to bump its fee", txHash)
+	}
+
+	signedTx, err := sign(&btcjson.FundRawTransactionResult{
+		Transaction:    replacement,
+		Fee:            btcutil.Amount(newFee),
+		ChangePosition: changeIndex,
+	})
+	if err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+	log.Infof("Replacing transaction %s, fee increased from %d to %d satoshis", txHash, originalFee, newFee)
+	newHash, err := conn.client.SendRawTransaction(signedTx, false)
+	if err != nil {
+		return blockchain.BitcoinTransactionResult{}, err
+	}
+	return blockchain.BitcoinTransactionResult{
+		Hash: newHash.String(),
+		Fee:  entities.SatoshiToWei(uint64(newFee)),
+	}, nil
+}
+
+func getUnconfirmedWalletTransaction(conn *Connection, txHash string) (*wire.MsgTx, error) {
+	hash, err := chainhash.NewHashFromStr(txHash)
+	if err != nil {
+		return nil, err
+	}
+	walletTx, err := conn.client.GetTransaction(hash)
+	if err != nil {
+		return nil, err
+	} else if walletTx.Confirmations != 0 {
+		return nil, fmt.Errorf("transaction %s is not in the mempool", txHash)
+	}
+	return decodeWalletTransaction(walletTx)
+}
+
+// getWalletTransactionFee calculates the fee of a transaction from the value of the outputs it spends, all of them
+// are expected to belong to the wallet
+func getWalletTransactionFee(conn *Connection, tx *wire.MsgTx) (int64, error) {
+	var inputsValue, outputsValue int64
+	for _, input := range tx.TxIn {
+		walletTx, err := conn.client.GetTransaction(&input.PreviousOutPoint.Hash)
+		if err != nil {
+			return 0, err
+		}
+		previousTx, err := decodeWalletTransaction(walletTx)
+		if err != nil {
+			return 0, err
+		} else if int(input.PreviousOutPoint.Index) >= len(previousTx.TxOut) {
+			return 0, fmt.Errorf("output %s not found", input.PreviousOutPoint.String())
+		}
+		inputsValue += previousTx.TxOut[input.PreviousOutPoint.Index].Value
+	}
+	for _, output := range tx.TxOut {
+		outputsValue += output.Value
+	}
+	return inputsValue - outputsValue, nil
+}
+
+func decodeWalletTransaction(walletTx *btcjson.GetTransactionResult) (*wire.MsgTx, error) {
+	rawTx, err := hex.DecodeString(walletTx.Hex)
+	if err != nil {
+		return nil, err
+	}
+	tx := wire.NewMsgTx(wire.TxVersion)
+	if err = tx.Deserialize(bytes.NewReader(rawTx)); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func createUnfundedTransactionWithOpReturn(conn *Connection, address string, value *entities.Wei, opReturnContent []byte) ([]byte, error) {
+	rawTx, err := buildRawTransactionWithOpReturn(conn, address, value, opReturnContent)
+	if err != nil {
+		return nil, err
+	}
+	return serializeTransaction(rawTx)
+}
+
+func createUnfundedBatchTransactionWithOpReturn(conn *Connection, payments []blockchain.BitcoinPayment) ([]byte, error) {
+	rawTx, err := buildBatchRawTransactionWithOpReturn(conn, payments)
+	if err != nil {
+		return nil, err
+	}
+	return serializeTransaction(rawTx)
+}
+
+func serializeTransaction(tx *wire.MsgTx) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func estimateFeeRate(conn *Connection) (*float64, error) {
+	strategy := conn.feePolicy.FeeRate
+	if strategy == nil {
+		strategy = NewNodeFeeRateStrategy(conn, DefaultFeeRateTarget)
+	}
+	feeRate, err := strategy.FeeRate()
+	if err != nil {
+		return nil, err
+	}
+	return btcjson.Float64(feeRate), nil
+}
+
+func buildFundRawTransactionOpts(conn *Connection, changeAddress btcutil.Address, changeOutputPosition int) (btcjson.FundRawTransactionOpts, error) {
+	feeRate, err := estimateFeeRate(conn)
+	if err != nil {
+		return btcjson.FundRawTransactionOpts{}, err
+	}
+	return btcjson.FundRawTransactionOpts{
+		ChangeAddress:   btcjson.String(changeAddress.EncodeAddress()),
+		ChangePosition:  btcjson.Int(changeOutputPosition),
+		IncludeWatching: btcjson.Bool(true),
+		LockUnspents:    btcjson.Bool(true),
+		FeeRate:         feeRate,
+		Replaceable:     btcjson.Bool(true),
+	}, nil
+}
+
+func buildRawTransactionWithOpReturn(conn *Connection, address string, value *entities.Wei, opReturnContent []byte) (*wire.MsgTx, error) {
+	decodedAddress, err := btcutil.DecodeAddress(address, conn.NetworkParams)
+	if err != nil {
+		return nil, err
+	}
+
+	satoshis, _ := value.ToSatoshi().Float64()
+	output := map[btcutil.Address]btcutil.Amount{decodedAddress: btcutil.Amount(satoshis)}
+	rawTx, err := conn.client.CreateRawTransaction(nil, output, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	opReturnScript, err := txscript.NullDataScript(opReturnContent)
+	if err != nil {
+		return nil, err
+	}
+	rawTx.AddTxOut(wire.NewTxOut(0, opReturnScript))
+
+	return rawTx, nil
+}
+
+// buildBatchRawTransactionWithOpReturn builds a transaction with a payment output followed by its OP_RETURN output
+// for each one of the payments. The first two outputs are the same as in buildRawTransactionWithOpReturn
+func buildBatchRawTransactionWithOpReturn(conn *Connection, payments []blockchain.BitcoinPayment) (*wire.MsgTx, error) {
+	if len(payments) == 0 {
+		return nil, errors.New("batch transaction without payments")
+	}
+	rawTx, err := buildRawTransactionWithOpReturn(conn, payments[0].Address, payments[0].Value, payments[0].OpReturnContent)
+	if err != nil {
+		return nil, err
+	}
+	for _, payment := range payments[1:] {
+		decodedAddress, err := btcutil.DecodeAddress(payment.Address, conn.NetworkParams)
+		if err != nil {
+			return nil, err
+		}
+		paymentScript, err := txscript.PayToAddrScript(decodedAddress)
+		if err != nil {
+			return nil, err
+		}
+		opReturnScript, err := txscript.NullDataScript(payment.OpReturnContent)
+		if err != nil {
+			return nil, err
+		}
+		satoshis, _ := payment.Value.ToSatoshi().Float64()
+		rawTx.AddTxOut(wire.NewTxOut(int64(satoshis), paymentScript))
+		rawTx.AddTxOut(wire.NewTxOut(0, opReturnScript))
+	}
+	return rawTx, nil
+}
+
+// singleKeySignatureHashes returns the legacy signature hash of every input of the transaction. All the inputs
+// are expected to spend P2PKH outputs of the wallet address, since it's the only one tracked by the node wallet
+func singleKeySignatureHashes(tx *wire.MsgTx, address *btcutil.AddressPubKey) ([][]byte, error) {
+	pkScript, err := txscript.PayToAddrScript(address.AddressPubKeyHash())
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([][]byte, len(tx.TxIn))
+	for i := range tx.TxIn {
+		if hashes[i], err = txscript.CalcSignatureHash(pkScript, txscript.SigHashAll, tx, i); err != nil {
+			return nil, err
+		}
+	}
+	return hashes, nil
+}
+
+// buildSingleKeySignatureScript builds the P2PKH signature script for a signature in the [R || S] format made by
+// an external signer. The signature is verified before being used, so a signature made with an unexpected key
+// is rejected before the transaction is broadcast
+func buildSingleKeySignatureScript(pubKey *btcec.PublicKey, signature []byte, hash []byte) ([]byte, error) {
+	const signatureLength = 64
+	var r, s btcec.ModNScalar
+	if len(signature) < signatureLength {
+		return nil, fmt.Errorf("invalid signature length: %d", len(signature))
+	}
+	if r.SetByteSlice(signature[:32]) || s.SetByteSlice(signature[32:signatureLength]) {
+		return nil, errors.New("signature overflows the curve order")
+	}
+	ecdsaSignature := ecdsa.NewSignature(&r, &s)
+	if !ecdsaSignature.Verify(hash, pubKey) {
+		return nil, errors.New("signature doesn't match the wallet key")
+	}
+	// Serialize returns the canonical (low S) DER encoding of the signature
+	return txscript.NewScriptBuilder().
+		AddData(append(ecdsaSignature.Serialize(), byte(txscript.SigHashAll))).
+		AddData(pubKey.SerializeCompressed()).
+		Script()
+}
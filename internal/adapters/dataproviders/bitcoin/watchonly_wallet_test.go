@@ -307,6 +307,31 @@ func TestWatchOnlyWallet_SendWithOpReturn(t *testing.T) {
 	require.Nil(t, result.Fee)
 }
 
+func TestWatchOnlyWallet_BumpFee(t *testing.T) {
+	client := &mocks.ClientAdapterMock{}
+	client.On("GetWalletInfo").Return(&btcjson.GetWalletInfoResult{PrivateKeysEnabled: false}, nil).Once()
+	wallet, err := bitcoin.NewWatchOnlyWallet(bitcoin.NewWalletConnection(&chaincfg.TestNet3Params, client, bitcoin.PeginWalletId))
+	require.NoError(t, err)
+	result, err := wallet.BumpFee(testnetTestTxHash, nil)
+	require.ErrorContains(t, err, "cannot bump fees from a watch-only wallet")
+	require.Empty(t, result.Hash)
+	require.Nil(t, result.Fee)
+}
+
+func TestWatchOnlyWallet_SendBatchWithOpReturn(t *testing.T) {
+	client := &mocks.ClientAdapterMock{}
+	client.On("GetWalletInfo").Return(&btcjson.GetWalletInfoResult{PrivateKeysEnabled: false}, nil).Once()
+	wallet, err := bitcoin.NewWatchOnlyWallet(bitcoin.NewWalletConnection(&chaincfg.TestNet3Params, client, bitcoin.PeginWalletId))
+	require.NoError(t, err)
+	result, err := wallet.SendBatchWithOpReturn(nil)
+	require.ErrorContains(t, err, "cannot send from a watch-only wallet")
+	require.Empty(t, result.Hash)
+	require.Nil(t, result.Fee)
+	rawTx, err := wallet.CreateUnfundedBatchTransactionWithOpReturn(nil)
+	require.ErrorContains(t, err, "cannot create transactions from a watch-only wallet")
+	require.Nil(t, rawTx)
+}
+
 func TestWatchOnlyWallet_CreateUnfundedTransactionWithOpReturn(t *testing.T) {
 	client := &mocks.ClientAdapterMock{}
 	client.On("GetWalletInfo").Return(&btcjson.GetWalletInfoResult{PrivateKeysEnabled: false}, nil).Once()
@@ -0,0 +1,75 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	AlertHistoryCollection = "alertHistory"
+)
+
+type alertMongoRepository struct {
+	conn *Connection
+}
+
+func NewAlertRepository(conn *Connection) alerts.AlertRepository {
+	return &alertMongoRepository{conn: conn}
+}
+
+func (repo *alertMongoRepository) InsertAlert(ctx context.Context, record alerts.AlertRecord) error {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(AlertHistoryCollection)
+	_, err := collection.InsertOne(dbCtx, record)
+	if err != nil {
+		return err
+	}
+	logDbInteraction(Insert, record)
+	return nil
+}
+
+func (repo *alertMongoRepository) GetLastDeliveredAlert(ctx context.Context, dedupKey string) (*alerts.AlertRecord, error) {
+	var result alerts.AlertRecord
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+
+	collection := repo.conn.Collection(AlertHistoryCollection)
+	filter := bson.D{
+		primitive.E{Key: "dedup_key", Value: dedupKey},
+		primitive.E{Key: "delivered", Value: true},
+	}
+	findOpts := options.FindOne().SetSort(bson.D{{Key: "timestamp", Value: SortDescending}})
+	err := collection.FindOne(dbCtx, filter, findOpts).Decode(&result)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	logDbInteraction(Read, result)
+	return &result, nil
+}
+
+func (repo *alertMongoRepository) GetRecentAlerts(ctx context.Context, limit int64) ([]alerts.AlertRecord, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+
+	collection := repo.conn.Collection(AlertHistoryCollection)
+	findOpts := options.Find().SetSort(bson.D{{Key: "timestamp", Value: SortDescending}}).SetLimit(limit)
+	cursor, err := collection.Find(dbCtx, bson.D{}, findOpts)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]alerts.AlertRecord, 0)
+	if err = cursor.All(dbCtx, &result); err != nil {
+		return nil, err
+	}
+	logDbInteraction(Read, len(result))
+	return result, nil
+}
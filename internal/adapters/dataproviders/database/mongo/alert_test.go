@@ -0,0 +1,123 @@
+package mongo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/database/mongo"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongoDb "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var testAlertRecord = alerts.AlertRecord{
+	Alert: alerts.Alert{
+		Kind:      alerts.AlertKindPeginOutOfLiquidity,
+		Subject:   alerts.AlertSubjectPeginOutOfLiquidity,
+		Severity:  alerts.AlertSeverityCritical,
+		Status:    alerts.AlertStatusFiring,
+		Message:   "You are out of liquidity to perform a PegIn. Please, do a deposit",
+		Amounts:   map[string]*entities.Wei{alerts.AlertAmountRequiredLiquidity: entities.NewWei(1000)},
+		Timestamp: time.Unix(1700000000, 0).UTC(),
+	},
+	DedupKey:   string(alerts.AlertKindPeginOutOfLiquidity),
+	Recipients: []string{"recipient@test.com"},
+	Delivered:  true,
+}
+
+func TestAlertMongoRepository_InsertAlert(t *testing.T) {
+	t.Run("Insert alert successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.AlertHistoryCollection)
+		collection.On("InsertOne", mock.Anything, testAlertRecord).Return(nil, nil).Once()
+		repo := mongo.NewAlertRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Insert)()
+		err := repo.InsertAlert(context.Background(), testAlertRecord)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+	})
+	t.Run("Db error inserting alert", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.AlertHistoryCollection)
+		collection.On("InsertOne", mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		repo := mongo.NewAlertRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.InsertAlert(context.Background(), testAlertRecord)
+		collection.AssertExpectations(t)
+		require.Error(t, err)
+	})
+}
+
+func TestAlertMongoRepository_GetLastDeliveredAlert(t *testing.T) {
+	filter := bson.D{
+		primitive.E{Key: "dedup_key", Value: testAlertRecord.DedupKey},
+		primitive.E{Key: "delivered", Value: true},
+	}
+	sortByNewest := mock.MatchedBy(func(opts *options.FindOneOptions) bool {
+		return assert.Equal(t, bson.D{{Key: "timestamp", Value: mongo.SortDescending}}, opts.Sort)
+	})
+	t.Run("Get last delivered alert successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.AlertHistoryCollection)
+		collection.On("FindOne", mock.Anything, filter, sortByNewest).
+			Return(mongoDb.NewSingleResultFromDocument(testAlertRecord, nil, nil)).Once()
+		repo := mongo.NewAlertRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetLastDeliveredAlert(context.Background(), testAlertRecord.DedupKey)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, testAlertRecord.DedupKey, result.DedupKey)
+		assert.Equal(t, testAlertRecord.Status, result.Status)
+		assert.True(t, testAlertRecord.Timestamp.Equal(result.Timestamp))
+		assert.Equal(t, testAlertRecord.Amounts, result.Amounts)
+	})
+	t.Run("Return nil when there is no alert", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.AlertHistoryCollection)
+		collection.On("FindOne", mock.Anything, filter, sortByNewest).
+			Return(mongoDb.NewSingleResultFromDocument(alerts.AlertRecord{}, mongoDb.ErrNoDocuments, nil)).Once()
+		repo := mongo.NewAlertRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetLastDeliveredAlert(context.Background(), testAlertRecord.DedupKey)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+	t.Run("Db error getting last alert", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.AlertHistoryCollection)
+		collection.On("FindOne", mock.Anything, mock.Anything, mock.Anything).
+			Return(mongoDb.NewSingleResultFromDocument(alerts.AlertRecord{}, assert.AnError, nil)).Once()
+		repo := mongo.NewAlertRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetLastDeliveredAlert(context.Background(), testAlertRecord.DedupKey)
+		collection.AssertExpectations(t)
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestAlertMongoRepository_GetRecentAlerts(t *testing.T) {
+	t.Run("Get recent alerts successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.AlertHistoryCollection)
+		collection.On("Find", mock.Anything, bson.D{}, mock.MatchedBy(func(opts *options.FindOptions) bool {
+			return assert.Equal(t, int64(5), *opts.Limit) &&
+				assert.Equal(t, bson.D{{Key: "timestamp", Value: mongo.SortDescending}}, opts.Sort)
+		})).Return(mongoDb.NewCursorFromDocuments([]any{testAlertRecord, testAlertRecord}, nil, nil)).Once()
+		repo := mongo.NewAlertRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetRecentAlerts(context.Background(), 5)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, testAlertRecord.Subject, result[0].Subject)
+		assert.Equal(t, testAlertRecord.Recipients, result[1].Recipients)
+	})
+	t.Run("Db error getting recent alerts", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.AlertHistoryCollection)
+		collection.On("Find", mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		repo := mongo.NewAlertRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetRecentAlerts(context.Background(), 5)
+		collection.AssertExpectations(t)
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
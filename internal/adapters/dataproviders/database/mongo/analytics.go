@@ -0,0 +1,264 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// analyticsGasTerm is a pair of fields of the retained quote whose product is gas spent by the LP
+type analyticsGasTerm struct {
+	gasUsedField  string
+	gasPriceField string
+}
+
+// analyticsPipelineParams are the parts of the analytics pipeline that are different for each quote direction
+type analyticsPipelineParams struct {
+	retainedCollection string
+	gasTerms           []analyticsGasTerm
+	// btcFeeField is the field of the retained quote with the BTC fee paid by the LP, empty if there is none
+	btcFeeField string
+}
+
+type storedAnalyticsBucket struct {
+	Id struct {
+		Period primitive.DateTime `bson:"period"`
+		Owner  string             `bson:"owner"`
+	} `bson:"_id"`
+	TotalQuotes         int64                `bson:"total_quotes"`
+	AcceptedQuotes      int64                `bson:"accepted_quotes"`
+	AcceptedAmount      primitive.Decimal128 `bson:"accepted_amount"`
+	PaidQuotes          int64                `bson:"paid_quotes"`
+	PaidAmount          primitive.Decimal128 `bson:"paid_amount"`
+	RefundedQuotes      int64                `bson:"refunded_quotes"`
+	RefundedAmount      primitive.Decimal128 `bson:"refunded_amount"`
+	PenalizationsCount  int64                `bson:"penalizations_count"`
+	PenalizationsAmount primitive.Decimal128 `bson:"penalizations_amount"`
+	CallFees            primitive.Decimal128 `bson:"call_fees"`
+	GasCollected        primitive.Decimal128 `bson:"gas_collected"`
+	GasSpent            primitive.Decimal128 `bson:"gas_spent"`
+	BtcFees             primitive.Decimal128 `bson:"btc_fees"`
+}
+
+// getAnalyticsBuckets runs the aggregation pipeline in the quotes collection and converts its result to buckets.
+// The amounts are stored as strings, so they are aggregated as Decimal128 values
+func getAnalyticsBuckets[S ~string](
+	ctx context.Context,
+	conn *Connection,
+	collectionName string,
+	params analyticsPipelineParams,
+	query quote.AnalyticsQuery[S],
+) ([]quote.AnalyticsBucket, error) {
+	if !query.Period.IsValid() {
+		return nil, fmt.Errorf("invalid analytics period: %s", query.Period)
+	}
+	dbCtx, cancel := context.WithTimeout(ctx, conn.timeout)
+	defer cancel()
+
+	collection := conn.Collection(collectionName)
+	pipeline := buildAnalyticsPipeline(params, query.Period, query.StartDate.Unix(), query.EndDate.Unix(), analyticsStates{
+		paid:     toStringSlice(query.PaidStates),
+		refunded: toStringSlice(query.RefundedStates),
+		revenue:  toStringSlice(query.RevenueStates),
+	})
+	cursor, err := collection.Aggregate(dbCtx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(dbCtx)
+
+	result := make([]quote.AnalyticsBucket, 0)
+	for cursor.Next(dbCtx) {
+		var stored storedAnalyticsBucket
+		if err = cursor.Decode(&stored); err != nil {
+			return nil, err
+		}
+		bucket, err := stored.toBucket()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, bucket)
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, err
+	}
+	logDbInteraction(Read, len(result))
+	return result, nil
+}
+
+type analyticsStates struct {
+	paid     []string
+	refunded []string
+	revenue  []string
+}
+
+// buildAnalyticsPipeline builds a pipeline that:
+// 1. Filters by date
+// 2. Joins with retained quotes and penalizations
+// 3. Calculates the period, owner and amounts of each quote
+// 4. Groups the quotes by period and owner
+// 5. Sorts the buckets by period and owner
+func buildAnalyticsPipeline(params analyticsPipelineParams, period quote.AnalyticsPeriod, startDate, endDate int64, states analyticsStates) mongo.Pipeline {
+	zero := decimalZero()
+	gasSpentTerms := bson.A{zero}
+	for _, term := range params.gasTerms {
+		gasSpentTerms = append(gasSpentTerms, bson.M{"$multiply": bson.A{
+			toDecimalExpression("$retained." + term.gasUsedField),
+			toDecimalExpression("$retained." + term.gasPriceField),
+		}})
+	}
+	var btcFee any = zero
+	if params.btcFeeField != "" {
+		btcFee = toDecimalExpression("$retained." + params.btcFeeField)
+	}
+	retainedState := bson.M{"$ifNull": bson.A{"$retained.state", ""}}
+
+	return mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"agreement_timestamp": bson.M{"$gte": startDate, "$lte": endDate},
+		}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         params.retainedCollection,
+			"localField":   "hash",
+			"foreignField": "quote_hash",
+			"as":           "retained",
+		}}},
+		{{Key: "$unwind", Value: bson.M{"path": "$retained", "preserveNullAndEmptyArrays": true}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         PenalizedEventCollection,
+			"localField":   "hash",
+			"foreignField": "quote_hash",
+			"as":           "penalizations",
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"period":              periodStartExpression(period),
+			"owner":               bson.M{"$ifNull": bson.A{"$retained.owner_account_address", ""}},
+			"accepted":            bson.M{"$ne": bson.A{bson.M{"$ifNull": bson.A{"$retained.quote_hash", ""}}, ""}},
+			"paid":                bson.M{"$in": bson.A{retainedState, states.paid}},
+			"refunded":            bson.M{"$in": bson.A{retainedState, states.refunded}},
+			"revenue":             bson.M{"$in": bson.A{retainedState, states.revenue}},
+			"amount":              bson.M{"$add": bson.A{toDecimalExpression("$value"), toDecimalExpression("$gas_fee")}},
+			"call_fee":            toDecimalExpression("$call_fee"),
+			"gas_fee":             toDecimalExpression("$gas_fee"),
+			"gas_used":            bson.M{"$add": gasSpentTerms},
+			"btc_fee":             btcFee,
+			"penalizations_count": bson.M{"$size": "$penalizations"},
+			"penalizations_amount": bson.M{"$reduce": bson.M{
+				"input":        "$penalizations",
+				"initialValue": zero,
+				"in":           bson.M{"$add": bson.A{"$$value", toDecimalExpression("$$this.penalty")}},
+			}},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":                  bson.M{"period": "$period", "owner": "$owner"},
+			"total_quotes":         bson.M{"$sum": 1},
+			"accepted_quotes":      bson.M{"$sum": bson.M{"$cond": bson.A{"$accepted", 1, 0}}},
+			"accepted_amount":      sumIf("$accepted", "$amount"),
+			"paid_quotes":          bson.M{"$sum": bson.M{"$cond": bson.A{"$paid", 1, 0}}},
+			"paid_amount":          sumIf("$paid", "$amount"),
+			"refunded_quotes":      bson.M{"$sum": bson.M{"$cond": bson.A{"$refunded", 1, 0}}},
+			"refunded_amount":      sumIf("$refunded", bson.M{"$add": bson.A{"$amount", "$call_fee"}}),
+			"penalizations_count":  bson.M{"$sum": bson.M{"$cond": bson.A{"$accepted", "$penalizations_count", 0}}},
+			"penalizations_amount": sumIf("$accepted", "$penalizations_amount"),
+			"call_fees":            sumIf("$revenue", "$call_fee"),
+			"gas_collected":        sumIf("$revenue", "$gas_fee"),
+			"gas_spent":            sumIf("$revenue", "$gas_used"),
+			"btc_fees":             sumIf("$revenue", "$btc_fee"),
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id.period", Value: SortAscending}, {Key: "_id.owner", Value: SortAscending}}}},
+	}
+}
+
+// periodStartExpression returns the start of the period of the agreement timestamp of the quote in UTC
+func periodStartExpression(period quote.AnalyticsPeriod) bson.M {
+	const date = "$$agreementDate"
+	var parts bson.M
+	switch period {
+	case quote.AnalyticsPeriodWeek:
+		parts = bson.M{"isoWeekYear": bson.M{"$isoWeekYear": date}, "isoWeek": bson.M{"$isoWeek": date}}
+	case quote.AnalyticsPeriodMonth:
+		parts = bson.M{"year": bson.M{"$year": date}, "month": bson.M{"$month": date}}
+	default:
+		parts = bson.M{"year": bson.M{"$year": date}, "month": bson.M{"$month": date}, "day": bson.M{"$dayOfMonth": date}}
+	}
+	return bson.M{"$let": bson.M{
+		"vars": bson.M{"agreementDate": bson.M{"$toDate": bson.M{"$multiply": bson.A{"$agreement_timestamp", millisecondsPerSecond}}}},
+		"in":   bson.M{"$dateFromParts": parts},
+	}}
+}
+
+// toDecimalExpression converts a field with a Wei value to Decimal128, missing or invalid values are considered zero
+func toDecimalExpression(field string) bson.M {
+	zero := decimalZero()
+	return bson.M{"$convert": bson.M{"input": field, "to": "decimal", "onError": zero, "onNull": zero}}
+}
+
+func sumIf(condition string, value any) bson.M {
+	return bson.M{"$sum": bson.M{"$cond": bson.A{condition, value, decimalZero()}}}
+}
+
+func decimalZero() primitive.Decimal128 {
+	return primitive.NewDecimal128(0, 0)
+}
+
+func toStringSlice[S ~string](values []S) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, string(value))
+	}
+	return result
+}
+
+func (stored storedAnalyticsBucket) toBucket() (quote.AnalyticsBucket, error) {
+	amounts := []primitive.Decimal128{
+		stored.AcceptedAmount, stored.PaidAmount, stored.RefundedAmount, stored.PenalizationsAmount,
+		stored.CallFees, stored.GasCollected, stored.GasSpent, stored.BtcFees,
+	}
+	converted := make([]*entities.Wei, 0, len(amounts))
+	for _, amount := range amounts {
+		wei, err := decimalToWei(amount)
+		if err != nil {
+			return quote.AnalyticsBucket{}, err
+		}
+		converted = append(converted, wei)
+	}
+	return quote.AnalyticsBucket{
+		PeriodStart:          stored.Id.Period.Time().UTC(),
+		OwnerAccountAddress:  stored.Id.Owner,
+		TotalQuotesCount:     stored.TotalQuotes,
+		AcceptedQuotesCount:  stored.AcceptedQuotes,
+		AcceptedQuotesAmount: converted[0],
+		PaidQuotesCount:      stored.PaidQuotes,
+		PaidQuotesAmount:     converted[1],
+		RefundedQuotesCount:  stored.RefundedQuotes,
+		RefundedQuotesAmount: converted[2],
+		PenalizationsCount:   stored.PenalizationsCount,
+		PenalizationsAmount:  converted[3],
+		CallFees:             converted[4],
+		GasFeesCollected:     converted[5],
+		GasSpent:             converted[6],
+		BtcFeesPaid:          converted[7],
+	}, nil
+}
+
+// decimalToWei converts an integer Decimal128 to Wei, the fractional part (if any) is truncated
+func decimalToWei(value primitive.Decimal128) (*entities.Wei, error) {
+	const base = 10
+	significand, exponent, err := value.BigInt()
+	if err != nil {
+		return nil, err
+	}
+	scale := new(big.Int).Exp(big.NewInt(base), big.NewInt(int64(max(exponent, -exponent))), nil)
+	if exponent >= 0 {
+		significand.Mul(significand, scale)
+	} else {
+		significand.Quo(significand, scale)
+	}
+	return entities.NewBigWei(significand), nil
+}
@@ -0,0 +1,198 @@
+package mongo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/database/mongo"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongoDb "go.mongodb.org/mongo-driver/mongo"
+)
+
+var (
+	analyticsStartDate = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	analyticsEndDate   = time.Date(2024, 1, 31, 23, 59, 59, 0, time.UTC)
+)
+
+func buildAnalyticsDocument(t *testing.T, period time.Time, owner string, amount string) bson.D {
+	decimal, err := primitive.ParseDecimal128(amount)
+	require.NoError(t, err)
+	zero := primitive.NewDecimal128(0, 0)
+	return bson.D{
+		{Key: "_id", Value: bson.D{{Key: "period", Value: primitive.NewDateTimeFromTime(period)}, {Key: "owner", Value: owner}}},
+		{Key: "total_quotes", Value: int32(3)},
+		{Key: "accepted_quotes", Value: int32(2)},
+		{Key: "accepted_amount", Value: decimal},
+		{Key: "paid_quotes", Value: int32(2)},
+		{Key: "paid_amount", Value: decimal},
+		{Key: "refunded_quotes", Value: int32(1)},
+		{Key: "refunded_amount", Value: decimal},
+		{Key: "penalizations_count", Value: int32(1)},
+		{Key: "penalizations_amount", Value: zero},
+		{Key: "call_fees", Value: decimal},
+		{Key: "gas_collected", Value: zero},
+		{Key: "gas_spent", Value: zero},
+		{Key: "btc_fees", Value: zero},
+	}
+}
+
+func findPipelineStage(pipeline mongoDb.Pipeline, name string) any {
+	for _, stage := range pipeline {
+		if stage[0].Key == name {
+			return stage[0].Value
+		}
+	}
+	return nil
+}
+
+// nolint:funlen
+func TestPeginMongoRepository_GetAnalyticsBuckets(t *testing.T) {
+	query := quote.AnalyticsQuery[quote.PeginState]{
+		Period:         quote.AnalyticsPeriodDay,
+		StartDate:      analyticsStartDate,
+		EndDate:        analyticsEndDate,
+		PaidStates:     []quote.PeginState{quote.PeginStateCallForUserSucceeded},
+		RefundedStates: []quote.PeginState{quote.PeginStateRegisterPegInSucceeded},
+		RevenueStates:  []quote.PeginState{quote.PeginStateRegisterPegInSucceeded},
+	}
+	t.Run("should aggregate the quotes by period and owner", func(t *testing.T) {
+		client, db := getClientAndDatabaseMocks()
+		collection := &mocks.CollectionBindingMock{}
+		db.EXPECT().Collection(mongo.PeginQuoteCollection).Return(collection)
+		firstDay := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		secondDay := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+		cursor, err := mongoDb.NewCursorFromDocuments([]any{
+			buildAnalyticsDocument(t, firstDay, "", "1500000000000000000"),
+			buildAnalyticsDocument(t, secondDay, test.AnyRskAddress, "1.5E+3"),
+		}, nil, nil)
+		require.NoError(t, err)
+		collection.On("Aggregate", mock.Anything, mock.MatchedBy(func(pipeline mongoDb.Pipeline) bool {
+			match := findPipelineStage(pipeline, "$match").(bson.M)
+			lookup := findPipelineStage(pipeline, "$lookup").(bson.M)
+			project := findPipelineStage(pipeline, "$project").(bson.M)
+			gasUsed := project["gas_used"].(bson.M)["$add"].(bson.A)
+			return assert.Equal(t, bson.M{"$gte": analyticsStartDate.Unix(), "$lte": analyticsEndDate.Unix()}, match["agreement_timestamp"]) &&
+				assert.Equal(t, mongo.RetainedPeginQuoteCollection, lookup["from"]) &&
+				assert.Len(t, gasUsed, 3) &&
+				assert.Equal(t, primitive.NewDecimal128(0, 0), project["btc_fee"]) &&
+				assert.Equal(t, bson.A{bson.M{"$ifNull": bson.A{"$retained.state", ""}}, []string{string(quote.PeginStateRegisterPegInSucceeded)}}, project["revenue"].(bson.M)["$in"])
+		})).Return(cursor, nil).Once()
+		repo := mongo.NewPeginMongoRepository(mongo.NewConnection(client, time.Duration(1)))
+
+		result, err := repo.GetAnalyticsBuckets(context.Background(), query)
+
+		require.NoError(t, err)
+		collection.AssertExpectations(t)
+		require.Len(t, result, 2)
+		assert.Equal(t, quote.AnalyticsBucket{
+			PeriodStart:          firstDay,
+			OwnerAccountAddress:  "",
+			TotalQuotesCount:     3,
+			AcceptedQuotesCount:  2,
+			AcceptedQuotesAmount: entities.NewWei(1500000000000000000),
+			PaidQuotesCount:      2,
+			PaidQuotesAmount:     entities.NewWei(1500000000000000000),
+			RefundedQuotesCount:  1,
+			RefundedQuotesAmount: entities.NewWei(1500000000000000000),
+			PenalizationsCount:   1,
+			PenalizationsAmount:  entities.NewWei(0),
+			CallFees:             entities.NewWei(1500000000000000000),
+			GasFeesCollected:     entities.NewWei(0),
+			GasSpent:             entities.NewWei(0),
+			BtcFeesPaid:          entities.NewWei(0),
+		}, result[0])
+		assert.Equal(t, secondDay, result[1].PeriodStart)
+		assert.Equal(t, test.AnyRskAddress, result[1].OwnerAccountAddress)
+		assert.Equal(t, entities.NewWei(1500), result[1].CallFees)
+	})
+	t.Run("should return an error if the period is not valid", func(t *testing.T) {
+		client, db := getClientAndDatabaseMocks()
+		repo := mongo.NewPeginMongoRepository(mongo.NewConnection(client, time.Duration(1)))
+		invalidQuery := query
+		invalidQuery.Period = "year"
+		result, err := repo.GetAnalyticsBuckets(context.Background(), invalidQuery)
+		require.ErrorContains(t, err, "invalid analytics period")
+		assert.Nil(t, result)
+		db.AssertNotCalled(t, "Collection", mock.Anything)
+	})
+	t.Run("should return an error if the aggregation fails", func(t *testing.T) {
+		client, db := getClientAndDatabaseMocks()
+		collection := &mocks.CollectionBindingMock{}
+		db.EXPECT().Collection(mongo.PeginQuoteCollection).Return(collection)
+		collection.On("Aggregate", mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		repo := mongo.NewPeginMongoRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetAnalyticsBuckets(context.Background(), query)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, result)
+	})
+	t.Run("should return an error if a bucket can't be decoded", func(t *testing.T) {
+		client, db := getClientAndDatabaseMocks()
+		collection := &mocks.CollectionBindingMock{}
+		db.EXPECT().Collection(mongo.PeginQuoteCollection).Return(collection)
+		cursor, err := mongoDb.NewCursorFromDocuments([]any{bson.D{{Key: "call_fees", Value: "not a decimal"}}}, nil, nil)
+		require.NoError(t, err)
+		collection.On("Aggregate", mock.Anything, mock.Anything).Return(cursor, nil).Once()
+		repo := mongo.NewPeginMongoRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetAnalyticsBuckets(context.Background(), query)
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestPegoutMongoRepository_GetAnalyticsBuckets(t *testing.T) {
+	query := quote.AnalyticsQuery[quote.PegoutState]{
+		StartDate:     analyticsStartDate,
+		EndDate:       analyticsEndDate,
+		RevenueStates: []quote.PegoutState{quote.PegoutStateRefundPegOutSucceeded},
+	}
+	cases := map[quote.AnalyticsPeriod]bson.M{
+		quote.AnalyticsPeriodDay: {
+			"year": bson.M{"$year": "$$agreementDate"}, "month": bson.M{"$month": "$$agreementDate"}, "day": bson.M{"$dayOfMonth": "$$agreementDate"},
+		},
+		quote.AnalyticsPeriodWeek: {
+			"isoWeekYear": bson.M{"$isoWeekYear": "$$agreementDate"}, "isoWeek": bson.M{"$isoWeek": "$$agreementDate"},
+		},
+		quote.AnalyticsPeriodMonth: {
+			"year": bson.M{"$year": "$$agreementDate"}, "month": bson.M{"$month": "$$agreementDate"},
+		},
+	}
+	for period, expectedParts := range cases {
+		t.Run("should aggregate the quotes by "+string(period), func(t *testing.T) {
+			client, db := getClientAndDatabaseMocks()
+			collection := &mocks.CollectionBindingMock{}
+			db.EXPECT().Collection(mongo.PegoutQuoteCollection).Return(collection)
+			periodStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			cursor, err := mongoDb.NewCursorFromDocuments([]any{buildAnalyticsDocument(t, periodStart, test.AnyRskAddress, "100")}, nil, nil)
+			require.NoError(t, err)
+			collection.On("Aggregate", mock.Anything, mock.MatchedBy(func(pipeline mongoDb.Pipeline) bool {
+				lookup := findPipelineStage(pipeline, "$lookup").(bson.M)
+				project := findPipelineStage(pipeline, "$project").(bson.M)
+				periodExpression := project["period"].(bson.M)["$let"].(bson.M)["in"].(bson.M)
+				btcFee := project["btc_fee"].(bson.M)["$convert"].(bson.M)
+				return assert.Equal(t, mongo.RetainedPegoutQuoteCollection, lookup["from"]) &&
+					assert.Equal(t, "$retained.send_pegout_btc_fee", btcFee["input"]) &&
+					assert.Equal(t, expectedParts, periodExpression["$dateFromParts"])
+			})).Return(cursor, nil).Once()
+			repo := mongo.NewPegoutMongoRepository(mongo.NewConnection(client, time.Duration(1)))
+			periodQuery := query
+			periodQuery.Period = period
+
+			result, err := repo.GetAnalyticsBuckets(context.Background(), periodQuery)
+
+			require.NoError(t, err)
+			collection.AssertExpectations(t)
+			require.Len(t, result, 1)
+			assert.Equal(t, periodStart, result[0].PeriodStart)
+			assert.Equal(t, entities.NewWei(100), result[0].CallFees)
+		})
+	}
+}
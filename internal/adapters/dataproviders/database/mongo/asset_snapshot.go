@@ -0,0 +1,112 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const AssetSnapshotCollection = "assetSnapshots"
+
+// AssetSnapshotRetention is how long the asset snapshots are kept. A snapshot is taken every minute, so they are
+// removed by the TTL index on expire_at to keep the collection bounded
+const AssetSnapshotRetention = 90 * 24 * time.Hour
+
+type storedAssetSnapshot struct {
+	reporting.AssetSnapshot `bson:",inline"`
+	ExpireAt                time.Time `bson:"expire_at"`
+}
+
+type assetSnapshotMongoRepository struct {
+	conn *Connection
+}
+
+func NewAssetSnapshotRepository(conn *Connection) reporting.AssetSnapshotRepository {
+	return &assetSnapshotMongoRepository{conn: conn}
+}
+
+func (repo *assetSnapshotMongoRepository) InsertAssetSnapshot(ctx context.Context, snapshot reporting.AssetSnapshot) error {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(AssetSnapshotCollection)
+	stored := storedAssetSnapshot{AssetSnapshot: snapshot, ExpireAt: snapshot.Timestamp.Add(AssetSnapshotRetention)}
+	if _, err := collection.InsertOne(dbCtx, stored); err != nil {
+		return err
+	}
+	logDbInteraction(Insert, snapshot)
+	return nil
+}
+
+func (repo *assetSnapshotMongoRepository) GetAssetSnapshots(
+	ctx context.Context,
+	startDate, endDate time.Time,
+	interval reporting.AssetHistoryInterval,
+) ([]reporting.AssetSnapshot, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(AssetSnapshotCollection)
+	cursor, err := collection.Aggregate(dbCtx, assetSnapshotsPipeline(startDate, endDate, interval))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]reporting.AssetSnapshot, 0)
+	if err = cursor.All(dbCtx, &result); err != nil {
+		return nil, err
+	}
+	for i := range result {
+		result[i].Timestamp = result[i].Timestamp.UTC()
+	}
+	logDbInteraction(Read, len(result))
+	return result, nil
+}
+
+func (repo *assetSnapshotMongoRepository) GetLastAssetSnapshot(ctx context.Context, before time.Time) (*reporting.AssetSnapshot, error) {
+	var result reporting.AssetSnapshot
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(AssetSnapshotCollection)
+	filter := bson.D{{Key: "timestamp", Value: bson.D{{Key: "$lte", Value: before}}}}
+	findOpts := options.FindOne().SetSort(bson.D{{Key: "timestamp", Value: SortDescending}})
+	err := collection.FindOne(dbCtx, filter, findOpts).Decode(&result)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	result.Timestamp = result.Timestamp.UTC()
+	logDbInteraction(Read, result)
+	return &result, nil
+}
+
+// assetSnapshotsPipeline builds the pipeline to get the snapshots of a range. When the interval has a duration, the
+// snapshots are grouped by the bucket their timestamp falls in and only the last one of each bucket is kept. The buckets
+// are calculated with the remainder of the epoch milliseconds so the pipeline works on servers without $dateTrunc
+func assetSnapshotsPipeline(startDate, endDate time.Time, interval reporting.AssetHistoryInterval) mongo.Pipeline {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "timestamp", Value: bson.D{{Key: "$gte", Value: startDate}, {Key: "$lte", Value: endDate}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "timestamp", Value: SortAscending}}}},
+	}
+	bucketSize := interval.Duration().Milliseconds()
+	if bucketSize == 0 {
+		return pipeline
+	}
+	epochMillis := bson.D{{Key: "$toLong", Value: "$timestamp"}}
+	return append(pipeline,
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$subtract", Value: bson.A{
+				epochMillis,
+				bson.D{{Key: "$mod", Value: bson.A{epochMillis, bucketSize}}},
+			}}}},
+			{Key: "snapshot", Value: bson.D{{Key: "$last", Value: "$$ROOT"}}},
+		}}},
+		bson.D{{Key: "$replaceRoot", Value: bson.D{{Key: "newRoot", Value: "$snapshot"}}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "timestamp", Value: SortAscending}}}},
+	)
+}
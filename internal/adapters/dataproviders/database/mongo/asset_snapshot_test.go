@@ -0,0 +1,180 @@
+package mongo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/database/mongo"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	mongoDb "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var testAssetSnapshot = reporting.AssetSnapshot{
+	Timestamp: time.Date(2024, 3, 12, 10, 15, 0, 0, time.UTC),
+	Btc: reporting.AssetBalance{
+		Total: entities.NewWei(900),
+		Location: reporting.AssetLocation{
+			BtcWallet:  entities.NewWei(500),
+			Federation: entities.NewWei(200),
+			RskWallet:  entities.NewWei(100),
+			Lbc:        entities.NewWei(100),
+		},
+		Allocation: reporting.AssetAllocation{
+			ReservedForUsers: entities.NewWei(50),
+			WaitingForRefund: entities.NewWei(400),
+			Available:        entities.NewWei(450),
+		},
+	},
+	Rbtc: reporting.AssetBalance{
+		Total: entities.NewWei(1000),
+		Location: reporting.AssetLocation{
+			Federation: entities.NewWei(300),
+			RskWallet:  entities.NewWei(200),
+			Lbc:        entities.NewWei(500),
+		},
+		Allocation: reporting.AssetAllocation{
+			ReservedForUsers: entities.NewWei(100),
+			WaitingForRefund: entities.NewWei(300),
+			Available:        entities.NewWei(600),
+		},
+	},
+}
+
+func TestAssetSnapshotMongoRepository_InsertAssetSnapshot(t *testing.T) {
+	matchStored := mock.MatchedBy(func(document any) bool {
+		raw, err := bson.Marshal(document)
+		require.NoError(t, err)
+		var stored struct {
+			reporting.AssetSnapshot `bson:",inline"`
+			ExpireAt                time.Time `bson:"expire_at"`
+		}
+		require.NoError(t, bson.Unmarshal(raw, &stored))
+		return stored.Timestamp.Equal(testAssetSnapshot.Timestamp) &&
+			stored.Btc.Total.Cmp(testAssetSnapshot.Btc.Total) == 0 &&
+			stored.ExpireAt.Equal(testAssetSnapshot.Timestamp.Add(mongo.AssetSnapshotRetention))
+	})
+	t.Run("Insert asset snapshot successfully with its expiration date", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.AssetSnapshotCollection)
+		collection.On("InsertOne", mock.Anything, matchStored).Return(nil, nil).Once()
+		repo := mongo.NewAssetSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Insert)()
+		err := repo.InsertAssetSnapshot(context.Background(), testAssetSnapshot)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+	})
+	t.Run("Db error inserting asset snapshot", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.AssetSnapshotCollection)
+		collection.On("InsertOne", mock.Anything, matchStored).Return(nil, assert.AnError).Once()
+		repo := mongo.NewAssetSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.InsertAssetSnapshot(context.Background(), testAssetSnapshot)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+	t.Run("Omit the BTC wallet location of the RBTC balance", func(t *testing.T) {
+		raw, err := bson.Marshal(testAssetSnapshot)
+		require.NoError(t, err)
+		var stored bson.M
+		require.NoError(t, bson.Unmarshal(raw, &stored))
+		btcLocation := stored["btc"].(bson.M)["location"].(bson.M)
+		rbtcLocation := stored["rbtc"].(bson.M)["location"].(bson.M)
+		assert.Contains(t, btcLocation, "btc_wallet")
+		assert.NotContains(t, rbtcLocation, "btc_wallet")
+	})
+}
+
+// nolint:funlen
+func TestAssetSnapshotMongoRepository_GetAssetSnapshots(t *testing.T) {
+	log.SetLevel(log.DebugLevel)
+	startDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 3, 31, 23, 59, 59, 0, time.UTC)
+	matchStage := bson.D{{Key: "timestamp", Value: bson.D{{Key: "$gte", Value: startDate}, {Key: "$lte", Value: endDate}}}}
+	t.Run("Get downsampled asset snapshots successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.AssetSnapshotCollection)
+		cursor, err := mongoDb.NewCursorFromDocuments([]any{testAssetSnapshot}, nil, nil)
+		require.NoError(t, err)
+		collection.On("Aggregate", mock.Anything, mock.MatchedBy(func(pipeline mongoDb.Pipeline) bool {
+			group := findPipelineStage(pipeline, "$group").(bson.D)
+			bucket := group[0].Value.(bson.D)[0].Value.(bson.A)[1].(bson.D)[0].Value.(bson.A)
+			return assert.Len(t, pipeline, 5) &&
+				assert.Equal(t, matchStage, findPipelineStage(pipeline, "$match")) &&
+				assert.Equal(t, time.Hour.Milliseconds(), bucket[1]) &&
+				assert.NotNil(t, findPipelineStage(pipeline, "$replaceRoot"))
+		})).Return(cursor, nil).Once()
+		repo := mongo.NewAssetSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Read)()
+		result, err := repo.GetAssetSnapshots(context.Background(), startDate, endDate, reporting.AssetHistoryIntervalHour)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Equal(t, []reporting.AssetSnapshot{testAssetSnapshot}, result)
+	})
+	t.Run("Don't downsample raw asset snapshots", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.AssetSnapshotCollection)
+		cursor, err := mongoDb.NewCursorFromDocuments([]any{}, nil, nil)
+		require.NoError(t, err)
+		collection.On("Aggregate", mock.Anything, mock.MatchedBy(func(pipeline mongoDb.Pipeline) bool {
+			return assert.Len(t, pipeline, 2) && assert.Nil(t, findPipelineStage(pipeline, "$group"))
+		})).Return(cursor, nil).Once()
+		repo := mongo.NewAssetSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetAssetSnapshots(context.Background(), startDate, endDate, reporting.AssetHistoryIntervalRaw)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+	t.Run("Db error getting asset snapshots", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.AssetSnapshotCollection)
+		collection.On("Aggregate", mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		repo := mongo.NewAssetSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetAssetSnapshots(context.Background(), startDate, endDate, reporting.AssetHistoryIntervalDay)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, result)
+	})
+}
+
+func TestAssetSnapshotMongoRepository_GetLastAssetSnapshot(t *testing.T) {
+	log.SetLevel(log.DebugLevel)
+	before := time.Date(2024, 3, 12, 11, 0, 0, 0, time.UTC)
+	filter := bson.D{{Key: "timestamp", Value: bson.D{{Key: "$lte", Value: before}}}}
+	sortByNewest := mock.MatchedBy(func(opts *options.FindOneOptions) bool {
+		return assert.Equal(t, bson.D{{Key: "timestamp", Value: mongo.SortDescending}}, opts.Sort)
+	})
+	t.Run("Get last asset snapshot successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.AssetSnapshotCollection)
+		collection.On("FindOne", mock.Anything, filter, sortByNewest).
+			Return(mongoDb.NewSingleResultFromDocument(testAssetSnapshot, nil, nil)).Once()
+		repo := mongo.NewAssetSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Read)()
+		result, err := repo.GetLastAssetSnapshot(context.Background(), before)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Equal(t, &testAssetSnapshot, result)
+	})
+	t.Run("Return nil when there is no asset snapshot", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.AssetSnapshotCollection)
+		collection.On("FindOne", mock.Anything, filter, sortByNewest).
+			Return(mongoDb.NewSingleResultFromDocument(reporting.AssetSnapshot{}, mongoDb.ErrNoDocuments, nil)).Once()
+		repo := mongo.NewAssetSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetLastAssetSnapshot(context.Background(), before)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+	t.Run("Db error getting last asset snapshot", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.AssetSnapshotCollection)
+		collection.On("FindOne", mock.Anything, filter, sortByNewest).
+			Return(mongoDb.NewSingleResultFromDocument(reporting.AssetSnapshot{}, assert.AnError, nil)).Once()
+		repo := mongo.NewAssetSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetLastAssetSnapshot(context.Background(), before)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, result)
+	})
+}
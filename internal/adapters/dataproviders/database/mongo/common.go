@@ -8,8 +8,9 @@ import (
 )
 
 const (
-	DbName        = "flyover"
-	SortAscending = 1
+	DbName         = "flyover"
+	SortAscending  = 1
+	SortDescending = -1
 )
 
 type DbInteraction string
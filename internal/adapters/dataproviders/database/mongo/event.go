@@ -0,0 +1,119 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	EventLogCollection     = "eventLog"
+	EventOffsetsCollection = "eventOffsets"
+)
+
+type StoredEventOffset struct {
+	Subscriber string           `json:"subscriber" bson:"subscriber"`
+	EventId    entities.EventId `json:"eventId" bson:"event_id"`
+	Sequence   uint64           `json:"sequence" bson:"sequence"`
+}
+
+type eventMongoRepository struct {
+	conn *Connection
+}
+
+func NewEventRepository(conn *Connection) entities.EventRepository {
+	return &eventMongoRepository{conn: conn}
+}
+
+func (repo *eventMongoRepository) InsertEvent(ctx context.Context, record entities.EventRecord) error {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(EventLogCollection)
+	_, err := collection.InsertOne(dbCtx, record)
+	if err != nil {
+		return err
+	}
+	logDbInteraction(Insert, record.EventId)
+	return nil
+}
+
+func (repo *eventMongoRepository) GetLastSequence(ctx context.Context) (uint64, error) {
+	var result entities.EventRecord
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+
+	collection := repo.conn.Collection(EventLogCollection)
+	findOpts := options.FindOne().SetSort(bson.D{{Key: "sequence", Value: SortDescending}})
+	err := collection.FindOne(dbCtx, bson.D{}, findOpts).Decode(&result)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	logDbInteraction(Read, result.Sequence)
+	return result.Sequence, nil
+}
+
+func (repo *eventMongoRepository) GetEvents(ctx context.Context, id entities.EventId, fromSequence uint64, limit int64) ([]entities.EventRecord, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+
+	collection := repo.conn.Collection(EventLogCollection)
+	filter := bson.D{
+		primitive.E{Key: "event_id", Value: id},
+		primitive.E{Key: "sequence", Value: bson.D{primitive.E{Key: "$gt", Value: fromSequence}}},
+	}
+	findOpts := options.Find().SetSort(bson.D{{Key: "sequence", Value: SortAscending}}).SetLimit(limit)
+	cursor, err := collection.Find(dbCtx, filter, findOpts)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]entities.EventRecord, 0)
+	if err = cursor.All(dbCtx, &result); err != nil {
+		return nil, err
+	}
+	logDbInteraction(Read, len(result))
+	return result, nil
+}
+
+func (repo *eventMongoRepository) GetSubscriberOffset(ctx context.Context, subscriber string, id entities.EventId) (uint64, error) {
+	var result StoredEventOffset
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+
+	collection := repo.conn.Collection(EventOffsetsCollection)
+	filter := bson.D{
+		primitive.E{Key: "subscriber", Value: subscriber},
+		primitive.E{Key: "event_id", Value: id},
+	}
+	err := collection.FindOne(dbCtx, filter).Decode(&result)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	logDbInteraction(Read, result)
+	return result.Sequence, nil
+}
+
+func (repo *eventMongoRepository) UpsertSubscriberOffset(ctx context.Context, subscriber string, id entities.EventId, sequence uint64) error {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+
+	offset := StoredEventOffset{Subscriber: subscriber, EventId: id, Sequence: sequence}
+	filter := bson.D{
+		primitive.E{Key: "subscriber", Value: subscriber},
+		primitive.E{Key: "event_id", Value: id},
+	}
+	_, err := repo.conn.Collection(EventOffsetsCollection).ReplaceOne(dbCtx, filter, offset, options.Replace().SetUpsert(true))
+	if err != nil {
+		return err
+	}
+	logDbInteraction(Upsert, offset)
+	return nil
+}
@@ -0,0 +1,182 @@
+package mongo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/database/mongo"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongoDb "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const testEventSubscriber = "PeginBridgeWatcher"
+
+var testEventRecord = entities.EventRecord{
+	Sequence:  5,
+	EventId:   quote.CallForUserCompletedEventId,
+	Timestamp: time.Unix(1700000000, 0).UTC(),
+	Error:     "some error",
+	Payload:   []byte(`{"PeginQuote":{"fedBTCAddr":"2N5muMepJizJE1gR7FbHJU6CD18V3BpNF9p"}}`),
+}
+
+func TestEventMongoRepository_InsertEvent(t *testing.T) {
+	t.Run("Insert event successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.EventLogCollection)
+		collection.On("InsertOne", mock.Anything, testEventRecord).Return(nil, nil).Once()
+		repo := mongo.NewEventRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Insert)()
+		err := repo.InsertEvent(context.Background(), testEventRecord)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+	})
+	t.Run("Db error inserting event", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.EventLogCollection)
+		collection.On("InsertOne", mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		repo := mongo.NewEventRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.InsertEvent(context.Background(), testEventRecord)
+		collection.AssertExpectations(t)
+		require.Error(t, err)
+	})
+}
+
+func TestEventMongoRepository_GetLastSequence(t *testing.T) {
+	sortBySequence := mock.MatchedBy(func(opts *options.FindOneOptions) bool {
+		return assert.Equal(t, bson.D{{Key: "sequence", Value: mongo.SortDescending}}, opts.Sort)
+	})
+	t.Run("Get last sequence successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.EventLogCollection)
+		collection.On("FindOne", mock.Anything, bson.D{}, sortBySequence).
+			Return(mongoDb.NewSingleResultFromDocument(testEventRecord, nil, nil)).Once()
+		repo := mongo.NewEventRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetLastSequence(context.Background())
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), result)
+	})
+	t.Run("Return zero when there are no events", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.EventLogCollection)
+		collection.On("FindOne", mock.Anything, bson.D{}, sortBySequence).
+			Return(mongoDb.NewSingleResultFromDocument(entities.EventRecord{}, mongoDb.ErrNoDocuments, nil)).Once()
+		repo := mongo.NewEventRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetLastSequence(context.Background())
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Zero(t, result)
+	})
+	t.Run("Db error getting last sequence", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.EventLogCollection)
+		collection.On("FindOne", mock.Anything, mock.Anything, mock.Anything).
+			Return(mongoDb.NewSingleResultFromDocument(entities.EventRecord{}, assert.AnError, nil)).Once()
+		repo := mongo.NewEventRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetLastSequence(context.Background())
+		collection.AssertExpectations(t)
+		require.Error(t, err)
+		assert.Zero(t, result)
+	})
+}
+
+func TestEventMongoRepository_GetEvents(t *testing.T) {
+	filter := bson.D{
+		primitive.E{Key: "event_id", Value: quote.CallForUserCompletedEventId},
+		primitive.E{Key: "sequence", Value: bson.D{primitive.E{Key: "$gt", Value: uint64(4)}}},
+	}
+	t.Run("Get events successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.EventLogCollection)
+		collection.On("Find", mock.Anything, filter, mock.MatchedBy(func(opts *options.FindOptions) bool {
+			return assert.Equal(t, int64(10), *opts.Limit) &&
+				assert.Equal(t, bson.D{{Key: "sequence", Value: mongo.SortAscending}}, opts.Sort)
+		})).Return(mongoDb.NewCursorFromDocuments([]any{testEventRecord}, nil, nil)).Once()
+		repo := mongo.NewEventRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetEvents(context.Background(), quote.CallForUserCompletedEventId, 4, 10)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, testEventRecord.Sequence, result[0].Sequence)
+		assert.Equal(t, testEventRecord.Error, result[0].Error)
+		assert.Equal(t, testEventRecord.Payload, result[0].Payload)
+		assert.True(t, testEventRecord.Timestamp.Equal(result[0].Timestamp))
+	})
+	t.Run("Db error getting events", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.EventLogCollection)
+		collection.On("Find", mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		repo := mongo.NewEventRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetEvents(context.Background(), quote.CallForUserCompletedEventId, 4, 10)
+		collection.AssertExpectations(t)
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestEventMongoRepository_GetSubscriberOffset(t *testing.T) {
+	filter := bson.D{
+		primitive.E{Key: "subscriber", Value: testEventSubscriber},
+		primitive.E{Key: "event_id", Value: quote.CallForUserCompletedEventId},
+	}
+	t.Run("Get subscriber offset successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.EventOffsetsCollection)
+		offset := mongo.StoredEventOffset{Subscriber: testEventSubscriber, EventId: quote.CallForUserCompletedEventId, Sequence: 7}
+		collection.On("FindOne", mock.Anything, filter).
+			Return(mongoDb.NewSingleResultFromDocument(offset, nil, nil)).Once()
+		repo := mongo.NewEventRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetSubscriberOffset(context.Background(), testEventSubscriber, quote.CallForUserCompletedEventId)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(7), result)
+	})
+	t.Run("Return zero when the subscriber has no offset", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.EventOffsetsCollection)
+		collection.On("FindOne", mock.Anything, filter).
+			Return(mongoDb.NewSingleResultFromDocument(mongo.StoredEventOffset{}, mongoDb.ErrNoDocuments, nil)).Once()
+		repo := mongo.NewEventRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetSubscriberOffset(context.Background(), testEventSubscriber, quote.CallForUserCompletedEventId)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Zero(t, result)
+	})
+	t.Run("Db error getting subscriber offset", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.EventOffsetsCollection)
+		collection.On("FindOne", mock.Anything, mock.Anything).
+			Return(mongoDb.NewSingleResultFromDocument(mongo.StoredEventOffset{}, assert.AnError, nil)).Once()
+		repo := mongo.NewEventRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetSubscriberOffset(context.Background(), testEventSubscriber, quote.CallForUserCompletedEventId)
+		collection.AssertExpectations(t)
+		require.Error(t, err)
+		assert.Zero(t, result)
+	})
+}
+
+func TestEventMongoRepository_UpsertSubscriberOffset(t *testing.T) {
+	t.Run("Upsert subscriber offset successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.EventOffsetsCollection)
+		collection.On("ReplaceOne", mock.Anything,
+			bson.D{
+				primitive.E{Key: "subscriber", Value: testEventSubscriber},
+				primitive.E{Key: "event_id", Value: quote.CallForUserCompletedEventId},
+			},
+			mongo.StoredEventOffset{Subscriber: testEventSubscriber, EventId: quote.CallForUserCompletedEventId, Sequence: 8},
+			options.Replace().SetUpsert(true),
+		).Return(&mongoDb.UpdateResult{ModifiedCount: 1}, nil).Once()
+		repo := mongo.NewEventRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Upsert)()
+		err := repo.UpsertSubscriberOffset(context.Background(), testEventSubscriber, quote.CallForUserCompletedEventId, 8)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+	})
+	t.Run("Db error upserting subscriber offset", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.EventOffsetsCollection)
+		collection.On("ReplaceOne", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, assert.AnError).Once()
+		repo := mongo.NewEventRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.UpsertSubscriberOffset(context.Background(), testEventSubscriber, quote.CallForUserCompletedEventId, 8)
+		collection.AssertExpectations(t)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,98 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const LiquidityHoldCollection = "liquidityHolds"
+
+type liquidityLedgerMongoRepository struct {
+	conn *Connection
+}
+
+func NewLiquidityLedgerRepository(conn *Connection) liquidity_provider.LiquidityLedger {
+	return &liquidityLedgerMongoRepository{conn: conn}
+}
+
+func (repo *liquidityLedgerMongoRepository) PlaceHold(ctx context.Context, hold liquidity_provider.LiquidityHold) error {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(LiquidityHoldCollection)
+	opts := options.Replace().SetUpsert(true)
+	if _, err := collection.ReplaceOne(dbCtx, bson.M{"quote_hash": hold.QuoteHash}, hold, opts); err != nil {
+		return err
+	}
+	logDbInteraction(Upsert, hold)
+	return nil
+}
+
+func (repo *liquidityLedgerMongoRepository) ReleaseHold(ctx context.Context, quoteHash string, reason string) (bool, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(LiquidityHoldCollection)
+	filter := bson.M{"quote_hash": quoteHash, "status": liquidity_provider.HoldStatusActive}
+	update := bson.M{"$set": bson.M{
+		"status":         liquidity_provider.HoldStatusReleased,
+		"released_at":    time.Now().UTC(),
+		"release_reason": reason,
+	}}
+	result, err := collection.UpdateOne(dbCtx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	logDbInteraction(Update, filter)
+	return result.ModifiedCount > 0, nil
+}
+
+func (repo *liquidityLedgerMongoRepository) ExpireHold(ctx context.Context, quoteHash string) (bool, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(LiquidityHoldCollection)
+	filter := bson.M{"quote_hash": quoteHash, "status": liquidity_provider.HoldStatusActive}
+	update := bson.M{"$set": bson.M{"status": liquidity_provider.HoldStatusExpired}}
+	result, err := collection.UpdateOne(dbCtx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	logDbInteraction(Update, filter)
+	return result.ModifiedCount > 0, nil
+}
+
+func (repo *liquidityLedgerMongoRepository) GetActiveHolds(ctx context.Context, operation liquidity_provider.HoldOperation) ([]liquidity_provider.LiquidityHold, error) {
+	filter := bson.M{"operation": operation, "status": liquidity_provider.HoldStatusActive}
+	return repo.findHolds(ctx, filter)
+}
+
+func (repo *liquidityLedgerMongoRepository) GetHolds(ctx context.Context, holdFilter liquidity_provider.LiquidityHoldFilter) ([]liquidity_provider.LiquidityHold, error) {
+	filter := bson.M{}
+	if holdFilter.Operation != "" {
+		filter["operation"] = holdFilter.Operation
+	}
+	if len(holdFilter.Statuses) != 0 {
+		filter["status"] = bson.M{"$in": holdFilter.Statuses}
+	}
+	return repo.findHolds(ctx, filter)
+}
+
+func (repo *liquidityLedgerMongoRepository) findHolds(ctx context.Context, filter bson.M) ([]liquidity_provider.LiquidityHold, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(LiquidityHoldCollection)
+	findOpts := options.Find().SetSort(bson.D{{Key: "created_at", Value: SortAscending}})
+	cursor, err := collection.Find(dbCtx, filter, findOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(dbCtx)
+	holds := make([]liquidity_provider.LiquidityHold, 0)
+	if err = cursor.All(dbCtx, &holds); err != nil {
+		return nil, err
+	}
+	logDbInteraction(Read, len(holds))
+	return holds, nil
+}
@@ -0,0 +1,174 @@
+package mongo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/database/mongo"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	mongoDb "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var testLiquidityHold = liquidity_provider.LiquidityHold{
+	QuoteHash: "0x0102",
+	Operation: liquidity_provider.PeginHold,
+	Amount:    entities.NewWei(500),
+	Status:    liquidity_provider.HoldStatusActive,
+	CreatedAt: time.Unix(1700000000, 0).UTC(),
+	ExpiresAt: time.Unix(1700010000, 0).UTC(),
+}
+
+func TestLiquidityLedgerMongoRepository_PlaceHold(t *testing.T) {
+	filter := bson.M{"quote_hash": testLiquidityHold.QuoteHash}
+	t.Run("Place hold successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.LiquidityHoldCollection)
+		collection.On("ReplaceOne", mock.Anything, filter, testLiquidityHold, mock.MatchedBy(func(opts *options.ReplaceOptions) bool {
+			return assert.True(t, *opts.Upsert)
+		})).Return(&mongoDb.UpdateResult{UpsertedCount: 1}, nil).Once()
+		repo := mongo.NewLiquidityLedgerRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Upsert)()
+		err := repo.PlaceHold(context.Background(), testLiquidityHold)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+	})
+	t.Run("Db error placing hold", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.LiquidityHoldCollection)
+		collection.On("ReplaceOne", mock.Anything, filter, testLiquidityHold, mock.Anything).Return(nil, assert.AnError).Once()
+		repo := mongo.NewLiquidityLedgerRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.PlaceHold(context.Background(), testLiquidityHold)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestLiquidityLedgerMongoRepository_ReleaseHold(t *testing.T) {
+	filter := bson.M{"quote_hash": testLiquidityHold.QuoteHash, "status": liquidity_provider.HoldStatusActive}
+	updateMatcher := mock.MatchedBy(func(update bson.M) bool {
+		set, ok := update["$set"].(bson.M)
+		return ok && set["status"] == liquidity_provider.HoldStatusReleased && set["release_reason"] == "CallForUserSucceeded" &&
+			!set["released_at"].(time.Time).IsZero()
+	})
+	t.Run("Release hold successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.LiquidityHoldCollection)
+		collection.On("UpdateOne", mock.Anything, filter, updateMatcher).Return(&mongoDb.UpdateResult{ModifiedCount: 1}, nil).Once()
+		repo := mongo.NewLiquidityLedgerRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Update)()
+		released, err := repo.ReleaseHold(context.Background(), testLiquidityHold.QuoteHash, "CallForUserSucceeded")
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.True(t, released)
+	})
+	t.Run("Return false when there is no active hold", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.LiquidityHoldCollection)
+		collection.On("UpdateOne", mock.Anything, filter, updateMatcher).Return(&mongoDb.UpdateResult{ModifiedCount: 0}, nil).Once()
+		repo := mongo.NewLiquidityLedgerRepository(mongo.NewConnection(client, time.Duration(1)))
+		released, err := repo.ReleaseHold(context.Background(), testLiquidityHold.QuoteHash, "CallForUserSucceeded")
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.False(t, released)
+	})
+	t.Run("Db error releasing hold", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.LiquidityHoldCollection)
+		collection.On("UpdateOne", mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		repo := mongo.NewLiquidityLedgerRepository(mongo.NewConnection(client, time.Duration(1)))
+		released, err := repo.ReleaseHold(context.Background(), testLiquidityHold.QuoteHash, "CallForUserSucceeded")
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.False(t, released)
+	})
+}
+
+func TestLiquidityLedgerMongoRepository_ExpireHold(t *testing.T) {
+	filter := bson.M{"quote_hash": testLiquidityHold.QuoteHash, "status": liquidity_provider.HoldStatusActive}
+	update := bson.M{"$set": bson.M{"status": liquidity_provider.HoldStatusExpired}}
+	t.Run("Expire hold successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.LiquidityHoldCollection)
+		collection.On("UpdateOne", mock.Anything, filter, update).Return(&mongoDb.UpdateResult{ModifiedCount: 1}, nil).Once()
+		repo := mongo.NewLiquidityLedgerRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Update)()
+		expired, err := repo.ExpireHold(context.Background(), testLiquidityHold.QuoteHash)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.True(t, expired)
+	})
+	t.Run("Return false when there is no active hold", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.LiquidityHoldCollection)
+		collection.On("UpdateOne", mock.Anything, filter, update).Return(&mongoDb.UpdateResult{ModifiedCount: 0}, nil).Once()
+		repo := mongo.NewLiquidityLedgerRepository(mongo.NewConnection(client, time.Duration(1)))
+		expired, err := repo.ExpireHold(context.Background(), testLiquidityHold.QuoteHash)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.False(t, expired)
+	})
+	t.Run("Db error expiring hold", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.LiquidityHoldCollection)
+		collection.On("UpdateOne", mock.Anything, filter, update).Return(nil, assert.AnError).Once()
+		repo := mongo.NewLiquidityLedgerRepository(mongo.NewConnection(client, time.Duration(1)))
+		expired, err := repo.ExpireHold(context.Background(), testLiquidityHold.QuoteHash)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.False(t, expired)
+	})
+}
+
+func TestLiquidityLedgerMongoRepository_GetActiveHolds(t *testing.T) {
+	filter := bson.M{"operation": liquidity_provider.PeginHold, "status": liquidity_provider.HoldStatusActive}
+	t.Run("Get active holds successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.LiquidityHoldCollection)
+		collection.On("Find", mock.Anything, filter, mock.MatchedBy(func(opts *options.FindOptions) bool {
+			return assert.Equal(t, bson.D{{Key: "created_at", Value: mongo.SortAscending}}, opts.Sort)
+		})).Return(mongoDb.NewCursorFromDocuments([]any{testLiquidityHold}, nil, nil)).Once()
+		repo := mongo.NewLiquidityLedgerRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Read)()
+		result, err := repo.GetActiveHolds(context.Background(), liquidity_provider.PeginHold)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Equal(t, []liquidity_provider.LiquidityHold{testLiquidityHold}, result)
+	})
+	t.Run("Db error getting active holds", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.LiquidityHoldCollection)
+		collection.On("Find", mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		repo := mongo.NewLiquidityLedgerRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetActiveHolds(context.Background(), liquidity_provider.PeginHold)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, result)
+	})
+}
+
+func TestLiquidityLedgerMongoRepository_GetHolds(t *testing.T) {
+	t.Run("Get holds with filter", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.LiquidityHoldCollection)
+		filter := bson.M{
+			"operation": liquidity_provider.PegoutHold,
+			"status":    bson.M{"$in": []liquidity_provider.HoldStatus{liquidity_provider.HoldStatusReleased, liquidity_provider.HoldStatusExpired}},
+		}
+		collection.On("Find", mock.Anything, filter, mock.Anything).
+			Return(mongoDb.NewCursorFromDocuments([]any{testLiquidityHold}, nil, nil)).Once()
+		repo := mongo.NewLiquidityLedgerRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetHolds(context.Background(), liquidity_provider.LiquidityHoldFilter{
+			Operation: liquidity_provider.PegoutHold,
+			Statuses:  []liquidity_provider.HoldStatus{liquidity_provider.HoldStatusReleased, liquidity_provider.HoldStatusExpired},
+		})
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+	t.Run("Get all holds", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.LiquidityHoldCollection)
+		collection.On("Find", mock.Anything, bson.M{}, mock.Anything).
+			Return(mongoDb.NewCursorFromDocuments([]any{}, nil, nil)).Once()
+		repo := mongo.NewLiquidityLedgerRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetHolds(context.Background(), liquidity_provider.LiquidityHoldFilter{})
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
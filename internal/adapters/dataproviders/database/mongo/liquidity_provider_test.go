@@ -80,7 +80,7 @@ func TestLpMongoRepository_GetPeginConfiguration(t *testing.T) {
 	filter := bson.D{primitive.E{Key: "name", Value: mongo.ConfigurationName("pegin")}}
 	log.SetLevel(log.DebugLevel)
 	t.Run("pegin configuration read successfully", func(t *testing.T) {
-		const expectedLog = "READ interaction with db: {Value:{TimeForDeposit:1 CallTime:2 PenaltyFee:3 FixedFee:4 FeePercentage:4.5 MaxValue:5 MinValue:6} Signature:pegin signature Hash:pegin hash}"
+		const expectedLog = "READ interaction with db: {Value:{TimeForDeposit:1 CallTime:2 PenaltyFee:3 FixedFee:4 FeePercentage:4.5 MaxValue:5 MinValue:6 FeeTiers:[] FeeSurcharges:<nil>} Signature:pegin signature Hash:pegin hash}"
 		client, collection := getClientAndCollectionMocks(mongo.LiquidityProviderCollection)
 		repo := mongo.NewLiquidityProviderRepository(mongo.NewConnection(client, time.Duration(1)))
 		collection.On("FindOne", mock.Anything, filter).
@@ -115,7 +115,7 @@ func TestLpMongoRepository_GetPegoutConfiguration(t *testing.T) {
 	filter := bson.D{primitive.E{Key: "name", Value: mongo.ConfigurationName("pegout")}}
 	log.SetLevel(log.DebugLevel)
 	t.Run("pegout configuration read successfully", func(t *testing.T) {
-		const expectedLog = "READ interaction with db: {Value:{TimeForDeposit:1 ExpireTime:2 PenaltyFee:3 FixedFee:4 FeePercentage:4.5 MaxValue:5 MinValue:6 ExpireBlocks:7 BridgeTransactionMin:8} Signature:pegout signature Hash:pegout hash}"
+		const expectedLog = "READ interaction with db: {Value:{TimeForDeposit:1 ExpireTime:2 PenaltyFee:3 FixedFee:4 FeePercentage:4.5 MaxValue:5 MinValue:6 ExpireBlocks:7 BridgeTransactionMin:8 FeeTiers:[] FeeSurcharges:<nil>} Signature:pegout signature Hash:pegout hash}"
 		client, collection := getClientAndCollectionMocks(mongo.LiquidityProviderCollection)
 		repo := mongo.NewLiquidityProviderRepository(mongo.NewConnection(client, time.Duration(1)))
 		collection.On("FindOne", mock.Anything, filter).
@@ -150,7 +150,7 @@ func TestLpMongoRepository_GetGeneralConfiguration(t *testing.T) {
 	filter := bson.D{primitive.E{Key: "name", Value: mongo.ConfigurationName("general")}}
 	log.SetLevel(log.DebugLevel)
 	t.Run("general configuration read successfully", func(t *testing.T) {
-		const expectedLog = "READ interaction with db: {Value:{RskConfirmations:map[1:2 3:4] BtcConfirmations:map[5:6 7:8] PublicLiquidityCheck:false} Signature:general signature Hash:general hash}"
+		const expectedLog = "READ interaction with db: {Value:{RskConfirmations:map[1:2 3:4] BtcConfirmations:map[5:6 7:8] PublicLiquidityCheck:false LiquidityThresholds:<nil>} Signature:general signature Hash:general hash}"
 		client, collection := getClientAndCollectionMocks(mongo.LiquidityProviderCollection)
 		repo := mongo.NewLiquidityProviderRepository(mongo.NewConnection(client, time.Duration(1)))
 		collection.On("FindOne", mock.Anything, filter).
@@ -220,7 +220,7 @@ func TestLpMongoRepository_UpsertPeginConfiguration(t *testing.T) {
 	configName := mongo.ConfigurationName("pegin")
 	filter := bson.D{primitive.E{Key: "name", Value: configName}}
 	t.Run("pegin configuration upserted successfully", func(t *testing.T) {
-		const expectedLog = "INSERT interaction with db: {Signed:{Value:{TimeForDeposit:1 CallTime:2 PenaltyFee:3 FixedFee:4 FeePercentage:4.5 MaxValue:5 MinValue:6} Signature:pegin signature Hash:pegin hash} Name:pegin}"
+		const expectedLog = "INSERT interaction with db: {Signed:{Value:{TimeForDeposit:1 CallTime:2 PenaltyFee:3 FixedFee:4 FeePercentage:4.5 MaxValue:5 MinValue:6 FeeTiers:[] FeeSurcharges:<nil>} Signature:pegin signature Hash:pegin hash} Name:pegin}"
 		client, collection := getClientAndCollectionMocks(mongo.LiquidityProviderCollection)
 		repo := mongo.NewLiquidityProviderRepository(mongo.NewConnection(client, time.Duration(1)))
 		collection.On("ReplaceOne", mock.Anything, filter, mongo.StoredConfiguration[liquidity_provider.PeginConfiguration]{
@@ -247,7 +247,7 @@ func TestLpMongoRepository_UpsertPegoutConfiguration(t *testing.T) {
 	configName := mongo.ConfigurationName("pegout")
 	filter := bson.D{primitive.E{Key: "name", Value: configName}}
 	t.Run("pegout configuration upserted successfully", func(t *testing.T) {
-		const expectedLog = "INSERT interaction with db: {Signed:{Value:{TimeForDeposit:1 ExpireTime:2 PenaltyFee:3 FixedFee:4 FeePercentage:4.5 MaxValue:5 MinValue:6 ExpireBlocks:7 BridgeTransactionMin:8} Signature:pegout signature Hash:pegout hash} Name:pegout}"
+		const expectedLog = "INSERT interaction with db: {Signed:{Value:{TimeForDeposit:1 ExpireTime:2 PenaltyFee:3 FixedFee:4 FeePercentage:4.5 MaxValue:5 MinValue:6 ExpireBlocks:7 BridgeTransactionMin:8 FeeTiers:[] FeeSurcharges:<nil>} Signature:pegout signature Hash:pegout hash} Name:pegout}"
 		client, collection := getClientAndCollectionMocks(mongo.LiquidityProviderCollection)
 		repo := mongo.NewLiquidityProviderRepository(mongo.NewConnection(client, time.Duration(1)))
 		collection.On("ReplaceOne", mock.Anything, filter, mongo.StoredConfiguration[liquidity_provider.PegoutConfiguration]{
@@ -274,7 +274,7 @@ func TestLpMongoRepository_UpsertGeneralConfiguration(t *testing.T) {
 	configName := mongo.ConfigurationName("general")
 	filter := bson.D{primitive.E{Key: "name", Value: configName}}
 	t.Run("general configuration upserted successfully", func(t *testing.T) {
-		const expectedLog = "INSERT interaction with db: {Signed:{Value:{RskConfirmations:map[1:2 3:4] BtcConfirmations:map[5:6 7:8] PublicLiquidityCheck:false} Signature:general signature Hash:general hash} Name:general}"
+		const expectedLog = "INSERT interaction with db: {Signed:{Value:{RskConfirmations:map[1:2 3:4] BtcConfirmations:map[5:6 7:8] PublicLiquidityCheck:false LiquidityThresholds:<nil>} Signature:general signature Hash:general hash} Name:general}"
 		client, collection := getClientAndCollectionMocks(mongo.LiquidityProviderCollection)
 		repo := mongo.NewLiquidityProviderRepository(mongo.NewConnection(client, time.Duration(1)))
 		collection.On("ReplaceOne", mock.Anything, filter, mongo.StoredConfiguration[liquidity_provider.GeneralConfiguration]{
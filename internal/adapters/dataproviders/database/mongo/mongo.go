@@ -44,6 +44,13 @@ func createIndexes(ctx context.Context, db *mongo.Database) error {
 		{collection: DepositEventsCollection, field: "tx_hash"},
 		{collection: TrustedAccountCollection, field: "address"},
 		{collection: BatchPegOutEventsCollection, field: "transaction_hash"},
+		{collection: EventLogCollection, field: "sequence"},
+		{collection: WebhookCollection, field: "id"},
+		{collection: RateLimitCollection, field: "key"},
+		{collection: LiquidityHoldCollection, field: "quote_hash"},
+		{collection: ReportSnapshotCollection, field: "id"},
+		{collection: AssetSnapshotCollection, field: "timestamp"},
+		{collection: PartnerAuthenticationCollection, field: "id"},
 	}
 	for _, idx := range indexes {
 		if err := createUniqueIndex(ctx, db, idx.collection, idx.field); err != nil {
@@ -51,17 +58,69 @@ func createIndexes(ctx context.Context, db *mongo.Database) error {
 		}
 		log.Infof("Created unique index on %s.%s", idx.collection, idx.field)
 	}
+	if err := createUniqueIndex(ctx, db, WebhookCollection, "quote_hash", "url"); err != nil {
+		return fmt.Errorf("error creating unique index on %s: %w", WebhookCollection, err)
+	}
+
+	queryIndexes := []struct {
+		collection string
+		keys       bson.D
+	}{
+		{collection: AlertHistoryCollection, keys: bson.D{{Key: "dedup_key", Value: 1}, {Key: "timestamp", Value: -1}}},
+		{collection: EventLogCollection, keys: bson.D{{Key: "event_id", Value: 1}, {Key: "sequence", Value: 1}}},
+		{collection: EventOffsetsCollection, keys: bson.D{{Key: "subscriber", Value: 1}, {Key: "event_id", Value: 1}}},
+		// the reports filter the quotes by date and join them with their retained quotes and penalizations
+		{collection: PeginQuoteCollection, keys: bson.D{{Key: "agreement_timestamp", Value: 1}}},
+		{collection: PegoutQuoteCollection, keys: bson.D{{Key: "agreement_timestamp", Value: 1}}},
+		{collection: RetainedPeginQuoteCollection, keys: bson.D{{Key: "quote_hash", Value: 1}}},
+		{collection: RetainedPegoutQuoteCollection, keys: bson.D{{Key: "quote_hash", Value: 1}}},
+		{collection: PenalizedEventCollection, keys: bson.D{{Key: "quote_hash", Value: 1}}},
+	}
+	for _, idx := range queryIndexes {
+		if err := createIndex(ctx, db, idx.collection, idx.keys); err != nil {
+			return fmt.Errorf("error creating index on %s: %w", idx.collection, err)
+		}
+		log.Infof("Created index on %s", idx.collection)
+	}
+
+	for _, collection := range []string{RateLimitCollection, PartnerAuthenticationCollection, AssetSnapshotCollection} {
+		if err := createTtlIndex(ctx, db, collection, "expire_at"); err != nil {
+			return fmt.Errorf("error creating TTL index on %s.expire_at: %w", collection, err)
+		}
+	}
 
 	return nil
 }
 
-func createUniqueIndex(ctx context.Context, db *mongo.Database, collectionName, field string) error {
+func createUniqueIndex(ctx context.Context, db *mongo.Database, collectionName string, fields ...string) error {
+	keys := make(bson.D, 0, len(fields))
+	for _, field := range fields {
+		keys = append(keys, bson.E{Key: field, Value: 1})
+	}
 	_, err := db.Collection(collectionName).Indexes().CreateOne(
 		ctx,
 		mongo.IndexModel{
-			Keys:    bson.D{{Key: field, Value: 1}},
+			Keys:    keys,
 			Options: options.Index().SetUnique(true),
 		},
 	)
 	return err
 }
+
+// createIndex creates a non unique index to support the queries that filter or sort by the keys
+func createIndex(ctx context.Context, db *mongo.Database, collectionName string, keys bson.D) error {
+	_, err := db.Collection(collectionName).Indexes().CreateOne(ctx, mongo.IndexModel{Keys: keys})
+	return err
+}
+
+// createTtlIndex creates an index that removes the documents once the date in the field is reached
+func createTtlIndex(ctx context.Context, db *mongo.Database, collectionName, field string) error {
+	_, err := db.Collection(collectionName).Indexes().CreateOne(
+		ctx,
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: field, Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	)
+	return err
+}
@@ -546,3 +546,15 @@ func (repo *peginMongoRepository) GetQuotesWithRetainedByStateAndDate(ctx contex
 	logDbInteraction(Read, len(result))
 	return result, nil
 }
+
+// GetAnalyticsBuckets aggregates the pegin quotes agreed in the query date range by period and trusted account owner.
+// The gas spent is the gas used by the callForUser and registerPegin transactions, pegins don't have BTC fees
+func (repo *peginMongoRepository) GetAnalyticsBuckets(ctx context.Context, query quote.AnalyticsQuery[quote.PeginState]) ([]quote.AnalyticsBucket, error) {
+	return getAnalyticsBuckets(ctx, repo.conn, PeginQuoteCollection, analyticsPipelineParams{
+		retainedCollection: RetainedPeginQuoteCollection,
+		gasTerms: []analyticsGasTerm{
+			{gasUsedField: "call_for_user_gas_used", gasPriceField: "call_for_user_gas_price"},
+			{gasUsedField: "register_pegin_gas_used", gasPriceField: "register_pegin_gas_price"},
+		},
+	}, query)
+}
@@ -681,3 +681,17 @@ func (repo *pegoutMongoRepository) GetQuotesWithRetainedByStateAndDate(ctx conte
 	logDbInteraction(Read, len(result))
 	return result, nil
 }
+
+// GetAnalyticsBuckets aggregates the pegout quotes agreed in the query date range by period and trusted account owner.
+// The gas spent is the gas used by the refundPegout and bridge refund transactions, the fee of the BTC transaction
+// sent by the LP is aggregated separately
+func (repo *pegoutMongoRepository) GetAnalyticsBuckets(ctx context.Context, query quote.AnalyticsQuery[quote.PegoutState]) ([]quote.AnalyticsBucket, error) {
+	return getAnalyticsBuckets(ctx, repo.conn, PegoutQuoteCollection, analyticsPipelineParams{
+		retainedCollection: RetainedPegoutQuoteCollection,
+		gasTerms: []analyticsGasTerm{
+			{gasUsedField: "refund_pegout_gas_used", gasPriceField: "refund_pegout_gas_price"},
+			{gasUsedField: "bridge_refund_gas_used", gasPriceField: "bridge_refund_gas_price"},
+		},
+		btcFeeField: "send_pegout_btc_fee",
+	}, query)
+}
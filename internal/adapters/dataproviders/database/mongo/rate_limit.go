@@ -0,0 +1,69 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const RateLimitCollection = "rate_limits"
+
+const millisecondsPerSecond = 1000
+
+type StoredTokenBucket struct {
+	entities.TokenBucket `bson:",inline"`
+	Key                  string    `bson:"key"`
+	Allowed              bool      `bson:"allowed"`
+	ExpireAt             time.Time `bson:"expire_at"`
+}
+
+// rateLimitMongoRepository keeps the token buckets in MongoDB so the limits are shared between all the
+// server instances. The buckets are updated with a single atomic operation to avoid race conditions
+// between instances, and they're removed by a TTL index once they're full again.
+type rateLimitMongoRepository struct {
+	conn *Connection
+}
+
+func NewRateLimitRepository(conn *Connection) entities.RateLimiter {
+	return &rateLimitMongoRepository{conn: conn}
+}
+
+func (repo *rateLimitMongoRepository) Take(ctx context.Context, key string, limit entities.RateLimit, cost uint64) (entities.RateLimitResult, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(RateLimitCollection)
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	result := collection.FindOneAndUpdate(dbCtx, bson.M{"key": key}, takeTokenPipeline(limit, now, cost), opts)
+	var bucket StoredTokenBucket
+	if err := result.Decode(&bucket); err != nil {
+		return entities.RateLimitResult{}, err
+	}
+	if bucket.Allowed {
+		return entities.RateLimitResult{Allowed: true}, nil
+	}
+	return entities.RateLimitResult{Allowed: false, RetryAfter: limit.RetryAfter(bucket.Tokens, cost)}, nil
+}
+
+// takeTokenPipeline is the equivalent of entities.RateLimit.Take expressed as an update pipeline
+func takeTokenPipeline(limit entities.RateLimit, now time.Time, cost uint64) mongo.Pipeline {
+	burst := float64(limit.Burst)
+	required := limit.TokenCost(cost)
+	elapsedMilliseconds := bson.M{"$max": bson.A{0, bson.M{"$subtract": bson.A{now, bson.M{"$ifNull": bson.A{"$updated_at", now}}}}}}
+	refill := bson.M{"$multiply": bson.A{bson.M{"$divide": bson.A{elapsedMilliseconds, millisecondsPerSecond}}, limit.RefillRate()}}
+	return mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"tokens":     bson.M{"$min": bson.A{burst, bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$tokens", burst}}, refill}}}},
+			"updated_at": now,
+		}}},
+		{{Key: "$set", Value: bson.M{"allowed": bson.M{"$gte": bson.A{"$tokens", required}}}}},
+		{{Key: "$set", Value: bson.M{
+			"tokens":    bson.M{"$cond": bson.A{"$allowed", bson.M{"$subtract": bson.A{"$tokens", required}}, "$tokens"}},
+			"expire_at": now.Add(limit.FillTime()),
+		}}},
+	}
+}
@@ -0,0 +1,70 @@
+package mongo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/database/mongo"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	mongoDb "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestRateLimitMongoRepository_Take(t *testing.T) {
+	const key = "quote:ip:127.0.0.1"
+	limit := entities.RateLimit{PerMinute: 6, Burst: 5}
+	filter := bson.M{"key": key}
+	upsertOptions := mock.MatchedBy(func(opts *options.FindOneAndUpdateOptions) bool {
+		return *opts.Upsert && *opts.ReturnDocument == options.After
+	})
+	pipeline := mock.MatchedBy(func(pipeline mongoDb.Pipeline) bool {
+		return len(pipeline) == 3
+	})
+	t.Run("should allow the request if the bucket has tokens", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.RateLimitCollection)
+		stored := mongo.StoredTokenBucket{Key: key, Allowed: true, TokenBucket: entities.TokenBucket{Tokens: 3, UpdatedAt: time.Now()}}
+		collection.On("FindOneAndUpdate", mock.Anything, filter, pipeline, upsertOptions).
+			Return(mongoDb.NewSingleResultFromDocument(stored, nil, nil)).Once()
+		repo := mongo.NewRateLimitRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.Take(context.Background(), key, limit, 1)
+		require.NoError(t, err)
+		assert.Equal(t, entities.RateLimitResult{Allowed: true}, result)
+		collection.AssertExpectations(t)
+	})
+	t.Run("should reject the request if the bucket is empty", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.RateLimitCollection)
+		stored := mongo.StoredTokenBucket{Key: key, Allowed: false, TokenBucket: entities.TokenBucket{Tokens: 0.5, UpdatedAt: time.Now()}}
+		collection.On("FindOneAndUpdate", mock.Anything, filter, pipeline, upsertOptions).
+			Return(mongoDb.NewSingleResultFromDocument(stored, nil, nil)).Once()
+		repo := mongo.NewRateLimitRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.Take(context.Background(), key, limit, 1)
+		require.NoError(t, err)
+		assert.Equal(t, entities.RateLimitResult{Allowed: false, RetryAfter: 5 * time.Second}, result)
+		collection.AssertExpectations(t)
+	})
+	t.Run("should calculate the retry time with the cost of the request", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.RateLimitCollection)
+		stored := mongo.StoredTokenBucket{Key: key, Allowed: false, TokenBucket: entities.TokenBucket{Tokens: 1, UpdatedAt: time.Now()}}
+		collection.On("FindOneAndUpdate", mock.Anything, filter, pipeline, upsertOptions).
+			Return(mongoDb.NewSingleResultFromDocument(stored, nil, nil)).Once()
+		repo := mongo.NewRateLimitRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.Take(context.Background(), key, limit, 3)
+		require.NoError(t, err)
+		assert.Equal(t, entities.RateLimitResult{Allowed: false, RetryAfter: 20 * time.Second}, result)
+		collection.AssertExpectations(t)
+	})
+	t.Run("should return db errors", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.RateLimitCollection)
+		collection.On("FindOneAndUpdate", mock.Anything, filter, pipeline, upsertOptions).
+			Return(mongoDb.NewSingleResultFromDocument(mongo.StoredTokenBucket{}, assert.AnError, nil)).Once()
+		repo := mongo.NewRateLimitRepository(mongo.NewConnection(client, time.Duration(1)))
+		_, err := repo.Take(context.Background(), key, limit, 1)
+		require.ErrorIs(t, err, assert.AnError)
+		collection.AssertExpectations(t)
+	})
+}
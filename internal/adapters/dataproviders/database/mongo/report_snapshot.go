@@ -0,0 +1,129 @@
+package mongo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const ReportSnapshotCollection = "reportSnapshots"
+
+// storedSnapshot keeps the content of the snapshot as a JSON string so the amounts are stored exactly as they
+// were reported and the document is still readable from the database
+type storedSnapshot struct {
+	Id          string               `bson:"id"`
+	Schedule    reporting.Schedule   `bson:"schedule"`
+	ReportType  reporting.ReportType `bson:"report_type"`
+	PeriodStart time.Time            `bson:"period_start"`
+	PeriodEnd   time.Time            `bson:"period_end"`
+	CreatedAt   time.Time            `bson:"created_at"`
+	Content     string               `bson:"content"`
+	DeliveredAt *time.Time           `bson:"delivered_at,omitempty"`
+}
+
+type reportSnapshotMongoRepository struct {
+	conn *Connection
+}
+
+func NewReportSnapshotRepository(conn *Connection) reporting.SnapshotRepository {
+	return &reportSnapshotMongoRepository{conn: conn}
+}
+
+func (repo *reportSnapshotMongoRepository) InsertSnapshot(ctx context.Context, snapshot reporting.Snapshot) error {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(ReportSnapshotCollection)
+	stored := storedSnapshot{
+		Id:          snapshot.Id,
+		Schedule:    snapshot.Schedule,
+		ReportType:  snapshot.ReportType,
+		PeriodStart: snapshot.PeriodStart,
+		PeriodEnd:   snapshot.PeriodEnd,
+		CreatedAt:   snapshot.CreatedAt,
+		Content:     string(snapshot.Content),
+		DeliveredAt: snapshot.DeliveredAt,
+	}
+	_, err := collection.InsertOne(dbCtx, stored)
+	if mongo.IsDuplicateKeyError(err) {
+		return reporting.DuplicateSnapshotError
+	} else if err != nil {
+		return err
+	}
+	logDbInteraction(Insert, snapshot.Id)
+	return nil
+}
+
+func (repo *reportSnapshotMongoRepository) GetSnapshots(
+	ctx context.Context,
+	reportType reporting.ReportType,
+	startDate, endDate time.Time,
+) ([]reporting.Snapshot, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	filter := bson.D{
+		{Key: "report_type", Value: reportType},
+		{Key: "period_start", Value: bson.D{{Key: "$gte", Value: startDate}, {Key: "$lte", Value: endDate}}},
+	}
+	return repo.findSnapshots(dbCtx, filter)
+}
+
+func (repo *reportSnapshotMongoRepository) GetUndeliveredSnapshots(ctx context.Context, createdAfter time.Time) ([]reporting.Snapshot, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	filter := bson.D{
+		{Key: "delivered_at", Value: nil},
+		{Key: "created_at", Value: bson.D{{Key: "$gt", Value: createdAfter}}},
+	}
+	return repo.findSnapshots(dbCtx, filter)
+}
+
+func (repo *reportSnapshotMongoRepository) MarkSnapshotsDelivered(ctx context.Context, ids []string, deliveredAt time.Time) error {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(ReportSnapshotCollection)
+	filter := bson.D{{Key: "id", Value: bson.D{{Key: "$in", Value: ids}}}}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "delivered_at", Value: deliveredAt}}}}
+	if _, err := collection.UpdateMany(dbCtx, filter, update); err != nil {
+		return err
+	}
+	logDbInteraction(Update, ids)
+	return nil
+}
+
+func (repo *reportSnapshotMongoRepository) findSnapshots(ctx context.Context, filter bson.D) ([]reporting.Snapshot, error) {
+	collection := repo.conn.Collection(ReportSnapshotCollection)
+	findOpts := options.Find().SetSort(bson.D{{Key: "period_start", Value: SortAscending}, {Key: "schedule", Value: SortAscending}})
+	cursor, err := collection.Find(ctx, filter, findOpts)
+	if err != nil {
+		return nil, err
+	}
+	stored := make([]storedSnapshot, 0)
+	if err = cursor.All(ctx, &stored); err != nil {
+		return nil, err
+	}
+	result := make([]reporting.Snapshot, 0, len(stored))
+	for _, snapshot := range stored {
+		var deliveredAt *time.Time
+		if snapshot.DeliveredAt != nil {
+			utc := snapshot.DeliveredAt.UTC()
+			deliveredAt = &utc
+		}
+		result = append(result, reporting.Snapshot{
+			Id:          snapshot.Id,
+			Schedule:    snapshot.Schedule,
+			ReportType:  snapshot.ReportType,
+			PeriodStart: snapshot.PeriodStart.UTC(),
+			PeriodEnd:   snapshot.PeriodEnd.UTC(),
+			CreatedAt:   snapshot.CreatedAt.UTC(),
+			Content:     json.RawMessage(snapshot.Content),
+			DeliveredAt: deliveredAt,
+		})
+	}
+	logDbInteraction(Read, len(result))
+	return result, nil
+}
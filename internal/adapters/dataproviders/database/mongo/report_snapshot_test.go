@@ -0,0 +1,173 @@
+package mongo_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/database/mongo"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	mongoDb "go.mongodb.org/mongo-driver/mongo"
+)
+
+var testSnapshot = reporting.Snapshot{
+	Id:          "daily:revenue:2024-03-12",
+	Schedule:    reporting.ScheduleDaily,
+	ReportType:  reporting.ReportTypeRevenue,
+	PeriodStart: time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC),
+	PeriodEnd:   time.Date(2024, 3, 12, 23, 59, 59, 999000000, time.UTC),
+	CreatedAt:   time.Date(2024, 3, 13, 0, 10, 0, 0, time.UTC),
+	Content:     json.RawMessage(`{"totalQuoteCallFees":"1000000000000000000000"}`),
+}
+
+func TestReportSnapshotMongoRepository_InsertSnapshot(t *testing.T) {
+	matchStored := mock.MatchedBy(func(document any) bool {
+		raw, err := bson.Marshal(document)
+		require.NoError(t, err)
+		var stored bson.M
+		require.NoError(t, bson.Unmarshal(raw, &stored))
+		return stored["id"] == testSnapshot.Id && stored["report_type"] == string(reporting.ReportTypeRevenue) &&
+			stored["schedule"] == string(reporting.ScheduleDaily) && stored["content"] == string(testSnapshot.Content)
+	})
+	t.Run("Insert snapshot successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.ReportSnapshotCollection)
+		collection.On("InsertOne", mock.Anything, matchStored).Return(nil, nil).Once()
+		repo := mongo.NewReportSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Insert)()
+		err := repo.InsertSnapshot(context.Background(), testSnapshot)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+	})
+	t.Run("Return error when the snapshot already exists", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.ReportSnapshotCollection)
+		duplicateError := mongoDb.WriteException{WriteErrors: []mongoDb.WriteError{{Code: 11000, Message: "duplicate key"}}}
+		collection.On("InsertOne", mock.Anything, matchStored).Return(nil, duplicateError).Once()
+		repo := mongo.NewReportSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.InsertSnapshot(context.Background(), testSnapshot)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, reporting.DuplicateSnapshotError)
+	})
+	t.Run("Db error inserting snapshot", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.ReportSnapshotCollection)
+		collection.On("InsertOne", mock.Anything, matchStored).Return(nil, assert.AnError).Once()
+		repo := mongo.NewReportSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.InsertSnapshot(context.Background(), testSnapshot)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestReportSnapshotMongoRepository_GetSnapshots(t *testing.T) {
+	log.SetLevel(log.DebugLevel)
+	startDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 3, 31, 23, 59, 59, 0, time.UTC)
+	filter := bson.D{
+		{Key: "report_type", Value: reporting.ReportTypeRevenue},
+		{Key: "period_start", Value: bson.D{{Key: "$gte", Value: startDate}, {Key: "$lte", Value: endDate}}},
+	}
+	t.Run("Get snapshots successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.ReportSnapshotCollection)
+		stored := bson.M{
+			"id":           testSnapshot.Id,
+			"schedule":     testSnapshot.Schedule,
+			"report_type":  testSnapshot.ReportType,
+			"period_start": testSnapshot.PeriodStart,
+			"period_end":   testSnapshot.PeriodEnd,
+			"created_at":   testSnapshot.CreatedAt,
+			"content":      string(testSnapshot.Content),
+		}
+		collection.On("Find", mock.Anything, filter, mock.Anything).
+			Return(mongoDb.NewCursorFromDocuments([]any{stored}, nil, nil)).Once()
+		repo := mongo.NewReportSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Read)()
+		result, err := repo.GetSnapshots(context.Background(), reporting.ReportTypeRevenue, startDate, endDate)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Equal(t, []reporting.Snapshot{testSnapshot}, result)
+	})
+	t.Run("Return empty list when there are no snapshots", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.ReportSnapshotCollection)
+		collection.On("Find", mock.Anything, filter, mock.Anything).
+			Return(mongoDb.NewCursorFromDocuments([]any{}, nil, nil)).Once()
+		repo := mongo.NewReportSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetSnapshots(context.Background(), reporting.ReportTypeRevenue, startDate, endDate)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+	t.Run("Db error getting snapshots", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.ReportSnapshotCollection)
+		collection.On("Find", mock.Anything, filter, mock.Anything).Return(nil, assert.AnError).Once()
+		repo := mongo.NewReportSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetSnapshots(context.Background(), reporting.ReportTypeRevenue, startDate, endDate)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, result)
+	})
+}
+
+func TestReportSnapshotMongoRepository_GetUndeliveredSnapshots(t *testing.T) {
+	createdAfter := time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC)
+	filter := bson.D{
+		{Key: "delivered_at", Value: nil},
+		{Key: "created_at", Value: bson.D{{Key: "$gt", Value: createdAfter}}},
+	}
+	t.Run("Get undelivered snapshots successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.ReportSnapshotCollection)
+		stored := bson.M{
+			"id":           testSnapshot.Id,
+			"schedule":     testSnapshot.Schedule,
+			"report_type":  testSnapshot.ReportType,
+			"period_start": testSnapshot.PeriodStart,
+			"period_end":   testSnapshot.PeriodEnd,
+			"created_at":   testSnapshot.CreatedAt,
+			"content":      string(testSnapshot.Content),
+		}
+		collection.On("Find", mock.Anything, filter, mock.Anything).
+			Return(mongoDb.NewCursorFromDocuments([]any{stored}, nil, nil)).Once()
+		repo := mongo.NewReportSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Read)()
+		result, err := repo.GetUndeliveredSnapshots(context.Background(), createdAfter)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Equal(t, []reporting.Snapshot{testSnapshot}, result)
+	})
+	t.Run("Db error getting undelivered snapshots", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.ReportSnapshotCollection)
+		collection.On("Find", mock.Anything, filter, mock.Anything).Return(nil, assert.AnError).Once()
+		repo := mongo.NewReportSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetUndeliveredSnapshots(context.Background(), createdAfter)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, result)
+	})
+}
+
+func TestReportSnapshotMongoRepository_MarkSnapshotsDelivered(t *testing.T) {
+	deliveredAt := time.Date(2024, 3, 13, 0, 20, 0, 0, time.UTC)
+	ids := []string{testSnapshot.Id, "daily:assets:2024-03-12"}
+	filter := bson.D{{Key: "id", Value: bson.D{{Key: "$in", Value: ids}}}}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "delivered_at", Value: deliveredAt}}}}
+	t.Run("Mark snapshots as delivered successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.ReportSnapshotCollection)
+		collection.On("UpdateMany", mock.Anything, filter, update).Return(&mongoDb.UpdateResult{ModifiedCount: 2}, nil).Once()
+		repo := mongo.NewReportSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Update)()
+		err := repo.MarkSnapshotsDelivered(context.Background(), ids, deliveredAt)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+	})
+	t.Run("Db error marking snapshots as delivered", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.ReportSnapshotCollection)
+		collection.On("UpdateMany", mock.Anything, filter, update).Return(nil, assert.AnError).Once()
+		repo := mongo.NewReportSnapshotRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.MarkSnapshotsDelivered(context.Background(), ids, deliveredAt)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
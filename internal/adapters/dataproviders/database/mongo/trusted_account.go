@@ -3,6 +3,7 @@ package mongo
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
@@ -11,7 +12,16 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-const TrustedAccountCollection = "trustedAccounts"
+const (
+	TrustedAccountCollection        = "trustedAccounts"
+	PartnerAuthenticationCollection = "partnerAuthentications"
+)
+
+// usedPartnerAuthentication is removed by a TTL index once the authentication is out of its validity window
+type usedPartnerAuthentication struct {
+	Id       string    `bson:"id"`
+	ExpireAt time.Time `bson:"expire_at"`
+}
 
 type trustedAccountMongoRepository struct {
 	conn *Connection
@@ -106,3 +116,17 @@ func (repo *trustedAccountMongoRepository) DeleteTrustedAccount(ctx context.Cont
 	logDbInteraction(Delete, filter)
 	return nil
 }
+
+func (repo *trustedAccountMongoRepository) RegisterPartnerAuthentication(ctx context.Context, id string, expireAt time.Time) error {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(PartnerAuthenticationCollection)
+	_, err := collection.InsertOne(dbCtx, usedPartnerAuthentication{Id: id, ExpireAt: expireAt.UTC()})
+	if mongo.IsDuplicateKeyError(err) {
+		return liquidity_provider.UsedPartnerAuthError
+	} else if err != nil {
+		return err
+	}
+	logDbInteraction(Insert, id)
+	return nil
+}
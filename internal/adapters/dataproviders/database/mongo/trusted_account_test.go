@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	mongoDb "go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -33,7 +34,7 @@ var signedTestAccount = entities.Signed[liquidity_provider.TrustedAccountDetails
 func TestLpMongoRepository_GetTrustedAccount(t *testing.T) {
 	log.SetLevel(log.DebugLevel)
 	t.Run("trusted account found successfully", func(t *testing.T) {
-		const expectedLog = "READ interaction with db: &{Value:{Address:0x1234567890abcdef1234567890abcdef12345678 Name:Test Account BtcLockingCap:1000000000000000000 RbtcLockingCap:2000000000000000000} Signature:signature Hash:hash}"
+		const expectedLog = "READ interaction with db: &{Value:{Address:0x1234567890abcdef1234567890abcdef12345678 Name:Test Account BtcLockingCap:1000000000000000000 RbtcLockingCap:2000000000000000000 PeginTerms:<nil> PegoutTerms:<nil>} Signature:signature Hash:hash}"
 		client, collection := getClientAndCollectionMocks(mongo.TrustedAccountCollection)
 		repo := mongo.NewTrustedAccountRepository(mongo.NewConnection(client, time.Duration(1)))
 		filter := bson.M{"address": testAccount.Address}
@@ -70,7 +71,7 @@ func TestLpMongoRepository_GetTrustedAccount(t *testing.T) {
 func TestLpMongoRepository_GetAllTrustedAccounts(t *testing.T) {
 	log.SetLevel(log.DebugLevel)
 	t.Run("all trusted accounts found successfully", func(t *testing.T) {
-		const expectedLog = "READ interaction with db: [{Value:{Address:0x1234567890abcdef1234567890abcdef12345678 Name:Test Account BtcLockingCap:1000000000000000000 RbtcLockingCap:2000000000000000000} Signature:signature Hash:hash}]"
+		const expectedLog = "READ interaction with db: [{Value:{Address:0x1234567890abcdef1234567890abcdef12345678 Name:Test Account BtcLockingCap:1000000000000000000 RbtcLockingCap:2000000000000000000 PeginTerms:<nil> PegoutTerms:<nil>} Signature:signature Hash:hash}]"
 		client, collection := getClientAndCollectionMocks(mongo.TrustedAccountCollection)
 		repo := mongo.NewTrustedAccountRepository(mongo.NewConnection(client, time.Duration(1)))
 		signedAccounts := []entities.Signed[liquidity_provider.TrustedAccountDetails]{signedTestAccount}
@@ -106,7 +107,7 @@ func TestLpMongoRepository_GetAllTrustedAccounts(t *testing.T) {
 func TestLpMongoRepository_UpdateTrustedAccount(t *testing.T) {
 	log.SetLevel(log.DebugLevel)
 	t.Run("trusted account updated successfully", func(t *testing.T) {
-		const expectedLog = "UPDATE interaction with db: {Value:{Address:0x1234567890abcdef1234567890abcdef12345678 Name:Test Account BtcLockingCap:1000000000000000000 RbtcLockingCap:2000000000000000000} Signature:signature Hash:hash}"
+		const expectedLog = "UPDATE interaction with db: {Value:{Address:0x1234567890abcdef1234567890abcdef12345678 Name:Test Account BtcLockingCap:1000000000000000000 RbtcLockingCap:2000000000000000000 PeginTerms:<nil> PegoutTerms:<nil>} Signature:signature Hash:hash}"
 		client, collection := getClientAndCollectionMocks(mongo.TrustedAccountCollection)
 		repo := mongo.NewTrustedAccountRepository(mongo.NewConnection(client, time.Duration(1)))
 		collection.On("FindOne", mock.Anything, bson.M{"address": signedTestAccount.Value.Address}).
@@ -153,7 +154,7 @@ func TestLpMongoRepository_UpdateTrustedAccount(t *testing.T) {
 func TestLpMongoRepository_AddTrustedAccount(t *testing.T) {
 	log.SetLevel(log.DebugLevel)
 	t.Run("trusted account added successfully", func(t *testing.T) {
-		const expectedLog = "INSERT interaction with db: {Value:{Address:0x1234567890abcdef1234567890abcdef12345678 Name:Test Account BtcLockingCap:1000000000000000000 RbtcLockingCap:2000000000000000000} Signature:signature Hash:hash}"
+		const expectedLog = "INSERT interaction with db: {Value:{Address:0x1234567890abcdef1234567890abcdef12345678 Name:Test Account BtcLockingCap:1000000000000000000 RbtcLockingCap:2000000000000000000 PeginTerms:<nil> PegoutTerms:<nil>} Signature:signature Hash:hash}"
 		client, collection := getClientAndCollectionMocks(mongo.TrustedAccountCollection)
 		repo := mongo.NewTrustedAccountRepository(mongo.NewConnection(client, time.Duration(1)))
 		collection.On("FindOne", mock.Anything, bson.M{"address": signedTestAccount.Value.Address}).
@@ -224,3 +225,40 @@ func TestLpMongoRepository_DeleteTrustedAccount(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestLpMongoRepository_RegisterPartnerAuthentication(t *testing.T) {
+	const authId = "0x1234567890abcdef1234567890abcdef12345678:0102"
+	expireAt := time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC)
+	matchStored := mock.MatchedBy(func(document any) bool {
+		raw, err := bson.Marshal(document)
+		require.NoError(t, err)
+		var stored bson.M
+		require.NoError(t, bson.Unmarshal(raw, &stored))
+		storedExpiration, ok := stored["expire_at"].(primitive.DateTime)
+		return stored["id"] == authId && ok && storedExpiration.Time().Equal(expireAt)
+	})
+	t.Run("authentication registered successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.PartnerAuthenticationCollection)
+		collection.On("InsertOne", mock.Anything, matchStored).Return(nil, nil).Once()
+		repo := mongo.NewTrustedAccountRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Insert)()
+		err := repo.RegisterPartnerAuthentication(context.Background(), authId, expireAt)
+		require.NoError(t, err)
+		collection.AssertExpectations(t)
+	})
+	t.Run("authentication already used", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.PartnerAuthenticationCollection)
+		duplicateError := mongoDb.WriteException{WriteErrors: []mongoDb.WriteError{{Code: 11000, Message: "duplicate key"}}}
+		collection.On("InsertOne", mock.Anything, matchStored).Return(nil, duplicateError).Once()
+		repo := mongo.NewTrustedAccountRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.RegisterPartnerAuthentication(context.Background(), authId, expireAt)
+		require.ErrorIs(t, err, liquidity_provider.UsedPartnerAuthError)
+	})
+	t.Run("Db error registering authentication", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.PartnerAuthenticationCollection)
+		collection.On("InsertOne", mock.Anything, matchStored).Return(nil, assert.AnError).Once()
+		repo := mongo.NewTrustedAccountRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.RegisterPartnerAuthentication(context.Background(), authId, expireAt)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
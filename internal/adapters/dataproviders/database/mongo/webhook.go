@@ -0,0 +1,100 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const WebhookCollection = "webhooks"
+
+type webhookMongoRepository struct {
+	conn *Connection
+}
+
+func NewWebhookRepository(conn *Connection) webhook.WebhookRepository {
+	return &webhookMongoRepository{conn: conn}
+}
+
+// InsertWebhook upserts the webhook using the quote hash and the URL as key, so two concurrent registrations
+// of the same webhook can't create duplicates. The unique index on those fields rejects the upsert that loses
+// the race
+func (repo *webhookMongoRepository) InsertWebhook(ctx context.Context, hook webhook.Webhook) error {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(WebhookCollection)
+	filter := bson.M{"quote_hash": hook.QuoteHash, "url": hook.Url}
+	update := bson.M{"$setOnInsert": hook}
+	result, err := collection.UpdateOne(dbCtx, filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) || (err == nil && result.UpsertedCount == 0) {
+		return webhook.DuplicateWebhookError
+	} else if err != nil {
+		return err
+	}
+	// the webhook is not logged entirely to avoid leaking the secret in the logs
+	logDbInteraction(Insert, hook.Id)
+	return nil
+}
+
+func (repo *webhookMongoRepository) GetWebhooks(ctx context.Context, quoteHash string) ([]webhook.Webhook, error) {
+	filter := bson.M{"quote_hash": ""}
+	if quoteHash != "" {
+		filter = bson.M{"quote_hash": bson.M{"$in": []string{"", quoteHash}}}
+	}
+	return repo.findWebhooks(ctx, filter)
+}
+
+func (repo *webhookMongoRepository) GetGlobalWebhooks(ctx context.Context) ([]webhook.Webhook, error) {
+	return repo.findWebhooks(ctx, bson.M{"quote_hash": ""})
+}
+
+func (repo *webhookMongoRepository) findWebhooks(ctx context.Context, filter bson.M) ([]webhook.Webhook, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(WebhookCollection)
+	cursor, err := collection.Find(dbCtx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(dbCtx)
+	webhooks := make([]webhook.Webhook, 0)
+	if err = cursor.All(dbCtx, &webhooks); err != nil {
+		return nil, err
+	}
+	logDbInteraction(Read, len(webhooks))
+	return webhooks, nil
+}
+
+func (repo *webhookMongoRepository) DeleteWebhook(ctx context.Context, id string) error {
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(WebhookCollection)
+	filter := bson.M{"id": id}
+	result, err := collection.DeleteOne(dbCtx, filter)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return webhook.WebhookNotFoundError
+	}
+	logDbInteraction(Delete, filter)
+	return nil
+}
+
+func (repo *webhookMongoRepository) DeleteQuoteWebhooks(ctx context.Context, quoteHash string) error {
+	if quoteHash == "" {
+		return nil
+	}
+	dbCtx, cancel := context.WithTimeout(ctx, repo.conn.timeout)
+	defer cancel()
+	collection := repo.conn.Collection(WebhookCollection)
+	filter := bson.M{"quote_hash": quoteHash}
+	if _, err := collection.DeleteMany(dbCtx, filter); err != nil {
+		return err
+	}
+	logDbInteraction(Delete, filter)
+	return nil
+}
@@ -0,0 +1,183 @@
+package mongo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/database/mongo"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	mongoDb "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var testWebhook = webhook.Webhook{
+	Id:        "0a1b2c3d",
+	Url:       "https://integrator.example.com/callback",
+	QuoteHash: "0x0102",
+	Secret:    "secret",
+	CreatedAt: time.Unix(1700000000, 0).UTC(),
+}
+
+func TestWebhookMongoRepository_InsertWebhook(t *testing.T) {
+	filter := bson.M{"quote_hash": testWebhook.QuoteHash, "url": testWebhook.Url}
+	update := bson.M{"$setOnInsert": testWebhook}
+	upsert := options.Update().SetUpsert(true)
+	t.Run("Insert webhook successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.WebhookCollection)
+		collection.On("UpdateOne", mock.Anything, filter, update, upsert).Return(&mongoDb.UpdateResult{UpsertedCount: 1}, nil).Once()
+		repo := mongo.NewWebhookRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Insert)()
+		err := repo.InsertWebhook(context.Background(), testWebhook)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+	})
+	t.Run("Return error when webhook is already registered", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.WebhookCollection)
+		collection.On("UpdateOne", mock.Anything, filter, update, upsert).Return(&mongoDb.UpdateResult{MatchedCount: 1}, nil).Once()
+		repo := mongo.NewWebhookRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.InsertWebhook(context.Background(), testWebhook)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, webhook.DuplicateWebhookError)
+	})
+	t.Run("Return error when a concurrent registration inserted the webhook", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.WebhookCollection)
+		duplicateKeyError := mongoDb.WriteException{WriteErrors: []mongoDb.WriteError{{Code: 11000, Message: "E11000 duplicate key error"}}}
+		collection.On("UpdateOne", mock.Anything, filter, update, upsert).Return(nil, duplicateKeyError).Once()
+		repo := mongo.NewWebhookRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.InsertWebhook(context.Background(), testWebhook)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, webhook.DuplicateWebhookError)
+	})
+	t.Run("Db error inserting webhook", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.WebhookCollection)
+		collection.On("UpdateOne", mock.Anything, filter, update, upsert).Return(nil, assert.AnError).Once()
+		repo := mongo.NewWebhookRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.InsertWebhook(context.Background(), testWebhook)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestWebhookMongoRepository_GetWebhooks(t *testing.T) {
+	globalWebhook := webhook.Webhook{Id: "global", Url: "https://integrator.example.com/all", CreatedAt: time.Unix(1700000000, 0).UTC()}
+	t.Run("Get quote and global webhooks", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.WebhookCollection)
+		filter := bson.M{"quote_hash": bson.M{"$in": []string{"", testWebhook.QuoteHash}}}
+		collection.On("Find", mock.Anything, filter).
+			Return(mongoDb.NewCursorFromDocuments([]any{globalWebhook, testWebhook}, nil, nil)).Once()
+		repo := mongo.NewWebhookRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetWebhooks(context.Background(), testWebhook.QuoteHash)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Equal(t, []webhook.Webhook{globalWebhook, testWebhook}, result)
+	})
+	t.Run("Get only global webhooks when there is no quote hash", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.WebhookCollection)
+		collection.On("Find", mock.Anything, bson.M{"quote_hash": ""}).
+			Return(mongoDb.NewCursorFromDocuments([]any{globalWebhook}, nil, nil)).Once()
+		repo := mongo.NewWebhookRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetWebhooks(context.Background(), "")
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Equal(t, []webhook.Webhook{globalWebhook}, result)
+	})
+	t.Run("Db error getting webhooks", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.WebhookCollection)
+		collection.On("Find", mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		repo := mongo.NewWebhookRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetWebhooks(context.Background(), testWebhook.QuoteHash)
+		collection.AssertExpectations(t)
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestWebhookMongoRepository_GetGlobalWebhooks(t *testing.T) {
+	log.SetLevel(log.DebugLevel)
+	globalWebhook := webhook.Webhook{Id: "global", Url: "https://integrator.example.com/all", CreatedAt: time.Unix(1700000000, 0).UTC()}
+	t.Run("Get global webhooks successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.WebhookCollection)
+		collection.On("Find", mock.Anything, bson.M{"quote_hash": ""}).
+			Return(mongoDb.NewCursorFromDocuments([]any{globalWebhook}, nil, nil)).Once()
+		repo := mongo.NewWebhookRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Read)()
+		result, err := repo.GetGlobalWebhooks(context.Background())
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Equal(t, []webhook.Webhook{globalWebhook}, result)
+	})
+	t.Run("Db error getting global webhooks", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.WebhookCollection)
+		collection.On("Find", mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		repo := mongo.NewWebhookRepository(mongo.NewConnection(client, time.Duration(1)))
+		result, err := repo.GetGlobalWebhooks(context.Background())
+		collection.AssertExpectations(t)
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestWebhookMongoRepository_DeleteWebhook(t *testing.T) {
+	log.SetLevel(log.DebugLevel)
+	filter := bson.M{"id": testWebhook.Id}
+	t.Run("Delete webhook successfully", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.WebhookCollection)
+		collection.On("DeleteOne", mock.Anything, filter).Return(&mongoDb.DeleteResult{DeletedCount: 1}, nil).Once()
+		repo := mongo.NewWebhookRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Delete)()
+		err := repo.DeleteWebhook(context.Background(), testWebhook.Id)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+	})
+	t.Run("Return error when webhook doesn't exist", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.WebhookCollection)
+		collection.On("DeleteOne", mock.Anything, filter).Return(&mongoDb.DeleteResult{DeletedCount: 0}, nil).Once()
+		repo := mongo.NewWebhookRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.DeleteWebhook(context.Background(), testWebhook.Id)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, webhook.WebhookNotFoundError)
+	})
+	t.Run("Db error deleting webhook", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.WebhookCollection)
+		collection.On("DeleteOne", mock.Anything, filter).Return(nil, assert.AnError).Once()
+		repo := mongo.NewWebhookRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.DeleteWebhook(context.Background(), testWebhook.Id)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestWebhookMongoRepository_DeleteQuoteWebhooks(t *testing.T) {
+	log.SetLevel(log.DebugLevel)
+	filter := bson.M{"quote_hash": testWebhook.QuoteHash}
+	t.Run("Delete the webhooks of the quote", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.WebhookCollection)
+		collection.On("DeleteMany", mock.Anything, filter).Return(&mongoDb.DeleteResult{DeletedCount: 2}, nil).Once()
+		repo := mongo.NewWebhookRepository(mongo.NewConnection(client, time.Duration(1)))
+		defer assertDbInteractionLog(t, mongo.Delete)()
+		err := repo.DeleteQuoteWebhooks(context.Background(), testWebhook.QuoteHash)
+		collection.AssertExpectations(t)
+		require.NoError(t, err)
+	})
+	t.Run("Don't delete the global webhooks", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.WebhookCollection)
+		repo := mongo.NewWebhookRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.DeleteQuoteWebhooks(context.Background(), "")
+		collection.AssertNotCalled(t, "DeleteMany", mock.Anything, mock.Anything)
+		require.NoError(t, err)
+	})
+	t.Run("Db error deleting the webhooks", func(t *testing.T) {
+		client, collection := getClientAndCollectionMocks(mongo.WebhookCollection)
+		collection.On("DeleteMany", mock.Anything, filter).Return(nil, assert.AnError).Once()
+		repo := mongo.NewWebhookRepository(mongo.NewConnection(client, time.Duration(1)))
+		err := repo.DeleteQuoteWebhooks(context.Background(), testWebhook.QuoteHash)
+		collection.AssertExpectations(t)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
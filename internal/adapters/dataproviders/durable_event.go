@@ -0,0 +1,399 @@
+package dataproviders
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/rootstock"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	durableEventsBatchSize  = 100
+	durableBusRetryInterval = 5 * time.Second
+	eventBaseField          = "Event"
+	eventErrorField         = "Error"
+)
+
+type eventDecoder func(record entities.EventRecord) (entities.Event, error)
+
+// durableEventDecoders contains the events persisted by the MongoEventBus. The events that are not
+// in this map are only delivered in memory, in the same way the LocalEventBus does.
+var durableEventDecoders = map[entities.EventId]eventDecoder{
+	quote.AcceptedPeginQuoteEventId:     decodeEvent[quote.AcceptedPeginQuoteEvent],
+	quote.CallForUserCompletedEventId:   decodeEvent[quote.CallForUserCompletedEvent],
+	quote.RegisterPeginCompletedEventId: decodeEvent[quote.RegisterPeginCompletedEvent],
+	quote.PeginStateUpdatedEventId:      decodeEvent[quote.PeginStateUpdatedEvent],
+	quote.AcceptedPegoutQuoteEventId:    decodeEvent[quote.AcceptedPegoutQuoteEvent],
+	quote.PegoutBtcSentEventId:          decodeEvent[quote.PegoutBtcSentToUserEvent],
+	quote.PegoutQuoteCompletedEventId:   decodeEvent[quote.PegoutQuoteCompletedEvent],
+	quote.PegoutStateUpdatedEventId:     decodeEvent[quote.PegoutStateUpdatedEvent],
+	rootstock.BatchPegOutUpdatedEventId: decodeEvent[rootstock.BatchPegOutUpdatedEvent],
+}
+
+// eventQueue is an unbounded FIFO queue, pushing to it never blocks. The signal channel receives
+// a value every time the queue is notified and is used to wake up the routine consuming it.
+type eventQueue struct {
+	mutex  sync.Mutex
+	events []entities.Event
+	signal chan struct{}
+}
+
+func newEventQueue() *eventQueue {
+	return &eventQueue{events: make([]entities.Event, 0), signal: make(chan struct{}, 1)}
+}
+
+func (queue *eventQueue) push(event entities.Event) {
+	queue.mutex.Lock()
+	queue.events = append(queue.events, event)
+	queue.mutex.Unlock()
+	queue.notify()
+}
+
+func (queue *eventQueue) notify() {
+	select {
+	case queue.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (queue *eventQueue) pop() []entities.Event {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	events := queue.events
+	queue.events = make([]entities.Event, 0)
+	return events
+}
+
+type eventSubscription struct {
+	queue   *eventQueue
+	channel chan entities.Event
+}
+
+type durableEventSubscription struct {
+	subscriber string
+	eventId    entities.EventId
+	// fallback holds the events that are delivered to the subscription without being persisted
+	fallback *eventQueue
+	channel  chan entities.Event
+}
+
+// MongoEventBus is an entities.DurableEventBus that stores the published events in an append-only
+// collection. Publish never blocks the caller, the events are queued and a background routine
+// persists them in order and delivers them to the subscribers. Each durable subscriber has its
+// offset stored after every delivery so it can resume from it after a restart.
+type MongoEventBus struct {
+	repository           entities.EventRepository
+	pending              *eventQueue
+	lastSequence         atomic.Uint64
+	subscriptionsMutex   sync.Mutex
+	subscriptions        map[entities.EventId][]*eventSubscription
+	durableSubscriptions map[entities.EventId][]*durableEventSubscription
+	closed               bool
+	ready                chan struct{}
+	done                 chan struct{}
+	routines             sync.WaitGroup
+}
+
+func NewMongoEventBus(repository entities.EventRepository) *MongoEventBus {
+	bus := &MongoEventBus{
+		repository:           repository,
+		pending:              newEventQueue(),
+		subscriptions:        make(map[entities.EventId][]*eventSubscription),
+		durableSubscriptions: make(map[entities.EventId][]*durableEventSubscription),
+		ready:                make(chan struct{}),
+		done:                 make(chan struct{}),
+	}
+	bus.routines.Add(1)
+	go bus.run()
+	return bus
+}
+
+func (bus *MongoEventBus) Publish(event entities.Event) {
+	bus.subscriptionsMutex.Lock()
+	closed := bus.closed
+	bus.subscriptionsMutex.Unlock()
+	if closed {
+		log.Error(closedBusMessage)
+		return
+	}
+	bus.pending.push(event)
+}
+
+func (bus *MongoEventBus) Subscribe(id entities.EventId) <-chan entities.Event {
+	bus.subscriptionsMutex.Lock()
+	defer bus.subscriptionsMutex.Unlock()
+	if bus.closed {
+		log.Error(closedBusMessage)
+		return nil
+	}
+	subscription := &eventSubscription{queue: newEventQueue(), channel: make(chan entities.Event, subscriptionBufferSize)}
+	bus.subscriptions[id] = append(bus.subscriptions[id], subscription)
+	bus.routines.Add(1)
+	go bus.runSubscription(subscription)
+	return subscription.channel
+}
+
+// SubscribeDurable returns an unbuffered channel, so the offset of the subscriber is only updated once the
+// subscriber has received the event. A subscriber without a stored offset starts from the events published
+// after it subscribed for the first time.
+func (bus *MongoEventBus) SubscribeDurable(subscriber string, id entities.EventId) <-chan entities.Event {
+	bus.subscriptionsMutex.Lock()
+	defer bus.subscriptionsMutex.Unlock()
+	if bus.closed {
+		log.Error(closedBusMessage)
+		return nil
+	}
+	subscription := &durableEventSubscription{
+		subscriber: subscriber,
+		eventId:    id,
+		fallback:   newEventQueue(),
+		channel:    make(chan entities.Event),
+	}
+	bus.durableSubscriptions[id] = append(bus.durableSubscriptions[id], subscription)
+	bus.routines.Add(1)
+	go bus.runDurableSubscription(subscription)
+	return subscription.channel
+}
+
+func (bus *MongoEventBus) Shutdown(closeChannel chan<- bool) {
+	bus.subscriptionsMutex.Lock()
+	if bus.closed {
+		bus.subscriptionsMutex.Unlock()
+		log.Error(closedBusMessage)
+		return
+	}
+	bus.closed = true
+	close(bus.done)
+	bus.subscriptionsMutex.Unlock()
+
+	bus.routines.Wait()
+	if unprocessed := len(bus.pending.pop()); unprocessed > 0 {
+		log.Warnf("Event bus shut down with %d unprocessed events", unprocessed)
+	}
+	closeChannel <- true
+	log.Debug("Event bus shut down")
+}
+
+func (bus *MongoEventBus) run() {
+	defer bus.routines.Done()
+	for {
+		lastSequence, err := bus.repository.GetLastSequence(context.Background())
+		if err == nil {
+			bus.lastSequence.Store(lastSequence)
+			break
+		}
+		log.Errorf("Error reading last event sequence: %v", err)
+		if !bus.wait(durableBusRetryInterval) {
+			return
+		}
+	}
+	close(bus.ready)
+	for {
+		select {
+		case <-bus.done:
+			return
+		case <-bus.pending.signal:
+			for _, event := range bus.pending.pop() {
+				bus.dispatch(event)
+			}
+		}
+	}
+}
+
+func (bus *MongoEventBus) dispatch(event entities.Event) {
+	persisted := bus.persist(event)
+	bus.subscriptionsMutex.Lock()
+	defer bus.subscriptionsMutex.Unlock()
+	for _, subscription := range bus.subscriptions[event.Id()] {
+		subscription.queue.push(event)
+	}
+	for _, subscription := range bus.durableSubscriptions[event.Id()] {
+		if persisted {
+			subscription.fallback.notify()
+		} else {
+			subscription.fallback.push(event)
+		}
+	}
+}
+
+func (bus *MongoEventBus) persist(event entities.Event) bool {
+	if _, ok := durableEventDecoders[event.Id()]; !ok {
+		return false
+	}
+	record, err := encodeEvent(bus.lastSequence.Load()+1, event)
+	if err != nil {
+		log.Errorf("Error encoding event %s: %v", event.Id(), err)
+		return false
+	}
+	if err = bus.repository.InsertEvent(context.Background(), record); err != nil {
+		log.Errorf("Error persisting event %s: %v", event.Id(), err)
+		return false
+	}
+	bus.lastSequence.Store(record.Sequence)
+	return true
+}
+
+func (bus *MongoEventBus) runSubscription(subscription *eventSubscription) {
+	defer bus.routines.Done()
+	defer close(subscription.channel)
+	for {
+		select {
+		case <-bus.done:
+			return
+		case <-subscription.queue.signal:
+			for _, event := range subscription.queue.pop() {
+				if !bus.deliver(subscription.channel, event) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (bus *MongoEventBus) runDurableSubscription(subscription *durableEventSubscription) {
+	defer bus.routines.Done()
+	defer close(subscription.channel)
+	select {
+	case <-bus.done:
+		return
+	case <-bus.ready:
+	}
+	offset, ok := bus.initialOffset(subscription)
+	if !ok {
+		return
+	}
+	for {
+		records, err := bus.repository.GetEvents(context.Background(), subscription.eventId, offset, durableEventsBatchSize)
+		if err != nil {
+			log.Errorf("Error reading %s events for subscriber %s: %v", subscription.eventId, subscription.subscriber, err)
+			if !bus.wait(durableBusRetryInterval) {
+				return
+			}
+			continue
+		}
+		for _, record := range records {
+			if !bus.deliverRecord(subscription, record) {
+				return
+			}
+			offset = record.Sequence
+		}
+		if len(records) == durableEventsBatchSize {
+			continue
+		}
+		for _, event := range subscription.fallback.pop() {
+			if !bus.deliver(subscription.channel, event) {
+				return
+			}
+		}
+		select {
+		case <-bus.done:
+			return
+		case <-subscription.fallback.signal:
+		}
+	}
+}
+
+func (bus *MongoEventBus) initialOffset(subscription *durableEventSubscription) (uint64, bool) {
+	for {
+		offset, err := bus.repository.GetSubscriberOffset(context.Background(), subscription.subscriber, subscription.eventId)
+		if err == nil && offset != 0 {
+			return offset, true
+		} else if err == nil {
+			return bus.lastSequence.Load(), true
+		}
+		log.Errorf("Error reading offset of subscriber %s: %v", subscription.subscriber, err)
+		if !bus.wait(durableBusRetryInterval) {
+			return 0, false
+		}
+	}
+}
+
+func (bus *MongoEventBus) deliverRecord(subscription *durableEventSubscription, record entities.EventRecord) bool {
+	event, err := durableEventDecoders[record.EventId](record)
+	if err != nil {
+		log.Errorf("Error decoding event %d, skipping it: %v", record.Sequence, err)
+	} else if !bus.deliver(subscription.channel, event) {
+		return false
+	}
+	err = bus.repository.UpsertSubscriberOffset(context.Background(), subscription.subscriber, subscription.eventId, record.Sequence)
+	if err != nil {
+		log.Errorf("Error updating offset of subscriber %s: %v", subscription.subscriber, err)
+	}
+	return true
+}
+
+func (bus *MongoEventBus) deliver(channel chan<- entities.Event, event entities.Event) bool {
+	select {
+	case channel <- event:
+		return true
+	case <-bus.done:
+		return false
+	}
+}
+
+func (bus *MongoEventBus) wait(duration time.Duration) bool {
+	select {
+	case <-bus.done:
+		return false
+	case <-time.After(duration):
+		return true
+	}
+}
+
+// encodeEvent serializes the event into an entities.EventRecord. The embedded entities.Event and the Error
+// fields can't be unmarshalled into interfaces, so they're stored separately in the record.
+func encodeEvent(sequence uint64, event entities.Event) (entities.EventRecord, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return entities.EventRecord{}, err
+	}
+	fields := make(map[string]json.RawMessage)
+	if err = json.Unmarshal(payload, &fields); err != nil {
+		return entities.EventRecord{}, err
+	}
+	delete(fields, eventBaseField)
+	delete(fields, eventErrorField)
+	if payload, err = json.Marshal(fields); err != nil {
+		return entities.EventRecord{}, err
+	}
+	record := entities.EventRecord{
+		Sequence:  sequence,
+		EventId:   event.Id(),
+		Timestamp: event.CreationTimestamp(),
+		Payload:   payload,
+	}
+	value := reflect.Indirect(reflect.ValueOf(event))
+	if value.Kind() != reflect.Struct {
+		return entities.EventRecord{}, fmt.Errorf("unsupported event type %T", event)
+	}
+	if errorField := value.FieldByName(eventErrorField); errorField.Kind() == reflect.Interface && !errorField.IsNil() {
+		if eventError, ok := errorField.Interface().(error); ok {
+			record.Error = eventError.Error()
+		}
+	}
+	return record, nil
+}
+
+func decodeEvent[T entities.Event](record entities.EventRecord) (entities.Event, error) {
+	var event T
+	if err := json.Unmarshal(record.Payload, &event); err != nil {
+		return nil, err
+	}
+	value := reflect.ValueOf(&event).Elem()
+	if baseField := value.FieldByName(eventBaseField); baseField.IsValid() {
+		baseField.Set(reflect.ValueOf(entities.BaseEvent{EventId: record.EventId, Timestamp: record.Timestamp}))
+	}
+	if errorField := value.FieldByName(eventErrorField); errorField.IsValid() && record.Error != "" {
+		errorField.Set(reflect.ValueOf(errors.New(record.Error)))
+	}
+	return event, nil
+}
@@ -0,0 +1,233 @@
+package dataproviders_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSubscriber   = "PeginBridgeWatcher"
+	eventWaitTimeout = time.Second
+)
+
+var testCallForUserEvent = quote.CallForUserCompletedEvent{
+	Event: entities.BaseEvent{
+		EventId:   quote.CallForUserCompletedEventId,
+		Timestamp: time.Unix(1700000000, 0).UTC(),
+	},
+	PeginQuote: quote.PeginQuote{
+		FedBtcAddress: "2N5muMepJizJE1gR7FbHJU6CD18V3BpNF9p",
+		Value:         entities.NewWei(500),
+		Nonce:         1234,
+	},
+	RetainedQuote: quote.RetainedPeginQuote{
+		QuoteHash: "0x0102",
+		State:     quote.PeginStateCallForUserFailed,
+	},
+	CreationData: quote.PeginCreationDataZeroValue(),
+	Error:        errors.New("call for user failed"),
+}
+
+func receiveEvent(t *testing.T, channel <-chan entities.Event) entities.Event {
+	select {
+	case event := <-channel:
+		return event
+	case <-time.After(eventWaitTimeout):
+		require.Fail(t, "Event not received")
+		return nil
+	}
+}
+
+func waitSignal(t *testing.T, channel <-chan struct{}) {
+	select {
+	case <-channel:
+	case <-time.After(eventWaitTimeout):
+		require.Fail(t, "Signal not received")
+	}
+}
+
+func notify(channel chan<- struct{}) {
+	select {
+	case channel <- struct{}{}:
+	default:
+	}
+}
+
+func shutdownBus(t *testing.T, bus entities.EventBus) {
+	closeChannel := make(chan bool, 1)
+	bus.Shutdown(closeChannel)
+	assert.True(t, <-closeChannel)
+}
+
+func TestMongoEventBus_Publish(t *testing.T) {
+	t.Run("Should not block the caller", func(t *testing.T) {
+		repository := &mocks.EventRepositoryMock{}
+		unblock := make(chan struct{})
+		repository.On("GetLastSequence", test.AnyCtx).Return(uint64(0), nil).Once()
+		repository.On("InsertEvent", test.AnyCtx, mock.Anything).Return(nil).Run(func(args mock.Arguments) { <-unblock })
+		bus := dataproviders.NewMongoEventBus(repository)
+		published := make(chan struct{})
+		go func() {
+			for i := 0; i < 100; i++ {
+				bus.Publish(testCallForUserEvent)
+			}
+			close(published)
+		}()
+		select {
+		case <-published:
+		case <-time.After(eventWaitTimeout):
+			assert.Fail(t, "Publish blocked the caller")
+		}
+		close(unblock)
+		shutdownBus(t, bus)
+	})
+	t.Run("Should persist durable events with increasing sequence", func(t *testing.T) {
+		repository := &mocks.EventRepositoryMock{}
+		records := make(chan entities.EventRecord, 2)
+		repository.On("GetLastSequence", test.AnyCtx).Return(uint64(10), nil).Once()
+		repository.On("InsertEvent", test.AnyCtx, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			records <- args.Get(1).(entities.EventRecord)
+		}).Twice()
+		bus := dataproviders.NewMongoEventBus(repository)
+		subscription := bus.Subscribe(quote.CallForUserCompletedEventId)
+		bus.Publish(testCallForUserEvent)
+		bus.Publish(testCallForUserEvent)
+		assert.Equal(t, testCallForUserEvent, receiveEvent(t, subscription))
+		assert.Equal(t, testCallForUserEvent, receiveEvent(t, subscription))
+
+		first, second := <-records, <-records
+		assert.Equal(t, uint64(11), first.Sequence)
+		assert.Equal(t, uint64(12), second.Sequence)
+		assert.Equal(t, quote.CallForUserCompletedEventId, first.EventId)
+		assert.True(t, testCallForUserEvent.CreationTimestamp().Equal(first.Timestamp))
+		assert.Equal(t, "call for user failed", first.Error)
+		fields := make(map[string]json.RawMessage)
+		require.NoError(t, json.Unmarshal(first.Payload, &fields))
+		assert.NotContains(t, fields, "Event")
+		assert.NotContains(t, fields, "Error")
+		assert.Contains(t, fields, "PeginQuote")
+		shutdownBus(t, bus)
+		repository.AssertExpectations(t)
+	})
+	t.Run("Should deliver non durable events only in memory", func(t *testing.T) {
+		repository := &mocks.EventRepositoryMock{}
+		repository.On("GetLastSequence", test.AnyCtx).Return(uint64(0), nil).Once()
+		bus := dataproviders.NewMongoEventBus(repository)
+		subscription := bus.Subscribe(testEventId)
+		event := testEvent{entities.NewBaseEvent(testEventId)}
+		bus.Publish(event)
+		assert.Equal(t, event, receiveEvent(t, subscription))
+		shutdownBus(t, bus)
+		repository.AssertNotCalled(t, "InsertEvent", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMongoEventBus_SubscribeDurable(t *testing.T) {
+	t.Run("Should resume from the stored offset", func(t *testing.T) {
+		repository := &mocks.EventRepositoryMock{}
+		records := make(chan entities.EventRecord, 1)
+		recorder := &mocks.EventRepositoryMock{}
+		recorder.On("GetLastSequence", test.AnyCtx).Return(uint64(3), nil).Once()
+		recorder.On("InsertEvent", test.AnyCtx, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			records <- args.Get(1).(entities.EventRecord)
+		}).Once()
+		recorderBus := dataproviders.NewMongoEventBus(recorder)
+		recorderBus.Publish(testCallForUserEvent)
+		record := <-records
+		shutdownBus(t, recorderBus)
+
+		repository.On("GetLastSequence", test.AnyCtx).Return(uint64(4), nil).Once()
+		repository.On("GetSubscriberOffset", test.AnyCtx, testSubscriber, quote.CallForUserCompletedEventId).Return(uint64(3), nil).Once()
+		repository.On("GetEvents", test.AnyCtx, quote.CallForUserCompletedEventId, uint64(3), int64(100)).Return([]entities.EventRecord{record}, nil).Once()
+		repository.On("GetEvents", test.AnyCtx, quote.CallForUserCompletedEventId, uint64(4), int64(100)).Return([]entities.EventRecord{}, nil).Maybe()
+		committed := make(chan struct{})
+		repository.On("UpsertSubscriberOffset", test.AnyCtx, testSubscriber, quote.CallForUserCompletedEventId, uint64(4)).
+			Return(nil).Run(func(args mock.Arguments) { close(committed) }).Once()
+		bus := dataproviders.NewMongoEventBus(repository)
+		subscription := bus.SubscribeDurable(testSubscriber, quote.CallForUserCompletedEventId)
+
+		received, ok := receiveEvent(t, subscription).(quote.CallForUserCompletedEvent)
+		require.True(t, ok)
+		assert.Equal(t, testCallForUserEvent.Id(), received.Id())
+		assert.True(t, testCallForUserEvent.CreationTimestamp().Equal(received.CreationTimestamp()))
+		assert.Equal(t, testCallForUserEvent.PeginQuote, received.PeginQuote)
+		assert.Equal(t, testCallForUserEvent.RetainedQuote, received.RetainedQuote)
+		assert.Equal(t, testCallForUserEvent.Error.Error(), received.Error.Error())
+		waitSignal(t, committed)
+		shutdownBus(t, bus)
+		repository.AssertExpectations(t)
+	})
+	t.Run("Should start from the last event for new subscribers", func(t *testing.T) {
+		repository := &mocks.EventRepositoryMock{}
+		subscribed := make(chan struct{})
+		repository.On("GetLastSequence", test.AnyCtx).Return(uint64(7), nil).Once()
+		repository.On("GetSubscriberOffset", test.AnyCtx, testSubscriber, quote.CallForUserCompletedEventId).
+			Return(uint64(0), nil).Run(func(args mock.Arguments) { close(subscribed) }).Once()
+		read := make(chan struct{}, 1)
+		repository.On("GetEvents", test.AnyCtx, quote.CallForUserCompletedEventId, uint64(7), int64(100)).
+			Return([]entities.EventRecord{}, nil).Run(func(args mock.Arguments) { notify(read) })
+		bus := dataproviders.NewMongoEventBus(repository)
+		_ = bus.SubscribeDurable(testSubscriber, quote.CallForUserCompletedEventId)
+		waitSignal(t, subscribed)
+		waitSignal(t, read)
+		shutdownBus(t, bus)
+		repository.AssertExpectations(t)
+	})
+	t.Run("Should deliver the events that couldn't be persisted", func(t *testing.T) {
+		repository := &mocks.EventRepositoryMock{}
+		subscribed := make(chan struct{})
+		repository.On("GetLastSequence", test.AnyCtx).Return(uint64(0), nil).Once()
+		repository.On("GetSubscriberOffset", test.AnyCtx, testSubscriber, quote.CallForUserCompletedEventId).
+			Return(uint64(0), nil).Run(func(args mock.Arguments) { close(subscribed) }).Once()
+		repository.On("GetEvents", test.AnyCtx, quote.CallForUserCompletedEventId, uint64(0), int64(100)).Return([]entities.EventRecord{}, nil)
+		repository.On("InsertEvent", test.AnyCtx, mock.Anything).Return(assert.AnError).Once()
+		bus := dataproviders.NewMongoEventBus(repository)
+		subscription := bus.SubscribeDurable(testSubscriber, quote.CallForUserCompletedEventId)
+		<-subscribed
+		bus.Publish(testCallForUserEvent)
+		assert.Equal(t, testCallForUserEvent, receiveEvent(t, subscription))
+		shutdownBus(t, bus)
+		repository.AssertNotCalled(t, "UpsertSubscriberOffset", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestMongoEventBus_Shutdown(t *testing.T) {
+	t.Run("Should close subscriptions and not allow to interact after closed", func(t *testing.T) {
+		const expectedBuff = "Trying to interact with closed bus"
+		repository := &mocks.EventRepositoryMock{}
+		repository.On("GetLastSequence", test.AnyCtx).Return(uint64(0), nil).Once()
+		repository.On("GetSubscriberOffset", test.AnyCtx, testSubscriber, testEventId).Return(uint64(0), nil).Maybe()
+		repository.On("GetEvents", test.AnyCtx, testEventId, uint64(0), int64(100)).Return([]entities.EventRecord{}, nil).Maybe()
+		bus := dataproviders.NewMongoEventBus(repository)
+		subscription := bus.Subscribe(testEventId)
+		durableSubscription := bus.SubscribeDurable(testSubscriber, testEventId)
+		shutdownBus(t, bus)
+		_, open := <-subscription
+		assert.False(t, open)
+		_, open = <-durableSubscription
+		assert.False(t, open)
+
+		previousOutput := log.StandardLogger().Out
+		buff := new(bytes.Buffer)
+		log.SetOutput(buff)
+		defer log.SetOutput(previousOutput)
+		assert.Nil(t, bus.Subscribe(testEventId))
+		assert.Nil(t, bus.SubscribeDurable(testSubscriber, testEventId))
+		bus.Publish(testEvent{entities.NewBaseEvent(testEventId)})
+		bus.Shutdown(make(chan bool))
+		assert.Equal(t, 4, bytes.Count(buff.Bytes(), []byte(expectedBuff)))
+	})
+}
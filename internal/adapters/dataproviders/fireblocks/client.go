@@ -0,0 +1,183 @@
+package fireblocks
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/utils"
+)
+
+const (
+	DefaultApiUrl = "https://api.fireblocks.io"
+	apiKeyHeader  = "X-API-Key"
+	// tokenLifetime must be lower than the 30 seconds accepted by the API
+	tokenLifetime = 25 * time.Second
+	nonceLength   = 16
+)
+
+// Client is an HTTP client of the Fireblocks API. Every request is authenticated with a JWT signed with
+// the RSA key of the API user, as described in https://developers.fireblocks.com/reference/signing-a-request-jwt-structure
+type Client struct {
+	httpClient   utils.HttpClient
+	baseUrl      string
+	apiKey       string
+	privateKey   *rsa.PrivateKey
+	pollInterval time.Duration
+}
+
+type apiError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+}
+
+type publicKeyInfo struct {
+	Algorithm      string `json:"algorithm"`
+	DerivationPath []int  `json:"derivationPath"`
+	PublicKey      string `json:"publicKey"`
+}
+
+// NewClient creates a Fireblocks API client. The privateKey is the PEM encoded RSA key of the API user
+// and pollInterval is how often the client checks the status of the transactions it's waiting for
+func NewClient(
+	httpClient utils.HttpClient,
+	baseUrl, apiKey string,
+	privateKey []byte,
+	pollInterval time.Duration,
+) (*Client, error) {
+	if apiKey == "" {
+		return nil, errors.New("missing fireblocks api key")
+	}
+	key, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing fireblocks api private key: %w", err)
+	}
+	if baseUrl == "" {
+		baseUrl = DefaultApiUrl
+	}
+	return &Client{
+		httpClient:   httpClient,
+		baseUrl:      strings.TrimSuffix(baseUrl, "/"),
+		apiKey:       apiKey,
+		privateKey:   key,
+		pollInterval: pollInterval,
+	}, nil
+}
+
+func parsePrivateKey(privateKey []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(privateKey)
+	if block == nil {
+		return nil, errors.New("key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsedKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := parsedKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("key is not an RSA key")
+	}
+	return key, nil
+}
+
+// GetPublicKey returns the compressed public key of the first address of an asset of a vault account.
+// That is the key used by Fireblocks when a raw signing transaction is created for that asset and vault
+func (client *Client) GetPublicKey(ctx context.Context, vaultAccountId, assetId string) ([]byte, error) {
+	var result publicKeyInfo
+	path := fmt.Sprintf("/v1/vault/accounts/%s/%s/0/0/public_key_info?compressed=true", url.PathEscape(vaultAccountId), url.PathEscape(assetId))
+	if err := client.do(ctx, http.MethodGet, path, nil, &result); err != nil {
+		return nil, fmt.Errorf("error getting public key of asset %s: %w", assetId, err)
+	}
+	return hex.DecodeString(strings.TrimPrefix(result.PublicKey, "0x"))
+}
+
+func (client *Client) do(ctx context.Context, method, path string, body any, result any) error {
+	var payload []byte
+	var err error
+	if body != nil {
+		if payload, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+	token, err := client.token(path, payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, client.baseUrl+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(apiKeyHeader, client.apiKey)
+	req.Header.Set("Authorization", "Bearer "+token)
+	res, err := client.httpClient.Do(req)
+	defer utils.CloseBodyIfExists(res)
+	if err != nil {
+		return err
+	}
+	responseBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		var errorResponse apiError
+		if json.Unmarshal(responseBody, &errorResponse) == nil && errorResponse.Message != "" {
+			return fmt.Errorf("fireblocks api error (%d): %s", res.StatusCode, errorResponse.Message)
+		}
+		return fmt.Errorf("fireblocks api error (%d): %s", res.StatusCode, responseBody)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(responseBody, result)
+}
+
+// token builds the JWT required by the API. The token is bound to the path and the body of the request
+func (client *Client) token(path string, body []byte) (string, error) {
+	nonce := make([]byte, nonceLength)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	now := time.Now()
+	bodyHash := sha256.Sum256(body)
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]any{
+		"uri":      path,
+		"nonce":    hex.EncodeToString(nonce),
+		"iat":      now.Unix(),
+		"exp":      now.Add(tokenLifetime).Unix(),
+		"sub":      client.apiKey,
+		"bodyHash": hex.EncodeToString(bodyHash[:]),
+	})
+	if err != nil {
+		return "", err
+	}
+	unsignedToken := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	hash := sha256.Sum256([]byte(unsignedToken))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, client.privateKey, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", err
+	}
+	return unsignedToken + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
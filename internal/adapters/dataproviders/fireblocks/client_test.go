@@ -0,0 +1,146 @@
+package fireblocks_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/fireblocks"
+	"github.com/rsksmart/liquidity-provider-server/test/fireblocks_stub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAsset = "BTC_TEST"
+
+func newTestClient(t *testing.T) (*fireblocks.Client, *fireblocks_stub.Server, *btcec.PrivateKey) {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	server := fireblocks_stub.NewServer(t, map[string]*btcec.PrivateKey{testAsset: key})
+	client, err := fireblocks.NewClient(http.DefaultClient, server.URL, fireblocks_stub.ApiKey, server.PrivateKey(), time.Millisecond)
+	require.NoError(t, err)
+	return client, server, key
+}
+
+func TestNewClient(t *testing.T) {
+	server := fireblocks_stub.NewServer(t, nil)
+	t.Run("should validate the api key", func(t *testing.T) {
+		client, err := fireblocks.NewClient(http.DefaultClient, server.URL, "", server.PrivateKey(), time.Second)
+		require.Error(t, err)
+		assert.Nil(t, client)
+	})
+	t.Run("should validate the private key", func(t *testing.T) {
+		client, err := fireblocks.NewClient(http.DefaultClient, server.URL, fireblocks_stub.ApiKey, []byte("not a key"), time.Second)
+		require.ErrorContains(t, err, "error parsing fireblocks api private key")
+		assert.Nil(t, client)
+	})
+	t.Run("should create the client", func(t *testing.T) {
+		client, err := fireblocks.NewClient(http.DefaultClient, "", fireblocks_stub.ApiKey, server.PrivateKey(), time.Second)
+		require.NoError(t, err)
+		assert.NotNil(t, client)
+	})
+}
+
+func TestClient_GetPublicKey(t *testing.T) {
+	client, _, key := newTestClient(t)
+	t.Run("should return the public key of the asset", func(t *testing.T) {
+		publicKey, err := client.GetPublicKey(context.Background(), fireblocks_stub.VaultAccountId, testAsset)
+		require.NoError(t, err)
+		assert.Equal(t, key.PubKey().SerializeCompressed(), publicKey)
+	})
+	t.Run("should return the api error", func(t *testing.T) {
+		publicKey, err := client.GetPublicKey(context.Background(), fireblocks_stub.VaultAccountId, "ETH")
+		require.ErrorContains(t, err, "fireblocks api error (400): Asset not found")
+		assert.Nil(t, publicKey)
+	})
+	t.Run("should fail if the request is not authenticated", func(t *testing.T) {
+		other := fireblocks_stub.NewServer(t, nil)
+		unauthorized, err := fireblocks.NewClient(http.DefaultClient, other.URL, fireblocks_stub.ApiKey, fireblocks_stub.NewServer(t, nil).PrivateKey(), time.Second)
+		require.NoError(t, err)
+		_, err = unauthorized.GetPublicKey(context.Background(), fireblocks_stub.VaultAccountId, testAsset)
+		require.ErrorContains(t, err, "fireblocks api error (401)")
+	})
+}
+
+func TestClient_RawSign(t *testing.T) {
+	hashes := [][]byte{sha256.New().Sum(nil), sha256.New().Sum([]byte{1})[:32]}
+	t.Run("should return the signatures in the same order as the hashes", func(t *testing.T) {
+		client, server, key := newTestClient(t)
+		signatures, err := client.RawSign(context.Background(), fireblocks.RawSignRequest{
+			VaultAccountId: fireblocks_stub.VaultAccountId,
+			AssetId:        testAsset,
+			Note:           "test",
+			Hashes:         hashes,
+		})
+		require.NoError(t, err)
+		require.Len(t, signatures, 2)
+		for i, signature := range signatures {
+			signatureBytes, bytesErr := signature.Bytes()
+			require.NoError(t, bytesErr)
+			compact := append([]byte{signature.V + 31}, signatureBytes...)
+			publicKey, _, recoverErr := ecdsa.RecoverCompact(compact, hashes[i])
+			require.NoError(t, recoverErr)
+			assert.True(t, key.PubKey().IsEqual(publicKey))
+		}
+		require.Len(t, server.Requests, 1)
+		assert.Equal(t, "RAW", server.Requests[0]["operation"])
+		assert.Equal(t, map[string]any{"type": "VAULT_ACCOUNT", "id": fireblocks_stub.VaultAccountId}, server.Requests[0]["source"])
+		assert.Equal(t, "test", server.Requests[0]["note"])
+	})
+	t.Run("should return error when the transaction is rejected", func(t *testing.T) {
+		client, server, _ := newTestClient(t)
+		server.FinalStatus = string(fireblocks.TransactionStatusRejected)
+		signatures, err := client.RawSign(context.Background(), fireblocks.RawSignRequest{
+			VaultAccountId: fireblocks_stub.VaultAccountId,
+			AssetId:        testAsset,
+			Hashes:         hashes,
+		})
+		require.ErrorIs(t, err, fireblocks.TransactionNotCompletedError)
+		assert.Nil(t, signatures)
+	})
+	t.Run("should stop waiting when the context is done", func(t *testing.T) {
+		key, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+		server := fireblocks_stub.NewServer(t, map[string]*btcec.PrivateKey{testAsset: key})
+		client, err := fireblocks.NewClient(http.DefaultClient, server.URL, fireblocks_stub.ApiKey, server.PrivateKey(), time.Hour)
+		require.NoError(t, err)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		signatures, err := client.RawSign(ctx, fireblocks.RawSignRequest{VaultAccountId: fireblocks_stub.VaultAccountId, AssetId: testAsset, Hashes: hashes})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Nil(t, signatures)
+	})
+	t.Run("should validate there are hashes to sign", func(t *testing.T) {
+		client, _, _ := newTestClient(t)
+		signatures, err := client.RawSign(context.Background(), fireblocks.RawSignRequest{VaultAccountId: fireblocks_stub.VaultAccountId, AssetId: testAsset})
+		require.Error(t, err)
+		assert.Nil(t, signatures)
+	})
+}
+
+func TestSignature_Bytes(t *testing.T) {
+	r := "01"
+	s := "02"
+	expected := make([]byte, 64)
+	expected[31] = 1
+	expected[63] = 2
+	t.Run("should use the full signature", func(t *testing.T) {
+		result, err := fireblocks.Signature{FullSig: hex.EncodeToString(expected)}.Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should build the signature from r and s", func(t *testing.T) {
+		result, err := fireblocks.Signature{R: r, S: s}.Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should validate the signature length", func(t *testing.T) {
+		_, err := fireblocks.Signature{FullSig: "0102"}.Bytes()
+		require.Error(t, err)
+	})
+}
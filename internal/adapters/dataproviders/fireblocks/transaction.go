@@ -0,0 +1,201 @@
+package fireblocks
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type TransactionStatus string
+
+const (
+	TransactionStatusCompleted TransactionStatus = "COMPLETED"
+	TransactionStatusCancelled TransactionStatus = "CANCELLED"
+	TransactionStatusRejected  TransactionStatus = "REJECTED"
+	TransactionStatusBlocked   TransactionStatus = "BLOCKED"
+	TransactionStatusFailed    TransactionStatus = "FAILED"
+)
+
+const (
+	rawOperation      = "RAW"
+	vaultAccountType  = "VAULT_ACCOUNT"
+	signatureByteSize = 32
+)
+
+var TransactionNotCompletedError = errors.New("fireblocks transaction was not completed")
+
+func (status TransactionStatus) IsFinal() bool {
+	switch status {
+	case TransactionStatusCompleted, TransactionStatusCancelled, TransactionStatusRejected, TransactionStatusBlocked, TransactionStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+type Transaction struct {
+	Id             string            `json:"id"`
+	Status         TransactionStatus `json:"status"`
+	SubStatus      string            `json:"subStatus"`
+	SignedMessages []SignedMessage   `json:"signedMessages"`
+}
+
+type SignedMessage struct {
+	Content   string    `json:"content"`
+	PublicKey string    `json:"publicKey"`
+	Signature Signature `json:"signature"`
+}
+
+// Signature is an ECDSA signature returned by the raw signing API. All the fields are hex encoded
+// except V, which is the recovery id (0 or 1)
+type Signature struct {
+	FullSig string `json:"fullSig"`
+	R       string `json:"r"`
+	S       string `json:"s"`
+	V       uint8  `json:"v"`
+}
+
+// Bytes returns the 64 bytes of the signature (r || s)
+func (signature Signature) Bytes() ([]byte, error) {
+	if signature.FullSig != "" {
+		fullSig, err := hex.DecodeString(strings.TrimPrefix(signature.FullSig, "0x"))
+		if err != nil {
+			return nil, err
+		} else if len(fullSig) != 2*signatureByteSize {
+			return nil, fmt.Errorf("invalid signature length %d", len(fullSig))
+		}
+		return fullSig, nil
+	}
+	r, err := hex.DecodeString(strings.TrimPrefix(signature.R, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	s, err := hex.DecodeString(strings.TrimPrefix(signature.S, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	if len(r) > signatureByteSize || len(s) > signatureByteSize {
+		return nil, errors.New("invalid signature length")
+	}
+	result := make([]byte, 2*signatureByteSize)
+	copy(result[signatureByteSize-len(r):signatureByteSize], r)
+	copy(result[2*signatureByteSize-len(s):], s)
+	return result, nil
+}
+
+// RawSignRequest is a request to sign a list of hashes with the key of an asset of a vault account
+type RawSignRequest struct {
+	VaultAccountId string
+	AssetId        string
+	Note           string
+	Hashes         [][]byte
+}
+
+type transferPeerPath struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+}
+
+type rawMessage struct {
+	Content string `json:"content"`
+}
+
+type createTransactionRequest struct {
+	Operation       string           `json:"operation"`
+	AssetId         string           `json:"assetId"`
+	Source          transferPeerPath `json:"source"`
+	Note            string           `json:"note,omitempty"`
+	ExtraParameters struct {
+		RawMessageData struct {
+			Messages []rawMessage `json:"messages"`
+		} `json:"rawMessageData"`
+	} `json:"extraParameters"`
+}
+
+type createTransactionResponse struct {
+	Id     string            `json:"id"`
+	Status TransactionStatus `json:"status"`
+}
+
+// RawSign creates a raw signing transaction, waits for it to be completed (including any approval required
+// by the workspace policies) and returns the signatures in the same order as the hashes of the request
+func (client *Client) RawSign(ctx context.Context, request RawSignRequest) ([]Signature, error) {
+	if len(request.Hashes) == 0 {
+		return nil, errors.New("no hashes to sign")
+	}
+	body := createTransactionRequest{
+		Operation: rawOperation,
+		AssetId:   request.AssetId,
+		Source:    transferPeerPath{Type: vaultAccountType, Id: request.VaultAccountId},
+		Note:      request.Note,
+	}
+	for _, hash := range request.Hashes {
+		body.ExtraParameters.RawMessageData.Messages = append(body.ExtraParameters.RawMessageData.Messages, rawMessage{Content: hex.EncodeToString(hash)})
+	}
+	var created createTransactionResponse
+	if err := client.do(ctx, http.MethodPost, "/v1/transactions", body, &created); err != nil {
+		return nil, fmt.Errorf("error creating raw signing transaction: %w", err)
+	}
+	log.Debugf("Fireblocks raw signing transaction %s created", created.Id)
+	transaction, err := client.WaitForTransaction(ctx, created.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	signatures := make(map[string]Signature, len(transaction.SignedMessages))
+	for _, message := range transaction.SignedMessages {
+		signatures[strings.ToLower(strings.TrimPrefix(message.Content, "0x"))] = message.Signature
+	}
+	result := make([]Signature, 0, len(request.Hashes))
+	for _, message := range body.ExtraParameters.RawMessageData.Messages {
+		signature, ok := signatures[message.Content]
+		if !ok {
+			return nil, fmt.Errorf("fireblocks transaction %s doesn't include the signature of %s", transaction.Id, message.Content)
+		}
+		result = append(result, signature)
+	}
+	return result, nil
+}
+
+func (client *Client) GetTransaction(ctx context.Context, id string) (Transaction, error) {
+	var transaction Transaction
+	if err := client.do(ctx, http.MethodGet, "/v1/transactions/"+url.PathEscape(id), nil, &transaction); err != nil {
+		return Transaction{}, fmt.Errorf("error getting transaction %s: %w", id, err)
+	}
+	return transaction, nil
+}
+
+// WaitForTransaction polls a transaction until it reaches a final status or the context is done. An error
+// is returned if the final status is not COMPLETED
+func (client *Client) WaitForTransaction(ctx context.Context, id string) (Transaction, error) {
+	ticker := time.NewTicker(client.pollInterval)
+	defer ticker.Stop()
+	for {
+		transaction, err := client.GetTransaction(ctx, id)
+		if err != nil {
+			return Transaction{}, err
+		} else if transaction.Status == TransactionStatusCompleted {
+			return transaction, nil
+		} else if transaction.Status.IsFinal() {
+			return Transaction{}, fmt.Errorf("%w: transaction %s finished with status %s (%s)", TransactionNotCompletedError, id, transaction.Status, transaction.SubStatus)
+		}
+		select {
+		case <-ctx.Done():
+			return Transaction{}, fmt.Errorf("error waiting for transaction %s: %w", id, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// VaultAsset identifies the key of an asset inside a vault account
+type VaultAsset struct {
+	VaultAccountId string
+	AssetId        string
+}
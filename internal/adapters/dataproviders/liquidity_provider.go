@@ -21,6 +21,7 @@ type LocalLiquidityProvider struct {
 	peginRepository  quote.PeginQuoteRepository
 	pegoutRepository quote.PegoutQuoteRepository
 	lpRepository     liquidity_provider.LiquidityProviderRepository
+	ledger           liquidity_provider.LiquidityLedger
 	rpc              blockchain.Rpc
 	signer           rootstock.TransactionSigner
 	btc              blockchain.BitcoinWallet
@@ -31,6 +32,7 @@ func NewLocalLiquidityProvider(
 	peginRepository quote.PeginQuoteRepository,
 	pegoutRepository quote.PegoutQuoteRepository,
 	lpRepository liquidity_provider.LiquidityProviderRepository,
+	ledger liquidity_provider.LiquidityLedger,
 	rpc blockchain.Rpc,
 	signer rootstock.TransactionSigner,
 	btc blockchain.BitcoinWallet,
@@ -40,6 +42,7 @@ func NewLocalLiquidityProvider(
 		peginRepository:  peginRepository,
 		pegoutRepository: pegoutRepository,
 		lpRepository:     lpRepository,
+		ledger:           ledger,
 		rpc:              rpc,
 		signer:           signer,
 		btc:              btc,
@@ -67,13 +70,9 @@ func (lp *LocalLiquidityProvider) SignPeginQuote(ctx context.Context, quoteHash
 	if err != nil {
 		return "", err
 	}
-
-	signatureBytes, err := lp.signer.SignBytes(hash[:])
-	if err != nil {
-		return "", err
-	}
-	signatureBytes[len(signatureBytes)-1] += 27 // v must be 27 or 28
-	return hex.EncodeToString(signatureBytes), nil
+	return lp.signQuoteHash(hash, func() ([]byte, error) {
+		return lp.contracts.PegIn.PeginQuoteTypedData(*peginQuote)
+	})
 }
 
 func (lp *LocalLiquidityProvider) SignPegoutQuote(ctx context.Context, quoteHash string) (string, error) {
@@ -88,8 +87,25 @@ func (lp *LocalLiquidityProvider) SignPegoutQuote(ctx context.Context, quoteHash
 	if err != nil {
 		return "", err
 	}
+	return lp.signQuoteHash(hash, func() ([]byte, error) {
+		return lp.contracts.PegOut.PegoutQuoteTypedData(*pegoutQuote)
+	})
+}
 
-	signatureBytes, err := lp.signer.SignBytes(hash[:])
+// signQuoteHash signs the EIP-712 hash of a quote. The signers that can't sign an arbitrary hash receive the
+// typed data of the quote instead, its hash is verified against the one of the contract before signing it
+func (lp *LocalLiquidityProvider) signQuoteHash(hash [32]byte, typedData func() ([]byte, error)) (string, error) {
+	var signatureBytes []byte
+	var err error
+	if typedDataSigner, ok := lp.signer.(rootstock.TypedDataSigner); ok {
+		var quoteTypedData []byte
+		if quoteTypedData, err = typedData(); err != nil {
+			return "", err
+		}
+		signatureBytes, err = typedDataSigner.SignTypedData(quoteTypedData, hash)
+	} else {
+		signatureBytes, err = lp.signer.SignBytes(hash[:])
+	}
 	if err != nil {
 		return "", err
 	}
@@ -121,15 +137,11 @@ func (lp *LocalLiquidityProvider) AvailablePegoutLiquidity(ctx context.Context)
 		return nil, err
 	}
 	log.Debugf("Liquidity: %s satoshi", liquidity.ToSatoshi().String())
-	quotes, err := lp.pegoutRepository.GetRetainedQuoteByState(ctx,
-		quote.PegoutStateWaitingForDeposit, quote.PegoutStateWaitingForDepositConfirmations,
-	)
+	holds, err := lp.ledger.GetActiveHolds(ctx, liquidity_provider.PegoutHold)
 	if err != nil {
 		return nil, err
 	}
-	for _, retainedQuote := range quotes {
-		lockedLiquidity.Add(lockedLiquidity, retainedQuote.RequiredLiquidity)
-	}
+	lockedLiquidity.Add(lockedLiquidity, liquidity_provider.TotalHeld(holds))
 	log.Debugf("Locked Liquidity: %s satoshi", lockedLiquidity.ToSatoshi().String())
 
 	if liquidity.Cmp(lockedLiquidity) < 0 {
@@ -169,15 +181,11 @@ func (lp *LocalLiquidityProvider) AvailablePeginLiquidity(ctx context.Context) (
 	}
 	liquidity.Add(lpRskBalance, lpLbcBalance)
 	log.Debugf("Liquidity: %s wei", liquidity.String())
-	peginQuotes, err := lp.peginRepository.GetRetainedQuoteByState(ctx,
-		quote.PeginStateWaitingForDeposit, quote.PeginStateWaitingForDepositConfirmations,
-	)
+	holds, err := lp.ledger.GetActiveHolds(ctx, liquidity_provider.PeginHold)
 	if err != nil {
 		return nil, err
 	}
-	for _, retainedQuote := range peginQuotes {
-		lockedLiquidity.Add(lockedLiquidity, retainedQuote.RequiredLiquidity)
-	}
+	lockedLiquidity.Add(lockedLiquidity, liquidity_provider.TotalHeld(holds))
 	// we include this in the locked liquidity because the refund is done in RBTC, and it is converted to BTC once a threshold is reached
 	pegoutQuotes, err := lp.pegoutRepository.GetRetainedQuoteByState(ctx, quote.PegoutStateRefundPegOutSucceeded)
 	if err != nil {
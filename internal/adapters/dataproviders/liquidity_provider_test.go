@@ -1,6 +1,7 @@
 package dataproviders_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"errors"
@@ -41,14 +42,14 @@ var pegoutQuote = quote.PegoutQuote{Nonce: 2}
 
 func TestLocalLiquidityProvider_BtcAddress(t *testing.T) {
 	btcWallet := new(mocks.BitcoinWalletMock)
-	lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
+	lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, nil, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
 	btcWallet.On("Address").Return(test.AnyAddress)
 	assert.Equal(t, test.AnyAddress, lp.BtcAddress())
 }
 
 func TestLocalLiquidityProvider_RskAddress(t *testing.T) {
 	signer := new(mocks.TransactionSignerMock)
-	lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, blockchain.Rpc{}, signer, nil, blockchain.RskContracts{})
+	lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, nil, blockchain.Rpc{}, signer, nil, blockchain.RskContracts{})
 	signer.On("Address").Return(common.HexToAddress(rskTestAddress))
 	assert.Equal(t, strings.ToLower(rskTestAddress), lp.RskAddress())
 }
@@ -65,18 +66,80 @@ func TestLocalLiquidityProvider_SignPeginQuote(t *testing.T) {
 	peginContract.EXPECT().HashPeginQuoteEIP712(peginQuote).Return(utils.To32Bytes(eip712HashBytes), nil).Once()
 	contracts := blockchain.RskContracts{PegIn: peginContract}
 	peginRepo.EXPECT().GetQuote(mock.Anything, peginQuoteHash).Return(&peginQuote, nil).Once()
-	lp := dataproviders.NewLocalLiquidityProvider(peginRepo, nil, nil, blockchain.Rpc{}, signer, nil, contracts)
+	lp := dataproviders.NewLocalLiquidityProvider(peginRepo, nil, nil, nil, blockchain.Rpc{}, signer, nil, contracts)
 	result, err := lp.SignPeginQuote(context.Background(), peginQuoteHash)
 	signer.AssertExpectations(t)
 	require.NoError(t, err)
 	assert.Equal(t, signatureAfterSum, result)
 }
 
+// typedDataSignerMock is a signer that can only sign the quotes through their EIP-712 typed data
+type typedDataSignerMock struct {
+	*mocks.TransactionSignerMock
+}
+
+func (signer *typedDataSignerMock) SignTypedData(typedData []byte, expectedHash [32]byte) ([]byte, error) {
+	args := signer.Called(typedData, expectedHash)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func TestLocalLiquidityProvider_SignQuote_TypedData(t *testing.T) {
+	typedData := []byte(`{"primaryType":"PegInQuote"}`)
+	signatureBytes, err := hex.DecodeString(signatureBeforeSum)
+	require.NoError(t, err)
+	t.Run("should sign the pegin quote typed data", func(t *testing.T) {
+		eip712HashBytes, hashErr := hex.DecodeString(peginQuoteEip712Hash)
+		require.NoError(t, hashErr)
+		signer := &typedDataSignerMock{TransactionSignerMock: new(mocks.TransactionSignerMock)}
+		signer.On("SignTypedData", typedData, utils.To32Bytes(eip712HashBytes)).Return(bytes.Clone(signatureBytes), nil).Once()
+		peginRepo := new(mocks.PeginQuoteRepositoryMock)
+		peginRepo.EXPECT().GetQuote(mock.Anything, peginQuoteHash).Return(&peginQuote, nil).Once()
+		peginContract := new(mocks.PeginContractMock)
+		peginContract.EXPECT().HashPeginQuoteEIP712(peginQuote).Return(utils.To32Bytes(eip712HashBytes), nil).Once()
+		peginContract.EXPECT().PeginQuoteTypedData(peginQuote).Return(typedData, nil).Once()
+		lp := dataproviders.NewLocalLiquidityProvider(peginRepo, nil, nil, nil, blockchain.Rpc{}, signer, nil, blockchain.RskContracts{PegIn: peginContract})
+		result, signErr := lp.SignPeginQuote(context.Background(), peginQuoteHash)
+		require.NoError(t, signErr)
+		assert.Equal(t, signatureAfterSum, result)
+		signer.AssertExpectations(t)
+		signer.AssertNotCalled(t, "SignBytes", mock.Anything)
+	})
+	t.Run("should sign the pegout quote typed data", func(t *testing.T) {
+		eip712HashBytes, hashErr := hex.DecodeString(pegoutQuoteEip712Hash)
+		require.NoError(t, hashErr)
+		signer := &typedDataSignerMock{TransactionSignerMock: new(mocks.TransactionSignerMock)}
+		signer.On("SignTypedData", typedData, utils.To32Bytes(eip712HashBytes)).Return(bytes.Clone(signatureBytes), nil).Once()
+		pegoutRepo := new(mocks.PegoutQuoteRepositoryMock)
+		pegoutRepo.EXPECT().GetQuote(mock.Anything, pegoutQuoteHash).Return(&pegoutQuote, nil).Once()
+		pegoutContract := new(mocks.PegoutContractMock)
+		pegoutContract.EXPECT().HashPegoutQuoteEIP712(pegoutQuote).Return(utils.To32Bytes(eip712HashBytes), nil).Once()
+		pegoutContract.EXPECT().PegoutQuoteTypedData(pegoutQuote).Return(typedData, nil).Once()
+		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepo, nil, nil, blockchain.Rpc{}, signer, nil, blockchain.RskContracts{PegOut: pegoutContract})
+		result, signErr := lp.SignPegoutQuote(context.Background(), pegoutQuoteHash)
+		require.NoError(t, signErr)
+		assert.Equal(t, signatureAfterSum, result)
+		signer.AssertExpectations(t)
+	})
+	t.Run("should handle error building the typed data", func(t *testing.T) {
+		signer := &typedDataSignerMock{TransactionSignerMock: new(mocks.TransactionSignerMock)}
+		peginRepo := new(mocks.PeginQuoteRepositoryMock)
+		peginRepo.EXPECT().GetQuote(mock.Anything, peginQuoteHash).Return(&peginQuote, nil).Once()
+		peginContract := new(mocks.PeginContractMock)
+		peginContract.EXPECT().HashPeginQuoteEIP712(peginQuote).Return([32]byte{1}, nil).Once()
+		peginContract.EXPECT().PeginQuoteTypedData(peginQuote).Return(nil, assert.AnError).Once()
+		lp := dataproviders.NewLocalLiquidityProvider(peginRepo, nil, nil, nil, blockchain.Rpc{}, signer, nil, blockchain.RskContracts{PegIn: peginContract})
+		result, signErr := lp.SignPeginQuote(context.Background(), peginQuoteHash)
+		require.ErrorIs(t, signErr, assert.AnError)
+		assert.Empty(t, result)
+		signer.AssertNotCalled(t, "SignTypedData", mock.Anything, mock.Anything)
+	})
+}
+
 func TestLocalLiquidityProvider_SignPeginQuote_ErrorHandling(t *testing.T) {
 	t.Run("Quote not found", func(t *testing.T) {
 		peginRepo := new(mocks.PeginQuoteRepositoryMock)
 		peginRepo.EXPECT().GetQuote(mock.Anything, test.AnyString).Return(nil, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(peginRepo, nil, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(peginRepo, nil, nil, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
 		result, err := lp.SignPeginQuote(context.Background(), test.AnyString)
 		require.ErrorIs(t, err, usecases.QuoteNotFoundError)
 		assert.Empty(t, result)
@@ -84,7 +147,7 @@ func TestLocalLiquidityProvider_SignPeginQuote_ErrorHandling(t *testing.T) {
 	t.Run("Error getting quote from db", func(t *testing.T) {
 		peginRepo := new(mocks.PeginQuoteRepositoryMock)
 		peginRepo.EXPECT().GetQuote(mock.Anything, test.AnyString).Return(nil, assert.AnError).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(peginRepo, nil, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(peginRepo, nil, nil, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
 		result, err := lp.SignPeginQuote(context.Background(), test.AnyString)
 		require.Error(t, err)
 		assert.Empty(t, result)
@@ -94,7 +157,7 @@ func TestLocalLiquidityProvider_SignPeginQuote_ErrorHandling(t *testing.T) {
 		peginRepo.EXPECT().GetQuote(mock.Anything, test.AnyString).Return(&peginQuote, nil).Once()
 		peginContract := new(mocks.PeginContractMock)
 		peginContract.EXPECT().HashPeginQuoteEIP712(peginQuote).Return([32]byte{}, assert.AnError).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(peginRepo, nil, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{PegIn: peginContract})
+		lp := dataproviders.NewLocalLiquidityProvider(peginRepo, nil, nil, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{PegIn: peginContract})
 		result, err := lp.SignPeginQuote(context.Background(), test.AnyString)
 		require.Error(t, err)
 		assert.Empty(t, result)
@@ -108,7 +171,7 @@ func TestLocalLiquidityProvider_SignPeginQuote_ErrorHandling(t *testing.T) {
 		peginContract.EXPECT().HashPeginQuoteEIP712(peginQuote).Return(utils.To32Bytes(hashBytes), nil).Once()
 		signer := new(mocks.TransactionSignerMock)
 		signer.On("SignBytes", mock.Anything).Return(nil, assert.AnError).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(peginRepo, nil, nil, blockchain.Rpc{}, signer, nil, blockchain.RskContracts{PegIn: peginContract})
+		lp := dataproviders.NewLocalLiquidityProvider(peginRepo, nil, nil, nil, blockchain.Rpc{}, signer, nil, blockchain.RskContracts{PegIn: peginContract})
 		result, err := lp.SignPeginQuote(context.Background(), test.AnyString)
 		require.Error(t, err)
 		assert.Empty(t, result)
@@ -127,7 +190,7 @@ func TestLocalLiquidityProvider_SignPegoutQuote(t *testing.T) {
 	pegoutContract.EXPECT().HashPegoutQuoteEIP712(pegoutQuote).Return(utils.To32Bytes(eip712HashBytes), nil).Once()
 	contracts := blockchain.RskContracts{PegOut: pegoutContract}
 	pegoutRepo.EXPECT().GetQuote(mock.Anything, pegoutQuoteHash).Return(&pegoutQuote, nil).Once()
-	lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepo, nil, blockchain.Rpc{}, signer, nil, contracts)
+	lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepo, nil, nil, blockchain.Rpc{}, signer, nil, contracts)
 	result, err := lp.SignPegoutQuote(context.Background(), pegoutQuoteHash)
 	signer.AssertExpectations(t)
 	require.NoError(t, err)
@@ -138,7 +201,7 @@ func TestLocalLiquidityProvider_SignPegoutQuote_ErrorHandling(t *testing.T) {
 	t.Run("Quote not found", func(t *testing.T) {
 		pegoutRepo := new(mocks.PegoutQuoteRepositoryMock)
 		pegoutRepo.EXPECT().GetQuote(mock.Anything, test.AnyString).Return(nil, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepo, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepo, nil, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
 		result, err := lp.SignPegoutQuote(context.Background(), test.AnyString)
 		require.ErrorIs(t, err, usecases.QuoteNotFoundError)
 		assert.Empty(t, result)
@@ -146,7 +209,7 @@ func TestLocalLiquidityProvider_SignPegoutQuote_ErrorHandling(t *testing.T) {
 	t.Run("Error getting quote from db", func(t *testing.T) {
 		pegoutRepo := new(mocks.PegoutQuoteRepositoryMock)
 		pegoutRepo.EXPECT().GetQuote(mock.Anything, test.AnyString).Return(nil, assert.AnError).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepo, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepo, nil, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
 		result, err := lp.SignPegoutQuote(context.Background(), test.AnyString)
 		require.Error(t, err)
 		assert.Empty(t, result)
@@ -156,7 +219,7 @@ func TestLocalLiquidityProvider_SignPegoutQuote_ErrorHandling(t *testing.T) {
 		pegoutRepo.EXPECT().GetQuote(mock.Anything, test.AnyString).Return(&pegoutQuote, nil).Once()
 		pegoutContract := new(mocks.PegoutContractMock)
 		pegoutContract.EXPECT().HashPegoutQuoteEIP712(pegoutQuote).Return([32]byte{}, assert.AnError).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepo, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{PegOut: pegoutContract})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepo, nil, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{PegOut: pegoutContract})
 		result, err := lp.SignPegoutQuote(context.Background(), test.AnyString)
 		require.Error(t, err)
 		assert.Empty(t, result)
@@ -170,51 +233,61 @@ func TestLocalLiquidityProvider_SignPegoutQuote_ErrorHandling(t *testing.T) {
 		pegoutContract.EXPECT().HashPegoutQuoteEIP712(pegoutQuote).Return(utils.To32Bytes(hashBytes), nil).Once()
 		signer := new(mocks.TransactionSignerMock)
 		signer.On("SignBytes", mock.Anything).Return(nil, assert.AnError).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepo, nil, blockchain.Rpc{}, signer, nil, blockchain.RskContracts{PegOut: pegoutContract})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepo, nil, nil, blockchain.Rpc{}, signer, nil, blockchain.RskContracts{PegOut: pegoutContract})
 		result, err := lp.SignPegoutQuote(context.Background(), test.AnyString)
 		require.Error(t, err)
 		assert.Empty(t, result)
 	})
 }
 
+func activeTestHold(amount int64) liquidity_provider.LiquidityHold {
+	return liquidity_provider.LiquidityHold{Amount: entities.NewWei(amount), Status: liquidity_provider.HoldStatusActive, ExpiresAt: time.Now().Add(time.Hour)}
+}
+
 func TestLocalLiquidityProvider_AvailablePegoutLiquidity(t *testing.T) {
 	t.Run("should return available pegout liquidity", func(t *testing.T) {
-		pegoutRepository := new(mocks.PegoutQuoteRepositoryMock)
-		pegoutRepository.On("GetRetainedQuoteByState", test.AnyCtx,
-			quote.PegoutStateWaitingForDeposit,
-			quote.PegoutStateWaitingForDepositConfirmations,
-		).Return([]quote.RetainedPegoutQuote{
-			{RequiredLiquidity: entities.NewWei(100)},
-			{RequiredLiquidity: entities.NewWei(300)},
-			{RequiredLiquidity: entities.NewWei(200)},
+		ledger := new(mocks.LiquidityLedgerMock)
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, liquidity_provider.PegoutHold).Return([]liquidity_provider.LiquidityHold{
+			activeTestHold(100), activeTestHold(300), activeTestHold(200),
 		}, nil).Once()
 		btcWallet := new(mocks.BitcoinWalletMock)
 		btcWallet.On("GetBalance").Return(entities.NewWei(1500), nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepository, nil, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, ledger, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
 		liquidity, err := lp.AvailablePegoutLiquidity(context.Background())
 		require.NoError(t, err)
 		assert.Equal(t, entities.NewWei(900), liquidity)
 		btcWallet.AssertExpectations(t)
-		pegoutRepository.AssertExpectations(t)
+		ledger.AssertExpectations(t)
+	})
+	t.Run("should count the active holds whose expiration time has passed", func(t *testing.T) {
+		overdueHold := activeTestHold(300)
+		overdueHold.ExpiresAt = time.Now().Add(-time.Second)
+		ledger := new(mocks.LiquidityLedgerMock)
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, liquidity_provider.PegoutHold).Return([]liquidity_provider.LiquidityHold{
+			activeTestHold(100), overdueHold,
+		}, nil).Once()
+		btcWallet := new(mocks.BitcoinWalletMock)
+		btcWallet.On("GetBalance").Return(entities.NewWei(1500), nil).Once()
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, ledger, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
+		liquidity, err := lp.AvailablePegoutLiquidity(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(1100), liquidity)
+		btcWallet.AssertExpectations(t)
+		ledger.AssertExpectations(t)
 	})
 	t.Run("should return 0 if the locked liquidity is higher than the available", func(t *testing.T) {
-		pegoutRepository := new(mocks.PegoutQuoteRepositoryMock)
-		pegoutRepository.On("GetRetainedQuoteByState", test.AnyCtx,
-			quote.PegoutStateWaitingForDeposit,
-			quote.PegoutStateWaitingForDepositConfirmations,
-		).Return([]quote.RetainedPegoutQuote{
-			{RequiredLiquidity: entities.NewWei(100)},
-			{RequiredLiquidity: entities.NewWei(300)},
-			{RequiredLiquidity: entities.NewWei(200)},
+		ledger := new(mocks.LiquidityLedgerMock)
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, liquidity_provider.PegoutHold).Return([]liquidity_provider.LiquidityHold{
+			activeTestHold(100), activeTestHold(300), activeTestHold(200),
 		}, nil).Once()
 		btcWallet := new(mocks.BitcoinWalletMock)
 		btcWallet.On("GetBalance").Return(entities.NewWei(100), nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepository, nil, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, ledger, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
 		liquidity, err := lp.AvailablePegoutLiquidity(context.Background())
 		require.NoError(t, err)
 		assert.Equal(t, entities.NewWei(0), liquidity)
 		btcWallet.AssertExpectations(t)
-		pegoutRepository.AssertExpectations(t)
+		ledger.AssertExpectations(t)
 	})
 }
 
@@ -222,20 +295,17 @@ func TestLocalLiquidityProvider_AvailablePegoutLiquidity_ErrorHandling(t *testin
 	t.Run("Error getting btc wallet balance when checking available pegout liquidity", func(t *testing.T) {
 		btcWallet := new(mocks.BitcoinWalletMock)
 		btcWallet.On("GetBalance").Return(nil, assert.AnError).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, nil, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
 		liquidity, err := lp.AvailablePegoutLiquidity(context.Background())
 		require.Error(t, err)
 		assert.Nil(t, liquidity)
 	})
-	t.Run("Error getting pegout quotes from db when checking available pegout liquidity", func(t *testing.T) {
-		pegoutRepository := new(mocks.PegoutQuoteRepositoryMock)
-		pegoutRepository.On("GetRetainedQuoteByState", test.AnyCtx,
-			quote.PegoutStateWaitingForDeposit,
-			quote.PegoutStateWaitingForDepositConfirmations,
-		).Return(nil, assert.AnError).Once()
+	t.Run("Error getting pegout holds from db when checking available pegout liquidity", func(t *testing.T) {
+		ledger := new(mocks.LiquidityLedgerMock)
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, liquidity_provider.PegoutHold).Return(nil, assert.AnError).Once()
 		btcWallet := new(mocks.BitcoinWalletMock)
 		btcWallet.On("GetBalance").Return(entities.NewWei(500), nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepository, nil, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, ledger, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
 		liquidity, err := lp.AvailablePegoutLiquidity(context.Background())
 		require.Error(t, err)
 		assert.Nil(t, liquidity)
@@ -243,18 +313,13 @@ func TestLocalLiquidityProvider_AvailablePegoutLiquidity_ErrorHandling(t *testin
 }
 
 func TestLocalLiquidityProvider_HasPegoutLiquidity(t *testing.T) {
-	pegoutRepository := new(mocks.PegoutQuoteRepositoryMock)
-	pegoutRepository.On("GetRetainedQuoteByState", test.AnyCtx,
-		quote.PegoutStateWaitingForDeposit,
-		quote.PegoutStateWaitingForDepositConfirmations,
-	).Return([]quote.RetainedPegoutQuote{
-		{RequiredLiquidity: entities.NewWei(100)},
-		{RequiredLiquidity: entities.NewWei(200)},
-		{RequiredLiquidity: entities.NewWei(150)},
+	ledger := new(mocks.LiquidityLedgerMock)
+	ledger.EXPECT().GetActiveHolds(test.AnyCtx, liquidity_provider.PegoutHold).Return([]liquidity_provider.LiquidityHold{
+		activeTestHold(100), activeTestHold(200), activeTestHold(150),
 	}, nil).Times(3)
 	btcWallet := new(mocks.BitcoinWalletMock)
 	btcWallet.On("GetBalance").Return(entities.NewWei(500), nil).Times(3)
-	lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepository, nil, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
+	lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, ledger, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
 	testCases := []struct {
 		amount        *entities.Wei
 		expectedError string
@@ -273,26 +338,23 @@ func TestLocalLiquidityProvider_HasPegoutLiquidity(t *testing.T) {
 		}
 	}
 	btcWallet.AssertExpectations(t)
-	pegoutRepository.AssertExpectations(t)
+	ledger.AssertExpectations(t)
 }
 
 func TestLocalLiquidityProvider_HasPegoutLiquidity_ErrorHandling(t *testing.T) {
 	t.Run("Error getting btc wallet balance", func(t *testing.T) {
 		btcWallet := new(mocks.BitcoinWalletMock)
 		btcWallet.On("GetBalance").Return(nil, assert.AnError).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, nil, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
 		err := lp.HasPegoutLiquidity(context.Background(), entities.NewWei(1))
 		require.Error(t, err)
 	})
-	t.Run("Error getting pegout quotes from db", func(t *testing.T) {
-		pegoutRepository := new(mocks.PegoutQuoteRepositoryMock)
-		pegoutRepository.On("GetRetainedQuoteByState", test.AnyCtx,
-			quote.PegoutStateWaitingForDeposit,
-			quote.PegoutStateWaitingForDepositConfirmations,
-		).Return(nil, assert.AnError).Times(3)
+	t.Run("Error getting pegout holds from db", func(t *testing.T) {
+		ledger := new(mocks.LiquidityLedgerMock)
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, liquidity_provider.PegoutHold).Return(nil, assert.AnError).Once()
 		btcWallet := new(mocks.BitcoinWalletMock)
-		btcWallet.On("GetBalance").Return(entities.NewWei(500), nil).Times(3)
-		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepository, nil, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
+		btcWallet.On("GetBalance").Return(entities.NewWei(500), nil).Once()
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, ledger, blockchain.Rpc{}, nil, btcWallet, blockchain.RskContracts{})
 		err := lp.HasPegoutLiquidity(context.Background(), entities.NewWei(1))
 		require.Error(t, err)
 	})
@@ -301,14 +363,9 @@ func TestLocalLiquidityProvider_HasPegoutLiquidity_ErrorHandling(t *testing.T) {
 func TestLocalLiquidityProvider_HasPeginLiquidity(t *testing.T) {
 	signer := new(mocks.TransactionSignerMock)
 	signer.On("Address").Return(common.HexToAddress(rskTestAddress)).Times(6)
-	peginRepository := new(mocks.PeginQuoteRepositoryMock)
-	peginRepository.On("GetRetainedQuoteByState", test.AnyCtx,
-		quote.PeginStateWaitingForDeposit,
-		quote.PeginStateWaitingForDepositConfirmations,
-	).Return([]quote.RetainedPeginQuote{
-		{RequiredLiquidity: entities.NewWei(100)},
-		{RequiredLiquidity: entities.NewWei(200)},
-		{RequiredLiquidity: entities.NewWei(150)},
+	ledger := new(mocks.LiquidityLedgerMock)
+	ledger.EXPECT().GetActiveHolds(test.AnyCtx, liquidity_provider.PeginHold).Return([]liquidity_provider.LiquidityHold{
+		activeTestHold(100), activeTestHold(200), activeTestHold(150),
 	}, nil).Times(3)
 	pegoutRepository := new(mocks.PegoutQuoteRepositoryMock)
 	pegoutRepository.On("GetRetainedQuoteByState", test.AnyCtx,
@@ -321,7 +378,7 @@ func TestLocalLiquidityProvider_HasPeginLiquidity(t *testing.T) {
 	peginContractMock.EXPECT().GetBalance(rskTestAddress).Return(entities.NewWei(400), nil).Times(3)
 	rpcMock := new(mocks.RootstockRpcServerMock)
 	rpcMock.On("GetBalance", test.AnyCtx, rskTestAddress).Return(entities.NewWei(300), nil).Times(3)
-	lp := dataproviders.NewLocalLiquidityProvider(peginRepository, pegoutRepository, nil, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
+	lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepository, nil, ledger, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
 	testCases := []struct {
 		amount        *entities.Wei
 		expectedError string
@@ -341,7 +398,7 @@ func TestLocalLiquidityProvider_HasPeginLiquidity(t *testing.T) {
 	}
 	peginContractMock.AssertExpectations(t)
 	rpcMock.AssertExpectations(t)
-	peginRepository.AssertExpectations(t)
+	ledger.AssertExpectations(t)
 	pegoutRepository.AssertExpectations(t)
 	signer.AssertExpectations(t)
 }
@@ -352,7 +409,7 @@ func TestLocalLiquidityProvider_HasPeginLiquidity_ErrorHandling(t *testing.T) {
 	t.Run("Error getting balance from RSK RPC server", func(t *testing.T) {
 		rpcMock := new(mocks.RootstockRpcServerMock)
 		rpcMock.On("GetBalance", test.AnyCtx, rskTestAddress).Return(nil, assert.AnError).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, nil, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{})
 		err := lp.HasPeginLiquidity(context.Background(), entities.NewWei(1))
 		require.Error(t, err)
 	})
@@ -361,21 +418,18 @@ func TestLocalLiquidityProvider_HasPeginLiquidity_ErrorHandling(t *testing.T) {
 		rpcMock.On("GetBalance", test.AnyCtx, rskTestAddress).Return(entities.NewWei(100), nil).Once()
 		peginContractMock := new(mocks.PeginContractMock)
 		peginContractMock.EXPECT().GetBalance(rskTestAddress).Return(nil, assert.AnError).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, nil, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
 		err := lp.HasPeginLiquidity(context.Background(), entities.NewWei(1))
 		require.Error(t, err)
 	})
-	t.Run("Error pegin quotes from db", func(t *testing.T) {
+	t.Run("Error pegin holds from db", func(t *testing.T) {
 		rpcMock := new(mocks.RootstockRpcServerMock)
 		rpcMock.On("GetBalance", test.AnyCtx, rskTestAddress).Return(entities.NewWei(100), nil).Once()
 		peginContractMock := new(mocks.PeginContractMock)
 		peginContractMock.EXPECT().GetBalance(rskTestAddress).Return(entities.NewWei(200), nil).Once()
-		peginRepository := new(mocks.PeginQuoteRepositoryMock)
-		peginRepository.On("GetRetainedQuoteByState", test.AnyCtx,
-			quote.PeginStateWaitingForDeposit,
-			quote.PeginStateWaitingForDepositConfirmations,
-		).Return(nil, assert.AnError).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(peginRepository, nil, nil, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
+		ledger := new(mocks.LiquidityLedgerMock)
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, liquidity_provider.PeginHold).Return(nil, assert.AnError).Once()
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, ledger, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
 		err := lp.HasPeginLiquidity(context.Background(), entities.NewWei(1))
 		require.Error(t, err)
 	})
@@ -386,13 +440,9 @@ func TestLocalLiquidityProvider_AvailablePeginLiquidity(t *testing.T) {
 	t.Run("should return available pegin liquidity", func(t *testing.T) {
 		signer := new(mocks.TransactionSignerMock)
 		signer.On("Address").Return(common.HexToAddress(rskTestAddress)).Twice()
-		peginRepository := new(mocks.PeginQuoteRepositoryMock)
-		peginRepository.On("GetRetainedQuoteByState", test.AnyCtx,
-			quote.PeginStateWaitingForDeposit, quote.PeginStateWaitingForDepositConfirmations,
-		).Return([]quote.RetainedPeginQuote{
-			{RequiredLiquidity: entities.NewWei(300)},
-			{RequiredLiquidity: entities.NewWei(500)},
-			{RequiredLiquidity: entities.NewWei(400)},
+		ledger := new(mocks.LiquidityLedgerMock)
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, liquidity_provider.PeginHold).Return([]liquidity_provider.LiquidityHold{
+			activeTestHold(300), activeTestHold(500), activeTestHold(400),
 		}, nil).Once()
 		pegoutRepository := new(mocks.PegoutQuoteRepositoryMock)
 		pegoutRepository.On("GetRetainedQuoteByState", test.AnyCtx,
@@ -405,14 +455,14 @@ func TestLocalLiquidityProvider_AvailablePeginLiquidity(t *testing.T) {
 		peginContractMock.EXPECT().GetBalance(rskTestAddress).Return(entities.NewWei(2000), nil).Once()
 		rpcMock := new(mocks.RootstockRpcServerMock)
 		rpcMock.On("GetBalance", test.AnyCtx, rskTestAddress).Return(entities.NewWei(800), nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(peginRepository, pegoutRepository, nil, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepository, nil, ledger, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
 
 		liquidity, err := lp.AvailablePeginLiquidity(context.Background())
 		require.NoError(t, err)
 		assert.Equal(t, entities.NewWei(1350), liquidity)
 		peginContractMock.AssertExpectations(t)
 		rpcMock.AssertExpectations(t)
-		peginRepository.AssertExpectations(t)
+		ledger.AssertExpectations(t)
 		pegoutRepository.AssertExpectations(t)
 		signer.AssertExpectations(t)
 	})
@@ -420,11 +470,9 @@ func TestLocalLiquidityProvider_AvailablePeginLiquidity(t *testing.T) {
 	t.Run("should return 0 if the locked liquidity is higher than the available", func(t *testing.T) {
 		signer := new(mocks.TransactionSignerMock)
 		signer.On("Address").Return(common.HexToAddress(rskTestAddress)).Twice()
-		peginRepository := new(mocks.PeginQuoteRepositoryMock)
-		peginRepository.On("GetRetainedQuoteByState", test.AnyCtx,
-			quote.PeginStateWaitingForDeposit, quote.PeginStateWaitingForDepositConfirmations,
-		).Return([]quote.RetainedPeginQuote{
-			{RequiredLiquidity: entities.NewWei(300)}, {RequiredLiquidity: entities.NewWei(500)}, {RequiredLiquidity: entities.NewWei(400)},
+		ledger := new(mocks.LiquidityLedgerMock)
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, liquidity_provider.PeginHold).Return([]liquidity_provider.LiquidityHold{
+			activeTestHold(300), activeTestHold(500), activeTestHold(400),
 		}, nil).Once()
 		pegoutRepository := new(mocks.PegoutQuoteRepositoryMock)
 		pegoutRepository.On("GetRetainedQuoteByState", test.AnyCtx,
@@ -436,14 +484,14 @@ func TestLocalLiquidityProvider_AvailablePeginLiquidity(t *testing.T) {
 		peginContractMock.EXPECT().GetBalance(rskTestAddress).Return(entities.NewWei(100), nil).Once()
 		rpcMock := new(mocks.RootstockRpcServerMock)
 		rpcMock.On("GetBalance", test.AnyCtx, rskTestAddress).Return(entities.NewWei(500), nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(peginRepository, pegoutRepository, nil, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepository, nil, ledger, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
 
 		liquidity, err := lp.AvailablePeginLiquidity(context.Background())
 		require.NoError(t, err)
 		assert.Equal(t, entities.NewWei(0), liquidity)
 		peginContractMock.AssertExpectations(t)
 		rpcMock.AssertExpectations(t)
-		peginRepository.AssertExpectations(t)
+		ledger.AssertExpectations(t)
 		pegoutRepository.AssertExpectations(t)
 		signer.AssertExpectations(t)
 	})
@@ -455,7 +503,7 @@ func TestLocalLiquidityProvider_AvailablePeginLiquidity_ErrorHandling(t *testing
 	t.Run("Error getting balance from RSK RPC server when getting available pegin liquidity", func(t *testing.T) {
 		rpcMock := new(mocks.RootstockRpcServerMock)
 		rpcMock.On("GetBalance", test.AnyCtx, rskTestAddress).Return(nil, assert.AnError).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, nil, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{})
 		liquidity, err := lp.AvailablePeginLiquidity(context.Background())
 		require.Error(t, err)
 		assert.Nil(t, liquidity)
@@ -465,19 +513,19 @@ func TestLocalLiquidityProvider_AvailablePeginLiquidity_ErrorHandling(t *testing
 		rpcMock.On("GetBalance", test.AnyCtx, rskTestAddress).Return(entities.NewWei(100), nil).Once()
 		peginContractMock := new(mocks.PeginContractMock)
 		peginContractMock.EXPECT().GetBalance(rskTestAddress).Return(nil, assert.AnError).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, nil, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
 		liquidity, err := lp.AvailablePeginLiquidity(context.Background())
 		require.Error(t, err)
 		assert.Nil(t, liquidity)
 	})
-	t.Run("Error getting pegin quotes from db when getting available pegin liquidity", func(t *testing.T) {
+	t.Run("Error getting pegin holds from db when getting available pegin liquidity", func(t *testing.T) {
 		rpcMock := new(mocks.RootstockRpcServerMock)
 		rpcMock.On("GetBalance", test.AnyCtx, rskTestAddress).Return(entities.NewWei(100), nil).Once()
 		peginContractMock := new(mocks.PeginContractMock)
 		peginContractMock.EXPECT().GetBalance(rskTestAddress).Return(entities.NewWei(200), nil).Once()
-		peginRepository := new(mocks.PeginQuoteRepositoryMock)
-		peginRepository.On("GetRetainedQuoteByState", test.AnyCtx, quote.PeginStateWaitingForDeposit, quote.PeginStateWaitingForDepositConfirmations).Return(nil, assert.AnError).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(peginRepository, nil, nil, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
+		ledger := new(mocks.LiquidityLedgerMock)
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, liquidity_provider.PeginHold).Return(nil, assert.AnError).Once()
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, nil, ledger, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
 		liquidity, err := lp.AvailablePeginLiquidity(context.Background())
 		require.Error(t, err)
 		assert.Nil(t, liquidity)
@@ -487,12 +535,11 @@ func TestLocalLiquidityProvider_AvailablePeginLiquidity_ErrorHandling(t *testing
 		rpcMock.On("GetBalance", test.AnyCtx, rskTestAddress).Return(entities.NewWei(100), nil).Once()
 		peginContractMock := new(mocks.PeginContractMock)
 		peginContractMock.EXPECT().GetBalance(rskTestAddress).Return(entities.NewWei(200), nil).Once()
-		peginRepository := new(mocks.PeginQuoteRepositoryMock)
-		peginRepository.On("GetRetainedQuoteByState", test.AnyCtx, quote.PeginStateWaitingForDeposit, quote.PeginStateWaitingForDepositConfirmations).
-			Return([]quote.RetainedPeginQuote{{RequiredLiquidity: entities.NewWei(300)}}, nil).Once()
+		ledger := new(mocks.LiquidityLedgerMock)
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, liquidity_provider.PeginHold).Return([]liquidity_provider.LiquidityHold{activeTestHold(300)}, nil).Once()
 		pegoutRepository := new(mocks.PegoutQuoteRepositoryMock)
 		pegoutRepository.On("GetRetainedQuoteByState", test.AnyCtx, quote.PegoutStateRefundPegOutSucceeded).Return(nil, assert.AnError).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(peginRepository, pegoutRepository, nil, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, pegoutRepository, nil, ledger, blockchain.Rpc{Rsk: rpcMock}, signer, nil, blockchain.RskContracts{PegIn: peginContractMock})
 		liquidity, err := lp.AvailablePeginLiquidity(context.Background())
 		require.Error(t, err)
 		assert.Nil(t, liquidity)
@@ -506,14 +553,14 @@ func TestLocalLiquidityProvider_GeneralConfiguration(t *testing.T) {
 	lpRepository := new(mocks.LiquidityProviderRepositoryMock)
 	t.Run("Return signed general configuration from db", func(t *testing.T) {
 		lpRepository.On("GetGeneralConfiguration", test.AnyCtx).Return(getGeneralConfigurationMock(), nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
 		result := lp.GeneralConfiguration(context.Background())
 		assert.Equal(t, getGeneralConfigurationMock().Value, result)
 		assert.NotEqual(t, liquidity_provider.DefaultGeneralConfiguration(), result)
 	})
 	t.Run("Return default general configuration when db doesn't have configuration", func(t *testing.T) {
 		lpRepository.On("GetGeneralConfiguration", test.AnyCtx).Return(nil, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
 
 		defer test.AssertLogContains(t, "Custom general configuration not found. Using default configuration.")()
 
@@ -523,7 +570,7 @@ func TestLocalLiquidityProvider_GeneralConfiguration(t *testing.T) {
 	})
 	t.Run("Return default general configuration on db read error", func(t *testing.T) {
 		lpRepository.On("GetGeneralConfiguration", test.AnyCtx).Return(nil, errors.New("database error")).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
 
 		defer test.AssertLogContains(t, "Error getting general configuration, using default configuration. Error: database error")()
 		config := lp.GeneralConfiguration(context.Background())
@@ -535,7 +582,7 @@ func TestLocalLiquidityProvider_GeneralConfiguration(t *testing.T) {
 		tamperedConfig := getGeneralConfigurationMock()
 		tamperedConfig.Value.RskConfirmations["2000000000000000000"] = 40
 		lpRepository.On("GetGeneralConfiguration", test.AnyCtx).Return(tamperedConfig, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
 		defer test.AssertLogContains(t, "Tampered general configuration. Using default configuration.")()
 		result := lp.GeneralConfiguration(context.Background())
 
@@ -545,7 +592,7 @@ func TestLocalLiquidityProvider_GeneralConfiguration(t *testing.T) {
 		invalidSignatureConfig := getGeneralConfigurationMock()
 		invalidSignatureConfig.Signature = "94530cf2d078ce7e44b4ce1d63a0cf7a225f07d4414f4dcf132f097fd027c08c7252b012ffff6855400fbc96939662904b22ce0b7a010bcb0b7a2c7db9dc26b702"
 		lpRepository.On("GetGeneralConfiguration", test.AnyCtx).Return(invalidSignatureConfig, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
 
 		defer test.AssertLogContains(t, "Invalid general configuration signature. Using default configuration.")()
 
@@ -557,7 +604,7 @@ func TestLocalLiquidityProvider_GeneralConfiguration(t *testing.T) {
 		integrityFailConfig := getGeneralConfigurationMock()
 		integrityFailConfig.Hash = "83cb825a5f8dcf1bdd3cd33effffda7a34ed8b0d80a39445049ddc9c06ecb1a9" // A fake one
 		lpRepository.On("GetGeneralConfiguration", test.AnyCtx).Return(integrityFailConfig, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
 
 		defer test.AssertLogContains(t, "Tampered general configuration. Using default configuration.")()
 
@@ -569,7 +616,7 @@ func TestLocalLiquidityProvider_GeneralConfiguration(t *testing.T) {
 		integrityFailConfig := getGeneralConfigurationMock()
 		integrityFailConfig.Hash = "an-invalid-hash" // Will fail hash validation
 		lpRepository.On("GetGeneralConfiguration", test.AnyCtx).Return(integrityFailConfig, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
 
 		defer test.AssertLogContains(t, "Error getting general configuration, using default configuration. Error: encoding/hex: invalid byte: U+006E")()
 
@@ -586,14 +633,14 @@ func TestLocalLiquidityProvider_PeginConfiguration(t *testing.T) {
 	lpRepository := new(mocks.LiquidityProviderRepositoryMock)
 	t.Run("Return signed pegin configuration from db", func(t *testing.T) {
 		lpRepository.On("GetPeginConfiguration", test.AnyCtx).Return(getPeginConfigurationMock(), nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
 		result := lp.PeginConfiguration(context.Background())
 		assert.Equal(t, getPeginConfigurationMock().Value, result)
 		assert.NotEqual(t, liquidity_provider.DefaultPeginConfiguration(), result)
 	})
 	t.Run("Return default pegin configuration when db doesn't have configuration", func(t *testing.T) {
 		lpRepository.On("GetPeginConfiguration", test.AnyCtx).Return(nil, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
 
 		defer test.AssertLogContains(t, "Custom pegin configuration not found. Using default configuration.")()
 
@@ -603,7 +650,7 @@ func TestLocalLiquidityProvider_PeginConfiguration(t *testing.T) {
 	})
 	t.Run("Return default pegin configuration on db read error", func(t *testing.T) {
 		lpRepository.On("GetPeginConfiguration", test.AnyCtx).Return(nil, errors.New("database error")).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
 
 		defer test.AssertLogContains(t, "Error getting pegin configuration, using default configuration. Error: database error")()
 		config := lp.PeginConfiguration(context.Background())
@@ -615,7 +662,7 @@ func TestLocalLiquidityProvider_PeginConfiguration(t *testing.T) {
 		tamperedConfig := getPeginConfigurationMock()
 		tamperedConfig.Value.MinValue = entities.NewWei(1)
 		lpRepository.On("GetPeginConfiguration", test.AnyCtx).Return(tamperedConfig, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
 		defer test.AssertLogContains(t, "Tampered pegin configuration. Using default configuration.")()
 		result := lp.PeginConfiguration(context.Background())
 
@@ -625,7 +672,7 @@ func TestLocalLiquidityProvider_PeginConfiguration(t *testing.T) {
 		invalidSignatureConfig := getPeginConfigurationMock()
 		invalidSignatureConfig.Signature = "93530cf2d078ce7e44c4ce1d63a0cf7a225f07d4414f4dcf132f097fd027c08c7252b012f1ff6855400fbc96939662904b22ce0b7a010bcb0b7a2c7db9dc26b702"
 		lpRepository.On("GetPeginConfiguration", test.AnyCtx).Return(invalidSignatureConfig, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
 
 		defer test.AssertLogContains(t, "Invalid pegin configuration signature. Using default configuration.")()
 
@@ -637,7 +684,7 @@ func TestLocalLiquidityProvider_PeginConfiguration(t *testing.T) {
 		integrityFailConfig := getPeginConfigurationMock()
 		integrityFailConfig.Hash = "83cb825a5f8dcf1bdd3cd33effffda7a34ed8b0d80a39445049ddc9c06ecb1a9" // A fake one
 		lpRepository.On("GetPeginConfiguration", test.AnyCtx).Return(integrityFailConfig, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
 
 		defer test.AssertLogContains(t, "Tampered pegin configuration. Using default configuration.")()
 
@@ -649,7 +696,7 @@ func TestLocalLiquidityProvider_PeginConfiguration(t *testing.T) {
 		integrityFailConfig := getPeginConfigurationMock()
 		integrityFailConfig.Hash = "an-invalid-hash" // Will fail hash validation
 		lpRepository.On("GetPeginConfiguration", test.AnyCtx).Return(integrityFailConfig, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
 
 		defer test.AssertLogContains(t, "Error getting pegin configuration, using default configuration. Error: encoding/hex: invalid byte: U+006E")()
 
@@ -666,14 +713,14 @@ func TestLocalLiquidityProvider_PegoutConfiguration(t *testing.T) {
 	lpRepository := new(mocks.LiquidityProviderRepositoryMock)
 	t.Run("Return signed pegout configuration from db", func(t *testing.T) {
 		lpRepository.On("GetPegoutConfiguration", test.AnyCtx).Return(getPegoutConfigurationMock(), nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
 		result := lp.PegoutConfiguration(context.Background())
 		assert.Equal(t, getPegoutConfigurationMock().Value, result)
 		assert.NotEqual(t, liquidity_provider.DefaultPegoutConfiguration(), result)
 	})
 	t.Run("Return default pegout configuration when db doesn't have configuration", func(t *testing.T) {
 		lpRepository.On("GetPegoutConfiguration", test.AnyCtx).Return(nil, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
 
 		defer test.AssertLogContains(t, "Custom pegout configuration not found. Using default configuration.")()
 
@@ -683,7 +730,7 @@ func TestLocalLiquidityProvider_PegoutConfiguration(t *testing.T) {
 	})
 	t.Run("Return default pegout configuration on db read error", func(t *testing.T) {
 		lpRepository.On("GetPegoutConfiguration", test.AnyCtx).Return(nil, errors.New("database error")).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, nil, nil, blockchain.RskContracts{})
 
 		defer test.AssertLogContains(t, "Error getting pegout configuration, using default configuration. Error: database error")()
 		config := lp.PegoutConfiguration(context.Background())
@@ -695,7 +742,7 @@ func TestLocalLiquidityProvider_PegoutConfiguration(t *testing.T) {
 		tamperedConfig := getPegoutConfigurationMock()
 		tamperedConfig.Value.MaxValue = entities.NewWei(1)
 		lpRepository.On("GetPegoutConfiguration", test.AnyCtx).Return(tamperedConfig, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
 		defer test.AssertLogContains(t, "Tampered pegout configuration. Using default configuration.")()
 		result := lp.PegoutConfiguration(context.Background())
 
@@ -705,7 +752,7 @@ func TestLocalLiquidityProvider_PegoutConfiguration(t *testing.T) {
 		invalidSignatureConfig := getPegoutConfigurationMock()
 		invalidSignatureConfig.Signature = "93530cf2d078ce7e44c4ce1d63a0cf7a225f07d4414f4dcf133f097fd027d08c7252b012f1ff6855400fbc96939662904b22ce0b7a010bcb0b7a2c7db9dc26b702"
 		lpRepository.On("GetPegoutConfiguration", test.AnyCtx).Return(invalidSignatureConfig, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
 
 		defer test.AssertLogContains(t, "Invalid pegout configuration signature. Using default configuration.")()
 
@@ -717,7 +764,7 @@ func TestLocalLiquidityProvider_PegoutConfiguration(t *testing.T) {
 		integrityFailConfig := getPegoutConfigurationMock()
 		integrityFailConfig.Hash = "83cb825a5f8dcf1bdd3cd33effffda7a34ed8b0d80a39445049ddc9c06ecb1a9" // A fake one
 		lpRepository.On("GetPegoutConfiguration", test.AnyCtx).Return(integrityFailConfig, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
 
 		defer test.AssertLogContains(t, "Tampered pegout configuration. Using default configuration.")()
 
@@ -729,7 +776,7 @@ func TestLocalLiquidityProvider_PegoutConfiguration(t *testing.T) {
 		integrityFailConfig := getPegoutConfigurationMock()
 		integrityFailConfig.Hash = "an-invalid-hash" // Will fail hash validation
 		lpRepository.On("GetPegoutConfiguration", test.AnyCtx).Return(integrityFailConfig, nil).Once()
-		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
+		lp := dataproviders.NewLocalLiquidityProvider(nil, nil, lpRepository, nil, blockchain.Rpc{}, wallet, nil, blockchain.RskContracts{})
 
 		defer test.AssertLogContains(t, "Error getting pegout configuration, using default configuration. Error: encoding/hex: invalid byte: U+006E")()
 
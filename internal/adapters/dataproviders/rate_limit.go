@@ -0,0 +1,55 @@
+package dataproviders
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+)
+
+const localRateLimiterCleanupInterval = 5 * time.Minute
+
+type localBucket struct {
+	entities.TokenBucket
+	fillTime time.Duration
+}
+
+// LocalRateLimiter keeps the token buckets in memory, so the limits are enforced per server instance
+type LocalRateLimiter struct {
+	mutex       sync.Mutex
+	buckets     map[string]localBucket
+	lastCleanup time.Time
+}
+
+func NewLocalRateLimiter() *LocalRateLimiter {
+	return &LocalRateLimiter{buckets: make(map[string]localBucket), lastCleanup: time.Now()}
+}
+
+func (limiter *LocalRateLimiter) Take(_ context.Context, key string, limit entities.RateLimit, cost uint64) (entities.RateLimitResult, error) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	now := time.Now()
+	limiter.cleanup(now)
+
+	var current *entities.TokenBucket
+	if bucket, ok := limiter.buckets[key]; ok {
+		current = &bucket.TokenBucket
+	}
+	updated, result := limit.Take(current, now, cost)
+	limiter.buckets[key] = localBucket{TokenBucket: updated, fillTime: limit.FillTime()}
+	return result, nil
+}
+
+// cleanup removes the buckets that are already full, since they're equivalent to a key that was never used
+func (limiter *LocalRateLimiter) cleanup(now time.Time) {
+	if now.Sub(limiter.lastCleanup) < localRateLimiterCleanupInterval {
+		return
+	}
+	for key, bucket := range limiter.buckets {
+		if now.Sub(bucket.UpdatedAt) >= bucket.fillTime {
+			delete(limiter.buckets, key)
+		}
+	}
+	limiter.lastCleanup = now
+}
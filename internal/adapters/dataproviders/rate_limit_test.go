@@ -0,0 +1,72 @@
+package dataproviders_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalRateLimiter_Take(t *testing.T) {
+	limit := entities.RateLimit{PerMinute: 1, Burst: 3}
+	t.Run("should allow the burst and reject the next request", func(t *testing.T) {
+		limiter := dataproviders.NewLocalRateLimiter()
+		for i := 0; i < 3; i++ {
+			result, err := limiter.Take(context.Background(), "key", limit, 1)
+			require.NoError(t, err)
+			assert.True(t, result.Allowed)
+		}
+		result, err := limiter.Take(context.Background(), "key", limit, 1)
+		require.NoError(t, err)
+		assert.False(t, result.Allowed)
+		assert.InDelta(t, time.Minute, result.RetryAfter, float64(time.Second))
+	})
+	t.Run("should consume the tokens of the cost", func(t *testing.T) {
+		limiter := dataproviders.NewLocalRateLimiter()
+		result, err := limiter.Take(context.Background(), "key", limit, 2)
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+		result, err = limiter.Take(context.Background(), "key", limit, 2)
+		require.NoError(t, err)
+		assert.False(t, result.Allowed)
+		result, err = limiter.Take(context.Background(), "key", limit, 1)
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+	})
+	t.Run("should keep independent buckets per key", func(t *testing.T) {
+		limiter := dataproviders.NewLocalRateLimiter()
+		for i := 0; i < 3; i++ {
+			_, err := limiter.Take(context.Background(), "key", limit, 1)
+			require.NoError(t, err)
+		}
+		result, err := limiter.Take(context.Background(), "other", limit, 1)
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+	})
+	t.Run("should be safe for concurrent use", func(t *testing.T) {
+		limiter := dataproviders.NewLocalRateLimiter()
+		var wg sync.WaitGroup
+		var mutex sync.Mutex
+		allowed := 0
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				result, err := limiter.Take(context.Background(), "key", limit, 1)
+				assert.NoError(t, err)
+				mutex.Lock()
+				defer mutex.Unlock()
+				if result.Allowed {
+					allowed++
+				}
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 3, allowed)
+	})
+}
@@ -0,0 +1,192 @@
+package remote_signer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// SignerType is the implementation of the external signer, since Clef and Web3Signer expose the same
+// operations through different JSON-RPC methods
+type SignerType string
+
+const (
+	ClefSigner       SignerType = "clef"
+	Web3SignerSigner SignerType = "web3signer"
+)
+
+// textPlainContentType makes Clef sign the data as an EIP-191 personal message (version 0x45)
+const textPlainContentType = "text/plain"
+
+type signerMethods struct {
+	accounts        string
+	signTransaction string
+	signTypedData   string
+	signMessage     string
+}
+
+var methodsByType = map[SignerType]signerMethods{
+	ClefSigner: {
+		accounts:        "account_list",
+		signTransaction: "account_signTransaction",
+		signTypedData:   "account_signTypedData",
+		signMessage:     "account_signData",
+	},
+	Web3SignerSigner: {
+		accounts:        "eth_accounts",
+		signTransaction: "eth_signTransaction",
+		signTypedData:   "eth_signTypedData",
+		signMessage:     "eth_sign",
+	},
+}
+
+var (
+	UnsupportedTransactionError = errors.New("unsupported transaction type for remote signature")
+	UnsupportedSignerError      = errors.New("unsupported remote signer type")
+)
+
+// Client is a JSON-RPC client for an external signer, either Clef or Web3Signer. The connection can
+// be established through HTTP(S), WebSocket or IPC, depending on the URL
+type Client struct {
+	rpc        *rpc.Client
+	signerType SignerType
+	methods    signerMethods
+}
+
+func NewClient(rpcClient *rpc.Client, signerType SignerType) (*Client, error) {
+	methods, ok := methodsByType[signerType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", UnsupportedSignerError, signerType)
+	}
+	return &Client{rpc: rpcClient, signerType: signerType, methods: methods}, nil
+}
+
+func Dial(ctx context.Context, url string, signerType SignerType) (*Client, error) {
+	if _, ok := methodsByType[signerType]; !ok {
+		return nil, fmt.Errorf("%w: %s", UnsupportedSignerError, signerType)
+	}
+	rpcClient, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to remote signer: %w", err)
+	}
+	return NewClient(rpcClient, signerType)
+}
+
+func (client *Client) Close() {
+	client.rpc.Close()
+}
+
+func (client *Client) Accounts(ctx context.Context) ([]common.Address, error) {
+	var accounts []common.Address
+	if err := client.rpc.CallContext(ctx, &accounts, client.methods.accounts); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// TransactionArgs are the arguments of the account_signTransaction (Clef) and eth_signTransaction (Web3Signer) methods
+type TransactionArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	ChainId              *hexutil.Big    `json:"chainId"`
+}
+
+func NewTransactionArgs(from common.Address, chainId *big.Int, tx *geth.Transaction) (TransactionArgs, error) {
+	args := TransactionArgs{
+		From:    from,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainId: (*hexutil.Big)(chainId),
+	}
+	switch tx.Type() {
+	case geth.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case geth.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	default:
+		return TransactionArgs{}, fmt.Errorf("%w: %d", UnsupportedTransactionError, tx.Type())
+	}
+	return args, nil
+}
+
+// SignTransaction returns the signed transaction. Web3Signer returns the raw transaction as the result while
+// Clef returns an object with the raw transaction in the raw field, so both formats are accepted
+func (client *Client) SignTransaction(ctx context.Context, args TransactionArgs) (*geth.Transaction, error) {
+	var result json.RawMessage
+	if err := client.rpc.CallContext(ctx, &result, client.methods.signTransaction, args); err != nil {
+		return nil, err
+	}
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err != nil {
+		var clefResult struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err = json.Unmarshal(result, &clefResult); err != nil || len(clefResult.Raw) == 0 {
+			return nil, fmt.Errorf("invalid signed transaction returned by remote signer: %s", string(result))
+		}
+		raw = clefResult.Raw
+	}
+	tx := new(geth.Transaction)
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("error decoding signed transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// SignTypedData returns the EIP-712 signature of the typed data, which must be the JSON encoding of the typed
+// data object (types, primaryType, domain and message) as defined in the eth_signTypedData_v4 method
+func (client *Client) SignTypedData(ctx context.Context, address common.Address, typedData json.RawMessage) ([]byte, error) {
+	var signature hexutil.Bytes
+	if err := client.rpc.CallContext(ctx, &signature, client.methods.signTypedData, address, typedData); err != nil {
+		return nil, err
+	}
+	return normalizeSignature(signature)
+}
+
+// SignMessage returns the EIP-191 signature of the message, the one done by the personal_sign method. The signer
+// adds the "\x19Ethereum Signed Message:\n" prefix to the message before hashing it
+func (client *Client) SignMessage(ctx context.Context, address common.Address, message []byte) ([]byte, error) {
+	var signature hexutil.Bytes
+	var err error
+	if client.signerType == ClefSigner {
+		err = client.rpc.CallContext(ctx, &signature, client.methods.signMessage, textPlainContentType, address, hexutil.Bytes(message))
+	} else {
+		err = client.rpc.CallContext(ctx, &signature, client.methods.signMessage, address, hexutil.Bytes(message))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return normalizeSignature(signature)
+}
+
+// normalizeSignature validates the signature returned by the signer and returns it in the [R || S || V] format,
+// being V 0 or 1
+func normalizeSignature(signature []byte) ([]byte, error) {
+	const signatureLength = 65
+	if len(signature) != signatureLength {
+		return nil, fmt.Errorf("invalid signature length returned by remote signer: %d", len(signature))
+	}
+	// the signers return V as 27 or 28
+	if signature[signatureLength-1] >= 27 {
+		signature[signatureLength-1] -= 27
+	}
+	return signature, nil
+}
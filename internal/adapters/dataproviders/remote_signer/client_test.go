@@ -0,0 +1,181 @@
+package remote_signer_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/remote_signer"
+	"github.com/rsksmart/liquidity-provider-server/test/remote_signer_stub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainId = 31
+
+var (
+	testRecipient = common.HexToAddress("0x79568C2989232dcA1840087d73d403602364c0D4")
+	signerTypes   = []remote_signer.SignerType{remote_signer.ClefSigner, remote_signer.Web3SignerSigner}
+)
+
+func newClient(t *testing.T, signerType remote_signer.SignerType) (*remote_signer.Client, *remote_signer_stub.Server) {
+	server := remote_signer_stub.NewServer(t, signerType)
+	client, err := remote_signer.Dial(context.Background(), server.URL, signerType)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	return client, server
+}
+
+func TestDial(t *testing.T) {
+	t.Run("should return error if the url is invalid", func(t *testing.T) {
+		client, err := remote_signer.Dial(context.Background(), "invalid://signer", remote_signer.ClefSigner)
+		require.ErrorContains(t, err, "error connecting to remote signer")
+		assert.Nil(t, client)
+	})
+	t.Run("should return error if the signer type is not supported", func(t *testing.T) {
+		client, err := remote_signer.Dial(context.Background(), "http://localhost:8550", "other")
+		require.ErrorIs(t, err, remote_signer.UnsupportedSignerError)
+		assert.Nil(t, client)
+	})
+}
+
+func TestClient_Accounts(t *testing.T) {
+	for _, signerType := range signerTypes {
+		t.Run(string(signerType), func(t *testing.T) {
+			client, server := newClient(t, signerType)
+			result, err := client.Accounts(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, []common.Address{server.Address}, result)
+		})
+	}
+}
+
+func TestNewTransactionArgs(t *testing.T) {
+	from := common.HexToAddress("0x9D93929A9099be4355fC2389FbF253982F9dF47c")
+	chainId := big.NewInt(testChainId)
+	t.Run("should set gas price for legacy transactions", func(t *testing.T) {
+		tx := geth.NewTx(&geth.LegacyTx{Nonce: 1, GasPrice: big.NewInt(5), Gas: 21000, To: &testRecipient, Value: big.NewInt(7), Data: []byte{1}})
+		args, err := remote_signer.NewTransactionArgs(from, chainId, tx)
+		require.NoError(t, err)
+		assert.Equal(t, from, args.From)
+		assert.Equal(t, &testRecipient, args.To)
+		assert.Equal(t, big.NewInt(5), args.GasPrice.ToInt())
+		assert.Nil(t, args.MaxFeePerGas)
+		assert.Equal(t, big.NewInt(7), args.Value.ToInt())
+		assert.Equal(t, chainId, args.ChainId.ToInt())
+		assert.EqualValues(t, 1, args.Nonce)
+		assert.EqualValues(t, 21000, args.Gas)
+		assert.EqualValues(t, []byte{1}, args.Data)
+	})
+	t.Run("should set fee caps for dynamic fee transactions", func(t *testing.T) {
+		tx := geth.NewTx(&geth.DynamicFeeTx{ChainID: chainId, GasTipCap: big.NewInt(2), GasFeeCap: big.NewInt(9), Gas: 21000, To: &testRecipient, Value: big.NewInt(7)})
+		args, err := remote_signer.NewTransactionArgs(from, chainId, tx)
+		require.NoError(t, err)
+		assert.Nil(t, args.GasPrice)
+		assert.Equal(t, big.NewInt(9), args.MaxFeePerGas.ToInt())
+		assert.Equal(t, big.NewInt(2), args.MaxPriorityFeePerGas.ToInt())
+	})
+	t.Run("should reject other transaction types", func(t *testing.T) {
+		tx := geth.NewTx(&geth.AccessListTx{ChainID: chainId, GasPrice: big.NewInt(2), Gas: 21000, To: &testRecipient, Value: big.NewInt(7)})
+		_, err := remote_signer.NewTransactionArgs(from, chainId, tx)
+		require.ErrorIs(t, err, remote_signer.UnsupportedTransactionError)
+	})
+}
+
+func TestClient_SignTransaction(t *testing.T) {
+	chainId := big.NewInt(testChainId)
+	tx := geth.NewTx(&geth.LegacyTx{Nonce: 3, GasPrice: big.NewInt(60000000), Gas: 21000, To: &testRecipient, Value: big.NewInt(1000)})
+	for _, signerType := range signerTypes {
+		client, server := newClient(t, signerType)
+		args, err := remote_signer.NewTransactionArgs(server.Address, chainId, tx)
+		require.NoError(t, err)
+		assertSigned := func(t *testing.T, signedTx *geth.Transaction) {
+			signer := geth.LatestSignerForChainID(chainId)
+			sender, senderErr := geth.Sender(signer, signedTx)
+			require.NoError(t, senderErr)
+			assert.Equal(t, server.Address, sender)
+			assert.Equal(t, signer.Hash(tx), signer.Hash(signedTx))
+		}
+		t.Run(string(signerType)+" should decode the raw transaction", func(t *testing.T) {
+			server.ClefFormat = false
+			signedTx, signErr := client.SignTransaction(context.Background(), args)
+			require.NoError(t, signErr)
+			assertSigned(t, signedTx)
+		})
+		t.Run(string(signerType)+" should decode the clef response", func(t *testing.T) {
+			server.ClefFormat = true
+			signedTx, signErr := client.SignTransaction(context.Background(), args)
+			require.NoError(t, signErr)
+			assertSigned(t, signedTx)
+		})
+		t.Run(string(signerType)+" should return the signer error", func(t *testing.T) {
+			server.Reject = true
+			defer func() { server.Reject = false }()
+			signedTx, signErr := client.SignTransaction(context.Background(), args)
+			require.ErrorContains(t, signErr, "request denied")
+			assert.Nil(t, signedTx)
+		})
+	}
+}
+
+func TestClient_SignMessage(t *testing.T) {
+	message := crypto.Keccak256([]byte("configuration"))
+	for _, signerType := range signerTypes {
+		client, server := newClient(t, signerType)
+		t.Run(string(signerType)+" should return the personal message signature with a 0 or 1 recovery id", func(t *testing.T) {
+			signature, err := client.SignMessage(context.Background(), server.Address, message)
+			require.NoError(t, err)
+			require.Len(t, signature, crypto.SignatureLength)
+			assert.LessOrEqual(t, signature[crypto.RecoveryIDOffset], byte(1))
+			assert.Equal(t, message, server.SignedMessages[len(server.SignedMessages)-1])
+			publicKey, err := crypto.SigToPub(accounts.TextHash(message), signature)
+			require.NoError(t, err)
+			assert.Equal(t, server.Address, crypto.PubkeyToAddress(*publicKey))
+		})
+		t.Run(string(signerType)+" should return the signer error", func(t *testing.T) {
+			signature, err := client.SignMessage(context.Background(), testRecipient, message)
+			require.ErrorContains(t, err, "request denied")
+			assert.Nil(t, signature)
+		})
+	}
+}
+
+func TestClient_SignTypedData(t *testing.T) {
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "chainId", Type: "uint256"}},
+			"Mail":         {{Name: "contents", Type: "string"}, {Name: "amount", Type: "uint256"}},
+		},
+		PrimaryType: "Mail",
+		Domain:      apitypes.TypedDataDomain{Name: "Test", ChainId: math.NewHexOrDecimal256(testChainId)},
+		Message:     apitypes.TypedDataMessage{"contents": "hello", "amount": "5"},
+	}
+	rawTypedData, err := json.Marshal(typedData)
+	require.NoError(t, err)
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+	for _, signerType := range signerTypes {
+		client, server := newClient(t, signerType)
+		t.Run(string(signerType)+" should return the typed data signature with a 0 or 1 recovery id", func(t *testing.T) {
+			signature, signErr := client.SignTypedData(context.Background(), server.Address, rawTypedData)
+			require.NoError(t, signErr)
+			require.Len(t, signature, crypto.SignatureLength)
+			assert.LessOrEqual(t, signature[crypto.RecoveryIDOffset], byte(1))
+			publicKey, recoverErr := crypto.SigToPub(hash, signature)
+			require.NoError(t, recoverErr)
+			assert.Equal(t, server.Address, crypto.PubkeyToAddress(*publicKey))
+		})
+		t.Run(string(signerType)+" should return the signer error", func(t *testing.T) {
+			signature, signErr := client.SignTypedData(context.Background(), testRecipient, rawTypedData)
+			require.ErrorContains(t, signErr, "request denied")
+			assert.Nil(t, signature)
+		})
+	}
+}
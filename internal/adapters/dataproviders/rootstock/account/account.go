@@ -134,6 +134,26 @@ func (account *RskAccount) UsePrivateKeyWif(usageFunc func(wif *btcutil.WIF) err
 	return usageFunc(wif)
 }
 
+// UpdatePassword re-encrypts the local keystore of the account with a new password. The new encrypted json must contain
+// the key of the same account, this way a password rotation can't be used to replace the key of the LPS
+func (account *RskAccount) UpdatePassword(currentPassword, newEncryptedJson, newPassword string) error {
+	key, err := keystore.DecryptKey([]byte(newEncryptedJson), newPassword)
+	defer func() {
+		if key != nil {
+			*key = keystore.Key{}
+		}
+	}()
+	if err != nil {
+		return fmt.Errorf("error decrypting new keystore: %w", err)
+	} else if key.Address != account.Account.Address {
+		return fmt.Errorf("new keystore belongs to %s instead of %s", key.Address, account.Account.Address)
+	}
+	if err = account.Keystore.Update(*account.Account, currentPassword, newPassword); err != nil {
+		return fmt.Errorf("error updating keystore password: %w", err)
+	}
+	return nil
+}
+
 func createAccount(ks *keystore.KeyStore, encryptedJson, password string) (*accounts.Account, error) {
 	account, err := ks.Import([]byte(encryptedJson), password, password)
 	if err != nil {
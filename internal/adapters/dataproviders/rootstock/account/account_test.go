@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock/account"
 	"github.com/rsksmart/liquidity-provider-server/test"
@@ -305,3 +306,36 @@ func testUsePrivateKeyWif(t *testing.T, testnetAccount, mainnetAccount *account.
 		assert.Equal(t, test.AnyAddress, mainnet)
 	})
 }
+
+func TestRskAccount_UpdatePassword(t *testing.T) {
+	const newPassword = "new-password"
+	testDir := filepath.Join(t.TempDir(), fmt.Sprintf("test-account-password-%d", time.Now().UnixNano()))
+	keyBytes, err := os.ReadFile(keyPath)
+	require.NoError(t, err)
+	testAccount, err := account.GetRskAccount(account.CreationArgs{KeyDir: testDir, EncryptedJson: string(keyBytes), Password: test.KeyPassword})
+	require.NoError(t, err)
+	newKeyJson, err := testAccount.Keystore.Export(*testAccount.Account, test.KeyPassword, newPassword)
+	require.NoError(t, err)
+
+	t.Run("should reject a keystore of other account", func(t *testing.T) {
+		otherKey, keyErr := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP).NewAccount(newPassword)
+		require.NoError(t, keyErr)
+		otherJson, readErr := os.ReadFile(otherKey.URL.Path)
+		require.NoError(t, readErr)
+		require.ErrorContains(t, testAccount.UpdatePassword(test.KeyPassword, string(otherJson), newPassword), "new keystore belongs to")
+	})
+	t.Run("should reject a keystore that can't be decrypted", func(t *testing.T) {
+		require.ErrorContains(t, testAccount.UpdatePassword(test.KeyPassword, string(newKeyJson), "wrong"), "error decrypting new keystore")
+	})
+	t.Run("should return error if current password is wrong", func(t *testing.T) {
+		require.ErrorContains(t, testAccount.UpdatePassword("wrong", string(newKeyJson), newPassword), "error updating keystore password")
+	})
+	t.Run("should update the password of the local keystore", func(t *testing.T) {
+		require.NoError(t, testAccount.UpdatePassword(test.KeyPassword, string(newKeyJson), newPassword))
+		reopened, reopenErr := account.GetRskAccount(account.CreationArgs{KeyDir: testDir, EncryptedJson: string(newKeyJson), Password: newPassword})
+		require.NoError(t, reopenErr)
+		assert.Equal(t, testAccount.Account.Address, reopened.Account.Address)
+		_, reopenErr = account.GetRskAccount(account.CreationArgs{KeyDir: testDir, EncryptedJson: string(keyBytes), Password: test.KeyPassword})
+		require.Error(t, reopenErr)
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
 	log "github.com/sirupsen/logrus"
+	"math/big"
 	"time"
 )
 
@@ -64,6 +65,18 @@ type TransactionSigner interface {
 	Sign(common.Address, *types.Transaction) (*types.Transaction, error)
 }
 
+// TypedDataSigner is implemented by the signers that can't sign an arbitrary hash, so the EIP-712 signatures
+// must be requested with the JSON encoded typed data. The expected hash is the one calculated by the contract
+type TypedDataSigner interface {
+	SignTypedData(typedData []byte, expectedHash [32]byte) ([]byte, error)
+}
+
+// PersonalMessageSigner is implemented by the signers that sign the hashes as EIP-191 personal messages instead of
+// signing them directly, so the signatures that must be verified in the same way for every wallet use this method
+type PersonalMessageSigner interface {
+	SignPersonalMessage(msg []byte) ([]byte, error)
+}
+
 type RskSignerWallet interface {
 	blockchain.RootstockWallet
 	TransactionSigner
@@ -77,6 +90,15 @@ func ParseAddress(address *common.Address, textAddress string) error {
 	return nil
 }
 
+// optionalBigInt converts an optional Wei value into the format used in the transaction options of the bindings.
+// A nil value means the bindings will use the gas price suggested by the node
+func optionalBigInt(value *entities.Wei) *big.Int {
+	if value == nil {
+		return nil
+	}
+	return value.AsBigInt()
+}
+
 func rskRetry[R any](retries uint, retrySleep time.Duration, call func() (R, error)) (R, error) {
 	var result R
 	var err error
@@ -0,0 +1,160 @@
+package rootstock
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	eip712DomainType       = "EIP712Domain"
+	eip712DomainMethod     = "eip712Domain"
+	peginQuoteTypedName    = "PegInQuote"
+	pegoutQuoteTypedName   = "PegOutQuote"
+	peginQuoteHashMethod   = "hashPegInQuoteEIP712"
+	pegoutQuoteHashMethod  = "hashPegOutQuoteEIP712"
+	eip712DomainFieldCount = 7
+)
+
+// flags of the fields bitmask returned by eip712Domain, as defined in EIP-5267
+const (
+	domainNameFlag = 1 << iota
+	domainVersionFlag
+	domainChainIdFlag
+	domainVerifyingContractFlag
+	domainSaltFlag
+)
+
+// buildQuoteTypedData builds the JSON encoded EIP-712 typed data of a quote. The type is built from the ABI of the
+// function that hashes the quote in the contract, so the fields have the same names and order that the contract
+// uses, and the domain is the one returned by the contract eip712Domain function
+func buildQuoteTypedData(
+	caller ContractCallerBinding,
+	retryParams RetryParams,
+	hashMethod abi.Method,
+	primaryType string,
+	parsedQuote any,
+) ([]byte, error) {
+	if len(hashMethod.Inputs) != 1 || hashMethod.Inputs[0].Type.T != abi.TupleTy {
+		return nil, fmt.Errorf("unexpected inputs in %s", hashMethod.Name)
+	}
+	quoteType := hashMethod.Inputs[0].Type
+	quoteValue := reflect.ValueOf(parsedQuote)
+	typedFields := make([]apitypes.Type, len(quoteType.TupleElems))
+	message := make(apitypes.TypedDataMessage, len(quoteType.TupleElems))
+	for i, elem := range quoteType.TupleElems {
+		name := quoteType.TupleRawNames[i]
+		field := quoteValue.FieldByName(abi.ToCamelCase(name))
+		if !field.IsValid() {
+			return nil, fmt.Errorf("field %s not found in %s", name, primaryType)
+		}
+		value, err := typedDataValue(field)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding field %s: %w", name, err)
+		}
+		typedFields[i] = apitypes.Type{Name: name, Type: elem.String()}
+		message[name] = value
+	}
+
+	domainFields, domain, err := getEip712Domain(caller, retryParams)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(apitypes.TypedData{
+		Types: apitypes.Types{
+			eip712DomainType: domainFields,
+			primaryType:      typedFields,
+		},
+		PrimaryType: primaryType,
+		Domain:      domain,
+		Message:     message,
+	})
+}
+
+// typedDataValue returns the value of a binding field in the format expected in the JSON typed data. The integers
+// are encoded as decimal strings so they don't lose precision
+func typedDataValue(field reflect.Value) (any, error) {
+	switch value := field.Interface().(type) {
+	case *big.Int:
+		return value.String(), nil
+	case common.Address:
+		return value.Hex(), nil
+	case []byte:
+		return hexutil.Encode(value), nil
+	case bool:
+		return value, nil
+	}
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(field.Int()).String(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(field.Uint()).String(), nil
+	case reflect.Array:
+		if field.Type().Elem().Kind() == reflect.Uint8 {
+			bytes := make([]byte, field.Len())
+			reflect.Copy(reflect.ValueOf(bytes), field)
+			return hexutil.Encode(bytes), nil
+		}
+	default:
+	}
+	return nil, fmt.Errorf("unsupported type %s", field.Type())
+}
+
+func getEip712Domain(caller ContractCallerBinding, retryParams RetryParams) ([]apitypes.Type, apitypes.TypedDataDomain, error) {
+	result, err := rskRetry(retryParams.Retries, retryParams.Sleep, func() ([]any, error) {
+		var out []any
+		callErr := caller.Call(&bind.CallOpts{}, &out, eip712DomainMethod)
+		return out, callErr
+	})
+	if err != nil {
+		return nil, apitypes.TypedDataDomain{}, fmt.Errorf("error getting EIP-712 domain: %w", err)
+	} else if len(result) != eip712DomainFieldCount {
+		return nil, apitypes.TypedDataDomain{}, errors.New("invalid EIP-712 domain returned by the contract")
+	}
+
+	flags, flagsOk := result[0].([1]byte)
+	name, nameOk := result[1].(string)
+	version, versionOk := result[2].(string)
+	chainId, chainIdOk := result[3].(*big.Int)
+	verifyingContract, contractOk := result[4].(common.Address)
+	salt, saltOk := result[5].([32]byte)
+	extensions, extensionsOk := result[6].([]*big.Int)
+	if !flagsOk || !nameOk || !versionOk || !chainIdOk || !contractOk || !saltOk || !extensionsOk {
+		return nil, apitypes.TypedDataDomain{}, errors.New("invalid EIP-712 domain returned by the contract")
+	} else if len(extensions) != 0 {
+		return nil, apitypes.TypedDataDomain{}, errors.New("EIP-712 domain extensions are not supported")
+	}
+
+	var fields []apitypes.Type
+	var domain apitypes.TypedDataDomain
+	if flags[0]&domainNameFlag != 0 {
+		fields = append(fields, apitypes.Type{Name: "name", Type: "string"})
+		domain.Name = name
+	}
+	if flags[0]&domainVersionFlag != 0 {
+		fields = append(fields, apitypes.Type{Name: "version", Type: "string"})
+		domain.Version = version
+	}
+	if flags[0]&domainChainIdFlag != 0 {
+		fields = append(fields, apitypes.Type{Name: "chainId", Type: "uint256"})
+		domain.ChainId = (*math.HexOrDecimal256)(chainId)
+	}
+	if flags[0]&domainVerifyingContractFlag != 0 {
+		fields = append(fields, apitypes.Type{Name: "verifyingContract", Type: "address"})
+		domain.VerifyingContract = verifyingContract.Hex()
+	}
+	if flags[0]&domainSaltFlag != 0 {
+		fields = append(fields, apitypes.Type{Name: "salt", Type: "bytes32"})
+		domain.Salt = hexutil.Encode(salt[:])
+	}
+	return fields, domain, nil
+}
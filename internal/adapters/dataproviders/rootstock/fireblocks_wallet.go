@@ -0,0 +1,134 @@
+package rootstock
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/fireblocks"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+)
+
+// FireblocksRskWallet is a RskSignerWallet whose key is held by Fireblocks. The transactions and the messages
+// are signed through raw signing transactions of the configured vault account, so the key is never present
+// in the LPS host
+type FireblocksRskWallet struct {
+	client         RpcClientBinding
+	fireblocks     *fireblocks.Client
+	asset          fireblocks.VaultAsset
+	address        common.Address
+	chainId        uint64
+	miningTimeout  time.Duration
+	signingTimeout time.Duration
+}
+
+func NewFireblocksRskWallet(
+	ctx context.Context,
+	client *RskClient,
+	fireblocksClient *fireblocks.Client,
+	asset fireblocks.VaultAsset,
+	chainId uint64,
+	miningTimeout time.Duration,
+	signingTimeout time.Duration,
+) (*FireblocksRskWallet, error) {
+	publicKeyBytes, err := fireblocksClient.GetPublicKey(ctx, asset.VaultAccountId, asset.AssetId)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := crypto.DecompressPubkey(publicKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key for asset %s: %w", asset.AssetId, err)
+	}
+	return &FireblocksRskWallet{
+		client:         client.client,
+		fireblocks:     fireblocksClient,
+		asset:          asset,
+		address:        crypto.PubkeyToAddress(*publicKey),
+		chainId:        chainId,
+		miningTimeout:  miningTimeout,
+		signingTimeout: signingTimeout,
+	}, nil
+}
+
+func (wallet *FireblocksRskWallet) Address() common.Address {
+	return wallet.address
+}
+
+func (wallet *FireblocksRskWallet) Sign(address common.Address, transaction *geth.Transaction) (*geth.Transaction, error) {
+	if address != wallet.address {
+		return nil, fmt.Errorf("provider address %v is incorrect", address.Hex())
+	}
+	signer := geth.LatestSignerForChainID(new(big.Int).SetUint64(wallet.chainId))
+	hash := signer.Hash(transaction)
+	signature, err := wallet.signHash(hash.Bytes(), fmt.Sprintf("LPS transaction with nonce %d", transaction.Nonce()))
+	if err != nil {
+		return nil, err
+	}
+	return transaction.WithSignature(signer, signature)
+}
+
+// SignBytes signs a hash and returns the signature in the [R || S || V] format, being V 0 or 1
+func (wallet *FireblocksRskWallet) SignBytes(msg []byte) ([]byte, error) {
+	return wallet.signHash(msg, "LPS message signature")
+}
+
+func (wallet *FireblocksRskWallet) Validate(signature, hash string) bool {
+	return validateSignature(wallet.address, signature, hash)
+}
+
+func (wallet *FireblocksRskWallet) SendRbtc(ctx context.Context, config blockchain.TransactionConfig, toAddress string) (blockchain.TransactionReceipt, error) {
+	sender := rbtcSender{client: wallet.client, signer: wallet, miningTimeout: wallet.miningTimeout}
+	return sender.send(ctx, config, toAddress)
+}
+
+func (wallet *FireblocksRskWallet) GetBalance(ctx context.Context) (*entities.Wei, error) {
+	return getBalance(ctx, wallet.client, wallet.address)
+}
+
+// signHash requests the signature of the hash to Fireblocks and returns it with the recovery id that matches
+// the wallet address. The recovery id is calculated locally so a signature made with an unexpected key is rejected
+func (wallet *FireblocksRskWallet) signHash(hash []byte, note string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), wallet.signingTimeout)
+	defer cancel()
+	signatures, err := wallet.fireblocks.RawSign(ctx, fireblocks.RawSignRequest{
+		VaultAccountId: wallet.asset.VaultAccountId,
+		AssetId:        wallet.asset.AssetId,
+		Note:           note,
+		Hashes:         [][]byte{hash},
+	})
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signatures[0].Bytes()
+	if err != nil {
+		return nil, err
+	}
+	recoverable := make([]byte, crypto.SignatureLength)
+	copy(recoverable, signature)
+	normalizeS(recoverable[32:64])
+	var publicKey *ecdsa.PublicKey
+	for recoveryId := byte(0); recoveryId <= 1; recoveryId++ {
+		recoverable[crypto.RecoveryIDOffset] = recoveryId
+		if publicKey, err = crypto.SigToPub(hash, recoverable); err == nil && crypto.PubkeyToAddress(*publicKey) == wallet.address {
+			return recoverable, nil
+		}
+	}
+	return nil, errors.New("fireblocks signature doesn't match the wallet address")
+}
+
+// normalizeS replaces S by N - S if S is in the upper half of the curve order, since RSK only accepts
+// transactions with low S values (EIP-2)
+func normalizeS(s []byte) {
+	curveOrder := crypto.S256().Params().N
+	value := new(big.Int).SetBytes(s)
+	if value.Cmp(new(big.Int).Rsh(curveOrder, 1)) > 0 {
+		value.Sub(curveOrder, value).FillBytes(s)
+	}
+}
@@ -0,0 +1,152 @@
+package rootstock_test
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/ethereum/go-ethereum/common"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/fireblocks"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/test/fireblocks_stub"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const fireblocksRskAsset = "RBTC_TEST"
+
+func newFireblocksRskWallet(t *testing.T, clientMock *mocks.RpcClientBindingMock) (*rootstock.FireblocksRskWallet, *fireblocks_stub.Server, common.Address) {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	server := fireblocks_stub.NewServer(t, map[string]*btcec.PrivateKey{fireblocksRskAsset: key})
+	client, err := fireblocks.NewClient(http.DefaultClient, server.URL, fireblocks_stub.ApiKey, server.PrivateKey(), time.Millisecond)
+	require.NoError(t, err)
+	wallet, err := rootstock.NewFireblocksRskWallet(
+		context.Background(),
+		rootstock.NewRskClient(clientMock),
+		client,
+		fireblocks.VaultAsset{VaultAccountId: fireblocks_stub.VaultAccountId, AssetId: fireblocksRskAsset},
+		chainId,
+		time.Second,
+		time.Second,
+	)
+	require.NoError(t, err)
+	return wallet, server, crypto.PubkeyToAddress(*key.PubKey().ToECDSA())
+}
+
+func TestNewFireblocksRskWallet(t *testing.T) {
+	t.Run("should use the address of the vault asset key", func(t *testing.T) {
+		wallet, _, address := newFireblocksRskWallet(t, &mocks.RpcClientBindingMock{})
+		assert.Equal(t, address, wallet.Address())
+	})
+	t.Run("should return error if the public key can't be obtained", func(t *testing.T) {
+		server := fireblocks_stub.NewServer(t, nil)
+		client, err := fireblocks.NewClient(http.DefaultClient, server.URL, fireblocks_stub.ApiKey, server.PrivateKey(), time.Millisecond)
+		require.NoError(t, err)
+		wallet, err := rootstock.NewFireblocksRskWallet(
+			context.Background(),
+			rootstock.NewRskClient(&mocks.RpcClientBindingMock{}),
+			client,
+			fireblocks.VaultAsset{VaultAccountId: fireblocks_stub.VaultAccountId, AssetId: fireblocksRskAsset},
+			chainId,
+			time.Second,
+			time.Second,
+		)
+		require.ErrorContains(t, err, "Asset not found")
+		assert.Nil(t, wallet)
+	})
+}
+
+func TestFireblocksRskWallet_Sign(t *testing.T) {
+	wallet, server, address := newFireblocksRskWallet(t, &mocks.RpcClientBindingMock{})
+	to := common.HexToAddress("0x79568C2989232dcA1840087d73d403602364c0D4")
+	tx := geth.NewTx(&geth.LegacyTx{To: &to, Nonce: 5, GasPrice: big.NewInt(60000000), Gas: 21000, Value: big.NewInt(1000)})
+	t.Run("should sign the transaction with the vault key", func(t *testing.T) {
+		signedTx, err := wallet.Sign(address, tx)
+		require.NoError(t, err)
+		sender, err := geth.Sender(geth.LatestSignerForChainID(big.NewInt(chainId)), signedTx)
+		require.NoError(t, err)
+		assert.Equal(t, address, sender)
+		_, _, s := signedTx.RawSignatureValues()
+		assert.LessOrEqual(t, s.Cmp(new(big.Int).Rsh(crypto.S256().Params().N, 1)), 0)
+		assert.Equal(t, "LPS transaction with nonce 5", server.Requests[len(server.Requests)-1]["note"])
+	})
+	t.Run("should not sign for other address", func(t *testing.T) {
+		signedTx, err := wallet.Sign(to, tx)
+		require.ErrorContains(t, err, "is incorrect")
+		assert.Nil(t, signedTx)
+	})
+	t.Run("should return error if the signature is rejected", func(t *testing.T) {
+		server.FinalStatus = string(fireblocks.TransactionStatusRejected)
+		defer func() { server.FinalStatus = "" }()
+		signedTx, err := wallet.Sign(address, tx)
+		require.ErrorIs(t, err, fireblocks.TransactionNotCompletedError)
+		assert.Nil(t, signedTx)
+	})
+}
+
+func TestFireblocksRskWallet_SignBytes(t *testing.T) {
+	wallet, _, _ := newFireblocksRskWallet(t, &mocks.RpcClientBindingMock{})
+	hash := crypto.Keccak256([]byte("message"))
+	signature, err := wallet.SignBytes(hash)
+	require.NoError(t, err)
+	require.Len(t, signature, 65)
+	assert.True(t, wallet.Validate(hex.EncodeToString(signature), hex.EncodeToString(hash)))
+	assert.False(t, wallet.Validate(hex.EncodeToString(signature), hex.EncodeToString(crypto.Keccak256([]byte("other")))))
+}
+
+func TestFireblocksRskWallet_SendRbtc(t *testing.T) {
+	const toAddress = "0x79568C2989232dcA1840087d73d403602364c0D4"
+	var gasLimit uint64 = 21000
+	clientMock := &mocks.RpcClientBindingMock{}
+	wallet, _, address := newFireblocksRskWallet(t, clientMock)
+	var sentTx *geth.Transaction
+	clientMock.On("PendingNonceAt", mock.Anything, address).Return(uint64(7), nil).Once()
+	clientMock.On("SendTransaction", mock.Anything, mock.AnythingOfType("*types.Transaction")).Return(nil).Run(func(args mock.Arguments) {
+		sentTx = args.Get(1).(*geth.Transaction)
+	}).Once()
+	clientMock.On("TransactionReceipt", mock.Anything, mock.Anything).Return(&geth.Receipt{
+		TxHash:            common.HexToHash("0x01"),
+		Status:            1,
+		BlockNumber:       big.NewInt(10),
+		BlockHash:         common.HexToHash("0x02"),
+		GasUsed:           21000,
+		CumulativeGasUsed: 21000,
+		EffectiveGasPrice: big.NewInt(60000000),
+	}, nil)
+	receipt, err := wallet.SendRbtc(context.Background(), blockchain.TransactionConfig{
+		Value:    entities.NewWei(1000),
+		GasLimit: &gasLimit,
+		GasPrice: entities.NewWei(60000000),
+	}, toAddress)
+	require.NoError(t, err)
+	assert.Equal(t, address.String(), receipt.From)
+	assert.Equal(t, common.HexToAddress(toAddress).String(), receipt.To)
+	assert.Equal(t, entities.NewWei(1000), receipt.Value)
+	require.NotNil(t, sentTx)
+	assert.Equal(t, uint64(7), sentTx.Nonce())
+	sender, err := geth.Sender(geth.LatestSignerForChainID(big.NewInt(chainId)), sentTx)
+	require.NoError(t, err)
+	assert.Equal(t, address, sender)
+	clientMock.AssertExpectations(t)
+}
+
+func TestFireblocksRskWallet_GetBalance(t *testing.T) {
+	clientMock := &mocks.RpcClientBindingMock{}
+	wallet, _, address := newFireblocksRskWallet(t, clientMock)
+	clientMock.On("BalanceAt", mock.Anything, address, (*big.Int)(nil)).Return(big.NewInt(500), nil).Once()
+	balance, err := wallet.GetBalance(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, entities.NewWei(500), balance)
+	clientMock.AssertExpectations(t)
+}
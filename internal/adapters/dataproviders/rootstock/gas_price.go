@@ -0,0 +1,197 @@
+package rootstock
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	log "github.com/sirupsen/logrus"
+)
+
+const maxPercentile = 100
+
+// NodeGasPriceStrategy uses the gas price suggested by the RSK node
+type NodeGasPriceStrategy struct {
+	client      RpcClientBinding
+	retryParams RetryParams
+}
+
+func NewNodeGasPriceStrategy(client *RskClient, retryParams RetryParams) *NodeGasPriceStrategy {
+	return &NodeGasPriceStrategy{client: client.client, retryParams: retryParams}
+}
+
+func (strategy *NodeGasPriceStrategy) GasPrice(ctx context.Context) (*entities.Wei, error) {
+	result, err := rskRetry(strategy.retryParams.Retries, strategy.retryParams.Sleep,
+		func() (*big.Int, error) {
+			return strategy.client.SuggestGasPrice(ctx)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return entities.NewBigWei(result), nil
+}
+
+// FixedGasPriceStrategy always uses the same gas price, unless it is lower than the gas price suggested
+// by the node, in that case the node gas price is used so the transactions are still accepted by the network
+type FixedGasPriceStrategy struct {
+	gasPrice *entities.Wei
+	node     *NodeGasPriceStrategy
+}
+
+func NewFixedGasPriceStrategy(client *RskClient, retryParams RetryParams, gasPrice *entities.Wei) *FixedGasPriceStrategy {
+	return &FixedGasPriceStrategy{gasPrice: gasPrice, node: NewNodeGasPriceStrategy(client, retryParams)}
+}
+
+func (strategy *FixedGasPriceStrategy) GasPrice(ctx context.Context) (*entities.Wei, error) {
+	if strategy.gasPrice == nil || strategy.gasPrice.Cmp(entities.NewWei(0)) <= 0 {
+		return nil, errors.New("fixed gas price must be positive")
+	}
+	return floorGasPrice(ctx, strategy.node, strategy.gasPrice.Copy())
+}
+
+// PercentileGasPriceStrategy uses a percentile of the gas prices paid by the transactions included in
+// the latest blocks. The result is never lower than the gas price suggested by the node, which in RSK
+// is the minimum gas price accepted by the network. The value is calculated once per block.
+type PercentileGasPriceStrategy struct {
+	client      RpcClientBinding
+	retryParams RetryParams
+	percentile  uint64
+	blocks      uint64
+	node        *NodeGasPriceStrategy
+	cacheMutex  sync.Mutex
+	cacheHeight uint64
+	cachePrice  *entities.Wei
+}
+
+func NewPercentileGasPriceStrategy(client *RskClient, retryParams RetryParams, percentile, blocks uint64) *PercentileGasPriceStrategy {
+	return &PercentileGasPriceStrategy{
+		client:      client.client,
+		retryParams: retryParams,
+		percentile:  percentile,
+		blocks:      blocks,
+		node:        NewNodeGasPriceStrategy(client, retryParams),
+	}
+}
+
+func (strategy *PercentileGasPriceStrategy) GasPrice(ctx context.Context) (*entities.Wei, error) {
+	if strategy.percentile == 0 || strategy.percentile > maxPercentile || strategy.blocks == 0 {
+		return nil, errors.New("invalid percentile gas price configuration")
+	}
+	height, err := rskRetry(strategy.retryParams.Retries, strategy.retryParams.Sleep,
+		func() (uint64, error) {
+			return strategy.client.BlockNumber(ctx)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	strategy.cacheMutex.Lock()
+	defer strategy.cacheMutex.Unlock()
+	if strategy.cachePrice != nil && strategy.cacheHeight == height {
+		return strategy.cachePrice.Copy(), nil
+	}
+
+	prices, err := strategy.recentGasPrices(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	minimum, err := strategy.node.GasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := minimum
+	if len(prices) != 0 {
+		slices.SortFunc(prices, func(a, b *big.Int) int { return a.Cmp(b) })
+		index := (uint64(len(prices))*strategy.percentile+maxPercentile-1)/maxPercentile - 1
+		if percentilePrice := entities.NewBigWei(prices[index]); percentilePrice.Cmp(minimum) > 0 {
+			result = percentilePrice
+		}
+	}
+	strategy.cacheHeight = height
+	strategy.cachePrice = result
+	return result.Copy(), nil
+}
+
+// recentGasPrices returns the gas prices of the transactions of the latest blocks, ignoring the ones with
+// zero gas price (e.g. the REMASC transaction) because they don't compete for the block space
+func (strategy *PercentileGasPriceStrategy) recentGasPrices(ctx context.Context, height uint64) ([]*big.Int, error) {
+	prices := make([]*big.Int, 0)
+	for i := uint64(0); i < strategy.blocks && i <= height; i++ {
+		blockNumber := new(big.Int).SetUint64(height - i)
+		block, err := rskRetry(strategy.retryParams.Retries, strategy.retryParams.Sleep,
+			func() (*types.Block, error) {
+				return strategy.client.BlockByNumber(ctx, blockNumber)
+			})
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range block.Transactions() {
+			if tx.GasPrice().Sign() > 0 {
+				prices = append(prices, tx.GasPrice())
+			}
+		}
+	}
+	return prices, nil
+}
+
+// CappedGasPriceStrategy adds a safety margin to the gas price of another strategy and keeps the
+// result between a minimum and a maximum. A zero minimum or maximum means that bound is not applied.
+// The maximum is never applied below the gas price suggested by the node.
+type CappedGasPriceStrategy struct {
+	strategy         blockchain.GasPriceStrategy
+	marginPercentage uint64
+	minimum          *entities.Wei
+	maximum          *entities.Wei
+	node             *NodeGasPriceStrategy
+}
+
+func NewCappedGasPriceStrategy(
+	client *RskClient,
+	retryParams RetryParams,
+	strategy blockchain.GasPriceStrategy,
+	marginPercentage uint64,
+	minimum, maximum *entities.Wei,
+) *CappedGasPriceStrategy {
+	return &CappedGasPriceStrategy{
+		strategy:         strategy,
+		marginPercentage: marginPercentage,
+		minimum:          minimum,
+		maximum:          maximum,
+		node:             NewNodeGasPriceStrategy(client, retryParams),
+	}
+}
+
+func (strategy *CappedGasPriceStrategy) GasPrice(ctx context.Context) (*entities.Wei, error) {
+	gasPrice, err := strategy.strategy.GasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	withMargin := new(big.Int).Mul(gasPrice.AsBigInt(), new(big.Int).SetUint64(maxPercentile+strategy.marginPercentage))
+	result := entities.NewBigWei(withMargin.Div(withMargin, big.NewInt(maxPercentile)))
+	if strategy.minimum != nil && strategy.minimum.Cmp(entities.NewWei(0)) > 0 && result.Cmp(strategy.minimum) < 0 {
+		result = strategy.minimum.Copy()
+	}
+	if strategy.maximum != nil && strategy.maximum.Cmp(entities.NewWei(0)) > 0 && result.Cmp(strategy.maximum) > 0 {
+		result = strategy.maximum.Copy()
+	}
+	return floorGasPrice(ctx, strategy.node, result)
+}
+
+// floorGasPrice returns the gas price suggested by the node if it is higher than the provided one, this is
+// the minimum gas price accepted by the network, so a lower one would make the transactions to be rejected
+func floorGasPrice(ctx context.Context, node *NodeGasPriceStrategy, gasPrice *entities.Wei) (*entities.Wei, error) {
+	minimum, err := node.GasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if gasPrice.Cmp(minimum) < 0 {
+		log.Warnf("Configured gas price %s is lower than the minimum gas price of the node %s, using the node one", gasPrice, minimum)
+		return minimum, nil
+	}
+	return gasPrice, nil
+}
@@ -0,0 +1,173 @@
+package rootstock_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGasPriceTestBlock(number int64, gasPrices ...int64) *types.Block {
+	txs := make([]*types.Transaction, 0, len(gasPrices))
+	for i, gasPrice := range gasPrices {
+		txs = append(txs, types.NewTx(&types.LegacyTx{Nonce: uint64(i), GasPrice: big.NewInt(gasPrice), Gas: 21000, Value: big.NewInt(0)}))
+	}
+	return types.NewBlockWithHeader(&types.Header{Number: big.NewInt(number)}).WithBody(types.Body{Transactions: txs})
+}
+
+func TestNodeGasPriceStrategy_GasPrice(t *testing.T) {
+	client := &mocks.RpcClientBindingMock{}
+	strategy := rootstock.NewNodeGasPriceStrategy(rootstock.NewRskClient(client), rootstock.RetryParams{})
+	t.Run("Success", func(t *testing.T) {
+		client.On("SuggestGasPrice", test.AnyCtx).Return(big.NewInt(200), nil).Once()
+		gasPrice, err := strategy.GasPrice(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(200), gasPrice)
+	})
+	t.Run("Error calling SuggestGasPrice", func(t *testing.T) {
+		client.On("SuggestGasPrice", test.AnyCtx).Return(nil, assert.AnError).Once()
+		gasPrice, err := strategy.GasPrice(context.Background())
+		require.Error(t, err)
+		assert.Nil(t, gasPrice)
+	})
+}
+
+func newGasPriceTestNode(minimum int64) *rootstock.RskClient {
+	client := &mocks.RpcClientBindingMock{}
+	client.On("SuggestGasPrice", test.AnyCtx).Return(big.NewInt(minimum), nil)
+	return rootstock.NewRskClient(client)
+}
+
+func TestFixedGasPriceStrategy_GasPrice(t *testing.T) {
+	t.Run("Should return the configured gas price", func(t *testing.T) {
+		strategy := rootstock.NewFixedGasPriceStrategy(newGasPriceTestNode(100), rootstock.RetryParams{}, entities.NewWei(60000000))
+		gasPrice, err := strategy.GasPrice(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(60000000), gasPrice)
+	})
+	t.Run("Should not return less than the node gas price", func(t *testing.T) {
+		strategy := rootstock.NewFixedGasPriceStrategy(newGasPriceTestNode(65000000), rootstock.RetryParams{}, entities.NewWei(60000000))
+		gasPrice, err := strategy.GasPrice(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(65000000), gasPrice)
+	})
+	t.Run("Should fail if the gas price is not positive", func(t *testing.T) {
+		node := newGasPriceTestNode(100)
+		gasPrice, err := rootstock.NewFixedGasPriceStrategy(node, rootstock.RetryParams{}, entities.NewWei(0)).GasPrice(context.Background())
+		require.Error(t, err)
+		assert.Nil(t, gasPrice)
+		gasPrice, err = rootstock.NewFixedGasPriceStrategy(node, rootstock.RetryParams{}, nil).GasPrice(context.Background())
+		require.Error(t, err)
+		assert.Nil(t, gasPrice)
+	})
+	t.Run("Should fail if the node gas price can't be retrieved", func(t *testing.T) {
+		client := &mocks.RpcClientBindingMock{}
+		client.On("SuggestGasPrice", test.AnyCtx).Return(nil, assert.AnError).Once()
+		gasPrice, err := rootstock.NewFixedGasPriceStrategy(rootstock.NewRskClient(client), rootstock.RetryParams{}, entities.NewWei(100)).GasPrice(context.Background())
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, gasPrice)
+	})
+}
+
+// nolint:funlen
+func TestPercentileGasPriceStrategy_GasPrice(t *testing.T) {
+	t.Run("Should return the percentile of the latest blocks ignoring zero gas price transactions", func(t *testing.T) {
+		client := &mocks.RpcClientBindingMock{}
+		client.On("BlockNumber", test.AnyCtx).Return(uint64(10), nil).Twice()
+		client.On("BlockByNumber", test.AnyCtx, big.NewInt(10)).Return(newGasPriceTestBlock(10, 0, 100, 400), nil).Once()
+		client.On("BlockByNumber", test.AnyCtx, big.NewInt(9)).Return(newGasPriceTestBlock(9, 0, 200, 300, 500), nil).Once()
+		client.On("SuggestGasPrice", test.AnyCtx).Return(big.NewInt(50), nil).Once()
+		strategy := rootstock.NewPercentileGasPriceStrategy(rootstock.NewRskClient(client), rootstock.RetryParams{}, 60, 2)
+		gasPrice, err := strategy.GasPrice(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(300), gasPrice)
+		// the value is cached until there is a new block
+		gasPrice, err = strategy.GasPrice(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(300), gasPrice)
+		client.AssertExpectations(t)
+	})
+	t.Run("Should not return less than the gas price suggested by the node", func(t *testing.T) {
+		client := &mocks.RpcClientBindingMock{}
+		client.On("BlockNumber", test.AnyCtx).Return(uint64(5), nil).Once()
+		client.On("BlockByNumber", test.AnyCtx, big.NewInt(5)).Return(newGasPriceTestBlock(5, 10, 20), nil).Once()
+		client.On("SuggestGasPrice", test.AnyCtx).Return(big.NewInt(60), nil).Once()
+		strategy := rootstock.NewPercentileGasPriceStrategy(rootstock.NewRskClient(client), rootstock.RetryParams{}, 100, 1)
+		gasPrice, err := strategy.GasPrice(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(60), gasPrice)
+		client.AssertExpectations(t)
+	})
+	t.Run("Should use the gas price suggested by the node if there are no transactions", func(t *testing.T) {
+		client := &mocks.RpcClientBindingMock{}
+		client.On("BlockNumber", test.AnyCtx).Return(uint64(0), nil).Once()
+		client.On("BlockByNumber", test.AnyCtx, big.NewInt(0)).Return(newGasPriceTestBlock(0), nil).Once()
+		client.On("SuggestGasPrice", test.AnyCtx).Return(big.NewInt(70), nil).Once()
+		strategy := rootstock.NewPercentileGasPriceStrategy(rootstock.NewRskClient(client), rootstock.RetryParams{}, 50, 20)
+		gasPrice, err := strategy.GasPrice(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(70), gasPrice)
+		client.AssertExpectations(t)
+	})
+	t.Run("Should handle RPC errors", func(t *testing.T) {
+		client := &mocks.RpcClientBindingMock{}
+		strategy := rootstock.NewPercentileGasPriceStrategy(rootstock.NewRskClient(client), rootstock.RetryParams{}, 50, 1)
+		client.On("BlockNumber", test.AnyCtx).Return(uint64(0), assert.AnError).Once()
+		_, err := strategy.GasPrice(context.Background())
+		require.ErrorIs(t, err, assert.AnError)
+		client.On("BlockNumber", test.AnyCtx).Return(uint64(3), nil).Once()
+		client.On("BlockByNumber", test.AnyCtx, big.NewInt(3)).Return(nil, assert.AnError).Once()
+		_, err = strategy.GasPrice(context.Background())
+		require.ErrorIs(t, err, assert.AnError)
+		client.AssertExpectations(t)
+	})
+	t.Run("Should fail with an invalid configuration", func(t *testing.T) {
+		client := &mocks.RpcClientBindingMock{}
+		_, err := rootstock.NewPercentileGasPriceStrategy(rootstock.NewRskClient(client), rootstock.RetryParams{}, 101, 1).GasPrice(context.Background())
+		require.Error(t, err)
+		_, err = rootstock.NewPercentileGasPriceStrategy(rootstock.NewRskClient(client), rootstock.RetryParams{}, 50, 0).GasPrice(context.Background())
+		require.Error(t, err)
+		client.AssertNotCalled(t, "BlockNumber")
+	})
+}
+
+func TestCappedGasPriceStrategy_GasPrice(t *testing.T) {
+	cases := []struct {
+		name     string
+		margin   uint64
+		minimum  *entities.Wei
+		maximum  *entities.Wei
+		node     int64
+		expected *entities.Wei
+	}{
+		{name: "adds the margin", margin: 10, node: 500, expected: entities.NewWei(1100)},
+		{name: "applies the minimum", margin: 10, node: 500, minimum: entities.NewWei(2000), expected: entities.NewWei(2000)},
+		{name: "applies the maximum", margin: 10, node: 500, maximum: entities.NewWei(1050), expected: entities.NewWei(1050)},
+		{name: "ignores zero caps", margin: 5, node: 500, minimum: entities.NewWei(0), maximum: entities.NewWei(0), expected: entities.NewWei(1050)},
+		{name: "doesn't apply the maximum below the node gas price", margin: 10, node: 1000, maximum: entities.NewWei(900), expected: entities.NewWei(1000)},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			node := newGasPriceTestNode(c.node)
+			inner := rootstock.NewFixedGasPriceStrategy(node, rootstock.RetryParams{}, entities.NewWei(1000))
+			strategy := rootstock.NewCappedGasPriceStrategy(node, rootstock.RetryParams{}, inner, c.margin, c.minimum, c.maximum)
+			gasPrice, err := strategy.GasPrice(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, gasPrice)
+		})
+	}
+	t.Run("propagates the error of the inner strategy", func(t *testing.T) {
+		node := newGasPriceTestNode(500)
+		strategy := rootstock.NewCappedGasPriceStrategy(node, rootstock.RetryParams{}, rootstock.NewFixedGasPriceStrategy(node, rootstock.RetryParams{}, nil), 10, nil, nil)
+		gasPrice, err := strategy.GasPrice(context.Background())
+		require.Error(t, err)
+		assert.Nil(t, gasPrice)
+	})
+}
@@ -107,6 +107,22 @@ func (peginContract *peginContractImpl) HashPeginQuoteEIP712(peginQuote quote.Pe
 	return result, nil
 }
 
+// PeginQuoteTypedData returns the JSON encoded EIP-712 typed data of the quote, whose hash is the one returned
+// by HashPeginQuoteEIP712
+func (peginContract *peginContractImpl) PeginQuoteTypedData(peginQuote quote.PeginQuote) ([]byte, error) {
+	parsedQuote, err := parsePeginQuote(peginQuote)
+	if err != nil {
+		return nil, err
+	}
+	return buildQuoteTypedData(
+		peginContract.contract.Caller(),
+		peginContract.retryParams,
+		peginContract.abis.PegIn.Methods[peginQuoteHashMethod],
+		peginQuoteTypedName,
+		parsedQuote,
+	)
+}
+
 func (peginContract *peginContractImpl) CallForUser(txConfig blockchain.TransactionConfig, peginQuote quote.PeginQuote) (blockchain.TransactionReceipt, error) {
 	parsedQuote, err := parsePeginQuote(peginQuote)
 	if err != nil {
@@ -115,6 +131,7 @@ func (peginContract *peginContractImpl) CallForUser(txConfig blockchain.Transact
 
 	opts := &bind.TransactOpts{
 		GasLimit: *txConfig.GasLimit,
+		GasPrice: optionalBigInt(txConfig.GasPrice),
 		Value:    txConfig.Value.AsBigInt(),
 		From:     peginContract.signer.Address(),
 		Signer:   peginContract.signer.Sign,
@@ -204,6 +221,7 @@ func (peginContract *peginContractImpl) RegisterPegin(params blockchain.Register
 		From:     peginContract.signer.Address(),
 		Signer:   peginContract.signer.Sign,
 		GasLimit: registerPeginGasLimit,
+		GasPrice: optionalBigInt(params.GasPrice),
 	}
 
 	var tx *geth.Transaction
@@ -2,8 +2,12 @@ package rootstock_test
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock/bindings"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
@@ -117,9 +121,10 @@ func TestPeginContractImpl_CallForUser(t *testing.T) {
 	)
 	modifiers := []txModifier{valueModifier(big.NewInt(1234)), gasLimitModifier(8000)}
 	var gasLimit uint64 = 8000
-	txConfig := blockchain.TransactionConfig{Value: entities.NewWei(1234), GasLimit: &gasLimit}
+	txConfig := blockchain.TransactionConfig{Value: entities.NewWei(1234), GasLimit: &gasLimit, GasPrice: entities.NewWei(65000000)}
 	optsMatchFunction := mock.MatchedBy(func(opts *bind.TransactOpts) bool {
-		return opts.Value.Cmp(txConfig.Value.AsBigInt()) == 0 && opts.GasLimit == *txConfig.GasLimit
+		return opts.Value.Cmp(txConfig.Value.AsBigInt()) == 0 && opts.GasLimit == *txConfig.GasLimit &&
+			opts.GasPrice.Cmp(txConfig.GasPrice.AsBigInt()) == 0
 	})
 	t.Run("Success", func(t *testing.T) {
 		tx := prepareTxMocks(mockClient, signerMock, true, modifiers...)
@@ -455,3 +460,96 @@ func TestPeginContractImpl_HashPeginQuoteEIP712(t *testing.T) {
 		contractBinding.AssertExpectations(t)
 	})
 }
+
+func eip712DomainResult(flags byte, verifyingContract common.Address) func(opts *bind.CallOpts, result *[]any, method string, params ...any) {
+	return func(opts *bind.CallOpts, result *[]any, method string, params ...any) {
+		*result = []any{[1]byte{flags}, "Flyover", "1", big.NewInt(31), verifyingContract, [32]byte{}, []*big.Int{}}
+	}
+}
+
+// nolint:funlen
+func TestPeginContractImpl_PeginQuoteTypedData(t *testing.T) {
+	verifyingContract := common.HexToAddress(peginQuote.LbcAddress)
+	t.Run("should return the typed data with the hash of the contract", func(t *testing.T) {
+		contractBinding := &mocks.PeginContractAdapterMock{}
+		callerMock := &mocks.ContractCallerBindingMock{}
+		contract := rootstock.NewPeginContractImpl(dummyClient, test.AnyAddress, contractBinding, nil, rootstock.RetryParams{}, time.Duration(1), Abis)
+		contractBinding.EXPECT().Caller().Return(callerMock).Once()
+		callerMock.EXPECT().Call(mock.Anything, mock.Anything, "eip712Domain").Run(eip712DomainResult(0x0f, verifyingContract)).Return(nil).Once()
+		typedDataJson, err := contract.PeginQuoteTypedData(peginQuote)
+		require.NoError(t, err)
+
+		var typedData apitypes.TypedData
+		require.NoError(t, json.Unmarshal(typedDataJson, &typedData))
+		assert.Equal(t, "PegInQuote", typedData.PrimaryType)
+		assert.Equal(t, "11223344", typedData.Message["nonce"])
+		assert.Equal(t, "0x12a1", typedData.Message["data"])
+		assert.Equal(t, true, typedData.Message["callOnRegister"])
+		hash, _, err := apitypes.TypedDataAndHash(typedData)
+		require.NoError(t, err)
+
+		// the expected hash is calculated following the EIP-712 encoding of the contract struct
+		words := func(types ...string) abi.Arguments {
+			arguments := make(abi.Arguments, len(types))
+			for i, typeName := range types {
+				argumentType, typeErr := abi.NewType(typeName, "", nil)
+				require.NoError(t, typeErr)
+				arguments[i] = abi.Argument{Type: argumentType}
+			}
+			return arguments
+		}
+		typeHash := crypto.Keccak256Hash([]byte("PegInQuote(uint256 chainId,uint256 callFee,uint256 penaltyFee,uint256 value,uint256 gasFee," +
+			"bytes20 fedBtcAddress,address lbcAddress,address liquidityProviderRskAddress,address contractAddress,address rskRefundAddress," +
+			"int64 nonce,uint32 gasLimit,uint32 agreementTimestamp,uint32 timeForDeposit,uint32 callTime,uint16 depositConfirmations," +
+			"bool callOnRegister,bytes btcRefundAddress,bytes liquidityProviderBtcAddress,bytes data)"))
+		encodedQuote, err := words("bytes32", "uint256", "uint256", "uint256", "uint256", "uint256", "bytes20", "address", "address", "address",
+			"address", "int64", "uint32", "uint32", "uint32", "uint32", "uint16", "bool", "bytes32", "bytes32", "bytes32").Pack(
+			typeHash, parsedPeginQuote.ChainId, parsedPeginQuote.CallFee, parsedPeginQuote.PenaltyFee, parsedPeginQuote.Value, parsedPeginQuote.GasFee,
+			parsedPeginQuote.FedBtcAddress, parsedPeginQuote.LbcAddress, parsedPeginQuote.LiquidityProviderRskAddress, parsedPeginQuote.ContractAddress,
+			parsedPeginQuote.RskRefundAddress, parsedPeginQuote.Nonce, parsedPeginQuote.GasLimit, parsedPeginQuote.AgreementTimestamp,
+			parsedPeginQuote.TimeForDeposit, parsedPeginQuote.CallTime, parsedPeginQuote.DepositConfirmations, parsedPeginQuote.CallOnRegister,
+			crypto.Keccak256Hash(parsedPeginQuote.BtcRefundAddress), crypto.Keccak256Hash(parsedPeginQuote.LiquidityProviderBtcAddress),
+			crypto.Keccak256Hash(parsedPeginQuote.Data),
+		)
+		require.NoError(t, err)
+		encodedDomain, err := words("bytes32", "bytes32", "bytes32", "uint256", "address").Pack(
+			crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)")),
+			crypto.Keccak256Hash([]byte("Flyover")), crypto.Keccak256Hash([]byte("1")), big.NewInt(31), verifyingContract,
+		)
+		require.NoError(t, err)
+		expectedHash := crypto.Keccak256([]byte{0x19, 0x01}, crypto.Keccak256(encodedDomain), crypto.Keccak256(encodedQuote))
+		assert.Equal(t, expectedHash, []byte(hash))
+		contractBinding.AssertExpectations(t)
+		callerMock.AssertExpectations(t)
+	})
+	t.Run("should only include the domain fields of the contract", func(t *testing.T) {
+		contractBinding := &mocks.PeginContractAdapterMock{}
+		callerMock := &mocks.ContractCallerBindingMock{}
+		contract := rootstock.NewPeginContractImpl(dummyClient, test.AnyAddress, contractBinding, nil, rootstock.RetryParams{}, time.Duration(1), Abis)
+		contractBinding.EXPECT().Caller().Return(callerMock).Once()
+		callerMock.EXPECT().Call(mock.Anything, mock.Anything, "eip712Domain").Run(eip712DomainResult(0x05, verifyingContract)).Return(nil).Once()
+		typedDataJson, err := contract.PeginQuoteTypedData(peginQuote)
+		require.NoError(t, err)
+		var typedData apitypes.TypedData
+		require.NoError(t, json.Unmarshal(typedDataJson, &typedData))
+		assert.Equal(t, []apitypes.Type{{Name: "name", Type: "string"}, {Name: "chainId", Type: "uint256"}}, typedData.Types["EIP712Domain"])
+		assert.Empty(t, typedData.Domain.Version)
+		assert.Empty(t, typedData.Domain.VerifyingContract)
+	})
+	t.Run("should handle error getting the domain", func(t *testing.T) {
+		contractBinding := &mocks.PeginContractAdapterMock{}
+		callerMock := &mocks.ContractCallerBindingMock{}
+		contract := rootstock.NewPeginContractImpl(dummyClient, test.AnyAddress, contractBinding, nil, rootstock.RetryParams{}, time.Duration(1), Abis)
+		contractBinding.EXPECT().Caller().Return(callerMock).Once()
+		callerMock.EXPECT().Call(mock.Anything, mock.Anything, "eip712Domain").Return(assert.AnError).Once()
+		typedDataJson, err := contract.PeginQuoteTypedData(peginQuote)
+		require.ErrorContains(t, err, "error getting EIP-712 domain")
+		assert.Nil(t, typedDataJson)
+	})
+	t.Run("should handle invalid quotes", func(t *testing.T) {
+		contract := rootstock.NewPeginContractImpl(dummyClient, test.AnyAddress, &mocks.PeginContractAdapterMock{}, nil, rootstock.RetryParams{}, time.Duration(1), Abis)
+		typedDataJson, err := contract.PeginQuoteTypedData(quote.PeginQuote{})
+		require.Error(t, err)
+		assert.Nil(t, typedDataJson)
+	})
+}
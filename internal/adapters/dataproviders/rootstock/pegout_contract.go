@@ -140,6 +140,22 @@ func (pegoutContract *pegoutContractImpl) HashPegoutQuoteEIP712(pegoutQuote quot
 	return result, nil
 }
 
+// PegoutQuoteTypedData returns the JSON encoded EIP-712 typed data of the quote, whose hash is the one returned
+// by HashPegoutQuoteEIP712
+func (pegoutContract *pegoutContractImpl) PegoutQuoteTypedData(pegoutQuote quote.PegoutQuote) ([]byte, error) {
+	parsedQuote, err := parsePegoutQuote(pegoutQuote)
+	if err != nil {
+		return nil, err
+	}
+	return buildQuoteTypedData(
+		pegoutContract.contract.Caller(),
+		pegoutContract.retryParams,
+		pegoutContract.abis.PegOut.Methods[pegoutQuoteHashMethod],
+		pegoutQuoteTypedName,
+		parsedQuote,
+	)
+}
+
 func (pegoutContract *pegoutContractImpl) RefundUserPegOut(quoteHash string) (string, error) {
 	// Validate the hash format
 	hashBytesSlice, err := hex.DecodeString(quoteHash)
@@ -204,6 +220,7 @@ func (pegoutContract *pegoutContractImpl) RefundPegout(txConfig blockchain.Trans
 		From:     pegoutContract.signer.Address(),
 		Signer:   pegoutContract.signer.Sign,
 		GasLimit: *txConfig.GasLimit,
+		GasPrice: optionalBigInt(txConfig.GasPrice),
 	}
 
 	var tx *geth.Transaction
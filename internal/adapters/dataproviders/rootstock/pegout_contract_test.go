@@ -3,7 +3,9 @@ package rootstock_test
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"math/big"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -11,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock/bindings"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
@@ -616,3 +619,31 @@ func TestPegoutContractImpl_HashPegoutQuoteEIP712(t *testing.T) {
 		assert.Empty(t, result)
 	})
 }
+
+func TestPegoutContractImpl_PegoutQuoteTypedData(t *testing.T) {
+	contract := &mocks.PegoutContractAdapterMock{}
+	pegoutContract := rootstock.NewPegoutContractImpl(dummyClient, test.AnyAddress, contract, nil, rootstock.RetryParams{}, time.Duration(1), Abis)
+	t.Run("should return the typed data of the quote", func(t *testing.T) {
+		callerMock := &mocks.ContractCallerBindingMock{}
+		contract.EXPECT().Caller().Return(callerMock).Once()
+		callerMock.EXPECT().Call(mock.Anything, mock.Anything, "eip712Domain").Run(eip712DomainResult(0x0f, parsedPegoutQuote.LbcAddress)).Return(nil).Once()
+		typedDataJson, err := pegoutContract.PegoutQuoteTypedData(pegoutQuote)
+		require.NoError(t, err)
+		var typedData apitypes.TypedData
+		require.NoError(t, json.Unmarshal(typedDataJson, &typedData))
+		assert.Equal(t, "PegOutQuote", typedData.PrimaryType)
+		require.Len(t, typedData.Types["PegOutQuote"], reflect.TypeOf(parsedPegoutQuote).NumField())
+		assert.Equal(t, parsedPegoutQuote.LbcAddress.Hex(), typedData.Message["lbcAddress"])
+		_, _, err = apitypes.TypedDataAndHash(typedData)
+		require.NoError(t, err)
+		callerMock.AssertExpectations(t)
+	})
+	t.Run("should handle error getting the domain", func(t *testing.T) {
+		callerMock := &mocks.ContractCallerBindingMock{}
+		contract.EXPECT().Caller().Return(callerMock).Once()
+		callerMock.EXPECT().Call(mock.Anything, mock.Anything, "eip712Domain").Return(assert.AnError).Once()
+		typedDataJson, err := pegoutContract.PegoutQuoteTypedData(pegoutQuote)
+		require.ErrorContains(t, err, "error getting EIP-712 domain")
+		assert.Nil(t, typedDataJson)
+	})
+}
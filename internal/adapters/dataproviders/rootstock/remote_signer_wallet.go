@@ -0,0 +1,175 @@
+package rootstock
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/remote_signer"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+)
+
+var (
+	ContractNotAllowedError = errors.New("transaction destination is not in the contract allowlist")
+	remoteSignatureError    = errors.New("remote signer signature doesn't match the wallet address")
+)
+
+// RemoteSignerRskWallet is a RskSignerWallet that delegates the signatures to an external JSON-RPC signer, so the
+// key of the account is never present in the LPS host. The wallet only signs transactions to the contracts of the
+// allowlist, the signer is expected to enforce the same rule on its side. The signers don't sign arbitrary hashes,
+// so the quotes are signed as EIP-712 typed data and any other hash is signed as an EIP-191 personal message
+type RemoteSignerRskWallet struct {
+	client           RpcClientBinding
+	signer           *remote_signer.Client
+	address          common.Address
+	allowedContracts []common.Address
+	chainId          uint64
+	miningTimeout    time.Duration
+	signingTimeout   time.Duration
+}
+
+type RemoteSignerWalletArgs struct {
+	Address          common.Address
+	AllowedContracts []common.Address
+	ChainId          uint64
+	MiningTimeout    time.Duration
+	SigningTimeout   time.Duration
+}
+
+func NewRemoteSignerRskWallet(
+	ctx context.Context,
+	client *RskClient,
+	signer *remote_signer.Client,
+	args RemoteSignerWalletArgs,
+) (*RemoteSignerRskWallet, error) {
+	accounts, err := signer.Accounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting remote signer accounts: %w", err)
+	} else if !slices.Contains(accounts, args.Address) {
+		return nil, fmt.Errorf("account %s is not managed by the remote signer", args.Address)
+	}
+	return &RemoteSignerRskWallet{
+		client:           client.client,
+		signer:           signer,
+		address:          args.Address,
+		allowedContracts: args.AllowedContracts,
+		chainId:          args.ChainId,
+		miningTimeout:    args.MiningTimeout,
+		signingTimeout:   args.SigningTimeout,
+	}, nil
+}
+
+func (wallet *RemoteSignerRskWallet) Address() common.Address {
+	return wallet.address
+}
+
+func (wallet *RemoteSignerRskWallet) Sign(address common.Address, transaction *geth.Transaction) (*geth.Transaction, error) {
+	if address != wallet.address {
+		return nil, fmt.Errorf("provider address %v is incorrect", address.Hex())
+	} else if transaction.To() == nil || !slices.Contains(wallet.allowedContracts, *transaction.To()) {
+		return nil, ContractNotAllowedError
+	}
+	chainId := new(big.Int).SetUint64(wallet.chainId)
+	args, err := remote_signer.NewTransactionArgs(wallet.address, chainId, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), wallet.signingTimeout)
+	defer cancel()
+	signedTx, err := wallet.signer.SignTransaction(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := geth.LatestSignerForChainID(chainId)
+	if signer.Hash(signedTx) != signer.Hash(transaction) {
+		return nil, errors.New("remote signer returned a different transaction than the requested one")
+	} else if sender, senderErr := geth.Sender(signer, signedTx); senderErr != nil || sender != wallet.address {
+		return nil, remoteSignatureError
+	}
+	return signedTx, nil
+}
+
+// SignBytes signs the message as an EIP-191 personal message, see SignPersonalMessage. It must be verified with Validate
+func (wallet *RemoteSignerRskWallet) SignBytes(msg []byte) ([]byte, error) {
+	return wallet.SignPersonalMessage(msg)
+}
+
+// SignPersonalMessage signs the message as an EIP-191 personal message and returns the signature in the [R || S || V]
+// format, being V 0 or 1. The signature is over keccak256("\x19Ethereum Signed Message:\n" + len(msg) + msg) and not
+// over msg itself
+func (wallet *RemoteSignerRskWallet) SignPersonalMessage(msg []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), wallet.signingTimeout)
+	defer cancel()
+	signature, err := wallet.signer.SignMessage(ctx, wallet.address, msg)
+	if err != nil {
+		return nil, err
+	}
+	if !wallet.isSignedByWallet(accounts.TextHash(msg), signature) {
+		return nil, remoteSignatureError
+	}
+	return signature, nil
+}
+
+// SignTypedData signs the EIP-712 typed data and returns the signature in the [R || S || V] format, being V 0 or 1.
+// The hash of the typed data is calculated before the request, so it is only sent to the signer if it matches the
+// expected hash
+func (wallet *RemoteSignerRskWallet) SignTypedData(typedData []byte, expectedHash [32]byte) ([]byte, error) {
+	var parsedTypedData apitypes.TypedData
+	if err := json.Unmarshal(typedData, &parsedTypedData); err != nil {
+		return nil, fmt.Errorf("invalid typed data: %w", err)
+	}
+	hash, _, err := apitypes.TypedDataAndHash(parsedTypedData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid typed data: %w", err)
+	} else if !bytes.Equal(hash, expectedHash[:]) {
+		return nil, errors.New("typed data hash doesn't match the expected hash")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), wallet.signingTimeout)
+	defer cancel()
+	signature, err := wallet.signer.SignTypedData(ctx, wallet.address, typedData)
+	if err != nil {
+		return nil, err
+	}
+	if !wallet.isSignedByWallet(hash, signature) {
+		return nil, remoteSignatureError
+	}
+	return signature, nil
+}
+
+// Validate verifies a signature made by SignBytes, so the hash is verified as an EIP-191 personal message
+func (wallet *RemoteSignerRskWallet) Validate(signature, hash string) bool {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return false
+	}
+	return validateSignature(wallet.address, signature, hex.EncodeToString(accounts.TextHash(hashBytes)))
+}
+
+func (wallet *RemoteSignerRskWallet) SendRbtc(ctx context.Context, config blockchain.TransactionConfig, toAddress string) (blockchain.TransactionReceipt, error) {
+	sender := rbtcSender{client: wallet.client, signer: wallet, miningTimeout: wallet.miningTimeout}
+	return sender.send(ctx, config, toAddress)
+}
+
+func (wallet *RemoteSignerRskWallet) GetBalance(ctx context.Context) (*entities.Wei, error) {
+	return getBalance(ctx, wallet.client, wallet.address)
+}
+
+func (wallet *RemoteSignerRskWallet) isSignedByWallet(hash, signature []byte) bool {
+	publicKey, err := crypto.SigToPub(hash, signature)
+	return err == nil && crypto.PubkeyToAddress(*publicKey) == wallet.address
+}
@@ -0,0 +1,214 @@
+package rootstock_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/remote_signer"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/rsksmart/liquidity-provider-server/test/remote_signer_stub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	allowedContract    = common.HexToAddress("0x0000000000000000000000000000000001000006")
+	notAllowedContract = common.HexToAddress("0x79568C2989232dcA1840087d73d403602364c0D4")
+)
+
+func newRemoteSignerRskWallet(t *testing.T, clientMock *mocks.RpcClientBindingMock) (*rootstock.RemoteSignerRskWallet, *remote_signer_stub.Server) {
+	server := remote_signer_stub.NewServer(t, remote_signer.ClefSigner)
+	signer, err := remote_signer.Dial(context.Background(), server.URL, remote_signer.ClefSigner)
+	require.NoError(t, err)
+	t.Cleanup(signer.Close)
+	wallet, err := rootstock.NewRemoteSignerRskWallet(context.Background(), rootstock.NewRskClient(clientMock), signer, rootstock.RemoteSignerWalletArgs{
+		Address:          server.Address,
+		AllowedContracts: []common.Address{allowedContract},
+		ChainId:          chainId,
+		MiningTimeout:    time.Second,
+		SigningTimeout:   time.Second,
+	})
+	require.NoError(t, err)
+	return wallet, server
+}
+
+func TestNewRemoteSignerRskWallet(t *testing.T) {
+	t.Run("should create the wallet for an account of the signer", func(t *testing.T) {
+		wallet, server := newRemoteSignerRskWallet(t, &mocks.RpcClientBindingMock{})
+		assert.Equal(t, server.Address, wallet.Address())
+	})
+	t.Run("should return error if the account is not managed by the signer", func(t *testing.T) {
+		server := remote_signer_stub.NewServer(t, remote_signer.Web3SignerSigner)
+		signer, err := remote_signer.Dial(context.Background(), server.URL, remote_signer.Web3SignerSigner)
+		require.NoError(t, err)
+		defer signer.Close()
+		wallet, err := rootstock.NewRemoteSignerRskWallet(context.Background(), rootstock.NewRskClient(&mocks.RpcClientBindingMock{}), signer, rootstock.RemoteSignerWalletArgs{
+			Address:        notAllowedContract,
+			SigningTimeout: time.Second,
+		})
+		require.ErrorContains(t, err, "is not managed by the remote signer")
+		assert.Nil(t, wallet)
+	})
+}
+
+func TestRemoteSignerRskWallet_Sign(t *testing.T) {
+	wallet, server := newRemoteSignerRskWallet(t, &mocks.RpcClientBindingMock{})
+	newTx := func(to common.Address) *geth.Transaction {
+		return geth.NewTx(&geth.LegacyTx{To: &to, Nonce: 5, GasPrice: big.NewInt(60000000), Gas: 21000, Value: big.NewInt(1000), Data: []byte{0x01}})
+	}
+	t.Run("should sign transactions to allowed contracts", func(t *testing.T) {
+		signedTx, err := wallet.Sign(server.Address, newTx(allowedContract))
+		require.NoError(t, err)
+		sender, err := geth.Sender(geth.LatestSignerForChainID(big.NewInt(chainId)), signedTx)
+		require.NoError(t, err)
+		assert.Equal(t, server.Address, sender)
+	})
+	t.Run("should not sign transactions to other addresses", func(t *testing.T) {
+		signedTx, err := wallet.Sign(server.Address, newTx(notAllowedContract))
+		require.ErrorIs(t, err, rootstock.ContractNotAllowedError)
+		assert.Nil(t, signedTx)
+	})
+	t.Run("should not sign contract deployments", func(t *testing.T) {
+		signedTx, err := wallet.Sign(server.Address, geth.NewTx(&geth.LegacyTx{Nonce: 5, GasPrice: big.NewInt(1), Gas: 21000, Data: []byte{0x01}}))
+		require.ErrorIs(t, err, rootstock.ContractNotAllowedError)
+		assert.Nil(t, signedTx)
+	})
+	t.Run("should not sign for other address", func(t *testing.T) {
+		signedTx, err := wallet.Sign(allowedContract, newTx(allowedContract))
+		require.ErrorContains(t, err, "is incorrect")
+		assert.Nil(t, signedTx)
+	})
+	t.Run("should reject a transaction modified by the signer", func(t *testing.T) {
+		server.TamperTransaction = true
+		defer func() { server.TamperTransaction = false }()
+		signedTx, err := wallet.Sign(server.Address, newTx(allowedContract))
+		require.ErrorContains(t, err, "different transaction")
+		assert.Nil(t, signedTx)
+	})
+	t.Run("should return error if the signer rejects the transaction", func(t *testing.T) {
+		server.Reject = true
+		defer func() { server.Reject = false }()
+		signedTx, err := wallet.Sign(server.Address, newTx(allowedContract))
+		require.Error(t, err)
+		assert.Nil(t, signedTx)
+	})
+}
+
+func TestRemoteSignerRskWallet_SignBytes(t *testing.T) {
+	wallet, server := newRemoteSignerRskWallet(t, &mocks.RpcClientBindingMock{})
+	hash := crypto.Keccak256([]byte("message"))
+	t.Run("should sign the hash as a personal message", func(t *testing.T) {
+		signature, err := wallet.SignBytes(hash)
+		require.NoError(t, err)
+		require.Len(t, signature, 65)
+		assert.Equal(t, hash, server.SignedMessages[len(server.SignedMessages)-1])
+		assert.True(t, wallet.Validate(hex.EncodeToString(signature), hex.EncodeToString(hash)))
+		assert.False(t, wallet.Validate(hex.EncodeToString(signature), hex.EncodeToString(crypto.Keccak256([]byte("other")))))
+	})
+	t.Run("should not validate signatures of the raw hash", func(t *testing.T) {
+		signature, err := crypto.Sign(hash, server.Key)
+		require.NoError(t, err)
+		assert.False(t, wallet.Validate(hex.EncodeToString(signature), hex.EncodeToString(hash)))
+	})
+	t.Run("should return error if the signer rejects the signature", func(t *testing.T) {
+		server.Reject = true
+		defer func() { server.Reject = false }()
+		signature, err := wallet.SignBytes(hash)
+		require.ErrorContains(t, err, "request denied")
+		assert.Nil(t, signature)
+	})
+}
+
+func TestRemoteSignerRskWallet_SignTypedData(t *testing.T) {
+	wallet, server := newRemoteSignerRskWallet(t, &mocks.RpcClientBindingMock{})
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "chainId", Type: "uint256"}},
+			"Quote":        {{Name: "value", Type: "uint256"}, {Name: "data", Type: "bytes"}},
+		},
+		PrimaryType: "Quote",
+		Domain:      apitypes.TypedDataDomain{Name: "Test", ChainId: math.NewHexOrDecimal256(chainId)},
+		Message:     apitypes.TypedDataMessage{"value": "10", "data": "0x0102"},
+	}
+	rawTypedData, err := json.Marshal(typedData)
+	require.NoError(t, err)
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+	t.Run("should sign the typed data", func(t *testing.T) {
+		signature, signErr := wallet.SignTypedData(rawTypedData, [32]byte(hash))
+		require.NoError(t, signErr)
+		publicKey, recoverErr := crypto.SigToPub(hash, signature)
+		require.NoError(t, recoverErr)
+		assert.Equal(t, server.Address, crypto.PubkeyToAddress(*publicKey))
+		assert.Equal(t, "Quote", server.SignedTypedData[len(server.SignedTypedData)-1].PrimaryType)
+	})
+	t.Run("should not sign if the hash doesn't match the expected one", func(t *testing.T) {
+		signedBefore := len(server.SignedTypedData)
+		signature, signErr := wallet.SignTypedData(rawTypedData, [32]byte(crypto.Keccak256([]byte("other"))))
+		require.ErrorContains(t, signErr, "typed data hash doesn't match the expected hash")
+		assert.Nil(t, signature)
+		assert.Len(t, server.SignedTypedData, signedBefore)
+	})
+	t.Run("should return error if the typed data is invalid", func(t *testing.T) {
+		signature, signErr := wallet.SignTypedData([]byte("{"), [32]byte(hash))
+		require.ErrorContains(t, signErr, "invalid typed data")
+		assert.Nil(t, signature)
+	})
+}
+
+func TestRemoteSignerRskWallet_SendRbtc(t *testing.T) {
+	var gasLimit uint64 = 21000
+	config := blockchain.TransactionConfig{Value: entities.NewWei(1000), GasLimit: &gasLimit, GasPrice: entities.NewWei(60000000)}
+	t.Run("should send rbtc to allowed contracts", func(t *testing.T) {
+		clientMock := &mocks.RpcClientBindingMock{}
+		wallet, server := newRemoteSignerRskWallet(t, clientMock)
+		clientMock.On("PendingNonceAt", mock.Anything, server.Address).Return(uint64(7), nil).Once()
+		clientMock.On("SendTransaction", mock.Anything, mock.AnythingOfType("*types.Transaction")).Return(nil).Once()
+		clientMock.On("TransactionReceipt", mock.Anything, mock.Anything).Return(&geth.Receipt{
+			TxHash:            common.HexToHash("0x01"),
+			Status:            1,
+			BlockNumber:       big.NewInt(10),
+			BlockHash:         common.HexToHash("0x02"),
+			GasUsed:           21000,
+			CumulativeGasUsed: 21000,
+			EffectiveGasPrice: big.NewInt(60000000),
+		}, nil)
+		receipt, err := wallet.SendRbtc(context.Background(), config, allowedContract.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, server.Address.String(), receipt.From)
+		assert.Equal(t, allowedContract.String(), receipt.To)
+		clientMock.AssertExpectations(t)
+	})
+	t.Run("should not send rbtc to other addresses", func(t *testing.T) {
+		clientMock := &mocks.RpcClientBindingMock{}
+		wallet, server := newRemoteSignerRskWallet(t, clientMock)
+		clientMock.On("PendingNonceAt", mock.Anything, server.Address).Return(uint64(7), nil).Once()
+		receipt, err := wallet.SendRbtc(context.Background(), config, notAllowedContract.Hex())
+		require.ErrorIs(t, err, rootstock.ContractNotAllowedError)
+		assert.Empty(t, receipt)
+		clientMock.AssertNotCalled(t, "SendTransaction", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRemoteSignerRskWallet_GetBalance(t *testing.T) {
+	clientMock := &mocks.RpcClientBindingMock{}
+	wallet, server := newRemoteSignerRskWallet(t, clientMock)
+	clientMock.On("BalanceAt", mock.Anything, server.Address, (*big.Int)(nil)).Return(big.NewInt(500), nil).Once()
+	balance, err := wallet.GetBalance(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, entities.NewWei(500), balance)
+	clientMock.AssertExpectations(t)
+}
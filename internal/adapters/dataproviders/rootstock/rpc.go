@@ -19,12 +19,25 @@ import (
 const newAccountGasCost = 25000
 
 type rskjRpcServer struct {
-	client      RpcClientBinding
-	retryParams RetryParams
+	client        RpcClientBinding
+	retryParams   RetryParams
+	gasPrice      blockchain.GasPriceStrategy
+	quoteGasPrice blockchain.GasPriceStrategy
 }
 
+// NewRskjRpcServer creates a server that uses the gas price suggested by the node, both for the quotes and the transactions
 func NewRskjRpcServer(client *RskClient, retryParams RetryParams) blockchain.RootstockRpcServer {
-	return &rskjRpcServer{client: client.client, retryParams: retryParams}
+	nodeGasPrice := NewNodeGasPriceStrategy(client, retryParams)
+	return NewRskjRpcServerWithGasPrice(client, retryParams, nodeGasPrice, nodeGasPrice)
+}
+
+func NewRskjRpcServerWithGasPrice(
+	client *RskClient,
+	retryParams RetryParams,
+	gasPrice blockchain.GasPriceStrategy,
+	quoteGasPrice blockchain.GasPriceStrategy,
+) blockchain.RootstockRpcServer {
+	return &rskjRpcServer{client: client.client, retryParams: retryParams, gasPrice: gasPrice, quoteGasPrice: quoteGasPrice}
 }
 
 func (rpc *rskjRpcServer) GetBalance(ctx context.Context, address string) (*entities.Wei, error) {
@@ -80,15 +93,11 @@ func (rpc *rskjRpcServer) EstimateGas(ctx context.Context, address string, value
 }
 
 func (rpc *rskjRpcServer) GasPrice(ctx context.Context) (*entities.Wei, error) {
-	result, err := rskRetry(rpc.retryParams.Retries, rpc.retryParams.Sleep,
-		func() (*big.Int, error) {
-			return rpc.client.SuggestGasPrice(ctx)
-		})
-	if err != nil {
-		return nil, err
-	} else {
-		return entities.NewBigWei(result), err
-	}
+	return rpc.gasPrice.GasPrice(ctx)
+}
+
+func (rpc *rskjRpcServer) QuoteGasPrice(ctx context.Context) (*entities.Wei, error) {
+	return rpc.quoteGasPrice.GasPrice(ctx)
 }
 
 func (rpc *rskjRpcServer) GetHeight(ctx context.Context) (uint64, error) {
@@ -149,6 +149,34 @@ func TestRskjRpcServer_GasPrice(t *testing.T) {
 	})
 }
 
+func TestRskjRpcServer_GasPrice_Strategy(t *testing.T) {
+	client := &mocks.RpcClientBindingMock{}
+	node := newGasPriceTestNode(100)
+	rpc := rootstock.NewRskjRpcServerWithGasPrice(
+		rootstock.NewRskClient(client),
+		rootstock.RetryParams{},
+		rootstock.NewFixedGasPriceStrategy(node, rootstock.RetryParams{}, entities.NewWei(60000000)),
+		rootstock.NewFixedGasPriceStrategy(node, rootstock.RetryParams{}, entities.NewWei(66000000)),
+	)
+	gasPrice, err := rpc.GasPrice(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, entities.NewWei(60000000), gasPrice)
+	quoteGasPrice, err := rpc.QuoteGasPrice(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, entities.NewWei(66000000), quoteGasPrice)
+	client.AssertNotCalled(t, "SuggestGasPrice", mock.Anything)
+}
+
+func TestRskjRpcServer_QuoteGasPrice(t *testing.T) {
+	client := &mocks.RpcClientBindingMock{}
+	client.On("SuggestGasPrice", test.AnyCtx).Return(big.NewInt(60000000), nil).Once()
+	rpc := rootstock.NewRskjRpcServer(rootstock.NewRskClient(client), rootstock.RetryParams{})
+	gasPrice, err := rpc.QuoteGasPrice(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, entities.NewWei(60000000), gasPrice)
+	client.AssertExpectations(t)
+}
+
 func TestRskjRpcServer_GetBalance(t *testing.T) {
 	var blockNumber *big.Int = nil
 	client := &mocks.RpcClientBindingMock{}
@@ -47,6 +47,27 @@ func (wallet *RskWalletImpl) SignBytes(msg []byte) ([]byte, error) {
 }
 
 func (wallet *RskWalletImpl) Validate(signature, hash string) bool {
+	return validateSignature(wallet.Address(), signature, hash)
+}
+
+func (wallet *RskWalletImpl) SendRbtc(ctx context.Context, config blockchain.TransactionConfig, toAddress string) (blockchain.TransactionReceipt, error) {
+	sender := rbtcSender{client: wallet.client, signer: wallet, miningTimeout: wallet.miningTimeout}
+	return sender.send(ctx, config, toAddress)
+}
+
+func (wallet *RskWalletImpl) GetBalance(ctx context.Context) (*entities.Wei, error) {
+	return getBalance(ctx, wallet.client, wallet.Address())
+}
+
+func getBalance(ctx context.Context, client RpcClientBinding, address common.Address) (*entities.Wei, error) {
+	balance, err := client.BalanceAt(ctx, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	return entities.NewBigWei(balance), nil
+}
+
+func validateSignature(address common.Address, signature, hash string) bool {
 	signatureBytes, err := hex.DecodeString(signature)
 	if err != nil {
 		log.Error("Error decoding signature: ", err)
@@ -63,29 +84,37 @@ func (wallet *RskWalletImpl) Validate(signature, hash string) bool {
 		return false
 	}
 	pubKeyHash := crypto.Keccak256Hash(pubKey[1:])
-	return bytes.Equal(wallet.account.Account.Address.Bytes(), pubKeyHash[12:]) // the last 20 bytes of the hash
+	return bytes.Equal(address.Bytes(), pubKeyHash[12:]) // the last 20 bytes of the hash
 }
 
-func (wallet *RskWalletImpl) SendRbtc(ctx context.Context, config blockchain.TransactionConfig, toAddress string) (blockchain.TransactionReceipt, error) {
-	to, nonce, err := wallet.validateAndPrepareSendRbtc(ctx, config, toAddress)
+// rbtcSender sends RBTC from the address of a TransactionSigner. It's shared by the wallet implementations,
+// which only differ in the way they sign the transactions
+type rbtcSender struct {
+	client        RpcClientBinding
+	signer        TransactionSigner
+	miningTimeout time.Duration
+}
+
+func (sender rbtcSender) send(ctx context.Context, config blockchain.TransactionConfig, toAddress string) (blockchain.TransactionReceipt, error) {
+	to, nonce, err := sender.validateAndPrepareSendRbtc(ctx, config, toAddress)
 	if err != nil {
 		return blockchain.TransactionReceipt{}, err
 	}
 
-	signedTx, err := wallet.createAndSignTransaction(to, nonce, config, toAddress)
+	signedTx, err := sender.createAndSignTransaction(to, nonce, config, toAddress)
 	if err != nil {
 		return blockchain.TransactionReceipt{}, err
 	}
 
-	receipt, err := wallet.sendAndAwaitTransaction(ctx, signedTx)
+	receipt, err := sender.sendAndAwaitTransaction(ctx, signedTx)
 	if err != nil {
 		return blockchain.TransactionReceipt{}, err
 	}
 
-	return wallet.buildTransactionReceipt(receipt, signedTx)
+	return sender.buildTransactionReceipt(receipt, signedTx)
 }
 
-func (wallet *RskWalletImpl) validateAndPrepareSendRbtc(ctx context.Context, config blockchain.TransactionConfig, toAddress string) (common.Address, uint64, error) {
+func (sender rbtcSender) validateAndPrepareSendRbtc(ctx context.Context, config blockchain.TransactionConfig, toAddress string) (common.Address, uint64, error) {
 	var to common.Address
 	var nonce uint64
 	var err error
@@ -98,14 +127,14 @@ func (wallet *RskWalletImpl) validateAndPrepareSendRbtc(ctx context.Context, con
 		return common.Address{}, 0, errors.New("incomplete transaction arguments")
 	}
 
-	if nonce, err = wallet.client.PendingNonceAt(ctx, wallet.Address()); err != nil {
+	if nonce, err = sender.client.PendingNonceAt(ctx, sender.signer.Address()); err != nil {
 		return common.Address{}, 0, err
 	}
 
 	return to, nonce, nil
 }
 
-func (wallet *RskWalletImpl) createAndSignTransaction(to common.Address, nonce uint64, config blockchain.TransactionConfig, toAddress string) (*geth.Transaction, error) {
+func (sender rbtcSender) createAndSignTransaction(to common.Address, nonce uint64, config blockchain.TransactionConfig, toAddress string) (*geth.Transaction, error) {
 	tx := geth.NewTx(&geth.LegacyTx{
 		To:       &to,
 		Nonce:    nonce,
@@ -115,12 +144,12 @@ func (wallet *RskWalletImpl) createAndSignTransaction(to common.Address, nonce u
 	})
 	log.Infof("Sending %v RBTC to %s\n", config.Value.ToRbtc(), toAddress)
 
-	return wallet.Sign(wallet.Address(), tx)
+	return sender.signer.Sign(sender.signer.Address(), tx)
 }
 
-func (wallet *RskWalletImpl) sendAndAwaitTransaction(ctx context.Context, signedTx *geth.Transaction) (*geth.Receipt, error) {
-	sendError := wallet.client.SendTransaction(ctx, signedTx)
-	receipt, err := AwaitTxWithCtx(wallet.client, wallet.miningTimeout, "SendRbtc", ctx, func() (*geth.Transaction, error) {
+func (sender rbtcSender) sendAndAwaitTransaction(ctx context.Context, signedTx *geth.Transaction) (*geth.Receipt, error) {
+	sendError := sender.client.SendTransaction(ctx, signedTx)
+	receipt, err := AwaitTxWithCtx(sender.client, sender.miningTimeout, "SendRbtc", ctx, func() (*geth.Transaction, error) {
 		return signedTx, sendError
 	})
 
@@ -134,7 +163,7 @@ func (wallet *RskWalletImpl) sendAndAwaitTransaction(ctx context.Context, signed
 	return receipt, nil
 }
 
-func (wallet *RskWalletImpl) buildTransactionReceipt(receipt *geth.Receipt, tx *geth.Transaction) (blockchain.TransactionReceipt, error) {
+func (sender rbtcSender) buildTransactionReceipt(receipt *geth.Receipt, tx *geth.Transaction) (blockchain.TransactionReceipt, error) {
 	// Use the transaction directly to get the "To" address and the Value
 	toAddressStr := ""
 	txValue := entities.NewWei(0)
@@ -149,7 +178,7 @@ func (wallet *RskWalletImpl) buildTransactionReceipt(receipt *geth.Receipt, tx *
 		TransactionHash:   receipt.TxHash.String(),
 		BlockHash:         receipt.BlockHash.String(),
 		BlockNumber:       receipt.BlockNumber.Uint64(),
-		From:              wallet.Address().String(),
+		From:              sender.signer.Address().String(),
 		To:                toAddressStr,
 		CumulativeGasUsed: new(big.Int).SetUint64(receipt.CumulativeGasUsed),
 		GasUsed:           new(big.Int).SetUint64(receipt.GasUsed),
@@ -164,11 +193,3 @@ func (wallet *RskWalletImpl) buildTransactionReceipt(receipt *geth.Receipt, tx *
 
 	return transactionReceipt, nil
 }
-
-func (wallet *RskWalletImpl) GetBalance(ctx context.Context) (*entities.Wei, error) {
-	balance, err := wallet.client.BalanceAt(ctx, wallet.Address(), nil)
-	if err != nil {
-		return nil, err
-	}
-	return entities.NewBigWei(balance), nil
-}
@@ -0,0 +1,195 @@
+package dataproviders
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/utils"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	WebhookSignatureHeader     = "X-Flyover-Signature"
+	WebhookSignatureTypeHeader = "X-Flyover-Signature-Type"
+	WebhookIdHeader            = "X-Flyover-Webhook-Id"
+)
+
+var nonRetryableWebhookError = errors.New("webhook rejected the notification")
+
+// HttpNotificationSender posts the quote notifications to the integrators webhooks. The body is signed
+// with the webhook secret (HMAC-SHA256) or, if the webhook doesn't have one, with the liquidity provider
+// key as an EIP-191 personal message of the hash of the body, regardless of the wallet. Network errors, 5xx and 429 responses are retried with exponential backoff. The callback webhooks are
+// delivered using the callbackClient, which should only connect to public addresses
+type HttpNotificationSender struct {
+	client         utils.HttpClient
+	callbackClient utils.HttpClient
+	signer         entities.Signer
+	hashFunction   entities.HashFunction
+	maxAttempts    uint64
+	initialBackoff time.Duration
+}
+
+func NewHttpNotificationSender(
+	client utils.HttpClient,
+	callbackClient utils.HttpClient,
+	signer entities.Signer,
+	hashFunction entities.HashFunction,
+	maxAttempts uint64,
+	initialBackoff time.Duration,
+) *HttpNotificationSender {
+	return &HttpNotificationSender{
+		client:         client,
+		callbackClient: callbackClient,
+		signer:         signer,
+		hashFunction:   hashFunction,
+		maxAttempts:    maxAttempts,
+		initialBackoff: initialBackoff,
+	}
+}
+
+func (sender *HttpNotificationSender) SendNotification(ctx context.Context, hook webhook.Webhook, notification webhook.Notification) error {
+	return sender.send(ctx, hook, notification)
+}
+
+func (sender *HttpNotificationSender) send(ctx context.Context, hook webhook.Webhook, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	signature, err := sender.sign(hook, body)
+	if err != nil {
+		return err
+	}
+	backoff := sender.initialBackoff
+	for attempt := uint64(1); ; attempt++ {
+		err = sender.post(ctx, hook, body, signature)
+		if err == nil || errors.Is(err, nonRetryableWebhookError) || attempt >= sender.maxAttempts {
+			return err
+		}
+		log.Debugf("Attempt %d to notify webhook %s failed, retrying in %s: %v", attempt, hook.Id, backoff, err)
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(backoff):
+			backoff *= 2
+		}
+	}
+}
+
+func (sender *HttpNotificationSender) sign(hook webhook.Webhook, body []byte) (string, error) {
+	if hook.SignatureType() == webhook.SignatureTypeHmac {
+		mac := hmac.New(sha256.New, []byte(hook.Secret))
+		mac.Write(body)
+		return hex.EncodeToString(mac.Sum(nil)), nil
+	}
+	var signature []byte
+	var err error
+	hash := sender.hashFunction(body)
+	if messageSigner, ok := sender.signer.(rootstock.PersonalMessageSigner); ok {
+		signature, err = messageSigner.SignPersonalMessage(hash)
+	} else {
+		signature, err = sender.signer.SignBytes(accounts.TextHash(hash))
+	}
+	if err != nil {
+		return "", err
+	}
+	signature[len(signature)-1] += 27 // v must be 27 or 28
+	return hex.EncodeToString(signature), nil
+}
+
+func (sender *HttpNotificationSender) post(ctx context.Context, hook webhook.Webhook, body []byte, signature string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.Url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Join(nonRetryableWebhookError, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookIdHeader, hook.Id)
+	req.Header.Set(WebhookSignatureHeader, signature)
+	req.Header.Set(WebhookSignatureTypeHeader, string(hook.SignatureType()))
+	client := sender.client
+	if hook.Callback {
+		client = sender.callbackClient
+	}
+	res, err := client.Do(req)
+	defer utils.CloseBodyIfExists(res)
+	if errors.Is(err, webhook.InvalidCallbackUrlError) {
+		return errors.Join(nonRetryableWebhookError, err)
+	} else if err != nil {
+		return err
+	}
+	if res.StatusCode >= 200 && res.StatusCode <= 299 {
+		return nil
+	}
+	message, readErr := io.ReadAll(res.Body)
+	if readErr != nil {
+		return readErr
+	}
+	err = fmt.Errorf("unexpected response (%d) from %s: %s", res.StatusCode, hook.Url, message)
+	if res.StatusCode != http.StatusTooManyRequests && res.StatusCode < http.StatusInternalServerError {
+		return errors.Join(nonRetryableWebhookError, err)
+	}
+	return err
+}
+
+// NewPublicHttpClient creates the client used to deliver the callback webhooks. Their URLs are provided by the
+// users of the public API and their hosts can resolve to any address, so the client refuses to connect to non
+// public addresses and doesn't follow redirects
+func NewPublicHttpClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !webhook.IsPublicIp(ip) {
+				return fmt.Errorf("%w: %s is not a public address", webhook.InvalidCallbackUrlError, host)
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext, TLSHandshakeTimeout: timeout},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// ReportSnapshotWebhookId is sent in the WebhookIdHeader of the report snapshots deliveries
+const ReportSnapshotWebhookId = "report-snapshots"
+
+// HttpSnapshotSender posts the report snapshots to a webhook configured by the liquidity provider. The snapshots
+// are signed and retried in the same way as the quote notifications
+type HttpSnapshotSender struct {
+	sender *HttpNotificationSender
+	hook   webhook.Webhook
+}
+
+func NewHttpSnapshotSender(sender *HttpNotificationSender, url, secret string) *HttpSnapshotSender {
+	return &HttpSnapshotSender{
+		sender: sender,
+		hook:   webhook.Webhook{Id: ReportSnapshotWebhookId, Url: url, Secret: secret},
+	}
+}
+
+func (sender *HttpSnapshotSender) SendSnapshot(ctx context.Context, snapshot reporting.Snapshot) error {
+	return sender.sender.send(ctx, sender.hook, snapshot)
+}
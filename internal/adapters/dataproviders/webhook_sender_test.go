@@ -0,0 +1,257 @@
+package dataproviders_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/remote_signer"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/rsksmart/liquidity-provider-server/test/remote_signer_stub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testNotification = webhook.Notification{
+	QuoteHash: "0x0102",
+	Operation: webhook.OperationPegin,
+	State:     "CallForUserSucceeded",
+	Timestamp: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+}
+
+func TestHttpNotificationSender_SendNotification(t *testing.T) {
+	t.Run("Should sign with HMAC when the webhook has a secret", func(t *testing.T) {
+		hook := webhook.Webhook{Id: "1", Secret: "secret"}
+		var received webhook.Notification
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			mac := hmac.New(sha256.New, []byte("secret"))
+			mac.Write(body)
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.Equal(t, "1", r.Header.Get(dataproviders.WebhookIdHeader))
+			assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), r.Header.Get(dataproviders.WebhookSignatureHeader))
+			assert.Equal(t, "hmac-sha256", r.Header.Get(dataproviders.WebhookSignatureTypeHeader))
+			assert.NoError(t, json.Unmarshal(body, &received))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+		hook.Url = server.URL
+		signer := &mocks.TransactionSignerMock{}
+		sender := dataproviders.NewHttpNotificationSender(server.Client(), server.Client(), signer, crypto.Keccak256, 3, time.Millisecond)
+		err := sender.SendNotification(context.Background(), hook, testNotification)
+		require.NoError(t, err)
+		assert.Equal(t, testNotification, received)
+		signer.AssertNotCalled(t, "SignBytes")
+	})
+	t.Run("Should sign with the provider key when the webhook doesn't have a secret", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "0102031b", r.Header.Get(dataproviders.WebhookSignatureHeader))
+			assert.Equal(t, "lp-signature", r.Header.Get(dataproviders.WebhookSignatureTypeHeader))
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		body, err := json.Marshal(testNotification)
+		require.NoError(t, err)
+		signer := &mocks.TransactionSignerMock{}
+		signer.On("SignBytes", accounts.TextHash(crypto.Keccak256(body))).Return([]byte{1, 2, 3, 0}, nil).Once()
+		sender := dataproviders.NewHttpNotificationSender(server.Client(), server.Client(), signer, crypto.Keccak256, 3, time.Millisecond)
+		err = sender.SendNotification(context.Background(), webhook.Webhook{Id: "1", Url: server.URL}, testNotification)
+		require.NoError(t, err)
+		signer.AssertExpectations(t)
+	})
+	t.Run("Should return error when signing fails", func(t *testing.T) {
+		signer := &mocks.TransactionSignerMock{}
+		signer.On("SignBytes", mock.AnythingOfType("[]uint8")).Return(nil, assert.AnError).Once()
+		sender := dataproviders.NewHttpNotificationSender(http.DefaultClient, http.DefaultClient, signer, crypto.Keccak256, 3, time.Millisecond)
+		err := sender.SendNotification(context.Background(), webhook.Webhook{Id: "1", Url: "http://localhost"}, testNotification)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestHttpNotificationSender_SendNotification_SignatureScheme(t *testing.T) {
+	testAccount := test.OpenWalletForTest(t, "webhook-signature")
+	keyJson, err := testAccount.Keystore.Export(*testAccount.Account, test.KeyPassword, test.KeyPassword)
+	require.NoError(t, err)
+	key, err := keystore.DecryptKey(keyJson, test.KeyPassword)
+	require.NoError(t, err)
+	signerServer := remote_signer_stub.NewServer(t, remote_signer.Web3SignerSigner)
+	signerServer.Key = key.PrivateKey
+	signerServer.Address = key.Address
+	signerClient, err := remote_signer.Dial(context.Background(), signerServer.URL, remote_signer.Web3SignerSigner)
+	require.NoError(t, err)
+	defer signerClient.Close()
+	rskClient := rootstock.NewRskClient(&mocks.RpcClientBindingMock{})
+	remoteWallet, err := rootstock.NewRemoteSignerRskWallet(context.Background(), rskClient, signerClient, rootstock.RemoteSignerWalletArgs{
+		Address:        key.Address,
+		SigningTimeout: time.Second,
+	})
+	require.NoError(t, err)
+	localWallet := rootstock.NewRskWalletImpl(rskClient, testAccount, 31, time.Second)
+
+	for _, signer := range []rootstock.TransactionSigner{localWallet, remoteWallet} {
+		var recovered common.Address
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, readErr := io.ReadAll(r.Body)
+			assert.NoError(t, readErr)
+			signature, decodeErr := hex.DecodeString(r.Header.Get(dataproviders.WebhookSignatureHeader))
+			assert.NoError(t, decodeErr)
+			signature[crypto.RecoveryIDOffset] -= 27
+			publicKey, recoverErr := crypto.SigToPub(accounts.TextHash(crypto.Keccak256(body)), signature)
+			assert.NoError(t, recoverErr)
+			recovered = crypto.PubkeyToAddress(*publicKey)
+			w.WriteHeader(http.StatusOK)
+		}))
+		sender := dataproviders.NewHttpNotificationSender(server.Client(), server.Client(), signer, crypto.Keccak256, 1, time.Millisecond)
+		err = sender.SendNotification(context.Background(), webhook.Webhook{Id: "1", Url: server.URL}, testNotification)
+		server.Close()
+		require.NoError(t, err)
+		assert.Equal(t, key.Address, recovered)
+	}
+	assert.Len(t, signerServer.SignedMessages, 1)
+}
+
+func TestHttpNotificationSender_SendNotification_Callback(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	signer := &mocks.TransactionSignerMock{}
+	signer.On("SignBytes", mock.AnythingOfType("[]uint8")).Return([]byte{1, 2, 3, 0}, nil)
+	sender := dataproviders.NewHttpNotificationSender(server.Client(), dataproviders.NewPublicHttpClient(time.Second), signer, crypto.Keccak256, 3, time.Millisecond)
+	t.Run("Should not deliver callback webhooks to non public addresses", func(t *testing.T) {
+		err := sender.SendNotification(context.Background(), webhook.Webhook{Id: "1", Url: server.URL, Callback: true}, testNotification)
+		require.ErrorIs(t, err, webhook.InvalidCallbackUrlError)
+		assert.Equal(t, int32(0), requests.Load())
+	})
+	t.Run("Should deliver the webhooks registered by the provider to any address", func(t *testing.T) {
+		err := sender.SendNotification(context.Background(), webhook.Webhook{Id: "2", Url: server.URL}, testNotification)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), requests.Load())
+	})
+}
+
+func TestHttpNotificationSender_SendNotification_Retries(t *testing.T) {
+	hook := webhook.Webhook{Id: "1", Secret: "secret"}
+	t.Run("Should retry on server errors until success", func(t *testing.T) {
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if attempts.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		hook.Url = server.URL
+		sender := dataproviders.NewHttpNotificationSender(server.Client(), server.Client(), &mocks.TransactionSignerMock{}, crypto.Keccak256, 5, time.Millisecond)
+		err := sender.SendNotification(context.Background(), hook, testNotification)
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), attempts.Load())
+	})
+	t.Run("Should retry on too many requests", func(t *testing.T) {
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts.Add(1)
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+		hook.Url = server.URL
+		sender := dataproviders.NewHttpNotificationSender(server.Client(), server.Client(), &mocks.TransactionSignerMock{}, crypto.Keccak256, 3, time.Millisecond)
+		err := sender.SendNotification(context.Background(), hook, testNotification)
+		require.ErrorContains(t, err, "unexpected response (429)")
+		assert.Equal(t, int32(3), attempts.Load())
+	})
+	t.Run("Should not retry on client errors", func(t *testing.T) {
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts.Add(1)
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("invalid notification"))
+		}))
+		defer server.Close()
+		hook.Url = server.URL
+		sender := dataproviders.NewHttpNotificationSender(server.Client(), server.Client(), &mocks.TransactionSignerMock{}, crypto.Keccak256, 3, time.Millisecond)
+		err := sender.SendNotification(context.Background(), hook, testNotification)
+		require.ErrorContains(t, err, "unexpected response (400)")
+		require.ErrorContains(t, err, "invalid notification")
+		assert.Equal(t, int32(1), attempts.Load())
+	})
+	t.Run("Should stop retrying when the context is done", func(t *testing.T) {
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+		hook.Url = server.URL
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		sender := dataproviders.NewHttpNotificationSender(server.Client(), server.Client(), &mocks.TransactionSignerMock{}, crypto.Keccak256, 3, time.Hour)
+		err := sender.SendNotification(ctx, hook, testNotification)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.LessOrEqual(t, attempts.Load(), int32(1))
+	})
+}
+
+func TestHttpSnapshotSender_SendSnapshot(t *testing.T) {
+	snapshot := reporting.Snapshot{
+		Id:          "daily:summaries:2024-01-01",
+		Schedule:    reporting.ScheduleDaily,
+		ReportType:  reporting.ReportTypeSummaries,
+		PeriodStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:   time.Date(2024, 1, 1, 23, 59, 59, 999999999, time.UTC),
+		CreatedAt:   time.Date(2024, 1, 2, 0, 1, 0, 0, time.UTC),
+		Content:     json.RawMessage(`{"peginSummary":{"totalQuotesCount":1}}`),
+	}
+	t.Run("Should post the snapshot signed with the secret", func(t *testing.T) {
+		var received reporting.Snapshot
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			mac := hmac.New(sha256.New, []byte("secret"))
+			mac.Write(body)
+			assert.Equal(t, dataproviders.ReportSnapshotWebhookId, r.Header.Get(dataproviders.WebhookIdHeader))
+			assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), r.Header.Get(dataproviders.WebhookSignatureHeader))
+			assert.NoError(t, json.Unmarshal(body, &received))
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		notificationSender := dataproviders.NewHttpNotificationSender(server.Client(), server.Client(), &mocks.TransactionSignerMock{}, crypto.Keccak256, 3, time.Millisecond)
+		sender := dataproviders.NewHttpSnapshotSender(notificationSender, server.URL, "secret")
+		err := sender.SendSnapshot(context.Background(), snapshot)
+		require.NoError(t, err)
+		assert.Equal(t, snapshot, received)
+	})
+	t.Run("Should return error when the webhook rejects the snapshot", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+		notificationSender := dataproviders.NewHttpNotificationSender(server.Client(), server.Client(), &mocks.TransactionSignerMock{}, crypto.Keccak256, 3, time.Millisecond)
+		sender := dataproviders.NewHttpSnapshotSender(notificationSender, server.URL, "secret")
+		err := sender.SendSnapshot(context.Background(), snapshot)
+		require.ErrorContains(t, err, "unexpected response (404)")
+	})
+}
@@ -191,7 +191,9 @@ const getTooltipText = (key) => {
         expireBlocks: 'The number of blocks after which a quote is considered expired.',
         bridgeTransactionMin: 'The amount of rBTC that needs to be gathered in peg out refunds before executing a native peg out.',
         fixedFee: 'A fixed fee charged for transactions.',
-        feePercentage: 'A percentage fee charged based on the transaction amount.'
+        feePercentage: 'A percentage fee charged based on the transaction amount.',
+        feeTiers: 'Fees applied instead of fixedFee and feePercentage to the quotes up to the tier amount. The tier with the lowest amount that covers the quote is used.',
+        feeSurcharges: 'Percentages added to the fee percentage depending on the available liquidity or the time of the day. Only editable through the management API.'
     };
     return tooltips[key] || 'No description available';
 };
@@ -304,16 +306,127 @@ const createConfirmationEntry = (container, configKey, index, amount = '', confi
     container.appendChild(div);
 };
 
+const createFeeTiersConfig = (section, tiers) => {
+    const container = document.createElement('div');
+    container.classList.add('confirmation-config', 'fee-tiers-config');
+
+    const header = document.createElement('h5');
+    header.textContent = 'feeTiers';
+    header.appendChild(createQuestionIcon(getTooltipText('feeTiers')));
+    container.appendChild(header);
+
+    const entriesContainer = document.createElement('div');
+    entriesContainer.classList.add('entries-container');
+    [...tiers].sort((tierA, tierB) => new Decimal(tierA.maxAmount).comparedTo(new Decimal(tierB.maxAmount)))
+        .forEach((tier, index) => createFeeTierEntry(entriesContainer, section.id, index, tier));
+    container.appendChild(entriesContainer);
+
+    const addButton = document.createElement('button');
+    addButton.type = 'button';
+    addButton.classList.add('btn', 'btn-secondary', 'mt-2');
+    addButton.textContent = 'Add Tier';
+    addButton.addEventListener('click', () => {
+        const index = entriesContainer.querySelectorAll('.fee-tier-entry').length;
+        createFeeTierEntry(entriesContainer, section.id, index);
+        setChanged(section.id);
+    });
+    container.appendChild(addButton);
+    section.appendChild(container);
+};
+
+const createFeeTierEntry = (container, sectionId, index, tier = { maxAmount: '', fixedFee: '', feePercentage: '' }) => {
+    const div = document.createElement('div');
+    div.classList.add('d-flex', 'align-items-center', 'mb-2', 'fee-tier-entry');
+    const fieldWidth = '150px';
+
+    const createTierField = (field, value, placeholder, unit) => {
+        const group = document.createElement('div');
+        group.classList.add('input-group', 'me-2');
+        const input = document.createElement('input');
+        input.type = 'text';
+        input.value = value;
+        input.classList.add('form-control', 'form-control-sm', 'fee-tier-input');
+        input.placeholder = placeholder;
+        input.dataset.field = field;
+        input.style.maxWidth = fieldWidth;
+        input.setAttribute('data-testid', `config-${sectionId.replace('Config','')}-feeTiers-${index}-${field}`);
+        input.addEventListener('input', () => setChanged(sectionId));
+        const append = document.createElement('span');
+        append.classList.add('input-group-text', 'input-group-text-sm');
+        append.textContent = unit;
+        group.appendChild(input);
+        group.appendChild(append);
+        return group;
+    };
+
+    div.appendChild(createTierField('maxAmount', tier.maxAmount ? weiToEther(tier.maxAmount) : '', 'Up to amount', 'rBTC'));
+    div.appendChild(createTierField('fixedFee', tier.fixedFee !== '' ? weiToEther(tier.fixedFee) : '', 'Fixed fee', 'rBTC'));
+    div.appendChild(createTierField('feePercentage', tier.feePercentage, 'Fee percentage', '%'));
+
+    const removeButton = document.createElement('button');
+    removeButton.type = 'button';
+    removeButton.classList.add('btn', 'btn-danger', 'btn-sm');
+    removeButton.textContent = 'Remove';
+    removeButton.addEventListener('click', () => {
+        div.remove();
+        setChanged(sectionId);
+    });
+    div.appendChild(removeButton);
+    container.appendChild(div);
+};
+
+const describeSurcharges = (surcharges) => {
+    const descriptions = [];
+    (surcharges.utilization || []).forEach(surcharge => {
+        const threshold = surcharge.threshold.amount !== undefined
+            ? `${weiToEther(surcharge.threshold.amount)} rBTC`
+            : `${surcharge.threshold.maxValueMultiplier} times maxValue`;
+        descriptions.push(`+${surcharge.feePercentage}% while available liquidity is under ${threshold}`);
+    });
+    (surcharges.timeOfDay || []).forEach(surcharge => {
+        descriptions.push(`+${surcharge.feePercentage}% from ${surcharge.startHour}:00 to ${surcharge.endHour}:00 UTC`);
+    });
+    return descriptions;
+};
+
+const createFeeSurchargesSummary = (section, surcharges) => {
+    const container = document.createElement('div');
+    container.classList.add('mb-3');
+    const header = document.createElement('h5');
+    header.textContent = 'feeSurcharges';
+    header.appendChild(createQuestionIcon(getTooltipText('feeSurcharges')));
+    container.appendChild(header);
+    const list = document.createElement('ul');
+    list.setAttribute('data-testid', `config-${section.id.replace('Config','')}-feeSurcharges`);
+    describeSurcharges(surcharges).forEach(description => {
+        const item = document.createElement('li');
+        item.textContent = description;
+        list.appendChild(item);
+    });
+    container.appendChild(list);
+    section.appendChild(container);
+};
+
 const populateConfigSection = (sectionId, config) => {
     const section = document.getElementById(sectionId);
     section.innerHTML = '';
     Object.entries(config).forEach(([key, value]) => {
-        if (key === 'rskConfirmations' || key === 'btcConfirmations') {
+        if (key === 'liquidityThresholds' || key === 'feeTiers' || key === 'feeSurcharges') {
+            // thresholds and surcharges are only editable through the management API, they're kept as they are when saving.
+            // The fee tiers are rendered after the rest of the fields
+            return;
+        } else if (key === 'rskConfirmations' || key === 'btcConfirmations') {
             createConfirmationConfig(section, key, value);
         } else {
             createInput(section, key, value);
         }
     });
+    if (sectionId === 'peginConfig' || sectionId === 'pegoutConfig') {
+        createFeeTiersConfig(section, config.feeTiers || []);
+        if (config.feeSurcharges) {
+            createFeeSurchargesSummary(section, config.feeSurcharges);
+        }
+    }
 };
 
 const showSuccessToast = () => {
@@ -453,7 +566,7 @@ function getConfirmationConfig(sectionId) {
 }
 
 function getRegularConfig(sectionId) {
-    const inputs = document.querySelectorAll(`#${sectionId} input:not(.form-check-input):not([data-field="amount"]):not([data-field="confirmation"])`);
+    const inputs = document.querySelectorAll(`#${sectionId} input:not(.form-check-input):not(.fee-tier-input):not([data-field="amount"]):not([data-field="confirmation"])`);
     const checkboxes = document.querySelectorAll(`#${sectionId} input.form-check-input`);
     const config = {};
 
@@ -512,6 +625,43 @@ function getRegularConfig(sectionId) {
     return config;
 }
 
+function getFeeTiersConfig(sectionId) {
+    const entries = document.querySelectorAll(`#${sectionId} .fee-tier-entry`);
+    const tiers = [];
+    entries.forEach(entry => {
+        const tier = {};
+        entry.querySelectorAll('input').forEach(input => {
+            const field = input.dataset.field;
+            const rawValue = input.value.trim();
+            if (rawValue === '') {
+                showErrorToast(`"${sectionId}": Please enter a non-empty value for the fee tier ${field}.`);
+                throw new Error(`Empty fee tier ${field}`);
+            }
+            if (field === 'feePercentage') {
+                const value = Number(rawValue.endsWith('%') ? rawValue.slice(0, -1) : rawValue);
+                if (isNaN(value) || value < 0 || value > 100) {
+                    showErrorToast(`"${sectionId}": Invalid fee tier percentage "${rawValue}". Please enter a value between 0% and 100%.`);
+                    throw new Error('Invalid fee tier percentage');
+                }
+                tier[field] = value;
+                return;
+            }
+            try {
+                tier[field] = etherToWei(rawValue).toString();
+            } catch (error) {
+                showErrorToast(`"${sectionId}": Invalid input "${rawValue}" for fee tier ${field}. Please enter a valid non-negative number.`);
+                throw error;
+            }
+        });
+        tiers.push(tier);
+    });
+    if (new Set(tiers.map(tier => tier.maxAmount)).size < tiers.length) {
+        showErrorToast(`"${sectionId}": Duplicate fee tier amounts found. Please remove duplicates before saving.`);
+        throw new Error('Duplicate fee tier amounts');
+    }
+    return tiers;
+}
+
 function getConfig(sectionId) {
     const confirmationInputs = document.querySelectorAll(`#${sectionId} .confirmation-config`);
     let config = {};
@@ -523,6 +673,11 @@ function getConfig(sectionId) {
 
     const regularConfig = getRegularConfig(sectionId);
     config = { ...config, ...regularConfig };
+
+    if (document.querySelector(`#${sectionId} .fee-tiers-config`)) {
+        const feeTiers = getFeeTiersConfig(sectionId);
+        if (feeTiers.length > 0) config.feeTiers = feeTiers;
+    }
     return config;
 }
 
@@ -552,19 +707,29 @@ const saveConfig = async (csrfToken, configurations) => {
         }
     }
 
-    const { isValid: isGeneralValid, errors: generalErrors } = validateConfig(formatGeneralConfig(generalConfig), configurations.general);
+    const formattedGeneralConfig = formatGeneralConfig(generalConfig);
+    if (configurations.general.liquidityThresholds) {
+        formattedGeneralConfig.liquidityThresholds = configurations.general.liquidityThresholds;
+    }
+    const { isValid: isGeneralValid, errors: generalErrors } = validateConfig(formattedGeneralConfig, configurations.general);
     if (!isGeneralValid) {
         showErrorToast(generalErrors.join('<br>'));
         saveSuccess = false;
     } else if (generalChanged.value) {
         try {
-            await postConfig('generalConfig', '/configuration', formatGeneralConfig(generalConfig), csrfToken);
+            await postConfig('generalConfig', '/configuration', formattedGeneralConfig, csrfToken);
         } catch (error) {
             showErrorToast(error.message);
             saveSuccess = false;
         }
     }
 
+    if (configurations.pegin.feeSurcharges) {
+        peginConfigData.feeSurcharges = configurations.pegin.feeSurcharges;
+    }
+    if (configurations.pegout.feeSurcharges) {
+        pegoutConfigData.feeSurcharges = configurations.pegout.feeSurcharges;
+    }
     const { isValid: isPeginValid, errors: peginErrors } = validateConfig(peginConfigData, configurations.pegin);
     if (!isPeginValid) {
         showErrorToast(peginErrors.join('<br>'));
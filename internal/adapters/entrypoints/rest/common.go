@@ -232,21 +232,32 @@ func getValidationMessage(field validator.FieldError) string {
 }
 
 func ValidateRequest[T any](w http.ResponseWriter, body *T) error {
-	var validationErrors validator.ValidationErrors
 	err := RequestValidator.Struct(body)
 	if err == nil {
 		return nil
-	} else if !errors.As(err, &validationErrors) {
+	}
+	details, ok := ValidationErrorDetails(err)
+	if !ok {
 		ValidateRequestError(w, err)
 		return err
 	}
+	jsonErr := NewErrorResponseWithDetails("validation error", details, true)
+	JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+	return err
+}
+
+// ValidationErrorDetails returns the validation message of each invalid field of a request. Returns false if
+// the error wasn't produced by the validation of the fields.
+func ValidationErrorDetails(err error) (ErrorDetails, bool) {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return nil, false
+	}
 	details := make(ErrorDetails)
 	for _, field := range validationErrors {
 		details[field.Field()] = getValidationMessage(field)
 	}
-	jsonErr := NewErrorResponseWithDetails("validation error", details, true)
-	JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
-	return err
+	return details, true
 }
 
 func RequiredQueryParam(name string) error {
@@ -0,0 +1,55 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const ContentTypeEventStream = "text/event-stream"
+
+// EventStream writes a Server-Sent Events response. The server write timeout is disabled for the
+// response, so the stream can stay open for as long as the client is connected
+type EventStream struct {
+	w          http.ResponseWriter
+	controller *http.ResponseController
+}
+
+func NewEventStream(w http.ResponseWriter) (*EventStream, error) {
+	controller := http.NewResponseController(w)
+	if err := controller.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return nil, err
+	} else if err != nil {
+		log.Debug("Write deadline can't be disabled for event stream, the stream will be closed by the server write timeout")
+	}
+	w.Header().Set(HeaderContentType, ContentTypeEventStream)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	return &EventStream{w: w, controller: controller}, controller.Flush()
+}
+
+// Send writes an event with the JSON representation of data as payload
+func (stream *EventStream) Send(event string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintf(stream.w, "event: %s\ndata: %s\n\n", event, payload); err != nil {
+		return err
+	}
+	return stream.controller.Flush()
+}
+
+// Ping writes a comment to keep the connection alive through proxies and detect disconnected clients
+func (stream *EventStream) Ping() error {
+	if _, err := fmt.Fprint(stream.w, ": ping\n\n"); err != nil {
+		return err
+	}
+	return stream.controller.Flush()
+}
@@ -15,7 +15,7 @@ import (
 // @Param Request body pkg.AcceptAuthenticatedQuoteRequest true "Quote Hash and Signature"
 // @Success 200 object pkg.AcceptPeginRespose Interface that represents that the quote has been successfully accepted
 // @Route /pegin/acceptAuthenticatedQuote [post]
-func NewAcceptPeginAuthenticatedQuoteHandler(useCase AcceptQuoteUseCase) http.HandlerFunc {
+func NewAcceptPeginAuthenticatedQuoteHandler(useCase AcceptQuoteUseCase, registerCallbackUseCase RegisterQuoteCallbackUseCase) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
 		var err error
 		acceptRequest := pkg.AcceptAuthenticatedQuoteRequest{}
@@ -33,12 +33,18 @@ func NewAcceptPeginAuthenticatedQuoteHandler(useCase AcceptQuoteUseCase) http.Ha
 
 		acceptRequest.Signature = strings.TrimPrefix(acceptRequest.Signature, "0x")
 
+		if err = validateQuoteCallback(w, acceptRequest.CallbackUrl); err != nil {
+			return
+		}
+
 		acceptedQuote, err := useCase.Run(req.Context(), acceptRequest.QuoteHash, acceptRequest.Signature)
 		if err != nil {
 			HandleAcceptQuoteError(w, err)
 			return
 		}
 
+		registerQuoteCallback(req.Context(), registerCallbackUseCase, acceptedQuote, acceptRequest.QuoteHash, acceptRequest.CallbackUrl)
+
 		response := pkg.AcceptPeginRespose{
 			Signature:                 acceptedQuote.Signature,
 			BitcoinDepositAddressHash: acceptedQuote.DepositAddress,
@@ -42,7 +42,7 @@ func TestAcceptPeginAuthenticatedQuoteHandler(t *testing.T) {
 	mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 	mockUseCase.On("Run", mock.Anything, quoteHash, signature).Return(acceptedQuote, nil)
 
-	handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase)
+	handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 	handler := http.HandlerFunc(handlerFunc)
 
 	handler.ServeHTTP(recorder, request)
@@ -69,7 +69,7 @@ func TestAcceptPeginAuthenticatedQuoteHandlerValidationErrors(t *testing.T) {
 
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 
-		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -102,7 +102,7 @@ func TestAcceptPeginAuthenticatedQuoteHandlerValidationErrors(t *testing.T) {
 
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 
-		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -136,7 +136,7 @@ func TestAcceptPeginAuthenticatedQuoteHandlerErrorCases(t *testing.T) {
 
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 
-		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -170,7 +170,7 @@ func TestAcceptPeginAuthenticatedQuoteHandlerErrorCases(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, signature).Return(quote.AcceptedQuote{}, usecases.QuoteNotFoundError)
 
-		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -204,7 +204,7 @@ func TestAcceptPeginAuthenticatedQuoteHandlerErrorCases(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, signature).Return(quote.AcceptedQuote{}, usecases.ExpiredQuoteError)
 
-		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -238,7 +238,7 @@ func TestAcceptPeginAuthenticatedQuoteHandlerErrorCases(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, signature).Return(quote.AcceptedQuote{}, usecases.NoLiquidityError)
 
-		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -272,7 +272,7 @@ func TestAcceptPeginAuthenticatedQuoteHandlerErrorCases(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, signature).Return(quote.AcceptedQuote{}, usecases.LockingCapExceededError)
 
-		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -306,7 +306,7 @@ func TestAcceptPeginAuthenticatedQuoteHandlerErrorCases(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, signature).Return(quote.AcceptedQuote{}, liquidity_provider.TamperedTrustedAccountError)
 
-		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -341,7 +341,7 @@ func TestAcceptPeginAuthenticatedQuoteHandlerErrorCases(t *testing.T) {
 		unexpectedError := errors.New("unexpected database error")
 		mockUseCase.On("Run", mock.Anything, quoteHash, signature).Return(quote.AcceptedQuote{}, unexpectedError)
 
-		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -373,7 +373,7 @@ func TestAcceptPeginAuthenticatedQuoteHandlerErrorCases(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.EXPECT().Run(mock.Anything, mock.Anything, mock.Anything).Return(quote.AcceptedQuote{}, blockchain.ContractPausedError)
 
-		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handlerFunc(recorder, request)
 
 		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
@@ -414,7 +414,7 @@ func TestAcceptPeginAuthenticatedQuoteHandler_SignatureProcessing(t *testing.T)
 		// Verify that the use case receives the signature WITHOUT the "0x" prefix
 		mockUseCase.On("Run", mock.Anything, quoteHash, signatureWithoutPrefix).Return(acceptedQuote, nil)
 
-		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -454,7 +454,7 @@ func TestAcceptPeginAuthenticatedQuoteHandler_SignatureProcessing(t *testing.T)
 		// Verify that the use case receives the signature unchanged
 		mockUseCase.On("Run", mock.Anything, quoteHash, signature).Return(acceptedQuote, nil)
 
-		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
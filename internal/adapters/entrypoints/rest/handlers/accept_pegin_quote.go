@@ -22,7 +22,7 @@ type AcceptQuoteUseCase interface {
 // @Param QuoteHash body pkg.AcceptQuoteRequest true "Quote Hash"
 // @Success 200  object pkg.AcceptPeginRespose Interface that represents that the quote has been successfully accepted
 // @Route /pegin/acceptQuote [post]
-func NewAcceptPeginQuoteHandler(useCase AcceptQuoteUseCase) http.HandlerFunc {
+func NewAcceptPeginQuoteHandler(useCase AcceptQuoteUseCase, registerCallbackUseCase RegisterQuoteCallbackUseCase) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
 		var err error
 		acceptRequest := pkg.AcceptQuoteRequest{}
@@ -38,6 +38,10 @@ func NewAcceptPeginQuoteHandler(useCase AcceptQuoteUseCase) http.HandlerFunc {
 			return
 		}
 
+		if err = validateQuoteCallback(w, acceptRequest.CallbackUrl); err != nil {
+			return
+		}
+
 		acceptedQuote, err := useCase.Run(req.Context(), acceptRequest.QuoteHash, "")
 		if errors.Is(err, usecases.QuoteNotFoundError) {
 			jsonErr := rest.NewErrorResponseWithDetails("quote not found", rest.DetailsFromError(err), true)
@@ -61,6 +65,8 @@ func NewAcceptPeginQuoteHandler(useCase AcceptQuoteUseCase) http.HandlerFunc {
 			return
 		}
 
+		registerQuoteCallback(req.Context(), registerCallbackUseCase, acceptedQuote, acceptRequest.QuoteHash, acceptRequest.CallbackUrl)
+
 		response := pkg.AcceptPeginRespose{
 			Signature:                 acceptedQuote.Signature,
 			BitcoinDepositAddressHash: acceptedQuote.DepositAddress,
@@ -2,6 +2,7 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
 	"github.com/rsksmart/liquidity-provider-server/pkg"
 	"github.com/rsksmart/liquidity-provider-server/test/mocks"
@@ -39,7 +41,7 @@ func TestAcceptPeginQuoteHandlerHappyPath(t *testing.T) {
 	mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 	mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(acceptedQuote, nil)
 
-	handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase)
+	handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 	handler := http.HandlerFunc(handlerFunc)
 
 	handler.ServeHTTP(recorder, request)
@@ -68,7 +70,7 @@ func TestAcceptPeginQuoteHandlerErrorCases(t *testing.T) {
 
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 
-		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -100,7 +102,7 @@ func TestAcceptPeginQuoteHandlerErrorCases(t *testing.T) {
 
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 
-		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -129,7 +131,7 @@ func TestAcceptPeginQuoteHandlerErrorCases(t *testing.T) {
 
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 
-		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -161,7 +163,7 @@ func TestAcceptPeginQuoteHandlerErrorCases(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(quote.AcceptedQuote{}, usecases.QuoteNotFoundError)
 
-		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -193,7 +195,7 @@ func TestAcceptPeginQuoteHandlerErrorCases(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(quote.AcceptedQuote{}, usecases.ExpiredQuoteError)
 
-		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -225,7 +227,7 @@ func TestAcceptPeginQuoteHandlerErrorCases(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(quote.AcceptedQuote{}, usecases.NoLiquidityError)
 
-		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -262,7 +264,7 @@ func TestAcceptPeginQuoteHandlerErrorCases(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(retainedQuote, nil)
 
-		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -295,7 +297,7 @@ func TestAcceptPeginQuoteHandlerErrorCases(t *testing.T) {
 		unexpectedError := errors.New("unexpected database error")
 		mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(quote.AcceptedQuote{}, unexpectedError)
 
-		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -323,7 +325,7 @@ func TestAcceptPeginQuoteHandlerErrorCases(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.EXPECT().Run(mock.Anything, mock.Anything, mock.Anything).Return(quote.AcceptedQuote{}, blockchain.ContractPausedError)
 
-		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPeginQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handlerFunc(recorder, request)
 
 		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
@@ -338,3 +340,75 @@ func TestAcceptPeginQuoteHandlerErrorCases(t *testing.T) {
 	})
 
 }
+
+func TestAcceptPeginQuoteHandlerCallbackUrl(t *testing.T) {
+	const quoteHash = "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+	const callbackUrl = "https://integrator.example.com/callback"
+	newRequest := func(t *testing.T, body pkg.AcceptQuoteRequest) *http.Request {
+		jsonBody, err := json.Marshal(body)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "/pegin/acceptQuote", bytes.NewBuffer(jsonBody))
+		request.Header.Set("Content-Type", "application/json")
+		return request
+	}
+	t.Run("should register the callback after accepting the quote", func(t *testing.T) {
+		accepted := false
+		registerUseCase := &mocks.RegisterQuoteCallbackUseCaseMock{}
+		registerUseCase.EXPECT().RunCallback(mock.Anything, callbackUrl, quoteHash).
+			Return(webhook.Webhook{Id: "1"}, nil).Run(func(ctx context.Context, url, hash string) { assert.True(t, accepted) }).Once()
+		acceptUseCase := new(mocks.AcceptQuoteUseCaseMock)
+		acceptUseCase.On("Run", mock.Anything, quoteHash, "").
+			Return(quote.AcceptedQuote{Signature: "signature", Created: true}, nil).Run(func(args mock.Arguments) { accepted = true }).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewAcceptPeginQuoteHandler(acceptUseCase, registerUseCase).
+			ServeHTTP(recorder, newRequest(t, pkg.AcceptQuoteRequest{QuoteHash: quoteHash, CallbackUrl: callbackUrl}))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		registerUseCase.AssertExpectations(t)
+		acceptUseCase.AssertExpectations(t)
+	})
+	t.Run("should not register the callback if the quote was accepted by a previous request", func(t *testing.T) {
+		registerUseCase := &mocks.RegisterQuoteCallbackUseCaseMock{}
+		acceptUseCase := new(mocks.AcceptQuoteUseCaseMock)
+		acceptUseCase.On("Run", mock.Anything, quoteHash, "").Return(quote.AcceptedQuote{Signature: "signature"}, nil).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewAcceptPeginQuoteHandler(acceptUseCase, registerUseCase).
+			ServeHTTP(recorder, newRequest(t, pkg.AcceptQuoteRequest{QuoteHash: quoteHash, CallbackUrl: callbackUrl}))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		registerUseCase.AssertNotCalled(t, "RunCallback")
+		acceptUseCase.AssertExpectations(t)
+	})
+	t.Run("should not register the callback if the quote can't be accepted", func(t *testing.T) {
+		registerUseCase := &mocks.RegisterQuoteCallbackUseCaseMock{}
+		acceptUseCase := new(mocks.AcceptQuoteUseCaseMock)
+		acceptUseCase.On("Run", mock.Anything, quoteHash, "").Return(quote.AcceptedQuote{}, usecases.QuoteNotFoundError).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewAcceptPeginQuoteHandler(acceptUseCase, registerUseCase).
+			ServeHTTP(recorder, newRequest(t, pkg.AcceptQuoteRequest{QuoteHash: quoteHash, CallbackUrl: callbackUrl}))
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		registerUseCase.AssertNotCalled(t, "RunCallback")
+	})
+	t.Run("should keep the quote accepted when the callback registration fails", func(t *testing.T) {
+		registerUseCase := &mocks.RegisterQuoteCallbackUseCaseMock{}
+		registerUseCase.EXPECT().RunCallback(mock.Anything, callbackUrl, quoteHash).Return(webhook.Webhook{}, assert.AnError).Once()
+		acceptUseCase := new(mocks.AcceptQuoteUseCaseMock)
+		acceptUseCase.On("Run", mock.Anything, quoteHash, "").Return(quote.AcceptedQuote{Signature: "signature", Created: true}, nil).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewAcceptPeginQuoteHandler(acceptUseCase, registerUseCase).
+			ServeHTTP(recorder, newRequest(t, pkg.AcceptQuoteRequest{QuoteHash: quoteHash, CallbackUrl: callbackUrl}))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		registerUseCase.AssertExpectations(t)
+		acceptUseCase.AssertExpectations(t)
+	})
+	t.Run("should return 400 on invalid callback url", func(t *testing.T) {
+		for _, invalidUrl := range []string{"invalid", "http://integrator.example.com/callback", "https://localhost/callback", "https://10.0.0.1/callback"} {
+			registerUseCase := &mocks.RegisterQuoteCallbackUseCaseMock{}
+			acceptUseCase := new(mocks.AcceptQuoteUseCaseMock)
+			recorder := httptest.NewRecorder()
+			handlers.NewAcceptPeginQuoteHandler(acceptUseCase, registerUseCase).
+				ServeHTTP(recorder, newRequest(t, pkg.AcceptQuoteRequest{QuoteHash: quoteHash, CallbackUrl: invalidUrl}))
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			registerUseCase.AssertNotCalled(t, "RunCallback")
+			acceptUseCase.AssertNotCalled(t, "Run")
+		}
+	})
+}
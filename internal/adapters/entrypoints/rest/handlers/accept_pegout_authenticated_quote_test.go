@@ -2,6 +2,7 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
@@ -12,6 +13,7 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
 	"github.com/rsksmart/liquidity-provider-server/pkg"
 	"github.com/rsksmart/liquidity-provider-server/test/mocks"
@@ -42,7 +44,7 @@ func TestAcceptPegoutAuthenticatedQuoteHandler_Success(t *testing.T) {
 	mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 	mockUseCase.On("Run", mock.Anything, quoteHash, signature).Return(acceptedQuote, nil)
 
-	handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase)
+	handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 	handler := http.HandlerFunc(handlerFunc)
 
 	handler.ServeHTTP(recorder, request)
@@ -70,7 +72,7 @@ func TestAcceptPegoutAuthenticatedQuoteHandler_RequestErrors(t *testing.T) {
 
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 
-		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -103,7 +105,7 @@ func TestAcceptPegoutAuthenticatedQuoteHandler_RequestErrors(t *testing.T) {
 
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 
-		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -134,7 +136,7 @@ func TestAcceptPegoutAuthenticatedQuoteHandler_RequestErrors(t *testing.T) {
 
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 
-		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -168,7 +170,7 @@ func TestAcceptPegoutAuthenticatedQuoteHandler_QuoteHashValidation(t *testing.T)
 
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 
-		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -198,7 +200,7 @@ func TestAcceptPegoutAuthenticatedQuoteHandler_QuoteHashValidation(t *testing.T)
 
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 
-		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -230,7 +232,7 @@ func TestAcceptPegoutAuthenticatedQuoteHandler_QuoteHashValidation(t *testing.T)
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.EXPECT().Run(mock.Anything, mock.Anything, mock.Anything).Return(quote.AcceptedQuote{}, blockchain.ContractPausedError)
 
-		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handlerFunc(recorder, request)
 
 		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
@@ -310,7 +312,7 @@ func TestAcceptPegoutAuthenticatedQuoteHandler_UseCaseErrors(t *testing.T) {
 			mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 			mockUseCase.On("Run", mock.Anything, quoteHash, signature).Return(quote.AcceptedQuote{}, tc.useCaseError)
 
-			handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase)
+			handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 			handler := http.HandlerFunc(handlerFunc)
 
 			handler.ServeHTTP(recorder, request)
@@ -355,7 +357,7 @@ func TestAcceptPegoutAuthenticatedQuoteHandler_SignatureProcessing(t *testing.T)
 		// Verify that the use case receives the signature WITHOUT the "0x" prefix
 		mockUseCase.On("Run", mock.Anything, quoteHash, signatureWithoutPrefix).Return(acceptedQuote, nil)
 
-		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -395,7 +397,7 @@ func TestAcceptPegoutAuthenticatedQuoteHandler_SignatureProcessing(t *testing.T)
 		// Verify that the use case receives the signature unchanged
 		mockUseCase.On("Run", mock.Anything, quoteHash, signature).Return(acceptedQuote, nil)
 
-		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutAuthenticatedQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -412,3 +414,70 @@ func TestAcceptPegoutAuthenticatedQuoteHandler_SignatureProcessing(t *testing.T)
 		mockUseCase.AssertExpectations(t)
 	})
 }
+
+func TestAcceptPegoutAuthenticatedQuoteHandler_CallbackUrl(t *testing.T) {
+	const quoteHash = "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+	const callbackUrl = "https://integrator.example.com/callback"
+	newRequest := func(t *testing.T, callbackUrl string) *http.Request {
+		reqBody := pkg.AcceptAuthenticatedQuoteRequest{QuoteHash: quoteHash, Signature: "0xsignature", CallbackUrl: callbackUrl}
+		jsonBody, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "/pegout/acceptAuthenticatedQuote", bytes.NewBuffer(jsonBody))
+		request.Header.Set("Content-Type", "application/json")
+		return request
+	}
+	t.Run("should accept the quote and register the callback", func(t *testing.T) {
+		accepted := false
+		registerUseCase := &mocks.RegisterQuoteCallbackUseCaseMock{}
+		registerUseCase.EXPECT().RunCallback(mock.Anything, callbackUrl, quoteHash).
+			Return(webhook.Webhook{Id: "1"}, nil).Run(func(ctx context.Context, url, hash string) { assert.True(t, accepted) }).Once()
+		acceptUseCase := new(mocks.AcceptQuoteUseCaseMock)
+		acceptUseCase.On("Run", mock.Anything, quoteHash, "signature").
+			Return(quote.AcceptedQuote{Signature: "signed", Created: true}, nil).Run(func(args mock.Arguments) { accepted = true }).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewAcceptPegoutAuthenticatedQuoteHandler(acceptUseCase, registerUseCase).ServeHTTP(recorder, newRequest(t, callbackUrl))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		registerUseCase.AssertExpectations(t)
+		acceptUseCase.AssertExpectations(t)
+	})
+	t.Run("should not register the callback if the quote was already accepted", func(t *testing.T) {
+		registerUseCase := &mocks.RegisterQuoteCallbackUseCaseMock{}
+		acceptUseCase := new(mocks.AcceptQuoteUseCaseMock)
+		acceptUseCase.On("Run", mock.Anything, quoteHash, "signature").Return(quote.AcceptedQuote{Signature: "signed"}, nil).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewAcceptPegoutAuthenticatedQuoteHandler(acceptUseCase, registerUseCase).ServeHTTP(recorder, newRequest(t, callbackUrl))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		registerUseCase.AssertNotCalled(t, "RunCallback")
+		acceptUseCase.AssertExpectations(t)
+	})
+	t.Run("should not register the callback if the accept fails", func(t *testing.T) {
+		registerUseCase := &mocks.RegisterQuoteCallbackUseCaseMock{}
+		acceptUseCase := new(mocks.AcceptQuoteUseCaseMock)
+		acceptUseCase.On("Run", mock.Anything, quoteHash, "signature").Return(quote.AcceptedQuote{}, usecases.ExpiredQuoteError).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewAcceptPegoutAuthenticatedQuoteHandler(acceptUseCase, registerUseCase).ServeHTTP(recorder, newRequest(t, callbackUrl))
+		assert.Equal(t, http.StatusGone, recorder.Code)
+		registerUseCase.AssertNotCalled(t, "RunCallback")
+	})
+	t.Run("should keep the quote accepted when the callback registration fails", func(t *testing.T) {
+		registerUseCase := &mocks.RegisterQuoteCallbackUseCaseMock{}
+		registerUseCase.EXPECT().RunCallback(mock.Anything, callbackUrl, quoteHash).Return(webhook.Webhook{}, assert.AnError).Once()
+		acceptUseCase := new(mocks.AcceptQuoteUseCaseMock)
+		acceptUseCase.On("Run", mock.Anything, quoteHash, "signature").Return(quote.AcceptedQuote{Signature: "signed", Created: true}, nil).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewAcceptPegoutAuthenticatedQuoteHandler(acceptUseCase, registerUseCase).ServeHTTP(recorder, newRequest(t, callbackUrl))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		registerUseCase.AssertExpectations(t)
+	})
+	t.Run("should reject non public callback urls before accepting the quote", func(t *testing.T) {
+		for _, invalidUrl := range []string{"http://integrator.example.com/callback", "https://127.0.0.1/callback", "https://169.254.169.254/latest"} {
+			registerUseCase := &mocks.RegisterQuoteCallbackUseCaseMock{}
+			acceptUseCase := new(mocks.AcceptQuoteUseCaseMock)
+			recorder := httptest.NewRecorder()
+			handlers.NewAcceptPegoutAuthenticatedQuoteHandler(acceptUseCase, registerUseCase).ServeHTTP(recorder, newRequest(t, invalidUrl))
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			acceptUseCase.AssertNotCalled(t, "Run")
+			registerUseCase.AssertNotCalled(t, "RunCallback")
+		}
+	})
+}
@@ -22,7 +22,7 @@ type AcceptPegoutQuoteUseCase interface {
 // @Param QuoteHash body pkg.AcceptQuoteRequest true "Quote Hash"
 // @Success 200 object pkg.AcceptPegoutResponse
 // @Route /pegout/acceptQuote [post]
-func NewAcceptPegoutQuoteHandler(useCase AcceptPegoutQuoteUseCase) http.HandlerFunc {
+func NewAcceptPegoutQuoteHandler(useCase AcceptPegoutQuoteUseCase, registerCallbackUseCase RegisterQuoteCallbackUseCase) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
 		var err error
 		acceptRequest := pkg.AcceptQuoteRequest{}
@@ -38,6 +38,10 @@ func NewAcceptPegoutQuoteHandler(useCase AcceptPegoutQuoteUseCase) http.HandlerF
 			return
 		}
 
+		if err = validateQuoteCallback(w, acceptRequest.CallbackUrl); err != nil {
+			return
+		}
+
 		acceptedQuote, err := useCase.Run(req.Context(), acceptRequest.QuoteHash, "")
 		if errors.Is(err, usecases.QuoteNotFoundError) {
 			jsonErr := rest.NewErrorResponseWithDetails("invalid quote hash", rest.DetailsFromError(err), true)
@@ -61,6 +65,8 @@ func NewAcceptPegoutQuoteHandler(useCase AcceptPegoutQuoteUseCase) http.HandlerF
 			return
 		}
 
+		registerQuoteCallback(req.Context(), registerCallbackUseCase, acceptedQuote, acceptRequest.QuoteHash, acceptRequest.CallbackUrl)
+
 		response := pkg.AcceptPegoutResponse{
 			Signature:  acceptedQuote.Signature,
 			LbcAddress: acceptedQuote.DepositAddress,
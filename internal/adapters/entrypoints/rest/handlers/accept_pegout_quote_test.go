@@ -38,7 +38,7 @@ func TestAcceptPegoutQuoteHandlerHappyPath(t *testing.T) {
 	mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 	mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(acceptedQuote, nil)
 
-	handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase)
+	handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 	handler := http.HandlerFunc(handlerFunc)
 
 	handler.ServeHTTP(recorder, request)
@@ -67,7 +67,7 @@ func TestAcceptPegoutQuoteHandlerErrorCases(t *testing.T) {
 
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 
-		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -98,7 +98,7 @@ func TestAcceptPegoutQuoteHandlerErrorCases(t *testing.T) {
 
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 
-		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -127,7 +127,7 @@ func TestAcceptPegoutQuoteHandlerErrorCases(t *testing.T) {
 
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 
-		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -157,7 +157,7 @@ func TestAcceptPegoutQuoteHandlerErrorCases(t *testing.T) {
 
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 
-		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -189,7 +189,7 @@ func TestAcceptPegoutQuoteHandlerErrorCases(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(quote.AcceptedQuote{}, usecases.QuoteNotFoundError)
 
-		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -221,7 +221,7 @@ func TestAcceptPegoutQuoteHandlerErrorCases(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(quote.AcceptedQuote{}, usecases.ExpiredQuoteError)
 
-		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -253,7 +253,7 @@ func TestAcceptPegoutQuoteHandlerErrorCases(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(quote.AcceptedQuote{}, usecases.NoLiquidityError)
 
-		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -290,7 +290,7 @@ func TestAcceptPegoutQuoteHandlerErrorCases(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(acceptedQuote, nil)
 
-		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -323,7 +323,7 @@ func TestAcceptPegoutQuoteHandlerErrorCases(t *testing.T) {
 		unexpectedError := errors.New("unexpected database error")
 		mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(quote.AcceptedQuote{}, unexpectedError)
 
-		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -359,7 +359,7 @@ func TestAcceptPegoutQuoteHandlerErrorResponseFormat(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(quote.AcceptedQuote{}, usecases.QuoteNotFoundError)
 
-		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -384,7 +384,7 @@ func TestAcceptPegoutQuoteHandlerErrorResponseFormat(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(quote.AcceptedQuote{}, usecases.QuoteNotFoundError)
 
-		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -413,7 +413,7 @@ func TestAcceptPegoutQuoteHandlerErrorResponseFormat(t *testing.T) {
 		mockUseCase := new(mocks.AcceptQuoteUseCaseMock)
 		mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(quote.AcceptedQuote{}, usecases.ExpiredQuoteError)
 
-		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -443,7 +443,7 @@ func TestAcceptPegoutQuoteHandlerErrorResponseFormat(t *testing.T) {
 		unexpectedError := errors.New("unexpected error")
 		mockUseCase.On("Run", mock.Anything, quoteHash, "").Return(quote.AcceptedQuote{}, unexpectedError)
 
-		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase)
+		handlerFunc := handlers.NewAcceptPegoutQuoteHandler(mockUseCase, &mocks.RegisterQuoteCallbackUseCaseMock{})
 		handler := http.HandlerFunc(handlerFunc)
 
 		handler.ServeHTTP(recorder, request)
@@ -5,7 +5,6 @@ import (
 	"net/http"
 
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
-	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	lp "github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
 	lpuc "github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/pkg"
@@ -31,14 +30,13 @@ func NewAddTrustedAccountHandler(useCase *lpuc.AddTrustedAccountUseCase) http.Ha
 		} else if err = rest.ValidateRequest(w, request); err != nil {
 			return
 		}
-		accountDetails := lp.TrustedAccountDetails{
-			Address:        request.Address,
-			Name:           request.Name,
-			BtcLockingCap:  entities.NewBigWei(request.BtcLockingCap),
-			RbtcLockingCap: entities.NewBigWei(request.RbtcLockingCap),
-		}
+		accountDetails := pkg.FromTrustedAccountRequest(*request)
 		err = useCase.Run(req.Context(), accountDetails)
-		if errors.Is(err, lp.DuplicateTrustedAccountError) {
+		if errors.Is(err, lp.InvalidPartnerTermsError) {
+			jsonErr := rest.NewErrorResponseWithDetails("Validation error", rest.DetailsFromError(err), true)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		} else if errors.Is(err, lp.DuplicateTrustedAccountError) {
 			jsonErr := rest.NewErrorResponse(lp.DuplicateTrustedAccountError.Error(), true)
 			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
 			return
@@ -14,6 +14,7 @@ import (
 
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	lp "github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
 	lpuc "github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/pkg"
@@ -104,6 +105,53 @@ func TestNewAddTrustedAccountHandler(t *testing.T) {
 		signer.AssertExpectations(t)
 		hashMock.AssertExpectations(t)
 	})
+	t.Run("should save the partner terms", func(t *testing.T) {
+		feePercentage := 0.1
+		reqBody := pkg.TrustedAccountRequest{
+			Address:        "0x7C4890A0f1D4bBf2C669Ac2d1efFa185c505359b",
+			Name:           "Test Account",
+			BtcLockingCap:  big.NewInt(1000),
+			RbtcLockingCap: big.NewInt(2000),
+			PeginTerms:     &pkg.PartnerTermsDTO{FeePercentage: &feePercentage, MaxValue: big.NewInt(500)},
+			PegoutTerms:    &pkg.PartnerTermsDTO{FixedFee: big.NewInt(0), QuoteValidity: 3600},
+		}
+		jsonBody, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest("POST", "/management/trusted-accounts", bytes.NewBuffer(jsonBody))
+		repo := &mocks.TrustedAccountRepositoryMock{}
+		signer := &mocks.TransactionSignerMock{}
+		hashMock := &mocks.HashMock{}
+		hashMock.On("Hash", mock.Anything).Return([]byte{1, 2, 3, 4})
+		signer.On("SignBytes", mock.Anything).Return([]byte{4, 3, 2, 1}, nil)
+		repo.On("AddTrustedAccount", mock.Anything, mock.MatchedBy(func(account entities.Signed[lp.TrustedAccountDetails]) bool {
+			return assert.Equal(t, pkg.FromTrustedAccountRequest(reqBody), account.Value)
+		})).Return(nil)
+		useCase := lpuc.NewAddTrustedAccountUseCase(repo, signer, hashMock.Hash)
+		handler := http.HandlerFunc(handlers.NewAddTrustedAccountHandler(useCase))
+		handler.ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+		repo.AssertExpectations(t)
+	})
+	t.Run("should return 400 on invalid partner terms", func(t *testing.T) {
+		reqBody := pkg.TrustedAccountRequest{
+			Address:        "0x7C4890A0f1D4bBf2C669Ac2d1efFa185c505359b",
+			Name:           "Test Account",
+			BtcLockingCap:  big.NewInt(1000),
+			RbtcLockingCap: big.NewInt(2000),
+			PeginTerms:     &pkg.PartnerTermsDTO{MinValue: big.NewInt(10), MaxValue: big.NewInt(5)},
+		}
+		jsonBody, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest("POST", "/management/trusted-accounts", bytes.NewBuffer(jsonBody))
+		repo := &mocks.TrustedAccountRepositoryMock{}
+		useCase := lpuc.NewAddTrustedAccountUseCase(repo, &mocks.TransactionSignerMock{}, (&mocks.HashMock{}).Hash)
+		handler := http.HandlerFunc(handlers.NewAddTrustedAccountHandler(useCase))
+		handler.ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		repo.AssertNotCalled(t, "AddTrustedAccount")
+	})
 }
 
 // Name validation test cases
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+)
+
+type RegisterWebhookUseCase interface {
+	Run(ctx context.Context, url, quoteHash, secret string) (webhook.Webhook, error)
+}
+
+// NewAddWebhookHandler
+// @Title Add Webhook
+// @Description Registers a global webhook that is notified about the state transitions of every quote
+// @Param WebhookRequest body pkg.WebhookRequest true "Webhook URL and optional HMAC secret"
+// @Success 201 object pkg.WebhookDTO
+// @Route /management/webhooks [post]
+func NewAddWebhookHandler(useCase RegisterWebhookUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var err error
+		request := &pkg.WebhookRequest{}
+		if err = rest.DecodeRequest(w, req, request); err != nil {
+			return
+		} else if err = rest.ValidateRequest(w, request); err != nil {
+			return
+		}
+		hook, err := useCase.Run(req.Context(), request.Url, "", request.Secret)
+		if errors.Is(err, webhook.DuplicateWebhookError) {
+			jsonErr := rest.NewErrorResponse(webhook.DuplicateWebhookError.Error(), true)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		} else if err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails(UnknownErrorMessage, rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+			return
+		}
+		response := pkg.ToWebhookDTO(hook)
+		rest.JsonResponseWithBody(w, http.StatusCreated, &response)
+	}
+}
@@ -0,0 +1,75 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAddWebhookHandler(t *testing.T) {
+	const url = "https://integrator.example.com/callback"
+	newRequest := func(t *testing.T, body pkg.WebhookRequest) *http.Request {
+		jsonBody, err := json.Marshal(body)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "/management/webhooks", bytes.NewBuffer(jsonBody))
+		request.Header.Set("Content-Type", "application/json")
+		return request
+	}
+	t.Run("should return 201 with the registered webhook", func(t *testing.T) {
+		hook := webhook.Webhook{Id: "0a1b", Url: url, Secret: "0123456789abcdef", CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+		useCase := &mocks.RegisterWebhookUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything, url, "", "0123456789abcdef").Return(hook, nil).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewAddWebhookHandler(useCase).ServeHTTP(recorder, newRequest(t, pkg.WebhookRequest{Url: url, Secret: "0123456789abcdef"}))
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+		assert.NotContains(t, recorder.Body.String(), "0123456789abcdef")
+		var response pkg.WebhookDTO
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		assert.Equal(t, pkg.WebhookDTO{Id: "0a1b", Url: url, SignatureType: "hmac-sha256", CreatedAt: hook.CreatedAt}, response)
+		useCase.AssertExpectations(t)
+	})
+	t.Run("should return 400 on invalid request", func(t *testing.T) {
+		cases := []pkg.WebhookRequest{
+			{},
+			{Url: "not an url"},
+			{Url: "ftp://integrator.example.com"},
+			{Url: url, Secret: "short"},
+		}
+		for _, body := range cases {
+			useCase := &mocks.RegisterWebhookUseCaseMock{}
+			recorder := httptest.NewRecorder()
+			handlers.NewAddWebhookHandler(useCase).ServeHTTP(recorder, newRequest(t, body))
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			useCase.AssertNotCalled(t, "Run")
+		}
+	})
+	t.Run("should return 400 when the webhook is already registered", func(t *testing.T) {
+		useCase := &mocks.RegisterWebhookUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything, url, "", "").
+			Return(webhook.Webhook{}, usecases.WrapUseCaseError(usecases.RegisterWebhookId, webhook.DuplicateWebhookError)).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewAddWebhookHandler(useCase).ServeHTTP(recorder, newRequest(t, pkg.WebhookRequest{Url: url}))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		useCase.AssertExpectations(t)
+	})
+	t.Run("should return 500 on unexpected error", func(t *testing.T) {
+		useCase := &mocks.RegisterWebhookUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything, url, "", "").Return(webhook.Webhook{}, assert.AnError).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewAddWebhookHandler(useCase).ServeHTTP(recorder, newRequest(t, pkg.WebhookRequest{Url: url}))
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		useCase.AssertExpectations(t)
+	})
+}
@@ -1,17 +1,33 @@
 package handlers
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	log "github.com/sirupsen/logrus"
 )
 
 const UnknownErrorMessage = "unknown error"
 
+const (
+	PartnerSignatureHeader = "X-Partner-Signature"
+	PartnerTimestampHeader = "X-Partner-Timestamp"
+)
+
+// MaxQuoteRequestBodySize is the maximum size of the body of the quote requests that are read before being decoded
+const MaxQuoteRequestBodySize = 1 << 20
+
 func HandleAcceptQuoteError(w http.ResponseWriter, err error) {
 	switch {
 	case errors.Is(err, usecases.QuoteNotFoundError):
@@ -37,3 +53,142 @@ func HandleAcceptQuoteError(w http.ResponseWriter, err error) {
 		rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
 	}
 }
+
+type RegisterQuoteCallbackUseCase interface {
+	RunCallback(ctx context.Context, url, quoteHash string) (webhook.Webhook, error)
+}
+
+// validateQuoteCallback rejects the accept requests with a callback URL that can't be registered before
+// accepting the quote
+func validateQuoteCallback(w http.ResponseWriter, callbackUrl string) error {
+	if callbackUrl == "" {
+		return nil
+	}
+	if err := webhook.ValidateCallbackUrl(callbackUrl); err != nil {
+		jsonErr := rest.NewErrorResponseWithDetails("invalid callback url", rest.DetailsFromError(err), true)
+		rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+		return err
+	}
+	return nil
+}
+
+// registerQuoteCallback registers the callback URL of an accept request as a webhook of the quote. It is only
+// done by the request that accepted the quote, so other users can't register callbacks for a quote they know
+// the hash of. A registration error doesn't fail the request since the quote is already accepted
+func registerQuoteCallback(ctx context.Context, useCase RegisterQuoteCallbackUseCase, acceptedQuote quote.AcceptedQuote, quoteHash, callbackUrl string) {
+	if callbackUrl == "" || !acceptedQuote.Created {
+		return
+	}
+	if _, err := useCase.RunCallback(ctx, callbackUrl, quoteHash); err != nil {
+		log.Errorf("Error registering callback url of quote %s: %v", quoteHash, err)
+	}
+}
+
+// readPartnerAuthentication reads the headers that a trusted account sends to get a quote with its own terms. The
+// request body is part of the signed payload, so it is read and then restored to be decoded by the handler. If the
+// request doesn't have a partner signature nil is returned.
+func readPartnerAuthentication(w http.ResponseWriter, req *http.Request) (*usecases.PartnerAuthentication, error) {
+	signature := req.Header.Get(PartnerSignatureHeader)
+	if signature == "" {
+		return nil, nil
+	}
+	timestamp, err := strconv.ParseInt(req.Header.Get(PartnerTimestampHeader), 10, 64)
+	if err != nil {
+		jsonErr := rest.NewErrorResponse("invalid "+PartnerTimestampHeader+" header", true)
+		rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+		return nil, err
+	}
+	payload, err := readQuoteRequestBody(w, req)
+	if err != nil {
+		return nil, err
+	}
+	return &usecases.PartnerAuthentication{Signature: signature, Timestamp: timestamp, Payload: payload}, nil
+}
+
+// readQuoteRequestBody reads the whole body of a quote request up to MaxQuoteRequestBodySize and restores it so it
+// can be decoded by the handler
+func readQuoteRequestBody(w http.ResponseWriter, req *http.Request) ([]byte, error) {
+	payload, err := io.ReadAll(http.MaxBytesReader(w, req.Body, MaxQuoteRequestBodySize))
+	var maxBytesError *http.MaxBytesError
+	if errors.As(err, &maxBytesError) {
+		details := rest.ErrorDetails{"maxSize": maxBytesError.Limit}
+		rest.JsonErrorResponse(w, http.StatusRequestEntityTooLarge, rest.NewErrorResponseWithDetails("request body too large", details, true))
+		return nil, err
+	} else if err != nil {
+		rest.DecodeRequestError(w, err)
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(payload))
+	return payload, nil
+}
+
+// handlePartnerAuthenticationError writes the response for the errors related to the partner authentication of a
+// quote request. Returns false if the error is not related to it.
+func handlePartnerAuthenticationError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, usecases.InvalidPartnerAuthError):
+		jsonErr := rest.NewErrorResponseWithDetails("invalid partner authentication", rest.DetailsFromError(err), true)
+		rest.JsonErrorResponse(w, http.StatusUnauthorized, jsonErr)
+	case errors.Is(err, liquidity_provider.TamperedTrustedAccountError):
+		jsonErr := rest.NewErrorResponseWithDetails("error fetching trusted account", rest.DetailsFromError(err), true)
+		rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+	default:
+		return false
+	}
+	return true
+}
+
+// isQuoteBatchRequest checks if the body of a quote request is a JSON array, in that case the request contains a batch
+// of quote requests. The body is restored so it can be decoded by the handler.
+func isQuoteBatchRequest(w http.ResponseWriter, req *http.Request) (bool, error) {
+	payload, err := readQuoteRequestBody(w, req)
+	if err != nil {
+		return false, err
+	}
+	trimmed := bytes.TrimLeft(payload, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '[', nil
+}
+
+// validateQuoteBatchSize writes the error response if the amount of requests of a quote batch is not between
+// one and the maximum size supported by the LPS.
+func validateQuoteBatchSize(w http.ResponseWriter, size int, maxSize uint64) error {
+	if size > 0 && uint64(size) <= maxSize {
+		return nil
+	}
+	details := rest.ErrorDetails{"size": size, "maxSize": maxSize}
+	rest.JsonErrorResponse(w, http.StatusBadRequest, rest.NewErrorResponseWithDetails("invalid batch size", details, true))
+	return usecases.QuoteBatchSizeError
+}
+
+// handleQuoteBatchError writes the response for the errors that make the whole quote batch fail
+func handleQuoteBatchError(w http.ResponseWriter, err error) {
+	switch {
+	case handlePartnerAuthenticationError(w, err):
+		return
+	case errors.Is(err, usecases.QuoteBatchSizeError):
+		jsonErr := rest.NewErrorResponseWithDetails("invalid batch size", rest.DetailsFromError(err), true)
+		rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+	case errors.Is(err, blockchain.ContractPausedError):
+		jsonErr := rest.NewErrorResponseWithDetails("protocol is paused", rest.DetailsFromError(err), true)
+		rest.JsonErrorResponse(w, http.StatusServiceUnavailable, jsonErr)
+	default:
+		jsonErr := rest.NewErrorResponseWithDetails(UnknownErrorMessage, rest.DetailsFromError(err), false)
+		rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+	}
+}
+
+// quoteBatchItemError converts the error of one of the requests of a quote batch into the error included in
+// the response for that request. The messages are the same that the single quote endpoints return.
+func quoteBatchItemError(err error, isBadRequest func(error) bool) *pkg.QuoteBatchErrorDTO {
+	if details, ok := rest.ValidationErrorDetails(err); ok {
+		return &pkg.QuoteBatchErrorDTO{Message: "validation error", Details: details, Recoverable: true}
+	}
+	switch {
+	case isBadRequest(err):
+		return &pkg.QuoteBatchErrorDTO{Message: "invalid request", Details: rest.DetailsFromError(err), Recoverable: true}
+	case errors.Is(err, usecases.NoLiquidityError):
+		return &pkg.QuoteBatchErrorDTO{Message: "no enough liquidity", Details: rest.DetailsFromError(err), Recoverable: true}
+	default:
+		return &pkg.QuoteBatchErrorDTO{Message: UnknownErrorMessage, Details: rest.DetailsFromError(err), Recoverable: false}
+	}
+}
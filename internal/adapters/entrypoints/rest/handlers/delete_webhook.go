@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+)
+
+type DeleteWebhookUseCase interface {
+	Run(ctx context.Context, id string) error
+}
+
+// NewDeleteWebhookHandler
+// @Title Delete Webhook
+// @Description Deletes a webhook
+// @Param id query string true "Identifier of the webhook to delete"
+// @Success 204 object
+// @Route /management/webhooks [delete]
+func NewDeleteWebhookHandler(useCase DeleteWebhookUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		id := req.URL.Query().Get("id")
+		if id == "" {
+			rest.ValidateRequestError(w, rest.RequiredQueryParam("id"))
+			return
+		}
+		err := useCase.Run(req.Context(), id)
+		if errors.Is(err, webhook.WebhookNotFoundError) {
+			jsonErr := rest.NewErrorResponse(webhook.WebhookNotFoundError.Error(), true)
+			rest.JsonErrorResponse(w, http.StatusNotFound, jsonErr)
+			return
+		} else if err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails(UnknownErrorMessage, rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+			return
+		}
+		rest.JsonResponse(w, http.StatusNoContent)
+	}
+}
@@ -0,0 +1,48 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewDeleteWebhookHandler(t *testing.T) {
+	t.Run("should return 204 on success", func(t *testing.T) {
+		useCase := &mocks.DeleteWebhookUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything, "0a1b").Return(nil).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewDeleteWebhookHandler(useCase).ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/management/webhooks?id=0a1b", nil))
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+		useCase.AssertExpectations(t)
+	})
+	t.Run("should return 400 when id is missing", func(t *testing.T) {
+		useCase := &mocks.DeleteWebhookUseCaseMock{}
+		recorder := httptest.NewRecorder()
+		handlers.NewDeleteWebhookHandler(useCase).ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/management/webhooks", nil))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		useCase.AssertNotCalled(t, "Run")
+	})
+	t.Run("should return 404 when webhook not found", func(t *testing.T) {
+		useCase := &mocks.DeleteWebhookUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything, "0a1b").Return(usecases.WrapUseCaseError(usecases.DeleteWebhookId, webhook.WebhookNotFoundError)).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewDeleteWebhookHandler(useCase).ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/management/webhooks?id=0a1b", nil))
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		useCase.AssertExpectations(t)
+	})
+	t.Run("should return 500 on unexpected error", func(t *testing.T) {
+		useCase := &mocks.DeleteWebhookUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything, "0a1b").Return(assert.AnError).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewDeleteWebhookHandler(useCase).ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/management/webhooks?id=0a1b", nil))
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		useCase.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegin"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+)
+
+type EstimatePeginQuoteUseCase interface {
+	Run(ctx context.Context, request pegin.QuoteRequest) (quote.PeginQuote, error)
+}
+
+// NewEstimatePeginQuoteHandler
+// @Title Estimate Pegin Quote
+// @Description Returns the fees and times of the quote that would be created for the request, without creating it. The estimation can't be accepted, to get a quote that can be accepted use /pegin/getQuote
+// @Param PeginQuoteRequest  body pkg.PeginQuoteRequest true "Interface with parameters for computing possible quotes for the service"
+// @Param X-Partner-Signature header string false "Hex encoded signature of a trusted account over the timestamp and the request body, used to get the estimation with the terms of that account"
+// @Param X-Partner-Timestamp header string false "Unix timestamp in seconds included in the partner signature. Required if X-Partner-Signature is present"
+// @Success 200 object pkg.PeginQuoteEstimationDTO Fees and times of the quote
+// @Route /pegin/estimate [post]
+func NewEstimatePeginQuoteHandler(useCase EstimatePeginQuoteUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var result quote.PeginQuote
+		var callArgument []byte
+		quoteRequest := pkg.PeginQuoteRequest{}
+		partnerAuth, err := readPartnerAuthentication(w, req)
+		if err != nil {
+			return
+		}
+		if err = rest.DecodeRequest(w, req, &quoteRequest); err != nil {
+			return
+		} else if err = rest.ValidateRequest(w, &quoteRequest); err != nil {
+			return
+		}
+
+		if callArgument, err = blockchain.DecodeStringTrimPrefix(quoteRequest.CallContractArguments); err != nil {
+			jsonErr := rest.NewErrorResponse(err.Error(), true)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		}
+
+		peginRequest := pegin.NewQuoteRequest(
+			quoteRequest.CallEoaOrContractAddress,
+			callArgument,
+			entities.NewBigWei(quoteRequest.ValueToTransfer),
+			quoteRequest.RskRefundAddress,
+		)
+		if partnerAuth != nil {
+			peginRequest = peginRequest.WithPartnerAuthentication(*partnerAuth)
+		}
+
+		result, err = useCase.Run(req.Context(), peginRequest)
+		if handlePartnerAuthenticationError(w, err) {
+			return
+		} else if isGetPeginQuoteBadRequest(err) {
+			jsonErr := rest.NewErrorResponseWithDetails("invalid request", rest.DetailsFromError(err), true)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		} else if errors.Is(err, blockchain.ContractPausedError) {
+			jsonErr := rest.NewErrorResponseWithDetails("protocol is paused", rest.DetailsFromError(err), true)
+			rest.JsonErrorResponse(w, http.StatusServiceUnavailable, jsonErr)
+			return
+		} else if err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails(UnknownErrorMessage, rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+			return
+		}
+		response := pkg.ToPeginQuoteEstimationDTO(result)
+		rest.JsonResponseWithBody(w, http.StatusOK, &response)
+	}
+}
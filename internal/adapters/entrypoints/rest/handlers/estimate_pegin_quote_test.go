@@ -0,0 +1,75 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const estimatePeginQuotePath = "/pegin/estimate"
+
+func TestEstimatePeginQuoteHandler(t *testing.T) {
+	body, err := json.Marshal(createValidPeginQuoteRequest())
+	require.NoError(t, err)
+	peginQuote := createTestPeginQuote()
+	useCase := mocks.NewEstimatePeginQuoteUseCaseMock(t)
+	useCase.EXPECT().Run(mock.Anything, mock.AnythingOfType("pegin.QuoteRequest")).Return(peginQuote, nil).Once()
+
+	request := httptest.NewRequest(http.MethodPost, estimatePeginQuotePath, bytes.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handlers.NewEstimatePeginQuoteHandler(useCase).ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var response pkg.PeginQuoteEstimationDTO
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+	assert.Equal(t, pkg.ToPeginQuoteEstimationDTO(peginQuote), response)
+}
+
+func TestEstimatePeginQuoteHandler_ErrorHandling(t *testing.T) {
+	validBody, err := json.Marshal(createValidPeginQuoteRequest())
+	require.NoError(t, err)
+	t.Run("should return 400 if the request is invalid", func(t *testing.T) {
+		invalidRequest := createValidPeginQuoteRequest()
+		invalidRequest.RskRefundAddress = "invalid"
+		body, marshalErr := json.Marshal(invalidRequest)
+		require.NoError(t, marshalErr)
+		request := httptest.NewRequest(http.MethodPost, estimatePeginQuotePath, bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+		handlers.NewEstimatePeginQuoteHandler(mocks.NewEstimatePeginQuoteUseCaseMock(t)).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "validation error")
+	})
+	cases := []struct {
+		err     error
+		status  int
+		message string
+	}{
+		{err: usecases.TxBelowMinimumError, status: http.StatusBadRequest, message: "invalid request"},
+		{err: blockchain.ContractPausedError, status: http.StatusServiceUnavailable, message: "protocol is paused"},
+		{err: usecases.InvalidPartnerAuthError, status: http.StatusUnauthorized, message: "invalid partner authentication"},
+		{err: assert.AnError, status: http.StatusInternalServerError, message: handlers.UnknownErrorMessage},
+	}
+	for _, c := range cases {
+		t.Run("should return "+http.StatusText(c.status)+" for "+c.err.Error(), func(t *testing.T) {
+			useCase := mocks.NewEstimatePeginQuoteUseCaseMock(t)
+			useCase.EXPECT().Run(mock.Anything, mock.Anything).Return(quote.PeginQuote{}, usecases.WrapUseCaseError(usecases.EstimatePeginQuoteId, c.err)).Once()
+			request := httptest.NewRequest(http.MethodPost, estimatePeginQuotePath, bytes.NewReader(validBody))
+			recorder := httptest.NewRecorder()
+			handlers.NewEstimatePeginQuoteHandler(useCase).ServeHTTP(recorder, request)
+			assert.Equal(t, c.status, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), c.message)
+		})
+	}
+}
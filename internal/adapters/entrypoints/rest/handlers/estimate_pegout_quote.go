@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegout"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+)
+
+type EstimatePegoutQuoteUseCase interface {
+	Run(ctx context.Context, request pegout.QuoteRequest) (quote.PegoutQuote, error)
+}
+
+// NewEstimatePegoutQuoteHandler
+// @Title Estimate Pegout Quote
+// @Description Returns the fees and times of the quote that would be created for the request, without creating it. The estimation can't be accepted, to get a quote that can be accepted use /pegout/getQuotes
+// @Param PegoutQuoteRequest body pkg.PegoutQuoteRequest true "Interface with parameters for computing possible quotes for the service"
+// @Param X-Partner-Signature header string false "Hex encoded signature of a trusted account over the timestamp and the request body, used to get the estimation with the terms of that account"
+// @Param X-Partner-Timestamp header string false "Unix timestamp in seconds included in the partner signature. Required if X-Partner-Signature is present"
+// @Success 200 object pkg.PegoutQuoteEstimationDTO Fees and times of the quote
+// @Route /pegout/estimate [post]
+func NewEstimatePegoutQuoteHandler(useCase EstimatePegoutQuoteUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var result quote.PegoutQuote
+		quoteRequest := pkg.PegoutQuoteRequest{}
+		partnerAuth, err := readPartnerAuthentication(w, req)
+		if err != nil {
+			return
+		}
+		if err = rest.DecodeRequest(w, req, &quoteRequest); err != nil {
+			return
+		} else if err = rest.ValidateRequest(w, &quoteRequest); err != nil {
+			return
+		}
+
+		pegoutRequest := pegout.NewQuoteRequest(
+			quoteRequest.To,
+			entities.NewBigWei(quoteRequest.ValueToTransfer),
+			quoteRequest.RskRefundAddress,
+		)
+		if partnerAuth != nil {
+			pegoutRequest = pegoutRequest.WithPartnerAuthentication(*partnerAuth)
+		}
+
+		result, err = useCase.Run(req.Context(), pegoutRequest)
+		if handlePartnerAuthenticationError(w, err) {
+			return
+		} else if isGetPegoutQuoteBadRequest(err) {
+			jsonErr := rest.NewErrorResponseWithDetails("invalid request", rest.DetailsFromError(err), true)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		} else if errors.Is(err, usecases.NoLiquidityError) {
+			jsonErr := rest.NewErrorResponseWithDetails("no enough liquidity", rest.DetailsFromError(err), true)
+			rest.JsonErrorResponse(w, http.StatusConflict, jsonErr)
+			return
+		} else if errors.Is(err, blockchain.ContractPausedError) {
+			jsonErr := rest.NewErrorResponseWithDetails("protocol is paused", rest.DetailsFromError(err), true)
+			rest.JsonErrorResponse(w, http.StatusServiceUnavailable, jsonErr)
+			return
+		} else if err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails(UnknownErrorMessage, rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+			return
+		}
+		response := pkg.ToPegoutQuoteEstimationDTO(result)
+		rest.JsonResponseWithBody(w, http.StatusOK, &response)
+	}
+}
@@ -0,0 +1,72 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const estimatePegoutQuotePath = "/pegout/estimate"
+
+func TestEstimatePegoutQuoteHandler(t *testing.T) {
+	body, err := json.Marshal(pkg.PegoutQuoteRequest{To: test.AnyBtcAddress, ValueToTransfer: big.NewInt(1000), RskRefundAddress: test.AnyRskAddress})
+	require.NoError(t, err)
+	pegoutQuote := createTestPegoutQuote()
+	useCase := mocks.NewEstimatePegoutQuoteUseCaseMock(t)
+	useCase.EXPECT().Run(mock.Anything, mock.AnythingOfType("pegout.QuoteRequest")).Return(pegoutQuote, nil).Once()
+
+	request := httptest.NewRequest(http.MethodPost, estimatePegoutQuotePath, bytes.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handlers.NewEstimatePegoutQuoteHandler(useCase).ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var response pkg.PegoutQuoteEstimationDTO
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+	assert.Equal(t, pkg.ToPegoutQuoteEstimationDTO(pegoutQuote), response)
+}
+
+func TestEstimatePegoutQuoteHandler_ErrorHandling(t *testing.T) {
+	validBody, err := json.Marshal(pkg.PegoutQuoteRequest{To: test.AnyBtcAddress, ValueToTransfer: big.NewInt(1000), RskRefundAddress: test.AnyRskAddress})
+	require.NoError(t, err)
+	t.Run("should return 400 if the request can't be decoded", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, estimatePegoutQuotePath, bytes.NewReader([]byte(`{"to":`)))
+		recorder := httptest.NewRecorder()
+		handlers.NewEstimatePegoutQuoteHandler(mocks.NewEstimatePegoutQuoteUseCaseMock(t)).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+	cases := []struct {
+		err     error
+		status  int
+		message string
+	}{
+		{err: blockchain.BtcAddressInvalidNetworkError, status: http.StatusBadRequest, message: "invalid request"},
+		{err: usecases.NoLiquidityError, status: http.StatusConflict, message: "no enough liquidity"},
+		{err: blockchain.ContractPausedError, status: http.StatusServiceUnavailable, message: "protocol is paused"},
+		{err: assert.AnError, status: http.StatusInternalServerError, message: handlers.UnknownErrorMessage},
+	}
+	for _, c := range cases {
+		t.Run("should return "+http.StatusText(c.status)+" for "+c.err.Error(), func(t *testing.T) {
+			useCase := mocks.NewEstimatePegoutQuoteUseCaseMock(t)
+			useCase.EXPECT().Run(mock.Anything, mock.Anything).Return(quote.PegoutQuote{}, usecases.WrapUseCaseError(usecases.EstimatePegoutQuoteId, c.err)).Once()
+			request := httptest.NewRequest(http.MethodPost, estimatePegoutQuotePath, bytes.NewReader(validBody))
+			recorder := httptest.NewRecorder()
+			handlers.NewEstimatePegoutQuoteHandler(useCase).ServeHTTP(recorder, request)
+			assert.Equal(t, c.status, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), c.message)
+		})
+	}
+}
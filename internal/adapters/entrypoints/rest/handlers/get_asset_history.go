@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	log "github.com/sirupsen/logrus"
+)
+
+type GetAssetHistoryUseCase interface {
+	Run(ctx context.Context, startDate, endDate time.Time, interval reporting.AssetHistoryInterval) ([]reporting.AssetSnapshot, error)
+}
+
+// NewGetAssetHistoryHandler
+// @Title Get asset history
+// @Description Get the time series of the LP assets in the specified range. A snapshot of the assets is stored every time the assets report is calculated, the series can be downsampled to the last snapshot of each interval.
+// @Param startDate query string true "Start date of the range. Supports YYYY-MM-DD (expands to full day) or ISO 8601 format (YYYY-MM-DDTHH:mm:ssZ)"
+// @Param endDate query string true "End date of the range. Supports YYYY-MM-DD (expands to end of day) or ISO 8601 format (YYYY-MM-DDTHH:mm:ssZ)"
+// @Param interval query string false "Downsampling interval: raw, minute, hour or day. Defaults to hour. The range of the raw interval can't be longer than 24 hours"
+// @Success 200 {object} pkg.GetAssetHistoryResponse
+// @Route /reports/assets/history [get]
+func NewGetAssetHistoryHandler(useCase GetAssetHistoryUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var requestParams pkg.GetAssetHistoryRequest
+		var err error
+		requestParams.StartDate = req.URL.Query().Get("startDate")
+		requestParams.EndDate = req.URL.Query().Get("endDate")
+		requestParams.Interval = req.URL.Query().Get("interval")
+		if requestParams.Interval == "" {
+			requestParams.Interval = string(reporting.AssetHistoryIntervalHour)
+		}
+
+		// callback function signature comes from the std lib we can't modify it
+		// nolint:contextcheck
+		if err = rest.ValidateRequest(w, &requestParams); err != nil {
+			return
+		}
+
+		if err = requestParams.ValidateDateRange(); err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails("Validation error", rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		}
+
+		startTime, endTime, err := requestParams.GetTimestamps()
+		if err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails("Date conversion error", rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		}
+
+		snapshots, err := useCase.Run(req.Context(), startTime, endTime, reporting.AssetHistoryInterval(requestParams.Interval))
+		if errors.Is(err, usecases.AssetHistoryRangeError) {
+			jsonErr := rest.NewErrorResponseWithDetails("Validation error", rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		} else if err != nil {
+			log.Error("Unknown error: ", err)
+			jsonErr := rest.NewErrorResponseWithDetails(UnknownErrorMessage, rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+			return
+		}
+
+		response := pkg.GetAssetHistoryResponse{
+			Interval:  requestParams.Interval,
+			Snapshots: make([]pkg.AssetSnapshotDTO, 0, len(snapshots)),
+		}
+		for _, snapshot := range snapshots {
+			response.Snapshots = append(response.Snapshots, pkg.ToAssetSnapshotDTO(snapshot))
+		}
+		rest.JsonResponseWithBody(w, http.StatusOK, &response)
+	}
+}
@@ -0,0 +1,135 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestNewGetAssetHistoryHandler(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		mockSetup func(useCase *mocks.GetAssetHistoryUseCaseMock)
+		result    int
+	}{
+		{
+			name:      "should return 400 if the start date is missing",
+			query:     "endDate=2024-01-31",
+			mockSetup: func(useCase *mocks.GetAssetHistoryUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:      "should return 400 if the interval is invalid",
+			query:     "startDate=2024-01-01&endDate=2024-01-31&interval=week",
+			mockSetup: func(useCase *mocks.GetAssetHistoryUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:      "should return 400 if the date range is invalid",
+			query:     "startDate=2024-01-31&endDate=2024-01-01",
+			mockSetup: func(useCase *mocks.GetAssetHistoryUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:  "should return 400 if the raw range is too long",
+			query: "startDate=2024-01-01&endDate=2024-01-31&interval=raw",
+			mockSetup: func(useCase *mocks.GetAssetHistoryUseCaseMock) {
+				useCase.EXPECT().Run(mock.Anything, mock.Anything, mock.Anything, reporting.AssetHistoryIntervalRaw).
+					Return(nil, usecases.WrapUseCaseError(usecases.GetAssetHistoryId, usecases.AssetHistoryRangeError)).Once()
+			},
+			result: http.StatusBadRequest,
+		},
+		{
+			name:  "should return 500 on unexpected errors",
+			query: "startDate=2024-01-01&endDate=2024-01-31&interval=day",
+			mockSetup: func(useCase *mocks.GetAssetHistoryUseCaseMock) {
+				useCase.EXPECT().Run(mock.Anything, mock.Anything, mock.Anything, reporting.AssetHistoryIntervalDay).Return(nil, assert.AnError).Once()
+			},
+			result: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			useCase := mocks.NewGetAssetHistoryUseCaseMock(t)
+			tc.mockSetup(useCase)
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/assets/history?"+tc.query, nil)
+			require.NoError(t, err)
+			rr := httptest.NewRecorder()
+			handlers.NewGetAssetHistoryHandler(useCase).ServeHTTP(rr, req)
+			assert.Equal(t, tc.result, rr.Code)
+		})
+	}
+
+	t.Run("should return the hourly history by default", func(t *testing.T) {
+		snapshot := reporting.AssetSnapshot{
+			Timestamp: time.Date(2024, 1, 1, 10, 59, 0, 0, time.UTC),
+			Btc: reporting.AssetBalance{
+				Total: entities.NewWei(900),
+				Location: reporting.AssetLocation{
+					BtcWallet:  entities.NewWei(500),
+					Federation: entities.NewWei(200),
+					RskWallet:  entities.NewWei(100),
+					Lbc:        entities.NewWei(100),
+				},
+				Allocation: reporting.AssetAllocation{
+					ReservedForUsers: entities.NewWei(50),
+					WaitingForRefund: entities.NewWei(400),
+					Available:        entities.NewWei(450),
+				},
+			},
+			Rbtc: reporting.AssetBalance{
+				Total: entities.NewWei(1000),
+				Location: reporting.AssetLocation{
+					Federation: entities.NewWei(300),
+					RskWallet:  entities.NewWei(200),
+					Lbc:        entities.NewWei(500),
+				},
+				Allocation: reporting.AssetAllocation{
+					ReservedForUsers: entities.NewWei(100),
+					WaitingForRefund: entities.NewWei(300),
+					Available:        entities.NewWei(600),
+				},
+			},
+		}
+		useCase := mocks.NewGetAssetHistoryUseCaseMock(t)
+		useCase.EXPECT().Run(
+			mock.Anything,
+			time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 31, 23, 59, 59, 999999999, time.UTC),
+			reporting.AssetHistoryIntervalHour,
+		).Return([]reporting.AssetSnapshot{snapshot}, nil).Once()
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/assets/history?startDate=2024-01-01&endDate=2024-01-31", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		handlers.NewGetAssetHistoryHandler(useCase).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var response pkg.GetAssetHistoryResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		assert.Equal(t, "hour", response.Interval)
+		require.Len(t, response.Snapshots, 1)
+		assert.Equal(t, snapshot.Timestamp, response.Snapshots[0].Timestamp)
+		assert.Equal(t, big.NewInt(1900), response.Snapshots[0].Total)
+		assert.Equal(t, big.NewInt(500), response.Snapshots[0].BtcAssetReport.Location.BtcWallet)
+		assert.Equal(t, big.NewInt(450), response.Snapshots[0].BtcAssetReport.Allocation.Available)
+		assert.Equal(t, big.NewInt(300), response.Snapshots[0].RbtcAssetReport.Location.Federation)
+		assert.Equal(t, big.NewInt(600), response.Snapshots[0].RbtcAssetReport.Allocation.Available)
+	})
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+)
+
+type GetLiquidityHoldsUseCase interface {
+	Run(ctx context.Context, filter liquidity_provider.LiquidityHoldFilter) ([]liquidity_provider.LiquidityHold, error)
+}
+
+// NewGetLiquidityHoldsHandler
+// @Title Get Liquidity Holds
+// @Description Returns the liquidity reserved for the accepted quotes, oldest first
+// @Param operation query string false "Operation of the holds to return: pegin or pegout. If not provided, holds of both operations are returned"
+// @Param status query string false "Status of the holds to return: active, released or expired. Can be repeated. If not provided, holds in any status are returned"
+// @Success 200 object pkg.LiquidityHoldsResponse
+// @Route /management/liquidity/holds [get]
+func NewGetLiquidityHoldsHandler(useCase GetLiquidityHoldsUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		filter := liquidity_provider.LiquidityHoldFilter{}
+		switch operation := liquidity_provider.HoldOperation(query.Get("operation")); operation {
+		case "", liquidity_provider.PeginHold, liquidity_provider.PegoutHold:
+			filter.Operation = operation
+		default:
+			jsonErr := rest.NewErrorResponse("invalid operation parameter: "+string(operation), true)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		}
+		for _, status := range query["status"] {
+			switch holdStatus := liquidity_provider.HoldStatus(status); holdStatus {
+			case liquidity_provider.HoldStatusActive, liquidity_provider.HoldStatusReleased, liquidity_provider.HoldStatusExpired:
+				filter.Statuses = append(filter.Statuses, holdStatus)
+			default:
+				jsonErr := rest.NewErrorResponse("invalid status parameter: "+status, true)
+				rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+				return
+			}
+		}
+
+		holds, err := useCase.Run(req.Context(), filter)
+		if err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails(UnknownErrorMessage, rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+			return
+		}
+		response := pkg.ToLiquidityHoldsResponse(holds)
+		rest.JsonResponseWithBody(w, http.StatusOK, &response)
+	}
+}
@@ -0,0 +1,85 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestNewGetLiquidityHoldsHandler(t *testing.T) {
+	createdAt := time.Now().UTC().Truncate(time.Second)
+	expiresAt := createdAt.Add(time.Hour)
+	releasedAt := createdAt.Add(time.Minute)
+	holds := []liquidity_provider.LiquidityHold{
+		{QuoteHash: "0x01", Operation: liquidity_provider.PeginHold, Amount: entities.NewWei(100), Status: liquidity_provider.HoldStatusActive, CreatedAt: createdAt, ExpiresAt: expiresAt},
+		{QuoteHash: "0x02", Operation: liquidity_provider.PeginHold, Amount: entities.NewWei(200), Status: liquidity_provider.HoldStatusActive, CreatedAt: createdAt, ExpiresAt: expiresAt},
+		{QuoteHash: "0x03", Operation: liquidity_provider.PegoutHold, Amount: entities.NewWei(300), Status: liquidity_provider.HoldStatusActive, CreatedAt: createdAt, ExpiresAt: expiresAt},
+		{
+			QuoteHash: "0x04", Operation: liquidity_provider.PegoutHold, Amount: entities.NewWei(400), Status: liquidity_provider.HoldStatusReleased,
+			CreatedAt: createdAt, ExpiresAt: expiresAt, ReleasedAt: releasedAt, ReleaseReason: "SendPegoutSucceeded",
+		},
+	}
+	t.Run("should return the holds and the held amounts", func(t *testing.T) {
+		useCase := &mocks.GetLiquidityHoldsUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything, liquidity_provider.LiquidityHoldFilter{}).Return(holds, nil).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewGetLiquidityHoldsHandler(useCase).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/management/liquidity/holds", nil))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response pkg.LiquidityHoldsResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		require.Len(t, response.Holds, 4)
+		assert.Equal(t, big.NewInt(300), response.PeginHeld)
+		assert.Equal(t, big.NewInt(300), response.PegoutHeld)
+		assert.Equal(t, pkg.LiquidityHoldDTO{
+			QuoteHash: "0x01", Operation: "pegin", Amount: big.NewInt(100), Status: "active", CreatedAt: createdAt, ExpiresAt: expiresAt,
+		}, response.Holds[0])
+		assert.Equal(t, pkg.LiquidityHoldDTO{
+			QuoteHash: "0x04", Operation: "pegout", Amount: big.NewInt(400), Status: "released", CreatedAt: createdAt, ExpiresAt: expiresAt,
+			ReleasedAt: &releasedAt, ReleaseReason: "SendPegoutSucceeded",
+		}, response.Holds[3])
+		useCase.AssertExpectations(t)
+	})
+	t.Run("should filter by operation and status", func(t *testing.T) {
+		useCase := &mocks.GetLiquidityHoldsUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything, liquidity_provider.LiquidityHoldFilter{
+			Operation: liquidity_provider.PegoutHold,
+			Statuses:  []liquidity_provider.HoldStatus{liquidity_provider.HoldStatusReleased, liquidity_provider.HoldStatusExpired},
+		}).Return([]liquidity_provider.LiquidityHold{}, nil).Once()
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/management/liquidity/holds?operation=pegout&status=released&status=expired", nil)
+		handlers.NewGetLiquidityHoldsHandler(useCase).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.JSONEq(t, `{"holds":[],"peginHeld":0,"pegoutHeld":0}`, recorder.Body.String())
+		useCase.AssertExpectations(t)
+	})
+	t.Run("should return 400 on invalid filters", func(t *testing.T) {
+		for _, query := range []string{"operation=other", "status=active&status=other", "operation=PEGIN"} {
+			useCase := &mocks.GetLiquidityHoldsUseCaseMock{}
+			recorder := httptest.NewRecorder()
+			handlers.NewGetLiquidityHoldsHandler(useCase).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/management/liquidity/holds?"+query, nil))
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			useCase.AssertNotCalled(t, "Run")
+		}
+	})
+	t.Run("should return 500 on unexpected error", func(t *testing.T) {
+		useCase := &mocks.GetLiquidityHoldsUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewGetLiquidityHoldsHandler(useCase).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/management/liquidity/holds", nil))
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		useCase.AssertExpectations(t)
+	})
+}
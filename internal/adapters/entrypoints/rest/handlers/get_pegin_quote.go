@@ -22,14 +22,19 @@ type GetPeginQuoteUseCase interface {
 // @Title Pegin GetQuote
 // @Description Gets Pegin Quote
 // @Param PeginQuoteRequest  body pkg.PeginQuoteRequest true "Interface with parameters for computing possible quotes for the service"
+// @Param X-Partner-Signature header string false "Hex encoded signature of a trusted account over the timestamp and the request body, used to get a quote with the terms of that account"
+// @Param X-Partner-Timestamp header string false "Unix timestamp in seconds included in the partner signature. Required if X-Partner-Signature is present"
 // @Success 200 array pkg.GetPeginQuoteResponse The quote structure defines the conditions of a service, and acts as a contract between users and LPs
 // @Route /pegin/getQuote [post]
 func NewGetPeginQuoteHandler(useCase GetPeginQuoteUseCase) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
-		var err error
 		var result pegin.GetPeginQuoteResult
 		var callArgument []byte
 		quoteRequest := pkg.PeginQuoteRequest{}
+		partnerAuth, err := readPartnerAuthentication(w, req)
+		if err != nil {
+			return
+		}
 		if err = rest.DecodeRequest(w, req, &quoteRequest); err != nil {
 			return
 		} else if err = rest.ValidateRequest(w, &quoteRequest); err != nil {
@@ -49,8 +54,14 @@ func NewGetPeginQuoteHandler(useCase GetPeginQuoteUseCase) http.HandlerFunc {
 			quoteRequest.RskRefundAddress,
 		)
 
+		if partnerAuth != nil {
+			peginRequest = peginRequest.WithPartnerAuthentication(*partnerAuth)
+		}
+
 		result, err = useCase.Run(req.Context(), peginRequest)
-		if isGetPeginQuoteBadRequest(err) {
+		if handlePartnerAuthenticationError(w, err) {
+			return
+		} else if isGetPeginQuoteBadRequest(err) {
 			jsonErr := rest.NewErrorResponseWithDetails("invalid request", rest.DetailsFromError(err), true)
 			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
 			return
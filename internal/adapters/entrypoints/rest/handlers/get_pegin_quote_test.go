@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"io"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
@@ -702,3 +703,70 @@ func createTestPeginQuote() quote.PeginQuote {
 		ChainId:            31,
 	}
 }
+
+// nolint:funlen
+func TestGetPeginQuoteHandlerPartnerAuthentication(t *testing.T) {
+	reqBody := createValidPeginQuoteRequest()
+	jsonBody, err := json.Marshal(reqBody)
+	require.NoError(t, err)
+	newRequest := func(timestamp string) *http.Request {
+		request := httptest.NewRequest(http.MethodPost, "/pegin/getQuote", bytes.NewBuffer(jsonBody))
+		request.Header.Set("Content-Type", "application/json")
+		request.Header.Set(handlers.PartnerSignatureHeader, "0102")
+		request.Header.Set(handlers.PartnerTimestampHeader, timestamp)
+		return request
+	}
+
+	t.Run("should forward the partner authentication to the use case", func(t *testing.T) {
+		expectedRequest := pegin.NewQuoteRequest(
+			reqBody.CallEoaOrContractAddress, []byte{0x12, 0x34}, entities.NewBigWei(reqBody.ValueToTransfer), reqBody.RskRefundAddress,
+		).WithPartnerAuthentication(usecases.PartnerAuthentication{Signature: "0102", Timestamp: 1700000000, Payload: jsonBody})
+		mockUseCase := new(mocks.GetPeginQuoteUseCaseMock)
+		mockUseCase.On("Run", mock.Anything, expectedRequest).
+			Return(pegin.GetPeginQuoteResult{PeginQuote: createTestPeginQuote(), Hash: test.AnyHash}, nil).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewGetPeginQuoteHandler(mockUseCase).ServeHTTP(recorder, newRequest("1700000000"))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("should return 400 on invalid timestamp header", func(t *testing.T) {
+		mockUseCase := new(mocks.GetPeginQuoteUseCaseMock)
+		recorder := httptest.NewRecorder()
+		handlers.NewGetPeginQuoteHandler(mockUseCase).ServeHTTP(recorder, newRequest("yesterday"))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		mockUseCase.AssertNotCalled(t, "Run")
+	})
+
+	t.Run("should return 413 when the signed body is too large", func(t *testing.T) {
+		mockUseCase := new(mocks.GetPeginQuoteUseCaseMock)
+		request := newRequest("1700000000")
+		request.Body = io.NopCloser(bytes.NewReader(bytes.Repeat([]byte(" "), handlers.MaxQuoteRequestBodySize+1)))
+		recorder := httptest.NewRecorder()
+		handlers.NewGetPeginQuoteHandler(mockUseCase).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "request body too large")
+		mockUseCase.AssertNotCalled(t, "Run")
+	})
+
+	t.Run("should return 401 on InvalidPartnerAuthError", func(t *testing.T) {
+		mockUseCase := new(mocks.GetPeginQuoteUseCaseMock)
+		mockUseCase.On("Run", mock.Anything, mock.AnythingOfType("pegin.QuoteRequest")).
+			Return(pegin.GetPeginQuoteResult{}, usecases.InvalidPartnerAuthError).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewGetPeginQuoteHandler(mockUseCase).ServeHTTP(recorder, newRequest("1700000000"))
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		var errorResponse map[string]interface{}
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&errorResponse))
+		assert.Equal(t, "invalid partner authentication", errorResponse["message"])
+	})
+
+	t.Run("should return 500 on TamperedTrustedAccountError", func(t *testing.T) {
+		mockUseCase := new(mocks.GetPeginQuoteUseCaseMock)
+		mockUseCase.On("Run", mock.Anything, mock.AnythingOfType("pegin.QuoteRequest")).
+			Return(pegin.GetPeginQuoteResult{}, liquidity_provider.TamperedTrustedAccountError).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewGetPeginQuoteHandler(mockUseCase).ServeHTTP(recorder, newRequest("1700000000"))
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
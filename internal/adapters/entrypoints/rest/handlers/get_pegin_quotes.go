@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegin"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+)
+
+type GetPeginQuotesUseCase interface {
+	Run(ctx context.Context, request pegin.BatchQuoteRequest) ([]pegin.GetPeginQuoteBatchResult, error)
+	MaxBatchSize() uint64
+}
+
+// NewGetPeginQuotesHandler
+// @Title Pegin GetQuotes
+// @Description Gets a Pegin Quote for each request of a batch. The response has one element per request in the same order, each element has either the quote or the reason why it couldn't be created. If the body is a single request instead of an array the endpoint behaves like /pegin/getQuote
+// @Param PeginQuoteRequest  body []pkg.PeginQuoteRequest true "Array of requests with the parameters for computing the quotes"
+// @Param X-Partner-Signature header string false "Hex encoded signature of a trusted account over the timestamp and the request body, used to get the quotes with the terms of that account"
+// @Param X-Partner-Timestamp header string false "Unix timestamp in seconds included in the partner signature. Required if X-Partner-Signature is present"
+// @Success 200 array pkg.PeginQuoteBatchItem Result of each request of the batch
+// @Route /pegin/getQuotes [post]
+func NewGetPeginQuotesHandler(singleUseCase GetPeginQuoteUseCase, batchUseCase GetPeginQuotesUseCase) http.HandlerFunc {
+	singleHandler := NewGetPeginQuoteHandler(singleUseCase)
+	return func(w http.ResponseWriter, req *http.Request) {
+		var quoteRequests []pkg.PeginQuoteRequest
+		if isBatch, err := isQuoteBatchRequest(w, req); err != nil {
+			return
+		} else if !isBatch {
+			singleHandler(w, req)
+			return
+		}
+		partnerAuth, err := readPartnerAuthentication(w, req)
+		if err != nil {
+			return
+		}
+		if err = rest.DecodeRequest(w, req, &quoteRequests); err != nil {
+			return
+		} else if err = validateQuoteBatchSize(w, len(quoteRequests), batchUseCase.MaxBatchSize()); err != nil {
+			return
+		}
+
+		responseBody := make([]pkg.PeginQuoteBatchItem, len(quoteRequests))
+		peginRequests := make([]pegin.QuoteRequest, 0, len(quoteRequests))
+		positions := make([]int, 0, len(quoteRequests))
+		for i := range quoteRequests {
+			peginRequest, itemErr := toPeginQuoteRequest(&quoteRequests[i])
+			if itemErr != nil {
+				responseBody[i].Error = itemErr
+				continue
+			}
+			peginRequests = append(peginRequests, peginRequest)
+			positions = append(positions, i)
+		}
+		if len(peginRequests) == 0 {
+			rest.JsonResponseWithBody(w, http.StatusOK, &responseBody)
+			return
+		}
+
+		batchRequest := pegin.NewBatchQuoteRequest(peginRequests)
+		if partnerAuth != nil {
+			batchRequest = batchRequest.WithPartnerAuthentication(*partnerAuth)
+		}
+		results, err := batchUseCase.Run(req.Context(), batchRequest)
+		if err != nil {
+			handleQuoteBatchError(w, err)
+			return
+		}
+		for i, result := range results {
+			item := &responseBody[positions[i]]
+			if result.Error != nil {
+				item.Error = quoteBatchItemError(result.Error, isGetPeginQuoteBadRequest)
+				continue
+			}
+			quoteDto := pkg.ToPeginQuoteDTO(result.Result.PeginQuote)
+			item.Quote = &quoteDto
+			item.QuoteHash = result.Result.Hash
+		}
+		rest.JsonResponseWithBody(w, http.StatusOK, &responseBody)
+	}
+}
+
+func toPeginQuoteRequest(quoteRequest *pkg.PeginQuoteRequest) (pegin.QuoteRequest, *pkg.QuoteBatchErrorDTO) {
+	if err := rest.RequestValidator.Struct(quoteRequest); err != nil {
+		return pegin.QuoteRequest{}, quoteBatchItemError(err, isGetPeginQuoteBadRequest)
+	}
+	callArgument, err := blockchain.DecodeStringTrimPrefix(quoteRequest.CallContractArguments)
+	if err != nil {
+		return pegin.QuoteRequest{}, &pkg.QuoteBatchErrorDTO{Message: "invalid request", Details: rest.DetailsFromError(err), Recoverable: true}
+	}
+	return pegin.NewQuoteRequest(
+		quoteRequest.CallEoaOrContractAddress,
+		callArgument,
+		entities.NewBigWei(quoteRequest.ValueToTransfer),
+		quoteRequest.RskRefundAddress,
+	), nil
+}
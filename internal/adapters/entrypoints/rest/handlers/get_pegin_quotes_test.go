@@ -0,0 +1,150 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegin"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const getPeginQuotesPath = "/pegin/getQuotes"
+
+func TestGetPeginQuotesHandler_Batch(t *testing.T) {
+	invalidRequest := createValidPeginQuoteRequest()
+	invalidRequest.RskRefundAddress = "not an address"
+	body, err := json.Marshal([]pkg.PeginQuoteRequest{createValidPeginQuoteRequest(), invalidRequest, createValidPeginQuoteRequest()})
+	require.NoError(t, err)
+
+	singleUseCase := mocks.NewGetPeginQuoteUseCaseMock(t)
+	batchUseCase := mocks.NewGetPeginQuotesUseCaseMock(t)
+	batchUseCase.EXPECT().MaxBatchSize().Return(3)
+	batchUseCase.EXPECT().Run(mock.Anything, mock.AnythingOfType("pegin.BatchQuoteRequest")).Return([]pegin.GetPeginQuoteBatchResult{
+		{Result: pegin.GetPeginQuoteResult{PeginQuote: createTestPeginQuote(), Hash: test.AnyHash}},
+		{Error: usecases.WrapUseCaseError(usecases.GetPeginQuoteId, usecases.TxBelowMinimumError)},
+	}, nil).Once()
+
+	request := httptest.NewRequest(http.MethodPost, getPeginQuotesPath, bytes.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handlers.NewGetPeginQuotesHandler(singleUseCase, batchUseCase).ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var response []pkg.PeginQuoteBatchItem
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+	require.Len(t, response, 3)
+	assert.Equal(t, test.AnyHash, response[0].QuoteHash)
+	require.NotNil(t, response[0].Quote)
+	assert.Equal(t, createTestPeginQuote().LbcAddress, response[0].Quote.LBCAddr)
+	assert.Nil(t, response[0].Error)
+	require.NotNil(t, response[1].Error)
+	assert.Equal(t, "validation error", response[1].Error.Message)
+	assert.Contains(t, response[1].Error.Details, "RskRefundAddress")
+	assert.True(t, response[1].Error.Recoverable)
+	assert.Nil(t, response[1].Quote)
+	require.NotNil(t, response[2].Error)
+	assert.Equal(t, "invalid request", response[2].Error.Message)
+	assert.True(t, response[2].Error.Recoverable)
+	assert.Empty(t, response[2].QuoteHash)
+}
+
+func TestGetPeginQuotesHandler_SingleRequest(t *testing.T) {
+	body, err := json.Marshal(createValidPeginQuoteRequest())
+	require.NoError(t, err)
+	singleUseCase := mocks.NewGetPeginQuoteUseCaseMock(t)
+	batchUseCase := mocks.NewGetPeginQuotesUseCaseMock(t)
+	singleUseCase.EXPECT().Run(mock.Anything, mock.AnythingOfType("pegin.QuoteRequest")).
+		Return(pegin.GetPeginQuoteResult{PeginQuote: createTestPeginQuote(), Hash: test.AnyHash}, nil).Once()
+
+	request := httptest.NewRequest(http.MethodPost, getPeginQuotesPath, bytes.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handlers.NewGetPeginQuotesHandler(singleUseCase, batchUseCase).ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var response []pkg.GetPeginQuoteResponse
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+	require.Len(t, response, 1)
+	assert.Equal(t, test.AnyHash, response[0].QuoteHash)
+}
+
+// nolint:funlen
+func TestGetPeginQuotesHandler_ErrorHandling(t *testing.T) {
+	validBody, err := json.Marshal([]pkg.PeginQuoteRequest{createValidPeginQuoteRequest(), createValidPeginQuoteRequest()})
+	require.NoError(t, err)
+	t.Run("should return 400 if the batch is bigger than the max size", func(t *testing.T) {
+		batchUseCase := mocks.NewGetPeginQuotesUseCaseMock(t)
+		batchUseCase.EXPECT().MaxBatchSize().Return(1)
+		request := httptest.NewRequest(http.MethodPost, getPeginQuotesPath, bytes.NewReader(validBody))
+		recorder := httptest.NewRecorder()
+		handlers.NewGetPeginQuotesHandler(mocks.NewGetPeginQuoteUseCaseMock(t), batchUseCase).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "invalid batch size")
+		batchUseCase.AssertNotCalled(t, "Run", mock.Anything, mock.Anything)
+	})
+	t.Run("should return 400 if the batch is empty", func(t *testing.T) {
+		batchUseCase := mocks.NewGetPeginQuotesUseCaseMock(t)
+		batchUseCase.EXPECT().MaxBatchSize().Return(10)
+		request := httptest.NewRequest(http.MethodPost, getPeginQuotesPath, bytes.NewReader([]byte(" []")))
+		recorder := httptest.NewRecorder()
+		handlers.NewGetPeginQuotesHandler(mocks.NewGetPeginQuoteUseCaseMock(t), batchUseCase).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "invalid batch size")
+	})
+	t.Run("should return 400 if an element of the batch can't be decoded", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, getPeginQuotesPath, bytes.NewReader([]byte(`[{"unknownField": 1}]`)))
+		recorder := httptest.NewRecorder()
+		handlers.NewGetPeginQuotesHandler(mocks.NewGetPeginQuoteUseCaseMock(t), mocks.NewGetPeginQuotesUseCaseMock(t)).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "Error decoding request")
+	})
+	t.Run("should not run the use case if all the requests are invalid", func(t *testing.T) {
+		invalidRequest := createValidPeginQuoteRequest()
+		invalidRequest.CallContractArguments = "0xnothex"
+		body, bodyErr := json.Marshal([]pkg.PeginQuoteRequest{invalidRequest})
+		require.NoError(t, bodyErr)
+		batchUseCase := mocks.NewGetPeginQuotesUseCaseMock(t)
+		batchUseCase.EXPECT().MaxBatchSize().Return(10)
+		request := httptest.NewRequest(http.MethodPost, getPeginQuotesPath, bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+		handlers.NewGetPeginQuotesHandler(mocks.NewGetPeginQuoteUseCaseMock(t), batchUseCase).ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		var response []pkg.PeginQuoteBatchItem
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+		require.Len(t, response, 1)
+		require.NotNil(t, response[0].Error)
+		assert.Equal(t, "invalid request", response[0].Error.Message)
+		batchUseCase.AssertNotCalled(t, "Run", mock.Anything, mock.Anything)
+	})
+	t.Run("should handle errors of the whole batch", func(t *testing.T) {
+		cases := []struct {
+			err     error
+			status  int
+			message string
+		}{
+			{err: blockchain.ContractPausedError, status: http.StatusServiceUnavailable, message: "protocol is paused"},
+			{err: usecases.InvalidPartnerAuthError, status: http.StatusUnauthorized, message: "invalid partner authentication"},
+			{err: usecases.QuoteBatchSizeError, status: http.StatusBadRequest, message: "invalid batch size"},
+			{err: assert.AnError, status: http.StatusInternalServerError, message: handlers.UnknownErrorMessage},
+		}
+		for _, c := range cases {
+			batchUseCase := mocks.NewGetPeginQuotesUseCaseMock(t)
+			batchUseCase.EXPECT().MaxBatchSize().Return(10)
+			batchUseCase.EXPECT().Run(mock.Anything, mock.Anything).Return(nil, usecases.WrapUseCaseError(usecases.GetPeginQuotesId, c.err)).Once()
+			request := httptest.NewRequest(http.MethodPost, getPeginQuotesPath, bytes.NewReader(validBody))
+			recorder := httptest.NewRecorder()
+			handlers.NewGetPeginQuotesHandler(mocks.NewGetPeginQuoteUseCaseMock(t), batchUseCase).ServeHTTP(recorder, request)
+			assert.Equal(t, c.status, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), c.message)
+		}
+	})
+}
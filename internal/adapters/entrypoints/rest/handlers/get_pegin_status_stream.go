@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+)
+
+// NewGetPeginStatusStreamHandler
+// @Title GetPeginStatusStream
+// @Description Streams the status of an accepted pegin quote using Server-Sent Events. The first event (status) has the same content as /pegin/status, then a state event is sent on every state transition and a confirmations event every time the confirmations of the user BTC deposit change
+// @Param quoteHash query string true "Hash of the quote"
+// @Success 200 {object} pkg.PeginQuoteStatusDTO "Stream of status, state (pkg.QuoteStateDTO) and confirmations (pkg.DepositConfirmationsDTO) events"
+// @Router /pegin/status/stream [get]
+func NewGetPeginStatusStreamHandler(
+	statusUseCase PeginStatusUseCase,
+	stateUseCase QuoteStateStreamUseCase,
+	confirmationsUseCase DepositConfirmationsUseCase,
+	pollInterval time.Duration,
+	maxDuration time.Duration,
+) http.HandlerFunc {
+	confirmationsPoller := newDepositConfirmationsPoller(confirmationsUseCase, pollInterval)
+	return func(w http.ResponseWriter, req *http.Request) {
+		quoteHash, err := validateStreamQuoteHash(w, req)
+		if err != nil {
+			return
+		}
+		ctx, cancel := context.WithTimeout(req.Context(), maxDuration)
+		defer cancel()
+		states := stateUseCase.Run(ctx, quoteHash)
+		result, err := statusUseCase.Run(ctx, quoteHash)
+		if err != nil {
+			handleQuoteStatusError(w, err)
+			return
+		}
+		status := pkg.PeginQuoteStatusDTO{
+			Detail:       pkg.ToPeginQuoteDTO(result.PeginQuote),
+			Status:       pkg.ToRetainedPeginQuoteDTO(result.RetainedQuote),
+			CreationData: pkg.ToPeginCreationDataDTO(result.CreationData),
+		}
+		streamQuoteStatus(ctx, w, quoteStatusStream{
+			quoteHash:            quoteHash,
+			state:                string(result.RetainedQuote.State),
+			waitingStates:        []string{string(quote.PeginStateWaitingForDeposit), string(quote.PeginStateWaitingForDepositConfirmations)},
+			confirmationsUseCase: confirmationsUseCase,
+			confirmationsPoller:  confirmationsPoller,
+			pollInterval:         pollInterval,
+		}, status, states)
+	}
+}
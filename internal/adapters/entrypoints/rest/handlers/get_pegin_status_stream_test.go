@@ -0,0 +1,284 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testStreamQuoteHash = "8d1ba2cb559a6ebe41f19131602467e1d939682d651b2a91e55b86bc664a6819"
+
+type streamEvent struct {
+	name string
+	data string
+}
+
+// parseEventStream returns the events of a Server-Sent Events body ignoring the comments (pings)
+func parseEventStream(t *testing.T, body string) []streamEvent {
+	events := make([]streamEvent, 0)
+	for _, block := range strings.Split(body, "\n\n") {
+		if block == "" || strings.HasPrefix(block, ":") {
+			continue
+		}
+		lines := strings.Split(block, "\n")
+		require.Len(t, lines, 2)
+		require.True(t, strings.HasPrefix(lines[0], "event: "))
+		require.True(t, strings.HasPrefix(lines[1], "data: "))
+		events = append(events, streamEvent{name: strings.TrimPrefix(lines[0], "event: "), data: strings.TrimPrefix(lines[1], "data: ")})
+	}
+	return events
+}
+
+// syncRecorder allows reading the body of a stream while the handler is still writing it
+type syncRecorder struct {
+	*httptest.ResponseRecorder
+	mutex sync.Mutex
+}
+
+func (recorder *syncRecorder) Write(content []byte) (int, error) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	return recorder.ResponseRecorder.Write(content)
+}
+
+func (recorder *syncRecorder) Flush() {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.ResponseRecorder.Flush()
+}
+
+func (recorder *syncRecorder) body() string {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	return recorder.Body.String()
+}
+
+func assertStreamEvent[T any](t *testing.T, event streamEvent, name string, expected T) {
+	var data T
+	assert.Equal(t, name, event.name)
+	require.NoError(t, json.Unmarshal([]byte(event.data), &data))
+	assert.Equal(t, expected, data)
+}
+
+// nolint:funlen
+func TestNewGetPeginStatusStreamHandler(t *testing.T) {
+	t.Run("should send the status, the state transitions and the confirmations when they change", func(t *testing.T) {
+		retainedQuote := testRetainedQuote
+		retainedQuote.QuoteHash = testStreamQuoteHash
+		retainedQuote.State = quote.PeginStateWaitingForDeposit
+		watchedQuote := quote.NewWatchedPeginQuote(testPeginQuote, retainedQuote, testCreationData)
+		states := make(chan webhook.Notification)
+		confirmedState := webhook.Notification{
+			QuoteHash: testStreamQuoteHash,
+			Operation: webhook.OperationPegin,
+			State:     string(quote.PeginStateWaitingForDepositConfirmations),
+			Timestamp: time.Unix(1700000000, 0).UTC(),
+		}
+		completedState := confirmedState
+		completedState.State = string(quote.PeginStateCallForUserSucceeded)
+
+		statusUseCase := mocks.NewPeginStatusUseCaseMock(t)
+		statusUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return(watchedQuote, nil).Once()
+		stateUseCase := mocks.NewQuoteStateStreamUseCaseMock(t)
+		stateUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return((<-chan webhook.Notification)(states)).Once()
+		confirmationsUseCase := mocks.NewDepositConfirmationsUseCaseMock(t)
+		confirmationsUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return(quote.DepositConfirmations{RequiredConfirmations: 6}, nil).Once()
+		confirmationsUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return(quote.DepositConfirmations{TxHash: "0x01", Confirmations: 2, RequiredConfirmations: 6}, nil).Once()
+		handler := handlers.NewGetPeginStatusStreamHandler(statusUseCase, stateUseCase, confirmationsUseCase, time.Hour, time.Hour)
+
+		req := httptest.NewRequest(http.MethodGet, "/pegin/status/stream?quoteHash="+testStreamQuoteHash, nil)
+		res := httptest.NewRecorder()
+		done := make(chan struct{})
+		go func() {
+			handler.ServeHTTP(res, req)
+			close(done)
+		}()
+		states <- confirmedState
+		states <- completedState
+		close(states)
+		<-done
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, rest.ContentTypeEventStream, res.Header().Get(rest.HeaderContentType))
+		assert.Equal(t, "no-cache", res.Header().Get("Cache-Control"))
+		events := parseEventStream(t, res.Body.String())
+		require.Len(t, events, 5)
+		assertStreamEvent(t, events[0], handlers.StatusStreamEvent, pkg.PeginQuoteStatusDTO{
+			Detail:       pkg.ToPeginQuoteDTO(testPeginQuote),
+			Status:       pkg.ToRetainedPeginQuoteDTO(retainedQuote),
+			CreationData: pkg.ToPeginCreationDataDTO(testCreationData),
+		})
+		assertStreamEvent(t, events[1], handlers.ConfirmationsStreamEvent, pkg.DepositConfirmationsDTO{QuoteHash: testStreamQuoteHash, RequiredConfirmations: 6})
+		assertStreamEvent(t, events[2], handlers.StateStreamEvent, pkg.ToQuoteStateDTO(confirmedState))
+		assertStreamEvent(t, events[3], handlers.ConfirmationsStreamEvent, pkg.DepositConfirmationsDTO{
+			QuoteHash: testStreamQuoteHash, TxHash: "0x01", Confirmations: 2, RequiredConfirmations: 6,
+		})
+		assertStreamEvent(t, events[4], handlers.StateStreamEvent, pkg.ToQuoteStateDTO(completedState))
+	})
+	t.Run("should poll the confirmations and only send them when they change", func(t *testing.T) {
+		retainedQuote := testRetainedQuote
+		retainedQuote.QuoteHash = testStreamQuoteHash
+		retainedQuote.State = quote.PeginStateWaitingForDepositConfirmations
+		watchedQuote := quote.NewWatchedPeginQuote(testPeginQuote, retainedQuote, testCreationData)
+		var calls atomic.Int32
+
+		statusUseCase := mocks.NewPeginStatusUseCaseMock(t)
+		statusUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return(watchedQuote, nil).Once()
+		stateUseCase := mocks.NewQuoteStateStreamUseCaseMock(t)
+		stateUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return((<-chan webhook.Notification)(make(chan webhook.Notification))).Once()
+		confirmationsUseCase := mocks.NewDepositConfirmationsUseCaseMock(t)
+		confirmationsUseCase.On("Run", mock.Anything, testStreamQuoteHash).
+			Return(quote.DepositConfirmations{TxHash: "0x01", Confirmations: 1, RequiredConfirmations: 6}, nil).
+			Run(func(args mock.Arguments) { calls.Add(1) })
+		handler := handlers.NewGetPeginStatusStreamHandler(statusUseCase, stateUseCase, confirmationsUseCase, time.Millisecond, time.Hour)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		req := httptest.NewRequest(http.MethodGet, "/pegin/status/stream?quoteHash="+testStreamQuoteHash, nil).WithContext(ctx)
+		res := httptest.NewRecorder()
+		done := make(chan struct{})
+		go func() {
+			handler.ServeHTTP(res, req)
+			close(done)
+		}()
+		assert.Eventually(t, func() bool { return calls.Load() >= 3 }, time.Second, time.Millisecond)
+		cancel()
+		<-done
+
+		assert.Contains(t, res.Body.String(), ": ping\n\n")
+		events := parseEventStream(t, res.Body.String())
+		require.Len(t, events, 2)
+		assert.Equal(t, handlers.StatusStreamEvent, events[0].name)
+		assertStreamEvent(t, events[1], handlers.ConfirmationsStreamEvent, pkg.DepositConfirmationsDTO{
+			QuoteHash: testStreamQuoteHash, TxHash: "0x01", Confirmations: 1, RequiredConfirmations: 6,
+		})
+	})
+	t.Run("should close the stream after the max duration", func(t *testing.T) {
+		retainedQuote := testRetainedQuote
+		retainedQuote.QuoteHash = testStreamQuoteHash
+		retainedQuote.State = quote.PeginStateCallForUserSucceeded
+		statusUseCase := mocks.NewPeginStatusUseCaseMock(t)
+		statusUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return(quote.NewWatchedPeginQuote(testPeginQuote, retainedQuote, testCreationData), nil).Once()
+		stateUseCase := mocks.NewQuoteStateStreamUseCaseMock(t)
+		stateUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return((<-chan webhook.Notification)(make(chan webhook.Notification))).Once()
+		handler := handlers.NewGetPeginStatusStreamHandler(statusUseCase, stateUseCase, mocks.NewDepositConfirmationsUseCaseMock(t), time.Hour, 10*time.Millisecond)
+		req := httptest.NewRequest(http.MethodGet, "/pegin/status/stream?quoteHash="+testStreamQuoteHash, nil)
+		res := httptest.NewRecorder()
+		done := make(chan struct{})
+		go func() {
+			handler.ServeHTTP(res, req)
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			require.Fail(t, "stream was not closed after the max duration")
+		}
+		events := parseEventStream(t, res.Body.String())
+		require.Len(t, events, 1)
+		assert.Equal(t, handlers.StatusStreamEvent, events[0].name)
+	})
+	t.Run("should share the confirmations poll between the streams of the same quote", func(t *testing.T) {
+		type streamKey struct{}
+		retainedQuote := testRetainedQuote
+		retainedQuote.QuoteHash = testStreamQuoteHash
+		retainedQuote.State = quote.PeginStateWaitingForDepositConfirmations
+		watchedQuote := quote.NewWatchedPeginQuote(testPeginQuote, retainedQuote, testCreationData)
+		var streamCalls, pollCalls atomic.Int32
+		releasePoll := make(chan struct{})
+
+		statusUseCase := mocks.NewPeginStatusUseCaseMock(t)
+		statusUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return(watchedQuote, nil).Twice()
+		stateUseCase := mocks.NewQuoteStateStreamUseCaseMock(t)
+		stateUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return((<-chan webhook.Notification)(make(chan webhook.Notification))).Twice()
+		confirmationsUseCase := mocks.NewDepositConfirmationsUseCaseMock(t)
+		// the calls of the streams have the request context, the rest are done by the shared poll
+		isStreamCall := func(ctx context.Context) bool { return ctx.Value(streamKey{}) != nil }
+		confirmationsUseCase.On("Run", mock.MatchedBy(isStreamCall), testStreamQuoteHash).
+			Return(quote.DepositConfirmations{TxHash: "0x01", Confirmations: 1, RequiredConfirmations: 6}, nil).
+			Run(func(args mock.Arguments) { streamCalls.Add(1) })
+		confirmationsUseCase.On("Run", mock.MatchedBy(func(ctx context.Context) bool { return !isStreamCall(ctx) }), testStreamQuoteHash).
+			Return(quote.DepositConfirmations{TxHash: "0x01", Confirmations: 3, RequiredConfirmations: 6}, nil).
+			Run(func(args mock.Arguments) {
+				pollCalls.Add(1)
+				<-releasePoll
+			})
+		handler := handlers.NewGetPeginStatusStreamHandler(statusUseCase, stateUseCase, confirmationsUseCase, time.Millisecond, time.Hour)
+
+		ctx, cancel := context.WithCancel(context.WithValue(context.Background(), streamKey{}, true))
+		recorders := []*syncRecorder{{ResponseRecorder: httptest.NewRecorder()}, {ResponseRecorder: httptest.NewRecorder()}}
+		var wg sync.WaitGroup
+		for _, recorder := range recorders {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				req := httptest.NewRequest(http.MethodGet, "/pegin/status/stream?quoteHash="+testStreamQuoteHash, nil).WithContext(ctx)
+				handler.ServeHTTP(recorder, req)
+			}()
+		}
+		assert.Eventually(t, func() bool { return streamCalls.Load() == 2 && pollCalls.Load() == 1 }, time.Second, time.Millisecond)
+		time.Sleep(20 * time.Millisecond)
+		assert.Equal(t, int32(1), pollCalls.Load())
+		close(releasePoll)
+		for _, recorder := range recorders {
+			assert.Eventually(t, func() bool { return strings.Contains(recorder.body(), `"confirmations":3`) }, time.Second, time.Millisecond)
+		}
+		cancel()
+		wg.Wait()
+	})
+}
+
+func TestNewGetPeginStatusStreamHandler_ErrorHandling(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "quote not found", err: usecases.QuoteNotFoundError, status: http.StatusNotFound},
+		{name: "quote not accepted", err: usecases.QuoteNotAcceptedError, status: http.StatusConflict},
+		{name: "unknown error", err: assert.AnError, status: http.StatusInternalServerError},
+	}
+	for _, c := range cases {
+		t.Run("should handle "+c.name, func(t *testing.T) {
+			statusUseCase := mocks.NewPeginStatusUseCaseMock(t)
+			statusUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return(quote.WatchedPeginQuote{}, usecases.WrapUseCaseError(usecases.PeginQuoteStatusId, c.err)).Once()
+			stateUseCase := mocks.NewQuoteStateStreamUseCaseMock(t)
+			stateUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return((<-chan webhook.Notification)(make(chan webhook.Notification))).Once()
+			handler := handlers.NewGetPeginStatusStreamHandler(statusUseCase, stateUseCase, mocks.NewDepositConfirmationsUseCaseMock(t), time.Hour, time.Hour)
+			req := httptest.NewRequest(http.MethodGet, "/pegin/status/stream?quoteHash="+testStreamQuoteHash, nil)
+			res := httptest.NewRecorder()
+			handler.ServeHTTP(res, req)
+			assert.Equal(t, c.status, res.Code)
+			assert.Equal(t, rest.ContentTypeJson, res.Header().Get(rest.HeaderContentType))
+		})
+	}
+	t.Run("should validate the quote hash", func(t *testing.T) {
+		handler := handlers.NewGetPeginStatusStreamHandler(
+			mocks.NewPeginStatusUseCaseMock(t),
+			mocks.NewQuoteStateStreamUseCaseMock(t),
+			mocks.NewDepositConfirmationsUseCaseMock(t),
+			time.Hour,
+			time.Hour,
+		)
+		req := httptest.NewRequest(http.MethodGet, "/pegin/status/stream?quoteHash=invalid", nil)
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
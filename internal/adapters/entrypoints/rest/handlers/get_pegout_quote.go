@@ -22,13 +22,18 @@ type GetPegoutQuoteUseCase interface {
 // @Title Pegout GetQuote
 // @Description Gets Pegout Quote
 // @Param PegoutQuoteRequest body pkg.PegoutQuoteRequest true "Interface with parameters for computing possible quotes for the service"
+// @Param X-Partner-Signature header string false "Hex encoded signature of a trusted account over the timestamp and the request body, used to get a quote with the terms of that account"
+// @Param X-Partner-Timestamp header string false "Unix timestamp in seconds included in the partner signature. Required if X-Partner-Signature is present"
 // @Success 200 array pkg.GetPegoutQuoteResponse The quote structure defines the conditions of a service, and acts as a contract between users and LPs
 // @Route /pegout/getQuotes [post]
 func NewGetPegoutQuoteHandler(useCase GetPegoutQuoteUseCase) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
-		var err error
 		var result pegout.GetPegoutQuoteResult
 		quoteRequest := pkg.PegoutQuoteRequest{}
+		partnerAuth, err := readPartnerAuthentication(w, req)
+		if err != nil {
+			return
+		}
 		if err = rest.DecodeRequest(w, req, &quoteRequest); err != nil {
 			return
 		} else if err = rest.ValidateRequest(w, &quoteRequest); err != nil {
@@ -41,8 +46,14 @@ func NewGetPegoutQuoteHandler(useCase GetPegoutQuoteUseCase) http.HandlerFunc {
 			quoteRequest.RskRefundAddress,
 		)
 
+		if partnerAuth != nil {
+			pegoutRequest = pegoutRequest.WithPartnerAuthentication(*partnerAuth)
+		}
+
 		result, err = useCase.Run(req.Context(), pegoutRequest)
-		if isGetPegoutQuoteBadRequest(err) {
+		if handlePartnerAuthenticationError(w, err) {
+			return
+		} else if isGetPegoutQuoteBadRequest(err) {
 			jsonErr := rest.NewErrorResponseWithDetails("invalid request", rest.DetailsFromError(err), true)
 			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
 			return
@@ -484,3 +484,37 @@ func createTestPegoutQuote() quote.PegoutQuote {
 		ChainId:               31,
 	}
 }
+
+func TestGetPegoutQuoteHandlerPartnerAuthentication(t *testing.T) {
+	reqBody := createValidPegoutQuoteRequest()
+	jsonBody, err := json.Marshal(reqBody)
+	require.NoError(t, err)
+	newRequest := func() *http.Request {
+		request := httptest.NewRequest(http.MethodPost, "/pegout/getQuotes", bytes.NewBuffer(jsonBody))
+		request.Header.Set("Content-Type", "application/json")
+		request.Header.Set(handlers.PartnerSignatureHeader, "0102")
+		request.Header.Set(handlers.PartnerTimestampHeader, "1700000000")
+		return request
+	}
+
+	t.Run("should forward the partner authentication to the use case", func(t *testing.T) {
+		expectedRequest := pegout.NewQuoteRequest(reqBody.To, entities.NewBigWei(reqBody.ValueToTransfer), reqBody.RskRefundAddress).
+			WithPartnerAuthentication(usecases.PartnerAuthentication{Signature: "0102", Timestamp: 1700000000, Payload: jsonBody})
+		mockUseCase := new(mocks.GetPegoutQuoteUseCaseMock)
+		mockUseCase.On("Run", mock.Anything, expectedRequest).
+			Return(pegout.GetPegoutQuoteResult{PegoutQuote: createTestPegoutQuote(), Hash: test.AnyHash}, nil).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewGetPegoutQuoteHandler(mockUseCase).ServeHTTP(recorder, newRequest())
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("should return 401 on InvalidPartnerAuthError", func(t *testing.T) {
+		mockUseCase := new(mocks.GetPegoutQuoteUseCaseMock)
+		mockUseCase.On("Run", mock.Anything, mock.AnythingOfType("pegout.QuoteRequest")).
+			Return(pegout.GetPegoutQuoteResult{}, usecases.InvalidPartnerAuthError).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewGetPegoutQuoteHandler(mockUseCase).ServeHTTP(recorder, newRequest())
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegout"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+)
+
+type GetPegoutQuotesUseCase interface {
+	Run(ctx context.Context, request pegout.BatchQuoteRequest) ([]pegout.GetPegoutQuoteBatchResult, error)
+	MaxBatchSize() uint64
+}
+
+// NewGetPegoutQuotesHandler
+// @Title Pegout GetQuotes
+// @Description Gets a Pegout Quote for each request of a batch. The response has one element per request in the same order, each element has either the quote or the reason why it couldn't be created. If the body is a single request instead of an array the endpoint keeps its legacy behavior and returns an array with only that quote
+// @Param PegoutQuoteRequest body []pkg.PegoutQuoteRequest true "Array of requests with the parameters for computing the quotes"
+// @Param X-Partner-Signature header string false "Hex encoded signature of a trusted account over the timestamp and the request body, used to get the quotes with the terms of that account"
+// @Param X-Partner-Timestamp header string false "Unix timestamp in seconds included in the partner signature. Required if X-Partner-Signature is present"
+// @Success 200 array pkg.PegoutQuoteBatchItem Result of each request of the batch
+// @Route /pegout/getQuotes [post]
+func NewGetPegoutQuotesHandler(singleUseCase GetPegoutQuoteUseCase, batchUseCase GetPegoutQuotesUseCase) http.HandlerFunc {
+	singleHandler := NewGetPegoutQuoteHandler(singleUseCase)
+	return func(w http.ResponseWriter, req *http.Request) {
+		var quoteRequests []pkg.PegoutQuoteRequest
+		if isBatch, err := isQuoteBatchRequest(w, req); err != nil {
+			return
+		} else if !isBatch {
+			singleHandler(w, req)
+			return
+		}
+		partnerAuth, err := readPartnerAuthentication(w, req)
+		if err != nil {
+			return
+		}
+		if err = rest.DecodeRequest(w, req, &quoteRequests); err != nil {
+			return
+		} else if err = validateQuoteBatchSize(w, len(quoteRequests), batchUseCase.MaxBatchSize()); err != nil {
+			return
+		}
+
+		responseBody := make([]pkg.PegoutQuoteBatchItem, len(quoteRequests))
+		pegoutRequests := make([]pegout.QuoteRequest, 0, len(quoteRequests))
+		positions := make([]int, 0, len(quoteRequests))
+		for i := range quoteRequests {
+			if validationErr := rest.RequestValidator.Struct(&quoteRequests[i]); validationErr != nil {
+				responseBody[i].Error = quoteBatchItemError(validationErr, isGetPegoutQuoteBadRequest)
+				continue
+			}
+			pegoutRequests = append(pegoutRequests, pegout.NewQuoteRequest(
+				quoteRequests[i].To,
+				entities.NewBigWei(quoteRequests[i].ValueToTransfer),
+				quoteRequests[i].RskRefundAddress,
+			))
+			positions = append(positions, i)
+		}
+		if len(pegoutRequests) == 0 {
+			rest.JsonResponseWithBody(w, http.StatusOK, &responseBody)
+			return
+		}
+
+		batchRequest := pegout.NewBatchQuoteRequest(pegoutRequests)
+		if partnerAuth != nil {
+			batchRequest = batchRequest.WithPartnerAuthentication(*partnerAuth)
+		}
+		results, err := batchUseCase.Run(req.Context(), batchRequest)
+		if err != nil {
+			handleQuoteBatchError(w, err)
+			return
+		}
+		for i, result := range results {
+			item := &responseBody[positions[i]]
+			if result.Error != nil {
+				item.Error = quoteBatchItemError(result.Error, isGetPegoutQuoteBadRequest)
+				continue
+			}
+			quoteDto := pkg.ToPegoutQuoteDTO(result.Result.PegoutQuote)
+			item.Quote = &quoteDto
+			item.QuoteHash = result.Result.Hash
+		}
+		rest.JsonResponseWithBody(w, http.StatusOK, &responseBody)
+	}
+}
@@ -0,0 +1,128 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegout"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const getPegoutQuotesPath = "/pegout/getQuotes"
+
+// nolint:funlen
+func TestGetPegoutQuotesHandler_Batch(t *testing.T) {
+	requests := []pkg.PegoutQuoteRequest{
+		{To: test.AnyBtcAddress, ValueToTransfer: big.NewInt(1000), RskRefundAddress: test.AnyRskAddress},
+		{To: test.AnyBtcAddress, ValueToTransfer: big.NewInt(2000), RskRefundAddress: test.AnyRskAddress},
+		{To: test.AnyBtcAddress, RskRefundAddress: test.AnyRskAddress},
+		{To: test.AnyBtcAddress, ValueToTransfer: big.NewInt(3000), RskRefundAddress: test.AnyRskAddress},
+	}
+	body, err := json.Marshal(requests)
+	require.NoError(t, err)
+	partnerAuth := usecases.PartnerAuthentication{Signature: "0x1234", Timestamp: 1700000000, Payload: body}
+	expectedRequest := pegout.NewBatchQuoteRequest([]pegout.QuoteRequest{
+		pegout.NewQuoteRequest(test.AnyBtcAddress, entities.NewWei(1000), test.AnyRskAddress),
+		pegout.NewQuoteRequest(test.AnyBtcAddress, entities.NewWei(2000), test.AnyRskAddress),
+		pegout.NewQuoteRequest(test.AnyBtcAddress, entities.NewWei(3000), test.AnyRskAddress),
+	}).WithPartnerAuthentication(partnerAuth)
+
+	singleUseCase := mocks.NewGetPegoutQuoteUseCaseMock(t)
+	batchUseCase := mocks.NewGetPegoutQuotesUseCaseMock(t)
+	batchUseCase.EXPECT().MaxBatchSize().Return(10)
+	batchUseCase.EXPECT().Run(mock.Anything, expectedRequest).Return([]pegout.GetPegoutQuoteBatchResult{
+		{Error: usecases.WrapUseCaseError(usecases.GetPegoutQuoteId, usecases.NoLiquidityError)},
+		{Result: pegout.GetPegoutQuoteResult{PegoutQuote: createTestPegoutQuote(), Hash: test.AnyHash}},
+		{Error: usecases.WrapUseCaseError(usecases.GetPegoutQuoteId, assert.AnError)},
+	}, nil).Once()
+
+	request := httptest.NewRequest(http.MethodPost, getPegoutQuotesPath, bytes.NewReader(body))
+	request.Header.Set(handlers.PartnerSignatureHeader, partnerAuth.Signature)
+	request.Header.Set(handlers.PartnerTimestampHeader, "1700000000")
+	recorder := httptest.NewRecorder()
+	handlers.NewGetPegoutQuotesHandler(singleUseCase, batchUseCase).ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var response []pkg.PegoutQuoteBatchItem
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+	require.Len(t, response, 4)
+	require.NotNil(t, response[0].Error)
+	assert.Equal(t, "no enough liquidity", response[0].Error.Message)
+	assert.True(t, response[0].Error.Recoverable)
+	assert.Equal(t, test.AnyHash, response[1].QuoteHash)
+	require.NotNil(t, response[1].Quote)
+	assert.Equal(t, createTestPegoutQuote().LpRskAddress, response[1].Quote.LPRSKAddr)
+	require.NotNil(t, response[2].Error)
+	assert.Equal(t, "validation error", response[2].Error.Message)
+	assert.Contains(t, response[2].Error.Details, "ValueToTransfer")
+	require.NotNil(t, response[3].Error)
+	assert.Equal(t, handlers.UnknownErrorMessage, response[3].Error.Message)
+	assert.False(t, response[3].Error.Recoverable)
+}
+
+func TestGetPegoutQuotesHandler_SingleRequest(t *testing.T) {
+	body, err := json.Marshal(pkg.PegoutQuoteRequest{To: test.AnyBtcAddress, ValueToTransfer: big.NewInt(1000), RskRefundAddress: test.AnyRskAddress})
+	require.NoError(t, err)
+	singleUseCase := mocks.NewGetPegoutQuoteUseCaseMock(t)
+	batchUseCase := mocks.NewGetPegoutQuotesUseCaseMock(t)
+	singleUseCase.EXPECT().Run(mock.Anything, mock.AnythingOfType("pegout.QuoteRequest")).
+		Return(pegout.GetPegoutQuoteResult{PegoutQuote: createTestPegoutQuote(), Hash: test.AnyHash}, nil).Once()
+
+	request := httptest.NewRequest(http.MethodPost, getPegoutQuotesPath, bytes.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handlers.NewGetPegoutQuotesHandler(singleUseCase, batchUseCase).ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var response []pkg.GetPegoutQuoteResponse
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+	require.Len(t, response, 1)
+	assert.Equal(t, test.AnyHash, response[0].QuoteHash)
+}
+
+func TestGetPegoutQuotesHandler_ErrorHandling(t *testing.T) {
+	body, err := json.Marshal([]pkg.PegoutQuoteRequest{{To: test.AnyBtcAddress, ValueToTransfer: big.NewInt(1000), RskRefundAddress: test.AnyRskAddress}})
+	require.NoError(t, err)
+	t.Run("should return 400 if the batch is bigger than the max size", func(t *testing.T) {
+		twoRequests, marshalErr := json.Marshal([]pkg.PegoutQuoteRequest{{}, {}})
+		require.NoError(t, marshalErr)
+		batchUseCase := mocks.NewGetPegoutQuotesUseCaseMock(t)
+		batchUseCase.EXPECT().MaxBatchSize().Return(1)
+		request := httptest.NewRequest(http.MethodPost, getPegoutQuotesPath, bytes.NewReader(twoRequests))
+		recorder := httptest.NewRecorder()
+		handlers.NewGetPegoutQuotesHandler(mocks.NewGetPegoutQuoteUseCaseMock(t), batchUseCase).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "invalid batch size")
+	})
+	t.Run("should return 503 if the protocol is paused", func(t *testing.T) {
+		batchUseCase := mocks.NewGetPegoutQuotesUseCaseMock(t)
+		batchUseCase.EXPECT().MaxBatchSize().Return(10)
+		batchUseCase.EXPECT().Run(mock.Anything, mock.Anything).Return(nil, usecases.WrapUseCaseError(usecases.GetPegoutQuotesId, blockchain.ContractPausedError)).Once()
+		request := httptest.NewRequest(http.MethodPost, getPegoutQuotesPath, bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+		handlers.NewGetPegoutQuotesHandler(mocks.NewGetPegoutQuoteUseCaseMock(t), batchUseCase).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "protocol is paused")
+	})
+	t.Run("should return 400 if the partner timestamp is invalid", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, getPegoutQuotesPath, bytes.NewReader(body))
+		request.Header.Set(handlers.PartnerSignatureHeader, "0x1234")
+		request.Header.Set(handlers.PartnerTimestampHeader, "not a number")
+		recorder := httptest.NewRecorder()
+		handlers.NewGetPegoutQuotesHandler(mocks.NewGetPegoutQuoteUseCaseMock(t), mocks.NewGetPegoutQuotesUseCaseMock(t)).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "invalid "+handlers.PartnerTimestampHeader+" header")
+	})
+}
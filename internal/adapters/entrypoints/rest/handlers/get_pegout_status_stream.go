@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+)
+
+// NewGetPegoutStatusStreamHandler
+// @Title GetPegoutStatusStream
+// @Description Streams the status of an accepted pegout quote using Server-Sent Events. The first event (status) has the same content as /pegout/status, then a state event is sent on every state transition and a confirmations event every time the confirmations of the user RBTC deposit change
+// @Param quoteHash query string true "Hash of the quote"
+// @Success 200 {object} pkg.PegoutQuoteStatusDTO "Stream of status, state (pkg.QuoteStateDTO) and confirmations (pkg.DepositConfirmationsDTO) events"
+// @Router /pegout/status/stream [get]
+func NewGetPegoutStatusStreamHandler(
+	statusUseCase PegoutStatusUseCase,
+	stateUseCase QuoteStateStreamUseCase,
+	confirmationsUseCase DepositConfirmationsUseCase,
+	pollInterval time.Duration,
+	maxDuration time.Duration,
+) http.HandlerFunc {
+	confirmationsPoller := newDepositConfirmationsPoller(confirmationsUseCase, pollInterval)
+	return func(w http.ResponseWriter, req *http.Request) {
+		quoteHash, err := validateStreamQuoteHash(w, req)
+		if err != nil {
+			return
+		}
+		ctx, cancel := context.WithTimeout(req.Context(), maxDuration)
+		defer cancel()
+		states := stateUseCase.Run(ctx, quoteHash)
+		result, err := statusUseCase.Run(ctx, quoteHash)
+		if err != nil {
+			handleQuoteStatusError(w, err)
+			return
+		}
+		status := pkg.PegoutQuoteStatusDTO{
+			Detail:       pkg.ToPegoutQuoteDTO(result.PegoutQuote),
+			Status:       pkg.ToRetainedPegoutQuoteDTO(result.RetainedQuote),
+			CreationData: pkg.ToPegoutCreationDataDTO(result.CreationData),
+		}
+		streamQuoteStatus(ctx, w, quoteStatusStream{
+			quoteHash:            quoteHash,
+			state:                string(result.RetainedQuote.State),
+			waitingStates:        []string{string(quote.PegoutStateWaitingForDeposit), string(quote.PegoutStateWaitingForDepositConfirmations)},
+			confirmationsUseCase: confirmationsUseCase,
+			confirmationsPoller:  confirmationsPoller,
+			pollInterval:         pollInterval,
+		}, status, states)
+	}
+}
@@ -0,0 +1,89 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetPegoutStatusStreamHandler(t *testing.T) {
+	t.Run("should send the status and the state transitions", func(t *testing.T) {
+		retainedQuote := testRetainedPegoutQuote
+		retainedQuote.QuoteHash = testStreamQuoteHash
+		retainedQuote.State = quote.PegoutStateWaitingForDepositConfirmations
+		watchedQuote := quote.NewWatchedPegoutQuote(testPegoutQuote, retainedQuote, testPegoutCreationData)
+		states := make(chan webhook.Notification)
+		notification := webhook.Notification{
+			QuoteHash: testStreamQuoteHash,
+			Operation: webhook.OperationPegout,
+			State:     string(quote.PegoutStateSendPegoutSucceeded),
+			Timestamp: time.Unix(1700000000, 0).UTC(),
+		}
+		confirmations := quote.DepositConfirmations{TxHash: retainedQuote.UserRskTxHash, Confirmations: 3, RequiredConfirmations: 6}
+
+		statusUseCase := mocks.NewPegoutStatusUseCaseMock(t)
+		statusUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return(watchedQuote, nil).Once()
+		stateUseCase := mocks.NewQuoteStateStreamUseCaseMock(t)
+		stateUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return((<-chan webhook.Notification)(states)).Once()
+		confirmationsUseCase := mocks.NewDepositConfirmationsUseCaseMock(t)
+		confirmationsUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return(confirmations, nil).Once()
+		handler := handlers.NewGetPegoutStatusStreamHandler(statusUseCase, stateUseCase, confirmationsUseCase, time.Hour, time.Hour)
+
+		req := httptest.NewRequest(http.MethodGet, "/pegout/status/stream?quoteHash="+testStreamQuoteHash, nil)
+		res := httptest.NewRecorder()
+		done := make(chan struct{})
+		go func() {
+			handler.ServeHTTP(res, req)
+			close(done)
+		}()
+		states <- notification
+		close(states)
+		<-done
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		events := parseEventStream(t, res.Body.String())
+		require.Len(t, events, 3)
+		assertStreamEvent(t, events[0], handlers.StatusStreamEvent, pkg.PegoutQuoteStatusDTO{
+			Detail:       pkg.ToPegoutQuoteDTO(testPegoutQuote),
+			Status:       pkg.ToRetainedPegoutQuoteDTO(retainedQuote),
+			CreationData: pkg.ToPegoutCreationDataDTO(testPegoutCreationData),
+		})
+		assertStreamEvent(t, events[1], handlers.ConfirmationsStreamEvent, pkg.ToDepositConfirmationsDTO(testStreamQuoteHash, confirmations))
+		assertStreamEvent(t, events[2], handlers.StateStreamEvent, pkg.ToQuoteStateDTO(notification))
+	})
+	t.Run("should handle quote not found", func(t *testing.T) {
+		statusUseCase := mocks.NewPegoutStatusUseCaseMock(t)
+		statusUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return(quote.WatchedPegoutQuote{}, usecases.WrapUseCaseError(usecases.PegoutQuoteStatusId, usecases.QuoteNotFoundError)).Once()
+		stateUseCase := mocks.NewQuoteStateStreamUseCaseMock(t)
+		stateUseCase.On("Run", mock.Anything, testStreamQuoteHash).Return((<-chan webhook.Notification)(make(chan webhook.Notification))).Once()
+		handler := handlers.NewGetPegoutStatusStreamHandler(statusUseCase, stateUseCase, mocks.NewDepositConfirmationsUseCaseMock(t), time.Hour, time.Hour)
+		req := httptest.NewRequest(http.MethodGet, "/pegout/status/stream?quoteHash="+testStreamQuoteHash, nil)
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+	t.Run("should validate the quote hash", func(t *testing.T) {
+		handler := handlers.NewGetPegoutStatusStreamHandler(
+			mocks.NewPegoutStatusUseCaseMock(t),
+			mocks.NewQuoteStateStreamUseCaseMock(t),
+			mocks.NewDepositConfirmationsUseCaseMock(t),
+			time.Hour,
+			time.Hour,
+		)
+		req := httptest.NewRequest(http.MethodGet, "/pegout/status/stream", nil)
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
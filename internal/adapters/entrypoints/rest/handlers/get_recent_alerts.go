@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+)
+
+type GetRecentAlertsUseCase interface {
+	Run(ctx context.Context, limit uint64) ([]alerts.AlertRecord, error)
+}
+
+// NewGetRecentAlertsHandler
+// @Title Get Recent Alerts
+// @Description Returns the most recent entries of the alert history, newest first
+// @Param limit query int false "Maximum number of alerts to return (max: 500, default: 50)"
+// @Success 200 object pkg.RecentAlertsResponse
+// @Route /management/alerts [get]
+func NewGetRecentAlertsHandler(useCase GetRecentAlertsUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var limit uint64
+		var err error
+		if limitParam := req.URL.Query().Get("limit"); limitParam != "" {
+			if limit, err = strconv.ParseUint(limitParam, 10, 64); err != nil {
+				jsonErr := rest.NewErrorResponse("invalid limit parameter: "+err.Error(), true)
+				rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+				return
+			}
+		}
+
+		records, err := useCase.Run(req.Context(), limit)
+		if errors.Is(err, liquidity_provider.InvalidAlertsLimitError) {
+			jsonErr := rest.NewErrorResponse(liquidity_provider.InvalidAlertsLimitError.Error(), true)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		} else if err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails(UnknownErrorMessage, rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+			return
+		}
+		response := pkg.ToRecentAlertsResponse(records)
+		rest.JsonResponseWithBody(w, http.StatusOK, &response)
+	}
+}
@@ -0,0 +1,108 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestNewGetRecentAlertsHandler(t *testing.T) {
+	timestamp := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	records := []alerts.AlertRecord{
+		{
+			Alert: alerts.Alert{
+				Kind:      alerts.AlertKindPeginOutOfLiquidity,
+				Subject:   alerts.AlertSubjectPeginOutOfLiquidity,
+				Severity:  alerts.AlertSeverityCritical,
+				Status:    alerts.AlertStatusFiring,
+				Message:   "out of liquidity",
+				Amounts:   map[string]*entities.Wei{alerts.AlertAmountRequiredLiquidity: entities.NewWei(1000)},
+				Timestamp: timestamp,
+			},
+			DedupKey:   "pegin_out_of_liquidity",
+			Recipients: []string{"test@test.com"},
+			Delivered:  false,
+			Error:      "some error",
+		},
+	}
+	t.Run("should return recent alerts", func(t *testing.T) {
+		useCase := &mocks.GetRecentAlertsUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything, uint64(20)).Return(records, nil).Once()
+		request := httptest.NewRequest(http.MethodGet, "/management/alerts?limit=20", nil)
+		recorder := httptest.NewRecorder()
+		handlers.NewGetRecentAlertsHandler(useCase).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response pkg.RecentAlertsResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		require.Len(t, response.Alerts, 1)
+		assert.Equal(t, pkg.AlertDTO{
+			Kind:       "pegin_out_of_liquidity",
+			Subject:    alerts.AlertSubjectPeginOutOfLiquidity,
+			Severity:   "critical",
+			Status:     "firing",
+			Message:    "out of liquidity",
+			Amounts:    map[string]*big.Int{"requiredLiquidity": big.NewInt(1000)},
+			Timestamp:  timestamp,
+			Recipients: []string{"test@test.com"},
+			Delivered:  false,
+			Error:      "some error",
+		}, response.Alerts[0])
+		useCase.AssertExpectations(t)
+	})
+	t.Run("should use default limit when not provided", func(t *testing.T) {
+		useCase := &mocks.GetRecentAlertsUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything, uint64(0)).Return([]alerts.AlertRecord{}, nil).Once()
+		request := httptest.NewRequest(http.MethodGet, "/management/alerts", nil)
+		recorder := httptest.NewRecorder()
+		handlers.NewGetRecentAlertsHandler(useCase).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.JSONEq(t, `{"alerts":[]}`, recorder.Body.String())
+		useCase.AssertExpectations(t)
+	})
+	t.Run("should return 400 on invalid limit", func(t *testing.T) {
+		for _, limit := range []string{"abc", "-1"} {
+			useCase := &mocks.GetRecentAlertsUseCaseMock{}
+			request := httptest.NewRequest(http.MethodGet, "/management/alerts?limit="+limit, nil)
+			recorder := httptest.NewRecorder()
+			handlers.NewGetRecentAlertsHandler(useCase).ServeHTTP(recorder, request)
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			useCase.AssertNotCalled(t, "Run")
+		}
+		useCase := &mocks.GetRecentAlertsUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything, uint64(501)).
+			Return(nil, usecases.WrapUseCaseError(usecases.GetRecentAlertsId, liquidity_provider.InvalidAlertsLimitError)).Once()
+		request := httptest.NewRequest(http.MethodGet, "/management/alerts?limit=501", nil)
+		recorder := httptest.NewRecorder()
+		handlers.NewGetRecentAlertsHandler(useCase).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		useCase.AssertExpectations(t)
+	})
+	t.Run("should return 500 on unexpected error", func(t *testing.T) {
+		useCase := &mocks.GetRecentAlertsUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		request := httptest.NewRequest(http.MethodGet, "/management/alerts", nil)
+		recorder := httptest.NewRecorder()
+		handlers.NewGetRecentAlertsHandler(useCase).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		var response rest.ErrorResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		assert.Equal(t, handlers.UnknownErrorMessage, response.Message)
+		useCase.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	log "github.com/sirupsen/logrus"
+)
+
+type GetReportSnapshotsUseCase interface {
+	Run(ctx context.Context, reportType reporting.ReportType, startDate, endDate time.Time) ([]reporting.Snapshot, error)
+}
+
+// NewGetReportSnapshotsHandler
+// @Title Get report snapshots
+// @Description Get the daily and weekly snapshots of a report whose period starts in the specified range. The snapshots are generated periodically if REPORT_SNAPSHOTS_ENABLED is set.
+// @Param type query string true "Report of the snapshots: summaries, revenue or assets"
+// @Param startDate query string true "Start date of the range. Supports YYYY-MM-DD (expands to full day) or ISO 8601 format (YYYY-MM-DDTHH:mm:ssZ)"
+// @Param endDate query string true "End date of the range. Supports YYYY-MM-DD (expands to end of day) or ISO 8601 format (YYYY-MM-DDTHH:mm:ssZ)"
+// @Success 200 {object} pkg.GetReportSnapshotsResponse
+// @Route /reports/snapshots [get]
+func NewGetReportSnapshotsHandler(useCase GetReportSnapshotsUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var requestParams pkg.GetReportSnapshotsRequest
+		var err error
+		requestParams.Type = req.URL.Query().Get("type")
+		requestParams.StartDate = req.URL.Query().Get("startDate")
+		requestParams.EndDate = req.URL.Query().Get("endDate")
+
+		// callback function signature comes from the std lib we can't modify it
+		// nolint:contextcheck
+		if err = rest.ValidateRequest(w, &requestParams); err != nil {
+			return
+		}
+
+		if err = requestParams.ValidateDateRange(); err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails("Validation error", rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		}
+
+		startTime, endTime, err := requestParams.GetTimestamps()
+		if err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails("Date conversion error", rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		}
+
+		snapshots, err := useCase.Run(req.Context(), reporting.ReportType(requestParams.Type), startTime, endTime)
+		if err != nil {
+			log.Error("Unknown error: ", err)
+			jsonErr := rest.NewErrorResponseWithDetails(UnknownErrorMessage, rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+			return
+		}
+
+		response := pkg.GetReportSnapshotsResponse{Snapshots: make([]pkg.ReportSnapshotDTO, 0, len(snapshots))}
+		for _, snapshot := range snapshots {
+			response.Snapshots = append(response.Snapshots, pkg.ReportSnapshotDTO{
+				Id:          snapshot.Id,
+				Schedule:    string(snapshot.Schedule),
+				ReportType:  string(snapshot.ReportType),
+				PeriodStart: snapshot.PeriodStart,
+				PeriodEnd:   snapshot.PeriodEnd,
+				CreatedAt:   snapshot.CreatedAt,
+				Content:     snapshot.Content,
+				DeliveredAt: snapshot.DeliveredAt,
+			})
+		}
+		rest.JsonResponseWithBody(w, http.StatusOK, &response)
+	}
+}
@@ -0,0 +1,106 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetReportSnapshotsHandler(t *testing.T) {
+	const validQuery = "type=revenue&startDate=2024-01-01&endDate=2024-01-31"
+	tests := []struct {
+		name      string
+		query     string
+		mockSetup func(useCase *mocks.GetReportSnapshotsUseCaseMock)
+		result    int
+	}{
+		{
+			name:      "should return 400 if the report type is missing",
+			query:     "startDate=2024-01-01&endDate=2024-01-31",
+			mockSetup: func(useCase *mocks.GetReportSnapshotsUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:      "should return 400 if the report type is invalid",
+			query:     "type=pegin&startDate=2024-01-01&endDate=2024-01-31",
+			mockSetup: func(useCase *mocks.GetReportSnapshotsUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:      "should return 400 if the date range is invalid",
+			query:     "type=revenue&startDate=2024-01-31&endDate=2024-01-01",
+			mockSetup: func(useCase *mocks.GetReportSnapshotsUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:  "should return 500 on unexpected errors",
+			query: validQuery,
+			mockSetup: func(useCase *mocks.GetReportSnapshotsUseCaseMock) {
+				useCase.EXPECT().Run(mock.Anything, reporting.ReportTypeRevenue, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+			},
+			result: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			useCase := mocks.NewGetReportSnapshotsUseCaseMock(t)
+			tc.mockSetup(useCase)
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/snapshots?"+tc.query, nil)
+			require.NoError(t, err)
+			rr := httptest.NewRecorder()
+			handlers.NewGetReportSnapshotsHandler(useCase).ServeHTTP(rr, req)
+			assert.Equal(t, tc.result, rr.Code)
+		})
+	}
+
+	t.Run("should return the snapshots of the range", func(t *testing.T) {
+		deliveredAt := time.Date(2024, 1, 8, 0, 10, 5, 0, time.UTC)
+		snapshot := reporting.Snapshot{
+			Id:          "weekly:revenue:2024-01-01",
+			Schedule:    reporting.ScheduleWeekly,
+			ReportType:  reporting.ReportTypeRevenue,
+			PeriodStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			PeriodEnd:   time.Date(2024, 1, 7, 23, 59, 59, 999999999, time.UTC),
+			CreatedAt:   time.Date(2024, 1, 8, 0, 10, 0, 0, time.UTC),
+			Content:     json.RawMessage(`{"totalQuoteCallFees":1000}`),
+			DeliveredAt: &deliveredAt,
+		}
+		useCase := mocks.NewGetReportSnapshotsUseCaseMock(t)
+		useCase.EXPECT().Run(
+			mock.Anything,
+			reporting.ReportTypeRevenue,
+			time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 31, 23, 59, 59, 999999999, time.UTC),
+		).Return([]reporting.Snapshot{snapshot}, nil).Once()
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/snapshots?"+validQuery, nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		handlers.NewGetReportSnapshotsHandler(useCase).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var response pkg.GetReportSnapshotsResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		assert.Equal(t, pkg.GetReportSnapshotsResponse{Snapshots: []pkg.ReportSnapshotDTO{{
+			Id:          snapshot.Id,
+			Schedule:    "weekly",
+			ReportType:  "revenue",
+			PeriodStart: snapshot.PeriodStart,
+			PeriodEnd:   snapshot.PeriodEnd,
+			CreatedAt:   snapshot.CreatedAt,
+			Content:     snapshot.Content,
+			DeliveredAt: &deliveredAt,
+		}}}, response)
+	})
+}
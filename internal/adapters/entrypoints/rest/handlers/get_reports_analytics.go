@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+)
+
+type GetAnalyticsReportUseCase interface {
+	Run(ctx context.Context, period quote.AnalyticsPeriod, startDate, endDate time.Time) (reports.GetAnalyticsReportResult, error)
+}
+
+// NewGetReportsAnalyticsHandler
+// @Title Get analytics report
+// @Description Get the quote counts, amounts, revenue and net profit for the specified period bucketed by day, week or month, direction and trusted account owner.
+// @Param startDate query string true "Start date for the report. Supports YYYY-MM-DD (expands to full day) or ISO 8601 format (YYYY-MM-DDTHH:mm:ssZ)"
+// @Param endDate query string true "End date for the report. Supports YYYY-MM-DD (expands to end of day) or ISO 8601 format (YYYY-MM-DDTHH:mm:ssZ)"
+// @Param period query string false "Length of the buckets: day (default), week or month. Periods are in UTC and weeks start on monday"
+// @Param format query string false "Response format: json (default), csv or xlsx. Can also be selected with the Accept header (text/csv or application/vnd.openxmlformats-officedocument.spreadsheetml.sheet). Amounts in csv and xlsx are expressed in RBTC/BTC"
+// @Success 200 pkg.GetAnalyticsReportResponse
+// @Route /reports/analytics [get]
+func NewGetReportsAnalyticsHandler(
+	singleFlightGroup *singleflight.Group,
+	singleFlightKey string,
+	useCase GetAnalyticsReportUseCase,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var requestParams pkg.GetAnalyticsReportRequest
+		var err error
+		requestParams.StartDate = req.URL.Query().Get("startDate")
+		requestParams.EndDate = req.URL.Query().Get("endDate")
+		requestParams.Period = req.URL.Query().Get("period")
+		if requestParams.Period == "" {
+			requestParams.Period = string(quote.AnalyticsPeriodDay)
+		}
+		format, ok := getReportResponseFormat(w, req)
+		if !ok {
+			return
+		}
+
+		// callback function signature comes from the std lib we can't modify it
+		// nolint:contextcheck
+		if err = rest.ValidateRequest(w, &requestParams); err != nil {
+			return
+		}
+
+		if err = requestParams.ValidateDateRange(); err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails("Validation error", rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		}
+
+		startTime, endTime, err := requestParams.GetTimestamps()
+		if err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails("Date conversion error", rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		}
+
+		rawAnalyticsReport, err, shared := singleFlightGroup.Do(
+			CalculateSingleFlightKey(singleFlightKey, req),
+			// callback function signature comes from the std lib, we can't modify it
+			// nolint:contextcheck
+			func() (any, error) {
+				return useCase.Run(req.Context(), quote.AnalyticsPeriod(requestParams.Period), startTime, endTime)
+			})
+		analyticsReport, ok := rawAnalyticsReport.(reports.GetAnalyticsReportResult)
+		if !ok {
+			jsonErr := rest.NewErrorResponse("Internal error parsing result", false)
+			rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+			return
+		} else if shared {
+			log.Info("GetAnalyticsReport result was shared with multiple requests")
+		}
+
+		if err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails(UnknownErrorMessage, rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+			return
+		}
+
+		response := pkg.GetAnalyticsReportResponse{
+			Period:  string(analyticsReport.Period),
+			Buckets: make([]pkg.AnalyticsBucketDTO, 0, len(analyticsReport.Buckets)),
+		}
+		for _, bucket := range analyticsReport.Buckets {
+			response.Buckets = append(response.Buckets, pkg.AnalyticsBucketDTO{
+				PeriodStart:          bucket.PeriodStart,
+				Direction:            string(bucket.Direction),
+				OwnerAccountAddress:  bucket.OwnerAccountAddress,
+				TotalQuotesCount:     bucket.TotalQuotesCount,
+				AcceptedQuotesCount:  bucket.AcceptedQuotesCount,
+				AcceptedQuotesAmount: bucket.AcceptedQuotesAmount.AsBigInt(),
+				PaidQuotesCount:      bucket.PaidQuotesCount,
+				PaidQuotesAmount:     bucket.PaidQuotesAmount.AsBigInt(),
+				RefundedQuotesCount:  bucket.RefundedQuotesCount,
+				RefundedQuotesAmount: bucket.RefundedQuotesAmount.AsBigInt(),
+				PenalizationsCount:   bucket.PenalizationsCount,
+				PenalizationsAmount:  bucket.PenalizationsAmount.AsBigInt(),
+				CallFees:             bucket.CallFees.AsBigInt(),
+				GasFeesCollected:     bucket.GasFeesCollected.AsBigInt(),
+				GasSpent:             bucket.GasSpent.AsBigInt(),
+				BtcFeesPaid:          bucket.BtcFeesPaid.AsBigInt(),
+				NetProfit:            bucket.NetProfit.AsBigInt(),
+			})
+		}
+		if format != rest.ResponseFormatJson {
+			rest.TableResponse(w, format, analyticsReportFileName, analyticsReportTable(response))
+			return
+		}
+		rest.JsonResponseWithBody(w, http.StatusOK, &response)
+	}
+}
@@ -0,0 +1,180 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var analyticsReport = reports.GetAnalyticsReportResult{
+	Period: quote.AnalyticsPeriodWeek,
+	Buckets: []reports.AnalyticsReportBucket{
+		{
+			AnalyticsBucket: quote.AnalyticsBucket{
+				PeriodStart:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				OwnerAccountAddress:  test.AnyRskAddress,
+				TotalQuotesCount:     3,
+				AcceptedQuotesCount:  2,
+				AcceptedQuotesAmount: entities.NewWei(2000000000000000000),
+				PaidQuotesCount:      2,
+				PaidQuotesAmount:     entities.NewWei(2000000000000000000),
+				RefundedQuotesCount:  1,
+				RefundedQuotesAmount: entities.NewWei(1100000000000000000),
+				PenalizationsCount:   0,
+				PenalizationsAmount:  entities.NewWei(0),
+				CallFees:             entities.NewWei(100000000000000000),
+				GasFeesCollected:     entities.NewWei(1000),
+				GasSpent:             entities.NewWei(500),
+				BtcFeesPaid:          entities.NewWei(200),
+			},
+			Direction: reports.AnalyticsDirectionPegout,
+			NetProfit: entities.NewWei(100000000000000300),
+		},
+	},
+}
+
+// nolint:funlen
+func TestNewGetReportsAnalyticsHandler(t *testing.T) {
+	type testCase struct {
+		name      string
+		query     string
+		mockSetup func(useCase *mocks.GetAnalyticsReportUseCaseMock)
+		result    int
+	}
+
+	tests := []testCase{
+		{
+			name:      "should return 400 if startDate is missing",
+			query:     "endDate=2025-08-27",
+			mockSetup: func(useCase *mocks.GetAnalyticsReportUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:      "should return 400 if startDate is after endDate",
+			query:     "startDate=2025-08-27&endDate=2025-08-26",
+			mockSetup: func(useCase *mocks.GetAnalyticsReportUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:      "should return 400 for invalid period",
+			query:     "startDate=2024-01-01&endDate=2025-08-27&period=year",
+			mockSetup: func(useCase *mocks.GetAnalyticsReportUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:      "should return 400 for unsupported format",
+			query:     "startDate=2024-01-01&endDate=2025-08-27&format=pdf",
+			mockSetup: func(useCase *mocks.GetAnalyticsReportUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:  "should return 500 if use case returns an error",
+			query: "startDate=2024-01-01&endDate=2025-08-26&period=month",
+			mockSetup: func(useCase *mocks.GetAnalyticsReportUseCaseMock) {
+				useCase.EXPECT().Run(mock.Anything, quote.AnalyticsPeriodMonth, mock.Anything, mock.Anything).
+					Return(reports.GetAnalyticsReportResult{}, assert.AnError).Once()
+			},
+			result: http.StatusInternalServerError,
+		},
+		{
+			name:  "should use day as default period",
+			query: "startDate=2024-01-01&endDate=2025-08-25",
+			mockSetup: func(useCase *mocks.GetAnalyticsReportUseCaseMock) {
+				useCase.EXPECT().Run(mock.Anything, quote.AnalyticsPeriodDay, mock.Anything, mock.Anything).
+					Return(reports.GetAnalyticsReportResult{Period: quote.AnalyticsPeriodDay}, nil).Once()
+			},
+			result: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			useCase := mocks.NewGetAnalyticsReportUseCaseMock(t)
+			tc.mockSetup(useCase)
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/analytics?"+tc.query, nil)
+			require.NoError(t, err)
+			rr := httptest.NewRecorder()
+			handler := handlers.NewGetReportsAnalyticsHandler(handlers.SingleFlightGroup, handlers.AnalyticsReportSingleFlightKey, useCase)
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, tc.result, rr.Code)
+			useCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNewGetReportsAnalyticsHandler_ResponseStructure(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 31, 23, 59, 59, 999999999, time.UTC)
+
+	t.Run("should return the buckets as json", func(t *testing.T) {
+		useCase := mocks.NewGetAnalyticsReportUseCaseMock(t)
+		useCase.EXPECT().Run(mock.Anything, quote.AnalyticsPeriodWeek, startDate, endDate).Return(analyticsReport, nil).Once()
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/analytics?startDate=2024-01-01&endDate=2024-01-31&period=week", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		handlers.NewGetReportsAnalyticsHandler(handlers.SingleFlightGroup, handlers.AnalyticsReportSingleFlightKey, useCase).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var response pkg.GetAnalyticsReportResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		assert.Equal(t, pkg.GetAnalyticsReportResponse{
+			Period: "week",
+			Buckets: []pkg.AnalyticsBucketDTO{{
+				PeriodStart:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Direction:            "pegout",
+				OwnerAccountAddress:  test.AnyRskAddress,
+				TotalQuotesCount:     3,
+				AcceptedQuotesCount:  2,
+				AcceptedQuotesAmount: big.NewInt(2000000000000000000),
+				PaidQuotesCount:      2,
+				PaidQuotesAmount:     big.NewInt(2000000000000000000),
+				RefundedQuotesCount:  1,
+				RefundedQuotesAmount: big.NewInt(1100000000000000000),
+				PenalizationsCount:   0,
+				PenalizationsAmount:  big.NewInt(0),
+				CallFees:             big.NewInt(100000000000000000),
+				GasFeesCollected:     big.NewInt(1000),
+				GasSpent:             big.NewInt(500),
+				BtcFeesPaid:          big.NewInt(200),
+				NetProfit:            big.NewInt(100000000000000300),
+			}},
+		}, response)
+	})
+
+	t.Run("should return the buckets as csv", func(t *testing.T) {
+		useCase := mocks.NewGetAnalyticsReportUseCaseMock(t)
+		useCase.EXPECT().Run(mock.Anything, quote.AnalyticsPeriodWeek, startDate, endDate).Return(analyticsReport, nil).Once()
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/analytics?startDate=2024-01-01&endDate=2024-01-31&period=week&format=csv", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		handlers.NewGetReportsAnalyticsHandler(handlers.SingleFlightGroup, handlers.AnalyticsReportSingleFlightKey, useCase).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, rest.ContentTypeCsv, rr.Header().Get(rest.HeaderContentType))
+		assert.Equal(t, `attachment; filename="analytics-report.csv"`, rr.Header().Get(rest.HeaderContentDisposition))
+		records, err := csv.NewReader(rr.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, []string{
+			"2024-01-01T00:00:00Z", "pegout", test.AnyRskAddress, "3", "2", "2", "2", "2", "1", "1.1", "0", "0",
+			"0.1", "0.000000000000001", "0.0000000000000005", "0.0000000000000002", "0.1000000000000003",
+		}, records[1])
+	})
+}
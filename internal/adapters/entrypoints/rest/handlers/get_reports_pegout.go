@@ -21,6 +21,7 @@ type GetPegoutReportUseCase interface {
 // @Description Get the last pegouts on the API. Included in the management API.
 // @Param startDate query string true "Start date for the report. Supports YYYY-MM-DD (expands to full day) or ISO 8601 format (YYYY-MM-DDTHH:mm:ssZ)"
 // @Param endDate query string true "End date for the report. Supports YYYY-MM-DD (expands to end of day) or ISO 8601 format (YYYY-MM-DDTHH:mm:ssZ)"
+// @Param format query string false "Response format: json (default), csv or xlsx. Can also be selected with the Accept header (text/csv or application/vnd.openxmlformats-officedocument.spreadsheetml.sheet). Amounts in csv and xlsx are expressed in RBTC/BTC"
 // @Success 200 pkg.GetPegoutReportResponse
 // @Route /reports/pegout [get]
 func NewGetReportsPegoutHandler(
@@ -33,6 +34,10 @@ func NewGetReportsPegoutHandler(
 		var err error
 		requestParams.StartDate = req.URL.Query().Get("startDate")
 		requestParams.EndDate = req.URL.Query().Get("endDate")
+		format, ok := getReportResponseFormat(w, req)
+		if !ok {
+			return
+		}
 
 		// callback function signature comes from the std lib we can't modify it
 		// nolint:contextcheck
@@ -82,6 +87,12 @@ func NewGetReportsPegoutHandler(
 			TotalFeesCollected: pegoutReport.TotalFeesCollected.AsBigInt(),
 			AverageFeePerQuote: pegoutReport.AverageFeePerQuote.AsBigInt(),
 		}
+		if format != rest.ResponseFormatJson {
+			table := quoteReportTable(response.NumberOfQuotes, response.MinimumQuoteValue, response.MaximumQuoteValue,
+				response.AverageQuoteValue, response.TotalFeesCollected, response.AverageFeePerQuote)
+			rest.TableResponse(w, format, pegoutReportFileName, table)
+			return
+		}
 		rest.JsonResponseWithBody(w, http.StatusOK, &response)
 	}
 }
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	log "github.com/sirupsen/logrus"
+)
+
+type GetQuotePnlUseCase interface {
+	Run(ctx context.Context, quoteHash string) (reports.QuotePnl, error)
+}
+
+// NewGetReportsQuotePnlHandler
+// @Title Get quote profit and loss
+// @Description Returns the fees quoted to the user, the costs paid by the LP, the penalizations and the net margin of an accepted pegin or pegout quote
+// @Param quoteHash query string true "Hash of the quote"
+// @Success 200 {object} pkg.QuotePnlResponse
+// @Route /reports/quote/pnl [get]
+func NewGetReportsQuotePnlHandler(useCase GetQuotePnlUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		const paramName = "quoteHash"
+		quoteHash := req.URL.Query().Get(paramName)
+		if err := quote.ValidateQuoteHash(quoteHash); err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails("invalid or missing parameter quoteHash", rest.DetailsFromError(err), true)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		}
+		result, err := useCase.Run(req.Context(), quoteHash)
+		if errors.Is(err, usecases.QuoteNotFoundError) {
+			rest.JsonErrorResponse(w, http.StatusNotFound, rest.NewErrorResponse("Quote not found", true))
+			return
+		} else if errors.Is(err, usecases.QuoteNotAcceptedError) {
+			rest.JsonErrorResponse(w, http.StatusConflict, rest.NewErrorResponse(err.Error(), true))
+			return
+		} else if err != nil {
+			log.Error("Unknown error: ", err)
+			rest.JsonErrorResponse(w, http.StatusInternalServerError, rest.NewErrorResponse("Internal server error", false))
+			return
+		}
+
+		response := pkg.QuotePnlResponse{
+			QuoteHash:           result.QuoteHash,
+			Direction:           string(result.Direction),
+			State:               result.State,
+			OwnerAccountAddress: result.OwnerAccountAddress,
+			AgreementDate:       result.AgreementDate,
+			Value:               result.Value.AsBigInt(),
+			CallFee:             result.CallFee.AsBigInt(),
+			GasFee:              result.GasFee.AsBigInt(),
+			Costs:               make([]pkg.QuoteCostDTO, 0, len(result.Costs)),
+			TotalGasSpent:       result.TotalGasSpent.AsBigInt(),
+			BtcFeePaid:          result.BtcFeePaid.AsBigInt(),
+			Penalizations:       make([]pkg.QuotePenalizationDTO, 0, len(result.Penalizations)),
+			TotalPenalizations:  result.TotalPenalizations.AsBigInt(),
+			GasFeeMargin:        result.GasFeeMargin.AsBigInt(),
+			NetMargin:           result.NetMargin.AsBigInt(),
+			Realized:            result.Realized,
+		}
+		for _, cost := range result.Costs {
+			response.Costs = append(response.Costs, pkg.QuoteCostDTO{
+				Type:     string(cost.Type),
+				TxHash:   cost.TxHash,
+				GasUsed:  cost.GasUsed,
+				GasPrice: cost.GasPrice.AsBigInt(),
+				Amount:   cost.Amount.AsBigInt(),
+			})
+		}
+		for _, event := range result.Penalizations {
+			response.Penalizations = append(response.Penalizations, pkg.QuotePenalizationDTO{
+				LiquidityProvider: event.LiquidityProvider,
+				Penalty:           event.Penalty.AsBigInt(),
+			})
+		}
+		rest.JsonResponseWithBody(w, http.StatusOK, &response)
+	}
+}
@@ -0,0 +1,139 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/penalization"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var quotePnl = reports.QuotePnl{
+	QuoteHash:           test.AnyHash,
+	Direction:           reports.AnalyticsDirectionPegout,
+	State:               "BridgeTxSucceeded",
+	OwnerAccountAddress: test.AnyRskAddress,
+	AgreementDate:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	Value:               entities.NewWei(2000000),
+	CallFee:             entities.NewWei(4000),
+	GasFee:              entities.NewWei(1500),
+	Costs: []reports.QuoteCost{
+		{Type: reports.QuoteCostSendPegoutBtc, TxHash: "btcTx", GasPrice: entities.NewWei(0), Amount: entities.NewWei(1200)},
+		{Type: reports.QuoteCostRefundPegout, TxHash: "0x03", GasUsed: 20, GasPrice: entities.NewWei(10), Amount: entities.NewWei(200)},
+	},
+	TotalGasSpent: entities.NewWei(200),
+	BtcFeePaid:    entities.NewWei(1200),
+	Penalizations: []penalization.PenalizedEvent{
+		{LiquidityProvider: test.AnyRskAddress, Penalty: entities.NewWei(500), QuoteHash: test.AnyHash},
+	},
+	TotalPenalizations: entities.NewWei(500),
+	GasFeeMargin:       entities.NewWei(100),
+	NetMargin:          entities.NewWei(3600),
+	Realized:           true,
+}
+
+func TestNewGetReportsQuotePnlHandler(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		mockSetup func(useCase *mocks.GetQuotePnlUseCaseMock)
+		result    int
+	}{
+		{
+			name:      "should return 400 if the quote hash is missing",
+			query:     "",
+			mockSetup: func(useCase *mocks.GetQuotePnlUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:      "should return 400 if the quote hash is invalid",
+			query:     "quoteHash=invalid",
+			mockSetup: func(useCase *mocks.GetQuotePnlUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:  "should return 404 if the quote doesn't exist",
+			query: "quoteHash=" + test.AnyHash,
+			mockSetup: func(useCase *mocks.GetQuotePnlUseCaseMock) {
+				useCase.EXPECT().Run(mock.Anything, test.AnyHash).Return(reports.QuotePnl{}, usecases.QuoteNotFoundError).Once()
+			},
+			result: http.StatusNotFound,
+		},
+		{
+			name:  "should return 409 if the quote wasn't accepted",
+			query: "quoteHash=" + test.AnyHash,
+			mockSetup: func(useCase *mocks.GetQuotePnlUseCaseMock) {
+				useCase.EXPECT().Run(mock.Anything, test.AnyHash).Return(reports.QuotePnl{}, usecases.QuoteNotAcceptedError).Once()
+			},
+			result: http.StatusConflict,
+		},
+		{
+			name:  "should return 500 on unexpected errors",
+			query: "quoteHash=" + test.AnyHash,
+			mockSetup: func(useCase *mocks.GetQuotePnlUseCaseMock) {
+				useCase.EXPECT().Run(mock.Anything, test.AnyHash).Return(reports.QuotePnl{}, assert.AnError).Once()
+			},
+			result: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			useCase := mocks.NewGetQuotePnlUseCaseMock(t)
+			tc.mockSetup(useCase)
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/quote/pnl?"+tc.query, nil)
+			require.NoError(t, err)
+			rr := httptest.NewRecorder()
+			handlers.NewGetReportsQuotePnlHandler(useCase).ServeHTTP(rr, req)
+			assert.Equal(t, tc.result, rr.Code)
+		})
+	}
+
+	t.Run("should return the profit and loss of the quote", func(t *testing.T) {
+		useCase := mocks.NewGetQuotePnlUseCaseMock(t)
+		useCase.EXPECT().Run(mock.Anything, test.AnyHash).Return(quotePnl, nil).Once()
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/quote/pnl?quoteHash="+test.AnyHash, nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		handlers.NewGetReportsQuotePnlHandler(useCase).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var response pkg.QuotePnlResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		assert.Equal(t, pkg.QuotePnlResponse{
+			QuoteHash:           test.AnyHash,
+			Direction:           "pegout",
+			State:               "BridgeTxSucceeded",
+			OwnerAccountAddress: test.AnyRskAddress,
+			AgreementDate:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			Value:               big.NewInt(2000000),
+			CallFee:             big.NewInt(4000),
+			GasFee:              big.NewInt(1500),
+			Costs: []pkg.QuoteCostDTO{
+				{Type: "send_pegout_btc", TxHash: "btcTx", GasPrice: big.NewInt(0), Amount: big.NewInt(1200)},
+				{Type: "refund_pegout", TxHash: "0x03", GasUsed: 20, GasPrice: big.NewInt(10), Amount: big.NewInt(200)},
+			},
+			TotalGasSpent:      big.NewInt(200),
+			BtcFeePaid:         big.NewInt(1200),
+			Penalizations:      []pkg.QuotePenalizationDTO{{LiquidityProvider: test.AnyRskAddress, Penalty: big.NewInt(500)}},
+			TotalPenalizations: big.NewInt(500),
+			GasFeeMargin:       big.NewInt(100),
+			NetMargin:          big.NewInt(3600),
+			Realized:           true,
+		}, response)
+	})
+}
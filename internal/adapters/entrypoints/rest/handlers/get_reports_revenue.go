@@ -22,6 +22,7 @@ type GetRevenueReportUseCase interface {
 // @Description Get the revenue for the specified period.
 // @Param startDate query string true "Start date for the report. Supports YYYY-MM-DD (expands to full day) or ISO 8601 format (YYYY-MM-DDTHH:mm:ssZ)"
 // @Param endDate query string true "End date for the report. Supports YYYY-MM-DD (expands to end of day) or ISO 8601 format (YYYY-MM-DDTHH:mm:ssZ)"
+// @Param format query string false "Response format: json (default), csv or xlsx. Can also be selected with the Accept header (text/csv or application/vnd.openxmlformats-officedocument.spreadsheetml.sheet). Amounts in csv and xlsx are expressed in RBTC/BTC"
 // @Success 200 pkg.GetRevenueReportResponse
 // @Route /reports/revenue [get]
 func NewGetReportsRevenueHandler(
@@ -34,6 +35,10 @@ func NewGetReportsRevenueHandler(
 		var err error
 		requestParams.StartDate = req.URL.Query().Get("startDate")
 		requestParams.EndDate = req.URL.Query().Get("endDate")
+		format, ok := getReportResponseFormat(w, req)
+		if !ok {
+			return
+		}
 
 		// callback function signature comes from the std lib we can't modify it
 		// nolint:contextcheck
@@ -76,10 +81,17 @@ func NewGetReportsRevenueHandler(
 			return
 		}
 		response := pkg.GetRevenueReportResponse{
-			TotalQuoteCallFees:    revenueReport.TotalQuoteCallFees.AsBigInt(),
-			TotalPenalizations:    revenueReport.TotalPenalizations.AsBigInt(),
-			TotalGasFeesCollected: revenueReport.TotalGasFeesCollected.AsBigInt(),
-			TotalGasSpent:         revenueReport.TotalGasSpent.AsBigInt(),
+			TotalQuoteCallFees:       revenueReport.TotalQuoteCallFees.AsBigInt(),
+			TotalPenalizations:       revenueReport.TotalPenalizations.AsBigInt(),
+			TotalGasFeesCollected:    revenueReport.TotalGasFeesCollected.AsBigInt(),
+			TotalGasSpent:            revenueReport.TotalGasSpent.AsBigInt(),
+			TotalPegoutBtcFeesQuoted: revenueReport.TotalPegoutBtcFeesQuoted.AsBigInt(),
+			TotalPegoutBtcFeesPaid:   revenueReport.TotalPegoutBtcFeesPaid.AsBigInt(),
+			PegoutBtcFeesDifference:  revenueReport.PegoutBtcFeesDifference.AsBigInt(),
+		}
+		if format != rest.ResponseFormatJson {
+			rest.TableResponse(w, format, revenueReportFileName, revenueReportTable(response))
+			return
 		}
 		rest.JsonResponseWithBody(w, http.StatusOK, &response)
 	}
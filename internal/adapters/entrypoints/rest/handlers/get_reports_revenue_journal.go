@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+)
+
+type GetRevenueJournalUseCase interface {
+	Run(ctx context.Context, startDate, endDate time.Time) ([]reports.JournalEntry, error)
+}
+
+// NewGetReportsRevenueJournalHandler
+// @Title Get revenue journal
+// @Description Get the movements of the revenue report for the specified period as double-entry journal entries, one per call fee, gas collected, gas spent, BTC fee and penalty of each quote.
+// @Param startDate query string true "Start date for the report. Supports YYYY-MM-DD (expands to full day) or ISO 8601 format (YYYY-MM-DDTHH:mm:ssZ)"
+// @Param endDate query string true "End date for the report. Supports YYYY-MM-DD (expands to end of day) or ISO 8601 format (YYYY-MM-DDTHH:mm:ssZ)"
+// @Param format query string false "Response format: json (default), csv or xlsx. Can also be selected with the Accept header (text/csv or application/vnd.openxmlformats-officedocument.spreadsheetml.sheet). Amounts in csv and xlsx are expressed in RBTC/BTC"
+// @Success 200 pkg.GetRevenueJournalResponse
+// @Route /reports/revenue/journal [get]
+func NewGetReportsRevenueJournalHandler(useCase GetRevenueJournalUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var requestParams pkg.GetReportsByPeriodRequest
+		var err error
+		requestParams.StartDate = req.URL.Query().Get("startDate")
+		requestParams.EndDate = req.URL.Query().Get("endDate")
+		format, ok := getReportResponseFormat(w, req)
+		if !ok {
+			return
+		}
+
+		if err = rest.ValidateRequest(w, &requestParams); err != nil {
+			return
+		}
+
+		if err = requestParams.ValidateGetReportsByPeriodRequest(); err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails("Validation error", rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		}
+
+		startTime, endTime, err := requestParams.GetTimestamps()
+		if err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails("Date conversion error", rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		}
+
+		entries, err := useCase.Run(req.Context(), startTime, endTime)
+		if err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails(UnknownErrorMessage, rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+			return
+		}
+
+		response := pkg.GetRevenueJournalResponse{Entries: make([]pkg.RevenueJournalEntryDTO, 0, len(entries))}
+		for _, entry := range entries {
+			response.Entries = append(response.Entries, pkg.RevenueJournalEntryDTO{
+				Date:          entry.Date,
+				QuoteHash:     entry.QuoteHash,
+				QuoteType:     entry.QuoteType,
+				Movement:      string(entry.Movement),
+				DebitAccount:  string(entry.DebitAccount),
+				CreditAccount: string(entry.CreditAccount),
+				Amount:        entry.Amount.AsBigInt(),
+			})
+		}
+		if format != rest.ResponseFormatJson {
+			rest.TableResponse(w, format, revenueJournalFileName, revenueJournalTable(response))
+			return
+		}
+		rest.JsonResponseWithBody(w, http.StatusOK, &response)
+	}
+}
@@ -0,0 +1,169 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var journalEntries = []reports.JournalEntry{
+	{
+		Date:          time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC),
+		QuoteHash:     "pegout-hash",
+		QuoteType:     "pegout",
+		Movement:      reports.JournalMovementBtcFee,
+		DebitAccount:  reports.JournalAccountBtcFeeExpense,
+		CreditAccount: reports.JournalAccountLiquidity,
+		Amount:        entities.NewWei(1000),
+	},
+	{
+		Date:          time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+		QuoteHash:     "pegin-hash",
+		QuoteType:     "pegin",
+		Movement:      reports.JournalMovementCallFee,
+		DebitAccount:  reports.JournalAccountLiquidity,
+		CreditAccount: reports.JournalAccountCallFeeIncome,
+		Amount:        entities.NewWei(1500000000000000000),
+	},
+}
+
+// nolint:funlen
+func TestNewGetReportsRevenueJournalHandler(t *testing.T) {
+	type testCase struct {
+		name      string
+		query     string
+		mockSetup func(useCase *mocks.GetRevenueJournalUseCaseMock)
+		result    int
+	}
+
+	tests := []testCase{
+		{
+			name:      "should return 400 if startDate is missing",
+			query:     "endDate=2025-08-27",
+			mockSetup: func(useCase *mocks.GetRevenueJournalUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:      "should return 400 if startDate is after endDate",
+			query:     "startDate=2025-08-27&endDate=2025-08-26",
+			mockSetup: func(useCase *mocks.GetRevenueJournalUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:      "should return 400 for invalid date format",
+			query:     "startDate=2024-01-01&endDate=27/08/2025",
+			mockSetup: func(useCase *mocks.GetRevenueJournalUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:      "should return 400 for unsupported format",
+			query:     "startDate=2024-01-01&endDate=2025-08-27&format=pdf",
+			mockSetup: func(useCase *mocks.GetRevenueJournalUseCaseMock) {},
+			result:    http.StatusBadRequest,
+		},
+		{
+			name:  "should return 500 if use case returns an error",
+			query: "startDate=2024-01-01&endDate=2025-08-27",
+			mockSetup: func(useCase *mocks.GetRevenueJournalUseCaseMock) {
+				useCase.EXPECT().Run(mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+			},
+			result: http.StatusInternalServerError,
+		},
+		{
+			name:  "should return 200 if use case succeeds",
+			query: "startDate=2024-01-01&endDate=2025-08-27",
+			mockSetup: func(useCase *mocks.GetRevenueJournalUseCaseMock) {
+				useCase.EXPECT().Run(mock.Anything, mock.Anything, mock.Anything).Return(journalEntries, nil).Once()
+			},
+			result: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			useCase := mocks.NewGetRevenueJournalUseCaseMock(t)
+			tc.mockSetup(useCase)
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/revenue/journal?"+tc.query, nil)
+			require.NoError(t, err)
+			rr := httptest.NewRecorder()
+			handler := handlers.NewGetReportsRevenueJournalHandler(useCase)
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, tc.result, rr.Code)
+			useCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNewGetReportsRevenueJournalHandler_ResponseStructure(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 12, 31, 23, 59, 59, 999999999, time.UTC)
+
+	t.Run("should return the entries as json", func(t *testing.T) {
+		useCase := mocks.NewGetRevenueJournalUseCaseMock(t)
+		useCase.EXPECT().Run(mock.Anything, startDate, endDate).Return(journalEntries, nil).Once()
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/revenue/journal?startDate=2024-01-01&endDate=2024-12-31", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		handlers.NewGetReportsRevenueJournalHandler(useCase).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var response pkg.GetRevenueJournalResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		assert.Equal(t, pkg.GetRevenueJournalResponse{Entries: []pkg.RevenueJournalEntryDTO{
+			{
+				Date:          time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC),
+				QuoteHash:     "pegout-hash",
+				QuoteType:     "pegout",
+				Movement:      "btc_fee",
+				DebitAccount:  "Expenses:BtcFees",
+				CreditAccount: "Assets:Liquidity",
+				Amount:        big.NewInt(1000),
+			},
+			{
+				Date:          time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+				QuoteHash:     "pegin-hash",
+				QuoteType:     "pegin",
+				Movement:      "call_fee",
+				DebitAccount:  "Assets:Liquidity",
+				CreditAccount: "Income:CallFees",
+				Amount:        big.NewInt(1500000000000000000),
+			},
+		}}, response)
+	})
+
+	t.Run("should return the entries as csv", func(t *testing.T) {
+		useCase := mocks.NewGetRevenueJournalUseCaseMock(t)
+		useCase.EXPECT().Run(mock.Anything, startDate, endDate).Return(journalEntries, nil).Once()
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/revenue/journal?startDate=2024-01-01&endDate=2024-12-31", nil)
+		require.NoError(t, err)
+		req.Header.Set(rest.HeaderAccept, rest.ContentTypeCsv)
+		rr := httptest.NewRecorder()
+		handlers.NewGetReportsRevenueJournalHandler(useCase).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, rest.ContentTypeCsv, rr.Header().Get(rest.HeaderContentType))
+		assert.Equal(t, `attachment; filename="revenue-journal.csv"`, rr.Header().Get(rest.HeaderContentDisposition))
+		records, err := csv.NewReader(rr.Body).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"date", "quoteHash", "quoteType", "movement", "debitAccount", "creditAccount", "amount"},
+			{"2024-02-01T10:00:00Z", "pegout-hash", "pegout", "btc_fee", "Expenses:BtcFees", "Assets:Liquidity", "0.000000000000001"},
+			{"2024-03-01T10:00:00Z", "pegin-hash", "pegin", "call_fee", "Assets:Liquidity", "Income:CallFees", "1.5"},
+		}, records)
+	})
+}
@@ -2,12 +2,14 @@ package handlers_test
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
@@ -96,10 +98,13 @@ func TestNewGetReportsRevenueHandler(t *testing.T) {
 			mockSetup: func(useCase *mocks.GetRevenueReportUseCaseMock) {
 				useCase.On("Run", mock.Anything, mock.Anything, mock.Anything).
 					Return(reports.GetRevenueReportResult{
-						TotalQuoteCallFees:    entities.NewWei(1000),
-						TotalGasFeesCollected: entities.NewWei(500),
-						TotalGasSpent:         entities.NewWei(300),
-						TotalPenalizations:    entities.NewWei(100),
+						TotalQuoteCallFees:       entities.NewWei(1000),
+						TotalGasFeesCollected:    entities.NewWei(500),
+						TotalGasSpent:            entities.NewWei(300),
+						TotalPenalizations:       entities.NewWei(100),
+						TotalPegoutBtcFeesQuoted: entities.NewWei(100),
+						TotalPegoutBtcFeesPaid:   entities.NewWei(100),
+						PegoutBtcFeesDifference:  entities.NewWei(0),
 					}, nil).Once()
 			},
 			result: http.StatusOK,
@@ -111,10 +116,13 @@ func TestNewGetReportsRevenueHandler(t *testing.T) {
 			mockSetup: func(useCase *mocks.GetRevenueReportUseCaseMock) {
 				useCase.On("Run", mock.Anything, mock.Anything, mock.Anything).
 					Return(reports.GetRevenueReportResult{
-						TotalQuoteCallFees:    entities.NewWei(0),
-						TotalGasFeesCollected: entities.NewWei(0),
-						TotalGasSpent:         entities.NewWei(0),
-						TotalPenalizations:    entities.NewWei(0),
+						TotalQuoteCallFees:       entities.NewWei(0),
+						TotalGasFeesCollected:    entities.NewWei(0),
+						TotalGasSpent:            entities.NewWei(0),
+						TotalPenalizations:       entities.NewWei(0),
+						TotalPegoutBtcFeesQuoted: entities.NewWei(0),
+						TotalPegoutBtcFeesPaid:   entities.NewWei(0),
+						PegoutBtcFeesDifference:  entities.NewWei(0),
 					}, nil).Once()
 			},
 			result: http.StatusOK,
@@ -159,10 +167,13 @@ func TestNewGetReportsRevenueHandler_ResponseStructure(t *testing.T) {
 		useCase := mocks.NewGetRevenueReportUseCaseMock(t)
 		useCase.On("Run", mock.Anything, mock.Anything, mock.Anything).
 			Return(reports.GetRevenueReportResult{
-				TotalQuoteCallFees:    entities.NewWei(1000),
-				TotalGasFeesCollected: entities.NewWei(500),
-				TotalGasSpent:         entities.NewWei(300),
-				TotalPenalizations:    entities.NewWei(100),
+				TotalQuoteCallFees:       entities.NewWei(1000),
+				TotalGasFeesCollected:    entities.NewWei(500),
+				TotalGasSpent:            entities.NewWei(300),
+				TotalPenalizations:       entities.NewWei(100),
+				TotalPegoutBtcFeesQuoted: entities.NewWei(120),
+				TotalPegoutBtcFeesPaid:   entities.NewWei(150),
+				PegoutBtcFeesDifference:  entities.NewWei(-30),
 			}, nil).Once()
 
 		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/revenue?startDate=2024-01-01&endDate=2025-08-27", nil)
@@ -186,6 +197,9 @@ func TestNewGetReportsRevenueHandler_ResponseStructure(t *testing.T) {
 		assert.Equal(t, big.NewInt(500), response.TotalGasFeesCollected)
 		assert.Equal(t, big.NewInt(300), response.TotalGasSpent)
 		assert.Equal(t, big.NewInt(100), response.TotalPenalizations)
+		assert.Equal(t, big.NewInt(120), response.TotalPegoutBtcFeesQuoted)
+		assert.Equal(t, big.NewInt(150), response.TotalPegoutBtcFeesPaid)
+		assert.Equal(t, big.NewInt(-30), response.PegoutBtcFeesDifference)
 
 		useCase.AssertExpectations(t)
 	})
@@ -194,10 +208,13 @@ func TestNewGetReportsRevenueHandler_ResponseStructure(t *testing.T) {
 		useCase := mocks.NewGetRevenueReportUseCaseMock(t)
 		useCase.On("Run", mock.Anything, mock.Anything, mock.Anything).
 			Return(reports.GetRevenueReportResult{
-				TotalQuoteCallFees:    entities.NewWei(2000),
-				TotalGasFeesCollected: entities.NewWei(600),
-				TotalGasSpent:         entities.NewWei(400),
-				TotalPenalizations:    entities.NewWei(300),
+				TotalQuoteCallFees:       entities.NewWei(2000),
+				TotalGasFeesCollected:    entities.NewWei(600),
+				TotalGasSpent:            entities.NewWei(400),
+				TotalPenalizations:       entities.NewWei(300),
+				TotalPegoutBtcFeesQuoted: entities.NewWei(300),
+				TotalPegoutBtcFeesPaid:   entities.NewWei(300),
+				PegoutBtcFeesDifference:  entities.NewWei(0),
 			}, nil).Once()
 
 		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/revenue?startDate=2024-01-01&endDate=2025-08-27", nil)
@@ -228,10 +245,13 @@ func TestNewGetReportsRevenueHandler_ResponseStructure(t *testing.T) {
 		useCase := mocks.NewGetRevenueReportUseCaseMock(t)
 		useCase.On("Run", mock.Anything, mock.Anything, mock.Anything).
 			Return(reports.GetRevenueReportResult{
-				TotalQuoteCallFees:    entities.NewWei(3000),
-				TotalGasFeesCollected: entities.NewWei(200),
-				TotalGasSpent:         entities.NewWei(500),
-				TotalPenalizations:    entities.NewWei(100),
+				TotalQuoteCallFees:       entities.NewWei(3000),
+				TotalGasFeesCollected:    entities.NewWei(200),
+				TotalGasSpent:            entities.NewWei(500),
+				TotalPenalizations:       entities.NewWei(100),
+				TotalPegoutBtcFeesQuoted: entities.NewWei(100),
+				TotalPegoutBtcFeesPaid:   entities.NewWei(100),
+				PegoutBtcFeesDifference:  entities.NewWei(0),
 			}, nil).Once()
 
 		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/revenue?startDate=2024-01-01&endDate=2025-08-27", nil)
@@ -258,3 +278,58 @@ func TestNewGetReportsRevenueHandler_ResponseStructure(t *testing.T) {
 		useCase.AssertExpectations(t)
 	})
 }
+
+func TestNewGetReportsRevenueHandler_Export(t *testing.T) {
+	result := reports.GetRevenueReportResult{
+		TotalQuoteCallFees:       entities.NewWei(1000000000000000000),
+		TotalGasFeesCollected:    entities.NewWei(500),
+		TotalGasSpent:            entities.NewWei(300),
+		TotalPenalizations:       entities.NewWei(0),
+		TotalPegoutBtcFeesQuoted: entities.NewWei(120),
+		TotalPegoutBtcFeesPaid:   entities.NewWei(150),
+		PegoutBtcFeesDifference:  entities.NewWei(-30),
+	}
+
+	t.Run("should return the report as csv", func(t *testing.T) {
+		useCase := mocks.NewGetRevenueReportUseCaseMock(t)
+		useCase.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(result, nil).Once()
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/revenue?startDate=2024-01-01&endDate=2025-08-27&format=csv", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		handlers.NewGetReportsRevenueHandler(handlers.SingleFlightGroup, handlers.RevenueReportSingleFlightKey, useCase).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, rest.ContentTypeCsv, rr.Header().Get(rest.HeaderContentType))
+		assert.Equal(t, `attachment; filename="revenue-report.csv"`, rr.Header().Get(rest.HeaderContentDisposition))
+		records, err := csv.NewReader(rr.Body).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"totalQuoteCallFees", "totalPenalizations", "totalGasFeesCollected", "totalGasSpent", "totalPegoutBtcFeesQuoted", "totalPegoutBtcFeesPaid", "pegoutBtcFeesDifference"},
+			{"1", "0", "0.0000000000000005", "0.0000000000000003", "0.00000000000000012", "0.00000000000000015", "-0.00000000000000003"},
+		}, records)
+	})
+
+	t.Run("should return the report as xlsx", func(t *testing.T) {
+		useCase := mocks.NewGetRevenueReportUseCaseMock(t)
+		useCase.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(result, nil).Once()
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/revenue?startDate=2024-01-01&endDate=2025-08-27", nil)
+		require.NoError(t, err)
+		req.Header.Set(rest.HeaderAccept, rest.ContentTypeXlsx)
+		rr := httptest.NewRecorder()
+		handlers.NewGetReportsRevenueHandler(handlers.SingleFlightGroup, handlers.RevenueReportSingleFlightKey, useCase).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, rest.ContentTypeXlsx, rr.Header().Get(rest.HeaderContentType))
+		assert.Equal(t, `attachment; filename="revenue-report.xlsx"`, rr.Header().Get(rest.HeaderContentDisposition))
+		assert.NotEmpty(t, rr.Body.Bytes())
+	})
+
+	t.Run("should return 400 for unsupported format", func(t *testing.T) {
+		useCase := mocks.NewGetRevenueReportUseCaseMock(t)
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports/revenue?startDate=2024-01-01&endDate=2025-08-27&format=pdf", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		handlers.NewGetReportsRevenueHandler(handlers.SingleFlightGroup, handlers.RevenueReportSingleFlightKey, useCase).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -13,12 +13,13 @@ import (
 
 // NewGetReportsTransactionHandler
 // @Title Get Transaction Reports
-// @Description Get a paginated list of individual transactions of a specific type processed by the liquidity provider within a specified time period
+// @Description Get a paginated list of individual transactions of a specific type processed by the liquidity provider within a specified time period. The csv and xlsx exports ignore the pagination parameters and include all the transactions of the period
 // @Param type query string true "Transaction type filter: 'pegin' or 'pegout'"
 // @Param startDate query string false "Start date for the report. Supports YYYY-MM-DD (expands to full day) or ISO 8601 format (YYYY-MM-DDTHH:mm:ssZ)"
 // @Param endDate query string false "End date for the report. Supports YYYY-MM-DD (expands to end of day) or ISO 8601 format (YYYY-MM-DDTHH:mm:ssZ)"
-// @Param page query int false "Page number to retrieve (1-indexed, default: 1)"
-// @Param perPage query int false "Number of transactions per page (max: 100, default: 10)"
+// @Param page query int false "Page number to retrieve (1-indexed, default: 1). Ignored by the csv and xlsx formats"
+// @Param perPage query int false "Number of transactions per page (max: 100, default: 10). Ignored by the csv and xlsx formats"
+// @Param format query string false "Response format: json (default), csv or xlsx. Can also be selected with the Accept header (text/csv or application/vnd.openxmlformats-officedocument.spreadsheetml.sheet). Amounts in csv and xlsx are expressed in RBTC/BTC"
 // @Success 200 {object} pkg.GetTransactionsResponse "Paginated list of transactions with metadata"
 // @Router /reports/transactions [get]
 func NewGetReportsTransactionHandler(useCase *reports.GetTransactionsUseCase) http.HandlerFunc {
@@ -31,6 +32,11 @@ func NewGetReportsTransactionHandler(useCase *reports.GetTransactionsUseCase) ht
 			return
 		}
 
+		format, ok := getReportResponseFormat(w, req)
+		if !ok {
+			return
+		}
+
 		if err = rest.ValidateRequest(w, &requestParams); err != nil {
 			return
 		}
@@ -49,8 +55,13 @@ func NewGetReportsTransactionHandler(useCase *reports.GetTransactionsUseCase) ht
 			return
 		}
 
-		// Call use case
-		result, err := useCase.Run(req.Context(), requestParams.Type, startTime, endTime, requestParams.Page, requestParams.PerPage)
+		// Call use case, the file exports always contain the whole period
+		var result reports.GetTransactionsResult
+		if format == rest.ResponseFormatJson {
+			result, err = useCase.Run(req.Context(), requestParams.Type, startTime, endTime, requestParams.Page, requestParams.PerPage)
+		} else {
+			result, err = useCase.RunAll(req.Context(), requestParams.Type, startTime, endTime)
+		}
 		if err != nil {
 			jsonErr := rest.NewErrorResponseWithDetails(UnknownErrorMessage, rest.DetailsFromError(err), false)
 			rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
@@ -59,6 +70,10 @@ func NewGetReportsTransactionHandler(useCase *reports.GetTransactionsUseCase) ht
 
 		// Convert use case result to API response DTO and return
 		response := mapUseCaseResultToResponse(result)
+		if format != rest.ResponseFormatJson {
+			rest.TableResponse(w, format, transactionsFileName, transactionsTable(response))
+			return
+		}
 		rest.JsonResponseWithBody(w, http.StatusOK, &response)
 	}
 }
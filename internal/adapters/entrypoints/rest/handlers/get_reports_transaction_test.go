@@ -1,12 +1,14 @@
 package handlers_test
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
@@ -261,3 +263,41 @@ func TestGetReportsTransactionHandler_ISO8601DateFormat(t *testing.T) {
 	assert.Equal(t, 1, response.Pagination.Page)
 	assert.Equal(t, 1, response.Pagination.TotalPages)
 }
+
+func TestGetReportsTransactionHandler_CsvExportsTheWholeRange(t *testing.T) {
+	peginRepo := mocks.NewPeginQuoteRepositoryMock(t)
+	pegoutRepo := mocks.NewPegoutQuoteRepositoryMock(t)
+
+	startDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 1, 31, 23, 59, 59, 999999999, time.UTC)
+
+	mockQuotes := []quote.PeginQuoteWithRetained{
+		{
+			Quote:         quote.PeginQuote{Value: entities.NewWei(1000000000000000000), CallFee: entities.NewWei(50000000000000000), GasFee: entities.NewWei(10000000000000000)},
+			RetainedQuote: quote.RetainedPeginQuote{QuoteHash: "0x123", State: quote.PeginStateRegisterPegInSucceeded},
+		},
+		{
+			Quote:         quote.PeginQuote{Value: entities.NewWei(2000000000000000000), CallFee: entities.NewWei(50000000000000000), GasFee: entities.NewWei(10000000000000000)},
+			RetainedQuote: quote.RetainedPeginQuote{QuoteHash: "0x456", State: quote.PeginStateWaitingForDeposit},
+		},
+	}
+
+	// the pagination parameters of the request must be ignored
+	peginRepo.EXPECT().ListQuotesByDateRange(mock.Anything, startDate, endDate, 0, 0).Return(mockQuotes, 2, nil).Once()
+
+	useCase := reports.NewGetTransactionsUseCase(peginRepo, pegoutRepo)
+	handler := handlers.NewGetReportsTransactionHandler(useCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/reports/transactions?type=pegin&startDate=2023-01-01&endDate=2023-01-31&page=2&perPage=1&format=csv", nil)
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, rest.ContentTypeCsv, rr.Header().Get(rest.HeaderContentType))
+	records, err := csv.NewReader(rr.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, "0x123", records[1][0])
+	assert.Equal(t, "0x456", records[2][0])
+}
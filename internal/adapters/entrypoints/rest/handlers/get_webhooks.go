@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+)
+
+type GetWebhooksUseCase interface {
+	Run(ctx context.Context) ([]webhook.Webhook, error)
+}
+
+// NewGetWebhooksHandler
+// @Title Get Webhooks
+// @Description Returns the global webhooks. The secrets of the webhooks are never returned
+// @Success 200 object pkg.WebhooksResponse
+// @Route /management/webhooks [get]
+func NewGetWebhooksHandler(useCase GetWebhooksUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		hooks, err := useCase.Run(req.Context())
+		if err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails(UnknownErrorMessage, rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+			return
+		}
+		response := pkg.ToWebhooksResponse(hooks)
+		rest.JsonResponseWithBody(w, http.StatusOK, &response)
+	}
+}
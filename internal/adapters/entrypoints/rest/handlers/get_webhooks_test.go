@@ -0,0 +1,48 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetWebhooksHandler(t *testing.T) {
+	t.Run("should return the webhooks without their secrets", func(t *testing.T) {
+		createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		hooks := []webhook.Webhook{
+			{Id: "1", Url: "https://one.example.com", Secret: "0123456789abcdef", CreatedAt: createdAt},
+			{Id: "2", Url: "https://two.example.com", CreatedAt: createdAt},
+		}
+		useCase := &mocks.GetWebhooksUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything).Return(hooks, nil).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewGetWebhooksHandler(useCase).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/management/webhooks", nil))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.NotContains(t, recorder.Body.String(), "0123456789abcdef")
+		var response pkg.WebhooksResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		assert.Equal(t, []pkg.WebhookDTO{
+			{Id: "1", Url: "https://one.example.com", SignatureType: "hmac-sha256", CreatedAt: createdAt},
+			{Id: "2", Url: "https://two.example.com", SignatureType: "lp-signature", CreatedAt: createdAt},
+		}, response.Webhooks)
+		useCase.AssertExpectations(t)
+	})
+	t.Run("should return 500 on unexpected error", func(t *testing.T) {
+		useCase := &mocks.GetWebhooksUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything).Return(nil, assert.AnError).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewGetWebhooksHandler(useCase).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/management/webhooks", nil))
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		useCase.AssertExpectations(t)
+	})
+}
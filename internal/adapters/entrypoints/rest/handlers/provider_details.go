@@ -20,27 +20,11 @@ func NewProviderDetailsHandler(useCase *liquidity_provider.GetDetailUseCase) htt
 			rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
 			return
 		}
-		peginFeePercentage, _ := result.Pegin.FeePercentage.Native().Float64()
-		pegoutFeePercentage, _ := result.Pegout.FeePercentage.Native().Float64()
 		response := pkg.ProviderDetailResponse{
 			SiteKey:               result.SiteKey,
 			LiquidityCheckEnabled: result.LiquidityCheckEnabled,
-			Pegin: pkg.ProviderDetail{
-				Fee:                   result.Pegin.FixedFee.AsBigInt(),
-				FixedFee:              result.Pegin.FixedFee.AsBigInt(),
-				FeePercentage:         peginFeePercentage,
-				MinTransactionValue:   result.Pegin.MinTransactionValue.AsBigInt(),
-				MaxTransactionValue:   result.Pegin.MaxTransactionValue.AsBigInt(),
-				RequiredConfirmations: result.Pegin.RequiredConfirmations,
-			},
-			Pegout: pkg.ProviderDetail{
-				Fee:                   result.Pegout.FixedFee.AsBigInt(),
-				FixedFee:              result.Pegout.FixedFee.AsBigInt(),
-				FeePercentage:         pegoutFeePercentage,
-				MinTransactionValue:   result.Pegout.MinTransactionValue.AsBigInt(),
-				MaxTransactionValue:   result.Pegout.MaxTransactionValue.AsBigInt(),
-				RequiredConfirmations: result.Pegout.RequiredConfirmations,
-			},
+			Pegin:                 pkg.ToProviderDetail(result.Pegin),
+			Pegout:                pkg.ToProviderDetail(result.Pegout),
 		}
 		rest.JsonResponseWithBody(w, http.StatusOK, &response)
 	}
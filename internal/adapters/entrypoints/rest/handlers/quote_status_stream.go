@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// StatusStreamPollInterval is how often the status streams check the deposit confirmations and ping the client
+	StatusStreamPollInterval = 15 * time.Second
+	// StatusStreamMaxDuration is the maximum time a status stream is kept open, after that the client has to reconnect
+	StatusStreamMaxDuration = 30 * time.Minute
+
+	StatusStreamEvent        = "status"
+	StateStreamEvent         = "state"
+	ConfirmationsStreamEvent = "confirmations"
+)
+
+type QuoteStateStreamUseCase interface {
+	Run(ctx context.Context, quoteHash string) <-chan webhook.Notification
+}
+
+type DepositConfirmationsUseCase interface {
+	Run(ctx context.Context, quoteHash string) (quote.DepositConfirmations, error)
+}
+
+// quoteStatusStream has what the pegin and pegout status streams need to follow a quote. The deposit
+// confirmations are only checked while the quote is in one of the waitingStates
+type quoteStatusStream struct {
+	quoteHash            string
+	state                string
+	waitingStates        []string
+	confirmationsUseCase DepositConfirmationsUseCase
+	confirmationsPoller  *depositConfirmationsPoller
+	pollInterval         time.Duration
+}
+
+// depositConfirmationsPoller checks periodically the deposit confirmations of the quotes being streamed. There
+// is only one poll per quote hash no matter how many streams follow it, the result is shared between all of them
+// and the poll stops when the last stream unsubscribes
+type depositConfirmationsPoller struct {
+	useCase  DepositConfirmationsUseCase
+	interval time.Duration
+	mutex    sync.Mutex
+	polls    map[string]*confirmationsPoll
+}
+
+type confirmationsPoll struct {
+	subscribers map[chan quote.DepositConfirmations]struct{}
+	cancel      context.CancelFunc
+}
+
+func newDepositConfirmationsPoller(useCase DepositConfirmationsUseCase, interval time.Duration) *depositConfirmationsPoller {
+	return &depositConfirmationsPoller{
+		useCase:  useCase,
+		interval: interval,
+		polls:    make(map[string]*confirmationsPoll),
+	}
+}
+
+// subscribe returns a channel that receives the confirmations of the quote every time they are polled and a
+// function to stop receiving them. Only the latest result is kept in the channel, so a slow subscriber doesn't
+// block the rest of them
+func (poller *depositConfirmationsPoller) subscribe(quoteHash string) (<-chan quote.DepositConfirmations, func()) {
+	channel := make(chan quote.DepositConfirmations, 1)
+	poller.mutex.Lock()
+	defer poller.mutex.Unlock()
+	poll, ok := poller.polls[quoteHash]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		poll = &confirmationsPoll{subscribers: make(map[chan quote.DepositConfirmations]struct{}), cancel: cancel}
+		poller.polls[quoteHash] = poll
+		go poller.poll(ctx, quoteHash, poll)
+	}
+	poll.subscribers[channel] = struct{}{}
+	var once sync.Once
+	return channel, func() { once.Do(func() { poller.unsubscribe(quoteHash, poll, channel) }) }
+}
+
+func (poller *depositConfirmationsPoller) unsubscribe(quoteHash string, poll *confirmationsPoll, channel chan quote.DepositConfirmations) {
+	poller.mutex.Lock()
+	defer poller.mutex.Unlock()
+	delete(poll.subscribers, channel)
+	if len(poll.subscribers) == 0 {
+		poll.cancel()
+		delete(poller.polls, quoteHash)
+	}
+}
+
+func (poller *depositConfirmationsPoller) poll(ctx context.Context, quoteHash string, poll *confirmationsPoll) {
+	ticker := time.NewTicker(poller.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		confirmations, err := poller.useCase.Run(ctx, quoteHash)
+		if err != nil {
+			log.Errorf("Error getting deposit confirmations of quote %s: %v", quoteHash, err)
+			continue
+		}
+		poller.mutex.Lock()
+		for channel := range poll.subscribers {
+			select {
+			case <-channel:
+			default:
+			}
+			channel <- confirmations
+		}
+		poller.mutex.Unlock()
+	}
+}
+
+func validateStreamQuoteHash(w http.ResponseWriter, req *http.Request) (string, error) {
+	quoteHash := req.URL.Query().Get("quoteHash")
+	if err := quote.ValidateQuoteHash(quoteHash); err != nil {
+		jsonErr := rest.NewErrorResponseWithDetails("invalid or missing parameter quoteHash", rest.DetailsFromError(err), true)
+		rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+		return "", err
+	}
+	return quoteHash, nil
+}
+
+func handleQuoteStatusError(w http.ResponseWriter, err error) {
+	if errors.Is(err, usecases.QuoteNotFoundError) {
+		rest.JsonErrorResponse(w, http.StatusNotFound, rest.NewErrorResponse("Quote not found", true))
+	} else if errors.Is(err, usecases.QuoteNotAcceptedError) {
+		rest.JsonErrorResponse(w, http.StatusConflict, rest.NewErrorResponse(err.Error(), true))
+	} else {
+		log.Error("Unknown error: ", err)
+		rest.JsonErrorResponse(w, http.StatusInternalServerError, rest.NewErrorResponse("Internal server error", false))
+	}
+}
+
+// streamQuoteStatus sends the current status of the quote and then every state transition received from the
+// states channel until the client disconnects or the context is done. While the quote waits for the user deposit,
+// it also sends the confirmations of the deposit every time they change
+// nolint:cyclop
+func streamQuoteStatus(ctx context.Context, w http.ResponseWriter, stream quoteStatusStream, status any, states <-chan webhook.Notification) {
+	eventStream, err := rest.NewEventStream(w)
+	if err != nil {
+		log.Errorf("Error opening status stream of quote %s: %v", stream.quoteHash, err)
+		return
+	}
+	if err = eventStream.Send(StatusStreamEvent, status); err != nil {
+		return
+	}
+
+	var lastConfirmations *quote.DepositConfirmations
+	sendConfirmations := func(confirmations quote.DepositConfirmations) error {
+		if lastConfirmations != nil && *lastConfirmations == confirmations {
+			return nil
+		}
+		lastConfirmations = &confirmations
+		return eventStream.Send(ConfirmationsStreamEvent, pkg.ToDepositConfirmationsDTO(stream.quoteHash, confirmations))
+	}
+	// the confirmations are checked right away when the stream is opened or the state changes, after
+	// that they are taken from the poll of the quote shared with the rest of the streams
+	var polledConfirmations <-chan quote.DepositConfirmations
+	unsubscribe := func() {}
+	defer func() { unsubscribe() }()
+	checkConfirmations := func() error {
+		if !slices.Contains(stream.waitingStates, stream.state) {
+			unsubscribe()
+			polledConfirmations = nil
+			return nil
+		}
+		if polledConfirmations == nil {
+			polledConfirmations, unsubscribe = stream.confirmationsPoller.subscribe(stream.quoteHash)
+		}
+		confirmations, confirmationsErr := stream.confirmationsUseCase.Run(ctx, stream.quoteHash)
+		if confirmationsErr != nil {
+			log.Errorf("Error getting deposit confirmations of quote %s: %v", stream.quoteHash, confirmationsErr)
+			return nil
+		}
+		return sendConfirmations(confirmations)
+	}
+	if err = checkConfirmations(); err != nil {
+		return
+	}
+
+	ticker := time.NewTicker(stream.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case notification, ok := <-states:
+			if !ok {
+				return
+			}
+			stream.state = notification.State
+			if err = eventStream.Send(StateStreamEvent, pkg.ToQuoteStateDTO(notification)); err == nil {
+				err = checkConfirmations()
+			}
+		case confirmations := <-polledConfirmations:
+			err = sendConfirmations(confirmations)
+		case <-ticker.C:
+			err = eventStream.Ping()
+		}
+		if err != nil {
+			log.Debugf("Closing status stream of quote %s: %v", stream.quoteHash, err)
+			return
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+)
+
+type ReconcileLiquidityLedgerUseCase interface {
+	Run(ctx context.Context) (liquidity_provider.LiquidityLedgerReconciliation, error)
+}
+
+// NewReconcileLiquidityLedgerHandler
+// @Title Reconcile Liquidity Ledger
+// @Description Expires the outdated liquidity holds, creates the missing holds of the quotes waiting for the liquidity provider
+// to pay them and releases the holds of the quotes that are no longer waiting. The ledger is also reconciled periodically.
+// @Success 200 object pkg.LiquidityLedgerReconciliationResponse
+// @Route /management/liquidity/reconcile [post]
+func NewReconcileLiquidityLedgerHandler(useCase ReconcileLiquidityLedgerUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		result, err := useCase.Run(req.Context())
+		if err != nil {
+			jsonErr := rest.NewErrorResponseWithDetails(UnknownErrorMessage, rest.DetailsFromError(err), false)
+			rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+			return
+		}
+		response := pkg.ToLiquidityLedgerReconciliationResponse(result)
+		rest.JsonResponseWithBody(w, http.StatusOK, &response)
+	}
+}
@@ -0,0 +1,37 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewReconcileLiquidityLedgerHandler(t *testing.T) {
+	t.Run("should return the reconciliation result", func(t *testing.T) {
+		useCase := &mocks.ReconcileLiquidityLedgerUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything).Return(liquidity_provider.LiquidityLedgerReconciliation{
+			Created:  []string{"0x01"},
+			Released: []string{"0x02", "0x03"},
+			Expired:  4,
+		}, nil).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewReconcileLiquidityLedgerHandler(useCase).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/management/liquidity/reconcile", nil))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.JSONEq(t, `{"created":["0x01"],"released":["0x02","0x03"],"expired":4}`, recorder.Body.String())
+		useCase.AssertExpectations(t)
+	})
+	t.Run("should return 500 on unexpected error", func(t *testing.T) {
+		useCase := &mocks.ReconcileLiquidityLedgerUseCaseMock{}
+		useCase.EXPECT().Run(mock.Anything).Return(liquidity_provider.LiquidityLedgerReconciliation{}, assert.AnError).Once()
+		recorder := httptest.NewRecorder()
+		handlers.NewReconcileLiquidityLedgerHandler(useCase).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/management/liquidity/reconcile", nil))
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		useCase.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/pkg"
+)
+
+const (
+	peginReportFileName     = "pegin-report"
+	pegoutReportFileName    = "pegout-report"
+	revenueReportFileName   = "revenue-report"
+	revenueJournalFileName  = "revenue-journal"
+	analyticsReportFileName = "analytics-report"
+	transactionsFileName    = "transactions-report"
+)
+
+// getReportResponseFormat returns the format requested for a report, if the format is not valid it writes
+// the error response and returns false
+func getReportResponseFormat(w http.ResponseWriter, req *http.Request) (rest.ResponseFormat, bool) {
+	format, err := rest.GetResponseFormat(req)
+	if err != nil {
+		jsonErr := rest.NewErrorResponseWithDetails("Validation error", rest.DetailsFromError(err), true)
+		rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+		return "", false
+	}
+	return format, true
+}
+
+// quoteReportTable is used for both pegin and pegout reports since they have the same fields, the amounts are expressed in RBTC/BTC
+func quoteReportTable(numberOfQuotes int, minimum, maximum, average, totalFees, averageFee *big.Int) rest.Table {
+	return rest.Table{
+		Header: []string{"numberOfQuotes", "minimumQuoteValue", "maximumQuoteValue", "averageQuoteValue", "totalFeesCollected", "averageFeePerQuote"},
+		Rows: [][]string{{
+			strconv.Itoa(numberOfQuotes),
+			rest.FormatWeiAmount(minimum),
+			rest.FormatWeiAmount(maximum),
+			rest.FormatWeiAmount(average),
+			rest.FormatWeiAmount(totalFees),
+			rest.FormatWeiAmount(averageFee),
+		}},
+	}
+}
+
+func revenueReportTable(response pkg.GetRevenueReportResponse) rest.Table {
+	return rest.Table{
+		Header: []string{
+			"totalQuoteCallFees", "totalPenalizations", "totalGasFeesCollected", "totalGasSpent",
+			"totalPegoutBtcFeesQuoted", "totalPegoutBtcFeesPaid", "pegoutBtcFeesDifference",
+		},
+		Rows: [][]string{{
+			rest.FormatWeiAmount(response.TotalQuoteCallFees),
+			rest.FormatWeiAmount(response.TotalPenalizations),
+			rest.FormatWeiAmount(response.TotalGasFeesCollected),
+			rest.FormatWeiAmount(response.TotalGasSpent),
+			rest.FormatWeiAmount(response.TotalPegoutBtcFeesQuoted),
+			rest.FormatWeiAmount(response.TotalPegoutBtcFeesPaid),
+			rest.FormatWeiAmount(response.PegoutBtcFeesDifference),
+		}},
+	}
+}
+
+func transactionsTable(response pkg.GetTransactionsResponse) rest.Table {
+	rows := make([][]string, 0, len(response.Data))
+	for _, item := range response.Data {
+		rows = append(rows, []string{
+			item.QuoteHash,
+			rest.FormatWeiAmount(item.Amount),
+			rest.FormatWeiAmount(item.CallFee),
+			rest.FormatWeiAmount(item.GasFee),
+			item.Status,
+		})
+	}
+	return rest.Table{Header: []string{"quoteHash", "amount", "callFee", "gasFee", "status"}, Rows: rows}
+}
+
+func revenueJournalTable(response pkg.GetRevenueJournalResponse) rest.Table {
+	rows := make([][]string, 0, len(response.Entries))
+	for _, entry := range response.Entries {
+		rows = append(rows, []string{
+			entry.Date.Format(time.RFC3339),
+			entry.QuoteHash,
+			entry.QuoteType,
+			entry.Movement,
+			entry.DebitAccount,
+			entry.CreditAccount,
+			rest.FormatWeiAmount(entry.Amount),
+		})
+	}
+	return rest.Table{
+		Header: []string{"date", "quoteHash", "quoteType", "movement", "debitAccount", "creditAccount", "amount"},
+		Rows:   rows,
+	}
+}
+
+func analyticsReportTable(response pkg.GetAnalyticsReportResponse) rest.Table {
+	rows := make([][]string, 0, len(response.Buckets))
+	for _, bucket := range response.Buckets {
+		rows = append(rows, []string{
+			bucket.PeriodStart.Format(time.RFC3339),
+			bucket.Direction,
+			bucket.OwnerAccountAddress,
+			strconv.FormatInt(bucket.TotalQuotesCount, 10),
+			strconv.FormatInt(bucket.AcceptedQuotesCount, 10),
+			rest.FormatWeiAmount(bucket.AcceptedQuotesAmount),
+			strconv.FormatInt(bucket.PaidQuotesCount, 10),
+			rest.FormatWeiAmount(bucket.PaidQuotesAmount),
+			strconv.FormatInt(bucket.RefundedQuotesCount, 10),
+			rest.FormatWeiAmount(bucket.RefundedQuotesAmount),
+			strconv.FormatInt(bucket.PenalizationsCount, 10),
+			rest.FormatWeiAmount(bucket.PenalizationsAmount),
+			rest.FormatWeiAmount(bucket.CallFees),
+			rest.FormatWeiAmount(bucket.GasFeesCollected),
+			rest.FormatWeiAmount(bucket.GasSpent),
+			rest.FormatWeiAmount(bucket.BtcFeesPaid),
+			rest.FormatWeiAmount(bucket.NetProfit),
+		})
+	}
+	return rest.Table{
+		Header: []string{
+			"periodStart", "direction", "ownerAccountAddress", "totalQuotesCount", "acceptedQuotesCount", "acceptedQuotesAmount",
+			"paidQuotesCount", "paidQuotesAmount", "refundedQuotesCount", "refundedQuotesAmount", "penalizationsCount",
+			"penalizationsAmount", "callFees", "gasFeesCollected", "gasSpent", "btcFeesPaid", "netProfit",
+		},
+		Rows: rows,
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"testing"
 
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
 	"github.com/rsksmart/liquidity-provider-server/test/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -73,6 +76,47 @@ func TestSetGeneralConfigHandler(t *testing.T) {
 		assert.Contains(t, w.Body.String(), "must not be empty")
 		useCase.AssertNotCalled(t, "Run")
 	})
+	t.Run("should accept liquidity thresholds", func(t *testing.T) {
+		useCase := new(mocks.SetGeneralConfigUseCaseMock)
+		useCase.EXPECT().Run(mock.Anything, mock.MatchedBy(func(config liquidity_provider.GeneralConfiguration) bool {
+			return assert.Equal(t, entities.NewWei(5000), config.LiquidityThresholds.Pegin.Warning.Amount) &&
+				assert.Nil(t, config.LiquidityThresholds.Pegin.Critical) &&
+				assert.Equal(t, utils.NewBigFloat64(1.5), config.LiquidityThresholds.Pegout.Critical.MaxValueMultiplier)
+		})).Return(nil)
+
+		handler := handlers.NewSetGeneralConfigHandler(useCase)
+		reqBody := `{"configuration": {"btcConfirmations": {"5": 10}, "rskConfirmations": {"10": 20}, "liquidityThresholds": {"pegin": {"warning": {"amount": 5000}}, "pegout": {"critical": {"maxValueMultiplier": 1.5}}}}}`
+		req := httptest.NewRequest(http.MethodPost, "/configuration", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		handler(w, req)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		useCase.AssertExpectations(t)
+	})
+	t.Run("should return bad request if the liquidity multiplier is not positive", func(t *testing.T) {
+		useCase := new(mocks.SetGeneralConfigUseCaseMock)
+		handler := handlers.NewSetGeneralConfigHandler(useCase)
+		reqBody := `{"configuration": {"btcConfirmations": {"5": 10}, "rskConfirmations": {"10": 20}, "liquidityThresholds": {"pegin": {"warning": {"maxValueMultiplier": -1}}, "pegout": {}}}}`
+		req := httptest.NewRequest(http.MethodPost, "/configuration", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		handler(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		useCase.AssertNotCalled(t, "Run")
+	})
+	t.Run("should return bad request if the liquidity thresholds are invalid", func(t *testing.T) {
+		useCase := new(mocks.SetGeneralConfigUseCaseMock)
+		useCase.EXPECT().Run(mock.Anything, mock.Anything).Return(liquidity_provider.InvalidLiquidityThresholdError)
+
+		handler := handlers.NewSetGeneralConfigHandler(useCase)
+		reqBody := `{"configuration": {"btcConfirmations": {"5": 10}, "rskConfirmations": {"10": 20}, "liquidityThresholds": {"pegin": {"warning": {}}, "pegout": {}}}}`
+		req := httptest.NewRequest(http.MethodPost, "/configuration", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		handler(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		useCase.AssertExpectations(t)
+	})
 	t.Run("should return server internal error if the request validation fails", func(t *testing.T) {
 		useCase := new(mocks.SetGeneralConfigUseCaseMock)
 		useCase.EXPECT().Run(mock.Anything, mock.Anything).Return(assert.AnError)
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	lpEntity "github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/pkg"
@@ -30,7 +31,8 @@ func NewSetPeginConfigHandler(useCase *liquidity_provider.SetPeginConfigUseCase)
 		err = useCase.Run(req.Context(), pkg.FromPeginConfigurationDTO(request.Configuration))
 		if err != nil {
 			// Check if this is a validation error
-			if errors.Is(err, usecases.TxBelowMinimumError) || errors.Is(err, usecases.NonPositiveWeiError) {
+			if errors.Is(err, usecases.TxBelowMinimumError) || errors.Is(err, usecases.NonPositiveWeiError) ||
+				errors.Is(err, lpEntity.InvalidFeeScheduleError) {
 				jsonErr := rest.NewErrorResponseWithDetails("Validation error", rest.DetailsFromError(err), true)
 				rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
 			} else {
@@ -10,6 +10,7 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	lp "github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
 	uc_lp "github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/test/mocks"
 	"github.com/stretchr/testify/assert"
@@ -93,6 +94,51 @@ func TestSetPeginConfigHandler(t *testing.T) {
 		assert.Contains(t, w.Body.String(), "Validation error")
 	})
 
+	t.Run("should save the fee schedule", func(t *testing.T) {
+		lpRepository := &mocks.LiquidityProviderRepositoryMock{}
+		lpRepository.On("UpsertPeginConfiguration", mock.Anything, mock.MatchedBy(func(config entities.Signed[lp.PeginConfiguration]) bool {
+			return len(config.Value.FeeTiers) == 2 && len(config.Value.FeeSurcharges.TimeOfDay) == 1
+		})).Return(nil)
+		walletMock := &mocks.RskWalletMock{}
+		walletMock.On("SignBytes", mock.Anything).Return([]byte{1, 2, 3}, nil)
+		hashMock := &mocks.HashMock{}
+		hashMock.On("Hash", mock.Anything).Return([]byte{4, 5, 6})
+		bridge := &mocks.BridgeMock{}
+		bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(100), nil)
+		contracts := blockchain.RskContracts{Bridge: bridge}
+		useCase := uc_lp.NewSetPeginConfigUseCase(lpRepository, walletMock, hashMock.Hash, contracts)
+		handler := handlers.NewSetPeginConfigHandler(useCase)
+		reqBody := `{"configuration": {"timeForDeposit": 600, "callTime": 300, "penaltyFee": "1000", "fixedFee": "500", "feePercentage": 1.5, "maxValue": "10000000", "minValue": "1000",` +
+			`"feeTiers": [{"maxAmount": "5000", "fixedFee": "100", "feePercentage": 2}, {"maxAmount": "50000", "fixedFee": "0", "feePercentage": 1.75}],` +
+			`"feeSurcharges": {"timeOfDay": [{"startHour": 22, "endHour": 6, "feePercentage": 0.5}]}}}`
+		req := httptest.NewRequest(http.MethodPost, "/pegin/configuration", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		handler(w, req)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		lpRepository.AssertExpectations(t)
+	})
+
+	t.Run("should return bad request for invalid fee schedule", func(t *testing.T) {
+		bridge := &mocks.BridgeMock{}
+		bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(100), nil)
+		contracts := blockchain.RskContracts{Bridge: bridge}
+		useCase := uc_lp.NewSetPeginConfigUseCase(&mocks.LiquidityProviderRepositoryMock{}, &mocks.RskWalletMock{}, (&mocks.HashMock{}).Hash, contracts)
+		handler := handlers.NewSetPeginConfigHandler(useCase)
+		for _, schedule := range []string{
+			`"feeTiers": [{"maxAmount": "5000", "fixedFee": "100", "feePercentage": 2}, {"maxAmount": "5000", "fixedFee": "0", "feePercentage": 1}]`,
+			`"feeSurcharges": {"timeOfDay": [{"startHour": 24, "endHour": 6, "feePercentage": 0.5}]}`,
+			`"feeSurcharges": {"utilization": [{"threshold": {}, "feePercentage": 0.5}]}`,
+		} {
+			reqBody := `{"configuration": {"timeForDeposit": 600, "callTime": 300, "penaltyFee": "1000", "fixedFee": "500", "feePercentage": 1.5, "maxValue": "10000000", "minValue": "1000", ` + schedule + `}}`
+			req := httptest.NewRequest(http.MethodPost, "/pegin/configuration", strings.NewReader(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			handler(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Code, schedule)
+		}
+	})
+
 	t.Run("should return server internal error if use case fails with non-validation error", func(t *testing.T) {
 		lpRepository := &mocks.LiquidityProviderRepositoryMock{}
 		lpRepository.On("UpsertPeginConfiguration", mock.Anything, mock.Anything).Return(assert.AnError)
@@ -12,6 +12,7 @@ const (
 	PegOutReportSingleFlightKey    = "pegout-report-singleflight"
 	RevenueReportSingleFlightKey   = "revenue-report-singleflight"
 	SummariesReportSingleFlightKey = "summaries-report-singleflight"
+	AnalyticsReportSingleFlightKey = "analytics-report-singleflight"
 )
 
 var SingleFlightGroup = new(singleflight.Group)
@@ -5,7 +5,6 @@ import (
 	"net/http"
 
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
-	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	lp "github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
 	lpuc "github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/pkg"
@@ -26,14 +25,13 @@ func NewUpdateTrustedAccountHandler(useCase *lpuc.UpdateTrustedAccountUseCase) h
 		} else if err = rest.ValidateRequest(w, request); err != nil {
 			return
 		}
-		accountDetails := lp.TrustedAccountDetails{
-			Address:        request.Address,
-			Name:           request.Name,
-			BtcLockingCap:  entities.NewBigWei(request.BtcLockingCap),
-			RbtcLockingCap: entities.NewBigWei(request.RbtcLockingCap),
-		}
+		accountDetails := pkg.FromTrustedAccountRequest(*request)
 		err = useCase.Run(req.Context(), accountDetails)
-		if errors.Is(err, lp.TrustedAccountNotFoundError) {
+		if errors.Is(err, lp.InvalidPartnerTermsError) {
+			jsonErr := rest.NewErrorResponseWithDetails("Validation error", rest.DetailsFromError(err), true)
+			rest.JsonErrorResponse(w, http.StatusBadRequest, jsonErr)
+			return
+		} else if errors.Is(err, lp.TrustedAccountNotFoundError) {
 			jsonErr := rest.NewErrorResponse(lp.TrustedAccountNotFoundError.Error(), true)
 			rest.JsonErrorResponse(w, http.StatusNotFound, jsonErr)
 			return
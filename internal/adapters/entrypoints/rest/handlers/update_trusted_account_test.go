@@ -67,6 +67,26 @@ func TestNewUpdateTrustedAccountHandler(t *testing.T) {
 		handler.ServeHTTP(recorder, request)
 		assert.Equal(t, http.StatusBadRequest, recorder.Code)
 	})
+	t.Run("should return 400 on invalid partner terms", func(t *testing.T) {
+		reqBody := pkg.TrustedAccountRequest{
+			Address:        "0x7C4890A0f1D4bBf2C669Ac2d1efFa185c505359b",
+			Name:           "Test Account",
+			BtcLockingCap:  big.NewInt(1000),
+			RbtcLockingCap: big.NewInt(2000),
+			PegoutTerms:    &pkg.PartnerTermsDTO{FixedFee: big.NewInt(-1)},
+		}
+		jsonBody, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest("PUT", "/management/trusted-accounts", bytes.NewBuffer(jsonBody))
+		request.Header.Set("Content-Type", "application/json")
+		repo := &mocks.TrustedAccountRepositoryMock{}
+		useCase := lpuc.NewUpdateTrustedAccountUseCase(repo, &mocks.TransactionSignerMock{}, (&mocks.HashMock{}).Hash)
+		handler := http.HandlerFunc(handlers.NewUpdateTrustedAccountHandler(useCase))
+		handler.ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		repo.AssertNotCalled(t, "UpdateTrustedAccount")
+	})
 	t.Run("should return 404 when account not found", func(t *testing.T) {
 		request := createValidRequest()
 		recorder := httptest.NewRecorder()
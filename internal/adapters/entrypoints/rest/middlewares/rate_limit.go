@@ -0,0 +1,143 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	RetryAfterHeader     = "Retry-After"
+	forwardedForHeader   = "X-Forwarded-For"
+	maxRateLimitBodySize = 1 << 20 // 1 MB
+)
+
+type RateLimitConfig struct {
+	// Group is included in the keys of the buckets, so the limits of each group are independent
+	Group string
+	Limit entities.RateLimit
+	// PartnerKey returns the identity of the partner that signed the request, which is limited independently of
+	// the client IP. If it is nil or returns an empty string the requests are only limited by client IP. The identity
+	// must be authenticated, otherwise any client could exhaust the limit of other identities
+	PartnerKey func(r *http.Request) string
+	// Cost returns the amount of tokens that the request consumes. If it is nil every request consumes one token
+	Cost func(r *http.Request) uint64
+	// TrustForwardedFor makes the client IP be taken from the X-Forwarded-For header. It should only
+	// be enabled when the server is behind a proxy that sets that header
+	TrustForwardedFor bool
+}
+
+func NewRateLimitMiddleware(limiter entities.RateLimiter, config RateLimitConfig) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			keys := []string{config.Group + ":ip:" + clientIp(r, config.TrustForwardedFor)}
+			if config.PartnerKey != nil {
+				if partner := config.PartnerKey(r); partner != "" {
+					keys = append(keys, config.Group+":partner:"+partner)
+				}
+			}
+			cost := uint64(1)
+			if config.Cost != nil {
+				cost = config.Cost(r)
+			}
+			for _, key := range keys {
+				result, err := limiter.Take(r.Context(), key, config.Limit, cost)
+				if err != nil {
+					// the limits are not enforced if the store is not available to avoid a denial of service
+					log.Errorf("Error checking rate limit of %s: %v", key, err)
+					continue
+				}
+				if !result.Allowed {
+					retryAfter := int64(math.Ceil(result.RetryAfter.Seconds()))
+					w.Header().Set(RetryAfterHeader, strconv.FormatInt(max(retryAfter, 1), 10))
+					jsonErr := rest.NewErrorResponse("too many requests", true)
+					rest.JsonErrorResponse(w, http.StatusTooManyRequests, jsonErr)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func clientIp(r *http.Request, trustForwardedFor bool) string {
+	if forwardedFor := r.Header.Get(forwardedForHeader); trustForwardedFor && forwardedFor != "" {
+		// the last address is the one added by the proxy, the previous ones can be forged by the client
+		addresses := strings.Split(forwardedFor, ",")
+		return strings.TrimSpace(addresses[len(addresses)-1])
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// NewPartnerRateLimitKey returns a PartnerKey function that identifies the requests by the address that signed the
+// partner authentication headers. The requests with an invalid or expired signature are only limited by client IP,
+// they're rejected later by the handler
+func NewPartnerRateLimitKey(signatureHeader, timestampHeader string) func(r *http.Request) string {
+	return func(r *http.Request) string {
+		signature := r.Header.Get(signatureHeader)
+		if signature == "" {
+			return ""
+		}
+		timestamp, err := strconv.ParseInt(r.Header.Get(timestampHeader), 10, 64)
+		if err != nil {
+			return ""
+		}
+		body, err := peekRequestBody(r)
+		if err != nil {
+			return ""
+		}
+		auth := usecases.PartnerAuthentication{Signature: signature, Timestamp: timestamp, Payload: body}
+		signer, err := auth.Signer()
+		if err != nil {
+			return ""
+		}
+		return strings.ToLower(signer)
+	}
+}
+
+// QuoteBatchRateLimitCost returns the cost of a quote request, which is the amount of requests of the batch if the
+// body is a JSON array and one otherwise. That way a batch consumes the same tokens as requesting each quote
+// separately. The bodies that can't be parsed cost one token, they're rejected later by the handler
+func QuoteBatchRateLimitCost(r *http.Request) uint64 {
+	body, err := peekRequestBody(r)
+	if err != nil {
+		return 1
+	}
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		return 1
+	}
+	var items []json.RawMessage
+	if err = json.Unmarshal(trimmed, &items); err != nil {
+		return 1
+	}
+	return uint64(max(len(items), 1))
+}
+
+// peekRequestBody reads the body of the request without consuming it. Only the first maxRateLimitBodySize bytes
+// are read, the rest of the body is left for the handler
+func peekRequestBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRateLimitBodySize))
+	if err != nil {
+		return nil, err
+	}
+	r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+	return body, nil
+}
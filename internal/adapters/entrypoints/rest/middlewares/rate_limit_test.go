@@ -0,0 +1,178 @@
+package middlewares_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/middlewares"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestNewRateLimitMiddleware(t *testing.T) {
+	const (
+		body            = `{"rskRefundAddress":"0x79568C2989232DCa1840087D73d403602364c0D4","valueToTransfer":1}`
+		signatureHeader = "X-Partner-Signature"
+		timestampHeader = "X-Partner-Timestamp"
+	)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	limit := entities.RateLimit{PerMinute: 10, Burst: 5}
+	config := middlewares.RateLimitConfig{
+		Group:      "quote",
+		Limit:      limit,
+		PartnerKey: middlewares.NewPartnerRateLimitKey(signatureHeader, timestampHeader),
+	}
+	ipKey := "quote:ip:192.0.2.1"
+	partnerKey := "quote:partner:" + strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex())
+	next := func(t *testing.T) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			content, readErr := io.ReadAll(r.Body)
+			assert.NoError(t, readErr)
+			assert.JSONEq(t, body, string(content))
+			w.WriteHeader(http.StatusOK)
+		})
+	}
+	newRequest := func() *http.Request {
+		now := time.Now().Unix()
+		request := httptest.NewRequest(http.MethodPost, "/pegin/getQuote", bytes.NewBufferString(body))
+		request.RemoteAddr = "192.0.2.1:1234"
+		request.Header.Set(signatureHeader, test.SignPartnerRequest(t, key, now, []byte(body)))
+		request.Header.Set(timestampHeader, strconv.FormatInt(now, 10))
+		return request
+	}
+	t.Run("should allow requests with available tokens", func(t *testing.T) {
+		limiter := &mocks.RateLimiterMock{}
+		limiter.EXPECT().Take(test.AnyCtx, ipKey, limit, uint64(1)).Return(entities.RateLimitResult{Allowed: true}, nil).Once()
+		limiter.EXPECT().Take(test.AnyCtx, partnerKey, limit, uint64(1)).Return(entities.RateLimitResult{Allowed: true}, nil).Once()
+		recorder := httptest.NewRecorder()
+		middlewares.NewRateLimitMiddleware(limiter, config)(next(t)).ServeHTTP(recorder, newRequest())
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		limiter.AssertExpectations(t)
+	})
+	t.Run("should return 429 with Retry-After when the ip is limited", func(t *testing.T) {
+		limiter := &mocks.RateLimiterMock{}
+		limiter.EXPECT().Take(test.AnyCtx, ipKey, limit, uint64(1)).
+			Return(entities.RateLimitResult{Allowed: false, RetryAfter: 2500 * time.Millisecond}, nil).Once()
+		recorder := httptest.NewRecorder()
+		middlewares.NewRateLimitMiddleware(limiter, config)(next(t)).ServeHTTP(recorder, newRequest())
+		assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+		assert.Equal(t, "3", recorder.Header().Get(middlewares.RetryAfterHeader))
+		assert.Contains(t, recorder.Body.String(), "too many requests")
+		limiter.AssertExpectations(t)
+	})
+	t.Run("should return 429 when the partner is limited", func(t *testing.T) {
+		limiter := &mocks.RateLimiterMock{}
+		limiter.EXPECT().Take(test.AnyCtx, ipKey, limit, uint64(1)).Return(entities.RateLimitResult{Allowed: true}, nil).Once()
+		limiter.EXPECT().Take(test.AnyCtx, partnerKey, limit, uint64(1)).
+			Return(entities.RateLimitResult{Allowed: false, RetryAfter: time.Millisecond}, nil).Once()
+		recorder := httptest.NewRecorder()
+		middlewares.NewRateLimitMiddleware(limiter, config)(next(t)).ServeHTTP(recorder, newRequest())
+		assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+		assert.Equal(t, "1", recorder.Header().Get(middlewares.RetryAfterHeader))
+		limiter.AssertExpectations(t)
+	})
+	t.Run("should allow the request if the limiter fails", func(t *testing.T) {
+		limiter := &mocks.RateLimiterMock{}
+		limiter.EXPECT().Take(test.AnyCtx, ipKey, limit, uint64(1)).Return(entities.RateLimitResult{}, assert.AnError).Once()
+		limiter.EXPECT().Take(test.AnyCtx, partnerKey, limit, uint64(1)).Return(entities.RateLimitResult{}, assert.AnError).Once()
+		recorder := httptest.NewRecorder()
+		middlewares.NewRateLimitMiddleware(limiter, config)(next(t)).ServeHTTP(recorder, newRequest())
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		limiter.AssertExpectations(t)
+	})
+	t.Run("should only limit by ip if the request is not signed by a partner", func(t *testing.T) {
+		limiter := &mocks.RateLimiterMock{}
+		limiter.EXPECT().Take(test.AnyCtx, ipKey, limit, uint64(1)).Return(entities.RateLimitResult{Allowed: true}, nil).Once()
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodPost, "/pegin/getQuote", bytes.NewBufferString(body))
+		request.RemoteAddr = "192.0.2.1:1234"
+		middlewares.NewRateLimitMiddleware(limiter, config)(next(t)).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		limiter.AssertExpectations(t)
+	})
+	t.Run("should only limit by ip if the partner signature is invalid", func(t *testing.T) {
+		expired := time.Now().Add(-time.Hour).Unix()
+		requests := []*http.Request{newRequest(), newRequest(), newRequest()}
+		requests[0].Header.Set(signatureHeader, "0102")
+		requests[1].Header.Set(timestampHeader, "yesterday")
+		requests[2].Header.Set(signatureHeader, test.SignPartnerRequest(t, key, expired, []byte(body)))
+		requests[2].Header.Set(timestampHeader, strconv.FormatInt(expired, 10))
+		for _, request := range requests {
+			limiter := &mocks.RateLimiterMock{}
+			limiter.EXPECT().Take(test.AnyCtx, ipKey, limit, uint64(1)).Return(entities.RateLimitResult{Allowed: true}, nil).Once()
+			recorder := httptest.NewRecorder()
+			middlewares.NewRateLimitMiddleware(limiter, config)(next(t)).ServeHTTP(recorder, request)
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			limiter.AssertExpectations(t)
+		}
+	})
+	t.Run("should charge the cost of the request to every key", func(t *testing.T) {
+		limiter := &mocks.RateLimiterMock{}
+		limiter.EXPECT().Take(test.AnyCtx, ipKey, limit, uint64(3)).Return(entities.RateLimitResult{Allowed: true}, nil).Once()
+		limiter.EXPECT().Take(test.AnyCtx, partnerKey, limit, uint64(3)).Return(entities.RateLimitResult{Allowed: true}, nil).Once()
+		costConfig := config
+		costConfig.Cost = func(_ *http.Request) uint64 { return 3 }
+		recorder := httptest.NewRecorder()
+		middlewares.NewRateLimitMiddleware(limiter, costConfig)(next(t)).ServeHTTP(recorder, newRequest())
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		limiter.AssertExpectations(t)
+	})
+	t.Run("should only limit by ip if there is no partner key", func(t *testing.T) {
+		limiter := &mocks.RateLimiterMock{}
+		limiter.EXPECT().Take(test.AnyCtx, "default:ip:192.0.2.1", limit, uint64(1)).Return(entities.RateLimitResult{Allowed: true}, nil).Once()
+		recorder := httptest.NewRecorder()
+		middlewares.NewRateLimitMiddleware(limiter, middlewares.RateLimitConfig{Group: "default", Limit: limit})(next(t)).
+			ServeHTTP(recorder, newRequest())
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		limiter.AssertExpectations(t)
+	})
+	t.Run("should use the X-Forwarded-For header only if trusted", func(t *testing.T) {
+		for _, trusted := range []bool{true, false} {
+			expectedKey := "default:ip:192.0.2.1"
+			if trusted {
+				expectedKey = "default:ip:198.51.100.7"
+			}
+			limiter := &mocks.RateLimiterMock{}
+			limiter.EXPECT().Take(test.AnyCtx, expectedKey, limit, uint64(1)).Return(entities.RateLimitResult{Allowed: true}, nil).Once()
+			request := httptest.NewRequest(http.MethodGet, "/providers/details", nil)
+			request.RemoteAddr = "192.0.2.1:1234"
+			request.Header.Set("X-Forwarded-For", "203.0.113.5, 198.51.100.7")
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+			rateLimitConfig := middlewares.RateLimitConfig{Group: "default", Limit: limit, TrustForwardedFor: trusted}
+			middlewares.NewRateLimitMiddleware(limiter, rateLimitConfig)(handler).ServeHTTP(httptest.NewRecorder(), request)
+			limiter.AssertExpectations(t)
+		}
+	})
+}
+
+func TestQuoteBatchRateLimitCost(t *testing.T) {
+	cases := []struct {
+		body     string
+		expected uint64
+	}{
+		{body: `{"valueToTransfer":1}`, expected: 1},
+		{body: ` [{"valueToTransfer":1},{"valueToTransfer":2},{"valueToTransfer":3}]`, expected: 3},
+		{body: `[]`, expected: 1},
+		{body: `[{"valueToTransfer":1},`, expected: 1},
+		{body: ``, expected: 1},
+	}
+	for _, c := range cases {
+		request := httptest.NewRequest(http.MethodPost, "/pegin/getQuotes", bytes.NewBufferString(c.body))
+		assert.Equal(t, c.expected, middlewares.QuoteBatchRateLimitCost(request))
+		content, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+		assert.Equal(t, c.body, string(content))
+	}
+}
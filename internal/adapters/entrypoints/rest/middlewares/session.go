@@ -1,14 +1,15 @@
 package middlewares
 
 import (
+	"bytes"
 	"net/http"
+	"sync"
 
 	"github.com/gorilla/csrf"
 	"github.com/gorilla/sessions"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/server/cookies"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
-	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -50,16 +51,53 @@ func sessionMiddleware(store sessions.Store) func(next http.Handler) http.Handle
 }
 
 func csrfMiddleware(env environment.ManagementEnv) func(next http.Handler) http.Handler {
-	authKey, err := utils.DecodeKey(env.SessionTokenAuthKey, cookies.KeysBytesLength)
+	authKey, err := cookies.CsrfAuthKey(env)
 	if err != nil {
 		log.Fatalf("error decoding session token auth key: %v", err)
 	}
-	return csrf.Protect(
+	return func(next http.Handler) http.Handler {
+		handler := &rotatingCsrfHandler{env: env, next: next}
+		handler.protect(authKey)
+		return handler
+	}
+}
+
+// rotatingCsrfHandler applies the CSRF protection using the key returned by cookies.CsrfAuthKey, so the protection
+// is rebuilt when the key is rotated
+type rotatingCsrfHandler struct {
+	env       environment.ManagementEnv
+	next      http.Handler
+	mutex     sync.RWMutex
+	authKey   []byte
+	protected http.Handler
+}
+
+func (handler *rotatingCsrfHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	authKey, err := cookies.CsrfAuthKey(handler.env)
+	if err != nil {
+		jsonErr := rest.NewErrorResponseWithDetails("CRSF token validation error", rest.DetailsFromError(err), false)
+		rest.JsonErrorResponse(w, http.StatusInternalServerError, jsonErr)
+		return
+	}
+	handler.mutex.RLock()
+	protected, currentKey := handler.protected, handler.authKey
+	handler.mutex.RUnlock()
+	if !bytes.Equal(authKey, currentKey) {
+		protected = handler.protect(authKey)
+	}
+	protected.ServeHTTP(w, r)
+}
+
+func (handler *rotatingCsrfHandler) protect(authKey []byte) http.Handler {
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+	handler.authKey = authKey
+	handler.protected = csrf.Protect(
 		authKey,
 		csrf.MaxAge(cookies.SessionMaxSeconds),
 		csrf.CookieName(cookies.CsrfCookieName),
 		csrf.Path("/"),
-		csrf.Secure(env.UseHttps),
+		csrf.Secure(handler.env.UseHttps),
 		csrf.HttpOnly(true),
 		csrf.SameSite(csrf.SameSiteStrictMode),
 		csrf.ErrorHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -67,5 +105,6 @@ func csrfMiddleware(env environment.ManagementEnv) func(next http.Handler) http.
 			jsonErr := rest.NewErrorResponseWithDetails("CRSF token validation error", details, true)
 			rest.JsonErrorResponse(w, http.StatusForbidden, jsonErr)
 		})),
-	)
+	)(handler.next)
+	return handler.protected
 }
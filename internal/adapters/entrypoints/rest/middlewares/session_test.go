@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/gorilla/csrf"
 	"github.com/gorilla/sessions"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/middlewares"
@@ -177,6 +178,42 @@ func TestSessionMiddleware_SessionSaveError_StillProcessesRequest(t *testing.T)
 	mockHandler.AssertCalled(t, "ServeHTTP", rr, req)
 }
 
+func TestCsrfMiddleware_KeyRotation(t *testing.T) {
+	const csrfHeader = "X-Csrf-Token"
+	env := mockManagementEnv()
+	middleware := middlewares.NewSessionMiddlewares(env, mocks.NewStoreMock(t))
+	handler := middleware.Csrf(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(csrfHeader, csrf.Token(r))
+		w.WriteHeader(http.StatusOK)
+	}))
+	getToken := func(t *testing.T) (string, *http.Cookie) {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/test", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		// nolint:bodyclose
+		return rr.Header().Get(csrfHeader), rr.Result().Cookies()[0]
+	}
+	post := func(token string, cookie *http.Cookie) int {
+		req := httptest.NewRequest(http.MethodPost, "/test", nil)
+		req.Header.Set(csrfHeader, token)
+		req.AddCookie(cookie)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	token, cookie := getToken(t)
+	require.Equal(t, http.StatusOK, post(token, cookie))
+
+	rotatedEnv := env
+	rotatedEnv.SessionTokenAuthKey = "04fbac02d66202e8468d2a4f1deba4fa5c2491f592e0e22e32fe1e6acac25923"
+	require.NoError(t, cookies.RotateSessionKeys(rotatedEnv))
+	assert.Equal(t, http.StatusForbidden, post(token, cookie))
+
+	token, cookie = getToken(t)
+	assert.Equal(t, http.StatusOK, post(token, cookie))
+}
+
 // Helper function to create a mock management environment
 func mockManagementEnv() environment.ManagementEnv {
 	return environment.ManagementEnv{
@@ -0,0 +1,60 @@
+package middlewares
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+)
+
+type StreamLimitConfig struct {
+	// MaxPerIp is the maximum amount of streams that a client IP can have open at the same time
+	MaxPerIp uint64
+	// MaxTotal is the maximum amount of streams open at the same time in the server
+	MaxTotal uint64
+	// TrustForwardedFor makes the client IP be taken from the X-Forwarded-For header. It should only
+	// be enabled when the server is behind a proxy that sets that header
+	TrustForwardedFor bool
+}
+
+// NewStreamLimitMiddleware limits the amount of long-lived responses (like the Server-Sent Events streams) open
+// at the same time. Unlike the rate limit, the limits are not applied to the requests but to the open connections,
+// so a slot is taken while the handler is running and released when it returns. The same middleware must be used
+// for all the stream endpoints so the limits are shared between them
+func NewStreamLimitMiddleware(config StreamLimitConfig) func(http.Handler) http.Handler {
+	var mutex sync.Mutex
+	var total uint64
+	perIp := make(map[string]uint64)
+
+	acquire := func(ip string) bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if total >= config.MaxTotal || perIp[ip] >= config.MaxPerIp {
+			return false
+		}
+		total++
+		perIp[ip]++
+		return true
+	}
+	release := func(ip string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		total--
+		if perIp[ip]--; perIp[ip] == 0 {
+			delete(perIp, ip)
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := clientIp(r, config.TrustForwardedFor)
+			if !acquire(ip) {
+				jsonErr := rest.NewErrorResponse("too many open streams", true)
+				rest.JsonErrorResponse(w, http.StatusTooManyRequests, jsonErr)
+				return
+			}
+			defer release(ip)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
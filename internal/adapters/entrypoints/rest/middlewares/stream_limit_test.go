@@ -0,0 +1,94 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/middlewares"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestNewStreamLimitMiddleware(t *testing.T) {
+	newRequest := func(ip string) *http.Request {
+		request := httptest.NewRequest(http.MethodGet, "/pegin/status/stream", nil)
+		request.RemoteAddr = ip + ":1234"
+		return request
+	}
+	// openStreams starts the requests and waits until all of them are being handled, the returned
+	// function finishes the open streams and waits for the handlers to return
+	openStreams := func(t *testing.T, middleware func(http.Handler) http.Handler, ips ...string) ([]*httptest.ResponseRecorder, func()) {
+		release := make(chan struct{})
+		var started, finished sync.WaitGroup
+		handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			started.Done()
+			<-release
+			w.WriteHeader(http.StatusOK)
+		}))
+		recorders := make([]*httptest.ResponseRecorder, len(ips))
+		for i, ip := range ips {
+			recorders[i] = httptest.NewRecorder()
+			started.Add(1)
+			finished.Add(1)
+			go func() {
+				defer finished.Done()
+				handler.ServeHTTP(recorders[i], newRequest(ip))
+			}()
+		}
+		started.Wait()
+		return recorders, func() {
+			close(release)
+			finished.Wait()
+			for _, recorder := range recorders {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			}
+		}
+	}
+	blockingHandler := func(t *testing.T) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("handler should not be called")
+		})
+	}
+
+	t.Run("should limit the streams of the same ip", func(t *testing.T) {
+		middleware := middlewares.NewStreamLimitMiddleware(middlewares.StreamLimitConfig{MaxPerIp: 2, MaxTotal: 10})
+		_, finish := openStreams(t, middleware, "192.0.2.1", "192.0.2.1")
+		recorder := httptest.NewRecorder()
+		middleware(blockingHandler(t)).ServeHTTP(recorder, newRequest("192.0.2.1"))
+		assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "too many open streams")
+		_, finishOther := openStreams(t, middleware, "192.0.2.2")
+		finishOther()
+		finish()
+	})
+	t.Run("should limit the streams of the server", func(t *testing.T) {
+		middleware := middlewares.NewStreamLimitMiddleware(middlewares.StreamLimitConfig{MaxPerIp: 2, MaxTotal: 3})
+		_, finish := openStreams(t, middleware, "192.0.2.1", "192.0.2.2", "192.0.2.3")
+		recorder := httptest.NewRecorder()
+		middleware(blockingHandler(t)).ServeHTTP(recorder, newRequest("192.0.2.4"))
+		assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+		finish()
+	})
+	t.Run("should release the slots when the streams are closed", func(t *testing.T) {
+		middleware := middlewares.NewStreamLimitMiddleware(middlewares.StreamLimitConfig{MaxPerIp: 1, MaxTotal: 1})
+		_, finish := openStreams(t, middleware, "192.0.2.1")
+		finish()
+		_, finish = openStreams(t, middleware, "192.0.2.1")
+		finish()
+	})
+	t.Run("should use the forwarded ip when configured", func(t *testing.T) {
+		middleware := middlewares.NewStreamLimitMiddleware(middlewares.StreamLimitConfig{MaxPerIp: 1, MaxTotal: 10, TrustForwardedFor: true})
+		_, finish := openStreams(t, middleware, "192.0.2.1")
+		request := newRequest("192.0.2.1")
+		request.Header.Set("X-Forwarded-For", "198.51.100.7")
+		recorder := httptest.NewRecorder()
+		middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})).ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		finish()
+	})
+}
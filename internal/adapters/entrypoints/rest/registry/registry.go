@@ -6,13 +6,18 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegin"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegout"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/webhook"
 )
 
 type UseCaseRegistry interface {
 	GetPeginQuoteUseCase() *pegin.GetQuoteUseCase
+	GetPeginQuotesUseCase() *pegin.GetQuotesUseCase
+	EstimatePeginQuoteUseCase() *pegin.EstimateQuoteUseCase
 	GetAcceptPeginQuoteUseCase() *pegin.AcceptQuoteUseCase
 	GetProviderDetailUseCase() *liquidity_provider.GetDetailUseCase
 	GetPegoutQuoteUseCase() *pegout.GetQuoteUseCase
+	GetPegoutQuotesUseCase() *pegout.GetQuotesUseCase
+	EstimatePegoutQuoteUseCase() *pegout.EstimateQuoteUseCase
 	GetAcceptPegoutQuoteUseCase() *pegout.AcceptQuoteUseCase
 	GetUserDepositsUseCase() *pegout.GetUserDepositsUseCase
 	GetProvidersUseCase() *liquidity_provider.GetProvidersUseCase
@@ -40,7 +45,12 @@ type UseCaseRegistry interface {
 	GetPeginReportUseCase() *reports.GetPeginReportUseCase
 	GetPegoutReportUseCase() *reports.GetPegoutReportUseCase
 	GetRevenueReportUseCase() *reports.GetRevenueReportUseCase
+	GetRevenueJournalUseCase() *reports.GetRevenueJournalUseCase
+	GetAnalyticsReportUseCase() *reports.GetAnalyticsReportUseCase
+	GetQuotePnlUseCase() *reports.GetQuotePnlUseCase
+	GetReportSnapshotsUseCase() *reports.GetReportSnapshotsUseCase
 	GetAssetsReportUseCase() *reports.GetAssetsReportUseCase
+	GetAssetHistoryUseCase() *reports.GetAssetHistoryUseCase
 	GetTransactionsReportUseCase() *reports.GetTransactionsUseCase
 	GetTrustedAccountsUseCase() *liquidity_provider.GetTrustedAccountsUseCase
 	UpdateTrustedAccountUseCase() *liquidity_provider.UpdateTrustedAccountUseCase
@@ -48,4 +58,13 @@ type UseCaseRegistry interface {
 	DeleteTrustedAccountUseCase() *liquidity_provider.DeleteTrustedAccountUseCase
 	RecommendedPegoutUseCase() *pegout.RecommendedPegoutUseCase
 	RecommendedPeginUseCase() *pegin.RecommendedPeginUseCase
+	GetRecentAlertsUseCase() *liquidity_provider.GetRecentAlertsUseCase
+	RegisterWebhookUseCase() *webhook.RegisterWebhookUseCase
+	GetWebhooksUseCase() *webhook.GetWebhooksUseCase
+	DeleteWebhookUseCase() *webhook.DeleteWebhookUseCase
+	StreamQuoteStateUseCase() *webhook.StreamQuoteStateUseCase
+	GetPeginDepositConfirmationsUseCase() *pegin.DepositConfirmationsUseCase
+	GetPegoutDepositConfirmationsUseCase() *pegout.DepositConfirmationsUseCase
+	GetLiquidityHoldsUseCase() *liquidity_provider.GetLiquidityHoldsUseCase
+	ReconcileLiquidityLedgerUseCase() *liquidity_provider.ReconcileLiquidityLedgerUseCase
 }
@@ -114,11 +114,40 @@ func GetManagementEndpoints(env environment.Environment, useCaseRegistry registr
 				useCaseRegistry.GetRevenueReportUseCase(),
 			),
 		},
+		{
+			Path:    "/reports/revenue/journal",
+			Method:  http.MethodGet,
+			Handler: handlers.NewGetReportsRevenueJournalHandler(useCaseRegistry.GetRevenueJournalUseCase()),
+		},
+		{
+			Path:   "/reports/analytics",
+			Method: http.MethodGet,
+			Handler: handlers.NewGetReportsAnalyticsHandler(
+				handlers.SingleFlightGroup,
+				handlers.AnalyticsReportSingleFlightKey,
+				useCaseRegistry.GetAnalyticsReportUseCase(),
+			),
+		},
+		{
+			Path:    "/reports/quote/pnl",
+			Method:  http.MethodGet,
+			Handler: handlers.NewGetReportsQuotePnlHandler(useCaseRegistry.GetQuotePnlUseCase()),
+		},
+		{
+			Path:    "/reports/snapshots",
+			Method:  http.MethodGet,
+			Handler: handlers.NewGetReportSnapshotsHandler(useCaseRegistry.GetReportSnapshotsUseCase()),
+		},
 		{
 			Path:    "/reports/assets",
 			Method:  http.MethodGet,
 			Handler: handlers.NewGetReportsAssetsHandler(useCaseRegistry.GetAssetsReportUseCase()),
 		},
+		{
+			Path:    "/reports/assets/history",
+			Method:  http.MethodGet,
+			Handler: handlers.NewGetAssetHistoryHandler(useCaseRegistry.GetAssetHistoryUseCase()),
+		},
 		{
 			Path:    "/reports/transactions",
 			Method:  http.MethodGet,
@@ -174,5 +203,35 @@ func GetManagementEndpoints(env environment.Environment, useCaseRegistry registr
 			Method:  http.MethodDelete,
 			Handler: handlers.NewDeleteTrustedAccountHandler(useCaseRegistry.DeleteTrustedAccountUseCase()),
 		},
+		{
+			Path:    "/management/alerts",
+			Method:  http.MethodGet,
+			Handler: handlers.NewGetRecentAlertsHandler(useCaseRegistry.GetRecentAlertsUseCase()),
+		},
+		{
+			Path:    "/management/webhooks",
+			Method:  http.MethodGet,
+			Handler: handlers.NewGetWebhooksHandler(useCaseRegistry.GetWebhooksUseCase()),
+		},
+		{
+			Path:    "/management/webhooks",
+			Method:  http.MethodPost,
+			Handler: handlers.NewAddWebhookHandler(useCaseRegistry.RegisterWebhookUseCase()),
+		},
+		{
+			Path:    "/management/webhooks",
+			Method:  http.MethodDelete,
+			Handler: handlers.NewDeleteWebhookHandler(useCaseRegistry.DeleteWebhookUseCase()),
+		},
+		{
+			Path:    "/management/liquidity/holds",
+			Method:  http.MethodGet,
+			Handler: handlers.NewGetLiquidityHoldsHandler(useCaseRegistry.GetLiquidityHoldsUseCase()),
+		},
+		{
+			Path:    "/management/liquidity/reconcile",
+			Method:  http.MethodPost,
+			Handler: handlers.NewReconcileLiquidityLedgerHandler(useCaseRegistry.ReconcileLiquidityLedgerUseCase()),
+		},
 	}
 }
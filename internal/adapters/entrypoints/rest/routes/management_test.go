@@ -10,6 +10,7 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegin"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegout"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/webhook"
 	"github.com/rsksmart/liquidity-provider-server/test"
 	"github.com/rsksmart/liquidity-provider-server/test/mocks"
 	"github.com/stretchr/testify/assert"
@@ -37,12 +38,23 @@ func TestGetManagementEndpoints(t *testing.T) {
 	registryMock.EXPECT().GetPeginReportUseCase().Return(&reports.GetPeginReportUseCase{})
 	registryMock.EXPECT().GetPegoutReportUseCase().Return(&reports.GetPegoutReportUseCase{})
 	registryMock.EXPECT().GetRevenueReportUseCase().Return(&reports.GetRevenueReportUseCase{})
+	registryMock.EXPECT().GetRevenueJournalUseCase().Return(&reports.GetRevenueJournalUseCase{})
+	registryMock.EXPECT().GetAnalyticsReportUseCase().Return(&reports.GetAnalyticsReportUseCase{})
+	registryMock.EXPECT().GetQuotePnlUseCase().Return(&reports.GetQuotePnlUseCase{})
+	registryMock.EXPECT().GetReportSnapshotsUseCase().Return(&reports.GetReportSnapshotsUseCase{})
 	registryMock.EXPECT().GetAssetsReportUseCase().Return(&reports.GetAssetsReportUseCase{})
+	registryMock.EXPECT().GetAssetHistoryUseCase().Return(&reports.GetAssetHistoryUseCase{})
 	registryMock.EXPECT().GetTransactionsReportUseCase().Return(&reports.GetTransactionsUseCase{})
 	registryMock.EXPECT().GetTrustedAccountsUseCase().Return(&liquidity_provider.GetTrustedAccountsUseCase{})
 	registryMock.EXPECT().UpdateTrustedAccountUseCase().Return(&liquidity_provider.UpdateTrustedAccountUseCase{})
 	registryMock.EXPECT().AddTrustedAccountUseCase().Return(&liquidity_provider.AddTrustedAccountUseCase{})
 	registryMock.EXPECT().DeleteTrustedAccountUseCase().Return(&liquidity_provider.DeleteTrustedAccountUseCase{})
+	registryMock.EXPECT().GetRecentAlertsUseCase().Return(&liquidity_provider.GetRecentAlertsUseCase{})
+	registryMock.EXPECT().GetWebhooksUseCase().Return(&webhook.GetWebhooksUseCase{})
+	registryMock.EXPECT().RegisterWebhookUseCase().Return(&webhook.RegisterWebhookUseCase{})
+	registryMock.EXPECT().DeleteWebhookUseCase().Return(&webhook.DeleteWebhookUseCase{})
+	registryMock.EXPECT().GetLiquidityHoldsUseCase().Return(&liquidity_provider.GetLiquidityHoldsUseCase{})
+	registryMock.EXPECT().ReconcileLiquidityLedgerUseCase().Return(&liquidity_provider.ReconcileLiquidityLedgerUseCase{})
 
 	endpoints := routes.GetManagementEndpoints(environment.Environment{}, registryMock, &mocks.StoreMock{})
 	specBytes := test.ReadFile(t, "OpenApi.yml")
@@ -51,7 +63,7 @@ func TestGetManagementEndpoints(t *testing.T) {
 	err := yaml.Unmarshal(specBytes, spec)
 	require.NoError(t, err)
 
-	assert.Len(t, endpoints, 27)
+	assert.Len(t, endpoints, 38)
 	for _, endpoint := range endpoints {
 		if endpoint.Path != routes.IconPath && endpoint.Path != routes.StaticPath {
 			lowerCaseMethod := strings.ToLower(endpoint.Method)
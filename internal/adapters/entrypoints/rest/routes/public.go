@@ -8,9 +8,21 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/registry"
 )
 
+type RateLimitGroup string
+
+const (
+	DefaultRateLimitGroup RateLimitGroup = "default"
+	QuoteRateLimitGroup   RateLimitGroup = "quote"
+	AcceptRateLimitGroup  RateLimitGroup = "accept"
+)
+
 type PublicEndpoint struct {
 	Endpoint
 	RequiresCaptcha bool
+	// RateLimitGroup is the group whose limits apply to the endpoint, if empty the default group is used
+	RateLimitGroup RateLimitGroup
+	// Stream marks the endpoints that keep the connection open, they are also limited by the amount of open streams
+	Stream bool
 }
 
 // nolint:funlen
@@ -36,44 +48,74 @@ func GetPublicEndpoints(useCaseRegistry registry.UseCaseRegistry) []PublicEndpoi
 				Method:  http.MethodPost,
 				Handler: handlers.NewGetPeginQuoteHandler(useCaseRegistry.GetPeginQuoteUseCase()),
 			},
+			RateLimitGroup: QuoteRateLimitGroup,
+		},
+		{
+			Endpoint: Endpoint{
+				Path:    "/pegin/getQuotes",
+				Method:  http.MethodPost,
+				Handler: handlers.NewGetPeginQuotesHandler(useCaseRegistry.GetPeginQuoteUseCase(), useCaseRegistry.GetPeginQuotesUseCase()),
+			},
+			RateLimitGroup: QuoteRateLimitGroup,
+		},
+		{
+			Endpoint: Endpoint{
+				Path:    "/pegin/estimate",
+				Method:  http.MethodPost,
+				Handler: handlers.NewEstimatePeginQuoteHandler(useCaseRegistry.EstimatePeginQuoteUseCase()),
+			},
+			RateLimitGroup: QuoteRateLimitGroup,
 		},
 		{
 			Endpoint: Endpoint{
 				Path:    "/pegin/acceptQuote",
 				Method:  http.MethodPost,
-				Handler: handlers.NewAcceptPeginQuoteHandler(useCaseRegistry.GetAcceptPeginQuoteUseCase()),
+				Handler: handlers.NewAcceptPeginQuoteHandler(useCaseRegistry.GetAcceptPeginQuoteUseCase(), useCaseRegistry.RegisterWebhookUseCase()),
 			},
 			RequiresCaptcha: true,
+			RateLimitGroup:  AcceptRateLimitGroup,
 		},
 		{
 			Endpoint: Endpoint{
 				Path:    "/pegin/acceptAuthenticatedQuote",
 				Method:  http.MethodPost,
-				Handler: handlers.NewAcceptPeginAuthenticatedQuoteHandler(useCaseRegistry.GetAcceptPeginQuoteUseCase()),
+				Handler: handlers.NewAcceptPeginAuthenticatedQuoteHandler(useCaseRegistry.GetAcceptPeginQuoteUseCase(), useCaseRegistry.RegisterWebhookUseCase()),
 			},
+			RateLimitGroup: AcceptRateLimitGroup,
 		},
 		{
 			Endpoint: Endpoint{
 				Path:    "/pegout/getQuotes",
 				Method:  http.MethodPost,
-				Handler: handlers.NewGetPegoutQuoteHandler(useCaseRegistry.GetPegoutQuoteUseCase()),
+				Handler: handlers.NewGetPegoutQuotesHandler(useCaseRegistry.GetPegoutQuoteUseCase(), useCaseRegistry.GetPegoutQuotesUseCase()),
 			},
+			RateLimitGroup: QuoteRateLimitGroup,
+		},
+		{
+			Endpoint: Endpoint{
+				Path:    "/pegout/estimate",
+				Method:  http.MethodPost,
+				Handler: handlers.NewEstimatePegoutQuoteHandler(useCaseRegistry.EstimatePegoutQuoteUseCase()),
+			},
+			RateLimitGroup: QuoteRateLimitGroup,
 		},
 		{
 			Endpoint: Endpoint{
 				Path:    "/pegout/acceptQuote",
 				Method:  http.MethodPost,
-				Handler: handlers.NewAcceptPegoutQuoteHandler(useCaseRegistry.GetAcceptPegoutQuoteUseCase()),
+				Handler: handlers.NewAcceptPegoutQuoteHandler(useCaseRegistry.GetAcceptPegoutQuoteUseCase(), useCaseRegistry.RegisterWebhookUseCase()),
 			},
 			RequiresCaptcha: true,
+			RateLimitGroup:  AcceptRateLimitGroup,
 		},
 		{
 			Endpoint: Endpoint{
 				Path:    "/pegout/acceptAuthenticatedQuote",
 				Method:  http.MethodPost,
-				Handler: handlers.NewAcceptPegoutAuthenticatedQuoteHandler(useCaseRegistry.GetAcceptPegoutQuoteUseCase()),
+				Handler: handlers.NewAcceptPegoutAuthenticatedQuoteHandler(useCaseRegistry.GetAcceptPegoutQuoteUseCase(), useCaseRegistry.RegisterWebhookUseCase()),
 			},
 			RequiresCaptcha: false,
+			RateLimitGroup:  AcceptRateLimitGroup,
 		},
 		{
 			Endpoint: Endpoint{
@@ -103,6 +145,34 @@ func GetPublicEndpoints(useCaseRegistry registry.UseCaseRegistry) []PublicEndpoi
 				Handler: handlers.NewGetPegoutQuoteStatusHandler(useCaseRegistry.GetPegoutStatusUseCase()),
 			},
 		},
+		{
+			Endpoint: Endpoint{
+				Path:   "/pegin/status/stream",
+				Method: http.MethodGet,
+				Handler: handlers.NewGetPeginStatusStreamHandler(
+					useCaseRegistry.GetPeginStatusUseCase(),
+					useCaseRegistry.StreamQuoteStateUseCase(),
+					useCaseRegistry.GetPeginDepositConfirmationsUseCase(),
+					handlers.StatusStreamPollInterval,
+					handlers.StatusStreamMaxDuration,
+				),
+			},
+			Stream: true,
+		},
+		{
+			Endpoint: Endpoint{
+				Path:   "/pegout/status/stream",
+				Method: http.MethodGet,
+				Handler: handlers.NewGetPegoutStatusStreamHandler(
+					useCaseRegistry.GetPegoutStatusUseCase(),
+					useCaseRegistry.StreamQuoteStateUseCase(),
+					useCaseRegistry.GetPegoutDepositConfirmationsUseCase(),
+					handlers.StatusStreamPollInterval,
+					handlers.StatusStreamMaxDuration,
+				),
+			},
+			Stream: true,
+		},
 		{
 			Endpoint: Endpoint{
 				Path:    "/providers/liquidity",
@@ -123,6 +193,7 @@ func GetPublicEndpoints(useCaseRegistry registry.UseCaseRegistry) []PublicEndpoi
 				Method:  http.MethodGet,
 				Handler: handlers.NewRecommendedPegoutHandler(useCaseRegistry.RecommendedPegoutUseCase()),
 			},
+			RateLimitGroup: QuoteRateLimitGroup,
 		},
 		{
 			Endpoint: Endpoint{
@@ -130,6 +201,7 @@ func GetPublicEndpoints(useCaseRegistry registry.UseCaseRegistry) []PublicEndpoi
 				Method:  http.MethodGet,
 				Handler: handlers.NewRecommendedPeginHandler(useCaseRegistry.RecommendedPeginUseCase()),
 			},
+			RateLimitGroup: QuoteRateLimitGroup,
 		},
 		{
 			Endpoint: Endpoint{
@@ -9,6 +9,7 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegin"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegout"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/webhook"
 	"github.com/rsksmart/liquidity-provider-server/test"
 	"github.com/rsksmart/liquidity-provider-server/test/mocks"
 	"github.com/stretchr/testify/assert"
@@ -24,13 +25,21 @@ func TestGetPublicEndpoints(t *testing.T) {
 	registryMock.EXPECT().HealthUseCase().Return(&usecases.HealthUseCase{})
 	registryMock.EXPECT().GetProvidersUseCase().Return(&liquidity_provider.GetProvidersUseCase{})
 	registryMock.EXPECT().GetPeginQuoteUseCase().Return(&pegin.GetQuoteUseCase{})
+	registryMock.EXPECT().GetPeginQuotesUseCase().Return(&pegin.GetQuotesUseCase{})
+	registryMock.EXPECT().EstimatePeginQuoteUseCase().Return(&pegin.EstimateQuoteUseCase{})
 	registryMock.EXPECT().GetAcceptPeginQuoteUseCase().Return(acceptQuoteUseCase)
 	registryMock.EXPECT().GetPegoutQuoteUseCase().Return(&pegout.GetQuoteUseCase{})
+	registryMock.EXPECT().GetPegoutQuotesUseCase().Return(&pegout.GetQuotesUseCase{})
+	registryMock.EXPECT().EstimatePegoutQuoteUseCase().Return(&pegout.EstimateQuoteUseCase{})
 	registryMock.EXPECT().GetAcceptPegoutQuoteUseCase().Return(&pegout.AcceptQuoteUseCase{})
+	registryMock.EXPECT().RegisterWebhookUseCase().Return(&webhook.RegisterWebhookUseCase{})
 	registryMock.EXPECT().GetUserDepositsUseCase().Return(&pegout.GetUserDepositsUseCase{})
 	registryMock.EXPECT().GetProviderDetailUseCase().Return(&liquidity_provider.GetDetailUseCase{})
 	registryMock.EXPECT().GetPeginStatusUseCase().Return(&pegin.StatusUseCase{})
 	registryMock.EXPECT().GetPegoutStatusUseCase().Return(&pegout.StatusUseCase{})
+	registryMock.EXPECT().StreamQuoteStateUseCase().Return(&webhook.StreamQuoteStateUseCase{})
+	registryMock.EXPECT().GetPeginDepositConfirmationsUseCase().Return(&pegin.DepositConfirmationsUseCase{})
+	registryMock.EXPECT().GetPegoutDepositConfirmationsUseCase().Return(&pegout.DepositConfirmationsUseCase{})
 	registryMock.EXPECT().GetAvailableLiquidityUseCase().Return(&liquidity_provider.GetAvailableLiquidityUseCase{})
 	registryMock.EXPECT().GetServerInfoUseCase().Return(&liquidity_provider.ServerInfoUseCase{})
 	registryMock.EXPECT().RecommendedPegoutUseCase().Return(&pegout.RecommendedPegoutUseCase{})
@@ -43,10 +52,11 @@ func TestGetPublicEndpoints(t *testing.T) {
 	err := yaml.Unmarshal(specBytes, spec)
 	require.NoError(t, err)
 
-	assert.Len(t, endpoints, 17)
+	assert.Len(t, endpoints, 22)
 	for _, endpoint := range endpoints {
 		lowerCaseMethod := strings.ToLower(endpoint.Method)
 		assert.NotNilf(t, spec.Paths[endpoint.Path][lowerCaseMethod], "Handler not found for path %s and verb %s", endpoint.Path, endpoint.Method)
+		assert.Equalf(t, strings.HasSuffix(endpoint.Path, "/stream"), endpoint.Stream, "Unexpected stream flag for path %s", endpoint.Path)
 	}
 	registryMock.AssertExpectations(t)
 }
@@ -1,6 +1,8 @@
 package routes
 
 import (
+	"cmp"
+
 	"github.com/gorilla/mux"
 	"github.com/gorilla/sessions"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/handlers"
@@ -8,6 +10,7 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/registry"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/server/cookies"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	log "github.com/sirupsen/logrus"
 	"net/http"
 	"slices"
@@ -39,7 +42,13 @@ func (f *endpointFactoryImpl) GetPrivate(env environment.Environment, useCaseReg
 	return GetManagementEndpoints(env, useCaseRegistry, store)
 }
 
-func ConfigureRoutes(router *mux.Router, env environment.Environment, useCaseRegistry registry.UseCaseRegistry, endpointFactory EndpointFactory) {
+func ConfigureRoutes(
+	router *mux.Router,
+	env environment.Environment,
+	useCaseRegistry registry.UseCaseRegistry,
+	rateLimiter entities.RateLimiter,
+	endpointFactory EndpointFactory,
+) {
 	router.Use(middlewares.NewCorsMiddleware(env.AllowedOrigins))
 
 	store, err := cookies.GetSessionCookieStore(env.Management)
@@ -47,7 +56,7 @@ func ConfigureRoutes(router *mux.Router, env environment.Environment, useCaseReg
 		log.Fatal("Error registering routes: ", err)
 	}
 
-	registerPublicRoutes(router, env, endpointFactory.GetPublic(useCaseRegistry))
+	registerPublicRoutes(router, env, rateLimiter, endpointFactory.GetPublic(useCaseRegistry))
 
 	if env.Management.EnableManagementApi {
 		registerManagementRoutes(router, env, store, endpointFactory.GetPrivate(env, useCaseRegistry, store))
@@ -56,17 +65,61 @@ func ConfigureRoutes(router *mux.Router, env environment.Environment, useCaseReg
 	router.Methods(http.MethodOptions).HandlerFunc(handlers.NewOptionsHandler())
 }
 
-func registerPublicRoutes(router *mux.Router, env environment.Environment, endpoints []PublicEndpoint) {
+func registerPublicRoutes(router *mux.Router, env environment.Environment, rateLimiter entities.RateLimiter, endpoints []PublicEndpoint) {
 	captchaMiddleware := middlewares.NewCaptchaMiddleware(env.Captcha.Url, env.Captcha.Threshold, env.Captcha.Disabled, env.Captcha.SecretKey)
+	rateLimitMiddlewares := getRateLimitMiddlewares(env.RateLimit, rateLimiter)
+	limits := env.RateLimit.FillWithDefaults()
+	streamLimitMiddleware := middlewares.NewStreamLimitMiddleware(middlewares.StreamLimitConfig{
+		MaxPerIp:          limits.StreamsPerIp,
+		MaxTotal:          limits.MaxStreams,
+		TrustForwardedFor: limits.TrustForwardedFor,
+	})
+	if env.RateLimit.Disabled {
+		log.Warn("IMPORTANT! Public API running with rate limiting disabled")
+	}
 	for _, endpoint := range endpoints {
 		handler := endpoint.Handler
+		// the open streams are limited even if the rate limit is disabled, as each one keeps a connection open
+		if endpoint.Stream {
+			handler = useMiddlewares(handler, streamLimitMiddleware)
+		}
 		if endpoint.RequiresCaptcha {
 			handler = useMiddlewares(handler, captchaMiddleware)
 		}
+		// the rate limit is applied first to avoid validating the captcha of the limited requests
+		if !env.RateLimit.Disabled {
+			handler = useMiddlewares(handler, rateLimitMiddlewares[cmp.Or(endpoint.RateLimitGroup, DefaultRateLimitGroup)])
+		}
 		router.Path(endpoint.Path).Methods(endpoint.Method).Handler(handler)
 	}
 }
 
+func getRateLimitMiddlewares(env environment.RateLimitEnv, rateLimiter entities.RateLimiter) map[RateLimitGroup]func(http.Handler) http.Handler {
+	limits := env.FillWithDefaults()
+	configs := []middlewares.RateLimitConfig{
+		{
+			Group:      string(QuoteRateLimitGroup),
+			Limit:      entities.RateLimit{PerMinute: limits.QuotePerMinute, Burst: limits.QuoteBurst},
+			PartnerKey: middlewares.NewPartnerRateLimitKey(handlers.PartnerSignatureHeader, handlers.PartnerTimestampHeader),
+			Cost:       middlewares.QuoteBatchRateLimitCost,
+		},
+		{
+			Group: string(AcceptRateLimitGroup),
+			Limit: entities.RateLimit{PerMinute: limits.AcceptPerMinute, Burst: limits.AcceptBurst},
+		},
+		{
+			Group: string(DefaultRateLimitGroup),
+			Limit: entities.RateLimit{PerMinute: limits.DefaultPerMinute, Burst: limits.DefaultBurst},
+		},
+	}
+	result := make(map[RateLimitGroup]func(http.Handler) http.Handler, len(configs))
+	for _, config := range configs {
+		config.TrustForwardedFor = env.TrustForwardedFor
+		result[RateLimitGroup(config.Group)] = middlewares.NewRateLimitMiddleware(rateLimiter, config)
+	}
+	return result
+}
+
 func registerManagementRoutes(router *mux.Router, env environment.Environment, store sessions.Store, endpoints []Endpoint) {
 	log.Warn(
 		"Server is running with the management API exposed. This interface " +
@@ -10,6 +10,7 @@ import (
 	"github.com/gorilla/csrf"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/sessions"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/registry"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/routes"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/server/cookies"
@@ -19,6 +20,7 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegin"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegout"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/webhook"
 	"github.com/rsksmart/liquidity-provider-server/test/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -48,9 +50,11 @@ func TestConfigureRoutes_Public(t *testing.T) {
 			UseHttps:             false,
 		},
 		AllowedOrigins: testAllowedDomains,
+		// the routes are requested several times, so the limits would make the results depend on the amount of routes
+		RateLimit: environment.RateLimitEnv{Disabled: true},
 	}
 
-	routes.ConfigureRoutes(onlyPublicRouter, onlyPublicEnv, useCaseRegistry, newBlockedEndpointFactory())
+	routes.ConfigureRoutes(onlyPublicRouter, onlyPublicEnv, useCaseRegistry, dataproviders.NewLocalRateLimiter(), newBlockedEndpointFactory())
 	onlyPublicRoutes := make([]*mux.Route, 0)
 
 	err := onlyPublicRouter.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
@@ -109,8 +113,10 @@ func TestConfigureRoutes_Management(t *testing.T) {
 			UseHttps:             false,
 		},
 		AllowedOrigins: testAllowedDomains,
+		// the routes are requested several times, so the limits would make the results depend on the amount of routes
+		RateLimit: environment.RateLimitEnv{Disabled: true},
 	}
-	routes.ConfigureRoutes(managementRouter, managementEnv, useCaseRegistry, newBlockedEndpointFactory())
+	routes.ConfigureRoutes(managementRouter, managementEnv, useCaseRegistry, dataproviders.NewLocalRateLimiter(), newBlockedEndpointFactory())
 	managementAndPublicRoutes := make([]*mux.Route, 0)
 
 	err := managementRouter.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
@@ -249,13 +255,20 @@ func setupRegistryMock(registryMock *mocks.UseCaseRegistryMock) {
 	registryMock.EXPECT().HealthUseCase().Return(&usecases.HealthUseCase{})
 	registryMock.EXPECT().GetProvidersUseCase().Return(&liquidity_provider.GetProvidersUseCase{})
 	registryMock.EXPECT().GetPeginQuoteUseCase().Return(&pegin.GetQuoteUseCase{})
+	registryMock.EXPECT().GetPeginQuotesUseCase().Return(&pegin.GetQuotesUseCase{})
+	registryMock.EXPECT().EstimatePeginQuoteUseCase().Return(&pegin.EstimateQuoteUseCase{})
 	registryMock.EXPECT().GetAcceptPeginQuoteUseCase().Return(acceptQuoteUseCase)
 	registryMock.EXPECT().GetPegoutQuoteUseCase().Return(&pegout.GetQuoteUseCase{})
+	registryMock.EXPECT().GetPegoutQuotesUseCase().Return(&pegout.GetQuotesUseCase{})
+	registryMock.EXPECT().EstimatePegoutQuoteUseCase().Return(&pegout.EstimateQuoteUseCase{})
 	registryMock.EXPECT().GetAcceptPegoutQuoteUseCase().Return(&pegout.AcceptQuoteUseCase{})
 	registryMock.EXPECT().GetUserDepositsUseCase().Return(&pegout.GetUserDepositsUseCase{})
 	registryMock.EXPECT().GetProviderDetailUseCase().Return(&liquidity_provider.GetDetailUseCase{})
 	registryMock.EXPECT().GetPeginStatusUseCase().Return(&pegin.StatusUseCase{})
 	registryMock.EXPECT().GetPegoutStatusUseCase().Return(&pegout.StatusUseCase{})
+	registryMock.EXPECT().StreamQuoteStateUseCase().Return(&webhook.StreamQuoteStateUseCase{})
+	registryMock.EXPECT().GetPeginDepositConfirmationsUseCase().Return(&pegin.DepositConfirmationsUseCase{})
+	registryMock.EXPECT().GetPegoutDepositConfirmationsUseCase().Return(&pegout.DepositConfirmationsUseCase{})
 	registryMock.EXPECT().GetAvailableLiquidityUseCase().Return(&liquidity_provider.GetAvailableLiquidityUseCase{})
 	registryMock.EXPECT().SummariesUseCase().Return(&reports.SummariesUseCase{})
 	registryMock.EXPECT().GetServerInfoUseCase().Return(&liquidity_provider.ServerInfoUseCase{})
@@ -278,12 +291,23 @@ func setupRegistryMock(registryMock *mocks.UseCaseRegistryMock) {
 	registryMock.EXPECT().GetPeginReportUseCase().Return(&reports.GetPeginReportUseCase{})
 	registryMock.EXPECT().GetPegoutReportUseCase().Return(&reports.GetPegoutReportUseCase{})
 	registryMock.EXPECT().GetRevenueReportUseCase().Return(&reports.GetRevenueReportUseCase{})
+	registryMock.EXPECT().GetRevenueJournalUseCase().Return(&reports.GetRevenueJournalUseCase{})
+	registryMock.EXPECT().GetAnalyticsReportUseCase().Return(&reports.GetAnalyticsReportUseCase{})
+	registryMock.EXPECT().GetQuotePnlUseCase().Return(&reports.GetQuotePnlUseCase{})
+	registryMock.EXPECT().GetReportSnapshotsUseCase().Return(&reports.GetReportSnapshotsUseCase{})
 	registryMock.EXPECT().GetAssetsReportUseCase().Return(&reports.GetAssetsReportUseCase{})
+	registryMock.EXPECT().GetAssetHistoryUseCase().Return(&reports.GetAssetHistoryUseCase{})
 	registryMock.EXPECT().GetTransactionsReportUseCase().Return(&reports.GetTransactionsUseCase{})
 	registryMock.EXPECT().GetTrustedAccountsUseCase().Return(&liquidity_provider.GetTrustedAccountsUseCase{})
 	registryMock.EXPECT().UpdateTrustedAccountUseCase().Return(&liquidity_provider.UpdateTrustedAccountUseCase{})
 	registryMock.EXPECT().AddTrustedAccountUseCase().Return(&liquidity_provider.AddTrustedAccountUseCase{})
 	registryMock.EXPECT().DeleteTrustedAccountUseCase().Return(&liquidity_provider.DeleteTrustedAccountUseCase{})
+	registryMock.EXPECT().GetRecentAlertsUseCase().Return(&liquidity_provider.GetRecentAlertsUseCase{})
+	registryMock.EXPECT().RegisterWebhookUseCase().Return(&webhook.RegisterWebhookUseCase{})
+	registryMock.EXPECT().GetWebhooksUseCase().Return(&webhook.GetWebhooksUseCase{})
+	registryMock.EXPECT().DeleteWebhookUseCase().Return(&webhook.DeleteWebhookUseCase{})
+	registryMock.EXPECT().GetLiquidityHoldsUseCase().Return(&liquidity_provider.GetLiquidityHoldsUseCase{})
+	registryMock.EXPECT().ReconcileLiquidityLedgerUseCase().Return(&liquidity_provider.ReconcileLiquidityLedgerUseCase{})
 	registryMock.EXPECT().RecommendedPegoutUseCase().Return(&pegout.RecommendedPegoutUseCase{})
 	registryMock.EXPECT().RecommendedPeginUseCase().Return(&pegin.RecommendedPeginUseCase{})
 }
@@ -1,11 +1,13 @@
 package cookies
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"github.com/gorilla/sessions"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+	log "github.com/sirupsen/logrus"
 	"net/http"
 	"sync"
 )
@@ -20,6 +22,10 @@ const (
 var storeOnce sync.Once
 var cookieStore sessions.Store
 
+// keysMutex protects the keys currently used by the cookie store and the CSRF protection, since they can be rotated
+var keysMutex sync.RWMutex
+var sessionAuthKey, sessionEncryptionKey, csrfAuthKey []byte
+
 func GetSessionCookieStore(env environment.ManagementEnv) (sessions.Store, error) {
 	var authKey, encryptionKey []byte
 	var err error
@@ -39,11 +45,73 @@ func GetSessionCookieStore(env environment.ManagementEnv) (sessions.Store, error
 	}
 
 	storeOnce.Do(func() {
+		keysMutex.Lock()
+		defer keysMutex.Unlock()
+		sessionAuthKey, sessionEncryptionKey = authKey, encryptionKey
 		cookieStore = NewUniqueSessionStore(ManagementSessionCookieName, authKey, encryptionKey)
 	})
 	return cookieStore, err
 }
 
+// RotateSessionKeys updates the keys of the management session cookie store and the CSRF protection if they are different
+// from the ones in use. The existing session remains valid after the rotation, but a new CSRF token has to be obtained
+func RotateSessionKeys(env environment.ManagementEnv) error {
+	authKey, err := utils.DecodeKey(env.SessionAuthKey, KeysBytesLength)
+	if err != nil {
+		return fmt.Errorf("error decoding session auth key: %w", err)
+	}
+	encryptionKey, err := utils.DecodeKey(env.SessionEncryptionKey, KeysBytesLength)
+	if err != nil {
+		return fmt.Errorf("error decoding session encryption key: %w", err)
+	}
+	tokenAuthKey, err := utils.DecodeKey(env.SessionTokenAuthKey, KeysBytesLength)
+	if err != nil {
+		return fmt.Errorf("error decoding session token auth key: %w", err)
+	}
+	store, err := GetSessionCookieStore(env)
+	if err != nil {
+		return err
+	}
+	uniqueStore, ok := store.(*UniqueSessionStore)
+	if !ok {
+		return errors.New("key rotation is only supported by UniqueSessionStore")
+	}
+
+	keysMutex.Lock()
+	defer keysMutex.Unlock()
+	if !bytes.Equal(authKey, sessionAuthKey) || !bytes.Equal(encryptionKey, sessionEncryptionKey) {
+		uniqueStore.RotateKeys(authKey, encryptionKey)
+		sessionAuthKey, sessionEncryptionKey = authKey, encryptionKey
+		log.Info("Management session keys rotated")
+	}
+	if csrfAuthKey != nil && !bytes.Equal(tokenAuthKey, csrfAuthKey) {
+		log.Info("Management CSRF key rotated")
+	}
+	csrfAuthKey = tokenAuthKey
+	return nil
+}
+
+// CsrfAuthKey returns the key currently used to authenticate the CSRF tokens of the management session
+func CsrfAuthKey(env environment.ManagementEnv) ([]byte, error) {
+	keysMutex.RLock()
+	key := csrfAuthKey
+	keysMutex.RUnlock()
+	if key != nil {
+		return key, nil
+	}
+
+	decodedKey, err := utils.DecodeKey(env.SessionTokenAuthKey, KeysBytesLength)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding session token auth key: %w", err)
+	}
+	keysMutex.Lock()
+	defer keysMutex.Unlock()
+	if csrfAuthKey == nil {
+		csrfAuthKey = decodedKey
+	}
+	return csrfAuthKey, nil
+}
+
 type CreateSessionArgs struct {
 	Store   sessions.Store
 	Env     environment.ManagementEnv
@@ -17,6 +17,7 @@ func TestSession(t *testing.T) {
 	testGetSessionCookieStore(t)
 	cookie := testCreateManagementSession(t)
 	testCloseManagementSession(t, cookie)
+	testRotateSessionKeys(t)
 }
 
 func testGetSessionCookieStore(t *testing.T) {
@@ -152,3 +153,42 @@ func testCloseManagementSession(t *testing.T, cookie *http.Cookie) {
 		require.ErrorContains(t, err, "closing a unique session is only supported by UniqueSessionStore")
 	})
 }
+
+func testRotateSessionKeys(t *testing.T) {
+	zeroKey := hex.EncodeToString(make([]byte, 32))
+	currentEnv := environment.ManagementEnv{SessionAuthKey: zeroKey, SessionEncryptionKey: zeroKey, SessionTokenAuthKey: zeroKey}
+	rotatedEnv := environment.ManagementEnv{
+		SessionAuthKey:       "01fbac02d66202e8468d2a4f1deba4fa5c2491f592e0e22e32fe1e6acac25923",
+		SessionEncryptionKey: "02fbac02d66202e8468d2a4f1deba4fa5c2491f592e0e22e32fe1e6acac25923",
+		SessionTokenAuthKey:  "03fbac02d66202e8468d2a4f1deba4fa5c2491f592e0e22e32fe1e6acac25923",
+	}
+	t.Run("should return error if a key is invalid", func(t *testing.T) {
+		invalidEnv := rotatedEnv
+		invalidEnv.SessionTokenAuthKey = "invalid"
+		require.ErrorContains(t, cookies.RotateSessionKeys(invalidEnv), "error decoding session token auth key")
+		csrfKey, err := cookies.CsrfAuthKey(currentEnv)
+		require.NoError(t, err)
+		assert.Equal(t, make([]byte, 32), csrfKey)
+	})
+	t.Run("should rotate the keys keeping the current session", func(t *testing.T) {
+		store, err := cookies.GetSessionCookieStore(currentEnv)
+		require.NoError(t, err)
+		response := httptest.NewRecorder()
+		require.NoError(t, cookies.CreateManagementSession(&cookies.CreateSessionArgs{
+			Store: store, Env: currentEnv, Request: httptest.NewRequest(http.MethodGet, "/", nil), Writer: response,
+		}))
+		// nolint:bodyclose
+		cookie := response.Result().Cookies()[0]
+		require.NoError(t, response.Result().Body.Close())
+
+		require.NoError(t, cookies.RotateSessionKeys(rotatedEnv))
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(cookie)
+		session, err := store.Get(req, cookies.ManagementSessionCookieName)
+		require.NoError(t, err)
+		assert.False(t, session.IsNew)
+		csrfKey, err := cookies.CsrfAuthKey(currentEnv)
+		require.NoError(t, err)
+		assert.Equal(t, "03fbac02d66202e8468d2a4f1deba4fa5c2491f592e0e22e32fe1e6acac25923", hex.EncodeToString(csrfKey))
+	})
+}
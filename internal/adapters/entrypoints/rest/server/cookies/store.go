@@ -18,6 +18,10 @@ type UniqueSessionStore struct {
 	session      *sessions.Session
 	name         string
 	sessionMutex *sync.Mutex
+	codecsMutex  *sync.RWMutex
+	// activeCodecs is the number of codecs created from the current keys, the rest of the codecs are from the
+	// previous keys and are only kept to decode the cookie of the existing session after a rotation
+	activeCodecs int
 }
 
 func NewUniqueSessionStore(uniqueSessionName string, keyPairs ...[]byte) *UniqueSessionStore {
@@ -25,7 +29,9 @@ func NewUniqueSessionStore(uniqueSessionName string, keyPairs ...[]byte) *Unique
 		CookieStore:  *sessions.NewCookieStore(keyPairs...),
 		name:         uniqueSessionName,
 		sessionMutex: &sync.Mutex{},
+		codecsMutex:  &sync.RWMutex{},
 	}
+	store.activeCodecs = len(store.Codecs)
 	return store
 }
 
@@ -69,7 +75,7 @@ func (s *UniqueSessionStore) Save(r *http.Request, w http.ResponseWriter, sessio
 	}
 
 	s.session = session
-	encoded, err := securecookie.EncodeMulti(session.Name(), session.ID, s.Codecs...)
+	encoded, err := securecookie.EncodeMulti(session.Name(), session.ID, s.codecs()...)
 	if err != nil {
 		return err
 	}
@@ -88,6 +94,28 @@ func (s *UniqueSessionStore) CloseUniqueSession(r *http.Request, w http.Response
 	return nil
 }
 
+// RotateKeys replaces the keys used to encode the session cookie. The previous keys are still accepted to decode
+// the cookie, so the current session is not closed by the rotation and its cookie will be encoded with the new
+// keys the next time the session is saved
+func (s *UniqueSessionStore) RotateKeys(keyPairs ...[]byte) {
+	codecs := securecookie.CodecsFromPairs(keyPairs...)
+	for _, codec := range codecs {
+		if secureCookie, ok := codec.(*securecookie.SecureCookie); ok {
+			secureCookie.MaxAge(s.Options.MaxAge)
+		}
+	}
+	s.codecsMutex.Lock()
+	defer s.codecsMutex.Unlock()
+	s.Codecs = append(codecs, s.Codecs[:s.activeCodecs]...)
+	s.activeCodecs = len(codecs)
+}
+
+func (s *UniqueSessionStore) codecs() []securecookie.Codec {
+	s.codecsMutex.RLock()
+	defer s.codecsMutex.RUnlock()
+	return s.Codecs
+}
+
 func (s *UniqueSessionStore) getExistingSession(r *http.Request, name string) (*sessions.Session, error) {
 	var err error
 	var cookie *http.Cookie
@@ -98,7 +126,7 @@ func (s *UniqueSessionStore) getExistingSession(r *http.Request, name string) (*
 		return s.dummySession(name), err
 	}
 
-	err = securecookie.DecodeMulti(name, cookie.Value, &sessionId, s.Codecs...)
+	err = securecookie.DecodeMulti(name, cookie.Value, &sessionId, s.codecs()...)
 	if err != nil {
 		return s.dummySession(name), err
 	}
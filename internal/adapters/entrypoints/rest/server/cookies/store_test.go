@@ -182,3 +182,55 @@ func assertDummySession(t *testing.T, session *sessions.Session) {
 	assert.Empty(t, session.Values)
 	assert.Empty(t, session.ID)
 }
+
+func TestUniqueSessionStore_RotateKeys(t *testing.T) {
+	k1, err := hex.DecodeString(key1String)
+	require.NoError(t, err)
+	k2, err := hex.DecodeString(key2String)
+	require.NoError(t, err)
+	k3, err := utils.GetRandomBytes(32)
+	require.NoError(t, err)
+	store := cookies.NewUniqueSessionStore(cookieName, k1, k2)
+	saveSession := func(t *testing.T, session *sessions.Session) *http.Cookie {
+		res := httptest.NewRecorder()
+		require.NoError(t, store.Save(httptest.NewRequest(http.MethodGet, "/", nil), res, session))
+		// nolint:bodyclose
+		cookie := res.Result().Cookies()[0]
+		require.NoError(t, res.Result().Body.Close())
+		return cookie
+	}
+	getSession := func(cookie *http.Cookie) (*sessions.Session, error) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(cookie)
+		return store.New(req, cookieName)
+	}
+
+	session, err := store.New(httptest.NewRequest(http.MethodGet, "/", nil), cookieName)
+	require.NoError(t, err)
+	session.Options.MaxAge = cookies.SessionMaxSeconds
+	originalCookie := saveSession(t, session)
+
+	store.RotateKeys(k2, k1)
+	t.Run("should accept the cookie encoded with the previous keys", func(t *testing.T) {
+		existing, getErr := getSession(originalCookie)
+		require.NoError(t, getErr)
+		assert.Equal(t, session.ID, existing.ID)
+		assert.False(t, existing.IsNew)
+	})
+	rotatedCookie := saveSession(t, session)
+	t.Run("should encode the cookie with the new keys", func(t *testing.T) {
+		assert.NotEqual(t, originalCookie.Value, rotatedCookie.Value)
+		decoded := ""
+		require.NoError(t, securecookie.DecodeMulti(cookieName, rotatedCookie.Value, &decoded, securecookie.CodecsFromPairs(k2, k1)...))
+		assert.Equal(t, session.ID, decoded)
+	})
+
+	store.RotateKeys(k3, k2)
+	t.Run("should only keep the keys of the last rotation", func(t *testing.T) {
+		_, getErr := getSession(originalCookie)
+		require.Error(t, getErr)
+		existing, getErr := getSession(rotatedCookie)
+		require.NoError(t, getErr)
+		assert.Equal(t, session.ID, existing.ID)
+	})
+}
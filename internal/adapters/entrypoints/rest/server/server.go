@@ -8,6 +8,7 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/registry"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest/routes"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	log "github.com/sirupsen/logrus"
 	"io"
 	"net/http"
@@ -24,12 +25,14 @@ type Server struct {
 	doneChannel     chan os.Signal
 	env             environment.Environment
 	useCaseRegistry registry.UseCaseRegistry
+	rateLimiter     entities.RateLimiter
 	timeouts        environment.ApplicationTimeouts
 }
 
 func NewServer(
 	env environment.Environment,
 	useCaseRegistry registry.UseCaseRegistry,
+	rateLimiter entities.RateLimiter,
 	logLevel log.Level,
 	timeouts environment.ApplicationTimeouts,
 ) (*Server, chan os.Signal) {
@@ -41,12 +44,13 @@ func NewServer(
 		logLevel:        logLevel,
 		router:          mux.NewRouter(),
 		useCaseRegistry: useCaseRegistry,
+		rateLimiter:     rateLimiter,
 		timeouts:        timeouts,
 	}, done
 }
 
 func (s *Server) start() error {
-	routes.ConfigureRoutes(s.router, s.env, s.useCaseRegistry, routes.NewEndpointFactory())
+	routes.ConfigureRoutes(s.router, s.env, s.useCaseRegistry, s.rateLimiter, routes.NewEndpointFactory())
 	w := log.StandardLogger().WriterLevel(s.logLevel)
 	h := handlers.LoggingHandler(w, s.router)
 	defer func(w *io.PipeWriter) {
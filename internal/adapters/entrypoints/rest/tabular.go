@@ -0,0 +1,189 @@
+package rest
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+)
+
+const (
+	ContentTypeCsv  = "text/csv"
+	ContentTypeXlsx = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+	HeaderAccept             = "Accept"
+	HeaderContentDisposition = "Content-Disposition"
+
+	FormatParam = "format"
+)
+
+// ResponseFormat is the format in which a tabular report is returned. It can be selected with the format
+// query parameter or with the Accept header, the query parameter has precedence
+type ResponseFormat string
+
+const (
+	ResponseFormatJson ResponseFormat = "json"
+	ResponseFormatCsv  ResponseFormat = "csv"
+	ResponseFormatXlsx ResponseFormat = "xlsx"
+)
+
+// weiDecimals is the number of decimals used to express the wei amounts as RBTC/BTC in the tabular responses
+const weiDecimals = 18
+
+// Table is the tabular representation of a report
+type Table struct {
+	Header []string
+	Rows   [][]string
+}
+
+// GetResponseFormat returns the format requested by the client, JSON is returned if none was requested
+func GetResponseFormat(req *http.Request) (ResponseFormat, error) {
+	if format := strings.ToLower(strings.TrimSpace(req.URL.Query().Get(FormatParam))); format != "" {
+		switch ResponseFormat(format) {
+		case ResponseFormatJson, ResponseFormatCsv, ResponseFormatXlsx:
+			return ResponseFormat(format), nil
+		default:
+			return "", fmt.Errorf("unsupported format %s, must be one of: json, csv, xlsx", format)
+		}
+	}
+	accept := req.Header.Get(HeaderAccept)
+	switch {
+	case strings.Contains(accept, ContentTypeCsv):
+		return ResponseFormatCsv, nil
+	case strings.Contains(accept, ContentTypeXlsx):
+		return ResponseFormatXlsx, nil
+	default:
+		return ResponseFormatJson, nil
+	}
+}
+
+// FormatWeiAmount formats an amount in wei as a decimal number of RBTC/BTC without losing precision
+func FormatWeiAmount(amount *big.Int) string {
+	if amount == nil {
+		return ""
+	}
+	sign := ""
+	abs := new(big.Int).Abs(amount)
+	if amount.Sign() < 0 {
+		sign = "-"
+	}
+	digits := fmt.Sprintf("%0*s", weiDecimals+1, abs.String())
+	integerPart := digits[:len(digits)-weiDecimals]
+	decimalPart := strings.TrimRight(digits[len(digits)-weiDecimals:], "0")
+	if decimalPart == "" {
+		return sign + integerPart
+	}
+	return sign + integerPart + "." + decimalPart
+}
+
+// TableResponse writes the table in the requested format as an attachment with the given file name (without extension).
+// It must be used only with the CSV and XLSX formats
+func TableResponse(w http.ResponseWriter, format ResponseFormat, fileName string, table Table) {
+	var err error
+	var contentType string
+	body := new(bytes.Buffer)
+	switch format {
+	case ResponseFormatCsv:
+		contentType = ContentTypeCsv
+		err = writeCsv(body, table)
+	case ResponseFormatXlsx:
+		contentType = ContentTypeXlsx
+		err = writeXlsx(body, table)
+	default:
+		err = fmt.Errorf("unsupported tabular format %s", format)
+	}
+	if err != nil {
+		JsonErrorResponse(w, http.StatusInternalServerError, NewErrorResponseWithDetails("Unable to build response", DetailsFromError(err), true))
+		return
+	}
+	w.Header().Set(HeaderContentType, contentType)
+	w.Header().Set(HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", fileName+"."+string(format)))
+	w.WriteHeader(http.StatusOK)
+	if _, err = body.WriteTo(w); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
+
+func writeCsv(w io.Writer, table Table) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(table.Header); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(table.Rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`
+	xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Report" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`
+)
+
+// writeXlsx writes the table as a single sheet workbook. All the cells are written as inline strings,
+// so the amounts keep all their decimals
+func writeXlsx(w io.Writer, table Table) error {
+	archive := zip.NewWriter(w)
+	files := []struct {
+		name    string
+		content string
+	}{
+		{name: "[Content_Types].xml", content: xlsxContentTypes},
+		{name: "_rels/.rels", content: xlsxRootRels},
+		{name: "xl/workbook.xml", content: xlsxWorkbook},
+		{name: "xl/_rels/workbook.xml.rels", content: xlsxWorkbookRels},
+	}
+	for _, file := range files {
+		if err := writeZipFile(archive, file.name, strings.NewReader(file.content)); err != nil {
+			return err
+		}
+	}
+	sheet, err := buildXlsxSheet(table)
+	if err != nil {
+		return err
+	}
+	if err = writeZipFile(archive, "xl/worksheets/sheet1.xml", sheet); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+func writeZipFile(archive *zip.Writer, name string, content io.Reader) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(file, content)
+	return err
+}
+
+func buildXlsxSheet(table Table) (io.Reader, error) {
+	sheet := new(bytes.Buffer)
+	sheet.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	sheet.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	rows := append([][]string{table.Header}, table.Rows...)
+	for _, row := range rows {
+		sheet.WriteString("<row>")
+		for _, cell := range row {
+			sheet.WriteString(`<c t="inlineStr"><is><t>`)
+			if err := xml.EscapeText(sheet, []byte(cell)); err != nil {
+				return nil, err
+			}
+			sheet.WriteString("</t></is></c>")
+		}
+		sheet.WriteString("</row>")
+	}
+	sheet.WriteString("</sheetData></worksheet>")
+	return sheet, nil
+}
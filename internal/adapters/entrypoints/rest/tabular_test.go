@@ -0,0 +1,117 @@
+package rest_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/rest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetResponseFormat(t *testing.T) {
+	cases := []struct {
+		query    string
+		accept   string
+		expected rest.ResponseFormat
+	}{
+		{query: "", accept: "", expected: rest.ResponseFormatJson},
+		{query: "", accept: "application/json", expected: rest.ResponseFormatJson},
+		{query: "", accept: "text/csv", expected: rest.ResponseFormatCsv},
+		{query: "", accept: "text/csv;q=0.9, */*;q=0.1", expected: rest.ResponseFormatCsv},
+		{query: "", accept: rest.ContentTypeXlsx, expected: rest.ResponseFormatXlsx},
+		{query: "csv", accept: "", expected: rest.ResponseFormatCsv},
+		{query: "XLSX", accept: "", expected: rest.ResponseFormatXlsx},
+		{query: "json", accept: "text/csv", expected: rest.ResponseFormatJson},
+		{query: "xlsx", accept: "text/csv", expected: rest.ResponseFormatXlsx},
+	}
+	for _, c := range cases {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports?format="+c.query, nil)
+		require.NoError(t, err)
+		req.Header.Set(rest.HeaderAccept, c.accept)
+		format, err := rest.GetResponseFormat(req)
+		require.NoError(t, err)
+		assert.Equal(t, c.expected, format)
+	}
+
+	t.Run("should fail on unsupported format", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/reports?format=pdf", nil)
+		require.NoError(t, err)
+		format, err := rest.GetResponseFormat(req)
+		require.ErrorContains(t, err, "unsupported format pdf")
+		assert.Empty(t, format)
+	})
+}
+
+func TestFormatWeiAmount(t *testing.T) {
+	cases := map[string]*big.Int{
+		"0":                         big.NewInt(0),
+		"1":                         big.NewInt(1000000000000000000),
+		"0.000000000000000001":      big.NewInt(1),
+		"0.0005":                    big.NewInt(500000000000000),
+		"-1.5":                      big.NewInt(-1500000000000000000),
+		"123456.789000000000000001": new(big.Int).Add(new(big.Int).Mul(big.NewInt(123456789), big.NewInt(1000000000000000)), big.NewInt(1)),
+		"":                          nil,
+	}
+	for expected, amount := range cases {
+		assert.Equal(t, expected, rest.FormatWeiAmount(amount))
+	}
+}
+
+func TestTableResponse(t *testing.T) {
+	table := rest.Table{
+		Header: []string{"quoteHash", "amount"},
+		Rows:   [][]string{{"hash-1", "1.5"}, {"hash,2", "<0.1>"}},
+	}
+
+	t.Run("should write csv", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		rest.TableResponse(w, rest.ResponseFormatCsv, "report", table)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, rest.ContentTypeCsv, w.Header().Get(rest.HeaderContentType))
+		assert.Equal(t, `attachment; filename="report.csv"`, w.Header().Get(rest.HeaderContentDisposition))
+		records, err := csv.NewReader(w.Body).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, append([][]string{table.Header}, table.Rows...), records)
+	})
+
+	t.Run("should write xlsx", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		rest.TableResponse(w, rest.ResponseFormatXlsx, "report", table)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, rest.ContentTypeXlsx, w.Header().Get(rest.HeaderContentType))
+		assert.Equal(t, `attachment; filename="report.xlsx"`, w.Header().Get(rest.HeaderContentDisposition))
+		body := w.Body.Bytes()
+		archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+		require.NoError(t, err)
+		files := make(map[string]string)
+		for _, file := range archive.File {
+			reader, openErr := file.Open()
+			require.NoError(t, openErr)
+			content, readErr := io.ReadAll(reader)
+			require.NoError(t, readErr)
+			files[file.Name] = string(content)
+		}
+		assert.Len(t, files, 5)
+		assert.Contains(t, files, "[Content_Types].xml")
+		assert.Contains(t, files, "xl/workbook.xml")
+		sheet := files["xl/worksheets/sheet1.xml"]
+		assert.Contains(t, sheet, `<row><c t="inlineStr"><is><t>quoteHash</t></is></c><c t="inlineStr"><is><t>amount</t></is></c></row>`)
+		assert.Contains(t, sheet, "<t>hash,2</t>")
+		assert.Contains(t, sheet, "<t>&lt;0.1&gt;</t>")
+	})
+
+	t.Run("should fail on non tabular format", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		rest.TableResponse(w, rest.ResponseFormatJson, "report", table)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, rest.ContentTypeJson, w.Header().Get(rest.HeaderContentType))
+	})
+}
@@ -0,0 +1,67 @@
+package watcher
+
+import (
+	"context"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	log "github.com/sirupsen/logrus"
+)
+
+type CheckAssetDriftUseCase interface {
+	Run(ctx context.Context) (reports.AssetDriftResult, error)
+}
+
+// AssetDriftWatcher periodically compares the change of the LP assets with the change explained by the
+// revenue of the quotes, the asset snapshots are stored by the AssetReportWatcher
+type AssetDriftWatcher struct {
+	checkAssetDriftUseCase CheckAssetDriftUseCase
+	ticker                 utils.Ticker
+	watcherStopChannel     chan bool
+}
+
+func NewAssetDriftWatcher(checkAssetDriftUseCase CheckAssetDriftUseCase, ticker utils.Ticker) *AssetDriftWatcher {
+	watcherStopChannel := make(chan bool, 1)
+	return &AssetDriftWatcher{
+		checkAssetDriftUseCase: checkAssetDriftUseCase,
+		ticker:                 ticker,
+		watcherStopChannel:     watcherStopChannel,
+	}
+}
+
+func (watcher *AssetDriftWatcher) Prepare(ctx context.Context) error {
+	return nil
+}
+
+func (watcher *AssetDriftWatcher) Start() {
+watcherLoop:
+	for {
+		select {
+		case <-watcher.ticker.C():
+			watcher.check()
+		case <-watcher.watcherStopChannel:
+			watcher.ticker.Stop()
+			close(watcher.watcherStopChannel)
+			break watcherLoop
+		}
+	}
+}
+
+func (watcher *AssetDriftWatcher) Shutdown(closeChannel chan<- bool) {
+	watcher.watcherStopChannel <- true
+	closeChannel <- true
+	log.Debug("AssetDriftWatcher shut down")
+}
+
+func (watcher *AssetDriftWatcher) check() {
+	result, err := watcher.checkAssetDriftUseCase.Run(context.Background())
+	if err != nil {
+		log.Error("AssetDriftWatcher: error checking asset drift: ", err)
+		return
+	}
+	if !result.Checked {
+		log.Debug("AssetDriftWatcher: not enough asset snapshots to check the drift")
+	} else if result.Detected {
+		log.Warnf("AssetDriftWatcher: unexplained asset drift of %s wei between %s and %s", result.Drift, result.StartDate, result.EndDate)
+	}
+}
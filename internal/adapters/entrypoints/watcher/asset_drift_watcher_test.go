@@ -0,0 +1,63 @@
+package watcher_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/watcher"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetDriftWatcher_Prepare(t *testing.T) {
+	driftWatcher := watcher.NewAssetDriftWatcher(mocks.NewCheckAssetDriftUseCaseMock(t), &mocks.TickerMock{})
+	require.NoError(t, driftWatcher.Prepare(context.Background()))
+}
+
+func TestAssetDriftWatcher_Start(t *testing.T) {
+	tickerChannel := make(chan time.Time)
+	stopped := make(chan struct{})
+	ticker := &mocks.TickerMock{}
+	ticker.EXPECT().C().Return(tickerChannel)
+	ticker.EXPECT().Stop().Run(func() { close(stopped) }).Return()
+	useCase := mocks.NewCheckAssetDriftUseCaseMock(t)
+	driftWatcher := watcher.NewAssetDriftWatcher(useCase, ticker)
+	go driftWatcher.Start()
+
+	t.Run("should check the drift on every tick", func(t *testing.T) {
+		useCase.EXPECT().Run(test.AnyCtx).Return(reports.AssetDriftResult{
+			Checked:  true,
+			Drift:    entities.NewWei(2200),
+			Detected: true,
+		}, nil).Once()
+		useCase.EXPECT().Run(test.AnyCtx).Return(reports.AssetDriftResult{Checked: false}, nil).Once()
+		checkFunction := test.AssertLogContains(t, "unexplained asset drift of 2200 wei")
+		tickerChannel <- time.Now()
+		// the watcher only receives the next tick after finishing the previous check
+		tickerChannel <- time.Now()
+		assert.True(t, checkFunction())
+	})
+	t.Run("should handle use case errors", func(t *testing.T) {
+		useCase.EXPECT().Run(test.AnyCtx).Return(reports.AssetDriftResult{}, assert.AnError).Once()
+		checkFunction := test.AssertLogContains(t, "error checking asset drift")
+		tickerChannel <- time.Now()
+		closeChannel := make(chan bool, 1)
+		driftWatcher.Shutdown(closeChannel)
+		<-closeChannel
+		<-stopped
+		assert.True(t, checkFunction())
+	})
+	useCase.AssertExpectations(t)
+}
+
+func TestAssetDriftWatcher_Shutdown(t *testing.T) {
+	createWatcherShutdownTest(t, func(ticker utils.Ticker) watcher.Watcher {
+		return watcher.NewAssetDriftWatcher(mocks.NewCheckAssetDriftUseCaseMock(t), ticker)
+	})
+}
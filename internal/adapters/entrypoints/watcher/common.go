@@ -21,6 +21,9 @@ const (
 	rskEclipseCheckInterval          = 15 * time.Second
 	btcReleaseCheckInterval          = 3 * time.Minute
 	assetMetricsUpdateInterval       = 1 * time.Minute
+	liquidityLedgerInterval          = 10 * time.Minute
+	reportSnapshotInterval           = 10 * time.Minute
+	assetDriftCheckInterval          = 1 * time.Hour
 )
 
 type Watcher interface {
@@ -2,6 +2,7 @@ package watcher
 
 import (
 	"context"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/watcher/monitoring"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
 	log "github.com/sirupsen/logrus"
@@ -10,6 +11,7 @@ import (
 
 type LiquidityCheckWatcher struct {
 	checkLiquidityUseCase *liquidity_provider.CheckLiquidityUseCase
+	appMetrics            *monitoring.Metrics
 	watcherStopChannel    chan bool
 	ticker                utils.Ticker
 	validationTimeout     time.Duration
@@ -17,12 +19,14 @@ type LiquidityCheckWatcher struct {
 
 func NewLiquidityCheckWatcher(
 	checkLiquidityUseCase *liquidity_provider.CheckLiquidityUseCase,
+	appMetrics *monitoring.Metrics,
 	ticker utils.Ticker,
 	validationTimeout time.Duration,
 ) *LiquidityCheckWatcher {
 	watcherStopChannel := make(chan bool, 1)
 	return &LiquidityCheckWatcher{
 		checkLiquidityUseCase: checkLiquidityUseCase,
+		appMetrics:            appMetrics,
 		watcherStopChannel:    watcherStopChannel,
 		ticker:                ticker,
 		validationTimeout:     validationTimeout,
@@ -43,8 +47,10 @@ watcherLoop:
 		select {
 		case <-watcher.ticker.C():
 			ctx, cancel := context.WithTimeout(context.Background(), watcher.validationTimeout)
-			if err := watcher.checkLiquidityUseCase.Run(ctx); err != nil {
+			if result, err := watcher.checkLiquidityUseCase.Run(ctx); err != nil {
 				log.Error("Error checking liquidity inside watcher: ", err)
+			} else {
+				watcher.appMetrics.UpdateLiquidityFromCheck(result)
 			}
 			cancel()
 		case <-watcher.watcherStopChannel:
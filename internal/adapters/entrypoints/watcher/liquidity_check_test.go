@@ -2,9 +2,14 @@ package watcher_test
 
 import (
 	"context"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/watcher"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/watcher/monitoring"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	lp "github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/test"
@@ -19,15 +24,15 @@ import (
 
 func TestLiquidityCheckWatcher_Shutdown(t *testing.T) {
 	createWatcherShutdownTest(t, func(ticker utils.Ticker) watcher.Watcher {
-		return watcher.NewLiquidityCheckWatcher(nil, ticker, time.Duration(1))
+		return watcher.NewLiquidityCheckWatcher(nil, nil, ticker, time.Duration(1))
 	})
 }
 
 func TestNewLiquidityCheckWatcher(t *testing.T) {
 	ticker := &mocks.TickerMock{}
 	providerMock := &mocks.ProviderMock{}
-	useCase := liquidity_provider.NewCheckLiquidityUseCase(providerMock, providerMock, blockchain.RskContracts{}, &mocks.AlertSenderMock{}, test.AnyString)
-	test.AssertNonZeroValues(t, watcher.NewLiquidityCheckWatcher(useCase, ticker, time.Duration(1)))
+	useCase := liquidity_provider.NewCheckLiquidityUseCase(providerMock, providerMock, providerMock, blockchain.RskContracts{}, &mocks.AlertSenderMock{}, test.AnyString)
+	test.AssertNonZeroValues(t, watcher.NewLiquidityCheckWatcher(useCase, monitoring.NewMetrics(prometheus.NewRegistry()), ticker, time.Duration(1)))
 }
 
 func TestLiquidityCheckWatcher_Start(t *testing.T) {
@@ -38,10 +43,18 @@ func TestLiquidityCheckWatcher_Start(t *testing.T) {
 	providerMock := &mocks.ProviderMock{}
 	providerMock.On("HasPeginLiquidity", mock.Anything, mock.Anything).Return(nil)
 	providerMock.On("HasPegoutLiquidity", mock.Anything, mock.Anything).Return(nil)
+	providerMock.On("GeneralConfiguration", mock.Anything).Return(lp.DefaultGeneralConfiguration())
+	providerMock.On("AvailablePeginLiquidity", mock.Anything).Return(entities.NewWei(1000000000000000000), nil)
+	providerMock.On("AvailablePegoutLiquidity", mock.Anything).Return(entities.NewWei(2000000000000000000), nil)
+	providerMock.On("PeginConfiguration", mock.Anything).Return(lp.DefaultPeginConfiguration())
+	providerMock.On("PegoutConfiguration", mock.Anything).Return(lp.DefaultPegoutConfiguration())
 	bridgeMock := &mocks.BridgeMock{}
 	bridgeMock.On("GetMinimumLockTxValue").Return(entities.NewWei(5), nil)
-	useCase := liquidity_provider.NewCheckLiquidityUseCase(providerMock, providerMock, blockchain.RskContracts{Bridge: bridgeMock}, &mocks.AlertSenderMock{}, test.AnyString)
-	w := watcher.NewLiquidityCheckWatcher(useCase, ticker, time.Duration(1))
+	alertSender := &mocks.AlertSenderMock{}
+	alertSender.On("SendAlert", mock.Anything, mock.MatchedBy(func(alert alerts.Alert) bool { return alert.IsResolved() }), mock.Anything).Return(nil).Twice()
+	useCase := liquidity_provider.NewCheckLiquidityUseCase(providerMock, providerMock, providerMock, blockchain.RskContracts{Bridge: bridgeMock}, alertSender, test.AnyString)
+	appMetrics := monitoring.NewMetrics(prometheus.NewRegistry())
+	w := watcher.NewLiquidityCheckWatcher(useCase, appMetrics, ticker, time.Duration(1))
 	wg := sync.WaitGroup{}
 	wg.Add(2)
 	closeChannel := make(chan bool)
@@ -59,6 +72,10 @@ func TestLiquidityCheckWatcher_Start(t *testing.T) {
 	wg.Wait()
 	providerMock.AssertExpectations(t)
 	bridgeMock.AssertExpectations(t)
+	alertSender.AssertExpectations(t)
+	liquidity := &dto.Metric{}
+	require.NoError(t, appMetrics.LiquidityMetrics.WithLabelValues("pegout", "available").Write(liquidity))
+	assert.InDelta(t, 2.0, liquidity.GetGauge().GetValue(), 0.0001)
 }
 
 func TestLiquidityCheckWatcher_Start_ErrorHandling(t *testing.T) {
@@ -70,8 +87,8 @@ func TestLiquidityCheckWatcher_Start_ErrorHandling(t *testing.T) {
 	providerMock := &mocks.ProviderMock{}
 	bridgeMock := &mocks.BridgeMock{}
 	bridgeMock.On("GetMinimumLockTxValue").Return(nil, assert.AnError)
-	useCase := liquidity_provider.NewCheckLiquidityUseCase(providerMock, providerMock, blockchain.RskContracts{Bridge: bridgeMock}, &mocks.AlertSenderMock{}, test.AnyString)
-	w := watcher.NewLiquidityCheckWatcher(useCase, ticker, time.Duration(1))
+	useCase := liquidity_provider.NewCheckLiquidityUseCase(providerMock, providerMock, providerMock, blockchain.RskContracts{Bridge: bridgeMock}, &mocks.AlertSenderMock{}, test.AnyString)
+	w := watcher.NewLiquidityCheckWatcher(useCase, monitoring.NewMetrics(prometheus.NewRegistry()), ticker, time.Duration(1))
 	wg := sync.WaitGroup{}
 	wg.Add(2)
 	defer test.AssertLogContains(t, assert.AnError.Error())
@@ -90,6 +107,6 @@ func TestLiquidityCheckWatcher_Start_ErrorHandling(t *testing.T) {
 }
 
 func TestLiquidityCheckWatcher_Prepare(t *testing.T) {
-	w := watcher.NewLiquidityCheckWatcher(nil, &mocks.TickerMock{}, time.Duration(1))
+	w := watcher.NewLiquidityCheckWatcher(nil, nil, &mocks.TickerMock{}, time.Duration(1))
 	require.NoError(t, w.Prepare(context.Background()))
 }
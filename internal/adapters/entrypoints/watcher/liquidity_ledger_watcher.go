@@ -0,0 +1,112 @@
+package watcher
+
+import (
+	"context"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
+	log "github.com/sirupsen/logrus"
+)
+
+const liquidityLedgerWatcherSubscriber = "LiquidityLedgerWatcher"
+
+// LiquidityLedgerWatcher releases the liquidity holds of the quotes once the LP pays them or they fail or expire.
+// It also reconciles periodically the ledger with the retained quotes to fix the holds of any missed transition.
+type LiquidityLedgerWatcher struct {
+	releaseUseCase     *liquidity_provider.ReleaseLiquidityHoldUseCase
+	reconcileUseCase   *liquidity_provider.ReconcileLiquidityLedgerUseCase
+	eventBus           entities.EventBus
+	ticker             utils.Ticker
+	watcherStopChannel chan bool
+}
+
+func NewLiquidityLedgerWatcher(
+	releaseUseCase *liquidity_provider.ReleaseLiquidityHoldUseCase,
+	reconcileUseCase *liquidity_provider.ReconcileLiquidityLedgerUseCase,
+	eventBus entities.EventBus,
+	ticker utils.Ticker,
+) *LiquidityLedgerWatcher {
+	watcherStopChannel := make(chan bool, 1)
+	return &LiquidityLedgerWatcher{
+		releaseUseCase:     releaseUseCase,
+		reconcileUseCase:   reconcileUseCase,
+		eventBus:           eventBus,
+		ticker:             ticker,
+		watcherStopChannel: watcherStopChannel,
+	}
+}
+
+// Prepare reconciles the ledger so the quotes accepted before the ledger existed have their hold
+func (watcher *LiquidityLedgerWatcher) Prepare(ctx context.Context) error {
+	watcher.reconcile(ctx)
+	return nil
+}
+
+func (watcher *LiquidityLedgerWatcher) Start() {
+	ctx := context.Background()
+	subscribe := func(id entities.EventId) <-chan entities.Event {
+		return entities.SubscribeDurable(watcher.eventBus, liquidityLedgerWatcherSubscriber, id)
+	}
+	callForUserChannel := subscribe(quote.CallForUserCompletedEventId)
+	peginStateChannel := subscribe(quote.PeginStateUpdatedEventId)
+	sendPegoutChannel := subscribe(quote.PegoutBtcSentEventId)
+	pegoutStateChannel := subscribe(quote.PegoutStateUpdatedEventId)
+
+watcherLoop:
+	for {
+		select {
+		case event := <-callForUserChannel:
+			if parsedEvent, ok := event.(quote.CallForUserCompletedEvent); ok {
+				watcher.releasePegin(ctx, parsedEvent.RetainedQuote)
+			}
+		case event := <-peginStateChannel:
+			if parsedEvent, ok := event.(quote.PeginStateUpdatedEvent); ok {
+				watcher.releasePegin(ctx, parsedEvent.RetainedQuote)
+			}
+		case event := <-sendPegoutChannel:
+			if parsedEvent, ok := event.(quote.PegoutBtcSentToUserEvent); ok {
+				watcher.releasePegout(ctx, parsedEvent.RetainedQuote)
+			}
+		case event := <-pegoutStateChannel:
+			if parsedEvent, ok := event.(quote.PegoutStateUpdatedEvent); ok {
+				watcher.releasePegout(ctx, parsedEvent.RetainedQuote)
+			}
+		case <-watcher.ticker.C():
+			watcher.reconcile(ctx)
+		case <-watcher.watcherStopChannel:
+			watcher.ticker.Stop()
+			close(watcher.watcherStopChannel)
+			break watcherLoop
+		}
+	}
+}
+
+func (watcher *LiquidityLedgerWatcher) Shutdown(closeChannel chan<- bool) {
+	watcher.watcherStopChannel <- true
+	closeChannel <- true
+	log.Debug("LiquidityLedgerWatcher shut down")
+}
+
+func (watcher *LiquidityLedgerWatcher) releasePegin(ctx context.Context, retainedQuote quote.RetainedPeginQuote) {
+	if _, err := watcher.releaseUseCase.RunPegin(ctx, retainedQuote); err != nil {
+		log.Errorf("LiquidityLedgerWatcher: error releasing hold of quote %s: %v", retainedQuote.QuoteHash, err)
+	}
+}
+
+func (watcher *LiquidityLedgerWatcher) releasePegout(ctx context.Context, retainedQuote quote.RetainedPegoutQuote) {
+	if _, err := watcher.releaseUseCase.RunPegout(ctx, retainedQuote); err != nil {
+		log.Errorf("LiquidityLedgerWatcher: error releasing hold of quote %s: %v", retainedQuote.QuoteHash, err)
+	}
+}
+
+func (watcher *LiquidityLedgerWatcher) reconcile(ctx context.Context) {
+	result, err := watcher.reconcileUseCase.Run(ctx)
+	if err != nil {
+		log.Error("Error reconciling liquidity ledger: ", err)
+		return
+	}
+	log.Infof("Liquidity ledger reconciled: %d holds created, %d released, %d expired",
+		len(result.Created), len(result.Released), result.Expired)
+}
@@ -0,0 +1,149 @@
+package watcher_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/watcher"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	lpEntity "github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newLiquidityLedgerWatcherForTest(ledger *mocks.LiquidityLedgerMock, eventBus entities.EventBus, ticker utils.Ticker) *watcher.LiquidityLedgerWatcher {
+	peginRepository := &mocks.PeginQuoteRepositoryMock{}
+	peginRepository.EXPECT().GetRetainedQuoteByState(mock.Anything, mock.Anything, mock.Anything).Return([]quote.RetainedPeginQuote{}, nil).Maybe()
+	pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
+	pegoutRepository.EXPECT().GetRetainedQuoteByState(mock.Anything, mock.Anything, mock.Anything).Return([]quote.RetainedPegoutQuote{}, nil).Maybe()
+	mutex := &mocks.MutexMock{}
+	mutex.On("Lock").Return().Maybe()
+	mutex.On("Unlock").Return().Maybe()
+	return watcher.NewLiquidityLedgerWatcher(
+		liquidity_provider.NewReleaseLiquidityHoldUseCase(ledger),
+		liquidity_provider.NewReconcileLiquidityLedgerUseCase(ledger, peginRepository, pegoutRepository, mutex, mutex),
+		eventBus,
+		ticker,
+	)
+}
+
+func TestLiquidityLedgerWatcher_Prepare(t *testing.T) {
+	t.Run("should reconcile the ledger", func(t *testing.T) {
+		ledger := mocks.NewLiquidityLedgerMock(t)
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, lpEntity.PeginHold).Return([]lpEntity.LiquidityHold{
+			{QuoteHash: "0x01", Status: lpEntity.HoldStatusActive, ExpiresAt: time.Now().Add(-time.Minute)},
+		}, nil).Once()
+		ledger.EXPECT().ExpireHold(test.AnyCtx, "0x01").Return(true, nil).Once()
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, lpEntity.PegoutHold).Return([]lpEntity.LiquidityHold{}, nil).Once()
+		checkFunction := test.AssertLogContains(t, "Liquidity ledger reconciled: 0 holds created, 0 released, 1 expired")
+		ledgerWatcher := newLiquidityLedgerWatcherForTest(ledger, &mocks.EventBusMock{}, &mocks.TickerMock{})
+		require.NoError(t, ledgerWatcher.Prepare(context.Background()))
+		assert.True(t, checkFunction())
+	})
+	t.Run("should not fail if the reconciliation fails", func(t *testing.T) {
+		ledger := mocks.NewLiquidityLedgerMock(t)
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, lpEntity.PeginHold).Return(nil, assert.AnError).Once()
+		checkFunction := test.AssertLogContains(t, "Error reconciling liquidity ledger")
+		ledgerWatcher := newLiquidityLedgerWatcherForTest(ledger, &mocks.EventBusMock{}, &mocks.TickerMock{})
+		require.NoError(t, ledgerWatcher.Prepare(context.Background()))
+		assert.True(t, checkFunction())
+	})
+}
+
+// nolint:funlen
+func TestLiquidityLedgerWatcher_Start(t *testing.T) {
+	eventIds := []entities.EventId{
+		quote.CallForUserCompletedEventId,
+		quote.PeginStateUpdatedEventId,
+		quote.PegoutBtcSentEventId,
+		quote.PegoutStateUpdatedEventId,
+	}
+	channels := make(map[entities.EventId]chan entities.Event)
+	eventBus := &mocks.EventBusMock{}
+	for _, id := range eventIds {
+		channels[id] = make(chan entities.Event)
+		eventBus.On("Subscribe", id).Return((<-chan entities.Event)(channels[id])).Once()
+	}
+	tickerChannel := make(chan time.Time)
+	ticker := &mocks.TickerMock{}
+	ticker.EXPECT().C().Return(tickerChannel)
+	ticker.EXPECT().Stop().Return()
+	ledger := mocks.NewLiquidityLedgerMock(t)
+	released := make(chan string, 10)
+	ledger.EXPECT().ReleaseHold(test.AnyCtx, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, quoteHash string, reason string) (bool, error) {
+		released <- quoteHash + ":" + reason
+		return true, nil
+	})
+	ledgerWatcher := newLiquidityLedgerWatcherForTest(ledger, eventBus, ticker)
+	go ledgerWatcher.Start()
+
+	receive := func(t *testing.T) string {
+		select {
+		case result := <-released:
+			return result
+		case <-time.After(time.Second):
+			require.Fail(t, "Hold not released")
+			return ""
+		}
+	}
+
+	t.Run("should release the holds of the paid or failed quotes", func(t *testing.T) {
+		channels[quote.CallForUserCompletedEventId] <- quote.CallForUserCompletedEvent{
+			RetainedQuote: quote.RetainedPeginQuote{QuoteHash: "0x01", State: quote.PeginStateCallForUserSucceeded},
+		}
+		assert.Equal(t, "0x01:CallForUserSucceeded", receive(t))
+		channels[quote.PeginStateUpdatedEventId] <- quote.PeginStateUpdatedEvent{
+			RetainedQuote: quote.RetainedPeginQuote{QuoteHash: "0x02", State: quote.PeginStateTimeForDepositElapsed},
+		}
+		assert.Equal(t, "0x02:TimeForDepositElapsed", receive(t))
+		channels[quote.PegoutBtcSentEventId] <- quote.PegoutBtcSentToUserEvent{
+			RetainedQuote: quote.RetainedPegoutQuote{QuoteHash: "0x03", State: quote.PegoutStateSendPegoutSucceeded},
+		}
+		assert.Equal(t, "0x03:SendPegoutSucceeded", receive(t))
+		channels[quote.PegoutStateUpdatedEventId] <- quote.PegoutStateUpdatedEvent{
+			RetainedQuote: quote.RetainedPegoutQuote{QuoteHash: "0x04", State: quote.PegoutStateTimeForDepositElapsed},
+		}
+		assert.Equal(t, "0x04:TimeForDepositElapsed", receive(t))
+	})
+	t.Run("should keep the holds of the quotes waiting for the LP", func(t *testing.T) {
+		channels[quote.PegoutStateUpdatedEventId] <- quote.PegoutStateUpdatedEvent{
+			RetainedQuote: quote.RetainedPegoutQuote{QuoteHash: "0x05", State: quote.PegoutStateWaitingForDepositConfirmations},
+		}
+		channels[quote.PeginStateUpdatedEventId] <- quote.PeginStateUpdatedEvent{
+			RetainedQuote: quote.RetainedPeginQuote{QuoteHash: "0x06", State: quote.PeginStateTimeForDepositElapsed},
+		}
+		assert.Equal(t, "0x06:TimeForDepositElapsed", receive(t))
+	})
+	t.Run("should reconcile periodically", func(t *testing.T) {
+		reconciled := make(chan struct{})
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, lpEntity.PeginHold).Return(nil, assert.AnError).Run(func(_ context.Context, _ lpEntity.HoldOperation) {
+			close(reconciled)
+		}).Once()
+		tickerChannel <- time.Now()
+		select {
+		case <-reconciled:
+		case <-time.After(time.Second):
+			require.Fail(t, "Ledger not reconciled")
+		}
+	})
+
+	closeChannel := make(chan bool, 1)
+	ledgerWatcher.Shutdown(closeChannel)
+	<-closeChannel
+	eventBus.AssertExpectations(t)
+}
+
+func TestLiquidityLedgerWatcher_Shutdown(t *testing.T) {
+	createWatcherShutdownTest(t, func(ticker utils.Ticker) watcher.Watcher {
+		eventBus := &mocks.EventBusMock{}
+		eventBus.On("Subscribe", mock.Anything).Return((<-chan entities.Event)(make(chan entities.Event)))
+		return newLiquidityLedgerWatcherForTest(mocks.NewLiquidityLedgerMock(t), eventBus, ticker)
+	})
+}
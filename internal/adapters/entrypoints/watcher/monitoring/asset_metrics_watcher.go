@@ -13,24 +13,29 @@ type GetAssetReportUseCase interface {
 	Run(ctx context.Context) (reports.GetAssetsReportResult, error)
 }
 
+// AssetReportWatcher updates the asset metrics and stores every report as an asset snapshot to build the
+// history of the LP assets
 type AssetReportWatcher struct {
-	appMetrics            *Metrics
-	getAssetReportUseCase GetAssetReportUseCase
-	ticker                utils.Ticker
-	watcherStopChannel    chan struct{}
+	appMetrics                 *Metrics
+	getAssetReportUseCase      GetAssetReportUseCase
+	createAssetSnapshotUseCase *reports.CreateAssetSnapshotUseCase
+	ticker                     utils.Ticker
+	watcherStopChannel         chan struct{}
 }
 
 func NewAssetReportWatcher(
 	appMetrics *Metrics,
 	getAssetReportUseCase GetAssetReportUseCase,
+	createAssetSnapshotUseCase *reports.CreateAssetSnapshotUseCase,
 	ticker utils.Ticker,
 ) *AssetReportWatcher {
 	watcherStopChannel := make(chan struct{}, 1)
 	return &AssetReportWatcher{
-		appMetrics:            appMetrics,
-		getAssetReportUseCase: getAssetReportUseCase,
-		watcherStopChannel:    watcherStopChannel,
-		ticker:                ticker,
+		appMetrics:                 appMetrics,
+		getAssetReportUseCase:      getAssetReportUseCase,
+		createAssetSnapshotUseCase: createAssetSnapshotUseCase,
+		watcherStopChannel:         watcherStopChannel,
+		ticker:                     ticker,
 	}
 }
 
@@ -59,11 +64,15 @@ func (watcher *AssetReportWatcher) Shutdown(closeChannel chan<- bool) {
 }
 
 func (watcher *AssetReportWatcher) updateMetrics() {
-	report, err := watcher.getAssetReportUseCase.Run(context.Background())
+	ctx := context.Background()
+	report, err := watcher.getAssetReportUseCase.Run(ctx)
 	if err != nil {
 		log.WithError(err).Error("Failed to collect asset report")
 		return
 	}
 
 	watcher.appMetrics.UpdateAssetsFromReport(report)
+	if _, err = watcher.createAssetSnapshotUseCase.Run(ctx, report); err != nil {
+		log.WithError(err).Error("Failed to store asset snapshot")
+	}
 }
@@ -12,7 +12,9 @@ import (
 	dto "github.com/prometheus/client_model/go"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/watcher/monitoring"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/test"
 	"github.com/rsksmart/liquidity-provider-server/test/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -25,7 +27,7 @@ func TestNewAssetReportWatcher(t *testing.T) {
 		assetsReportUseCase := mocks.NewGetAssetsReportUseCaseMock(t)
 		ticker := &mocks.TickerMock{}
 
-		watcher := monitoring.NewAssetReportWatcher(appMetrics, assetsReportUseCase, ticker)
+		watcher := monitoring.NewAssetReportWatcher(appMetrics, assetsReportUseCase, reports.NewCreateAssetSnapshotUseCase(mocks.NewAssetSnapshotRepositoryMock(t)), ticker)
 
 		require.NotNil(t, watcher)
 
@@ -42,6 +44,10 @@ func TestNewAssetReportWatcher(t *testing.T) {
 		require.True(t, found, "getAssetReportUseCase field should exist")
 		assert.Equal(t, "monitoring.GetAssetReportUseCase", getAssetReportUseCaseField.Type.String())
 
+		createAssetSnapshotUseCaseField, found := watcherType.FieldByName("createAssetSnapshotUseCase")
+		require.True(t, found, "createAssetSnapshotUseCase field should exist")
+		assert.Equal(t, "*reports.CreateAssetSnapshotUseCase", createAssetSnapshotUseCaseField.Type.String())
+
 		tickerField, found := watcherType.FieldByName("ticker")
 		require.True(t, found, "ticker field should exist")
 		assert.Equal(t, "utils.Ticker", tickerField.Type.String())
@@ -64,7 +70,7 @@ func TestAssetReportWatcher_Prepare(t *testing.T) {
 		assetsReportUseCase := mocks.NewGetAssetsReportUseCaseMock(t)
 		ticker := &mocks.TickerMock{}
 
-		watcher := monitoring.NewAssetReportWatcher(appMetrics, assetsReportUseCase, ticker)
+		watcher := monitoring.NewAssetReportWatcher(appMetrics, assetsReportUseCase, reports.NewCreateAssetSnapshotUseCase(mocks.NewAssetSnapshotRepositoryMock(t)), ticker)
 		err := watcher.Prepare(context.Background())
 
 		assert.NoError(t, err)
@@ -78,7 +84,7 @@ func TestAssetReportWatcher_Prepare(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel() // Cancel context immediately
 
-		watcher := monitoring.NewAssetReportWatcher(appMetrics, assetsReportUseCase, ticker)
+		watcher := monitoring.NewAssetReportWatcher(appMetrics, assetsReportUseCase, reports.NewCreateAssetSnapshotUseCase(mocks.NewAssetSnapshotRepositoryMock(t)), ticker)
 		err := watcher.Prepare(ctx)
 
 		assert.NoError(t, err)
@@ -127,7 +133,12 @@ func TestAssetReportWatcher_Start(t *testing.T) {
 		}
 		assetsReportUseCase.On("Run", mock.Anything).Return(testReport, nil)
 
-		watcher := monitoring.NewAssetReportWatcher(appMetrics, assetsReportUseCase, ticker)
+		snapshotRepository := mocks.NewAssetSnapshotRepositoryMock(t)
+		snapshotRepository.EXPECT().InsertAssetSnapshot(mock.Anything, mock.MatchedBy(func(snapshot reporting.AssetSnapshot) bool {
+			return snapshot.Btc.Total == testReport.BtcAssetReport.Total && snapshot.Rbtc.Total == testReport.RbtcAssetReport.Total &&
+				snapshot.Btc.Location.BtcWallet == testReport.BtcAssetReport.Location.BtcWallet && !snapshot.Timestamp.IsZero()
+		})).Return(nil).Once()
+		watcher := monitoring.NewAssetReportWatcher(appMetrics, assetsReportUseCase, reports.NewCreateAssetSnapshotUseCase(snapshotRepository), ticker)
 
 		// Verify initial metric values are zero
 		initialRbtcTotal := getAssetGaugeValue(appMetrics.AssetsMetrics, "rbtc", "total")
@@ -179,7 +190,7 @@ func TestAssetReportWatcher_Start(t *testing.T) {
 		expectedError := errors.New("failed to get asset report")
 		assetsReportUseCase.On("Run", mock.Anything).Return(reports.GetAssetsReportResult{}, expectedError)
 
-		watcher := monitoring.NewAssetReportWatcher(appMetrics, assetsReportUseCase, ticker)
+		watcher := monitoring.NewAssetReportWatcher(appMetrics, assetsReportUseCase, reports.NewCreateAssetSnapshotUseCase(mocks.NewAssetSnapshotRepositoryMock(t)), ticker)
 
 		// Verify initial metric values are zero
 		initialRbtcTotal := getAssetGaugeValue(appMetrics.AssetsMetrics, "rbtc", "total")
@@ -212,6 +223,54 @@ func TestAssetReportWatcher_Start(t *testing.T) {
 		ticker.AssertExpectations(t)
 	})
 
+	t.Run("should update metrics even if the asset snapshot can't be stored", func(t *testing.T) {
+		appMetrics := createTestAssetMetrics()
+		assetsReportUseCase := mocks.NewGetAssetsReportUseCaseMock(t)
+		ticker := &mocks.TickerMock{}
+
+		tickerChannel := make(chan time.Time, 1)
+		ticker.On("C").Return((<-chan time.Time)(tickerChannel))
+		ticker.On("Stop").Return()
+
+		zero := entities.NewWei(0)
+		report := reports.GetAssetsReportResult{
+			BtcAssetReport: reports.BtcAssetReport{
+				Total:      entities.NewWei(1000000000000000000), // 1.0 BTC
+				Location:   reports.BtcAssetLocation{BtcWallet: zero, Federation: zero, RskWallet: zero, Lbc: zero},
+				Allocation: reports.BtcAssetAllocation{ReservedForUsers: zero, WaitingForRefund: zero, Available: zero},
+			},
+			RbtcAssetReport: reports.RbtcAssetReport{
+				Total:      entities.NewWei(2000000000000000000), // 2.0 RBTC
+				Location:   reports.RbtcAssetLocation{RskWallet: zero, Lbc: zero, Federation: zero},
+				Allocation: reports.RbtcAssetAllocation{ReservedForUsers: zero, WaitingForRefund: zero, Available: zero},
+			},
+		}
+		assetsReportUseCase.On("Run", mock.Anything).Return(report, nil).Once()
+		snapshotRepository := mocks.NewAssetSnapshotRepositoryMock(t)
+		snapshotRepository.EXPECT().InsertAssetSnapshot(mock.Anything, mock.Anything).Return(assert.AnError).Once()
+
+		watcher := monitoring.NewAssetReportWatcher(appMetrics, assetsReportUseCase, reports.NewCreateAssetSnapshotUseCase(snapshotRepository), ticker)
+		checkFunction := test.AssertLogContains(t, "Failed to store asset snapshot")
+
+		go func() {
+			watcher.Start()
+		}()
+
+		tickerChannel <- time.Now()
+		time.Sleep(50 * time.Millisecond)
+
+		assert.InDelta(t, 2.0, getAssetGaugeValue(appMetrics.AssetsMetrics, "rbtc", "total"), 0.0001)
+		assert.InDelta(t, 1.0, getAssetGaugeValue(appMetrics.AssetsMetrics, "btc", "total"), 0.0001)
+		assert.True(t, checkFunction())
+
+		closeChannel := make(chan bool, 1)
+		watcher.Shutdown(closeChannel)
+		<-closeChannel
+
+		time.Sleep(10 * time.Millisecond)
+		ticker.AssertExpectations(t)
+	})
+
 	t.Run("should process multiple ticker events and update metrics consistently", func(t *testing.T) {
 		appMetrics := createTestAssetMetrics()
 		assetsReportUseCase := mocks.NewGetAssetsReportUseCaseMock(t)
@@ -285,7 +344,9 @@ func TestAssetReportWatcher_Start(t *testing.T) {
 		assetsReportUseCase.On("Run", mock.Anything).Return(firstReport, nil).Once()
 		assetsReportUseCase.On("Run", mock.Anything).Return(secondReport, nil).Once()
 
-		watcher := monitoring.NewAssetReportWatcher(appMetrics, assetsReportUseCase, ticker)
+		snapshotRepository := mocks.NewAssetSnapshotRepositoryMock(t)
+		snapshotRepository.EXPECT().InsertAssetSnapshot(mock.Anything, mock.Anything).Return(nil).Twice()
+		watcher := monitoring.NewAssetReportWatcher(appMetrics, assetsReportUseCase, reports.NewCreateAssetSnapshotUseCase(snapshotRepository), ticker)
 
 		go func() {
 			watcher.Start()
@@ -330,7 +391,7 @@ func TestAssetReportWatcher_Shutdown(t *testing.T) {
 		ticker.On("C").Return(make(<-chan time.Time))
 		ticker.On("Stop").Return()
 
-		watcher := monitoring.NewAssetReportWatcher(appMetrics, assetsReportUseCase, ticker)
+		watcher := monitoring.NewAssetReportWatcher(appMetrics, assetsReportUseCase, reports.NewCreateAssetSnapshotUseCase(mocks.NewAssetSnapshotRepositoryMock(t)), ticker)
 
 		go func() {
 			watcher.Start()
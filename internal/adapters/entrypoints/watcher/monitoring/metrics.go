@@ -3,14 +3,24 @@ package monitoring
 import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
 )
 
+// Values of the lps_low_liquidity_level gauge
+const (
+	LiquidityLevelHealthy  = 0
+	LiquidityLevelWarning  = 1
+	LiquidityLevelCritical = 2
+)
+
 type Metrics struct {
 	PeginQuotesMetric  *prometheus.CounterVec
 	PegoutQuotesMetric *prometheus.CounterVec
 	ServerInfoMetric   *prometheus.GaugeVec
 	AssetsMetrics      *prometheus.GaugeVec
+	LiquidityMetrics   *prometheus.GaugeVec
+	LiquidityLevel     *prometheus.GaugeVec
 }
 
 func NewMetrics(reg prometheus.Registerer) *Metrics {
@@ -43,6 +53,20 @@ func NewMetrics(reg prometheus.Registerer) *Metrics {
 			},
 			[]string{"currency", "type"},
 		),
+		LiquidityMetrics: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "lps_liquidity",
+				Help: "Liquidity provider available liquidity and low liquidity thresholds (in BTC/RBTC units)",
+			},
+			[]string{"operation", "type"},
+		),
+		LiquidityLevel: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "lps_low_liquidity_level",
+				Help: "Low liquidity level of the liquidity provider (0 healthy, 1 warning, 2 critical)",
+			},
+			[]string{"operation"},
+		),
 	}
 
 	reg.MustRegister(
@@ -50,6 +74,8 @@ func NewMetrics(reg prometheus.Registerer) *Metrics {
 		appMetrics.PeginQuotesMetric,
 		appMetrics.ServerInfoMetric,
 		appMetrics.AssetsMetrics,
+		appMetrics.LiquidityMetrics,
+		appMetrics.LiquidityLevel,
 	)
 	return &appMetrics
 }
@@ -83,6 +109,36 @@ func (m *Metrics) UpdateAssetsFromReport(report reports.GetAssetsReportResult) {
 	m.AssetsMetrics.WithLabelValues("btc", "allocation_available").Set(weiToBtcFloat64(report.BtcAssetReport.Allocation.Available))
 }
 
+func (m *Metrics) UpdateLiquidityFromCheck(result liquidity_provider.CheckLiquidityResult) {
+	m.updateOperationLiquidity("pegin", result.Pegin)
+	m.updateOperationLiquidity("pegout", result.Pegout)
+}
+
+func (m *Metrics) updateOperationLiquidity(operation string, status liquidity_provider.LiquidityStatus) {
+	level := LiquidityLevelHealthy
+	m.LiquidityMetrics.WithLabelValues(operation, "available").Set(weiToBtcFloat64(status.Available))
+
+	if status.WarningThreshold != nil {
+		m.LiquidityMetrics.WithLabelValues(operation, "warning_threshold").Set(weiToBtcFloat64(status.WarningThreshold))
+		if status.Available.Cmp(status.WarningThreshold) < 0 {
+			level = LiquidityLevelWarning
+		}
+	} else {
+		m.LiquidityMetrics.DeleteLabelValues(operation, "warning_threshold")
+	}
+
+	if status.CriticalThreshold != nil {
+		m.LiquidityMetrics.WithLabelValues(operation, "critical_threshold").Set(weiToBtcFloat64(status.CriticalThreshold))
+		if status.Available.Cmp(status.CriticalThreshold) < 0 {
+			level = LiquidityLevelCritical
+		}
+	} else {
+		m.LiquidityMetrics.DeleteLabelValues(operation, "critical_threshold")
+	}
+
+	m.LiquidityLevel.WithLabelValues(operation).Set(float64(level))
+}
+
 func weiToBtcFloat64(weiValue *entities.Wei) float64 {
 	asRbtc := weiValue.ToRbtc()
 	asFloat, _ := asRbtc.Float64()
@@ -7,6 +7,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/watcher/monitoring"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
 	"github.com/rsksmart/liquidity-provider-server/test/mocks"
 	"github.com/stretchr/testify/assert"
@@ -23,12 +24,16 @@ func TestNewMetrics(t *testing.T) {
 		assert.NotNil(t, metrics.PegoutQuotesMetric)
 		assert.NotNil(t, metrics.ServerInfoMetric)
 		assert.NotNil(t, metrics.AssetsMetrics)
+		assert.NotNil(t, metrics.LiquidityMetrics)
+		assert.NotNil(t, metrics.LiquidityLevel)
 
 		// Verify metric names and help text by checking descriptors
 		peginDesc := getMetricDesc(metrics.PeginQuotesMetric)
 		pegoutDesc := getMetricDesc(metrics.PegoutQuotesMetric)
 		serverInfoDesc := getMetricDesc(metrics.ServerInfoMetric)
 		assetsDesc := getMetricDesc(metrics.AssetsMetrics)
+		liquidityDesc := getMetricDesc(metrics.LiquidityMetrics)
+		liquidityLevelDesc := getMetricDesc(metrics.LiquidityLevel)
 
 		assert.Contains(t, peginDesc, "lps_pegin_quotes")
 		assert.Contains(t, peginDesc, "Pegin quotes processed")
@@ -48,6 +53,13 @@ func TestNewMetrics(t *testing.T) {
 		assert.Contains(t, assetsDesc, "currency")
 		assert.Contains(t, assetsDesc, "type")
 
+		assert.Contains(t, liquidityDesc, "lps_liquidity")
+		assert.Contains(t, liquidityDesc, "operation")
+		assert.Contains(t, liquidityDesc, "type")
+
+		assert.Contains(t, liquidityLevelDesc, "lps_low_liquidity_level")
+		assert.Contains(t, liquidityLevelDesc, "operation")
+
 		registerer.AssertExpectations(t)
 	})
 }
@@ -180,6 +192,51 @@ func createTestAssetReport() reports.GetAssetsReportResult {
 }
 
 // Helper function to create metrics with fresh mock registerer for each test
+func TestMetrics_UpdateLiquidityFromCheck(t *testing.T) {
+	t.Run("should update liquidity and threshold metrics", func(t *testing.T) {
+		metrics, _ := createMetricsWithMock(t)
+		metrics.UpdateLiquidityFromCheck(liquidity_provider.CheckLiquidityResult{
+			Pegin: liquidity_provider.LiquidityStatus{
+				Available:         createWeiFromString("1500000000000000000"),
+				WarningThreshold:  createWeiFromString("2000000000000000000"),
+				CriticalThreshold: createWeiFromString("1000000000000000000"),
+			},
+			Pegout: liquidity_provider.LiquidityStatus{
+				Available:         createWeiFromString("500000000000000000"),
+				CriticalThreshold: createWeiFromString("1000000000000000000"),
+			},
+		})
+
+		assert.InDelta(t, 1.5, getGaugeVecValue(metrics.LiquidityMetrics, "pegin", "available"), 0.0001)
+		assert.InDelta(t, 2.0, getGaugeVecValue(metrics.LiquidityMetrics, "pegin", "warning_threshold"), 0.0001)
+		assert.InDelta(t, 1.0, getGaugeVecValue(metrics.LiquidityMetrics, "pegin", "critical_threshold"), 0.0001)
+		assert.InDelta(t, float64(monitoring.LiquidityLevelWarning), getGaugeVecValue(metrics.LiquidityLevel, "pegin"), 0.0001)
+
+		assert.InDelta(t, 0.5, getGaugeVecValue(metrics.LiquidityMetrics, "pegout", "available"), 0.0001)
+		assert.InDelta(t, 1.0, getGaugeVecValue(metrics.LiquidityMetrics, "pegout", "critical_threshold"), 0.0001)
+		assert.InDelta(t, float64(monitoring.LiquidityLevelCritical), getGaugeVecValue(metrics.LiquidityLevel, "pegout"), 0.0001)
+	})
+
+	t.Run("should remove threshold metrics when thresholds are not configured", func(t *testing.T) {
+		metrics, _ := createMetricsWithMock(t)
+		metrics.UpdateLiquidityFromCheck(liquidity_provider.CheckLiquidityResult{
+			Pegin: liquidity_provider.LiquidityStatus{
+				Available:        createWeiFromString("1000000000000000000"),
+				WarningThreshold: createWeiFromString("500000000000000000"),
+			},
+			Pegout: liquidity_provider.LiquidityStatus{Available: createWeiFromString("1000000000000000000")},
+		})
+		assert.Equal(t, 3, countMetrics(metrics.LiquidityMetrics))
+		metrics.UpdateLiquidityFromCheck(liquidity_provider.CheckLiquidityResult{
+			Pegin:  liquidity_provider.LiquidityStatus{Available: createWeiFromString("1000000000000000000")},
+			Pegout: liquidity_provider.LiquidityStatus{Available: createWeiFromString("1000000000000000000")},
+		})
+		assert.Equal(t, 2, countMetrics(metrics.LiquidityMetrics))
+		assert.InDelta(t, float64(monitoring.LiquidityLevelHealthy), getGaugeVecValue(metrics.LiquidityLevel, "pegin"), 0.0001)
+		assert.InDelta(t, float64(monitoring.LiquidityLevelHealthy), getGaugeVecValue(metrics.LiquidityLevel, "pegout"), 0.0001)
+	})
+}
+
 func createMetricsWithMock(t *testing.T) (*monitoring.Metrics, *mocks.RegistererMock) {
 	registerer := mocks.NewRegistererMock(t)
 	registerer.On("MustRegister",
@@ -187,6 +244,8 @@ func createMetricsWithMock(t *testing.T) (*monitoring.Metrics, *mocks.Registerer
 		mock.AnythingOfType("*prometheus.CounterVec"), // PeginQuotesMetric
 		mock.AnythingOfType("*prometheus.GaugeVec"),   // ServerInfoMetric
 		mock.AnythingOfType("*prometheus.GaugeVec"),   // AssetsMetrics
+		mock.AnythingOfType("*prometheus.GaugeVec"),   // LiquidityMetrics
+		mock.AnythingOfType("*prometheus.GaugeVec"),   // LiquidityLevel
 	).Return()
 
 	metrics := monitoring.NewMetrics(registerer)
@@ -201,6 +260,14 @@ func getMetricDesc(metric prometheus.Collector) string {
 	return desc.String()
 }
 
+// Helper function to count the metrics collected from a collector
+func countMetrics(collector prometheus.Collector) int {
+	metricChannel := make(chan prometheus.Metric, 10)
+	collector.Collect(metricChannel)
+	close(metricChannel)
+	return len(metricChannel)
+}
+
 // Helper function to create Wei values from string (for large numbers)
 func createWeiFromString(weiStr string) *entities.Wei {
 	val := new(big.Int)
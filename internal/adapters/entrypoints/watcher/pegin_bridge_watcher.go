@@ -73,7 +73,7 @@ func (watcher *PeginBridgeWatcher) Prepare(ctx context.Context) error {
 }
 
 func (watcher *PeginBridgeWatcher) Start() {
-	eventChannel := watcher.eventBus.Subscribe(quote.CallForUserCompletedEventId)
+	eventChannel := entities.SubscribeDurable(watcher.eventBus, "PeginBridgeWatcher", quote.CallForUserCompletedEventId)
 watcherLoop:
 	for {
 		select {
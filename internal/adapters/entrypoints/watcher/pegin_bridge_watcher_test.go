@@ -165,7 +165,9 @@ func TestPeginBridgeWatcher_Start_BlockchainCheck(t *testing.T) {
 	peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false, Reason: "", Since: 0}, nil)
 
 	btcRpc := &mocks.BtcRpcMock{}
-	rpc := blockchain.Rpc{Btc: btcRpc}
+	rskRpc := &mocks.RootstockRpcServerMock{}
+	rskRpc.On("GasPrice", mock.Anything).Return(entities.NewWei(60000000), nil)
+	rpc := blockchain.Rpc{Btc: btcRpc, Rsk: rskRpc}
 	eventBus := &mocks.EventBusMock{}
 	cfuChannel := make(chan entities.Event)
 	eventBus.On("Subscribe", quote.CallForUserCompletedEventId).Return((<-chan entities.Event)(cfuChannel))
@@ -105,7 +105,7 @@ func (watcher *PeginDepositAddressWatcher) Prepare(ctx context.Context) error {
 }
 
 func (watcher *PeginDepositAddressWatcher) Start() {
-	eventChannel := watcher.eventBus.Subscribe(quote.AcceptedPeginQuoteEventId)
+	eventChannel := entities.SubscribeDurable(watcher.eventBus, "PeginDepositAddressWatcher", quote.AcceptedPeginQuoteEventId)
 watcherLoop:
 	for {
 		select {
@@ -157,9 +157,11 @@ func TestPeginDepositAddressWatcher_Start_BlockchainCheck(t *testing.T) {
 	peginRepository.EXPECT().GetRetainedQuoteByState(mock.Anything, quote.PeginStateWaitingForDepositConfirmations).Return([]quote.RetainedPeginQuote{}, nil).Once()
 	btcWallet := &mocks.BitcoinWalletMock{}
 	btcRpc := &mocks.BtcRpcMock{}
-	rpc := blockchain.Rpc{Btc: btcRpc}
+	rskRpc := &mocks.RootstockRpcServerMock{}
+	rskRpc.On("GasPrice", mock.Anything).Return(entities.NewWei(60000000), nil)
+	rpc := blockchain.Rpc{Btc: btcRpc, Rsk: rskRpc}
 	eventBus := &mocks.EventBusMock{}
-	eventBus.On("Publish", mock.Anything).Return(nil).Twice()
+	eventBus.On("Publish", mock.Anything).Return(nil)
 	acceptPeginChannel := make(chan entities.Event)
 	eventBus.On("Subscribe", quote.AcceptedPeginQuoteEventId).Return((<-chan entities.Event)(acceptPeginChannel))
 	ticker := &mocks.TickerMock{}
@@ -187,8 +189,8 @@ func TestPeginDepositAddressWatcher_Start_BlockchainCheck(t *testing.T) {
 	peginProvider.On("RskAddress").Return(test.AnyAddress)
 	appMutexes := environment.NewApplicationMutexes()
 	getUseCase := w.NewGetWatchedPeginQuoteUseCase(peginRepository)
-	expireUseCase := pegin.NewExpiredPeginQuoteUseCase(peginRepository)
-	updateUseCase := w.NewUpdatePeginDepositUseCase(peginRepository)
+	expireUseCase := pegin.NewExpiredPeginQuoteUseCase(peginRepository, eventBus)
+	updateUseCase := w.NewUpdatePeginDepositUseCase(peginRepository, eventBus)
 	cfuUseCase := pegin.NewCallForUserUseCase(blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}, peginRepository, rpc, peginProvider, eventBus, appMutexes.RskWalletMutex())
 	useCases := watcher.NewPeginDepositAddressWatcherUseCases(cfuUseCase, getUseCase, updateUseCase, expireUseCase)
 	peginWatcher := watcher.NewPeginDepositAddressWatcher(useCases, btcWallet, rpc, eventBus, ticker)
@@ -34,8 +34,12 @@ func TestPegoutBridgeWatcher_Start(t *testing.T) {
 	rskWallet := &mocks.RskWalletMock{}
 	bridge := &mocks.BridgeMock{}
 	bridge.On("GetAddress").Return(test.AnyAddress)
+	rsk := &mocks.RootstockRpcServerMock{}
+	rsk.On("GasPrice", mock.Anything).Return(entities.NewWei(60000000), nil)
 	mutexes := environment.NewApplicationMutexes()
-	bridgeUseCase := pegout.NewBridgePegoutUseCase(pegoutRepository, providerMock, rskWallet, blockchain.RskContracts{Bridge: bridge}, mutexes.RskWalletMutex())
+	eventBus := &mocks.EventBusMock{}
+	eventBus.On("Publish", mock.Anything).Return()
+	bridgeUseCase := pegout.NewBridgePegoutUseCase(pegoutRepository, providerMock, rskWallet, blockchain.RskContracts{Bridge: bridge}, blockchain.Rpc{Rsk: rsk}, mutexes.RskWalletMutex(), eventBus)
 	getUseCase := w.NewGetWatchedPegoutQuoteUseCase(pegoutRepository)
 	bridgeWatcher := watcher.NewPegoutBridgeWatcher(getUseCase, bridgeUseCase, ticker)
 	resetMocks := func() {
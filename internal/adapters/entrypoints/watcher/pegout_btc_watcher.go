@@ -21,6 +21,7 @@ type PegoutBtcTransferWatcher struct {
 	quotesMutex                  sync.RWMutex
 	getWatchedPegoutQuoteUseCase *w.GetWatchedPegoutQuoteUseCase
 	refundPegoutUseCase          *pegout.RefundPegoutUseCase
+	bumpPegoutFeeUseCase         *pegout.BumpPegoutFeeUseCase
 	rpc                          blockchain.Rpc
 	ticker                       utils.Ticker
 	eventBus                     entities.EventBus
@@ -32,6 +33,7 @@ type PegoutBtcTransferWatcher struct {
 func NewPegoutBtcTransferWatcher(
 	getWatchedPegoutQuoteUseCase *w.GetWatchedPegoutQuoteUseCase,
 	refundPegoutUseCase *pegout.RefundPegoutUseCase,
+	bumpPegoutFeeUseCase *pegout.BumpPegoutFeeUseCase,
 	rpc blockchain.Rpc,
 	eventBus entities.EventBus,
 	ticker utils.Ticker,
@@ -44,6 +46,7 @@ func NewPegoutBtcTransferWatcher(
 		quotesMutex:                  sync.RWMutex{},
 		getWatchedPegoutQuoteUseCase: getWatchedPegoutQuoteUseCase,
 		refundPegoutUseCase:          refundPegoutUseCase,
+		bumpPegoutFeeUseCase:         bumpPegoutFeeUseCase,
 		rpc:                          rpc,
 		eventBus:                     eventBus,
 		watcherStopChannel:           watcherStopChannel,
@@ -76,7 +79,7 @@ func (watcher *PegoutBtcTransferWatcher) Prepare(ctx context.Context) error {
 }
 
 func (watcher *PegoutBtcTransferWatcher) Start() {
-	eventChannel := watcher.eventBus.Subscribe(quote.PegoutBtcSentEventId)
+	eventChannel := entities.SubscribeDurable(watcher.eventBus, "PegoutBtcTransferWatcher", quote.PegoutBtcSentEventId)
 watcherLoop:
 	for {
 		select {
@@ -106,15 +109,55 @@ watcherLoop:
 func (watcher *PegoutBtcTransferWatcher) checkQuotes() {
 	var err error
 	var tx blockchain.BitcoinTransactionInformation
-	for _, watchedQuote := range watcher.quotes {
-		if tx, err = watcher.rpc.Btc.GetTransactionInfo(watchedQuote.RetainedQuote.LpBtcTxHash); err != nil {
+	// quotes paid in the same transaction (batch pegouts) must have their fee bumped together
+	unconfirmedQuotes := make(map[string][]quote.WatchedPegoutQuote)
+	defer func() {
+		for _, watchedQuotes := range unconfirmedQuotes {
+			watcher.bumpFee(watchedQuotes)
+		}
+	}()
+	for quoteHash, watchedQuote := range watcher.quotes {
+		if tx, err = watcher.getLpBtcTransaction(&watchedQuote); err != nil {
 			log.Error(pegoutBtcWatcherLog(blockchain.BtcTxInfoErrorTemplate, watchedQuote.RetainedQuote.LpBtcTxHash, err))
 			return
 		}
+		watcher.quotes[quoteHash] = watchedQuote
 		if watcher.validateQuote(watchedQuote, tx) {
 			watcher.refundPegout(watchedQuote)
+		} else if tx.Confirmations == 0 {
+			txHash := watchedQuote.RetainedQuote.LpBtcTxHash
+			unconfirmedQuotes[txHash] = append(unconfirmedQuotes[txHash], watchedQuote)
+		}
+	}
+}
+
+// getLpBtcTransaction returns the information of the LP BTC transaction of the quote. If the transaction was
+// replaced to bump its fee and one of the replaced transactions got confirmed instead, the quote is updated to
+// use that transaction, so the refund is made with the one that is in the blockchain
+func (watcher *PegoutBtcTransferWatcher) getLpBtcTransaction(watchedQuote *quote.WatchedPegoutQuote) (blockchain.BitcoinTransactionInformation, error) {
+	tx, err := watcher.rpc.Btc.GetTransactionInfo(watchedQuote.RetainedQuote.LpBtcTxHash)
+	if (err == nil && tx.Confirmations > 0) || len(watchedQuote.RetainedQuote.LpBtcReplacedTxs) == 0 {
+		return tx, err
+	}
+	for _, replaced := range watchedQuote.RetainedQuote.LpBtcReplacedTxs {
+		replacedTx, replacedErr := watcher.rpc.Btc.GetTransactionInfo(replaced.TxHash)
+		if replacedErr == nil && replacedTx.Confirmations > 0 {
+			log.Info(pegoutBtcWatcherLog("Replaced transaction %s of quote %s was confirmed", replaced.TxHash, watchedQuote.RetainedQuote.QuoteHash))
+			watchedQuote.RetainedQuote.UseLpBtcTransaction(replaced.TxHash)
+			return replacedTx, nil
 		}
 	}
+	return tx, err
+}
+
+func (watcher *PegoutBtcTransferWatcher) bumpFee(watchedQuotes []quote.WatchedPegoutQuote) {
+	updatedQuotes, err := watcher.bumpPegoutFeeUseCase.RunBatch(context.Background(), watchedQuotes)
+	if err != nil {
+		log.Error(pegoutBtcWatcherLog("Error bumping fee of transaction %s: %v", watchedQuotes[0].RetainedQuote.LpBtcTxHash, err))
+	}
+	for _, updatedQuote := range updatedQuotes {
+		watcher.quotes[updatedQuote.RetainedQuote.QuoteHash] = updatedQuote
+	}
 }
 
 func (watcher *PegoutBtcTransferWatcher) refundPegout(watchedQuote quote.WatchedPegoutQuote) {
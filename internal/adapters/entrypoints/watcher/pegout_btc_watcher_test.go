@@ -32,7 +32,7 @@ func TestPegoutBtcTransferWatcher_Start_SentPegout(t *testing.T) {
 	ticker := &mocks.TickerMock{}
 	ticker.EXPECT().C().Return(make(chan time.Time))
 	ticker.EXPECT().Stop().Return()
-	pegoutWatcher := watcher.NewPegoutBtcTransferWatcher(nil, nil, rpc, eventBus, ticker)
+	pegoutWatcher := watcher.NewPegoutBtcTransferWatcher(nil, nil, nil, rpc, eventBus, ticker)
 
 	go pegoutWatcher.Start()
 	t.Run("handle quote without tx hash", func(t *testing.T) {
@@ -91,7 +91,9 @@ func TestPegoutBtcTransferWatcher_Start_BlockchainCheck(t *testing.T) {
 	testPegoutQuote := quote.PegoutQuote{Nonce: 5, TransferConfirmations: 5}
 	pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
 	btcRpc := &mocks.BtcRpcMock{}
-	rpc := blockchain.Rpc{Btc: btcRpc}
+	rskRpc := &mocks.RootstockRpcServerMock{}
+	rskRpc.On("GasPrice", mock.Anything).Return(entities.NewWei(60000000), nil)
+	rpc := blockchain.Rpc{Btc: btcRpc, Rsk: rskRpc}
 	eventBus := &mocks.EventBusMock{}
 	pegoutSentChannel := make(chan entities.Event)
 	eventBus.On("Subscribe", quote.PegoutBtcSentEventId).Return((<-chan entities.Event)(pegoutSentChannel))
@@ -118,7 +120,7 @@ func TestPegoutBtcTransferWatcher_Start_BlockchainCheck(t *testing.T) {
 	pegoutContract.On("RefundPegout", mock.Anything, mock.Anything).Return(refundPegoutReceipt, nil).Once()
 	pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false, Reason: "", Since: 0}, nil)
 	refundUseCase := pegout.NewRefundPegoutUseCase(pegoutRepository, blockchain.RskContracts{PegOut: pegoutContract}, eventBus, rpc, mutex)
-	pegoutWatcher := watcher.NewPegoutBtcTransferWatcher(nil, refundUseCase, rpc, eventBus, ticker)
+	pegoutWatcher := watcher.NewPegoutBtcTransferWatcher(nil, refundUseCase, nil, rpc, eventBus, ticker)
 	resetMocks := func() {
 		btcRpc.Calls = []mock.Call{}
 		btcRpc.ExpectedCalls = []*mock.Call{}
@@ -240,6 +242,134 @@ func TestPegoutBtcTransferWatcher_Start_BlockchainCheck(t *testing.T) {
 	assert.Eventually(t, func() bool { return eventBus.AssertExpectations(t) && ticker.AssertExpectations(t) }, time.Second, 10*time.Millisecond)
 }
 
+// nolint:funlen
+func TestPegoutBtcTransferWatcher_Start_FeeBump(t *testing.T) {
+	const replacementTxHash = "030202"
+	testRetainedQuote := quote.RetainedPegoutQuote{
+		QuoteHash: "070809", DepositAddress: test.AnyAddress, UserRskTxHash: test.AnyHash, LpBtcTxHash: "030201",
+		SendPegoutBtcFee: entities.NewWei(500), State: quote.PegoutStateSendPegoutSucceeded,
+	}
+	testPegoutQuote := quote.PegoutQuote{Nonce: 5, TransferConfirmations: 5, TransferTime: 60}
+	replacedRetainedQuote := testRetainedQuote
+	replacedRetainedQuote.ReplaceLpBtcTransaction(replacementTxHash, entities.NewWei(900))
+	pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
+	btcRpc := &mocks.BtcRpcMock{}
+	rskRpc := &mocks.RootstockRpcServerMock{}
+	rskRpc.On("GetTransactionReceipt", mock.Anything, test.AnyHash).Return(blockchain.TransactionReceipt{BlockHash: test.AnyHash}, nil)
+	rskRpc.On("GetBlockByHash", mock.Anything, test.AnyHash).Return(blockchain.BlockInfo{Timestamp: time.Now().Add(-time.Minute)}, nil)
+	rpc := blockchain.Rpc{Btc: btcRpc, Rsk: rskRpc}
+	eventBus := &mocks.EventBusMock{}
+	pegoutSentChannel := make(chan entities.Event)
+	eventBus.On("Subscribe", quote.PegoutBtcSentEventId).Return((<-chan entities.Event)(pegoutSentChannel))
+	tickerChannel := make(chan time.Time)
+	ticker := &mocks.TickerMock{}
+	ticker.EXPECT().C().Return(tickerChannel)
+	ticker.EXPECT().Stop().Return()
+	mutex := new(mocks.MutexMock)
+	mutex.On("Lock").Return()
+	mutex.On("Unlock").Return()
+	btcWallet := &mocks.BitcoinWalletMock{}
+	maxFee := entities.NewWei(100000)
+	bumpFeeUseCase := pegout.NewBumpPegoutFeeUseCase(btcWallet, pegoutRepository, rpc, mutex, maxFee, 50)
+	pegoutWatcher := watcher.NewPegoutBtcTransferWatcher(nil, nil, bumpFeeUseCase, rpc, eventBus, ticker)
+	go pegoutWatcher.Start()
+	pegoutSentChannel <- quote.PegoutBtcSentToUserEvent{
+		Event:       entities.NewBaseEvent(quote.PegoutBtcSentEventId),
+		PegoutQuote: testPegoutQuote, RetainedQuote: testRetainedQuote,
+	}
+
+	t.Run("should replace unconfirmed transaction", func(t *testing.T) {
+		btcRpc.On("GetHeight").Return(big.NewInt(5), nil).Once()
+		btcRpc.On("GetTransactionInfo", testRetainedQuote.LpBtcTxHash).Return(blockchain.BitcoinTransactionInformation{Confirmations: 0}, nil).Once()
+		btcWallet.On("BumpFee", testRetainedQuote.LpBtcTxHash, maxFee).Return(blockchain.BitcoinTransactionResult{Hash: replacementTxHash, Fee: entities.NewWei(900)}, nil).Once()
+		pegoutRepository.EXPECT().UpdateRetainedQuote(mock.Anything, replacedRetainedQuote).Return(nil).Once()
+		tickerChannel <- time.Now()
+		assert.Eventually(t, func() bool {
+			watchedQuote, ok := pegoutWatcher.GetWatchedQuote(testRetainedQuote.QuoteHash)
+			return ok && watchedQuote.RetainedQuote.LpBtcTxHash == replacementTxHash && btcWallet.AssertExpectations(t)
+		}, time.Second, 10*time.Millisecond)
+		watchedQuote, _ := pegoutWatcher.GetWatchedQuote(testRetainedQuote.QuoteHash)
+		assert.Equal(t, quote.WatchedPegoutQuote{PegoutQuote: testPegoutQuote, RetainedQuote: replacedRetainedQuote}, watchedQuote)
+		btcRpc.AssertExpectations(t)
+		pegoutRepository.AssertExpectations(t)
+	})
+	t.Run("should track the replaced transaction if it gets confirmed", func(t *testing.T) {
+		btcRpc.On("GetHeight").Return(big.NewInt(6), nil).Once()
+		btcRpc.On("GetTransactionInfo", replacementTxHash).Return(blockchain.BitcoinTransactionInformation{}, assert.AnError).Once()
+		btcRpc.On("GetTransactionInfo", testRetainedQuote.LpBtcTxHash).Return(blockchain.BitcoinTransactionInformation{Confirmations: 1}, nil).Once()
+		tickerChannel <- time.Now()
+		assert.Eventually(t, func() bool {
+			watchedQuote, ok := pegoutWatcher.GetWatchedQuote(testRetainedQuote.QuoteHash)
+			return ok && watchedQuote.RetainedQuote.LpBtcTxHash == testRetainedQuote.LpBtcTxHash && btcRpc.AssertExpectations(t)
+		}, time.Second, 10*time.Millisecond)
+		watchedQuote, _ := pegoutWatcher.GetWatchedQuote(testRetainedQuote.QuoteHash)
+		assert.Equal(t, entities.NewWei(500), watchedQuote.RetainedQuote.SendPegoutBtcFee)
+		assert.Equal(t, []quote.ReplacedBtcTransaction{{TxHash: replacementTxHash, Fee: entities.NewWei(900)}}, watchedQuote.RetainedQuote.LpBtcReplacedTxs)
+		btcWallet.AssertNumberOfCalls(t, "BumpFee", 1)
+	})
+	closeChannel := make(chan bool)
+	go pegoutWatcher.Shutdown(closeChannel)
+	<-closeChannel
+}
+
+func TestPegoutBtcTransferWatcher_Start_BatchFeeBump(t *testing.T) {
+	const replacementTxHash = "030202"
+	firstRetainedQuote := quote.RetainedPegoutQuote{
+		QuoteHash: "070809", DepositAddress: test.AnyAddress, UserRskTxHash: test.AnyHash, LpBtcTxHash: "030201",
+		SendPegoutBtcFee: entities.NewWei(500), State: quote.PegoutStateSendPegoutSucceeded,
+	}
+	secondRetainedQuote := firstRetainedQuote
+	secondRetainedQuote.QuoteHash = "0a0b0c"
+	testPegoutQuote := quote.PegoutQuote{Nonce: 5, TransferConfirmations: 5, TransferTime: 60}
+	pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
+	btcRpc := &mocks.BtcRpcMock{}
+	rskRpc := &mocks.RootstockRpcServerMock{}
+	rskRpc.On("GetTransactionReceipt", mock.Anything, test.AnyHash).Return(blockchain.TransactionReceipt{BlockHash: test.AnyHash}, nil)
+	rskRpc.On("GetBlockByHash", mock.Anything, test.AnyHash).Return(blockchain.BlockInfo{Timestamp: time.Now().Add(-time.Minute)}, nil)
+	rpc := blockchain.Rpc{Btc: btcRpc, Rsk: rskRpc}
+	eventBus := &mocks.EventBusMock{}
+	pegoutSentChannel := make(chan entities.Event)
+	eventBus.On("Subscribe", quote.PegoutBtcSentEventId).Return((<-chan entities.Event)(pegoutSentChannel))
+	tickerChannel := make(chan time.Time)
+	ticker := &mocks.TickerMock{}
+	ticker.EXPECT().C().Return(tickerChannel)
+	ticker.EXPECT().Stop().Return()
+	mutex := new(mocks.MutexMock)
+	mutex.On("Lock").Return()
+	mutex.On("Unlock").Return()
+	btcWallet := &mocks.BitcoinWalletMock{}
+	maxFee := entities.NewWei(100000)
+	bumpFeeUseCase := pegout.NewBumpPegoutFeeUseCase(btcWallet, pegoutRepository, rpc, mutex, maxFee, 50)
+	pegoutWatcher := watcher.NewPegoutBtcTransferWatcher(nil, nil, bumpFeeUseCase, rpc, eventBus, ticker)
+	go pegoutWatcher.Start()
+	for _, retainedQuote := range []quote.RetainedPegoutQuote{firstRetainedQuote, secondRetainedQuote} {
+		pegoutSentChannel <- quote.PegoutBtcSentToUserEvent{
+			Event:       entities.NewBaseEvent(quote.PegoutBtcSentEventId),
+			PegoutQuote: testPegoutQuote, RetainedQuote: retainedQuote,
+		}
+	}
+
+	btcRpc.On("GetHeight").Return(big.NewInt(5), nil).Once()
+	btcRpc.On("GetTransactionInfo", firstRetainedQuote.LpBtcTxHash).Return(blockchain.BitcoinTransactionInformation{Confirmations: 0}, nil).Twice()
+	btcWallet.On("BumpFee", firstRetainedQuote.LpBtcTxHash, maxFee).Return(blockchain.BitcoinTransactionResult{Hash: replacementTxHash, Fee: entities.NewWei(900)}, nil).Once()
+	pegoutRepository.EXPECT().UpdateRetainedQuote(mock.Anything, mock.MatchedBy(func(retainedQuote quote.RetainedPegoutQuote) bool {
+		return retainedQuote.LpBtcTxHash == replacementTxHash && retainedQuote.SendPegoutBtcFee.Cmp(entities.NewWei(450)) == 0
+	})).Return(nil).Twice()
+	tickerChannel <- time.Now()
+	assert.Eventually(t, func() bool {
+		first, firstOk := pegoutWatcher.GetWatchedQuote(firstRetainedQuote.QuoteHash)
+		second, secondOk := pegoutWatcher.GetWatchedQuote(secondRetainedQuote.QuoteHash)
+		return firstOk && secondOk && first.RetainedQuote.LpBtcTxHash == replacementTxHash &&
+			second.RetainedQuote.LpBtcTxHash == replacementTxHash
+	}, time.Second, 10*time.Millisecond)
+	btcWallet.AssertNumberOfCalls(t, "BumpFee", 1)
+	pegoutRepository.AssertExpectations(t)
+
+	closeChannel := make(chan bool)
+	go pegoutWatcher.Shutdown(closeChannel)
+	<-closeChannel
+}
+
 func TestPegoutBtcTransferWatcher_Prepare(t *testing.T) {
 	t.Run("prepare watcher successfully", func(t *testing.T) {
 		quotes := []quote.RetainedPegoutQuote{
@@ -255,7 +385,7 @@ func TestPegoutBtcTransferWatcher_Prepare(t *testing.T) {
 			quoteRepository.EXPECT().GetPegoutCreationData(mock.Anything, mock.Anything).Return(quote.PegoutCreationData{GasPrice: entities.NewWei(int64(i))}).Once()
 		}
 		useCase := w.NewGetWatchedPegoutQuoteUseCase(quoteRepository)
-		pegoutWatcher := watcher.NewPegoutBtcTransferWatcher(useCase, nil, blockchain.Rpc{}, nil, nil)
+		pegoutWatcher := watcher.NewPegoutBtcTransferWatcher(useCase, nil, nil, blockchain.Rpc{}, nil, nil)
 		err := pegoutWatcher.Prepare(context.Background())
 		require.NoError(t, err)
 		for i, q := range quotes {
@@ -273,7 +403,7 @@ func TestPegoutBtcTransferWatcher_Prepare(t *testing.T) {
 		pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
 		pegoutRepository.EXPECT().GetRetainedQuoteByState(mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
 		useCase := w.NewGetWatchedPegoutQuoteUseCase(pegoutRepository)
-		addressWatcher := watcher.NewPegoutBtcTransferWatcher(useCase, nil, blockchain.Rpc{}, nil, nil)
+		addressWatcher := watcher.NewPegoutBtcTransferWatcher(useCase, nil, nil, blockchain.Rpc{}, nil, nil)
 		err := addressWatcher.Prepare(context.Background())
 		require.Error(t, err)
 		pegoutRepository.AssertExpectations(t)
@@ -284,7 +414,7 @@ func TestPegoutBtcTransferWatcher_Shutdown(t *testing.T) {
 	eventBus := &mocks.EventBusMock{}
 	eventBus.On("Subscribe", mock.Anything).Return(make(<-chan entities.Event))
 	createWatcherShutdownTest(t, func(ticker utils.Ticker) watcher.Watcher {
-		return watcher.NewPegoutBtcTransferWatcher(nil, nil, blockchain.Rpc{}, eventBus, ticker)
+		return watcher.NewPegoutBtcTransferWatcher(nil, nil, nil, blockchain.Rpc{}, eventBus, ticker)
 	})
 }
 
@@ -306,7 +436,7 @@ func TestPegoutBtcTransferWatcher(t *testing.T) {
 			After(time.Second*2).
 			Return([]quote.RetainedPegoutQuote{}, nil)
 		useCase := w.NewGetWatchedPegoutQuoteUseCase(quoteRepository)
-		pegoutWatcher := watcher.NewPegoutBtcTransferWatcher(useCase, nil, rpc, eventBus, ticker)
+		pegoutWatcher := watcher.NewPegoutBtcTransferWatcher(useCase, nil, nil, rpc, eventBus, ticker)
 
 		prepareDoneChannel := make(chan bool, 1)
 		startDoneChannel := make(chan bool, 1)
@@ -26,6 +26,7 @@ type PegoutRskDepositWatcher struct {
 	sendPegoutUseCase            *pegout.SendPegoutUseCase
 	updatePegoutDepositUseCase   *w.UpdatePegoutQuoteDepositUseCase
 	initDepositCacheUseCase      *pegout.InitPegoutDepositCacheUseCase
+	sendBatchPegoutUseCase       *pegout.SendBatchPegoutUseCase
 	pegoutLp                     liquidity_provider.PegoutLiquidityProvider
 	rpc                          blockchain.Rpc
 	contracts                    blockchain.RskContracts
@@ -44,6 +45,7 @@ type PegoutRskDepositWatcherUseCases struct {
 	sendPegoutUseCase            *pegout.SendPegoutUseCase
 	updatePegoutDepositUseCase   *w.UpdatePegoutQuoteDepositUseCase
 	initDepositCacheUseCase      *pegout.InitPegoutDepositCacheUseCase
+	sendBatchPegoutUseCase       *pegout.SendBatchPegoutUseCase
 }
 
 func NewPegoutRskDepositWatcherUseCases(
@@ -52,6 +54,7 @@ func NewPegoutRskDepositWatcherUseCases(
 	sendPegoutUseCase *pegout.SendPegoutUseCase,
 	updatePegoutDepositUseCase *w.UpdatePegoutQuoteDepositUseCase,
 	initDepositCacheUseCase *pegout.InitPegoutDepositCacheUseCase,
+	sendBatchPegoutUseCase *pegout.SendBatchPegoutUseCase,
 ) *PegoutRskDepositWatcherUseCases {
 	return &PegoutRskDepositWatcherUseCases{
 		getWatchedPegoutQuoteUseCase: getWatchedPegoutQuoteUseCase,
@@ -59,6 +62,7 @@ func NewPegoutRskDepositWatcherUseCases(
 		sendPegoutUseCase:            sendPegoutUseCase,
 		updatePegoutDepositUseCase:   updatePegoutDepositUseCase,
 		initDepositCacheUseCase:      initDepositCacheUseCase,
+		sendBatchPegoutUseCase:       sendBatchPegoutUseCase,
 	}
 }
 
@@ -82,6 +86,7 @@ func NewPegoutRskDepositWatcher(
 		sendPegoutUseCase:            watcherUseCases.sendPegoutUseCase,
 		updatePegoutDepositUseCase:   watcherUseCases.updatePegoutDepositUseCase,
 		initDepositCacheUseCase:      watcherUseCases.initDepositCacheUseCase,
+		sendBatchPegoutUseCase:       watcherUseCases.sendBatchPegoutUseCase,
 		pegoutLp:                     pegoutLp,
 		rpc:                          rpc,
 		contracts:                    contracts,
@@ -136,7 +141,7 @@ func (watcher *PegoutRskDepositWatcher) Prepare(ctx context.Context) error {
 }
 
 func (watcher *PegoutRskDepositWatcher) Start() {
-	eventChannel := watcher.eventBus.Subscribe(quote.AcceptedPegoutQuoteEventId)
+	eventChannel := entities.SubscribeDurable(watcher.eventBus, "PegoutRskDepositWatcher", quote.AcceptedPegoutQuoteEventId)
 
 watcherLoop:
 	for {
@@ -222,18 +227,29 @@ func (watcher *PegoutRskDepositWatcher) checkDeposit(ctx context.Context, deposi
 }
 
 func (watcher *PegoutRskDepositWatcher) checkQuotes(ctx context.Context, height uint64) {
+	readyQuotes := make([]quote.WatchedPegoutQuote, 0)
 	for _, watchedQuote := range watcher.quotes {
-		watcher.checkQuote(ctx, height, watchedQuote)
+		if watcher.checkQuote(ctx, height, watchedQuote) {
+			readyQuotes = append(readyQuotes, watchedQuote)
+		}
+	}
+	if watcher.sendBatchPegoutUseCase != nil && len(readyQuotes) > 1 {
+		watcher.sendBatchPegout(ctx, readyQuotes)
+		return
+	}
+	for _, watchedQuote := range readyQuotes {
+		watcher.sendPegout(ctx, watchedQuote)
 	}
 }
 
-func (watcher *PegoutRskDepositWatcher) checkQuote(ctx context.Context, height uint64, watchedQuote quote.WatchedPegoutQuote) {
+// checkQuote returns true if the deposit of the quote is confirmed and the pegout is ready to be sent
+func (watcher *PegoutRskDepositWatcher) checkQuote(ctx context.Context, height uint64, watchedQuote quote.WatchedPegoutQuote) bool {
 	var err error
 	var receipt blockchain.TransactionReceipt
 	if watchedQuote.RetainedQuote.State == quote.PegoutStateWaitingForDeposit && watchedQuote.PegoutQuote.IsExpired() {
 		if err = watcher.expiredUseCase.Run(ctx, watchedQuote.RetainedQuote); err != nil {
 			log.Error(pegoutRskWatcherLog("Error updating expired quote (%s): %v", watchedQuote.RetainedQuote.QuoteHash, err))
-			return
+			return false
 		} else {
 			log.Info(pegoutRskWatcherLog("Quote %s expired at %d", watchedQuote.RetainedQuote.QuoteHash, watchedQuote.PegoutQuote.ExpireTime().Unix()))
 			delete(watcher.quotes, watchedQuote.RetainedQuote.QuoteHash)
@@ -243,18 +259,34 @@ func (watcher *PegoutRskDepositWatcher) checkQuote(ctx context.Context, height u
 	if watchedQuote.RetainedQuote.State == quote.PegoutStateWaitingForDepositConfirmations {
 		if receipt, err = watcher.rpc.Rsk.GetTransactionReceipt(ctx, watchedQuote.RetainedQuote.UserRskTxHash); err != nil {
 			log.Error(pegoutRskWatcherLog("Error getting pegout deposit receipt of quote %s: %v", watchedQuote.RetainedQuote.QuoteHash, err))
-			return
-		}
-		if validateDepositedPegoutQuote(watchedQuote, receipt, height) {
-			watcher.sendPegout(ctx, watchedQuote)
+			return false
 		}
+		return validateDepositedPegoutQuote(watchedQuote, receipt, height)
 	}
+	return false
 }
 
 func (watcher *PegoutRskDepositWatcher) sendPegout(ctx context.Context, watchedQuote quote.WatchedPegoutQuote) {
-	var err error
+	err := watcher.sendPegoutUseCase.Run(ctx, watchedQuote.RetainedQuote)
+	watcher.handleSendPegoutResult(watchedQuote, err)
+}
+
+func (watcher *PegoutRskDepositWatcher) sendBatchPegout(ctx context.Context, watchedQuotes []quote.WatchedPegoutQuote) {
+	retainedQuotes := make([]quote.RetainedPegoutQuote, 0, len(watchedQuotes))
+	for _, watchedQuote := range watchedQuotes {
+		retainedQuotes = append(retainedQuotes, watchedQuote.RetainedQuote)
+	}
+	results := watcher.sendBatchPegoutUseCase.Run(ctx, retainedQuotes)
+	for _, watchedQuote := range watchedQuotes {
+		if err, ok := results[watchedQuote.RetainedQuote.QuoteHash]; ok {
+			watcher.handleSendPegoutResult(watchedQuote, err)
+		}
+	}
+}
+
+func (watcher *PegoutRskDepositWatcher) handleSendPegoutResult(watchedQuote quote.WatchedPegoutQuote, err error) {
 	const sendPegoutErrorMsgTemplate = "Error sending pegout to the user (quote %s): %v"
-	if err = watcher.sendPegoutUseCase.Run(ctx, watchedQuote.RetainedQuote); errors.Is(err, usecases.NonRecoverableError) {
+	if errors.Is(err, usecases.NonRecoverableError) {
 		log.Error(pegoutRskWatcherLog(sendPegoutErrorMsgTemplate, watchedQuote.RetainedQuote.QuoteHash, err))
 		delete(watcher.quotes, watchedQuote.RetainedQuote.QuoteHash)
 	} else if err != nil {
@@ -36,10 +36,11 @@ func TestNewPegoutRskDepositWatcher(t *testing.T) {
 		&pegout.SendPegoutUseCase{},
 		&w.UpdatePegoutQuoteDepositUseCase{},
 		&pegout.InitPegoutDepositCacheUseCase{},
+		&pegout.SendBatchPegoutUseCase{},
 	)
 	depositWatcher := watcher.NewPegoutRskDepositWatcher(useCases, providerMock, rpc, contracts, eventBus, 1, ticker, time.Duration(1))
-	// the strcut has 17, but we need the mutexes to have the zero value
-	assert.Equal(t, 15, test.CountNonZeroValues(depositWatcher))
+	// the strcut has 18, but we need the mutexes to have the zero value
+	assert.Equal(t, 16, test.CountNonZeroValues(depositWatcher))
 }
 
 // nolint:funlen
@@ -49,7 +50,7 @@ func TestPegoutRskDepositWatcher_Prepare(t *testing.T) {
 		rskRpc := &mocks.RootstockRpcServerMock{}
 		rpc := blockchain.Rpc{Rsk: rskRpc}
 		initCacheUseCase := pegout.NewInitPegoutDepositCacheUseCase(&mocks.PegoutQuoteRepositoryMock{}, contracts, rpc)
-		useCases := watcher.NewPegoutRskDepositWatcherUseCases(nil, nil, nil, nil, initCacheUseCase)
+		useCases := watcher.NewPegoutRskDepositWatcherUseCases(nil, nil, nil, nil, initCacheUseCase, nil)
 		depositWatcher := watcher.NewPegoutRskDepositWatcher(useCases, nil, rpc, contracts, nil, 1, nil, time.Duration(1))
 		rskRpc.EXPECT().GetHeight(mock.Anything).Return(uint64(0), assert.AnError)
 		err := depositWatcher.Prepare(context.Background())
@@ -81,7 +82,7 @@ func TestPegoutRskDepositWatcher_Prepare(t *testing.T) {
 
 		initCacheUseCase := pegout.NewInitPegoutDepositCacheUseCase(pegoutRepository, contracts, rpc)
 		getWatchedQuotesUseCase := w.NewGetWatchedPegoutQuoteUseCase(pegoutRepository)
-		useCases := watcher.NewPegoutRskDepositWatcherUseCases(getWatchedQuotesUseCase, nil, nil, nil, initCacheUseCase)
+		useCases := watcher.NewPegoutRskDepositWatcherUseCases(getWatchedQuotesUseCase, nil, nil, nil, initCacheUseCase, nil)
 		depositWatcher := watcher.NewPegoutRskDepositWatcher(useCases, providerMock, rpc, contracts, nil, 3000, nil, time.Duration(1))
 		err := depositWatcher.Prepare(context.Background())
 		require.NoError(t, err)
@@ -121,7 +122,7 @@ func TestPegoutRskDepositWatcher_Prepare(t *testing.T) {
 		providerMock.On("PegoutConfiguration", mock.Anything).Return(liquidity_provider.DefaultPegoutConfiguration()).Once()
 		initCacheUseCase := pegout.NewInitPegoutDepositCacheUseCase(pegoutRepository, contracts, rpc)
 		getWatchedQuotesUseCase := w.NewGetWatchedPegoutQuoteUseCase(pegoutRepository)
-		useCases := watcher.NewPegoutRskDepositWatcherUseCases(getWatchedQuotesUseCase, nil, nil, nil, initCacheUseCase)
+		useCases := watcher.NewPegoutRskDepositWatcherUseCases(getWatchedQuotesUseCase, nil, nil, nil, initCacheUseCase, nil)
 		depositWatcher := watcher.NewPegoutRskDepositWatcher(useCases, providerMock, rpc, contracts, nil, 0, nil, time.Duration(1))
 		err := depositWatcher.Prepare(context.Background())
 		require.NoError(t, err)
@@ -179,7 +180,7 @@ func TestPegoutRskDepositWatcher_Prepare(t *testing.T) {
 		providerMock.On("PegoutConfiguration", mock.Anything).Return(liquidity_provider.DefaultPegoutConfiguration()).Once()
 		initCacheUseCase := pegout.NewInitPegoutDepositCacheUseCase(pegoutRepository, contracts, rpc)
 		getWatchedQuotesUseCase := w.NewGetWatchedPegoutQuoteUseCase(pegoutRepository)
-		useCases := watcher.NewPegoutRskDepositWatcherUseCases(getWatchedQuotesUseCase, nil, nil, nil, initCacheUseCase)
+		useCases := watcher.NewPegoutRskDepositWatcherUseCases(getWatchedQuotesUseCase, nil, nil, nil, initCacheUseCase, nil)
 		depositWatcher := watcher.NewPegoutRskDepositWatcher(useCases, providerMock, rpc, contracts, nil, 0, nil, time.Duration(1))
 		err := depositWatcher.Prepare(context.Background())
 		require.NoError(t, err)
@@ -200,7 +201,7 @@ func TestPegoutRskDepositWatcher_Prepare(t *testing.T) {
 		rskRpc.EXPECT().GetHeight(mock.Anything).Return(uint64(0), assert.AnError).Once()
 		initCacheUseCase := pegout.NewInitPegoutDepositCacheUseCase(pegoutRepository, contracts, rpc)
 		getWatchedQuotesUseCase := w.NewGetWatchedPegoutQuoteUseCase(pegoutRepository)
-		useCases := watcher.NewPegoutRskDepositWatcherUseCases(getWatchedQuotesUseCase, nil, nil, nil, initCacheUseCase)
+		useCases := watcher.NewPegoutRskDepositWatcherUseCases(getWatchedQuotesUseCase, nil, nil, nil, initCacheUseCase, nil)
 		depositWatcher := watcher.NewPegoutRskDepositWatcher(useCases, providerMock, rpc, contracts, nil, 0, nil, time.Duration(1))
 		err := depositWatcher.Prepare(context.Background())
 		require.Error(t, err)
@@ -237,7 +238,7 @@ func TestPegoutRskDepositWatcher_Start_QuoteAccepted(t *testing.T) {
 	testPegoutQuote := quote.PegoutQuote{Nonce: 1}
 	testRetainedQuote := quote.RetainedPegoutQuote{QuoteHash: "010203"}
 
-	useCases := watcher.NewPegoutRskDepositWatcherUseCases(nil, nil, nil, nil, nil)
+	useCases := watcher.NewPegoutRskDepositWatcherUseCases(nil, nil, nil, nil, nil, nil)
 	depositWatcher := watcher.NewPegoutRskDepositWatcher(useCases, providerMock, rpc, contracts, eventBus, 3000, ticker, time.Duration(1))
 
 	go depositWatcher.Start()
@@ -297,12 +298,13 @@ func TestPegoutRskDepositWatcher_Start_BlockchainCheck_CheckDeposits(t *testing.
 	eventBus := &mocks.EventBusMock{}
 	acceptPegoutChannel := make(chan entities.Event)
 	eventBus.On("Subscribe", quote.AcceptedPegoutQuoteEventId).Return((<-chan entities.Event)(acceptPegoutChannel))
+	eventBus.On("Publish", mock.Anything).Return()
 
 	testPegoutQuote := quote.PegoutQuote{Nonce: 1, Value: entities.NewWei(3), ExpireBlock: 100, ExpireDate: uint32(time.Now().Unix() + 600)}
 	testRetainedQuote := quote.RetainedPegoutQuote{QuoteHash: "010203", State: quote.PegoutStateWaitingForDeposit}
 
-	updatePegoutDeposit := w.NewUpdatePegoutQuoteDepositUseCase(pegoutRepository)
-	useCases := watcher.NewPegoutRskDepositWatcherUseCases(nil, nil, nil, updatePegoutDeposit, nil)
+	updatePegoutDeposit := w.NewUpdatePegoutQuoteDepositUseCase(pegoutRepository, eventBus)
+	useCases := watcher.NewPegoutRskDepositWatcherUseCases(nil, nil, nil, updatePegoutDeposit, nil, nil)
 	depositWatcher := watcher.NewPegoutRskDepositWatcher(useCases, providerMock, rpc, contracts, eventBus, 0, ticker, time.Duration(1))
 
 	go depositWatcher.Start()
@@ -425,9 +427,9 @@ func TestPegoutRskDepositWatcher_Start_BlockchainCheck_CheckQuotes(t *testing.T)
 	testPegoutQuote := quote.PegoutQuote{Nonce: 1, Value: entities.NewWei(3), ExpireBlock: 100, ExpireDate: uint32(time.Now().Unix() + 600), DepositConfirmations: 5}
 	testRetainedQuote := quote.RetainedPegoutQuote{QuoteHash: "0102030000000000000000000000000000000000000000000000000000000000", State: quote.PegoutStateWaitingForDepositConfirmations, UserRskTxHash: test.AnyHash}
 
-	expireUseCase := pegout.NewExpiredPegoutQuoteUseCase(pegoutRepository)
+	expireUseCase := pegout.NewExpiredPegoutQuoteUseCase(pegoutRepository, eventBus)
 	sendPegoutUseCase := pegout.NewSendPegoutUseCase(btcWallet, pegoutRepository, rpc, eventBus, contracts, mutexes.BtcWalletMutex(), rootstock.ParseDepositEvent)
-	useCases := watcher.NewPegoutRskDepositWatcherUseCases(nil, expireUseCase, sendPegoutUseCase, nil, nil)
+	useCases := watcher.NewPegoutRskDepositWatcherUseCases(nil, expireUseCase, sendPegoutUseCase, nil, nil, nil)
 	depositWatcher := watcher.NewPegoutRskDepositWatcher(useCases, providerMock, rpc, contracts, eventBus, 0, ticker, time.Duration(1))
 
 	go depositWatcher.Start()
@@ -583,6 +585,77 @@ func TestPegoutRskDepositWatcher_Start_BlockchainCheck_CheckQuotes(t *testing.T)
 	})
 }
 
+// nolint:funlen
+func TestPegoutRskDepositWatcher_Start_BatchPegout(t *testing.T) {
+	mutexes := environment.NewApplicationMutexes()
+	ticker := &mocks.TickerMock{}
+	tickerChannel := make(chan time.Time)
+	ticker.EXPECT().C().Return(tickerChannel)
+	ticker.EXPECT().Stop().Return()
+	btcWallet := &mocks.BitcoinWalletMock{}
+	pegoutContract := &mocks.PegoutContractMock{}
+	contracts := blockchain.RskContracts{PegOut: pegoutContract}
+	rskRpc := &mocks.RootstockRpcServerMock{}
+	rpc := blockchain.Rpc{Rsk: rskRpc}
+	pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
+	eventBus := &mocks.EventBusMock{}
+	acceptPegoutChannel := make(chan entities.Event)
+	eventBus.On("Subscribe", quote.AcceptedPegoutQuoteEventId).Return((<-chan entities.Event)(acceptPegoutChannel))
+	eventBus.On("Publish", mock.Anything).Return()
+
+	sendPegoutUseCase := pegout.NewSendPegoutUseCase(btcWallet, pegoutRepository, rpc, eventBus, contracts, mutexes.BtcWalletMutex(), rootstock.ParseDepositEvent)
+	sendBatchPegoutUseCase := pegout.NewSendBatchPegoutUseCase(sendPegoutUseCase)
+	useCases := watcher.NewPegoutRskDepositWatcherUseCases(nil, nil, sendPegoutUseCase, nil, nil, sendBatchPegoutUseCase)
+	depositWatcher := watcher.NewPegoutRskDepositWatcher(useCases, &mocks.ProviderMock{}, rpc, contracts, eventBus, 10, ticker, time.Duration(1))
+	go depositWatcher.Start()
+
+	quoteHashes := []string{
+		"0102030000000000000000000000000000000000000000000000000000000000",
+		"0405060000000000000000000000000000000000000000000000000000000000",
+	}
+	for i, quoteHash := range quoteHashes {
+		testPegoutQuote := quote.PegoutQuote{Nonce: int64(i), Value: entities.NewWei(3), GasFee: entities.NewWei(1), ExpireBlock: 100, ExpireDate: uint32(time.Now().Unix() + 600), DepositConfirmations: 5}
+		testRetainedQuote := quote.RetainedPegoutQuote{QuoteHash: quoteHash, State: quote.PegoutStateWaitingForDepositConfirmations, UserRskTxHash: quoteHash}
+		receipt := test.AddDepositLogFromQuote(t, &blockchain.TransactionReceipt{BlockNumber: 10, Value: entities.NewWei(3)}, testPegoutQuote, testRetainedQuote)
+		rskRpc.EXPECT().GetTransactionReceipt(mock.Anything, testRetainedQuote.UserRskTxHash).Return(*receipt, nil)
+		pegoutRepository.EXPECT().GetQuote(mock.Anything, quoteHash).Return(&testPegoutQuote, nil).Once()
+		pegoutRepository.EXPECT().GetRetainedQuote(mock.Anything, quoteHash).Return(&testRetainedQuote, nil).Once()
+		pegoutRepository.EXPECT().GetPegoutCreationData(mock.Anything, quoteHash).Return(quote.PegoutCreationDataZeroValue()).Once()
+		pegoutContract.EXPECT().IsPegOutQuoteCompleted(quoteHash).Return(false, nil).Once()
+		acceptPegoutChannel <- quote.AcceptedPegoutQuoteEvent{
+			Event:         entities.NewBaseEvent(quote.AcceptedPegoutQuoteEventId),
+			Quote:         testPegoutQuote,
+			RetainedQuote: testRetainedQuote,
+		}
+	}
+	// one call from the watcher and one from the validation of each quote
+	rskRpc.EXPECT().GetHeight(mock.Anything).Return(uint64(20), nil).Times(3)
+	rskRpc.EXPECT().GetBlockByHash(mock.Anything, mock.Anything).Return(blockchain.BlockInfo{Timestamp: time.Now()}, nil)
+	pegoutContract.EXPECT().GetDepositEvents(mock.Anything, uint64(10), mock.MatchedBy(matchUinPtr(20))).Return([]quote.PegoutDeposit{}, nil).Once()
+	pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil)
+	pegoutContract.EXPECT().ValidatePegout(mock.Anything, []byte{0x01}).Return(nil).Twice()
+	btcWallet.On("CreateUnfundedBatchTransactionWithOpReturn", mock.Anything).Return([]byte{0x01}, nil).Once()
+	btcWallet.On("GetBalance").Return(entities.NewWei(10), nil).Once()
+	btcWallet.On("SendBatchWithOpReturn", mock.MatchedBy(func(payments []blockchain.BitcoinPayment) bool {
+		return len(payments) == 2
+	})).Return(blockchain.BitcoinTransactionResult{Hash: test.AnyHash, Fee: entities.NewWei(2)}, nil).Once()
+	pegoutRepository.EXPECT().UpdateRetainedQuote(mock.Anything, mock.MatchedBy(func(retainedQuote quote.RetainedPegoutQuote) bool {
+		return retainedQuote.LpBtcTxHash == test.AnyHash && retainedQuote.State == quote.PegoutStateSendPegoutSucceeded
+	})).Return(nil).Twice()
+
+	tickerChannel <- time.Now()
+	assert.Eventually(t, func() bool {
+		_, firstWatched := depositWatcher.GetWatchedQuote(quoteHashes[0])
+		_, secondWatched := depositWatcher.GetWatchedQuote(quoteHashes[1])
+		return !firstWatched && !secondWatched && btcWallet.AssertExpectations(t) && pegoutRepository.AssertExpectations(t)
+	}, time.Second, 10*time.Millisecond)
+	btcWallet.AssertNotCalled(t, "SendWithOpReturn", mock.Anything, mock.Anything, mock.Anything)
+
+	closeChannel := make(chan bool)
+	go depositWatcher.Shutdown(closeChannel)
+	<-closeChannel
+}
+
 func matchUinPtr(target uint64) func(uin *uint64) bool {
 	return func(uin *uint64) bool {
 		return *uin == target
@@ -0,0 +1,120 @@
+package watcher
+
+import (
+	"context"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/rootstock"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	webhookuc "github.com/rsksmart/liquidity-provider-server/internal/usecases/webhook"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	quoteWebhookWatcherSubscriber = "QuoteWebhookWatcher"
+	// maxConcurrentNotifications is the maximum amount of notifications being delivered at the same time,
+	// when all the slots are busy the watcher waits for one to be released before handling more events
+	maxConcurrentNotifications = 20
+)
+
+// QuoteWebhookWatcher listens to the events of the quote state transitions and notifies them to the
+// webhooks registered by the integrators. The notifications are sent asynchronously, so a slow webhook
+// doesn't delay the processing of the rest of the events, up to maxConcurrentNotifications at the same time
+type QuoteWebhookWatcher struct {
+	notifyQuoteStateUseCase *webhookuc.NotifyQuoteStateUseCase
+	eventBus                entities.EventBus
+	watcherStopChannel      chan bool
+	notificationTimeout     time.Duration
+	notificationSlots       chan struct{}
+}
+
+func NewQuoteWebhookWatcher(
+	notifyQuoteStateUseCase *webhookuc.NotifyQuoteStateUseCase,
+	eventBus entities.EventBus,
+	notificationTimeout time.Duration,
+) *QuoteWebhookWatcher {
+	watcherStopChannel := make(chan bool, 1)
+	return &QuoteWebhookWatcher{
+		notifyQuoteStateUseCase: notifyQuoteStateUseCase,
+		eventBus:                eventBus,
+		watcherStopChannel:      watcherStopChannel,
+		notificationTimeout:     notificationTimeout,
+		notificationSlots:       make(chan struct{}, maxConcurrentNotifications),
+	}
+}
+
+func (watcher *QuoteWebhookWatcher) Prepare(ctx context.Context) error { return nil }
+
+// nolint:cyclop
+func (watcher *QuoteWebhookWatcher) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	subscribe := func(id entities.EventId) <-chan entities.Event {
+		return entities.SubscribeDurable(watcher.eventBus, quoteWebhookWatcherSubscriber, id)
+	}
+	acceptedPeginChannel := subscribe(quote.AcceptedPeginQuoteEventId)
+	callForUserChannel := subscribe(quote.CallForUserCompletedEventId)
+	registerPeginChannel := subscribe(quote.RegisterPeginCompletedEventId)
+	peginStateChannel := subscribe(quote.PeginStateUpdatedEventId)
+	acceptedPegoutChannel := subscribe(quote.AcceptedPegoutQuoteEventId)
+	sendPegoutChannel := subscribe(quote.PegoutBtcSentEventId)
+	pegoutCompletedChannel := subscribe(quote.PegoutQuoteCompletedEventId)
+	pegoutStateChannel := subscribe(quote.PegoutStateUpdatedEventId)
+	batchPegoutChannel := subscribe(rootstock.BatchPegOutUpdatedEventId)
+
+watcherLoop:
+	for {
+		select {
+		case event := <-acceptedPeginChannel:
+			watcher.handleEvent(ctx, event)
+		case event := <-callForUserChannel:
+			watcher.handleEvent(ctx, event)
+		case event := <-registerPeginChannel:
+			watcher.handleEvent(ctx, event)
+		case event := <-peginStateChannel:
+			watcher.handleEvent(ctx, event)
+		case event := <-acceptedPegoutChannel:
+			watcher.handleEvent(ctx, event)
+		case event := <-sendPegoutChannel:
+			watcher.handleEvent(ctx, event)
+		case event := <-pegoutCompletedChannel:
+			watcher.handleEvent(ctx, event)
+		case event := <-pegoutStateChannel:
+			watcher.handleEvent(ctx, event)
+		case event := <-batchPegoutChannel:
+			watcher.handleEvent(ctx, event)
+		case <-watcher.watcherStopChannel:
+			close(watcher.watcherStopChannel)
+			break watcherLoop
+		}
+	}
+}
+
+func (watcher *QuoteWebhookWatcher) Shutdown(closeChannel chan<- bool) {
+	watcher.watcherStopChannel <- true
+	closeChannel <- true
+	log.Debug("QuoteWebhookWatcher shut down")
+}
+
+func (watcher *QuoteWebhookWatcher) handleEvent(ctx context.Context, event entities.Event) {
+	if event == nil {
+		return
+	}
+	for _, notification := range webhookuc.NotificationsFromEvent(event) {
+		watcher.notificationSlots <- struct{}{}
+		go func(notification webhook.Notification) {
+			defer func() { <-watcher.notificationSlots }()
+			watcher.notify(ctx, notification)
+		}(notification)
+	}
+}
+
+func (watcher *QuoteWebhookWatcher) notify(ctx context.Context, notification webhook.Notification) {
+	notificationCtx, cancel := context.WithTimeout(ctx, watcher.notificationTimeout)
+	defer cancel()
+	if err := watcher.notifyQuoteStateUseCase.Run(notificationCtx, notification); err != nil {
+		log.Errorf("QuoteWebhookWatcher: error notifying state %s of quote %s: %v", notification.State, notification.QuoteHash, err)
+	}
+}
@@ -0,0 +1,131 @@
+package watcher_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/watcher"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/rootstock"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	webhookuc "github.com/rsksmart/liquidity-provider-server/internal/usecases/webhook"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestQuoteWebhookWatcher_Start(t *testing.T) {
+	eventIds := []entities.EventId{
+		quote.AcceptedPeginQuoteEventId,
+		quote.CallForUserCompletedEventId,
+		quote.RegisterPeginCompletedEventId,
+		quote.PeginStateUpdatedEventId,
+		quote.AcceptedPegoutQuoteEventId,
+		quote.PegoutBtcSentEventId,
+		quote.PegoutQuoteCompletedEventId,
+		quote.PegoutStateUpdatedEventId,
+		rootstock.BatchPegOutUpdatedEventId,
+	}
+	channels := make(map[entities.EventId]chan entities.Event)
+	eventBus := &mocks.EventBusMock{}
+	for _, id := range eventIds {
+		channels[id] = make(chan entities.Event)
+		eventBus.On("Subscribe", id).Return((<-chan entities.Event)(channels[id])).Once()
+	}
+	hook := webhook.Webhook{Id: "1", Url: "https://integrator.example.com/callback"}
+	repository := &mocks.WebhookRepositoryMock{}
+	failed := make(chan struct{})
+	repository.On("GetWebhooks", mock.Anything, "0x06").Return(nil, assert.AnError).Run(func(args mock.Arguments) { close(failed) }).Once()
+	repository.On("GetWebhooks", mock.Anything, mock.Anything).Return([]webhook.Webhook{hook}, nil)
+	repository.On("DeleteQuoteWebhooks", mock.Anything, mock.Anything).Return(nil).Maybe()
+	sender := &mocks.NotificationSenderMock{}
+	sent := make(chan webhook.Notification, 10)
+	sender.On("SendNotification", mock.Anything, hook, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		sent <- args.Get(2).(webhook.Notification)
+	})
+	useCase := webhookuc.NewNotifyQuoteStateUseCase(repository, sender)
+	quoteWatcher := watcher.NewQuoteWebhookWatcher(useCase, eventBus, time.Second)
+	require.NoError(t, quoteWatcher.Prepare(context.Background()))
+	go quoteWatcher.Start()
+
+	receive := func(t *testing.T) webhook.Notification {
+		select {
+		case notification := <-sent:
+			return notification
+		case <-time.After(time.Second):
+			require.Fail(t, "Notification not sent")
+			return webhook.Notification{}
+		}
+	}
+	baseEvent := func(id entities.EventId) entities.BaseEvent {
+		return entities.BaseEvent{EventId: id, Timestamp: time.Unix(1700000000, 0)}
+	}
+
+	t.Run("should notify pegin transitions", func(t *testing.T) {
+		channels[quote.CallForUserCompletedEventId] <- quote.CallForUserCompletedEvent{
+			Event:         baseEvent(quote.CallForUserCompletedEventId),
+			RetainedQuote: quote.RetainedPeginQuote{QuoteHash: "0x01", State: quote.PeginStateCallForUserFailed},
+			Error:         assert.AnError,
+		}
+		assert.Equal(t, webhook.Notification{
+			QuoteHash: "0x01",
+			Operation: webhook.OperationPegin,
+			State:     string(quote.PeginStateCallForUserFailed),
+			Timestamp: time.Unix(1700000000, 0).UTC(),
+			Error:     assert.AnError.Error(),
+		}, receive(t))
+		channels[quote.PeginStateUpdatedEventId] <- quote.PeginStateUpdatedEvent{
+			Event:         baseEvent(quote.PeginStateUpdatedEventId),
+			RetainedQuote: quote.RetainedPeginQuote{QuoteHash: "0x02", State: quote.PeginStateTimeForDepositElapsed},
+		}
+		notification := receive(t)
+		assert.Equal(t, "0x02", notification.QuoteHash)
+		assert.Equal(t, string(quote.PeginStateTimeForDepositElapsed), notification.State)
+		assert.Empty(t, notification.Error)
+	})
+	t.Run("should notify pegout transitions", func(t *testing.T) {
+		channels[quote.PegoutStateUpdatedEventId] <- quote.PegoutStateUpdatedEvent{
+			Event:         baseEvent(quote.PegoutStateUpdatedEventId),
+			RetainedQuote: quote.RetainedPegoutQuote{QuoteHash: "0x03", State: quote.PegoutStateBridgeTxSucceeded},
+		}
+		assert.Equal(t, webhook.Notification{
+			QuoteHash: "0x03",
+			Operation: webhook.OperationPegout,
+			State:     string(quote.PegoutStateBridgeTxSucceeded),
+			Timestamp: time.Unix(1700000000, 0).UTC(),
+		}, receive(t))
+	})
+	t.Run("should notify every quote of a batch pegout", func(t *testing.T) {
+		channels[rootstock.BatchPegOutUpdatedEventId] <- rootstock.BatchPegOutUpdatedEvent{
+			Event:       baseEvent(rootstock.BatchPegOutUpdatedEventId),
+			QuoteHashes: []string{"0x04", "0x05"},
+		}
+		hashes := []string{receive(t).QuoteHash, receive(t).QuoteHash}
+		assert.ElementsMatch(t, []string{"0x04", "0x05"}, hashes)
+	})
+	t.Run("should keep working when the notification fails", func(t *testing.T) {
+		channels[quote.AcceptedPegoutQuoteEventId] <- quote.AcceptedPegoutQuoteEvent{
+			Event:         baseEvent(quote.AcceptedPegoutQuoteEventId),
+			RetainedQuote: quote.RetainedPegoutQuote{QuoteHash: "0x06", State: quote.PegoutStateWaitingForDeposit},
+		}
+		select {
+		case <-failed:
+		case <-time.After(time.Second):
+			require.Fail(t, "Notification not attempted")
+		}
+		channels[quote.PegoutBtcSentEventId] <- quote.PegoutBtcSentToUserEvent{
+			Event:         baseEvent(quote.PegoutBtcSentEventId),
+			RetainedQuote: quote.RetainedPegoutQuote{QuoteHash: "0x07", State: quote.PegoutStateSendPegoutSucceeded},
+		}
+		assert.Equal(t, "0x07", receive(t).QuoteHash)
+	})
+
+	closeChannel := make(chan bool, 1)
+	quoteWatcher.Shutdown(closeChannel)
+	assert.True(t, <-closeChannel)
+	eventBus.AssertExpectations(t)
+}
@@ -0,0 +1,139 @@
+package watcher
+
+import (
+	"context"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	log "github.com/sirupsen/logrus"
+)
+
+type SummariesReportUseCase interface {
+	Run(ctx context.Context, startDate, endDate time.Time) (reports.SummaryResult, error)
+}
+
+type RevenueReportUseCase interface {
+	Run(ctx context.Context, startDate, endDate time.Time) (reports.GetRevenueReportResult, error)
+}
+
+type AssetsReportUseCase interface {
+	Run(ctx context.Context) (reports.GetAssetsReportResult, error)
+}
+
+// ReportSnapshotWatcher generates the reports of the last completed period of each schedule and stores them as
+// snapshots. The assets report can't be calculated for a past date, so it reflects the positions at the moment
+// of the generation, which is at most one reportSnapshotInterval after the end of the period unless the server
+// was down at that moment
+type ReportSnapshotWatcher struct {
+	summariesUseCase       SummariesReportUseCase
+	revenueUseCase         RevenueReportUseCase
+	assetsUseCase          AssetsReportUseCase
+	createSnapshotsUseCase *reports.CreateReportSnapshotsUseCase
+	schedules              []reporting.Schedule
+	lastGenerated          map[reporting.Schedule]time.Time
+	ticker                 utils.Ticker
+	watcherStopChannel     chan bool
+}
+
+func NewReportSnapshotWatcher(
+	summariesUseCase SummariesReportUseCase,
+	revenueUseCase RevenueReportUseCase,
+	assetsUseCase AssetsReportUseCase,
+	createSnapshotsUseCase *reports.CreateReportSnapshotsUseCase,
+	schedules []reporting.Schedule,
+	ticker utils.Ticker,
+) *ReportSnapshotWatcher {
+	watcherStopChannel := make(chan bool, 1)
+	return &ReportSnapshotWatcher{
+		summariesUseCase:       summariesUseCase,
+		revenueUseCase:         revenueUseCase,
+		assetsUseCase:          assetsUseCase,
+		createSnapshotsUseCase: createSnapshotsUseCase,
+		schedules:              schedules,
+		lastGenerated:          make(map[reporting.Schedule]time.Time),
+		ticker:                 ticker,
+		watcherStopChannel:     watcherStopChannel,
+	}
+}
+
+func (watcher *ReportSnapshotWatcher) Prepare(ctx context.Context) error {
+	return nil
+}
+
+// Start generates the snapshots of the last completed periods right away, so the periods that ended while the
+// server was down are not missed. The snapshots that were already stored are skipped
+func (watcher *ReportSnapshotWatcher) Start() {
+	ctx := context.Background()
+	watcher.generate(ctx, time.Now())
+watcherLoop:
+	for {
+		select {
+		case <-watcher.ticker.C():
+			watcher.generate(ctx, time.Now())
+		case <-watcher.watcherStopChannel:
+			watcher.ticker.Stop()
+			close(watcher.watcherStopChannel)
+			break watcherLoop
+		}
+	}
+}
+
+func (watcher *ReportSnapshotWatcher) Shutdown(closeChannel chan<- bool) {
+	watcher.watcherStopChannel <- true
+	closeChannel <- true
+	log.Debug("ReportSnapshotWatcher shut down")
+}
+
+// generate creates the snapshots of the periods that weren't generated yet. Creating the snapshots also delivers
+// the ones whose delivery failed before, if there is nothing to create the failed deliveries are retried anyway
+func (watcher *ReportSnapshotWatcher) generate(ctx context.Context, now time.Time) {
+	var assets *reports.GetAssetsReportResult
+	delivered := false
+	defer func() {
+		if delivered {
+			return
+		}
+		if err := watcher.createSnapshotsUseCase.DeliverPending(ctx); err != nil {
+			log.Error("ReportSnapshotWatcher: error delivering pending snapshots: ", err)
+		}
+	}()
+	for _, schedule := range watcher.schedules {
+		periodStart, periodEnd := schedule.LastCompletedPeriod(now)
+		if lastGenerated, ok := watcher.lastGenerated[schedule]; ok && lastGenerated.Equal(periodStart) {
+			continue
+		}
+		if assets == nil {
+			result, err := watcher.assetsUseCase.Run(ctx)
+			if err != nil {
+				log.Error("ReportSnapshotWatcher: error generating assets report: ", err)
+				return
+			}
+			assets = &result
+		}
+		summaries, err := watcher.summariesUseCase.Run(ctx, periodStart, periodEnd)
+		if err != nil {
+			log.Errorf("ReportSnapshotWatcher: error generating %s summaries report: %v", schedule, err)
+			continue
+		}
+		revenue, err := watcher.revenueUseCase.Run(ctx, periodStart, periodEnd)
+		if err != nil {
+			log.Errorf("ReportSnapshotWatcher: error generating %s revenue report: %v", schedule, err)
+			continue
+		}
+		snapshots, err := watcher.createSnapshotsUseCase.Run(ctx, schedule, periodStart, periodEnd, map[reporting.ReportType]any{
+			reporting.ReportTypeSummaries: summaries,
+			reporting.ReportTypeRevenue:   revenue,
+			reporting.ReportTypeAssets:    *assets,
+		})
+		delivered = true
+		if err != nil {
+			log.Errorf("ReportSnapshotWatcher: error creating %s snapshots: %v", schedule, err)
+			continue
+		}
+		watcher.lastGenerated[schedule] = periodStart
+		log.Infof("ReportSnapshotWatcher: %d %s snapshots created for the period starting on %s",
+			len(snapshots), schedule, periodStart.Format(time.DateOnly))
+	}
+}
@@ -0,0 +1,174 @@
+package watcher_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/entrypoints/watcher"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportSnapshotWatcher_Prepare(t *testing.T) {
+	snapshotWatcher := watcher.NewReportSnapshotWatcher(
+		&mocks.GetSummariesReportUseCaseMock{},
+		&mocks.GetRevenueReportUseCaseMock{},
+		&mocks.GetAssetsReportUseCaseMock{},
+		reports.NewCreateReportSnapshotsUseCase(mocks.NewSnapshotRepositoryMock(t), nil, "", nil),
+		[]reporting.Schedule{reporting.ScheduleDaily},
+		&mocks.TickerMock{},
+	)
+	require.NoError(t, snapshotWatcher.Prepare(context.Background()))
+}
+
+// nolint:funlen
+func TestReportSnapshotWatcher_Start(t *testing.T) {
+	now := time.Now()
+	dailyStart, dailyEnd := reporting.ScheduleDaily.LastCompletedPeriod(now)
+	weeklyStart, weeklyEnd := reporting.ScheduleWeekly.LastCompletedPeriod(now)
+	tickerChannel := make(chan time.Time)
+	ticker := &mocks.TickerMock{}
+	ticker.EXPECT().C().Return(tickerChannel)
+	ticker.EXPECT().Stop().Return()
+	summariesUseCase := &mocks.GetSummariesReportUseCaseMock{}
+	revenueUseCase := &mocks.GetRevenueReportUseCaseMock{}
+	assetsUseCase := &mocks.GetAssetsReportUseCaseMock{}
+	repository := mocks.NewSnapshotRepositoryMock(t)
+	inserted := make(chan reporting.Snapshot, 10)
+	repository.EXPECT().InsertSnapshot(test.AnyCtx, mock.Anything).RunAndReturn(func(_ context.Context, snapshot reporting.Snapshot) error {
+		inserted <- snapshot
+		return nil
+	})
+
+	assetsUseCase.EXPECT().Run(test.AnyCtx).Return(reports.GetAssetsReportResult{}, nil).Once()
+	summariesUseCase.EXPECT().Run(test.AnyCtx, dailyStart, dailyEnd).Return(reports.SummaryResult{}, nil).Once()
+	summariesUseCase.EXPECT().Run(test.AnyCtx, weeklyStart, weeklyEnd).Return(reports.SummaryResult{}, assert.AnError).Once()
+	revenueUseCase.EXPECT().Run(test.AnyCtx, dailyStart, dailyEnd).Return(reports.GetRevenueReportResult{}, nil).Once()
+
+	snapshotWatcher := watcher.NewReportSnapshotWatcher(
+		summariesUseCase,
+		revenueUseCase,
+		assetsUseCase,
+		reports.NewCreateReportSnapshotsUseCase(repository, nil, "", nil),
+		[]reporting.Schedule{reporting.ScheduleDaily, reporting.ScheduleWeekly},
+		ticker,
+	)
+
+	receive := func(t *testing.T) reporting.Snapshot {
+		select {
+		case snapshot := <-inserted:
+			return snapshot
+		case <-time.After(time.Second):
+			require.Fail(t, "Snapshot not stored")
+			return reporting.Snapshot{}
+		}
+	}
+
+	checkFunction := test.AssertLogContains(t, "error generating weekly summaries report")
+	go snapshotWatcher.Start()
+
+	t.Run("should generate the snapshots of the last completed periods on start", func(t *testing.T) {
+		for _, reportType := range []reporting.ReportType{reporting.ReportTypeSummaries, reporting.ReportTypeRevenue, reporting.ReportTypeAssets} {
+			snapshot := receive(t)
+			assert.Equal(t, reportType, snapshot.ReportType)
+			assert.Equal(t, reporting.ScheduleDaily, snapshot.Schedule)
+			assert.Equal(t, dailyStart, snapshot.PeriodStart)
+		}
+	})
+	t.Run("should retry only the schedules that failed on the next tick", func(t *testing.T) {
+		assetsUseCase.EXPECT().Run(test.AnyCtx).Return(reports.GetAssetsReportResult{}, nil).Once()
+		summariesUseCase.EXPECT().Run(test.AnyCtx, weeklyStart, weeklyEnd).Return(reports.SummaryResult{}, nil).Once()
+		revenueUseCase.EXPECT().Run(test.AnyCtx, weeklyStart, weeklyEnd).Return(reports.GetRevenueReportResult{}, nil).Once()
+		tickerChannel <- now
+		for range 3 {
+			snapshot := receive(t)
+			assert.Equal(t, reporting.ScheduleWeekly, snapshot.Schedule)
+			assert.Equal(t, weeklyStart, snapshot.PeriodStart)
+		}
+		assert.True(t, checkFunction())
+	})
+
+	closeChannel := make(chan bool, 1)
+	snapshotWatcher.Shutdown(closeChannel)
+	<-closeChannel
+	summariesUseCase.AssertExpectations(t)
+	revenueUseCase.AssertExpectations(t)
+	assetsUseCase.AssertExpectations(t)
+	assert.Empty(t, inserted)
+}
+
+func TestReportSnapshotWatcher_Start_RetryDelivery(t *testing.T) {
+	now := time.Now()
+	dailyStart, dailyEnd := reporting.ScheduleDaily.LastCompletedPeriod(now)
+	tickerChannel := make(chan time.Time)
+	ticker := &mocks.TickerMock{}
+	ticker.EXPECT().C().Return(tickerChannel)
+	ticker.EXPECT().Stop().Return()
+	summariesUseCase := &mocks.GetSummariesReportUseCaseMock{}
+	revenueUseCase := &mocks.GetRevenueReportUseCaseMock{}
+	assetsUseCase := &mocks.GetAssetsReportUseCaseMock{}
+	repository := mocks.NewSnapshotRepositoryMock(t)
+	snapshotSender := mocks.NewSnapshotSenderMock(t)
+	undelivered := reporting.Snapshot{Id: "daily:revenue", Schedule: reporting.ScheduleDaily, ReportType: reporting.ReportTypeRevenue, PeriodStart: dailyStart}
+	delivered := make(chan []string, 1)
+
+	assetsUseCase.EXPECT().Run(test.AnyCtx).Return(reports.GetAssetsReportResult{}, nil).Once()
+	summariesUseCase.EXPECT().Run(test.AnyCtx, dailyStart, dailyEnd).Return(reports.SummaryResult{}, nil).Once()
+	revenueUseCase.EXPECT().Run(test.AnyCtx, dailyStart, dailyEnd).Return(reports.GetRevenueReportResult{}, nil).Once()
+	repository.EXPECT().InsertSnapshot(test.AnyCtx, mock.Anything).Return(reporting.DuplicateSnapshotError).Times(3)
+	repository.EXPECT().GetUndeliveredSnapshots(test.AnyCtx, mock.Anything).Return([]reporting.Snapshot{}, nil).Once()
+	repository.EXPECT().GetUndeliveredSnapshots(test.AnyCtx, mock.Anything).Return([]reporting.Snapshot{undelivered}, nil).Once()
+	snapshotSender.EXPECT().SendSnapshot(test.AnyCtx, undelivered).Return(nil).Once()
+	repository.EXPECT().MarkSnapshotsDelivered(test.AnyCtx, []string{undelivered.Id}, mock.Anything).
+		RunAndReturn(func(_ context.Context, ids []string, _ time.Time) error {
+			delivered <- ids
+			return nil
+		}).Once()
+
+	snapshotWatcher := watcher.NewReportSnapshotWatcher(
+		summariesUseCase,
+		revenueUseCase,
+		assetsUseCase,
+		reports.NewCreateReportSnapshotsUseCase(repository, nil, "", snapshotSender),
+		[]reporting.Schedule{reporting.ScheduleDaily},
+		ticker,
+	)
+	go snapshotWatcher.Start()
+
+	t.Run("should retry the pending deliveries on the ticks without new periods", func(t *testing.T) {
+		tickerChannel <- now
+		select {
+		case ids := <-delivered:
+			assert.Equal(t, []string{undelivered.Id}, ids)
+		case <-time.After(time.Second):
+			require.Fail(t, "Snapshot not delivered")
+		}
+	})
+
+	closeChannel := make(chan bool, 1)
+	snapshotWatcher.Shutdown(closeChannel)
+	<-closeChannel
+	summariesUseCase.AssertExpectations(t)
+	revenueUseCase.AssertExpectations(t)
+	assetsUseCase.AssertExpectations(t)
+}
+
+func TestReportSnapshotWatcher_Shutdown(t *testing.T) {
+	createWatcherShutdownTest(t, func(ticker utils.Ticker) watcher.Watcher {
+		return watcher.NewReportSnapshotWatcher(
+			&mocks.GetSummariesReportUseCaseMock{},
+			&mocks.GetRevenueReportUseCaseMock{},
+			&mocks.GetAssetsReportUseCaseMock{},
+			reports.NewCreateReportSnapshotsUseCase(mocks.NewSnapshotRepositoryMock(t), nil, "", nil),
+			[]reporting.Schedule{},
+			ticker,
+		)
+	})
+}
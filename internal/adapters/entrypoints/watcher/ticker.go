@@ -15,6 +15,9 @@ type ApplicationTickers struct {
 	RskEclipseCheckTicker          utils.Ticker
 	BtcReleaseCheckTicker          utils.Ticker
 	AssetReportTicker              utils.Ticker
+	LiquidityLedgerTicker          utils.Ticker
+	ReportSnapshotTicker           utils.Ticker
+	AssetDriftTicker               utils.Ticker
 }
 
 func NewApplicationTickers() *ApplicationTickers {
@@ -31,5 +34,8 @@ func NewApplicationTickers() *ApplicationTickers {
 		RskEclipseCheckTicker:          utils.NewTickerWrapper(rskEclipseCheckInterval),
 		BtcReleaseCheckTicker:          utils.NewTickerWrapper(btcReleaseCheckInterval),
 		AssetReportTicker:              utils.NewTickerWrapper(assetMetricsUpdateInterval),
+		LiquidityLedgerTicker:          utils.NewTickerWrapper(liquidityLedgerInterval),
+		ReportSnapshotTicker:           utils.NewTickerWrapper(reportSnapshotInterval),
+		AssetDriftTicker:               utils.NewTickerWrapper(assetDriftCheckInterval),
 	}
 }
@@ -13,11 +13,14 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/bitcoin/mempool_space"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
 	log "github.com/sirupsen/logrus"
 )
 
 const (
-	unknownBtcdVersion = -1
+	unknownBtcdVersion     = -1
+	fixedFeeRateStrategy   = "fixed"
+	mempoolFeeRateStrategy = "mempool"
 )
 
 type CreatedClient struct {
@@ -39,7 +42,24 @@ func BitcoinWallet(env environment.BtcEnv, walletId string) (*bitcoin.Connection
 	if err != nil {
 		return nil, err
 	}
-	return bitcoin.NewWalletConnection(createdClient.Params, createdClient.Client, walletId), nil
+	conn := bitcoin.NewWalletConnection(createdClient.Params, createdClient.Client, walletId)
+	conn.SetFeePolicy(BitcoinFeePolicy(env, conn))
+	return conn, nil
+}
+
+// BitcoinFeePolicy builds the bitcoin.FeePolicy of a wallet connection from the environment
+func BitcoinFeePolicy(env environment.BtcEnv, conn *bitcoin.Connection) bitcoin.FeePolicy {
+	var strategy blockchain.BtcFeeRateStrategy
+	target := utils.FirstNonZero(env.FeeRateTarget, bitcoin.DefaultFeeRateTarget)
+	switch env.FeeRateStrategy {
+	case fixedFeeRateStrategy:
+		strategy = bitcoin.NewFixedFeeRateStrategy(env.FeeRateFixed)
+	case mempoolFeeRateStrategy:
+		strategy = mempool_space.NewMempoolSpaceFeeRateStrategy(http.DefaultClient, env.FeeRateMempoolUrl, target)
+	default:
+		strategy = bitcoin.NewNodeFeeRateStrategy(conn, target)
+	}
+	return bitcoin.FeePolicy{FeeRate: strategy, QuoteMarginPercentage: env.FeeMarginPercentage}
 }
 
 func Bitcoin(env environment.BtcEnv) (*bitcoin.Connection, error) {
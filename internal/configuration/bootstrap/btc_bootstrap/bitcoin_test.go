@@ -1,8 +1,13 @@
 package btc_bootstrap_test
 
 import (
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/bitcoin"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/bitcoin/mempool_space"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/bootstrap/btc_bootstrap"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -84,3 +89,24 @@ func TestExternalBitcoinSources(t *testing.T) {
 		})
 	}
 }
+
+func TestBitcoinFeePolicy(t *testing.T) {
+	conn := bitcoin.NewConnection(&chaincfg.TestNet3Params, &mocks.ClientAdapterMock{})
+	t.Run("Uses the node strategy by default", func(t *testing.T) {
+		policy := btc_bootstrap.BitcoinFeePolicy(environment.BtcEnv{}, conn)
+		assert.IsType(t, &bitcoin.NodeFeeRateStrategy{}, policy.FeeRate)
+		assert.Zero(t, policy.QuoteMarginPercentage)
+	})
+	t.Run("Uses the fixed strategy", func(t *testing.T) {
+		policy := btc_bootstrap.BitcoinFeePolicy(environment.BtcEnv{FeeRateStrategy: "fixed", FeeRateFixed: 12, FeeMarginPercentage: 10}, conn)
+		require.IsType(t, &bitcoin.FixedFeeRateStrategy{}, policy.FeeRate)
+		feeRate, err := policy.FeeRate.FeeRate()
+		require.NoError(t, err)
+		assert.InDelta(t, 0.00012, feeRate, 0)
+		assert.Equal(t, uint64(10), policy.QuoteMarginPercentage)
+	})
+	t.Run("Uses the mempool strategy", func(t *testing.T) {
+		policy := btc_bootstrap.BitcoinFeePolicy(environment.BtcEnv{FeeRateStrategy: "mempool", FeeRateMempoolUrl: "http://mempool-source/api", FeeRateTarget: 3}, conn)
+		assert.IsType(t, &mempool_space.MempoolSpaceFeeRateStrategy{}, policy.FeeRate)
+	})
+}
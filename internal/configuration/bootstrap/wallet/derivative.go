@@ -1,6 +1,7 @@
 package wallet
 
 import (
+	"context"
 	"fmt"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/bitcoin"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock"
@@ -8,6 +9,7 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/bootstrap"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/bootstrap/btc_bootstrap"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment/secrets"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
 	log "github.com/sirupsen/logrus"
 )
@@ -17,6 +19,8 @@ type DerivativeWalletFactory struct {
 	env        environment.Environment
 	rskClient  *rootstock.RskClient
 	timeouts   environment.ApplicationTimeouts
+	// keystorePassword is the current password of the local keystore, required to re-encrypt it during a rotation
+	keystorePassword string
 }
 
 func NewDerivativeFactory(args FactoryCreationArgs) (AbstractFactory, error) {
@@ -30,13 +34,31 @@ func NewDerivativeFactory(args FactoryCreationArgs) (AbstractFactory, error) {
 	}
 	log.Debug("Connected to RSK account")
 	return &DerivativeWalletFactory{
-		rskAccount: rskAccount,
-		env:        args.Env,
-		rskClient:  args.RskClient,
-		timeouts:   args.Timeouts,
+		rskAccount:       rskAccount,
+		env:              args.Env,
+		rskClient:        args.RskClient,
+		timeouts:         args.Timeouts,
+		keystorePassword: applicationSecrets.EncryptedJsonPassword,
 	}, nil
 }
 
+// RotateSecrets updates the password of the local keystore if the keystore password was changed in the secret source
+func (factory *DerivativeWalletFactory) RotateSecrets(ctx context.Context, loader secrets.SecretLoader) error {
+	applicationSecrets, err := loader.LoadDerivativeSecrets(ctx)
+	if err != nil {
+		return err
+	} else if applicationSecrets.EncryptedJsonPassword == factory.keystorePassword {
+		return nil
+	}
+	err = factory.rskAccount.UpdatePassword(factory.keystorePassword, applicationSecrets.EncryptedJson, applicationSecrets.EncryptedJsonPassword)
+	if err != nil {
+		return err
+	}
+	factory.keystorePassword = applicationSecrets.EncryptedJsonPassword
+	log.Info("Keystore password rotated")
+	return nil
+}
+
 func (factory *DerivativeWalletFactory) BitcoinMonitoringWallet(walletId string) (blockchain.BitcoinWallet, error) {
 	walletConnection, err := btc_bootstrap.BitcoinWallet(factory.env.Btc, walletId)
 	if err != nil {
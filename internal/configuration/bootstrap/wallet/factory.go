@@ -15,6 +15,11 @@ type AbstractFactory interface {
 	RskWallet() (rootstock.RskSignerWallet, error)
 }
 
+// RotatableFactory is implemented by the factories whose secrets can be rotated while the server is running
+type RotatableFactory interface {
+	RotateSecrets(ctx context.Context, loader secrets.SecretLoader) error
+}
+
 type FactoryCreationArgs struct {
 	Ctx          context.Context
 	Env          environment.Environment
@@ -29,6 +34,8 @@ func NewFactory(env environment.Environment, args FactoryCreationArgs) (Abstract
 		return NewDerivativeFactory(args)
 	case "fireblocks":
 		return NewFireBlocksFactory(args)
+	case "remote":
+		return NewRemoteSignerFactory(args)
 	default:
 		return nil, errors.New("unknown wallet management scheme")
 	}
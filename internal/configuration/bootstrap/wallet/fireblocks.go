@@ -1,25 +1,92 @@
 package wallet
 
-import "github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
 
-// TODO complete with fireblocks integration
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/bitcoin"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/fireblocks"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/bootstrap/btc_bootstrap"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	log "github.com/sirupsen/logrus"
+)
 
-const featureUnimplemented = "feature unimplemented"
+const fireblocksPollInterval = 2 * time.Second
 
-type FireBlocksWalletFactory struct{}
+type FireBlocksWalletFactory struct {
+	ctx       context.Context
+	client    *fireblocks.Client
+	env       environment.Environment
+	rskClient *rootstock.RskClient
+	timeouts  environment.ApplicationTimeouts
+}
 
 func NewFireBlocksFactory(args FactoryCreationArgs) (AbstractFactory, error) {
-	panic(featureUnimplemented)
+	fireblocksSecrets, err := args.SecretLoader.LoadFireBlocksSecrets(args.Ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application secrets: %w", err)
+	}
+	client, err := fireblocks.NewClient(
+		&http.Client{Timeout: args.Timeouts.FireblocksSigning.Seconds()},
+		args.Env.Fireblocks.ApiUrl,
+		fireblocksSecrets.ApiKey,
+		[]byte(fireblocksSecrets.PrivateKey),
+		fireblocksPollInterval,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Fireblocks client: %w", err)
+	}
+	return &FireBlocksWalletFactory{
+		ctx:       args.Ctx,
+		client:    client,
+		env:       args.Env,
+		rskClient: args.RskClient,
+		timeouts:  args.Timeouts,
+	}, nil
 }
 
-func (f FireBlocksWalletFactory) BitcoinMonitoringWallet(walletId string) (blockchain.BitcoinWallet, error) {
-	panic(featureUnimplemented)
+func (factory *FireBlocksWalletFactory) BitcoinMonitoringWallet(walletId string) (blockchain.BitcoinWallet, error) {
+	walletConnection, err := btc_bootstrap.BitcoinWallet(factory.env.Btc, walletId)
+	if err != nil {
+		return nil, fmt.Errorf("error creating BTC monitoring connection: %w", err)
+	}
+	wallet, err := bitcoin.NewWatchOnlyWallet(walletConnection)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("Connected to BTC node wallet for monitoring")
+	return wallet, nil
 }
 
-func (f FireBlocksWalletFactory) BitcoinPaymentWallet(walletId string) (blockchain.BitcoinWallet, error) {
-	panic(featureUnimplemented)
+// BitcoinPaymentWallet ignores the wallet id and always uses the bitcoin.FireblocksWalletId node wallet, so the
+// Fireblocks public key is never mixed with the key imported by the native wallet management
+func (factory *FireBlocksWalletFactory) BitcoinPaymentWallet(_ string) (blockchain.BitcoinWallet, error) {
+	walletConnection, err := btc_bootstrap.BitcoinWallet(factory.env.Btc, bitcoin.FireblocksWalletId)
+	if err != nil {
+		return nil, fmt.Errorf("error creating BTC payment connection: %w", err)
+	}
+	wallet, err := bitcoin.NewFireblocksWallet(factory.ctx, walletConnection, factory.client, fireblocks.VaultAsset{
+		VaultAccountId: factory.env.Fireblocks.VaultAccountId,
+		AssetId:        factory.env.Fireblocks.BtcAssetId,
+	}, factory.timeouts.FireblocksSigning.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("Connected to BTC node wallet for payments")
+	return wallet, nil
 }
 
-func (f FireBlocksWalletFactory) RskWallet() (blockchain.RootstockWallet, error) {
-	panic(featureUnimplemented)
+func (factory *FireBlocksWalletFactory) RskWallet() (rootstock.RskSignerWallet, error) {
+	wallet, err := rootstock.NewFireblocksRskWallet(factory.ctx, factory.rskClient, factory.client, fireblocks.VaultAsset{
+		VaultAccountId: factory.env.Fireblocks.VaultAccountId,
+		AssetId:        factory.env.Fireblocks.RskAssetId,
+	}, factory.env.Rsk.ChainId, factory.timeouts.MiningWait.Seconds(), factory.timeouts.FireblocksSigning.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to Fireblocks RSK wallet: %w", err)
+	}
+	return wallet, nil
 }
@@ -0,0 +1,103 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/bitcoin"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/remote_signer"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/bootstrap/btc_bootstrap"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	log "github.com/sirupsen/logrus"
+)
+
+type RemoteSignerWalletFactory struct {
+	env       environment.Environment
+	rskWallet *rootstock.RemoteSignerRskWallet
+}
+
+func NewRemoteSignerFactory(args FactoryCreationArgs) (AbstractFactory, error) {
+	var address common.Address
+	if args.Env.RemoteSigner.Url == "" {
+		return nil, errors.New("missing remote signer url")
+	} else if err := rootstock.ParseAddress(&address, args.Env.RemoteSigner.Address); err != nil {
+		return nil, fmt.Errorf("invalid remote signer address: %w", err)
+	}
+	allowedContracts, err := remoteSignerAllowlist(args.Env.Rsk)
+	if err != nil {
+		return nil, err
+	}
+	if args.Env.RemoteSigner.BtcAddress == "" {
+		return nil, errors.New("missing remote signer btc address")
+	}
+	signer, err := remote_signer.Dial(args.Ctx, args.Env.RemoteSigner.Url, remote_signer.SignerType(args.Env.RemoteSigner.Type))
+	if err != nil {
+		return nil, err
+	}
+	rskWallet, err := rootstock.NewRemoteSignerRskWallet(args.Ctx, args.RskClient, signer, rootstock.RemoteSignerWalletArgs{
+		Address:          address,
+		AllowedContracts: allowedContracts,
+		ChainId:          args.Env.Rsk.ChainId,
+		MiningTimeout:    args.Timeouts.MiningWait.Seconds(),
+		SigningTimeout:   args.Timeouts.RemoteSigning.Seconds(),
+	})
+	if err != nil {
+		signer.Close()
+		return nil, fmt.Errorf("error connecting to remote signer account: %w", err)
+	}
+	log.Debug("Connected to remote signer account")
+	return &RemoteSignerWalletFactory{env: args.Env, rskWallet: rskWallet}, nil
+}
+
+func (factory *RemoteSignerWalletFactory) BitcoinMonitoringWallet(walletId string) (blockchain.BitcoinWallet, error) {
+	walletConnection, err := btc_bootstrap.BitcoinWallet(factory.env.Btc, walletId)
+	if err != nil {
+		return nil, fmt.Errorf("error creating BTC monitoring connection: %w", err)
+	}
+	wallet, err := bitcoin.NewWatchOnlyWallet(walletConnection)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("Connected to BTC node wallet for monitoring")
+	return wallet, nil
+}
+
+// BitcoinPaymentWallet ignores the wallet id and always uses the bitcoin.NodeKeyWalletId node wallet, since the
+// external signers can't sign BTC transactions, so the BTC key must be held by the node
+func (factory *RemoteSignerWalletFactory) BitcoinPaymentWallet(_ string) (blockchain.BitcoinWallet, error) {
+	walletConnection, err := btc_bootstrap.BitcoinWallet(factory.env.Btc, bitcoin.NodeKeyWalletId)
+	if err != nil {
+		return nil, fmt.Errorf("error creating BTC payment connection: %w", err)
+	}
+	wallet, err := bitcoin.NewNodeKeyWallet(walletConnection, factory.env.RemoteSigner.BtcAddress)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("Connected to BTC node wallet for payments")
+	return wallet, nil
+}
+
+func (factory *RemoteSignerWalletFactory) RskWallet() (rootstock.RskSignerWallet, error) {
+	return factory.rskWallet, nil
+}
+
+// remoteSignerAllowlist returns the only contracts that the LPS is allowed to send transactions to
+func remoteSignerAllowlist(env environment.RskEnv) ([]common.Address, error) {
+	contracts := []string{
+		env.PeginContractAddress,
+		env.PegoutContractAddress,
+		env.CollateralManagementAddress,
+		env.DiscoveryAddress,
+		env.BridgeAddress,
+	}
+	allowlist := make([]common.Address, len(contracts))
+	for i, contract := range contracts {
+		if err := rootstock.ParseAddress(&allowlist[i], contract); err != nil {
+			return nil, fmt.Errorf("invalid contract address %s: %w", contract, err)
+		}
+	}
+	return allowlist, nil
+}
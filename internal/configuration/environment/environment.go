@@ -5,20 +5,27 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/go-playground/validator/v10"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/watcher"
 	log "github.com/sirupsen/logrus"
 )
 
+// DefaultQuoteBatchSize is the maximum amount of requests of the batch quote endpoints if QUOTE_BATCH_MAX_SIZE is not set
+const DefaultQuoteBatchSize = 10
+
 type Environment struct {
 	LpsStage         string   `env:"LPS_STAGE" validate:"required,oneof=regtest testnet mainnet"`
 	Port             uint     `env:"SERVER_PORT" validate:"required"`
 	LogLevel         string   `env:"LOG_LEVEL" validate:"required"`
 	LogFile          string   `env:"LOG_FILE"`
 	AwsLocalEndpoint string   `env:"AWS_LOCAL_ENDPOINT"`
-	SecretSource     string   `env:"SECRET_SRC" validate:"required,oneof=aws env"`
-	WalletManagement string   `env:"WALLET" validate:"required,oneof=native fireblocks"`
+	SecretSource     string   `env:"SECRET_SRC" validate:"required,oneof=aws env vault file"`
+	WalletManagement string   `env:"WALLET" validate:"required,oneof=native fireblocks remote"`
 	AllowedOrigins   []string `env:"ALLOWED_ORIGINS" validate:"required,dive,url"`
+	EventBus         string   `env:"EVENT_BUS" validate:"omitempty,oneof=local mongo"`
+	SecretsReload    uint64   `env:"SECRETS_RELOAD_INTERVAL"`
+	QuoteBatchSize   uint64   `env:"QUOTE_BATCH_MAX_SIZE"`
 	Management       ManagementEnv
 	Mongo            MongoEnv
 	Rsk              RskEnv
@@ -28,6 +35,16 @@ type Environment struct {
 	Captcha          CaptchaEnv
 	Timeouts         TimeoutEnv
 	Eclipse          EclipseEnv
+	Alerting         AlertingEnv
+	Webhook          WebhookEnv
+	Fireblocks       FireblocksEnv
+	RemoteSigner     RemoteSignerEnv
+	Vault            VaultEnv
+	SecretsFile      SecretsFileEnv
+	RateLimit        RateLimitEnv
+	GasPrice         GasPriceEnv
+	ReportSnapshot   ReportSnapshotEnv
+	AssetDrift       AssetDriftEnv
 }
 
 type MongoEnv struct {
@@ -69,6 +86,12 @@ type BtcEnv struct {
 	Password        string           `env:"BTC_PASSWORD" validate:"required"`
 	Endpoint        string           `env:"BTC_ENDPOINT" validate:"required"`
 	BtcExtraSources []BtcExtraSource `env:"BTC_EXTRA_SOURCES"`
+	// FeeRateStrategy is the source of the fee rate of the LP transactions, the fee rates are in sat/vB
+	FeeRateStrategy     string `env:"BTC_FEE_RATE_STRATEGY" validate:"omitempty,oneof=node mempool fixed"`
+	FeeRateTarget       uint64 `env:"BTC_FEE_RATE_TARGET"`
+	FeeRateFixed        uint64 `env:"BTC_FEE_RATE_FIXED" validate:"required_if=FeeRateStrategy fixed"`
+	FeeRateMempoolUrl   string `env:"BTC_FEE_RATE_MEMPOOL_URL" validate:"required_if=FeeRateStrategy mempool"`
+	FeeMarginPercentage uint64 `env:"BTC_FEE_MARGIN_PERCENTAGE"`
 }
 
 type TimeoutEnv struct {
@@ -83,6 +106,9 @@ type TimeoutEnv struct {
 	ServerIdle          uint64 `env:"SERVER_IDLE_TIMEOUT"`
 	PegoutDepositCheck  uint64 `env:"PEGOUT_DEPOSIT_CHECK_TIMEOUT"`
 	BtcReleaseCheck     uint64 `env:"BTC_RELEASE_CHECK_TIMEOUT"`
+	WebhookNotification uint64 `env:"WEBHOOK_NOTIFICATION_TIMEOUT"`
+	FireblocksSigning   uint64 `env:"FIREBLOCKS_SIGNING_TIMEOUT"`
+	RemoteSigning       uint64 `env:"REMOTE_SIGNING_TIMEOUT"`
 }
 
 type EclipseEnv struct {
@@ -164,10 +190,99 @@ func (env *ProviderEnv) ProviderType() liquidity_provider.ProviderType {
 // PeginEnv This structure was kept just in case, right now all the parameters are manipulated through management API
 type PeginEnv struct{}
 
+type AlertRouteEnv struct {
+	Subject  string   `json:"subject" validate:"required"`
+	Severity string   `json:"severity" validate:"omitempty,oneof=info warning error critical"`
+	Channels []string `json:"channels" validate:"required,min=1,dive,oneof=log email webhook slack pagerduty"`
+	// DedupWindowSeconds overrides ALERT_DEDUP_WINDOW_SECONDS for the subject, zero disables the deduplication
+	DedupWindowSeconds *uint64 `json:"dedupWindowSeconds"`
+}
+
+type AlertingEnv struct {
+	WebhookUrl          string          `env:"ALERT_WEBHOOK_URL" validate:"omitempty,url"`
+	SlackWebhookUrl     string          `env:"ALERT_SLACK_WEBHOOK_URL" validate:"omitempty,url"`
+	PagerDutyRoutingKey string          `env:"ALERT_PAGERDUTY_ROUTING_KEY"`
+	PagerDutyUrl        string          `env:"ALERT_PAGERDUTY_URL" validate:"omitempty,url"`
+	DefaultChannels     []string        `env:"ALERT_DEFAULT_CHANNELS" validate:"dive,oneof=log email webhook slack pagerduty"`
+	Routes              []AlertRouteEnv `env:"ALERT_ROUTES" validate:"dive"`
+	// DedupWindowSeconds is a pointer so zero can be used to disable the deduplication
+	DedupWindowSeconds *uint64 `env:"ALERT_DEDUP_WINDOW_SECONDS"`
+}
+
+func (env *AlertingEnv) FillWithDefaults() *AlertingEnv {
+	const defaultDedupWindowSeconds uint64 = 60 * 60 // 1 hour
+	if env.DedupWindowSeconds == nil {
+		defaultWindow := defaultDedupWindowSeconds
+		env.DedupWindowSeconds = &defaultWindow
+	}
+	return env
+}
+
+type WebhookEnv struct {
+	MaxAttempts           uint64 `env:"WEBHOOK_MAX_ATTEMPTS"`
+	InitialBackoffSeconds uint64 `env:"WEBHOOK_INITIAL_BACKOFF_SECONDS"`
+}
+
+func (env *WebhookEnv) FillWithDefaults() *WebhookEnv {
+	const (
+		defaultMaxAttempts           = 5
+		defaultInitialBackoffSeconds = 2
+	)
+	env.MaxAttempts = utils.FirstNonZero(env.MaxAttempts, defaultMaxAttempts)
+	env.InitialBackoffSeconds = utils.FirstNonZero(env.InitialBackoffSeconds, defaultInitialBackoffSeconds)
+	return env
+}
+
+type FireblocksEnv struct {
+	ApiUrl         string `env:"FIREBLOCKS_API_URL" validate:"omitempty,url"`
+	VaultAccountId string `env:"FIREBLOCKS_VAULT_ACCOUNT_ID"`
+	RskAssetId     string `env:"FIREBLOCKS_RSK_ASSET_ID"`
+	BtcAssetId     string `env:"FIREBLOCKS_BTC_ASSET_ID"`
+	// Only if secret source is aws & wallet is fireblocks
+	ApiKeySecret     string `env:"FIREBLOCKS_API_KEY_SECRET"`
+	PrivateKeySecret string `env:"FIREBLOCKS_PRIVATE_KEY_SECRET"`
+	// Only if secret source is env & wallet is fireblocks
+	ApiKey         string `env:"FIREBLOCKS_API_KEY"`
+	PrivateKeyFile string `env:"FIREBLOCKS_PRIVATE_KEY_FILE"`
+}
+
+// RemoteSignerEnv is only used if wallet is remote
+type RemoteSignerEnv struct {
+	Type       string `env:"REMOTE_SIGNER_TYPE"`
+	Url        string `env:"REMOTE_SIGNER_URL"`
+	Address    string `env:"REMOTE_SIGNER_ADDRESS"`
+	BtcAddress string `env:"REMOTE_SIGNER_BTC_ADDRESS"`
+}
+
+// VaultEnv is only used if secret source is vault
+type VaultEnv struct {
+	Address   string `env:"VAULT_ADDR" validate:"omitempty,url"`
+	Token     string `env:"VAULT_TOKEN"`
+	TokenFile string `env:"VAULT_TOKEN_FILE"`
+	Namespace string `env:"VAULT_NAMESPACE"`
+	Mount     string `env:"VAULT_KV_MOUNT"`
+	Path      string `env:"VAULT_SECRET_PATH"`
+}
+
+func (env *VaultEnv) FillWithDefaults() *VaultEnv {
+	const defaultMount = "secret"
+	env.Mount = utils.FirstNonZero(env.Mount, defaultMount)
+	return env
+}
+
+// SecretsFileEnv is only used if secret source is file
+type SecretsFileEnv struct {
+	Path    string `env:"SECRETS_FILE"`
+	KeyFile string `env:"SECRETS_FILE_KEY"`
+}
+
 type PegoutEnv struct {
 	DepositCacheStartBlock      uint64 `env:"PEGOUT_DEPOSIT_CACHE_START_BLOCK"`
 	BtcReleaseWatcherStartBlock uint64 `env:"BTC_RELEASE_WATCHER_START_BLOCK"`
 	BtcReleaseWatcherPageSize   uint64 `env:"BTC_RELEASE_WATCHER_PAGE_SIZE"`
+	FeeBumpThreshold            uint64 `env:"PEGOUT_BTC_FEE_BUMP_THRESHOLD" validate:"lte=100"`
+	FeeBumpMaxFee               uint64 `env:"PEGOUT_BTC_FEE_BUMP_MAX_FEE" validate:"required_with=FeeBumpThreshold"`
+	BatchPayouts                bool   `env:"PEGOUT_BATCH_PAYOUTS"`
 }
 
 type CaptchaEnv struct {
@@ -178,6 +293,111 @@ type CaptchaEnv struct {
 	Url       string  `env:"CAPTCHA_URL"`
 }
 
+// RateLimitEnv configures the limits of the public API. The limits are applied per client IP and, in
+// the quote endpoints, also per RSK refund address
+type RateLimitEnv struct {
+	Disabled          bool   `env:"DISABLE_RATE_LIMIT"`
+	Store             string `env:"RATE_LIMIT_STORE" validate:"omitempty,oneof=memory mongo"`
+	TrustForwardedFor bool   `env:"RATE_LIMIT_TRUST_FORWARDED_FOR"`
+	QuotePerMinute    uint64 `env:"RATE_LIMIT_QUOTE_PER_MINUTE"`
+	QuoteBurst        uint64 `env:"RATE_LIMIT_QUOTE_BURST"`
+	AcceptPerMinute   uint64 `env:"RATE_LIMIT_ACCEPT_PER_MINUTE"`
+	AcceptBurst       uint64 `env:"RATE_LIMIT_ACCEPT_BURST"`
+	DefaultPerMinute  uint64 `env:"RATE_LIMIT_DEFAULT_PER_MINUTE"`
+	DefaultBurst      uint64 `env:"RATE_LIMIT_DEFAULT_BURST"`
+	StreamsPerIp      uint64 `env:"RATE_LIMIT_STREAMS_PER_IP"`
+	MaxStreams        uint64 `env:"RATE_LIMIT_MAX_STREAMS"`
+}
+
+func (env *RateLimitEnv) FillWithDefaults() *RateLimitEnv {
+	defaults := RateLimitEnv{
+		QuotePerMinute:   30,
+		QuoteBurst:       10,
+		AcceptPerMinute:  10,
+		AcceptBurst:      5,
+		DefaultPerMinute: 120,
+		DefaultBurst:     60,
+		StreamsPerIp:     5,
+		MaxStreams:       1000,
+	}
+	env.QuotePerMinute = utils.FirstNonZero(env.QuotePerMinute, defaults.QuotePerMinute)
+	env.QuoteBurst = utils.FirstNonZero(env.QuoteBurst, defaults.QuoteBurst)
+	env.AcceptPerMinute = utils.FirstNonZero(env.AcceptPerMinute, defaults.AcceptPerMinute)
+	env.AcceptBurst = utils.FirstNonZero(env.AcceptBurst, defaults.AcceptBurst)
+	env.DefaultPerMinute = utils.FirstNonZero(env.DefaultPerMinute, defaults.DefaultPerMinute)
+	env.DefaultBurst = utils.FirstNonZero(env.DefaultBurst, defaults.DefaultBurst)
+	env.StreamsPerIp = utils.FirstNonZero(env.StreamsPerIp, defaults.StreamsPerIp)
+	env.MaxStreams = utils.FirstNonZero(env.MaxStreams, defaults.MaxStreams)
+	return env
+}
+
+type GasPriceEnv struct {
+	Strategy         string `env:"RSK_GAS_PRICE_STRATEGY" validate:"omitempty,oneof=node fixed percentile"`
+	Fixed            uint64 `env:"RSK_GAS_PRICE_FIXED" validate:"required_if=Strategy fixed"`
+	Percentile       uint64 `env:"RSK_GAS_PRICE_PERCENTILE" validate:"lte=100"`
+	Blocks           uint64 `env:"RSK_GAS_PRICE_BLOCKS"`
+	MarginPercentage uint64 `env:"RSK_GAS_PRICE_MARGIN_PERCENTAGE"`
+	Min              uint64 `env:"RSK_GAS_PRICE_MIN"`
+	Max              uint64 `env:"RSK_GAS_PRICE_MAX" validate:"omitempty,gtefield=Min"`
+}
+
+func (env *GasPriceEnv) FillWithDefaults() *GasPriceEnv {
+	const (
+		defaultPercentile = 60
+		defaultBlocks     = 20
+	)
+	env.Percentile = utils.FirstNonZero(env.Percentile, defaultPercentile)
+	env.Blocks = utils.FirstNonZero(env.Blocks, defaultBlocks)
+	return env
+}
+
+// ReportSnapshotEnv configures the scheduled generation of the financial reports snapshots. The snapshots are always
+// stored, the alert and the webhook are optional delivery channels
+type ReportSnapshotEnv struct {
+	Enabled       bool     `env:"REPORT_SNAPSHOTS_ENABLED"`
+	Schedules     []string `env:"REPORT_SNAPSHOT_SCHEDULES" validate:"dive,oneof=daily weekly"`
+	SendAlert     bool     `env:"REPORT_SNAPSHOT_SEND_ALERT"`
+	WebhookUrl    string   `env:"REPORT_SNAPSHOT_WEBHOOK_URL" validate:"omitempty,url"`
+	WebhookSecret string   `env:"REPORT_SNAPSHOT_WEBHOOK_SECRET"`
+}
+
+func (env *ReportSnapshotEnv) FillWithDefaults() *ReportSnapshotEnv {
+	if len(env.Schedules) == 0 {
+		env.Schedules = []string{string(reporting.ScheduleDaily), string(reporting.ScheduleWeekly)}
+	}
+	return env
+}
+
+func (env *ReportSnapshotEnv) ScheduleList() []reporting.Schedule {
+	schedules := make([]reporting.Schedule, 0, len(env.Schedules))
+	for _, schedule := range env.Schedules {
+		schedules = append(schedules, reporting.Schedule(schedule))
+	}
+	return schedules
+}
+
+// AssetDriftEnv configures the detection of drops of the LP assets that are not explained by the revenue of the quotes.
+// The tolerance is in wei
+type AssetDriftEnv struct {
+	Enabled     bool   `env:"ASSET_DRIFT_CHECK_ENABLED"`
+	WindowHours uint64 `env:"ASSET_DRIFT_WINDOW_HOURS"`
+	// Tolerance is a pointer so zero can be used to alert on any unexplained drop
+	Tolerance *uint64 `env:"ASSET_DRIFT_TOLERANCE"`
+}
+
+func (env *AssetDriftEnv) FillWithDefaults() *AssetDriftEnv {
+	const (
+		defaultWindowHours        = 24
+		defaultTolerance   uint64 = 1_000_000_000_000_000 // 0.001 BTC
+	)
+	env.WindowHours = utils.FirstNonZero(env.WindowHours, defaultWindowHours)
+	if env.Tolerance == nil {
+		tolerance := defaultTolerance
+		env.Tolerance = &tolerance
+	}
+	return env
+}
+
 type ManagementEnv struct {
 	EnableManagementApi   bool   `env:"ENABLE_MANAGEMENT_API"`
 	SessionAuthKey        string `env:"MANAGEMENT_AUTH_KEY"`
@@ -69,6 +69,11 @@ func setEnvValue(field reflect.Value, envVar string) error {
 		return parseSlice(envVar, field)
 	case reflect.Map:
 		return parseMap(envVar, field)
+	case reflect.Ptr:
+		if _, ok := reflect.New(field.Type().Elem()).Interface().(json.Unmarshaler); ok {
+			return jsonUnmarshalEnvValue(field, envVar)
+		}
+		return parsePointer(envVar, field)
 	default:
 		return jsonUnmarshalEnvValue(field, envVar)
 	}
@@ -121,6 +126,21 @@ func parseBool(envVar string, field reflect.Value) error {
 	return nil
 }
 
+// parsePointer leaves the field as nil when the variable is empty, so an explicit zero value can be
+// distinguished from a missing one
+func parsePointer(envVar string, field reflect.Value) error {
+	if envVar == "" {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+	pointer := reflect.New(field.Type().Elem())
+	if err := setEnvValue(pointer.Elem(), envVar); err != nil {
+		return err
+	}
+	field.Set(pointer)
+	return nil
+}
+
 func parseSlice(envVar string, field reflect.Value) error {
 	elemType := field.Type().Elem()
 	if elemType.Kind() != reflect.String && elemType.Kind() != reflect.Struct {
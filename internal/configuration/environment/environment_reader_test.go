@@ -14,6 +14,7 @@ func setUpEnv(t *testing.T) {
 	// this map is to define the value for the vars that intentionally have a zero value in the sample-config.env file
 	var sampleZeroVars = map[string]string{
 		"ENABLE_SECURITY_HEADERS":              "true",
+		"PEGOUT_BATCH_PAYOUTS":                 "true",
 		"MANAGEMENT_USE_HTTPS":                 "true",
 		"ENABLE_MANAGEMENT_API":                "true",
 		"LBC_ADDR":                             "0x1234",
@@ -22,6 +23,8 @@ func setUpEnv(t *testing.T) {
 		"CAPTCHA_SITE_KEY":                     "site",
 		"PEGOUT_DEPOSIT_CACHE_START_BLOCK":     "1",
 		"RSK_EXTRA_SOURCES":                    "test1,test2",
+		"RSK_GAS_PRICE_MIN":                    "59000000",
+		"RSK_GAS_PRICE_MAX":                    "1000000000",
 		"BTC_EXTRA_SOURCES":                    `[{"format": "rpc", "url": "test3.com"}, {"format": "mempool", "url": "test4.com"}]`,
 		"ECLIPSE_RSK_TOLERANCE_THRESHOLD":      "5",
 		"ECLIPSE_RSK_MAX_MS_WAIT_FOR_BLOCK":    "1000",
@@ -34,6 +37,20 @@ func setUpEnv(t *testing.T) {
 		"BTC_RELEASE_WATCHER_START_BLOCK":      "1",
 		"USE_SEGWIT_FEDERATION":                "true",
 		"ALLOWED_ORIGINS":                      "http://example.com,http://example2.com",
+		"ALERT_ROUTES":                         `[{"subject": "LPS has been penalized", "severity": "critical", "channels": ["log", "slack"]}]`,
+		"ALERT_WEBHOOK_URL":                    "http://webhook.com",
+		"ALERT_SLACK_WEBHOOK_URL":              "http://slack.com",
+		"ALERT_PAGERDUTY_ROUTING_KEY":          "key",
+		"ALERT_PAGERDUTY_URL":                  "http://pagerduty.com",
+		"ALERT_DEDUP_WINDOW_SECONDS":           "60",
+		"DISABLE_RATE_LIMIT":                   "true",
+		"RATE_LIMIT_TRUST_FORWARDED_FOR":       "true",
+		"REPORT_SNAPSHOTS_ENABLED":             "true",
+		"REPORT_SNAPSHOT_SEND_ALERT":           "true",
+		"REPORT_SNAPSHOT_WEBHOOK_URL":          "http://snapshots.com",
+		"REPORT_SNAPSHOT_WEBHOOK_SECRET":       "secret",
+		"ASSET_DRIFT_CHECK_ENABLED":            "true",
+		"SECRETS_RELOAD_INTERVAL":              "300",
 	}
 	const envFilePath = "../../../sample-config.env"
 	envFile, err := os.ReadFile(envFilePath)
@@ -68,6 +85,19 @@ func TestLoad(t *testing.T) {
 		require.NoError(t, err)
 		assert.False(t, env.Management.EnableSecurityHeaders)
 	})
+	t.Run("distinguishes an empty pointer value from zero", func(t *testing.T) {
+		setUpEnv(t)
+		t.Setenv("ALERT_DEDUP_WINDOW_SECONDS", "")
+		env := &environment.Environment{}
+		require.NoError(t, environment.Load(env))
+		assert.Nil(t, env.Alerting.DedupWindowSeconds)
+
+		t.Setenv("ALERT_DEDUP_WINDOW_SECONDS", "0")
+		env = &environment.Environment{}
+		require.NoError(t, environment.Load(env))
+		require.NotNil(t, env.Alerting.DedupWindowSeconds)
+		assert.Equal(t, uint64(0), *env.Alerting.DedupWindowSeconds)
+	})
 	t.Run("does not fail when a slice is an empty string", func(t *testing.T) {
 		setUpEnv(t)
 		t.Setenv("RSK_EXTRA_SOURCES", "")
@@ -2,7 +2,9 @@ package environment_test
 
 import (
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/go-playground/validator/v10"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
 	"github.com/rsksmart/liquidity-provider-server/test"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -74,3 +76,73 @@ func TestEclipseEnv_ToConfig(t *testing.T) {
 	require.Equal(t, uint64(10000), config.BtcWaitPollingMsInterval)
 	test.AssertNonZeroValues(t, config)
 }
+
+func TestWebhookEnv_FillWithDefaults(t *testing.T) {
+	t.Run("should fill empty values with defaults", func(t *testing.T) {
+		defaults := (&environment.WebhookEnv{}).FillWithDefaults()
+		require.Equal(t, uint64(5), defaults.MaxAttempts)
+		require.Equal(t, uint64(2), defaults.InitialBackoffSeconds)
+	})
+	t.Run("should keep provided values", func(t *testing.T) {
+		env := (&environment.WebhookEnv{MaxAttempts: 3, InitialBackoffSeconds: 10}).FillWithDefaults()
+		require.Equal(t, uint64(3), env.MaxAttempts)
+		require.Equal(t, uint64(10), env.InitialBackoffSeconds)
+	})
+}
+
+func TestRateLimitEnv_FillWithDefaults(t *testing.T) {
+	t.Run("should fill empty values with defaults", func(t *testing.T) {
+		defaults := (&environment.RateLimitEnv{}).FillWithDefaults()
+		require.Equal(t, uint64(30), defaults.QuotePerMinute)
+		require.Equal(t, uint64(10), defaults.QuoteBurst)
+		require.Equal(t, uint64(10), defaults.AcceptPerMinute)
+		require.Equal(t, uint64(5), defaults.AcceptBurst)
+		require.Equal(t, uint64(120), defaults.DefaultPerMinute)
+		require.Equal(t, uint64(60), defaults.DefaultBurst)
+	})
+	t.Run("should keep provided values", func(t *testing.T) {
+		env := (&environment.RateLimitEnv{QuotePerMinute: 1, AcceptBurst: 2}).FillWithDefaults()
+		require.Equal(t, uint64(1), env.QuotePerMinute)
+		require.Equal(t, uint64(2), env.AcceptBurst)
+	})
+}
+
+func TestReportSnapshotEnv_FillWithDefaults(t *testing.T) {
+	t.Run("should generate all the schedules by default", func(t *testing.T) {
+		env := (&environment.ReportSnapshotEnv{}).FillWithDefaults()
+		require.Equal(t, []reporting.Schedule{reporting.ScheduleDaily, reporting.ScheduleWeekly}, env.ScheduleList())
+	})
+	t.Run("should keep provided schedules", func(t *testing.T) {
+		env := (&environment.ReportSnapshotEnv{Schedules: []string{"weekly"}}).FillWithDefaults()
+		require.Equal(t, []reporting.Schedule{reporting.ScheduleWeekly}, env.ScheduleList())
+	})
+}
+
+func TestAssetDriftEnv_FillWithDefaults(t *testing.T) {
+	t.Run("should use a day window and a 0.001 BTC tolerance by default", func(t *testing.T) {
+		env := (&environment.AssetDriftEnv{}).FillWithDefaults()
+		require.Equal(t, uint64(24), env.WindowHours)
+		require.NotNil(t, env.Tolerance)
+		require.Equal(t, uint64(1000000000000000), *env.Tolerance)
+	})
+	t.Run("should keep provided values", func(t *testing.T) {
+		tolerance := uint64(500)
+		env := (&environment.AssetDriftEnv{WindowHours: 6, Tolerance: &tolerance}).FillWithDefaults()
+		require.Equal(t, uint64(6), env.WindowHours)
+		require.Equal(t, uint64(500), *env.Tolerance)
+	})
+	t.Run("should keep a zero tolerance", func(t *testing.T) {
+		tolerance := uint64(0)
+		env := (&environment.AssetDriftEnv{Tolerance: &tolerance}).FillWithDefaults()
+		require.Equal(t, uint64(0), *env.Tolerance)
+	})
+}
+
+func TestGasPriceEnv_Validation(t *testing.T) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	require.NoError(t, validate.Struct(environment.GasPriceEnv{}))
+	require.NoError(t, validate.Struct(environment.GasPriceEnv{Min: 59000000}))
+	require.NoError(t, validate.Struct(environment.GasPriceEnv{Max: 1000000000}))
+	require.NoError(t, validate.Struct(environment.GasPriceEnv{Min: 59000000, Max: 59000000}))
+	require.ErrorContains(t, validate.Struct(environment.GasPriceEnv{Min: 1000000000, Max: 59000000}), "'Max' failed on the 'gtefield' tag")
+}
@@ -50,6 +50,28 @@ func (loader *AwsSecretsLoader) LoadDerivativeSecrets(ctx context.Context) (Deri
 }
 
 func (loader *AwsSecretsLoader) LoadFireBlocksSecrets(ctx context.Context) (FireBlocksWalletSecrets, error) {
-	// TODO complete with fireblocks integration
-	panic("feature unavailable")
+	if loader.env.Fireblocks.ApiKeySecret == "" || loader.env.Fireblocks.PrivateKeySecret == "" {
+		return FireBlocksWalletSecrets{}, errors.New("missing fireblocks api key or private key secret")
+	}
+	apiKeyInput := &secretsmanager.GetSecretValueInput{SecretId: &loader.env.Fireblocks.ApiKeySecret}
+	apiKey, err := loader.secretsManager.GetSecretValue(ctx, apiKeyInput)
+	if err != nil {
+		return FireBlocksWalletSecrets{}, fmt.Errorf("error loading fireblocks api key: %w", err)
+	}
+
+	privateKeyInput := &secretsmanager.GetSecretValueInput{SecretId: &loader.env.Fireblocks.PrivateKeySecret}
+	privateKey, err := loader.secretsManager.GetSecretValue(ctx, privateKeyInput)
+	if err != nil {
+		return FireBlocksWalletSecrets{}, fmt.Errorf("error loading fireblocks private key: %w", err)
+	}
+
+	return FireBlocksWalletSecrets{
+		ApiKey:     *apiKey.SecretString,
+		PrivateKey: *privateKey.SecretString,
+	}, nil
+}
+
+// LoadManagementSecrets returns the management keys from the environment, they are not stored in AWS
+func (loader *AwsSecretsLoader) LoadManagementSecrets(ctx context.Context) (ManagementSecrets, error) {
+	return managementSecretsFromEnv(loader.env.Management), nil
 }
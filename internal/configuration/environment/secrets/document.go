@@ -0,0 +1,75 @@
+package secrets
+
+import (
+	"fmt"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+)
+
+// Keys of the secrets inside a secret document
+const (
+	KeystoreKey               = "keystore"
+	KeystorePasswordKey       = "keystore_password"
+	FireblocksApiKey          = "fireblocks_api_key"
+	FireblocksPrivateKey      = "fireblocks_private_key"
+	ManagementAuthKey         = "management_auth_key"
+	ManagementEncryptionKey   = "management_encryption_key"
+	ManagementTokenAuthKey    = "management_token_auth_key"
+	secretDocumentMissingText = "missing %s in secret document"
+)
+
+// secretDocument is a set of secrets stored together as key-value pairs. This is how the secrets are stored
+// in the sources that keep all the LPS secrets in a single place, like the vault and the encrypted file sources
+type secretDocument map[string]string
+
+func (document secretDocument) derivativeSecrets() (DerivativeWalletSecrets, error) {
+	if err := document.requireKeys(KeystoreKey, KeystorePasswordKey); err != nil {
+		return DerivativeWalletSecrets{}, err
+	}
+	return DerivativeWalletSecrets{
+		EncryptedJson:         document[KeystoreKey],
+		EncryptedJsonPassword: document[KeystorePasswordKey],
+	}, nil
+}
+
+func (document secretDocument) fireBlocksSecrets() (FireBlocksWalletSecrets, error) {
+	if err := document.requireKeys(FireblocksApiKey, FireblocksPrivateKey); err != nil {
+		return FireBlocksWalletSecrets{}, err
+	}
+	return FireBlocksWalletSecrets{
+		ApiKey:     document[FireblocksApiKey],
+		PrivateKey: document[FireblocksPrivateKey],
+	}, nil
+}
+
+// managementSecrets returns the management session keys of the document. The keys that are not present
+// in the document are taken from the environment, so storing them in the document is optional
+func (document secretDocument) managementSecrets(env environment.ManagementEnv) ManagementSecrets {
+	secrets := managementSecretsFromEnv(env)
+	if value, ok := document[ManagementAuthKey]; ok {
+		secrets.SessionAuthKey = value
+	}
+	if value, ok := document[ManagementEncryptionKey]; ok {
+		secrets.SessionEncryptionKey = value
+	}
+	if value, ok := document[ManagementTokenAuthKey]; ok {
+		secrets.SessionTokenAuthKey = value
+	}
+	return secrets
+}
+
+func (document secretDocument) requireKeys(keys ...string) error {
+	for _, key := range keys {
+		if document[key] == "" {
+			return fmt.Errorf(secretDocumentMissingText, key)
+		}
+	}
+	return nil
+}
+
+func managementSecretsFromEnv(env environment.ManagementEnv) ManagementSecrets {
+	return ManagementSecrets{
+		SessionAuthKey:       env.SessionAuthKey,
+		SessionEncryptionKey: env.SessionEncryptionKey,
+		SessionTokenAuthKey:  env.SessionTokenAuthKey,
+	}
+}
@@ -46,6 +46,32 @@ func (loader *EnvSecretsLoader) LoadDerivativeSecrets(ctx context.Context) (Deri
 }
 
 func (loader *EnvSecretsLoader) LoadFireBlocksSecrets(ctx context.Context) (FireBlocksWalletSecrets, error) {
-	// TODO complete with fireblocks integration
-	panic("feature unavailable")
+	if loader.env.Fireblocks.ApiKey == "" || loader.env.Fireblocks.PrivateKeyFile == "" {
+		return FireBlocksWalletSecrets{}, errors.New("missing fireblocks api key or private key file")
+	}
+
+	privateKeyFile, err := os.Open(loader.env.Fireblocks.PrivateKeyFile)
+	if err != nil {
+		return FireBlocksWalletSecrets{}, fmt.Errorf("error opening fireblocks private key file: %w", err)
+	}
+
+	defer func(file *os.File) {
+		if closingErr := file.Close(); closingErr != nil {
+			log.Error("Error closing fireblocks private key file:", closingErr)
+		}
+	}(privateKeyFile)
+
+	privateKeyBytes, err := io.ReadAll(privateKeyFile)
+	if err != nil {
+		return FireBlocksWalletSecrets{}, fmt.Errorf("error reading fireblocks private key file: %w", err)
+	}
+
+	return FireBlocksWalletSecrets{
+		ApiKey:     loader.env.Fireblocks.ApiKey,
+		PrivateKey: string(privateKeyBytes),
+	}, nil
+}
+
+func (loader *EnvSecretsLoader) LoadManagementSecrets(ctx context.Context) (ManagementSecrets, error) {
+	return managementSecretsFromEnv(loader.env.Management), nil
 }
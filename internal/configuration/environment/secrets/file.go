@@ -0,0 +1,168 @@
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+const (
+	SecretsFileKeyLength = 32
+	gcmTagLength         = 16
+	gcmNonceLength       = 12
+)
+
+var encryptedValueRegex = regexp.MustCompile(`^ENC\[AES256_GCM,data:([A-Za-z0-9+/=]*),iv:([A-Za-z0-9+/=]+),tag:([A-Za-z0-9+/=]+),type:str]$`)
+
+// FileSecretsLoader reads the LPS secrets from a local JSON file where every value is encrypted with AES-256-GCM
+// and stored as ENC[AES256_GCM,data:...,iv:...,tag:...,type:str]. The file is not a sops file, it can only be
+// created with the encrypt_secrets utility. The key of each value
+// is used as additional data, so encrypted values can't be moved between keys. Both the file and the key file
+// are read on every load, so replacing the file is enough to rotate the secrets
+type FileSecretsLoader struct {
+	env environment.Environment
+}
+
+func NewFileSecretsLoader(env environment.Environment) (SecretLoader, error) {
+	if env.SecretsFile.Path == "" || env.SecretsFile.KeyFile == "" {
+		return nil, errors.New("missing secrets file or secrets file key")
+	}
+	return &FileSecretsLoader{env: env}, nil
+}
+
+func (loader *FileSecretsLoader) LoadDerivativeSecrets(ctx context.Context) (DerivativeWalletSecrets, error) {
+	document, err := loader.readDocument()
+	if err != nil {
+		return DerivativeWalletSecrets{}, err
+	}
+	return document.derivativeSecrets()
+}
+
+func (loader *FileSecretsLoader) LoadFireBlocksSecrets(ctx context.Context) (FireBlocksWalletSecrets, error) {
+	document, err := loader.readDocument()
+	if err != nil {
+		return FireBlocksWalletSecrets{}, err
+	}
+	return document.fireBlocksSecrets()
+}
+
+func (loader *FileSecretsLoader) LoadManagementSecrets(ctx context.Context) (ManagementSecrets, error) {
+	document, err := loader.readDocument()
+	if err != nil {
+		return ManagementSecrets{}, err
+	}
+	return document.managementSecrets(loader.env.Management), nil
+}
+
+func (loader *FileSecretsLoader) readDocument() (secretDocument, error) {
+	keyBytes, err := readSecretFile(loader.env.SecretsFile.KeyFile, "secrets file key")
+	if err != nil {
+		return nil, err
+	}
+	key, err := utils.DecodeKey(strings.TrimSpace(string(keyBytes)), SecretsFileKeyLength)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secrets file key: %w", err)
+	}
+	content, err := readSecretFile(loader.env.SecretsFile.Path, "secrets file")
+	if err != nil {
+		return nil, err
+	}
+	return DecryptSecretsFile(content, key)
+}
+
+// EncryptSecretsFile returns the content of an encrypted secrets file with the provided secrets
+func EncryptSecretsFile(secrets map[string]string, key []byte) ([]byte, error) {
+	aead, err := newSecretsFileCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	encrypted := make(map[string]string, len(secrets))
+	for name, value := range secrets {
+		nonce, nonceErr := utils.GetRandomBytes(gcmNonceLength)
+		if nonceErr != nil {
+			return nil, nonceErr
+		}
+		sealed := aead.Seal(nil, nonce, []byte(value), []byte(name))
+		data, tag := sealed[:len(sealed)-gcmTagLength], sealed[len(sealed)-gcmTagLength:]
+		encrypted[name] = fmt.Sprintf("ENC[AES256_GCM,data:%s,iv:%s,tag:%s,type:str]",
+			base64.StdEncoding.EncodeToString(data),
+			base64.StdEncoding.EncodeToString(nonce),
+			base64.StdEncoding.EncodeToString(tag),
+		)
+	}
+	return json.MarshalIndent(encrypted, "", "  ")
+}
+
+// DecryptSecretsFile returns the secrets of the content of an encrypted secrets file. Every value must be encrypted
+func DecryptSecretsFile(content []byte, key []byte) (map[string]string, error) {
+	encrypted := make(map[string]string)
+	if err := json.Unmarshal(content, &encrypted); err != nil {
+		return nil, fmt.Errorf("error parsing secrets file: %w", err)
+	}
+	aead, err := newSecretsFileCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	secrets := make(map[string]string, len(encrypted))
+	for name, value := range encrypted {
+		parts := encryptedValueRegex.FindStringSubmatch(value)
+		if parts == nil {
+			return nil, fmt.Errorf("value of %s is not encrypted", name)
+		}
+		var data, nonce, tag []byte
+		if data, err = base64.StdEncoding.DecodeString(parts[1]); err != nil {
+			return nil, fmt.Errorf("invalid data of %s: %w", name, err)
+		} else if nonce, err = base64.StdEncoding.DecodeString(parts[2]); err != nil || len(nonce) != gcmNonceLength {
+			return nil, fmt.Errorf("invalid iv of %s", name)
+		} else if tag, err = base64.StdEncoding.DecodeString(parts[3]); err != nil || len(tag) != gcmTagLength {
+			return nil, fmt.Errorf("invalid tag of %s", name)
+		}
+		plain, openErr := aead.Open(nil, nonce, append(data, tag...), []byte(name))
+		if openErr != nil {
+			return nil, fmt.Errorf("error decrypting %s: %w", name, openErr)
+		}
+		secrets[name] = string(plain)
+	}
+	return secrets, nil
+}
+
+func newSecretsFileCipher(key []byte) (cipher.AEAD, error) {
+	if len(key) != SecretsFileKeyLength {
+		return nil, fmt.Errorf("secrets file key must be %d bytes long", SecretsFileKeyLength)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func readSecretFile(path string, name string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening %s: %w", name, err)
+	}
+
+	defer func(file *os.File) {
+		if closingErr := file.Close(); closingErr != nil {
+			log.Errorf("Error closing %s: %v", name, closingErr)
+		}
+	}(file)
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", name, err)
+	}
+	return content, nil
+}
@@ -0,0 +1,112 @@
+package secrets_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecretsFileKey = []byte("0123456789abcdef0123456789abcdef")
+
+func writeSecretsFile(t *testing.T, plainSecrets map[string]string) environment.SecretsFileEnv {
+	dir := t.TempDir()
+	content, err := secrets.EncryptSecretsFile(plainSecrets, testSecretsFileKey)
+	require.NoError(t, err)
+	fileEnv := environment.SecretsFileEnv{Path: filepath.Join(dir, "secrets.enc.json"), KeyFile: filepath.Join(dir, "secrets.key")}
+	require.NoError(t, os.WriteFile(fileEnv.Path, content, 0600))
+	require.NoError(t, os.WriteFile(fileEnv.KeyFile, []byte(hex.EncodeToString(testSecretsFileKey)+"\n"), 0600))
+	return fileEnv
+}
+
+func TestEncryptSecretsFile(t *testing.T) {
+	plainSecrets := map[string]string{secrets.KeystoreKey: `{"version":3}`, secrets.KeystorePasswordKey: "password", "empty": ""}
+	content, err := secrets.EncryptSecretsFile(plainSecrets, testSecretsFileKey)
+	require.NoError(t, err)
+	encrypted := make(map[string]string)
+	require.NoError(t, json.Unmarshal(content, &encrypted))
+	for name, value := range encrypted {
+		assert.Regexp(t, `^ENC\[AES256_GCM,data:.*,iv:.+,tag:.+,type:str]$`, value)
+		if plainSecrets[name] != "" {
+			assert.NotContains(t, value, plainSecrets[name])
+		}
+	}
+
+	t.Run("should decrypt the file", func(t *testing.T) {
+		decrypted, decryptErr := secrets.DecryptSecretsFile(content, testSecretsFileKey)
+		require.NoError(t, decryptErr)
+		assert.Equal(t, plainSecrets, decrypted)
+	})
+	t.Run("should not decrypt with other key", func(t *testing.T) {
+		_, decryptErr := secrets.DecryptSecretsFile(content, []byte("fedcba9876543210fedcba9876543210"))
+		require.ErrorContains(t, decryptErr, "error decrypting")
+	})
+	t.Run("should not decrypt values moved to other key", func(t *testing.T) {
+		swapped, marshalErr := json.Marshal(map[string]string{secrets.KeystorePasswordKey: encrypted[secrets.KeystoreKey]})
+		require.NoError(t, marshalErr)
+		_, decryptErr := secrets.DecryptSecretsFile(swapped, testSecretsFileKey)
+		require.ErrorContains(t, decryptErr, "error decrypting keystore_password")
+	})
+	t.Run("should reject values that are not encrypted", func(t *testing.T) {
+		_, decryptErr := secrets.DecryptSecretsFile([]byte(`{"keystore_password":"password"}`), testSecretsFileKey)
+		require.ErrorContains(t, decryptErr, "value of keystore_password is not encrypted")
+	})
+	t.Run("should validate the key length", func(t *testing.T) {
+		_, encryptErr := secrets.EncryptSecretsFile(plainSecrets, []byte{1, 2, 3})
+		require.ErrorContains(t, encryptErr, "secrets file key must be 32 bytes long")
+	})
+}
+
+func TestFileSecretsLoader(t *testing.T) {
+	fileEnv := writeSecretsFile(t, map[string]string{
+		secrets.KeystoreKey:             `{"version":3}`,
+		secrets.KeystorePasswordKey:     "password",
+		secrets.FireblocksApiKey:        "api-key",
+		secrets.FireblocksPrivateKey:    "private-key",
+		secrets.ManagementEncryptionKey: "file-encryption",
+	})
+	loader, err := secrets.NewFileSecretsLoader(environment.Environment{
+		SecretsFile: fileEnv,
+		Management:  environment.ManagementEnv{SessionAuthKey: "env-auth", SessionEncryptionKey: "env-encryption", SessionTokenAuthKey: "env-token"},
+	})
+	require.NoError(t, err)
+
+	derivativeSecrets, err := loader.LoadDerivativeSecrets(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, secrets.DerivativeWalletSecrets{EncryptedJson: `{"version":3}`, EncryptedJsonPassword: "password"}, derivativeSecrets)
+	fireblocksSecrets, err := loader.LoadFireBlocksSecrets(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, secrets.FireBlocksWalletSecrets{ApiKey: "api-key", PrivateKey: "private-key"}, fireblocksSecrets)
+	managementSecrets, err := loader.LoadManagementSecrets(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, secrets.ManagementSecrets{SessionAuthKey: "env-auth", SessionEncryptionKey: "file-encryption", SessionTokenAuthKey: "env-token"}, managementSecrets)
+
+	t.Run("should read the new file after it is replaced", func(t *testing.T) {
+		content, encryptErr := secrets.EncryptSecretsFile(map[string]string{secrets.KeystoreKey: `{"version":3}`, secrets.KeystorePasswordKey: "rotated"}, testSecretsFileKey)
+		require.NoError(t, encryptErr)
+		require.NoError(t, os.WriteFile(fileEnv.Path, content, 0600))
+		result, loadErr := loader.LoadDerivativeSecrets(context.Background())
+		require.NoError(t, loadErr)
+		assert.Equal(t, "rotated", result.EncryptedJsonPassword)
+	})
+	t.Run("should return error if the key file is invalid", func(t *testing.T) {
+		invalidKeyLoader, loaderErr := secrets.NewFileSecretsLoader(environment.Environment{
+			SecretsFile: environment.SecretsFileEnv{Path: fileEnv.Path, KeyFile: fileEnv.Path},
+		})
+		require.NoError(t, loaderErr)
+		_, loadErr := invalidKeyLoader.LoadDerivativeSecrets(context.Background())
+		require.ErrorContains(t, loadErr, "invalid secrets file key")
+	})
+	t.Run("should validate required fields", func(t *testing.T) {
+		emptyLoader, loaderErr := secrets.NewFileSecretsLoader(environment.Environment{SecretsFile: environment.SecretsFileEnv{Path: fileEnv.Path}})
+		require.ErrorContains(t, loaderErr, "missing secrets file or secrets file key")
+		assert.Nil(t, emptyLoader)
+	})
+}
@@ -9,6 +9,7 @@ import (
 type SecretLoader interface {
 	LoadDerivativeSecrets(ctx context.Context) (DerivativeWalletSecrets, error)
 	LoadFireBlocksSecrets(ctx context.Context) (FireBlocksWalletSecrets, error)
+	LoadManagementSecrets(ctx context.Context) (ManagementSecrets, error)
 }
 
 type DerivativeWalletSecrets struct {
@@ -17,8 +18,16 @@ type DerivativeWalletSecrets struct {
 }
 
 type FireBlocksWalletSecrets struct {
-	// TODO complete with fireblocks integration
-	// TBD
+	ApiKey string
+	// PrivateKey is the PEM encoded RSA key used to sign the requests to the Fireblocks API
+	PrivateKey string
+}
+
+// ManagementSecrets are the hex encoded keys used to protect the management session
+type ManagementSecrets struct {
+	SessionAuthKey       string
+	SessionEncryptionKey string
+	SessionTokenAuthKey  string
 }
 
 func GetSecretLoader(ctx context.Context, environment environment.Environment) (SecretLoader, error) {
@@ -27,6 +36,10 @@ func GetSecretLoader(ctx context.Context, environment environment.Environment) (
 		return NewAwsSecretsLoader(ctx, environment)
 	case "env":
 		return NewEnvSecretsLoader(environment), nil
+	case "vault":
+		return NewVaultSecretsLoader(environment)
+	case "file":
+		return NewFileSecretsLoader(environment)
 	default:
 		return nil, errors.New("unknown secret source")
 	}
@@ -20,6 +20,22 @@ func TestGetSecretLoader(t *testing.T) {
 		require.NoError(t, err)
 		assert.IsType(t, &secrets.EnvSecretsLoader{}, loader)
 	})
+	t.Run("should create vault secret loader", func(t *testing.T) {
+		loader, err := secrets.GetSecretLoader(context.Background(), environment.Environment{
+			SecretSource: "vault",
+			Vault:        environment.VaultEnv{Address: "http://localhost:8200", Token: "token", Path: "lps"},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &secrets.VaultSecretsLoader{}, loader)
+	})
+	t.Run("should create file secret loader", func(t *testing.T) {
+		loader, err := secrets.GetSecretLoader(context.Background(), environment.Environment{
+			SecretSource: "file",
+			SecretsFile:  environment.SecretsFileEnv{Path: "secrets.json", KeyFile: "secrets.key"},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &secrets.FileSecretsLoader{}, loader)
+	})
 	t.Run("should return error for unknown secret source", func(t *testing.T) {
 		loader, err := secrets.GetSecretLoader(context.Background(), environment.Environment{SecretSource: "gcp"})
 		require.Error(t, err)
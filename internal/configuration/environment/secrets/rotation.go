@@ -0,0 +1,79 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+// RotationHandler applies the secrets obtained from the loader to a component that is already running. Handlers
+// are executed on every reload even if the secrets didn't change, so they should be idempotent
+type RotationHandler func(ctx context.Context, loader SecretLoader) error
+
+type namedRotationHandler struct {
+	name    string
+	handler RotationHandler
+}
+
+// Rotator periodically reloads the secrets from the secret source and passes them to the registered handlers,
+// this way the secrets can be rotated in the source without restarting the server
+type Rotator struct {
+	loader        SecretLoader
+	ticker        utils.Ticker
+	reloadTimeout time.Duration
+	handlers      []namedRotationHandler
+	stopChannel   chan bool
+}
+
+func NewRotator(loader SecretLoader, ticker utils.Ticker, reloadTimeout time.Duration) *Rotator {
+	return &Rotator{
+		loader:        loader,
+		ticker:        ticker,
+		reloadTimeout: reloadTimeout,
+		handlers:      make([]namedRotationHandler, 0),
+		stopChannel:   make(chan bool, 1),
+	}
+}
+
+// AddHandler registers a handler to be executed on every reload. Must not be called after Start
+func (rotator *Rotator) AddHandler(name string, handler RotationHandler) {
+	rotator.handlers = append(rotator.handlers, namedRotationHandler{name: name, handler: handler})
+}
+
+// Reload executes all the handlers. A failing handler doesn't prevent the execution of the rest of them
+func (rotator *Rotator) Reload(ctx context.Context) error {
+	var errs []error
+	for _, handler := range rotator.handlers {
+		if err := handler.handler(ctx, rotator.loader); err != nil {
+			errs = append(errs, fmt.Errorf("error rotating %s secrets: %w", handler.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (rotator *Rotator) Start() {
+rotatorLoop:
+	for {
+		select {
+		case <-rotator.ticker.C():
+			ctx, cancel := context.WithTimeout(context.Background(), rotator.reloadTimeout)
+			if err := rotator.Reload(ctx); err != nil {
+				log.Error("Error reloading secrets: ", err)
+			}
+			cancel()
+		case <-rotator.stopChannel:
+			rotator.ticker.Stop()
+			close(rotator.stopChannel)
+			break rotatorLoop
+		}
+	}
+}
+
+func (rotator *Rotator) Shutdown(closeChannel chan<- bool) {
+	rotator.stopChannel <- true
+	closeChannel <- true
+	log.Debug("Secrets rotator shut down")
+}
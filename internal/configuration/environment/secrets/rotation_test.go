@@ -0,0 +1,64 @@
+package secrets_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment/secrets"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotator_Reload(t *testing.T) {
+	loader := &mocks.SecretLoaderMock{}
+	rotator := secrets.NewRotator(loader, &mocks.TickerMock{}, time.Second)
+	executed := make([]string, 0)
+	rotator.AddHandler("failing", func(ctx context.Context, l secrets.SecretLoader) error {
+		assert.Same(t, loader, l)
+		executed = append(executed, "failing")
+		return assert.AnError
+	})
+	rotator.AddHandler("working", func(ctx context.Context, l secrets.SecretLoader) error {
+		executed = append(executed, "working")
+		return nil
+	})
+	err := rotator.Reload(context.Background())
+	require.ErrorIs(t, err, assert.AnError)
+	require.ErrorContains(t, err, "error rotating failing secrets")
+	assert.Equal(t, []string{"failing", "working"}, executed)
+}
+
+func TestRotator_Start(t *testing.T) {
+	tickerChannel := make(chan time.Time)
+	ticker := &mocks.TickerMock{}
+	ticker.EXPECT().C().Return(tickerChannel)
+	ticker.EXPECT().Stop().Return()
+	loader := &mocks.SecretLoaderMock{}
+	loader.On("LoadManagementSecrets", mock.Anything).Return(secrets.ManagementSecrets{SessionAuthKey: "key"}, nil).Once()
+	rotator := secrets.NewRotator(loader, ticker, time.Second)
+	reloaded := make(chan string, 1)
+	rotator.AddHandler("management", func(ctx context.Context, l secrets.SecretLoader) error {
+		managementSecrets, err := l.LoadManagementSecrets(ctx)
+		reloaded <- managementSecrets.SessionAuthKey
+		return err
+	})
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		rotator.Start()
+		wg.Done()
+	}()
+	tickerChannel <- time.Now()
+	assert.Equal(t, "key", <-reloaded)
+	closeChannel := make(chan bool, 1)
+	rotator.Shutdown(closeChannel)
+	<-closeChannel
+	wg.Wait()
+	loader.AssertExpectations(t)
+	ticker.AssertExpectations(t)
+}
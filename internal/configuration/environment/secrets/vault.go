@@ -0,0 +1,143 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	vaultTokenHeader     = "X-Vault-Token"
+	vaultNamespaceHeader = "X-Vault-Namespace"
+	vaultHttpTimeout     = 10 * time.Second
+)
+
+// VaultSecretsLoader reads the LPS secrets from a single secret of a HashiCorp Vault KV version 2 secrets engine.
+// The secret is read on every load, so a new version of the secret is picked up without restarting the server
+type VaultSecretsLoader struct {
+	client *http.Client
+	env    environment.Environment
+}
+
+type vaultKvResponse struct {
+	Data struct {
+		Data map[string]any `json:"data"`
+	} `json:"data"`
+}
+
+type vaultErrorResponse struct {
+	Errors []string `json:"errors"`
+}
+
+func NewVaultSecretsLoader(env environment.Environment) (SecretLoader, error) {
+	if env.Vault.Address == "" || env.Vault.Path == "" {
+		return nil, errors.New("missing vault address or secret path")
+	} else if env.Vault.Token == "" && env.Vault.TokenFile == "" {
+		return nil, errors.New("missing vault token or token file")
+	}
+	env.Vault.FillWithDefaults()
+	return &VaultSecretsLoader{client: &http.Client{Timeout: vaultHttpTimeout}, env: env}, nil
+}
+
+func (loader *VaultSecretsLoader) LoadDerivativeSecrets(ctx context.Context) (DerivativeWalletSecrets, error) {
+	document, err := loader.readSecret(ctx)
+	if err != nil {
+		return DerivativeWalletSecrets{}, err
+	}
+	return document.derivativeSecrets()
+}
+
+func (loader *VaultSecretsLoader) LoadFireBlocksSecrets(ctx context.Context) (FireBlocksWalletSecrets, error) {
+	document, err := loader.readSecret(ctx)
+	if err != nil {
+		return FireBlocksWalletSecrets{}, err
+	}
+	return document.fireBlocksSecrets()
+}
+
+func (loader *VaultSecretsLoader) LoadManagementSecrets(ctx context.Context) (ManagementSecrets, error) {
+	document, err := loader.readSecret(ctx)
+	if err != nil {
+		return ManagementSecrets{}, err
+	}
+	return document.managementSecrets(loader.env.Management), nil
+}
+
+func (loader *VaultSecretsLoader) readSecret(ctx context.Context) (secretDocument, error) {
+	token, err := loader.token()
+	if err != nil {
+		return nil, err
+	}
+	secretUrl, err := url.JoinPath(loader.env.Vault.Address, "v1", loader.env.Vault.Mount, "data", loader.env.Vault.Path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vault secret url: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, secretUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(vaultTokenHeader, token)
+	if loader.env.Vault.Namespace != "" {
+		req.Header.Set(vaultNamespaceHeader, loader.env.Vault.Namespace)
+	}
+
+	res, err := loader.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error reading secret from vault: %w", err)
+	}
+	defer func() {
+		if closingErr := res.Body.Close(); closingErr != nil {
+			log.Error("Error closing vault response body: ", closingErr)
+		}
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		errorResponse := vaultErrorResponse{}
+		_ = json.NewDecoder(res.Body).Decode(&errorResponse)
+		return nil, fmt.Errorf("error reading secret from vault (status %d): %s", res.StatusCode, strings.Join(errorResponse.Errors, ", "))
+	}
+
+	response := vaultKvResponse{}
+	if err = json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("error decoding vault secret: %w", err)
+	}
+	return parseVaultData(response.Data.Data)
+}
+
+// token returns the token to authenticate against vault. If a token file is configured, it is read on every request
+// so the token can be renewed by an external process (like vault agent) while the server is running
+func (loader *VaultSecretsLoader) token() (string, error) {
+	if loader.env.Vault.TokenFile == "" {
+		return loader.env.Vault.Token, nil
+	}
+	tokenBytes, err := readSecretFile(loader.env.Vault.TokenFile, "vault token file")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(tokenBytes)), nil
+}
+
+// parseVaultData converts the values of the vault secret to strings. Values that are not strings (e.g. a keystore
+// stored as a JSON object) are converted to their JSON representation
+func parseVaultData(data map[string]any) (secretDocument, error) {
+	document := make(secretDocument, len(data))
+	for key, value := range data {
+		if stringValue, ok := value.(string); ok {
+			document[key] = stringValue
+			continue
+		}
+		jsonValue, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing vault secret %s: %w", key, err)
+		}
+		document[key] = string(jsonValue)
+	}
+	return document, nil
+}
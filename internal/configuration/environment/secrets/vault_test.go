@@ -0,0 +1,108 @@
+package secrets_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testVaultToken = "s.test-token"
+	testVaultPath  = "lps/testnet"
+)
+
+func newVaultServer(t *testing.T, data map[string]any) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("X-Vault-Token") != testVaultToken {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		} else if r.URL.Path != "/v1/secret/data/"+testVaultPath {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"data": data, "metadata": map[string]any{"version": 2}},
+		}))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newVaultLoader(t *testing.T, vaultEnv environment.VaultEnv) secrets.SecretLoader {
+	loader, err := secrets.NewVaultSecretsLoader(environment.Environment{
+		Vault:      vaultEnv,
+		Management: environment.ManagementEnv{SessionAuthKey: "env-auth", SessionEncryptionKey: "env-encryption", SessionTokenAuthKey: "env-token"},
+	})
+	require.NoError(t, err)
+	return loader
+}
+
+func TestNewVaultSecretsLoader(t *testing.T) {
+	t.Run("should validate required fields", func(t *testing.T) {
+		loader, err := secrets.NewVaultSecretsLoader(environment.Environment{Vault: environment.VaultEnv{Token: testVaultToken}})
+		require.ErrorContains(t, err, "missing vault address or secret path")
+		assert.Nil(t, loader)
+		loader, err = secrets.NewVaultSecretsLoader(environment.Environment{Vault: environment.VaultEnv{Address: "http://localhost:8200", Path: testVaultPath}})
+		require.ErrorContains(t, err, "missing vault token or token file")
+		assert.Nil(t, loader)
+	})
+}
+
+func TestVaultSecretsLoader_LoadDerivativeSecrets(t *testing.T) {
+	server := newVaultServer(t, map[string]any{
+		secrets.KeystoreKey:         map[string]any{"address": "9d93929a9099be4355fc2389fbf253982f9df47c"},
+		secrets.KeystorePasswordKey: "password",
+	})
+	t.Run("should read the secrets from the kv engine", func(t *testing.T) {
+		loader := newVaultLoader(t, environment.VaultEnv{Address: server.URL, Token: testVaultToken, Path: testVaultPath})
+		result, err := loader.LoadDerivativeSecrets(context.Background())
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"address":"9d93929a9099be4355fc2389fbf253982f9df47c"}`, result.EncryptedJson)
+		assert.Equal(t, "password", result.EncryptedJsonPassword)
+	})
+	t.Run("should read the token from the token file on every request", func(t *testing.T) {
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(tokenFile, []byte("expired\n"), 0600))
+		loader := newVaultLoader(t, environment.VaultEnv{Address: server.URL, TokenFile: tokenFile, Path: testVaultPath})
+		_, err := loader.LoadDerivativeSecrets(context.Background())
+		require.ErrorContains(t, err, "status 403): permission denied")
+		require.NoError(t, os.WriteFile(tokenFile, []byte(testVaultToken+"\n"), 0600))
+		result, err := loader.LoadDerivativeSecrets(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "password", result.EncryptedJsonPassword)
+	})
+	t.Run("should return error if the secret doesn't exist", func(t *testing.T) {
+		loader := newVaultLoader(t, environment.VaultEnv{Address: server.URL, Token: testVaultToken, Path: "other", Mount: "secret"})
+		_, err := loader.LoadDerivativeSecrets(context.Background())
+		require.ErrorContains(t, err, "status 404")
+	})
+	t.Run("should return error if a secret is missing", func(t *testing.T) {
+		loader := newVaultLoader(t, environment.VaultEnv{Address: server.URL, Token: testVaultToken, Path: testVaultPath})
+		_, err := loader.LoadFireBlocksSecrets(context.Background())
+		require.ErrorContains(t, err, "missing fireblocks_api_key in secret document")
+	})
+}
+
+func TestVaultSecretsLoader_LoadManagementSecrets(t *testing.T) {
+	server := newVaultServer(t, map[string]any{secrets.ManagementAuthKey: "vault-auth", secrets.ManagementTokenAuthKey: "vault-token"})
+	loader := newVaultLoader(t, environment.VaultEnv{Address: server.URL, Token: testVaultToken, Path: testVaultPath})
+	result, err := loader.LoadManagementSecrets(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, secrets.ManagementSecrets{
+		SessionAuthKey:       "vault-auth",
+		SessionEncryptionKey: "env-encryption",
+		SessionTokenAuthKey:  "vault-token",
+	}, result)
+}
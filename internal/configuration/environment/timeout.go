@@ -25,6 +25,9 @@ type ApplicationTimeouts struct {
 	ServerIdle          Timeout `validate:"required"`
 	PegoutDepositCheck  Timeout `validate:"required"`
 	BtcReleaseCheck     Timeout `validate:"required"`
+	WebhookNotification Timeout `validate:"required"`
+	FireblocksSigning   Timeout `validate:"required"`
+	RemoteSigning       Timeout `validate:"required"`
 }
 
 func DefaultTimeouts() ApplicationTimeouts {
@@ -40,6 +43,9 @@ func DefaultTimeouts() ApplicationTimeouts {
 		ServerIdle:          10,
 		PegoutDepositCheck:  60,
 		BtcReleaseCheck:     180,
+		WebhookNotification: 300,
+		FireblocksSigning:   120,
+		RemoteSigning:       30,
 	}
 }
 
@@ -57,6 +63,9 @@ func TimeoutsFromEnv(env TimeoutEnv) (ApplicationTimeouts, error) {
 	timeouts.ServerIdle = utils.FirstNonZero(Timeout(env.ServerIdle), defaultTimeouts.ServerIdle)
 	timeouts.PegoutDepositCheck = utils.FirstNonZero(Timeout(env.PegoutDepositCheck), defaultTimeouts.PegoutDepositCheck)
 	timeouts.BtcReleaseCheck = utils.FirstNonZero(Timeout(env.BtcReleaseCheck), defaultTimeouts.BtcReleaseCheck)
+	timeouts.WebhookNotification = utils.FirstNonZero(Timeout(env.WebhookNotification), defaultTimeouts.WebhookNotification)
+	timeouts.FireblocksSigning = utils.FirstNonZero(Timeout(env.FireblocksSigning), defaultTimeouts.FireblocksSigning)
+	timeouts.RemoteSigning = utils.FirstNonZero(Timeout(env.RemoteSigning), defaultTimeouts.RemoteSigning)
 	validate := validator.New(validator.WithRequiredStructEnabled())
 	if err := validate.Struct(timeouts); err != nil {
 		return ApplicationTimeouts{}, fmt.Errorf("error validating timeouts: %w", err)
@@ -2,12 +2,113 @@ package registry
 
 import (
 	"context"
+	"net/http"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/ses"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/alerting"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	log "github.com/sirupsen/logrus"
 )
 
-func NewAlertSender(ctx context.Context, env environment.Environment) alerts.AlertSender {
-	return alerting.NewLogAlertSender()
+const (
+	alertChannelLog       = "log"
+	alertChannelEmail     = "email"
+	alertChannelWebhook   = "webhook"
+	alertChannelSlack     = "slack"
+	alertChannelPagerDuty = "pagerduty"
+
+	alertHttpTimeout = 10 * time.Second
+)
+
+// NewAlertSender builds the alert sender based on the alerting configuration. If an alert repository
+// is provided, the alerts are recorded in the alert history and deduplicated using it
+func NewAlertSender(ctx context.Context, env environment.Environment, repository alerts.AlertRepository) alerts.AlertSender {
+	sender := newRoutingAlertSender(ctx, env)
+	if repository == nil {
+		return sender
+	}
+	alertingEnv := env.Alerting.FillWithDefaults()
+	windows := make(map[string]time.Duration)
+	for _, route := range alertingEnv.Routes {
+		if route.DedupWindowSeconds != nil {
+			windows[route.Subject] = time.Duration(*route.DedupWindowSeconds) * time.Second
+		}
+	}
+	defaultWindow := time.Duration(*alertingEnv.DedupWindowSeconds) * time.Second
+	return alerting.NewHistoryAlertSender(sender, repository, defaultWindow, windows)
+}
+
+func newRoutingAlertSender(ctx context.Context, env environment.Environment) alerts.AlertSender {
+	alertingEnv := env.Alerting
+	if len(alertingEnv.Routes) == 0 && len(alertingEnv.DefaultChannels) == 0 {
+		return alerting.NewLogAlertSender()
+	}
+
+	channels := make(map[string]alerts.AlertSender)
+	getChannels := func(names []string) []alerts.AlertSender {
+		senders := make([]alerts.AlertSender, 0, len(names))
+		for _, name := range names {
+			sender, ok := channels[name]
+			if !ok {
+				sender = newAlertChannel(ctx, env, name)
+				channels[name] = sender
+			}
+			if sender != nil {
+				senders = append(senders, sender)
+			}
+		}
+		return senders
+	}
+
+	defaultChannels := alertingEnv.DefaultChannels
+	if len(defaultChannels) == 0 {
+		defaultChannels = []string{alertChannelLog}
+	}
+	routes := make(map[string]alerting.AlertRoute)
+	for _, route := range alertingEnv.Routes {
+		routes[route.Subject] = alerting.AlertRoute{
+			Severity: alerts.AlertSeverity(route.Severity),
+			Senders:  getChannels(route.Channels),
+		}
+	}
+	fallback := alerting.AlertRoute{Senders: getChannels(defaultChannels)}
+	return alerting.NewRoutingAlertSender(routes, fallback)
+}
+
+func newAlertChannel(ctx context.Context, env environment.Environment, name string) alerts.AlertSender {
+	httpClient := &http.Client{Timeout: alertHttpTimeout}
+	switch name {
+	case alertChannelLog:
+		return alerting.NewLogAlertSender()
+	case alertChannelEmail:
+		awsConfig, err := environment.GetAwsConfig(ctx, env)
+		if err != nil {
+			log.Errorf("Error loading AWS configuration, email alerts will be disabled: %v", err)
+			return nil
+		}
+		return alerting.NewSesAlertSender(ses.NewFromConfig(awsConfig), env.Provider.AlertSenderEmail)
+	case alertChannelWebhook:
+		if env.Alerting.WebhookUrl == "" {
+			log.Warn("Webhook alert channel requested without ALERT_WEBHOOK_URL, channel will be disabled")
+			return nil
+		}
+		return alerting.NewWebhookAlertSender(httpClient, env.Alerting.WebhookUrl)
+	case alertChannelSlack:
+		if env.Alerting.SlackWebhookUrl == "" {
+			log.Warn("Slack alert channel requested without ALERT_SLACK_WEBHOOK_URL, channel will be disabled")
+			return nil
+		}
+		return alerting.NewSlackAlertSender(httpClient, env.Alerting.SlackWebhookUrl)
+	case alertChannelPagerDuty:
+		if env.Alerting.PagerDutyRoutingKey == "" {
+			log.Warn("PagerDuty alert channel requested without ALERT_PAGERDUTY_ROUTING_KEY, channel will be disabled")
+			return nil
+		}
+		return alerting.NewPagerDutyAlertSender(httpClient, env.Alerting.PagerDutyUrl, env.Alerting.PagerDutyRoutingKey, env.Provider.Name)
+	default:
+		log.Warnf("Unknown alert channel %q, channel will be disabled", name)
+		return nil
+	}
 }
@@ -7,14 +7,52 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/alerting"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/registry"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestNewAlertSender(t *testing.T) {
 	env := environment.Environment{LpsStage: "testnet", Provider: environment.ProviderEnv{AlertSenderEmail: "fake@email.com"}}
-	sender := registry.NewAlertSender(context.Background(), env)
+	sender := registry.NewAlertSender(context.Background(), env, nil)
 	implementationPointer, ok := sender.(*alerting.LogAlertSender)
 	assert.NotNil(t, sender)
 	assert.True(t, ok)
 	assert.NotNil(t, implementationPointer)
 }
+
+func TestNewAlertSender_Routing(t *testing.T) {
+	env := environment.Environment{
+		LpsStage: "testnet",
+		Provider: environment.ProviderEnv{Name: "provider"},
+		Alerting: environment.AlertingEnv{
+			WebhookUrl:          "http://localhost:1234",
+			PagerDutyRoutingKey: "key",
+			DefaultChannels:     []string{"log", "webhook"},
+			Routes: []environment.AlertRouteEnv{
+				{Subject: alerts.AlertSubjectPenalization, Severity: "critical", Channels: []string{"pagerduty", "slack"}},
+			},
+		},
+	}
+	sender := registry.NewAlertSender(context.Background(), env, nil)
+	implementationPointer, ok := sender.(*alerting.RoutingAlertSender)
+	assert.True(t, ok)
+	assert.NotNil(t, implementationPointer)
+}
+
+func TestNewAlertSender_History(t *testing.T) {
+	dedupWindow := uint64(0)
+	env := environment.Environment{
+		LpsStage: "testnet",
+		Alerting: environment.AlertingEnv{
+			Routes: []environment.AlertRouteEnv{
+				{Subject: alerts.AlertSubjectPenalization, Channels: []string{"log"}, DedupWindowSeconds: &dedupWindow},
+			},
+		},
+	}
+	sender := registry.NewAlertSender(context.Background(), env, &mocks.AlertRepositoryMock{})
+	implementationPointer, ok := sender.(*alerting.HistoryAlertSender)
+	assert.True(t, ok)
+	assert.NotNil(t, implementationPointer)
+	assert.Equal(t, uint64(3600), *env.Alerting.FillWithDefaults().DedupWindowSeconds)
+}
@@ -2,10 +2,14 @@ package registry
 
 import (
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/database/mongo"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/penalization"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/rootstock"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
 )
 
 type Database struct {
@@ -15,6 +19,12 @@ type Database struct {
 	PenalizedEventRepository    penalization.PenalizedEventRepository
 	TrustedAccountRepository    liquidity_provider.TrustedAccountRepository
 	BatchPegOutRepository       rootstock.BatchPegOutRepository
+	AlertRepository             alerts.AlertRepository
+	EventRepository             entities.EventRepository
+	WebhookRepository           webhook.WebhookRepository
+	LiquidityLedger             liquidity_provider.LiquidityLedger
+	ReportSnapshotRepository    reporting.SnapshotRepository
+	AssetSnapshotRepository     reporting.AssetSnapshotRepository
 	Connection                  *mongo.Connection
 }
 
@@ -26,6 +36,12 @@ func NewDatabaseRegistry(connection *mongo.Connection) *Database {
 		PenalizedEventRepository:    mongo.NewPenalizedEventRepository(connection),
 		TrustedAccountRepository:    mongo.NewTrustedAccountRepository(connection),
 		BatchPegOutRepository:       mongo.NewBatchPegOutMongoRepository(connection),
+		AlertRepository:             mongo.NewAlertRepository(connection),
+		EventRepository:             mongo.NewEventRepository(connection),
+		WebhookRepository:           mongo.NewWebhookRepository(connection),
+		LiquidityLedger:             mongo.NewLiquidityLedgerRepository(connection),
+		ReportSnapshotRepository:    mongo.NewReportSnapshotRepository(connection),
+		AssetSnapshotRepository:     mongo.NewAssetSnapshotRepository(connection),
 		Connection:                  connection,
 	}
 }
@@ -18,6 +18,9 @@ func TestNewDatabaseRegistry(t *testing.T) {
 		assert.NotNil(t, dbRegistry.PeginRepository)
 		assert.NotNil(t, dbRegistry.PegoutRepository)
 		assert.NotNil(t, dbRegistry.LiquidityProviderRepository)
+		assert.NotNil(t, dbRegistry.EventRepository)
+		assert.NotNil(t, dbRegistry.WebhookRepository)
+		assert.NotNil(t, dbRegistry.LiquidityLedger)
 		assert.Equal(t, conn, dbRegistry.Connection)
 	})
 }
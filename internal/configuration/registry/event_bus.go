@@ -2,9 +2,15 @@ package registry
 
 import (
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 )
 
-func NewEventBus() entities.EventBus {
+const mongoEventBus = "mongo"
+
+func NewEventBus(env environment.Environment, eventRepository entities.EventRepository) entities.EventBus {
+	if env.EventBus == mongoEventBus {
+		return dataproviders.NewMongoEventBus(eventRepository)
+	}
 	return dataproviders.NewLocalEventBus()
 }
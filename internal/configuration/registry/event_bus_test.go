@@ -2,12 +2,26 @@ package registry_test
 
 import (
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/registry"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
 func TestNewEventBus(t *testing.T) {
-	bus := registry.NewEventBus()
-	assert.IsType(t, &dataproviders.LocalEventBus{}, bus)
+	t.Run("Should return local bus by default", func(t *testing.T) {
+		bus := registry.NewEventBus(environment.Environment{}, nil)
+		assert.IsType(t, &dataproviders.LocalEventBus{}, bus)
+	})
+	t.Run("Should return mongo bus when configured", func(t *testing.T) {
+		repository := &mocks.EventRepositoryMock{}
+		repository.On("GetLastSequence", test.AnyCtx).Return(uint64(0), nil).Maybe()
+		bus := registry.NewEventBus(environment.Environment{EventBus: "mongo"}, repository)
+		assert.IsType(t, &dataproviders.MongoEventBus{}, bus)
+		closeChannel := make(chan bool, 1)
+		bus.Shutdown(closeChannel)
+		assert.True(t, <-closeChannel)
+	})
 }
@@ -0,0 +1,45 @@
+package registry
+
+import (
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+)
+
+const (
+	fixedGasPriceStrategy      = "fixed"
+	percentileGasPriceStrategy = "percentile"
+)
+
+// NewGasPriceStrategies returns the strategy of the gas price of the transactions sent by the liquidity provider and
+// the one of the gas price used to calculate the gas fee of the quotes. Both use the same base strategy and bounds,
+// but the safety margin is only added to the gas price of the quotes, so it covers the increases of the gas price
+// between the quote and its payment instead of being spent by the liquidity provider
+func NewGasPriceStrategies(env environment.Environment, rskClient *rootstock.RskClient) (blockchain.GasPriceStrategy, blockchain.GasPriceStrategy) {
+	var strategy blockchain.GasPriceStrategy
+	gasPriceEnv := env.GasPrice.FillWithDefaults()
+	switch gasPriceEnv.Strategy {
+	case fixedGasPriceStrategy:
+		strategy = rootstock.NewFixedGasPriceStrategy(rskClient, rootstock.DefaultRetryParams, entities.NewUWei(gasPriceEnv.Fixed))
+	case percentileGasPriceStrategy:
+		strategy = rootstock.NewPercentileGasPriceStrategy(rskClient, rootstock.DefaultRetryParams, gasPriceEnv.Percentile, gasPriceEnv.Blocks)
+	default:
+		strategy = rootstock.NewNodeGasPriceStrategy(rskClient, rootstock.DefaultRetryParams)
+	}
+	return capGasPriceStrategy(rskClient, strategy, gasPriceEnv, 0), capGasPriceStrategy(rskClient, strategy, gasPriceEnv, gasPriceEnv.MarginPercentage)
+}
+
+func capGasPriceStrategy(rskClient *rootstock.RskClient, strategy blockchain.GasPriceStrategy, gasPriceEnv *environment.GasPriceEnv, marginPercentage uint64) blockchain.GasPriceStrategy {
+	if marginPercentage == 0 && gasPriceEnv.Min == 0 && gasPriceEnv.Max == 0 {
+		return strategy
+	}
+	return rootstock.NewCappedGasPriceStrategy(
+		rskClient,
+		rootstock.DefaultRetryParams,
+		strategy,
+		marginPercentage,
+		entities.NewUWei(gasPriceEnv.Min),
+		entities.NewUWei(gasPriceEnv.Max),
+	)
+}
@@ -0,0 +1,81 @@
+package registry_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/registry"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGasPriceStrategies(t *testing.T) {
+	rskClient := rootstock.NewRskClient(new(mocks.RpcClientBindingMock))
+	t.Run("Should use the node gas price by default", func(t *testing.T) {
+		strategy, quoteStrategy := registry.NewGasPriceStrategies(environment.Environment{}, rskClient)
+		assert.IsType(t, &rootstock.NodeGasPriceStrategy{}, strategy)
+		assert.Same(t, strategy, quoteStrategy)
+	})
+	t.Run("Should use a fixed gas price when configured", func(t *testing.T) {
+		client := new(mocks.RpcClientBindingMock)
+		client.On("SuggestGasPrice", test.AnyCtx).Return(big.NewInt(100), nil).Once()
+		env := environment.Environment{GasPrice: environment.GasPriceEnv{Strategy: "fixed", Fixed: 60000000}}
+		strategy, _ := registry.NewGasPriceStrategies(env, rootstock.NewRskClient(client))
+		assert.IsType(t, &rootstock.FixedGasPriceStrategy{}, strategy)
+		gasPrice, err := strategy.GasPrice(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(60000000), gasPrice)
+	})
+	t.Run("Should use the percentile of the latest blocks when configured", func(t *testing.T) {
+		env := environment.Environment{GasPrice: environment.GasPriceEnv{Strategy: "percentile"}}
+		strategy, _ := registry.NewGasPriceStrategies(env, rskClient)
+		assert.IsType(t, &rootstock.PercentileGasPriceStrategy{}, strategy)
+	})
+	t.Run("Should apply the margin only to the quotes and the caps to both", func(t *testing.T) {
+		client := new(mocks.RpcClientBindingMock)
+		client.On("SuggestGasPrice", test.AnyCtx).Return(big.NewInt(100), nil).Times(4)
+		env := environment.Environment{GasPrice: environment.GasPriceEnv{MarginPercentage: 10, Min: 101, Max: 105}}
+		strategy, quoteStrategy := registry.NewGasPriceStrategies(env, rootstock.NewRskClient(client))
+		assert.IsType(t, &rootstock.CappedGasPriceStrategy{}, strategy)
+		assert.IsType(t, &rootstock.CappedGasPriceStrategy{}, quoteStrategy)
+		gasPrice, err := strategy.GasPrice(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(101), gasPrice)
+		quoteGasPrice, err := quoteStrategy.GasPrice(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(105), quoteGasPrice)
+		client.AssertExpectations(t)
+	})
+	t.Run("Should not apply a maximum lower than the node gas price", func(t *testing.T) {
+		client := new(mocks.RpcClientBindingMock)
+		client.On("SuggestGasPrice", test.AnyCtx).Return(big.NewInt(100), nil)
+		env := environment.Environment{GasPrice: environment.GasPriceEnv{MarginPercentage: 10, Max: 90}}
+		strategy, quoteStrategy := registry.NewGasPriceStrategies(env, rootstock.NewRskClient(client))
+		gasPrice, err := strategy.GasPrice(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(100), gasPrice)
+		quoteGasPrice, err := quoteStrategy.GasPrice(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(100), quoteGasPrice)
+	})
+	t.Run("Should only apply the margin to the quotes if there are no caps", func(t *testing.T) {
+		client := new(mocks.RpcClientBindingMock)
+		client.On("SuggestGasPrice", test.AnyCtx).Return(big.NewInt(100), nil).Times(3)
+		env := environment.Environment{GasPrice: environment.GasPriceEnv{MarginPercentage: 10}}
+		strategy, quoteStrategy := registry.NewGasPriceStrategies(env, rootstock.NewRskClient(client))
+		assert.IsType(t, &rootstock.NodeGasPriceStrategy{}, strategy)
+		gasPrice, err := strategy.GasPrice(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(100), gasPrice)
+		quoteGasPrice, err := quoteStrategy.GasPrice(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(110), quoteGasPrice)
+		client.AssertExpectations(t)
+	})
+}
@@ -12,6 +12,7 @@ func NewLiquidityProvider(
 		databaseRegistry.PeginRepository,
 		databaseRegistry.PegoutRepository,
 		databaseRegistry.LiquidityProviderRepository,
+		databaseRegistry.LiquidityLedger,
 		messaging.Rpc,
 		rskRegistry.Wallet,
 		btcRegistry.PaymentWallet,
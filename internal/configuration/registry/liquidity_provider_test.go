@@ -49,7 +49,7 @@ func TestNewLiquidityProvider(t *testing.T) {
 	btcRegistry, err := registry.NewBitcoinRegistry(walletFactoryMock, connection)
 	require.NoError(t, err)
 
-	messagingRegistry := registry.NewMessagingRegistry(context.Background(), environment.Environment{}, rskClient, connection, registry.ExternalRpc{})
+	messagingRegistry := registry.NewMessagingRegistry(context.Background(), environment.Environment{}, rskClient, connection, registry.ExternalRpc{}, nil, nil)
 
 	lp := registry.NewLiquidityProvider(dbRegistry, rskRegistry, btcRegistry, messagingRegistry)
 	require.NotNil(t, lp)
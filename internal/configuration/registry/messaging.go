@@ -30,14 +30,17 @@ func NewMessagingRegistry(
 	rskClient *rootstock.RskClient,
 	btcConn *bitcoin.Connection,
 	externalRpc ExternalRpc,
+	alertRepository alerts.AlertRepository,
+	eventRepository entities.EventRepository,
 ) *Messaging {
+	gasPrice, quoteGasPrice := NewGasPriceStrategies(env, rskClient)
 	return &Messaging{
 		Rpc: blockchain.Rpc{
 			Btc: bitcoin.NewBitcoindRpc(btcConn),
-			Rsk: rootstock.NewRskjRpcServer(rskClient, rootstock.DefaultRetryParams),
+			Rsk: rootstock.NewRskjRpcServerWithGasPrice(rskClient, rootstock.DefaultRetryParams, gasPrice, quoteGasPrice),
 		},
-		EventBus:    NewEventBus(),
-		AlertSender: NewAlertSender(ctx, env),
+		EventBus:    NewEventBus(env, eventRepository),
+		AlertSender: NewAlertSender(ctx, env, alertRepository),
 		RskExtraRpc: externalRpc.RskExternalRpc,
 		BtcExtraRpc: externalRpc.BtcExternalRpc,
 	}
@@ -0,0 +1,45 @@
+package registry
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+)
+
+const webhookHttpTimeout = 10 * time.Second
+
+// NewNotificationSender builds the sender used to notify the quote state transitions to the webhooks
+// registered by the integrators. The signer is used for the webhooks that don't have a shared secret
+func NewNotificationSender(env environment.Environment, signer entities.Signer) webhook.NotificationSender {
+	return newHttpNotificationSender(env, signer)
+}
+
+// NewSnapshotSender builds the sender used to deliver the report snapshots to REPORT_SNAPSHOT_WEBHOOK_URL, it
+// returns nil if the url is not set
+func NewSnapshotSender(env environment.Environment, signer entities.Signer) reporting.SnapshotSender {
+	if env.ReportSnapshot.WebhookUrl == "" {
+		return nil
+	}
+	return dataproviders.NewHttpSnapshotSender(
+		newHttpNotificationSender(env, signer),
+		env.ReportSnapshot.WebhookUrl,
+		env.ReportSnapshot.WebhookSecret,
+	)
+}
+
+func newHttpNotificationSender(env environment.Environment, signer entities.Signer) *dataproviders.HttpNotificationSender {
+	webhookEnv := env.Webhook.FillWithDefaults()
+	return dataproviders.NewHttpNotificationSender(
+		&http.Client{Timeout: webhookHttpTimeout},
+		dataproviders.NewPublicHttpClient(webhookHttpTimeout),
+		signer,
+		signingHashFunction,
+		webhookEnv.MaxAttempts,
+		time.Duration(webhookEnv.InitialBackoffSeconds)*time.Second,
+	)
+}
@@ -0,0 +1,37 @@
+package registry_test
+
+import (
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/registry"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNotificationSender(t *testing.T) {
+	env := environment.Environment{LpsStage: "testnet"}
+	sender := registry.NewNotificationSender(env, &mocks.TransactionSignerMock{})
+	implementationPointer, ok := sender.(*dataproviders.HttpNotificationSender)
+	assert.NotNil(t, sender)
+	assert.True(t, ok)
+	assert.NotNil(t, implementationPointer)
+}
+
+func TestNewSnapshotSender(t *testing.T) {
+	t.Run("should return nil if the webhook url is not set", func(t *testing.T) {
+		env := environment.Environment{LpsStage: "testnet"}
+		assert.Nil(t, registry.NewSnapshotSender(env, &mocks.TransactionSignerMock{}))
+	})
+	t.Run("should build the http sender if the webhook url is set", func(t *testing.T) {
+		env := environment.Environment{
+			LpsStage:       "testnet",
+			ReportSnapshot: environment.ReportSnapshotEnv{WebhookUrl: "https://example.com/snapshots"},
+		}
+		sender := registry.NewSnapshotSender(env, &mocks.TransactionSignerMock{})
+		implementationPointer, ok := sender.(*dataproviders.HttpSnapshotSender)
+		assert.True(t, ok)
+		assert.NotNil(t, implementationPointer)
+	})
+}
@@ -0,0 +1,17 @@
+package registry
+
+import (
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/database/mongo"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+)
+
+const mongoRateLimitStore = "mongo"
+
+func NewRateLimiter(env environment.Environment, connection *mongo.Connection) entities.RateLimiter {
+	if env.RateLimit.Store == mongoRateLimitStore {
+		return mongo.NewRateLimitRepository(connection)
+	}
+	return dataproviders.NewLocalRateLimiter()
+}
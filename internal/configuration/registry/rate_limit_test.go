@@ -0,0 +1,28 @@
+package registry_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/database/mongo"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
+	"github.com/rsksmart/liquidity-provider-server/internal/configuration/registry"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+	t.Run("Should return local rate limiter by default", func(t *testing.T) {
+		limiter := registry.NewRateLimiter(environment.Environment{}, nil)
+		assert.IsType(t, &dataproviders.LocalRateLimiter{}, limiter)
+	})
+	t.Run("Should return mongo rate limiter when configured", func(t *testing.T) {
+		client := &mocks.DbClientBindingMock{}
+		client.On("Database", mongo.DbName).Return(&mocks.DbBindingMock{})
+		env := environment.Environment{RateLimit: environment.RateLimitEnv{Store: "mongo"}}
+		limiter := registry.NewRateLimiter(env, mongo.NewConnection(client, time.Duration(1)))
+		assert.NotNil(t, limiter)
+		assert.IsType(t, mongo.NewRateLimitRepository(nil), limiter)
+	})
+}
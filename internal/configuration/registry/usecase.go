@@ -2,6 +2,7 @@ package registry
 
 import (
 	"sync"
+	"time"
 
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
 
@@ -10,12 +11,14 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock"
 	"github.com/rsksmart/liquidity-provider-server/internal/configuration/environment"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegin"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegout"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/watcher"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/webhook"
 )
 
 var signingHashFunction = crypto.Keccak256
@@ -36,6 +39,8 @@ type UseCaseRegistry struct {
 	updatePegoutDepositUseCase    *watcher.UpdatePegoutQuoteDepositUseCase
 	initPegoutDepositCacheUseCase *pegout.InitPegoutDepositCacheUseCase
 	refundPegoutUseCase           *pegout.RefundPegoutUseCase
+	bumpPegoutFeeUseCase          *pegout.BumpPegoutFeeUseCase
+	sendBatchPegoutUseCase        *pegout.SendBatchPegoutUseCase
 	getPegoutQuoteUseCase         *pegout.GetQuoteUseCase
 	acceptPegoutQuoteUseCase      *pegout.AcceptQuoteUseCase
 	getUserDepositsUseCase        *pegout.GetUserDepositsUseCase
@@ -68,7 +73,15 @@ type UseCaseRegistry struct {
 	getPeginReportUseCase         *reports.GetPeginReportUseCase
 	getPegoutReportUseCase        *reports.GetPegoutReportUseCase
 	getRevenueReportUseCase       *reports.GetRevenueReportUseCase
+	getRevenueJournalUseCase      *reports.GetRevenueJournalUseCase
+	getAnalyticsReportUseCase     *reports.GetAnalyticsReportUseCase
+	getQuotePnlUseCase            *reports.GetQuotePnlUseCase
 	getAssetsReportUseCase        *reports.GetAssetsReportUseCase
+	createReportSnapshotsUseCase  *reports.CreateReportSnapshotsUseCase
+	getReportSnapshotsUseCase     *reports.GetReportSnapshotsUseCase
+	createAssetSnapshotUseCase    *reports.CreateAssetSnapshotUseCase
+	getAssetHistoryUseCase        *reports.GetAssetHistoryUseCase
+	checkAssetDriftUseCase        *reports.CheckAssetDriftUseCase
 	getTransactionsReportUseCase  *reports.GetTransactionsUseCase
 	updateTrustedAccountUseCase   *liquidity_provider.UpdateTrustedAccountUseCase
 	addTrustedAccountUseCase      *liquidity_provider.AddTrustedAccountUseCase
@@ -80,6 +93,21 @@ type UseCaseRegistry struct {
 	updateBtcReleaseUseCase       *pegout.UpdateBtcReleaseUseCase
 	recommendedPegoutUseCase      *pegout.RecommendedPegoutUseCase
 	recommendedPeginUseCase       *pegin.RecommendedPeginUseCase
+	getRecentAlertsUseCase        *liquidity_provider.GetRecentAlertsUseCase
+	registerWebhookUseCase        *webhook.RegisterWebhookUseCase
+	getWebhooksUseCase            *webhook.GetWebhooksUseCase
+	deleteWebhookUseCase          *webhook.DeleteWebhookUseCase
+	notifyQuoteStateUseCase       *webhook.NotifyQuoteStateUseCase
+	streamQuoteStateUseCase       *webhook.StreamQuoteStateUseCase
+	peginDepositConfirmations     *pegin.DepositConfirmationsUseCase
+	pegoutDepositConfirmations    *pegout.DepositConfirmationsUseCase
+	getLiquidityHoldsUseCase      *liquidity_provider.GetLiquidityHoldsUseCase
+	releaseLiquidityHoldUseCase   *liquidity_provider.ReleaseLiquidityHoldUseCase
+	reconcileLiquidityLedger      *liquidity_provider.ReconcileLiquidityLedgerUseCase
+	getPeginQuotesUseCase         *pegin.GetQuotesUseCase
+	getPegoutQuotesUseCase        *pegout.GetQuotesUseCase
+	estimatePeginQuoteUseCase     *pegin.EstimateQuoteUseCase
+	estimatePegoutQuoteUseCase    *pegout.EstimateQuoteUseCase
 }
 
 // NewUseCaseRegistry
@@ -93,7 +121,7 @@ func NewUseCaseRegistry(
 	messaging *Messaging,
 	mutexes entities.ApplicationMutexes,
 ) *UseCaseRegistry {
-	return &UseCaseRegistry{
+	registry := &UseCaseRegistry{
 		summariesUseCase: reports.NewSummariesUseCase(
 			databaseRegistry.PeginRepository,
 			databaseRegistry.PegoutRepository,
@@ -105,6 +133,8 @@ func NewUseCaseRegistry(
 			databaseRegistry.PeginRepository,
 			liquidityProvider,
 			liquidityProvider,
+			databaseRegistry.TrustedAccountRepository,
+			signingHashFunction,
 		),
 		registerProviderUseCase: liquidity_provider.NewRegistrationUseCase(
 			rskRegistry.Contracts,
@@ -133,11 +163,12 @@ func NewUseCaseRegistry(
 			liquidityProvider,
 			messaging.EventBus,
 			mutexes.PeginLiquidityMutex(),
+			databaseRegistry.LiquidityLedger,
 			databaseRegistry.TrustedAccountRepository,
 			signingHashFunction,
 		),
 		getWatchedPeginQuoteUseCase: watcher.NewGetWatchedPeginQuoteUseCase(databaseRegistry.PeginRepository),
-		expiredPeginQuoteUseCase:    pegin.NewExpiredPeginQuoteUseCase(databaseRegistry.PeginRepository),
+		expiredPeginQuoteUseCase:    pegin.NewExpiredPeginQuoteUseCase(databaseRegistry.PeginRepository, messaging.EventBus),
 		cleanExpiredQuotesUseCase: watcher.NewCleanExpiredQuotesUseCase(
 			databaseRegistry.PeginRepository,
 			databaseRegistry.PegoutRepository,
@@ -152,8 +183,8 @@ func NewUseCaseRegistry(
 		getWatchedPegoutQuoteUseCase: watcher.NewGetWatchedPegoutQuoteUseCase(
 			databaseRegistry.PegoutRepository,
 		),
-		expiredPegoutUseCase:       pegout.NewExpiredPegoutQuoteUseCase(databaseRegistry.PegoutRepository),
-		updatePegoutDepositUseCase: watcher.NewUpdatePegoutQuoteDepositUseCase(databaseRegistry.PegoutRepository),
+		expiredPegoutUseCase:       pegout.NewExpiredPegoutQuoteUseCase(databaseRegistry.PegoutRepository, messaging.EventBus),
+		updatePegoutDepositUseCase: watcher.NewUpdatePegoutQuoteDepositUseCase(databaseRegistry.PegoutRepository, messaging.EventBus),
 		initPegoutDepositCacheUseCase: pegout.NewInitPegoutDepositCacheUseCase(
 			databaseRegistry.PegoutRepository,
 			rskRegistry.Contracts,
@@ -166,6 +197,14 @@ func NewUseCaseRegistry(
 			messaging.Rpc,
 			mutexes.RskWalletMutex(),
 		),
+		bumpPegoutFeeUseCase: pegout.NewBumpPegoutFeeUseCase(
+			btcRegistry.PaymentWallet,
+			databaseRegistry.PegoutRepository,
+			messaging.Rpc,
+			mutexes.BtcWalletMutex(),
+			entities.SatoshiToWei(env.Pegout.FeeBumpMaxFee),
+			env.Pegout.FeeBumpThreshold,
+		),
 		getPegoutQuoteUseCase: pegout.NewGetQuoteUseCase(
 			messaging.Rpc,
 			rskRegistry.Contracts,
@@ -173,6 +212,8 @@ func NewUseCaseRegistry(
 			liquidityProvider,
 			liquidityProvider,
 			btcRegistry.PaymentWallet,
+			databaseRegistry.TrustedAccountRepository,
+			signingHashFunction,
 		),
 		acceptPegoutQuoteUseCase: pegout.NewAcceptQuoteUseCase(
 			databaseRegistry.PegoutRepository,
@@ -181,6 +222,7 @@ func NewUseCaseRegistry(
 			liquidityProvider,
 			messaging.EventBus,
 			mutexes.PegoutLiquidityMutex(),
+			databaseRegistry.LiquidityLedger,
 			databaseRegistry.TrustedAccountRepository,
 			signingHashFunction,
 		),
@@ -195,6 +237,7 @@ func NewUseCaseRegistry(
 		),
 		getUserDepositsUseCase: pegout.NewGetUserDepositsUseCase(databaseRegistry.PegoutRepository),
 		liquidityCheckUseCase: liquidity_provider.NewCheckLiquidityUseCase(
+			liquidityProvider,
 			liquidityProvider,
 			liquidityProvider,
 			rskRegistry.Contracts,
@@ -220,6 +263,8 @@ func NewUseCaseRegistry(
 			databaseRegistry.LiquidityProviderRepository,
 			rskRegistry.Wallet,
 			signingHashFunction,
+			liquidityProvider,
+			liquidityProvider,
 		),
 		setPeginConfigUseCase: liquidity_provider.NewSetPeginConfigUseCase(
 			databaseRegistry.LiquidityProviderRepository,
@@ -258,12 +303,14 @@ func NewUseCaseRegistry(
 			liquidityProvider,
 			rskRegistry.Wallet,
 			rskRegistry.Contracts,
+			messaging.Rpc,
 			mutexes.RskWalletMutex(),
+			messaging.EventBus,
 		),
 		peginStatusUseCase:        pegin.NewStatusUseCase(databaseRegistry.PeginRepository),
 		pegoutStatusUseCase:       pegout.NewStatusUseCase(databaseRegistry.PegoutRepository),
 		availableLiquidityUseCase: liquidity_provider.NewGetAvailableLiquidityUseCase(liquidityProvider, liquidityProvider, liquidityProvider),
-		updatePeginDepositUseCase: watcher.NewUpdatePeginDepositUseCase(databaseRegistry.PeginRepository),
+		updatePeginDepositUseCase: watcher.NewUpdatePeginDepositUseCase(databaseRegistry.PeginRepository, messaging.EventBus),
 		getServerInfoUseCase:      liquidity_provider.NewServerInfoUseCase(),
 		getPeginReportUseCase:     reports.NewGetPeginReportUseCase(databaseRegistry.PeginRepository),
 		getPegoutReportUseCase:    reports.NewGetPegoutReportUseCase(databaseRegistry.PegoutRepository),
@@ -272,6 +319,15 @@ func NewUseCaseRegistry(
 			databaseRegistry.PegoutRepository,
 			databaseRegistry.PenalizedEventRepository,
 		),
+		getAnalyticsReportUseCase: reports.NewGetAnalyticsReportUseCase(
+			databaseRegistry.PeginRepository,
+			databaseRegistry.PegoutRepository,
+		),
+		getQuotePnlUseCase: reports.NewGetQuotePnlUseCase(
+			databaseRegistry.PeginRepository,
+			databaseRegistry.PegoutRepository,
+			databaseRegistry.PenalizedEventRepository,
+		),
 		getAssetsReportUseCase: reports.NewGetAssetsReportUseCase(
 			btcRegistry.PaymentWallet,
 			messaging.Rpc,
@@ -282,6 +338,15 @@ func NewUseCaseRegistry(
 			databaseRegistry.PegoutRepository,
 			rskRegistry.Contracts,
 		),
+		createReportSnapshotsUseCase: reports.NewCreateReportSnapshotsUseCase(
+			databaseRegistry.ReportSnapshotRepository,
+			reportSnapshotAlertSender(env, messaging),
+			env.Provider.AlertRecipientEmail,
+			NewSnapshotSender(env, rskRegistry.Wallet),
+		),
+		getReportSnapshotsUseCase:  reports.NewGetReportSnapshotsUseCase(databaseRegistry.ReportSnapshotRepository),
+		createAssetSnapshotUseCase: reports.NewCreateAssetSnapshotUseCase(databaseRegistry.AssetSnapshotRepository),
+		getAssetHistoryUseCase:     reports.NewGetAssetHistoryUseCase(databaseRegistry.AssetSnapshotRepository),
 		getTransactionsReportUseCase: reports.NewGetTransactionsUseCase(
 			databaseRegistry.PeginRepository,
 			databaseRegistry.PegoutRepository,
@@ -348,13 +413,60 @@ func NewUseCaseRegistry(
 			messaging.Rpc,
 			utils.Scale,
 		),
+		getRecentAlertsUseCase: liquidity_provider.NewGetRecentAlertsUseCase(databaseRegistry.AlertRepository),
+		registerWebhookUseCase: webhook.NewRegisterWebhookUseCase(databaseRegistry.WebhookRepository),
+		getWebhooksUseCase:     webhook.NewGetWebhooksUseCase(databaseRegistry.WebhookRepository),
+		deleteWebhookUseCase:   webhook.NewDeleteWebhookUseCase(databaseRegistry.WebhookRepository),
+		notifyQuoteStateUseCase: webhook.NewNotifyQuoteStateUseCase(
+			databaseRegistry.WebhookRepository,
+			NewNotificationSender(env, rskRegistry.Wallet),
+		),
+		streamQuoteStateUseCase:     webhook.NewStreamQuoteStateUseCase(messaging.EventBus),
+		peginDepositConfirmations:   pegin.NewDepositConfirmationsUseCase(databaseRegistry.PeginRepository, messaging.Rpc),
+		pegoutDepositConfirmations:  pegout.NewDepositConfirmationsUseCase(databaseRegistry.PegoutRepository, messaging.Rpc),
+		getLiquidityHoldsUseCase:    liquidity_provider.NewGetLiquidityHoldsUseCase(databaseRegistry.LiquidityLedger),
+		releaseLiquidityHoldUseCase: liquidity_provider.NewReleaseLiquidityHoldUseCase(databaseRegistry.LiquidityLedger),
+		reconcileLiquidityLedger: liquidity_provider.NewReconcileLiquidityLedgerUseCase(
+			databaseRegistry.LiquidityLedger,
+			databaseRegistry.PeginRepository,
+			databaseRegistry.PegoutRepository,
+			mutexes.PeginLiquidityMutex(),
+			mutexes.PegoutLiquidityMutex(),
+		),
+	}
+	quoteBatchSize := utils.FirstNonZero(env.QuoteBatchSize, environment.DefaultQuoteBatchSize)
+	registry.getPeginQuotesUseCase = pegin.NewGetQuotesUseCase(registry.getPeginQuoteUseCase, quoteBatchSize)
+	registry.getPegoutQuotesUseCase = pegout.NewGetQuotesUseCase(registry.getPegoutQuoteUseCase, quoteBatchSize)
+	registry.estimatePeginQuoteUseCase = pegin.NewEstimateQuoteUseCase(registry.getPeginQuoteUseCase)
+	registry.estimatePegoutQuoteUseCase = pegout.NewEstimateQuoteUseCase(registry.getPegoutQuoteUseCase)
+	registry.getRevenueJournalUseCase = reports.NewGetRevenueJournalUseCase(registry.getRevenueReportUseCase)
+	assetDriftEnv := env.AssetDrift.FillWithDefaults()
+	registry.checkAssetDriftUseCase = reports.NewCheckAssetDriftUseCase(
+		databaseRegistry.AssetSnapshotRepository,
+		registry.getRevenueReportUseCase,
+		messaging.AlertSender,
+		env.Provider.AlertRecipientEmail,
+		time.Duration(assetDriftEnv.WindowHours)*time.Hour,
+		entities.NewUWei(*assetDriftEnv.Tolerance),
+	)
+	if env.Pegout.BatchPayouts {
+		registry.sendBatchPegoutUseCase = pegout.NewSendBatchPegoutUseCase(registry.sendPegoutUseCase)
 	}
+	return registry
 }
 
 func (registry *UseCaseRegistry) GetPeginQuoteUseCase() *pegin.GetQuoteUseCase {
 	return registry.getPeginQuoteUseCase
 }
 
+func (registry *UseCaseRegistry) GetPeginQuotesUseCase() *pegin.GetQuotesUseCase {
+	return registry.getPeginQuotesUseCase
+}
+
+func (registry *UseCaseRegistry) EstimatePeginQuoteUseCase() *pegin.EstimateQuoteUseCase {
+	return registry.estimatePeginQuoteUseCase
+}
+
 func (registry *UseCaseRegistry) GetRegistrationUseCase() *liquidity_provider.RegistrationUseCase {
 	return registry.registerProviderUseCase
 }
@@ -371,6 +483,14 @@ func (registry *UseCaseRegistry) GetPegoutQuoteUseCase() *pegout.GetQuoteUseCase
 	return registry.getPegoutQuoteUseCase
 }
 
+func (registry *UseCaseRegistry) GetPegoutQuotesUseCase() *pegout.GetQuotesUseCase {
+	return registry.getPegoutQuotesUseCase
+}
+
+func (registry *UseCaseRegistry) EstimatePegoutQuoteUseCase() *pegout.EstimateQuoteUseCase {
+	return registry.estimatePegoutQuoteUseCase
+}
+
 func (registry *UseCaseRegistry) GetAcceptPegoutQuoteUseCase() *pegout.AcceptQuoteUseCase {
 	return registry.acceptPegoutQuoteUseCase
 }
@@ -479,10 +599,34 @@ func (registry *UseCaseRegistry) GetRevenueReportUseCase() *reports.GetRevenueRe
 	return registry.getRevenueReportUseCase
 }
 
+func (registry *UseCaseRegistry) GetRevenueJournalUseCase() *reports.GetRevenueJournalUseCase {
+	return registry.getRevenueJournalUseCase
+}
+
+func (registry *UseCaseRegistry) GetAnalyticsReportUseCase() *reports.GetAnalyticsReportUseCase {
+	return registry.getAnalyticsReportUseCase
+}
+
+func (registry *UseCaseRegistry) GetQuotePnlUseCase() *reports.GetQuotePnlUseCase {
+	return registry.getQuotePnlUseCase
+}
+
 func (registry *UseCaseRegistry) GetAssetsReportUseCase() *reports.GetAssetsReportUseCase {
 	return registry.getAssetsReportUseCase
 }
 
+func (registry *UseCaseRegistry) CreateReportSnapshotsUseCase() *reports.CreateReportSnapshotsUseCase {
+	return registry.createReportSnapshotsUseCase
+}
+
+func (registry *UseCaseRegistry) GetReportSnapshotsUseCase() *reports.GetReportSnapshotsUseCase {
+	return registry.getReportSnapshotsUseCase
+}
+
+func (registry *UseCaseRegistry) GetAssetHistoryUseCase() *reports.GetAssetHistoryUseCase {
+	return registry.getAssetHistoryUseCase
+}
+
 func (registry *UseCaseRegistry) GetTransactionsReportUseCase() *reports.GetTransactionsUseCase {
 	return registry.getTransactionsReportUseCase
 }
@@ -514,3 +658,47 @@ func (registry *UseCaseRegistry) RecommendedPegoutUseCase() *pegout.RecommendedP
 func (registry *UseCaseRegistry) RecommendedPeginUseCase() *pegin.RecommendedPeginUseCase {
 	return registry.recommendedPeginUseCase
 }
+
+func (registry *UseCaseRegistry) GetRecentAlertsUseCase() *liquidity_provider.GetRecentAlertsUseCase {
+	return registry.getRecentAlertsUseCase
+}
+
+func (registry *UseCaseRegistry) RegisterWebhookUseCase() *webhook.RegisterWebhookUseCase {
+	return registry.registerWebhookUseCase
+}
+
+func (registry *UseCaseRegistry) GetWebhooksUseCase() *webhook.GetWebhooksUseCase {
+	return registry.getWebhooksUseCase
+}
+
+func (registry *UseCaseRegistry) DeleteWebhookUseCase() *webhook.DeleteWebhookUseCase {
+	return registry.deleteWebhookUseCase
+}
+
+func (registry *UseCaseRegistry) StreamQuoteStateUseCase() *webhook.StreamQuoteStateUseCase {
+	return registry.streamQuoteStateUseCase
+}
+
+func (registry *UseCaseRegistry) GetPeginDepositConfirmationsUseCase() *pegin.DepositConfirmationsUseCase {
+	return registry.peginDepositConfirmations
+}
+
+func (registry *UseCaseRegistry) GetPegoutDepositConfirmationsUseCase() *pegout.DepositConfirmationsUseCase {
+	return registry.pegoutDepositConfirmations
+}
+
+func (registry *UseCaseRegistry) GetLiquidityHoldsUseCase() *liquidity_provider.GetLiquidityHoldsUseCase {
+	return registry.getLiquidityHoldsUseCase
+}
+
+func (registry *UseCaseRegistry) ReconcileLiquidityLedgerUseCase() *liquidity_provider.ReconcileLiquidityLedgerUseCase {
+	return registry.reconcileLiquidityLedger
+}
+
+// reportSnapshotAlertSender returns the alert sender for the report snapshots only if REPORT_SNAPSHOT_SEND_ALERT is set
+func reportSnapshotAlertSender(env environment.Environment, messaging *Messaging) alerts.AlertSender {
+	if !env.ReportSnapshot.SendAlert {
+		return nil
+	}
+	return messaging.AlertSender
+}
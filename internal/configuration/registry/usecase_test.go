@@ -29,7 +29,8 @@ func TestNewUseCaseRegistry(t *testing.T) {
 				PegoutContractAddress:       "0x8901a2Bbf639bFD21A97004BA4D7aE2BD00B8DA5",
 				BridgeAddress:               "0x0000000000000000000000000000000001000006",
 			},
-			Btc: environment.BtcEnv{Network: "testnet"},
+			Btc:    environment.BtcEnv{Network: "testnet"},
+			Pegout: environment.PegoutEnv{BatchPayouts: true},
 		}
 
 		client := &mocks.DbClientBindingMock{}
@@ -51,7 +52,7 @@ func TestNewUseCaseRegistry(t *testing.T) {
 		btcRegistry, err := registry.NewBitcoinRegistry(walletFactoryMock, connection)
 		require.NoError(t, err)
 
-		messagingRegistry := registry.NewMessagingRegistry(context.Background(), environment.Environment{}, rskClient, connection, registry.ExternalRpc{})
+		messagingRegistry := registry.NewMessagingRegistry(context.Background(), environment.Environment{}, rskClient, connection, registry.ExternalRpc{}, nil, nil)
 		lp := registry.NewLiquidityProvider(dbRegistry, rskRegistry, btcRegistry, messagingRegistry)
 		mutexes := environment.NewApplicationMutexes()
 
@@ -21,8 +21,12 @@ type WatcherRegistry struct {
 	BitcoinEclipseWatcher      *watcher.EclipseWatcher
 	RskEclipseWatcher          *watcher.EclipseWatcher
 	BtcReleaseWatcher          *watcher.BtcReleaseWatcher
+	QuoteWebhookWatcher        *watcher.QuoteWebhookWatcher
 	QuoteMetricsWatcher        *monitoring.QuoteMetricsWatcher
 	AssetReportWatcher         *monitoring.AssetReportWatcher
+	LiquidityLedgerWatcher     *watcher.LiquidityLedgerWatcher
+	ReportSnapshotWatcher      *watcher.ReportSnapshotWatcher
+	AssetDriftWatcher          *watcher.AssetDriftWatcher
 }
 
 // nolint:funlen
@@ -70,6 +74,7 @@ func NewWatcherRegistry(
 				useCaseRegistry.sendPegoutUseCase,
 				useCaseRegistry.updatePegoutDepositUseCase,
 				useCaseRegistry.initPegoutDepositCacheUseCase,
+				useCaseRegistry.sendBatchPegoutUseCase,
 			),
 			liquidityProvider,
 			messaging.Rpc,
@@ -82,12 +87,14 @@ func NewWatcherRegistry(
 		PegoutBtcTransferWatcher: watcher.NewPegoutBtcTransferWatcher(
 			useCaseRegistry.getWatchedPegoutQuoteUseCase,
 			useCaseRegistry.refundPegoutUseCase,
+			useCaseRegistry.bumpPegoutFeeUseCase,
 			messaging.Rpc,
 			messaging.EventBus,
 			tickers.PegoutBtcTransferWatcherTicker,
 		),
 		LiquidityCheckWatcher: watcher.NewLiquidityCheckWatcher(
 			useCaseRegistry.liquidityCheckUseCase,
+			appMetrics,
 			tickers.LiquidityCheckTicker,
 			timeouts.WatcherValidation.Seconds(),
 		),
@@ -123,6 +130,11 @@ func NewWatcherRegistry(
 			env.Pegout.BtcReleaseWatcherPageSize,
 			timeouts.BtcReleaseCheck.Seconds(),
 		),
+		QuoteWebhookWatcher: watcher.NewQuoteWebhookWatcher(
+			useCaseRegistry.notifyQuoteStateUseCase,
+			messaging.EventBus,
+			timeouts.WebhookNotification.Seconds(),
+		),
 		QuoteMetricsWatcher: monitoring.NewQuoteMetricsWatcher(
 			appMetrics,
 			messaging.EventBus,
@@ -131,7 +143,26 @@ func NewWatcherRegistry(
 		AssetReportWatcher: monitoring.NewAssetReportWatcher(
 			appMetrics,
 			useCaseRegistry.GetAssetsReportUseCase(),
+			useCaseRegistry.createAssetSnapshotUseCase,
 			tickers.AssetReportTicker,
 		),
+		LiquidityLedgerWatcher: watcher.NewLiquidityLedgerWatcher(
+			useCaseRegistry.releaseLiquidityHoldUseCase,
+			useCaseRegistry.reconcileLiquidityLedger,
+			messaging.EventBus,
+			tickers.LiquidityLedgerTicker,
+		),
+		ReportSnapshotWatcher: watcher.NewReportSnapshotWatcher(
+			useCaseRegistry.summariesUseCase,
+			useCaseRegistry.getRevenueReportUseCase,
+			useCaseRegistry.getAssetsReportUseCase,
+			useCaseRegistry.createReportSnapshotsUseCase,
+			env.ReportSnapshot.FillWithDefaults().ScheduleList(),
+			tickers.ReportSnapshotTicker,
+		),
+		AssetDriftWatcher: watcher.NewAssetDriftWatcher(
+			useCaseRegistry.checkAssetDriftUseCase,
+			tickers.AssetDriftTicker,
+		),
 	}
 }
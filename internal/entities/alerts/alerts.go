@@ -1,6 +1,13 @@
 package alerts
 
-import "context"
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+)
 
 // This constants are used to standardize the alert subjects so they can be used in the alerting system
 // Changing one of these constants impacts the external alerting system and there is not an automatic way
@@ -10,8 +17,139 @@ const (
 	AlertSubjectPeginOutOfLiquidity  = "PegIn: Out of liquidity"
 	AlertSubjectPegoutOutOfLiquidity = "PegOut: Out of liquidity"
 	AlertSubjectEclipseAttack        = "Node Eclipse Detected"
+
+	AlertSubjectPeginLowLiquidityWarning   = "PegIn: Low liquidity warning"
+	AlertSubjectPeginLowLiquidityCritical  = "PegIn: Low liquidity critical"
+	AlertSubjectPegoutLowLiquidityWarning  = "PegOut: Low liquidity warning"
+	AlertSubjectPegoutLowLiquidityCritical = "PegOut: Low liquidity critical"
+
+	AlertSubjectDailyReport  = "Daily report"
+	AlertSubjectWeeklyReport = "Weekly report"
+
+	AlertSubjectAssetDrift = "Unexplained asset drift"
+)
+
+var EmptyAlertSubjectError = errors.New("alert subject cannot be empty")
+
+type AlertSeverity string
+
+const (
+	AlertSeverityInfo     AlertSeverity = "info"
+	AlertSeverityWarning  AlertSeverity = "warning"
+	AlertSeverityError    AlertSeverity = "error"
+	AlertSeverityCritical AlertSeverity = "critical"
+)
+
+func (severity AlertSeverity) IsValid() bool {
+	switch severity {
+	case AlertSeverityInfo, AlertSeverityWarning, AlertSeverityError, AlertSeverityCritical:
+		return true
+	default:
+		return false
+	}
+}
+
+// AlertKind identifies the condition that triggered the alert so external systems can act on it
+// without parsing the subject or the message
+type AlertKind string
+
+const (
+	AlertKindPenalization         AlertKind = "penalization"
+	AlertKindPeginOutOfLiquidity  AlertKind = "pegin_out_of_liquidity"
+	AlertKindPegoutOutOfLiquidity AlertKind = "pegout_out_of_liquidity"
+	AlertKindNodeEclipse          AlertKind = "node_eclipse"
+
+	AlertKindPeginLowLiquidityWarning   AlertKind = "pegin_low_liquidity_warning"
+	AlertKindPeginLowLiquidityCritical  AlertKind = "pegin_low_liquidity_critical"
+	AlertKindPegoutLowLiquidityWarning  AlertKind = "pegout_low_liquidity_warning"
+	AlertKindPegoutLowLiquidityCritical AlertKind = "pegout_low_liquidity_critical"
+
+	AlertKindDailyReport  AlertKind = "daily_report"
+	AlertKindWeeklyReport AlertKind = "weekly_report"
+
+	AlertKindAssetDrift AlertKind = "asset_drift"
 )
 
+// AlertStatus indicates if the condition that triggered the alert is still present or if it has been cleared
+type AlertStatus string
+
+const (
+	AlertStatusFiring   AlertStatus = "firing"
+	AlertStatusResolved AlertStatus = "resolved"
+)
+
+// Amount names used as keys of Alert.Amounts
+const (
+	AlertAmountPenalty           = "penalty"
+	AlertAmountRequiredLiquidity = "requiredLiquidity"
+	AlertAmountAvailable         = "availableLiquidity"
+	AlertAmountThreshold         = "threshold"
+	AlertAmountExpectedChange    = "expectedChange"
+	AlertAmountActualChange      = "actualChange"
+	AlertAmountDrift             = "drift"
+)
+
+type Alert struct {
+	Kind        AlertKind                `json:"kind" bson:"kind"`
+	Subject     string                   `json:"subject" bson:"subject"`
+	Severity    AlertSeverity            `json:"severity" bson:"severity"`
+	Status      AlertStatus              `json:"status,omitempty" bson:"status"`
+	Message     string                   `json:"message" bson:"message"`
+	QuoteHash   string                   `json:"quoteHash,omitempty" bson:"quote_hash,omitempty"`
+	Amounts     map[string]*entities.Wei `json:"amounts,omitempty" bson:"amounts,omitempty"`
+	NodeType    entities.NodeType        `json:"nodeType,omitempty" bson:"node_type,omitempty"`
+	BlockNumber uint64                   `json:"blockNumber,omitempty" bson:"block_number,omitempty"`
+	BlockHash   string                   `json:"blockHash,omitempty" bson:"block_hash,omitempty"`
+	Timestamp   time.Time                `json:"timestamp" bson:"timestamp"`
+}
+
+func (alert Alert) Validate() error {
+	if strings.TrimSpace(alert.Subject) == "" {
+		return EmptyAlertSubjectError
+	}
+	return nil
+}
+
+// IsResolved returns true if the alert notifies that a previously reported condition has been cleared.
+// Alerts without status are considered to be firing
+func (alert Alert) IsResolved() bool {
+	return alert.Status == AlertStatusResolved
+}
+
+// DedupKey identifies the condition reported by the alert, so the alerts reporting the same condition
+// can be grouped together. E.g. two penalizations for different quotes are different conditions, but two
+// out of liquidity alerts for the same operation are the same condition
+func (alert Alert) DedupKey() string {
+	key := string(alert.Kind)
+	if key == "" {
+		key = alert.Subject
+	}
+	if alert.QuoteHash != "" {
+		key += ":" + alert.QuoteHash
+	}
+	if alert.NodeType != "" {
+		key += ":" + alert.NodeType
+	}
+	return key
+}
+
+// AlertRecord is the entry of the alert history, it contains the alert and the result of its delivery
+type AlertRecord struct {
+	Alert      `bson:",inline"`
+	DedupKey   string   `json:"dedupKey" bson:"dedup_key"`
+	Recipients []string `json:"recipients" bson:"recipients"`
+	Delivered  bool     `json:"delivered" bson:"delivered"`
+	Error      string   `json:"error,omitempty" bson:"error,omitempty"`
+}
+
+type AlertRepository interface {
+	InsertAlert(ctx context.Context, record AlertRecord) error
+	// GetLastDeliveredAlert returns the most recent alert with the provided dedup key that was delivered
+	// successfully, or nil if there is no such alert
+	GetLastDeliveredAlert(ctx context.Context, dedupKey string) (*AlertRecord, error)
+	GetRecentAlerts(ctx context.Context, limit int64) ([]AlertRecord, error)
+}
+
 type AlertSender interface {
-	SendAlert(ctx context.Context, subject, body string, recipient []string) error
+	SendAlert(ctx context.Context, alert Alert, recipient []string) error
 }
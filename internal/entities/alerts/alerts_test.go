@@ -0,0 +1,52 @@
+package alerts_test
+
+import (
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertSeverity_IsValid(t *testing.T) {
+	for _, severity := range []alerts.AlertSeverity{
+		alerts.AlertSeverityInfo,
+		alerts.AlertSeverityWarning,
+		alerts.AlertSeverityError,
+		alerts.AlertSeverityCritical,
+	} {
+		assert.True(t, severity.IsValid())
+	}
+	assert.False(t, alerts.AlertSeverity("").IsValid())
+	assert.False(t, alerts.AlertSeverity("fatal").IsValid())
+}
+
+func TestAlert_Validate(t *testing.T) {
+	require.NoError(t, alerts.Alert{Subject: alerts.AlertSubjectPenalization}.Validate())
+	require.ErrorIs(t, alerts.Alert{}.Validate(), alerts.EmptyAlertSubjectError)
+	require.ErrorIs(t, alerts.Alert{Subject: " \n\t"}.Validate(), alerts.EmptyAlertSubjectError)
+}
+
+func TestAlert_IsResolved(t *testing.T) {
+	assert.True(t, alerts.Alert{Status: alerts.AlertStatusResolved}.IsResolved())
+	assert.False(t, alerts.Alert{Status: alerts.AlertStatusFiring}.IsResolved())
+	assert.False(t, alerts.Alert{}.IsResolved())
+}
+
+func TestAlert_DedupKey(t *testing.T) {
+	cases := []struct {
+		alert    alerts.Alert
+		expected string
+	}{
+		{alert: alerts.Alert{Kind: alerts.AlertKindPeginOutOfLiquidity, Subject: alerts.AlertSubjectPeginOutOfLiquidity}, expected: "pegin_out_of_liquidity"},
+		{alert: alerts.Alert{Kind: alerts.AlertKindPenalization, QuoteHash: "0x01"}, expected: "penalization:0x01"},
+		{alert: alerts.Alert{Kind: alerts.AlertKindNodeEclipse, NodeType: "bitcoin", BlockNumber: 5}, expected: "node_eclipse:bitcoin"},
+		{alert: alerts.Alert{Subject: "custom subject"}, expected: "custom subject"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, c.alert.DedupKey())
+	}
+	firing := alerts.Alert{Kind: alerts.AlertKindNodeEclipse, NodeType: "rootstock", Status: alerts.AlertStatusFiring}
+	resolved := alerts.Alert{Kind: alerts.AlertKindNodeEclipse, NodeType: "rootstock", Status: alerts.AlertStatusResolved}
+	assert.Equal(t, firing.DedupKey(), resolved.DedupKey())
+}
@@ -41,6 +41,9 @@ var (
 var (
 	BtcAddressInvalidNetworkError = errors.New("address network is not valid")
 	BtcAddressNotSupportedError   = errors.New("btc address not supported")
+	// BtcFeeAlreadyCurrentError is returned when a transaction already pays the current fee rate, so replacing it
+	// wouldn't improve its chances of being confirmed
+	BtcFeeAlreadyCurrentError = errors.New("transaction already pays the current fee rate")
 )
 
 const (
@@ -114,12 +117,29 @@ func IsMainnetBtcAddress(address string) bool {
 		btcMainnetP2TRRegex.MatchString(address)
 }
 
+// BtcFeeRateStrategy is the source of the fee rate used by the liquidity provider to fund its BTC transactions
+type BtcFeeRateStrategy interface {
+	// FeeRate returns the fee rate in BTC/kvB
+	FeeRate() (float64, error)
+}
+
 type BitcoinWallet interface {
 	entities.Closeable
+	// EstimateTxFees estimates the fee of a payment to toAddress. The estimation includes the margin configured
+	// for the quotes, so the fee charged to the user covers the fee rate increases until the payment is sent
 	EstimateTxFees(toAddress string, value *entities.Wei) (BtcFeeEstimation, error)
 	GetBalance() (*entities.Wei, error)
 	SendWithOpReturn(address string, value *entities.Wei, opReturnContent []byte) (BitcoinTransactionResult, error)
+	// BumpFee replaces an unconfirmed transaction sent by the wallet with a copy that pays the current fee rate
+	// (RBF). The fee of the replacement can't be higher than maxFee. Returns BtcFeeAlreadyCurrentError if the
+	// transaction already pays the current fee rate
+	BumpFee(txHash string, maxFee *entities.Wei) (BitcoinTransactionResult, error)
 	CreateUnfundedTransactionWithOpReturn(address string, value *entities.Wei, opReturnContent []byte) ([]byte, error)
+	// SendBatchWithOpReturn sends a single transaction that makes all the payments. Each payment output is followed
+	// by an OP_RETURN output with its content, so the first payment has the same layout as SendWithOpReturn
+	SendBatchWithOpReturn(payments []BitcoinPayment) (BitcoinTransactionResult, error)
+	// CreateUnfundedBatchTransactionWithOpReturn creates the transaction sent by SendBatchWithOpReturn without inputs
+	CreateUnfundedBatchTransactionWithOpReturn(payments []BitcoinPayment) ([]byte, error)
 	ImportAddress(address string) error
 	GetTransactions(address string) ([]BitcoinTransactionInformation, error)
 	Address() string
@@ -206,10 +226,19 @@ type MerkleBranch struct {
 }
 
 type BtcFeeEstimation struct {
-	Value   *entities.Wei
+	// Value is the estimated fee including the quote margin
+	Value *entities.Wei
+	// FeeRate is the fee rate used for the estimation in BTC/kvB, without the quote margin
 	FeeRate *utils.BigFloat
 }
 
+// BitcoinPayment is one of the payments of a transaction sent by BitcoinWallet.SendBatchWithOpReturn
+type BitcoinPayment struct {
+	Address         string
+	Value           *entities.Wei
+	OpReturnContent []byte
+}
+
 type BitcoinTransactionResult struct {
 	Hash string
 	Fee  *entities.Wei
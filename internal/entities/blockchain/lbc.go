@@ -23,6 +23,8 @@ type RegisterPeginParams struct {
 	PartialMerkleTree     []byte
 	BlockHeight           *big.Int
 	Quote                 quote.PeginQuote
+	// GasPrice of the registerPegIn transaction, if nil the gas price suggested by the node is used
+	GasPrice *entities.Wei
 }
 
 func (params RegisterPeginParams) String() string {
@@ -96,6 +98,8 @@ type PeginContract interface {
 	Withdraw(amount *entities.Wei) error
 	HashPeginQuote(peginQuote quote.PeginQuote) (string, error)
 	HashPeginQuoteEIP712(peginQuote quote.PeginQuote) ([32]byte, error)
+	// PeginQuoteTypedData returns the JSON encoded EIP-712 typed data whose hash is the one of HashPeginQuoteEIP712
+	PeginQuoteTypedData(peginQuote quote.PeginQuote) ([]byte, error)
 	CallForUser(txConfig TransactionConfig, peginQuote quote.PeginQuote) (TransactionReceipt, error)
 	RegisterPegin(params RegisterPeginParams) (TransactionReceipt, error)
 }
@@ -105,6 +109,8 @@ type PegoutContract interface {
 	GetAddress() string
 	HashPegoutQuote(pegoutQuote quote.PegoutQuote) (string, error)
 	HashPegoutQuoteEIP712(pegoutQuote quote.PegoutQuote) ([32]byte, error)
+	// PegoutQuoteTypedData returns the JSON encoded EIP-712 typed data whose hash is the one of HashPegoutQuoteEIP712
+	PegoutQuoteTypedData(pegoutQuote quote.PegoutQuote) ([]byte, error)
 	RefundUserPegOut(quoteHash string) (string, error)
 	IsPegOutQuoteCompleted(quoteHash string) (bool, error)
 	GetDepositEvents(ctx context.Context, fromBlock uint64, toBlock *uint64) ([]quote.PegoutDeposit, error)
@@ -94,9 +94,20 @@ func NewTransactionConfig(value *entities.Wei, gasLimit uint64, gasPrice *entiti
 	return TransactionConfig{Value: value, GasLimit: gas, GasPrice: gasPrice}
 }
 
+// GasPriceStrategy computes the gas price used by the liquidity provider, both to calculate the gas fees
+// of the quotes and to send its own transactions, so the quoted gas covers what is actually spent. The gas price
+// of the quotes can include a safety margin over the one of the transactions
+type GasPriceStrategy interface {
+	GasPrice(ctx context.Context) (*entities.Wei, error)
+}
+
 type RootstockRpcServer interface {
 	EstimateGas(ctx context.Context, addr string, value *entities.Wei, data []byte) (*entities.Wei, error)
+	// GasPrice returns the gas price according to the GasPriceStrategy configured for the server
 	GasPrice(ctx context.Context) (*entities.Wei, error)
+	// QuoteGasPrice returns the gas price used to calculate the gas fee of the quotes. It is the GasPrice plus
+	// the safety margin configured for the quotes
+	QuoteGasPrice(ctx context.Context) (*entities.Wei, error)
 	GetHeight(ctx context.Context) (uint64, error)
 	GetTransactionReceipt(ctx context.Context, hash string) (TransactionReceipt, error)
 	GetBalance(ctx context.Context, address string) (*entities.Wei, error)
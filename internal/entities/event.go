@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"context"
 	"sync"
 	"time"
 )
@@ -37,6 +38,42 @@ type EventBus interface {
 	Shutdown(chan<- bool)
 }
 
+// DurableEventBus is an EventBus that persists the published events, so a subscriber identified by
+// a name can resume from the last event it received after a crash or restart
+type DurableEventBus interface {
+	EventBus
+	SubscribeDurable(subscriber string, id EventId) <-chan Event
+}
+
+// SubscribeDurable subscribes to the events with the provided id using the durable subscription
+// if the bus supports it, otherwise it falls back to a regular subscription
+func SubscribeDurable(bus EventBus, subscriber string, id EventId) <-chan Event {
+	if durableBus, ok := bus.(DurableEventBus); ok {
+		return durableBus.SubscribeDurable(subscriber, id)
+	}
+	return bus.Subscribe(id)
+}
+
+// EventRecord is the persisted representation of an event. The Sequence is assigned by the
+// bus and is strictly increasing, the Payload is the serialized event
+type EventRecord struct {
+	Sequence  uint64    `json:"sequence" bson:"sequence"`
+	EventId   EventId   `json:"eventId" bson:"event_id"`
+	Timestamp time.Time `json:"timestamp" bson:"timestamp"`
+	Error     string    `json:"error,omitempty" bson:"error,omitempty"`
+	Payload   []byte    `json:"payload" bson:"payload"`
+}
+
+type EventRepository interface {
+	InsertEvent(ctx context.Context, record EventRecord) error
+	GetLastSequence(ctx context.Context) (uint64, error)
+	// GetEvents returns the events with the provided id and a sequence greater than fromSequence ordered by sequence
+	GetEvents(ctx context.Context, id EventId, fromSequence uint64, limit int64) ([]EventRecord, error)
+	// GetSubscriberOffset returns the sequence of the last event delivered to the subscriber, zero if there is none
+	GetSubscriberOffset(ctx context.Context, subscriber string, id EventId) (uint64, error)
+	UpsertSubscriberOffset(ctx context.Context, subscriber string, id EventId, sequence uint64) error
+}
+
 type ApplicationMutexes interface {
 	RskWalletMutex() *sync.Mutex
 	BtcWalletMutex() *sync.Mutex
@@ -2,6 +2,8 @@ package entities_test
 
 import (
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
@@ -12,3 +14,23 @@ func TestNewBaseEvent(t *testing.T) {
 		t.Error("Base event not initialized properly")
 	}
 }
+
+func TestSubscribeDurable(t *testing.T) {
+	var id entities.EventId = "any id"
+	t.Run("Should use durable subscription when the bus supports it", func(t *testing.T) {
+		bus := &mocks.DurableEventBusMock{}
+		channel := make(<-chan entities.Event)
+		bus.On("SubscribeDurable", "subscriber", id).Return(channel).Once()
+		result := entities.SubscribeDurable(bus, "subscriber", id)
+		assert.Equal(t, channel, result)
+		bus.AssertExpectations(t)
+	})
+	t.Run("Should fall back to regular subscription", func(t *testing.T) {
+		bus := &mocks.EventBusMock{}
+		channel := make(<-chan entities.Event)
+		bus.On("Subscribe", id).Return(channel).Once()
+		result := entities.SubscribeDurable(bus, "subscriber", id)
+		assert.Equal(t, channel, result)
+		bus.AssertExpectations(t)
+	})
+}
@@ -11,7 +11,8 @@ import (
 )
 
 var (
-	AmountOutOfRangeError = errors.New("amount out of range")
+	AmountOutOfRangeError          = errors.New("amount out of range")
+	InvalidLiquidityThresholdError = errors.New("invalid liquidity threshold")
 )
 
 // ConfirmationsPerAmount the key represents the amount in wei serialized as a string, and the value represents the number of confirmations required for that amount.
@@ -25,6 +26,8 @@ type PeginConfiguration struct {
 	FeePercentage  *utils.BigFloat `json:"feePercentage" bson:"fee_percentage" validate:"required"`
 	MaxValue       *entities.Wei   `json:"maxValue" bson:"max_value" validate:"required"`
 	MinValue       *entities.Wei   `json:"minValue" bson:"min_value" validate:"required"`
+	FeeTiers       FeeTiers        `json:"feeTiers,omitempty" bson:"fee_tiers,omitempty"`
+	FeeSurcharges  *FeeSurcharges  `json:"feeSurcharges,omitempty" bson:"fee_surcharges,omitempty"`
 }
 
 func (config PeginConfiguration) ValidateAmount(amount *entities.Wei) error {
@@ -39,6 +42,40 @@ func (config PeginConfiguration) GetFeePercentage() *utils.BigFloat {
 	return config.FeePercentage
 }
 
+// ValidateFeeSchedule validates the fee tiers and surcharges of the configuration and that the combined fee
+// percentage of a quote can't reach 100
+func (config PeginConfiguration) ValidateFeeSchedule() error {
+	return validateFeeSchedule(config.FeePercentage, config.FeeTiers, config.FeeSurcharges)
+}
+
+// ForQuote returns a copy of the configuration where FixedFee and FeePercentage are the fees that apply to
+// a quote of the given amount, after applying the fee tiers and the surcharges for the given conditions
+func (config PeginConfiguration) ForQuote(amount *entities.Wei, conditions FeeConditions) PeginConfiguration {
+	config.FixedFee, config.FeePercentage = resolveFees(
+		config.FixedFee, config.FeePercentage, config.FeeTiers, config.FeeSurcharges, config.MaxValue, amount, conditions,
+	)
+	return config
+}
+
+// WithPartnerTerms returns a copy of the configuration with the custom terms of a trusted account applied.
+// If the terms set any of the fees, the fee tiers and surcharges of the configuration are not applied.
+func (config PeginConfiguration) WithPartnerTerms(terms *PartnerTerms) PeginConfiguration {
+	if terms == nil {
+		return config
+	}
+	if terms.overridesFees() {
+		config.FeeTiers, config.FeeSurcharges = nil, nil
+	}
+	config.FixedFee = overrideValue(config.FixedFee, terms.FixedFee)
+	config.FeePercentage = overrideValue(config.FeePercentage, terms.FeePercentage)
+	config.MinValue = overrideValue(config.MinValue, terms.MinValue)
+	config.MaxValue = overrideValue(config.MaxValue, terms.MaxValue)
+	if terms.QuoteValidity != 0 {
+		config.TimeForDeposit = terms.QuoteValidity
+	}
+	return config
+}
+
 type PegoutConfiguration struct {
 	TimeForDeposit       uint32          `json:"timeForDeposit" bson:"time_for_deposit" validate:"required"`
 	ExpireTime           uint32          `json:"expireTime" bson:"expire_time" validate:"required"`
@@ -49,6 +86,8 @@ type PegoutConfiguration struct {
 	MinValue             *entities.Wei   `json:"minValue" bson:"min_value" validate:"required"`
 	ExpireBlocks         uint64          `json:"expireBlocks" bson:"expire_blocks" validate:"required"`
 	BridgeTransactionMin *entities.Wei   `json:"bridgeTransactionMin" bson:"bridge_transaction_min" validate:"required"`
+	FeeTiers             FeeTiers        `json:"feeTiers,omitempty" bson:"fee_tiers,omitempty"`
+	FeeSurcharges        *FeeSurcharges  `json:"feeSurcharges,omitempty" bson:"fee_surcharges,omitempty"`
 }
 
 func (config PegoutConfiguration) ValidateAmount(amount *entities.Wei) error {
@@ -63,10 +102,102 @@ func (config PegoutConfiguration) GetFeePercentage() *utils.BigFloat {
 	return config.FeePercentage
 }
 
+// ValidateFeeSchedule validates the fee tiers and surcharges of the configuration and that the combined fee
+// percentage of a quote can't reach 100
+func (config PegoutConfiguration) ValidateFeeSchedule() error {
+	return validateFeeSchedule(config.FeePercentage, config.FeeTiers, config.FeeSurcharges)
+}
+
+// ForQuote returns a copy of the configuration where FixedFee and FeePercentage are the fees that apply to
+// a quote of the given amount, after applying the fee tiers and the surcharges for the given conditions
+func (config PegoutConfiguration) ForQuote(amount *entities.Wei, conditions FeeConditions) PegoutConfiguration {
+	config.FixedFee, config.FeePercentage = resolveFees(
+		config.FixedFee, config.FeePercentage, config.FeeTiers, config.FeeSurcharges, config.MaxValue, amount, conditions,
+	)
+	return config
+}
+
+// WithPartnerTerms returns a copy of the configuration with the custom terms of a trusted account applied.
+// If the terms set any of the fees, the fee tiers and surcharges of the configuration are not applied.
+func (config PegoutConfiguration) WithPartnerTerms(terms *PartnerTerms) PegoutConfiguration {
+	if terms == nil {
+		return config
+	}
+	if terms.overridesFees() {
+		config.FeeTiers, config.FeeSurcharges = nil, nil
+	}
+	config.FixedFee = overrideValue(config.FixedFee, terms.FixedFee)
+	config.FeePercentage = overrideValue(config.FeePercentage, terms.FeePercentage)
+	config.MinValue = overrideValue(config.MinValue, terms.MinValue)
+	config.MaxValue = overrideValue(config.MaxValue, terms.MaxValue)
+	if terms.QuoteValidity != 0 {
+		config.ExpireTime = terms.QuoteValidity
+	}
+	return config
+}
+
 type GeneralConfiguration struct {
 	RskConfirmations     ConfirmationsPerAmount `json:"rskConfirmations" bson:"rsk_confirmations" validate:"required"`
 	BtcConfirmations     ConfirmationsPerAmount `json:"btcConfirmations" bson:"btc_confirmations" validate:"required"`
 	PublicLiquidityCheck bool                   `json:"publicLiquidityCheck" bson:"public_liquidity_check" validate:""`
+	LiquidityThresholds  *LiquidityThresholds   `json:"liquidityThresholds,omitempty" bson:"liquidity_thresholds,omitempty" validate:""`
+}
+
+// LiquidityThreshold is a level of available liquidity under which the liquidity provider is alerted. It can
+// be set either as an absolute Amount or as a multiple of the MaxValue of the operation configuration.
+type LiquidityThreshold struct {
+	Amount             *entities.Wei   `json:"amount,omitempty" bson:"amount,omitempty"`
+	MaxValueMultiplier *utils.BigFloat `json:"maxValueMultiplier,omitempty" bson:"max_value_multiplier,omitempty"`
+}
+
+func (threshold LiquidityThreshold) Validate() error {
+	if (threshold.Amount == nil) == (threshold.MaxValueMultiplier == nil) {
+		return fmt.Errorf("%w: exactly one of amount or maxValueMultiplier must be set", InvalidLiquidityThresholdError)
+	}
+	if threshold.Amount != nil && threshold.Amount.Cmp(entities.NewWei(0)) <= 0 {
+		return fmt.Errorf("%w: amount must be positive", InvalidLiquidityThresholdError)
+	}
+	if threshold.MaxValueMultiplier != nil && threshold.MaxValueMultiplier.Native().Sign() <= 0 {
+		return fmt.Errorf("%w: maxValueMultiplier must be positive", InvalidLiquidityThresholdError)
+	}
+	return nil
+}
+
+// ToWei returns the threshold amount in wei, using the provided MaxValue if the threshold is relative to it
+func (threshold LiquidityThreshold) ToWei(maxValue *entities.Wei) *entities.Wei {
+	if threshold.Amount != nil {
+		return threshold.Amount.Copy()
+	}
+	result, _ := new(big.Float).Mul(new(big.Float).SetInt(maxValue.AsBigInt()), threshold.MaxValueMultiplier.Native()).Int(nil)
+	return entities.NewBigWei(result)
+}
+
+// OperationLiquidityThresholds are the low liquidity thresholds of one operation, any of them can be nil
+// if the liquidity provider doesn't want to be alerted at that level
+type OperationLiquidityThresholds struct {
+	Warning  *LiquidityThreshold `json:"warning,omitempty" bson:"warning,omitempty"`
+	Critical *LiquidityThreshold `json:"critical,omitempty" bson:"critical,omitempty"`
+}
+
+func (thresholds OperationLiquidityThresholds) Validate(maxValue *entities.Wei) error {
+	for _, threshold := range []*LiquidityThreshold{thresholds.Warning, thresholds.Critical} {
+		if threshold == nil {
+			continue
+		}
+		if err := threshold.Validate(); err != nil {
+			return err
+		}
+	}
+	if thresholds.Warning != nil && thresholds.Critical != nil &&
+		thresholds.Critical.ToWei(maxValue).Cmp(thresholds.Warning.ToWei(maxValue)) > 0 {
+		return fmt.Errorf("%w: critical threshold can't be greater than warning threshold", InvalidLiquidityThresholdError)
+	}
+	return nil
+}
+
+type LiquidityThresholds struct {
+	Pegin  OperationLiquidityThresholds `json:"pegin" bson:"pegin"`
+	Pegout OperationLiquidityThresholds `json:"pegout" bson:"pegout"`
 }
 
 type HashedCredentials struct {
@@ -3,7 +3,9 @@ package liquidity_provider_test
 import (
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
 	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -121,3 +123,82 @@ func TestPegoutConfiguration_ValidateAmount(t *testing.T) {
 		require.ErrorIs(t, err, item.Result)
 	}
 }
+
+func TestLiquidityThreshold_Validate(t *testing.T) {
+	table := test.Table[liquidity_provider.LiquidityThreshold, error]{
+		{
+			Value:  liquidity_provider.LiquidityThreshold{Amount: entities.NewWei(1)},
+			Result: nil,
+		},
+		{
+			Value:  liquidity_provider.LiquidityThreshold{MaxValueMultiplier: utils.NewBigFloat64(0.5)},
+			Result: nil,
+		},
+		{
+			Value:  liquidity_provider.LiquidityThreshold{},
+			Result: liquidity_provider.InvalidLiquidityThresholdError,
+		},
+		{
+			Value:  liquidity_provider.LiquidityThreshold{Amount: entities.NewWei(1), MaxValueMultiplier: utils.NewBigFloat64(1)},
+			Result: liquidity_provider.InvalidLiquidityThresholdError,
+		},
+		{
+			Value:  liquidity_provider.LiquidityThreshold{Amount: entities.NewWei(0)},
+			Result: liquidity_provider.InvalidLiquidityThresholdError,
+		},
+		{
+			Value:  liquidity_provider.LiquidityThreshold{MaxValueMultiplier: utils.NewBigFloat64(-1)},
+			Result: liquidity_provider.InvalidLiquidityThresholdError,
+		},
+	}
+	for _, item := range table {
+		err := item.Value.Validate()
+		require.ErrorIs(t, err, item.Result)
+	}
+}
+
+func TestLiquidityThreshold_ToWei(t *testing.T) {
+	maxValue := entities.NewWei(1000)
+	t.Run("should return absolute amount", func(t *testing.T) {
+		threshold := liquidity_provider.LiquidityThreshold{Amount: entities.NewWei(500)}
+		assert.Equal(t, entities.NewWei(500), threshold.ToWei(maxValue))
+	})
+	t.Run("should return multiple of max value", func(t *testing.T) {
+		threshold := liquidity_provider.LiquidityThreshold{MaxValueMultiplier: utils.NewBigFloat64(2.5)}
+		assert.Equal(t, entities.NewWei(2500), threshold.ToWei(maxValue))
+	})
+}
+
+func TestOperationLiquidityThresholds_Validate(t *testing.T) {
+	maxValue := entities.NewWei(1000)
+	table := test.Table[liquidity_provider.OperationLiquidityThresholds, error]{
+		{
+			Value:  liquidity_provider.OperationLiquidityThresholds{},
+			Result: nil,
+		},
+		{
+			Value: liquidity_provider.OperationLiquidityThresholds{
+				Warning:  &liquidity_provider.LiquidityThreshold{MaxValueMultiplier: utils.NewBigFloat64(3)},
+				Critical: &liquidity_provider.LiquidityThreshold{Amount: entities.NewWei(1000)},
+			},
+			Result: nil,
+		},
+		{
+			Value: liquidity_provider.OperationLiquidityThresholds{
+				Warning:  &liquidity_provider.LiquidityThreshold{MaxValueMultiplier: utils.NewBigFloat64(1)},
+				Critical: &liquidity_provider.LiquidityThreshold{Amount: entities.NewWei(1001)},
+			},
+			Result: liquidity_provider.InvalidLiquidityThresholdError,
+		},
+		{
+			Value: liquidity_provider.OperationLiquidityThresholds{
+				Critical: &liquidity_provider.LiquidityThreshold{},
+			},
+			Result: liquidity_provider.InvalidLiquidityThresholdError,
+		},
+	}
+	for _, item := range table {
+		err := item.Value.Validate(maxValue)
+		require.ErrorIs(t, err, item.Result)
+	}
+}
@@ -0,0 +1,232 @@
+package liquidity_provider
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+)
+
+const (
+	maxFeePercentage = 100
+	hoursPerDay      = 24
+)
+
+var InvalidFeeScheduleError = errors.New("invalid fee schedule")
+
+// FeeTier replaces the base FixedFee and FeePercentage of an operation configuration for the quotes whose
+// amount is lower or equal than MaxAmount. The tier with the lowest MaxAmount that covers the quote amount is
+// the one applied, if no tier covers the amount then the base fees of the configuration are used.
+type FeeTier struct {
+	MaxAmount     *entities.Wei   `json:"maxAmount" bson:"max_amount"`
+	FixedFee      *entities.Wei   `json:"fixedFee" bson:"fixed_fee"`
+	FeePercentage *utils.BigFloat `json:"feePercentage" bson:"fee_percentage"`
+}
+
+type FeeTiers []FeeTier
+
+func (tiers FeeTiers) Validate() error {
+	maxAmounts := make(map[string]struct{}, len(tiers))
+	for _, tier := range tiers {
+		if tier.MaxAmount == nil || tier.FixedFee == nil || tier.FeePercentage == nil {
+			return fmt.Errorf("%w: maxAmount, fixedFee and feePercentage are required in every tier", InvalidFeeScheduleError)
+		}
+		if tier.MaxAmount.Cmp(entities.NewWei(0)) <= 0 {
+			return fmt.Errorf("%w: tier maxAmount must be positive", InvalidFeeScheduleError)
+		}
+		if tier.FixedFee.Cmp(entities.NewWei(0)) < 0 {
+			return fmt.Errorf("%w: tier fixedFee can't be negative", InvalidFeeScheduleError)
+		}
+		if err := validateFeePercentage(tier.FeePercentage); err != nil {
+			return err
+		}
+		if _, repeated := maxAmounts[tier.MaxAmount.String()]; repeated {
+			return fmt.Errorf("%w: repeated tier maxAmount %s", InvalidFeeScheduleError, tier.MaxAmount.String())
+		}
+		maxAmounts[tier.MaxAmount.String()] = struct{}{}
+	}
+	return nil
+}
+
+// ForValue returns the tier that applies to the given amount, or nil if there is no tier covering it
+func (tiers FeeTiers) ForValue(value *entities.Wei) *FeeTier {
+	sorted := slices.Clone(tiers)
+	slices.SortFunc(sorted, func(a, b FeeTier) int {
+		return a.MaxAmount.Cmp(b.MaxAmount)
+	})
+	index := slices.IndexFunc(sorted, func(tier FeeTier) bool {
+		return value.Cmp(tier.MaxAmount) <= 0
+	})
+	if index == -1 {
+		return nil
+	}
+	return &sorted[index]
+}
+
+// UtilizationSurcharge adds FeePercentage to the fee percentage of the quotes created while the available
+// liquidity of the operation is lower or equal than Threshold.
+type UtilizationSurcharge struct {
+	Threshold     LiquidityThreshold `json:"threshold" bson:"threshold"`
+	FeePercentage *utils.BigFloat    `json:"feePercentage" bson:"fee_percentage"`
+}
+
+// TimeOfDaySurcharge adds FeePercentage to the fee percentage of the quotes created between StartHour
+// (inclusive) and EndHour (exclusive) in UTC. If StartHour is greater than EndHour the window wraps around midnight.
+type TimeOfDaySurcharge struct {
+	StartHour     uint8           `json:"startHour" bson:"start_hour"`
+	EndHour       uint8           `json:"endHour" bson:"end_hour"`
+	FeePercentage *utils.BigFloat `json:"feePercentage" bson:"fee_percentage"`
+}
+
+func (surcharge TimeOfDaySurcharge) appliesAt(moment time.Time) bool {
+	hour := uint8(moment.UTC().Hour()) // nolint:gosec
+	if surcharge.StartHour < surcharge.EndHour {
+		return hour >= surcharge.StartHour && hour < surcharge.EndHour
+	}
+	return hour >= surcharge.StartHour || hour < surcharge.EndHour
+}
+
+// FeeSurcharges are the dynamic increments of the fee percentage of an operation. From each kind of surcharge
+// only the highest applicable one is taken, and the result of both kinds is added to the fee percentage.
+type FeeSurcharges struct {
+	Utilization []UtilizationSurcharge `json:"utilization,omitempty" bson:"utilization,omitempty"`
+	TimeOfDay   []TimeOfDaySurcharge   `json:"timeOfDay,omitempty" bson:"time_of_day,omitempty"`
+}
+
+func (surcharges FeeSurcharges) Validate() error {
+	for _, surcharge := range surcharges.Utilization {
+		if err := surcharge.Threshold.Validate(); err != nil {
+			return fmt.Errorf("%w: %w", InvalidFeeScheduleError, err)
+		}
+		if err := validateFeePercentage(surcharge.FeePercentage); err != nil {
+			return err
+		}
+	}
+	for _, surcharge := range surcharges.TimeOfDay {
+		if surcharge.StartHour >= hoursPerDay || surcharge.EndHour >= hoursPerDay {
+			return fmt.Errorf("%w: surcharge hours must be between 0 and 23", InvalidFeeScheduleError)
+		}
+		if surcharge.StartHour == surcharge.EndHour {
+			return fmt.Errorf("%w: surcharge startHour and endHour can't be equal", InvalidFeeScheduleError)
+		}
+		if err := validateFeePercentage(surcharge.FeePercentage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DependsOnLiquidity returns true if the available liquidity is required to calculate the surcharges
+func (surcharges *FeeSurcharges) DependsOnLiquidity() bool {
+	return surcharges != nil && len(surcharges.Utilization) > 0
+}
+
+// percentage returns the total surcharge percentage for the given conditions
+func (surcharges *FeeSurcharges) percentage(maxValue *entities.Wei, conditions FeeConditions) *big.Float {
+	utilization, timeOfDay := new(big.Float), new(big.Float)
+	if surcharges == nil {
+		return utilization
+	}
+	if conditions.AvailableLiquidity != nil {
+		for _, surcharge := range surcharges.Utilization {
+			if conditions.AvailableLiquidity.Cmp(surcharge.Threshold.ToWei(maxValue)) <= 0 &&
+				surcharge.FeePercentage.Native().Cmp(utilization) > 0 {
+				utilization = surcharge.FeePercentage.Native()
+			}
+		}
+	}
+	for _, surcharge := range surcharges.TimeOfDay {
+		if surcharge.appliesAt(conditions.Time) && surcharge.FeePercentage.Native().Cmp(timeOfDay) > 0 {
+			timeOfDay = surcharge.FeePercentage.Native()
+		}
+	}
+	return new(big.Float).Add(utilization, timeOfDay)
+}
+
+// FeeConditions are the circumstances under which a quote is being created. AvailableLiquidity
+// can be nil if there are no utilization surcharges configured.
+type FeeConditions struct {
+	AvailableLiquidity *entities.Wei
+	Time               time.Time
+}
+
+// resolveFees returns the fixed fee and fee percentage that apply to a quote of the given amount
+func resolveFees(
+	fixedFee *entities.Wei,
+	feePercentage *utils.BigFloat,
+	tiers FeeTiers,
+	surcharges *FeeSurcharges,
+	maxValue *entities.Wei,
+	amount *entities.Wei,
+	conditions FeeConditions,
+) (*entities.Wei, *utils.BigFloat) {
+	if tier := tiers.ForValue(amount); tier != nil {
+		fixedFee, feePercentage = tier.FixedFee, tier.FeePercentage
+	}
+	percentage := new(big.Float).Add(feePercentage.Native(), surcharges.percentage(maxValue, conditions))
+	return fixedFee.Copy(), utils.NewBigFloat(percentage)
+}
+
+// maxPercentage returns the highest surcharge percentage that can be applied to a quote
+func (surcharges *FeeSurcharges) maxPercentage() *big.Float {
+	utilization, timeOfDay := new(big.Float), new(big.Float)
+	if surcharges == nil {
+		return utilization
+	}
+	for _, surcharge := range surcharges.Utilization {
+		if surcharge.FeePercentage.Native().Cmp(utilization) > 0 {
+			utilization = surcharge.FeePercentage.Native()
+		}
+	}
+	for _, surcharge := range surcharges.TimeOfDay {
+		if surcharge.FeePercentage.Native().Cmp(timeOfDay) > 0 {
+			timeOfDay = surcharge.FeePercentage.Native()
+		}
+	}
+	return new(big.Float).Add(utilization, timeOfDay)
+}
+
+// validateFeeSchedule validates the tiers and surcharges and that the highest fee percentage that can be applied
+// to a quote, which is the highest between the base and the tiers percentage plus the highest surcharges, is lower
+// than 100
+func validateFeeSchedule(feePercentage *utils.BigFloat, tiers FeeTiers, surcharges *FeeSurcharges) error {
+	if err := validateFeePercentage(feePercentage); err != nil {
+		return err
+	}
+	if err := tiers.Validate(); err != nil {
+		return err
+	}
+	if surcharges != nil {
+		if err := surcharges.Validate(); err != nil {
+			return err
+		}
+	}
+	highest := feePercentage.Native()
+	for _, tier := range tiers {
+		if tier.FeePercentage.Native().Cmp(highest) > 0 {
+			highest = tier.FeePercentage.Native()
+		}
+	}
+	combined := new(big.Float).Add(highest, surcharges.maxPercentage())
+	if combined.Cmp(big.NewFloat(maxFeePercentage)) >= 0 {
+		return fmt.Errorf(
+			"%w: the combined fee percentage can reach %s, it must be lower than %d",
+			InvalidFeeScheduleError, combined.Text('f', -1), maxFeePercentage,
+		)
+	}
+	return nil
+}
+
+func validateFeePercentage(percentage *utils.BigFloat) error {
+	if percentage == nil {
+		return fmt.Errorf("%w: feePercentage is required", InvalidFeeScheduleError)
+	}
+	if percentage.Native().Sign() < 0 || percentage.Native().Cmp(big.NewFloat(maxFeePercentage)) >= 0 {
+		return fmt.Errorf("%w: feePercentage must be between 0 and %d (exclusive)", InvalidFeeScheduleError, maxFeePercentage)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package liquidity_provider_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testFeeTiers = liquidity_provider.FeeTiers{
+	{MaxAmount: entities.NewWei(1000), FixedFee: entities.NewWei(30), FeePercentage: utils.NewBigFloat64(0.5)},
+	{MaxAmount: entities.NewWei(100), FixedFee: entities.NewWei(50), FeePercentage: utils.NewBigFloat64(1)},
+}
+
+func TestFeeTiers_ForValue(t *testing.T) {
+	assert.Equal(t, entities.NewWei(50), testFeeTiers.ForValue(entities.NewWei(1)).FixedFee)
+	assert.Equal(t, entities.NewWei(50), testFeeTiers.ForValue(entities.NewWei(100)).FixedFee)
+	assert.Equal(t, entities.NewWei(30), testFeeTiers.ForValue(entities.NewWei(101)).FixedFee)
+	assert.Equal(t, entities.NewWei(30), testFeeTiers.ForValue(entities.NewWei(1000)).FixedFee)
+	assert.Nil(t, testFeeTiers.ForValue(entities.NewWei(1001)))
+	assert.Nil(t, liquidity_provider.FeeTiers{}.ForValue(entities.NewWei(1)))
+	// the original order must be kept
+	assert.Equal(t, entities.NewWei(1000), testFeeTiers[0].MaxAmount)
+}
+
+func TestFeeTiers_Validate(t *testing.T) {
+	require.NoError(t, testFeeTiers.Validate())
+	require.NoError(t, liquidity_provider.FeeTiers{}.Validate())
+	invalidTiers := []liquidity_provider.FeeTiers{
+		{{MaxAmount: entities.NewWei(1), FixedFee: entities.NewWei(1)}},
+		{{MaxAmount: entities.NewWei(0), FixedFee: entities.NewWei(1), FeePercentage: utils.NewBigFloat64(1)}},
+		{{MaxAmount: entities.NewWei(1), FixedFee: entities.NewWei(-1), FeePercentage: utils.NewBigFloat64(1)}},
+		{{MaxAmount: entities.NewWei(1), FixedFee: entities.NewWei(1), FeePercentage: utils.NewBigFloat64(-1)}},
+		{{MaxAmount: entities.NewWei(1), FixedFee: entities.NewWei(1), FeePercentage: utils.NewBigFloat64(100.1)}},
+		{{MaxAmount: entities.NewWei(1), FixedFee: entities.NewWei(1), FeePercentage: utils.NewBigFloat64(100)}},
+		{
+			{MaxAmount: entities.NewWei(1), FixedFee: entities.NewWei(1), FeePercentage: utils.NewBigFloat64(1)},
+			{MaxAmount: entities.NewWei(1), FixedFee: entities.NewWei(2), FeePercentage: utils.NewBigFloat64(2)},
+		},
+	}
+	for _, tiers := range invalidTiers {
+		require.ErrorIs(t, tiers.Validate(), liquidity_provider.InvalidFeeScheduleError)
+	}
+}
+
+func TestFeeSurcharges_Validate(t *testing.T) {
+	amount := entities.NewWei(5)
+	valid := liquidity_provider.FeeSurcharges{
+		Utilization: []liquidity_provider.UtilizationSurcharge{
+			{Threshold: liquidity_provider.LiquidityThreshold{Amount: amount}, FeePercentage: utils.NewBigFloat64(0.5)},
+		},
+		TimeOfDay: []liquidity_provider.TimeOfDaySurcharge{
+			{StartHour: 22, EndHour: 6, FeePercentage: utils.NewBigFloat64(0.1)},
+		},
+	}
+	require.NoError(t, valid.Validate())
+	invalidSurcharges := []liquidity_provider.FeeSurcharges{
+		{Utilization: []liquidity_provider.UtilizationSurcharge{{FeePercentage: utils.NewBigFloat64(0.5)}}},
+		{Utilization: []liquidity_provider.UtilizationSurcharge{{Threshold: liquidity_provider.LiquidityThreshold{Amount: amount}}}},
+		{TimeOfDay: []liquidity_provider.TimeOfDaySurcharge{{StartHour: 24, EndHour: 6, FeePercentage: utils.NewBigFloat64(1)}}},
+		{TimeOfDay: []liquidity_provider.TimeOfDaySurcharge{{StartHour: 6, EndHour: 6, FeePercentage: utils.NewBigFloat64(1)}}},
+		{TimeOfDay: []liquidity_provider.TimeOfDaySurcharge{{StartHour: 1, EndHour: 6, FeePercentage: utils.NewBigFloat64(101)}}},
+	}
+	for _, surcharges := range invalidSurcharges {
+		require.ErrorIs(t, surcharges.Validate(), liquidity_provider.InvalidFeeScheduleError)
+	}
+}
+
+func TestFeeSurcharges_DependsOnLiquidity(t *testing.T) {
+	var nilSurcharges *liquidity_provider.FeeSurcharges
+	assert.False(t, nilSurcharges.DependsOnLiquidity())
+	assert.False(t, (&liquidity_provider.FeeSurcharges{TimeOfDay: []liquidity_provider.TimeOfDaySurcharge{{}}}).DependsOnLiquidity())
+	assert.True(t, (&liquidity_provider.FeeSurcharges{Utilization: []liquidity_provider.UtilizationSurcharge{{}}}).DependsOnLiquidity())
+}
+
+// nolint:funlen
+func TestPeginConfiguration_ForQuote(t *testing.T) {
+	config := liquidity_provider.PeginConfiguration{
+		FixedFee:      entities.NewWei(10),
+		FeePercentage: utils.NewBigFloat64(0.25),
+		MaxValue:      entities.NewWei(2000),
+		FeeTiers:      testFeeTiers,
+		FeeSurcharges: &liquidity_provider.FeeSurcharges{
+			Utilization: []liquidity_provider.UtilizationSurcharge{
+				{Threshold: liquidity_provider.LiquidityThreshold{MaxValueMultiplier: utils.NewBigFloat64(2)}, FeePercentage: utils.NewBigFloat64(0.1)},
+				{Threshold: liquidity_provider.LiquidityThreshold{Amount: entities.NewWei(2000)}, FeePercentage: utils.NewBigFloat64(0.3)},
+			},
+			TimeOfDay: []liquidity_provider.TimeOfDaySurcharge{
+				{StartHour: 22, EndHour: 2, FeePercentage: utils.NewBigFloat64(0.05)},
+				{StartHour: 0, EndHour: 1, FeePercentage: utils.NewBigFloat64(0.01)},
+			},
+		},
+	}
+	noon := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	midnight := time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)
+	cases := []struct {
+		name          string
+		amount        *entities.Wei
+		conditions    liquidity_provider.FeeConditions
+		fixedFee      *entities.Wei
+		feePercentage float64
+	}{
+		{name: "first tier", amount: entities.NewWei(50), conditions: liquidity_provider.FeeConditions{Time: noon}, fixedFee: entities.NewWei(50), feePercentage: 1},
+		{name: "second tier", amount: entities.NewWei(500), conditions: liquidity_provider.FeeConditions{Time: noon}, fixedFee: entities.NewWei(30), feePercentage: 0.5},
+		{name: "above tiers", amount: entities.NewWei(1500), conditions: liquidity_provider.FeeConditions{Time: noon}, fixedFee: entities.NewWei(10), feePercentage: 0.25},
+		{
+			name: "enough liquidity", amount: entities.NewWei(1500), fixedFee: entities.NewWei(10), feePercentage: 0.25,
+			conditions: liquidity_provider.FeeConditions{Time: noon, AvailableLiquidity: entities.NewWei(4001)},
+		},
+		{
+			name: "under relative threshold", amount: entities.NewWei(1500), fixedFee: entities.NewWei(10), feePercentage: 0.35,
+			conditions: liquidity_provider.FeeConditions{Time: noon, AvailableLiquidity: entities.NewWei(4000)},
+		},
+		{
+			name: "under both thresholds", amount: entities.NewWei(1500), fixedFee: entities.NewWei(10), feePercentage: 0.55,
+			conditions: liquidity_provider.FeeConditions{Time: noon, AvailableLiquidity: entities.NewWei(1000)},
+		},
+		{
+			name: "time window wrapping midnight", amount: entities.NewWei(500), fixedFee: entities.NewWei(30), feePercentage: 0.55,
+			conditions: liquidity_provider.FeeConditions{Time: midnight},
+		},
+		{
+			name: "all surcharges", amount: entities.NewWei(500), fixedFee: entities.NewWei(30), feePercentage: 0.85,
+			conditions: liquidity_provider.FeeConditions{Time: midnight, AvailableLiquidity: entities.NewWei(1)},
+		},
+	}
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := config.ForQuote(testCase.amount, testCase.conditions)
+			assert.Equal(t, testCase.fixedFee, result.FixedFee)
+			percentage, _ := result.FeePercentage.Native().Float64()
+			assert.InDelta(t, testCase.feePercentage, percentage, 1e-9)
+			// the original configuration must not be modified
+			assert.Equal(t, entities.NewWei(10), config.FixedFee)
+			assert.Equal(t, utils.NewBigFloat64(0.25), config.FeePercentage)
+		})
+	}
+}
+
+func TestPegoutConfiguration_ForQuote(t *testing.T) {
+	config := liquidity_provider.PegoutConfiguration{
+		FixedFee:      entities.NewWei(10),
+		FeePercentage: utils.NewBigFloat64(0.25),
+		MaxValue:      entities.NewWei(2000),
+		FeeTiers:      testFeeTiers,
+		FeeSurcharges: &liquidity_provider.FeeSurcharges{
+			TimeOfDay: []liquidity_provider.TimeOfDaySurcharge{{StartHour: 10, EndHour: 14, FeePercentage: utils.NewBigFloat64(0.2)}},
+		},
+	}
+	result := config.ForQuote(entities.NewWei(100), liquidity_provider.FeeConditions{Time: time.Date(2024, 1, 1, 13, 59, 0, 0, time.UTC)})
+	assert.Equal(t, entities.NewWei(50), result.FixedFee)
+	percentage, _ := result.FeePercentage.Native().Float64()
+	assert.InDelta(t, 1.2, percentage, 1e-9)
+
+	result = config.ForQuote(entities.NewWei(5000), liquidity_provider.FeeConditions{Time: time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC)})
+	assert.Equal(t, entities.NewWei(10), result.FixedFee)
+	percentage, _ = result.FeePercentage.Native().Float64()
+	assert.InDelta(t, 0.25, percentage, 1e-9)
+}
+
+func TestPeginConfiguration_ValidateFeeSchedule(t *testing.T) {
+	config := liquidity_provider.DefaultPeginConfiguration()
+	require.NoError(t, config.ValidateFeeSchedule())
+	config.FeeTiers = testFeeTiers
+	require.NoError(t, config.ValidateFeeSchedule())
+	config.FeeSurcharges = &liquidity_provider.FeeSurcharges{TimeOfDay: []liquidity_provider.TimeOfDaySurcharge{{StartHour: 1, EndHour: 1}}}
+	require.ErrorIs(t, config.ValidateFeeSchedule(), liquidity_provider.InvalidFeeScheduleError)
+}
+
+func TestPeginConfiguration_ValidateFeeSchedule_CombinedPercentage(t *testing.T) {
+	config := liquidity_provider.DefaultPeginConfiguration()
+	config.FeePercentage = utils.NewBigFloat64(100)
+	require.ErrorIs(t, config.ValidateFeeSchedule(), liquidity_provider.InvalidFeeScheduleError)
+
+	config.FeePercentage = utils.NewBigFloat64(1)
+	config.FeeTiers = liquidity_provider.FeeTiers{
+		{MaxAmount: entities.NewWei(100), FixedFee: entities.NewWei(1), FeePercentage: utils.NewBigFloat64(60)},
+	}
+	config.FeeSurcharges = &liquidity_provider.FeeSurcharges{
+		Utilization: []liquidity_provider.UtilizationSurcharge{
+			{Threshold: liquidity_provider.LiquidityThreshold{Amount: entities.NewWei(5)}, FeePercentage: utils.NewBigFloat64(10)},
+			{Threshold: liquidity_provider.LiquidityThreshold{Amount: entities.NewWei(1)}, FeePercentage: utils.NewBigFloat64(20)},
+		},
+		TimeOfDay: []liquidity_provider.TimeOfDaySurcharge{{StartHour: 1, EndHour: 6, FeePercentage: utils.NewBigFloat64(19.99)}},
+	}
+	require.NoError(t, config.ValidateFeeSchedule())
+
+	config.FeeSurcharges.TimeOfDay[0].FeePercentage = utils.NewBigFloat64(20)
+	err := config.ValidateFeeSchedule()
+	require.ErrorIs(t, err, liquidity_provider.InvalidFeeScheduleError)
+	require.ErrorContains(t, err, "the combined fee percentage can reach 100")
+}
+
+func TestPegoutConfiguration_ValidateFeeSchedule(t *testing.T) {
+	config := liquidity_provider.DefaultPegoutConfiguration()
+	require.NoError(t, config.ValidateFeeSchedule())
+	config.FeeTiers = liquidity_provider.FeeTiers{{MaxAmount: entities.NewWei(1)}}
+	require.ErrorIs(t, config.ValidateFeeSchedule(), liquidity_provider.InvalidFeeScheduleError)
+	config.FeeTiers = liquidity_provider.FeeTiers{
+		{MaxAmount: entities.NewWei(1), FixedFee: entities.NewWei(1), FeePercentage: utils.NewBigFloat64(99)},
+	}
+	config.FeeSurcharges = &liquidity_provider.FeeSurcharges{
+		TimeOfDay: []liquidity_provider.TimeOfDaySurcharge{{StartHour: 1, EndHour: 6, FeePercentage: utils.NewBigFloat64(1)}},
+	}
+	require.ErrorIs(t, config.ValidateFeeSchedule(), liquidity_provider.InvalidFeeScheduleError)
+}
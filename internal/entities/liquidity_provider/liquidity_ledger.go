@@ -0,0 +1,100 @@
+package liquidity_provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+)
+
+type HoldOperation string
+
+const (
+	PeginHold  HoldOperation = "pegin"
+	PegoutHold HoldOperation = "pegout"
+)
+
+type HoldStatus string
+
+const (
+	HoldStatusActive   HoldStatus = "active"
+	HoldStatusReleased HoldStatus = "released"
+	HoldStatusExpired  HoldStatus = "expired"
+)
+
+const (
+	// HoldReleasedByReconciliation is the release reason of the holds whose quote was no longer waiting
+	// for the LP to pay when the ledger was reconciled
+	HoldReleasedByReconciliation = "Reconciliation"
+	// HoldReleasedByAcceptFailure is the release reason of the holds of the quotes that couldn't be accepted
+	HoldReleasedByAcceptFailure = "AcceptFailure"
+)
+
+// LiquidityHold is the liquidity reserved for an accepted quote. The hold is active until the quote is paid, fails
+// or expires, so it is kept while the quote is waiting for the deposit or its confirmations even if that takes longer
+// than ExpiresAt, which is the latest time in which the LP was expected to pay the quote. If a hold is still active
+// after that time once its quote is no longer waiting, it is marked as expired instead of released.
+// The amount is in wei for both operations, for pegout it represents the BTC to send to the user.
+type LiquidityHold struct {
+	QuoteHash     string        `json:"quoteHash" bson:"quote_hash" validate:"required"`
+	Operation     HoldOperation `json:"operation" bson:"operation" validate:"required,oneof=pegin pegout"`
+	Amount        *entities.Wei `json:"amount" bson:"amount" validate:"required"`
+	Status        HoldStatus    `json:"status" bson:"status" validate:"required"`
+	CreatedAt     time.Time     `json:"createdAt" bson:"created_at"`
+	ExpiresAt     time.Time     `json:"expiresAt" bson:"expires_at"`
+	ReleasedAt    time.Time     `json:"releasedAt" bson:"released_at"`
+	ReleaseReason string        `json:"releaseReason" bson:"release_reason"`
+}
+
+func NewLiquidityHold(quoteHash string, operation HoldOperation, amount *entities.Wei, expiresAt time.Time) LiquidityHold {
+	return LiquidityHold{
+		QuoteHash: quoteHash,
+		Operation: operation,
+		Amount:    amount,
+		Status:    HoldStatusActive,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: expiresAt.UTC(),
+	}
+}
+
+// IsActive returns true if the hold still reserves its amount
+func (hold LiquidityHold) IsActive() bool {
+	return hold.Status == HoldStatusActive
+}
+
+// IsOverdue returns true if the LP was expected to have paid the quote of the hold before the given time
+func (hold LiquidityHold) IsOverdue(now time.Time) bool {
+	return !now.Before(hold.ExpiresAt)
+}
+
+// TotalHeld returns the sum of the amounts of the holds that are active
+func TotalHeld(holds []LiquidityHold) *entities.Wei {
+	total := new(entities.Wei)
+	for _, hold := range holds {
+		if hold.IsActive() && hold.Amount != nil {
+			total.Add(total, hold.Amount)
+		}
+	}
+	return total
+}
+
+type LiquidityHoldFilter struct {
+	// Operation is the operation of the holds to return, if empty holds of both operations are returned
+	Operation HoldOperation
+	// Statuses are the statuses of the holds to return, if empty holds in any status are returned
+	Statuses []HoldStatus
+}
+
+// LiquidityLedger keeps track of the liquidity reserved for each accepted quote, so the available liquidity can be
+// calculated without going through all the retained quotes
+type LiquidityLedger interface {
+	// PlaceHold stores a new hold, if the quote already has a hold it is replaced
+	PlaceHold(ctx context.Context, hold LiquidityHold) error
+	// ReleaseHold marks the hold of a quote as released. It returns false if the quote doesn't have an active hold
+	ReleaseHold(ctx context.Context, quoteHash string, reason string) (bool, error)
+	// ExpireHold marks the hold of a quote as expired. It returns false if the quote doesn't have an active hold
+	ExpireHold(ctx context.Context, quoteHash string) (bool, error)
+	// GetActiveHolds returns the holds of the operation that are active at the moment of the query
+	GetActiveHolds(ctx context.Context, operation HoldOperation) ([]LiquidityHold, error)
+	GetHolds(ctx context.Context, filter LiquidityHoldFilter) ([]LiquidityHold, error)
+}
@@ -0,0 +1,50 @@
+package liquidity_provider_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLiquidityHold(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	hold := liquidity_provider.NewLiquidityHold("0x01", liquidity_provider.PeginHold, entities.NewWei(5), expiresAt)
+	assert.Equal(t, "0x01", hold.QuoteHash)
+	assert.Equal(t, liquidity_provider.PeginHold, hold.Operation)
+	assert.Equal(t, entities.NewWei(5), hold.Amount)
+	assert.Equal(t, liquidity_provider.HoldStatusActive, hold.Status)
+	assert.True(t, expiresAt.Equal(hold.ExpiresAt))
+	assert.WithinDuration(t, time.Now(), hold.CreatedAt, time.Second)
+	assert.True(t, hold.ReleasedAt.IsZero())
+}
+
+func TestLiquidityHold_IsActive(t *testing.T) {
+	now := time.Now()
+	assert.True(t, liquidity_provider.LiquidityHold{Status: liquidity_provider.HoldStatusActive, ExpiresAt: now.Add(time.Second)}.IsActive())
+	assert.True(t, liquidity_provider.LiquidityHold{Status: liquidity_provider.HoldStatusActive, ExpiresAt: now.Add(-time.Second)}.IsActive())
+	assert.False(t, liquidity_provider.LiquidityHold{Status: liquidity_provider.HoldStatusReleased, ExpiresAt: now.Add(time.Second)}.IsActive())
+	assert.False(t, liquidity_provider.LiquidityHold{Status: liquidity_provider.HoldStatusExpired, ExpiresAt: now.Add(time.Second)}.IsActive())
+}
+
+func TestLiquidityHold_IsOverdue(t *testing.T) {
+	now := time.Now()
+	assert.False(t, liquidity_provider.LiquidityHold{ExpiresAt: now.Add(time.Second)}.IsOverdue(now))
+	assert.True(t, liquidity_provider.LiquidityHold{ExpiresAt: now}.IsOverdue(now))
+	assert.True(t, liquidity_provider.LiquidityHold{ExpiresAt: now.Add(-time.Second)}.IsOverdue(now))
+}
+
+func TestTotalHeld(t *testing.T) {
+	now := time.Now()
+	holds := []liquidity_provider.LiquidityHold{
+		{Amount: entities.NewWei(10), Status: liquidity_provider.HoldStatusActive, ExpiresAt: now.Add(time.Minute)},
+		{Amount: entities.NewWei(20), Status: liquidity_provider.HoldStatusActive, ExpiresAt: now.Add(time.Hour)},
+		{Amount: entities.NewWei(40), Status: liquidity_provider.HoldStatusActive, ExpiresAt: now.Add(-time.Minute)},
+		{Amount: entities.NewWei(80), Status: liquidity_provider.HoldStatusReleased, ExpiresAt: now.Add(time.Hour)},
+		{Status: liquidity_provider.HoldStatusActive, ExpiresAt: now.Add(time.Hour)},
+	}
+	assert.Equal(t, entities.NewWei(70), liquidity_provider.TotalHeld(holds))
+	assert.Equal(t, entities.NewWei(0), liquidity_provider.TotalHeld(nil))
+}
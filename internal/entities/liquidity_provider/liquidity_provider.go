@@ -114,6 +114,8 @@ type LiquidityProviderDetail struct {
 	MinTransactionValue   *entities.Wei   `json:"minTransactionValue"  validate:"required"`
 	MaxTransactionValue   *entities.Wei   `json:"maxTransactionValue"  validate:"required"`
 	RequiredConfirmations uint16          `json:"requiredConfirmations"  validate:"required"`
+	FeeTiers              FeeTiers        `json:"feeTiers,omitempty" validate:""`
+	FeeSurcharges         *FeeSurcharges  `json:"feeSurcharges,omitempty" validate:""`
 }
 
 type AvailableLiquidity struct {
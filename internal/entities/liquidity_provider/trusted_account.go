@@ -3,14 +3,19 @@ package liquidity_provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
 )
 
 var (
 	TrustedAccountNotFoundError  = errors.New("trusted account not found")
 	DuplicateTrustedAccountError = errors.New("trusted account already exists")
 	TamperedTrustedAccountError  = errors.New("trusted account signature verification failed")
+	InvalidPartnerTermsError     = errors.New("invalid partner terms")
+	UsedPartnerAuthError         = errors.New("partner authentication already used")
 )
 
 type TrustedAccountDetails struct {
@@ -18,6 +23,67 @@ type TrustedAccountDetails struct {
 	Name           string        `json:"name" bson:"name" validate:"required"`
 	BtcLockingCap  *entities.Wei `json:"btcLockingCap" bson:"btc_locking_cap" validate:"required"`
 	RbtcLockingCap *entities.Wei `json:"rbtcLockingCap" bson:"rbtc_locking_cap" validate:"required"`
+	PeginTerms     *PartnerTerms `json:"peginTerms,omitempty" bson:"pegin_terms,omitempty"`
+	PegoutTerms    *PartnerTerms `json:"pegoutTerms,omitempty" bson:"pegout_terms,omitempty"`
+}
+
+func (account TrustedAccountDetails) ValidateTerms() error {
+	if err := account.PeginTerms.Validate(); err != nil {
+		return fmt.Errorf("pegin terms: %w", err)
+	}
+	if err := account.PegoutTerms.Validate(); err != nil {
+		return fmt.Errorf("pegout terms: %w", err)
+	}
+	return nil
+}
+
+// PartnerTerms are the custom conditions that a trusted account gets in the quotes it requests. Every field
+// is optional, the ones that are not set are taken from the configuration of the operation. QuoteValidity is
+// the amount of seconds that the quote can be accepted, this is, the time for deposit of a pegin quote and
+// the expire time of a pegout quote.
+type PartnerTerms struct {
+	FixedFee      *entities.Wei   `json:"fixedFee,omitempty" bson:"fixed_fee,omitempty"`
+	FeePercentage *utils.BigFloat `json:"feePercentage,omitempty" bson:"fee_percentage,omitempty"`
+	MinValue      *entities.Wei   `json:"minValue,omitempty" bson:"min_value,omitempty"`
+	MaxValue      *entities.Wei   `json:"maxValue,omitempty" bson:"max_value,omitempty"`
+	QuoteValidity uint32          `json:"quoteValidity,omitempty" bson:"quote_validity,omitempty"`
+}
+
+func (terms *PartnerTerms) Validate() error {
+	if terms == nil {
+		return nil
+	}
+	if terms.FixedFee != nil && terms.FixedFee.Cmp(entities.NewWei(0)) < 0 {
+		return fmt.Errorf("%w: fixedFee can't be negative", InvalidPartnerTermsError)
+	}
+	if terms.FeePercentage != nil {
+		if err := validateFeePercentage(terms.FeePercentage); err != nil {
+			return errors.Join(InvalidPartnerTermsError, err)
+		}
+	}
+	if terms.MinValue != nil && terms.MinValue.Cmp(entities.NewWei(0)) <= 0 {
+		return fmt.Errorf("%w: minValue must be positive", InvalidPartnerTermsError)
+	}
+	if terms.MaxValue != nil && terms.MaxValue.Cmp(entities.NewWei(0)) <= 0 {
+		return fmt.Errorf("%w: maxValue must be positive", InvalidPartnerTermsError)
+	}
+	if terms.MinValue != nil && terms.MaxValue != nil && terms.MinValue.Cmp(terms.MaxValue) > 0 {
+		return fmt.Errorf("%w: minValue can't be greater than maxValue", InvalidPartnerTermsError)
+	}
+	return nil
+}
+
+// overridesFees returns true if the terms replace any of the fees of the operation configuration. In that
+// case the fee schedule of the configuration doesn't apply to the partner quotes.
+func (terms *PartnerTerms) overridesFees() bool {
+	return terms.FixedFee != nil || terms.FeePercentage != nil
+}
+
+func overrideValue[T any](value, override *T) *T {
+	if override != nil {
+		return override
+	}
+	return value
 }
 
 type TrustedAccountRepository interface {
@@ -26,4 +92,7 @@ type TrustedAccountRepository interface {
 	AddTrustedAccount(ctx context.Context, account entities.Signed[TrustedAccountDetails]) error
 	UpdateTrustedAccount(ctx context.Context, account entities.Signed[TrustedAccountDetails]) error
 	DeleteTrustedAccount(ctx context.Context, address string) error
+	// RegisterPartnerAuthentication records the use of a partner authentication until expireAt, so it can only be
+	// used once. Returns UsedPartnerAuthError if the authentication was already registered
+	RegisterPartnerAuthentication(ctx context.Context, id string, expireAt time.Time) error
 }
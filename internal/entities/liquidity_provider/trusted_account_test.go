@@ -0,0 +1,85 @@
+package liquidity_provider_test
+
+import (
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartnerTerms_Validate(t *testing.T) {
+	var nilTerms *liquidity_provider.PartnerTerms
+	require.NoError(t, nilTerms.Validate())
+	require.NoError(t, (&liquidity_provider.PartnerTerms{}).Validate())
+	require.NoError(t, (&liquidity_provider.PartnerTerms{
+		FixedFee:      entities.NewWei(0),
+		FeePercentage: utils.NewBigFloat64(0.1),
+		MinValue:      entities.NewWei(10),
+		MaxValue:      entities.NewWei(10),
+		QuoteValidity: 60,
+	}).Validate())
+	invalidTerms := []liquidity_provider.PartnerTerms{
+		{FixedFee: entities.NewWei(-1)},
+		{FeePercentage: utils.NewBigFloat64(-0.1)},
+		{FeePercentage: utils.NewBigFloat64(101)},
+		{MinValue: entities.NewWei(0)},
+		{MaxValue: entities.NewWei(-5)},
+		{MinValue: entities.NewWei(11), MaxValue: entities.NewWei(10)},
+	}
+	for _, terms := range invalidTerms {
+		require.ErrorIs(t, terms.Validate(), liquidity_provider.InvalidPartnerTermsError)
+	}
+}
+
+func TestTrustedAccountDetails_ValidateTerms(t *testing.T) {
+	account := liquidity_provider.TrustedAccountDetails{PeginTerms: &liquidity_provider.PartnerTerms{QuoteValidity: 5}}
+	require.NoError(t, account.ValidateTerms())
+	account.PegoutTerms = &liquidity_provider.PartnerTerms{MinValue: entities.NewWei(0)}
+	err := account.ValidateTerms()
+	require.ErrorIs(t, err, liquidity_provider.InvalidPartnerTermsError)
+	require.ErrorContains(t, err, "pegout terms")
+}
+
+func TestPeginConfiguration_WithPartnerTerms(t *testing.T) {
+	config := liquidity_provider.DefaultPeginConfiguration()
+	config.FeeTiers = testFeeTiers
+	config.FeeSurcharges = &liquidity_provider.FeeSurcharges{TimeOfDay: []liquidity_provider.TimeOfDaySurcharge{{StartHour: 1, EndHour: 2}}}
+
+	assert.Equal(t, config, config.WithPartnerTerms(nil))
+
+	result := config.WithPartnerTerms(&liquidity_provider.PartnerTerms{MaxValue: entities.NewWei(5), QuoteValidity: 60})
+	assert.Equal(t, entities.NewWei(5), result.MaxValue)
+	assert.Equal(t, uint32(60), result.TimeForDeposit)
+	assert.Equal(t, config.MinValue, result.MinValue)
+	assert.Equal(t, config.FixedFee, result.FixedFee)
+	assert.Equal(t, config.FeeTiers, result.FeeTiers)
+	assert.Equal(t, config.FeeSurcharges, result.FeeSurcharges)
+
+	result = config.WithPartnerTerms(&liquidity_provider.PartnerTerms{FeePercentage: utils.NewBigFloat64(0.01), MinValue: entities.NewWei(1)})
+	assert.Equal(t, utils.NewBigFloat64(0.01), result.FeePercentage)
+	assert.Equal(t, config.FixedFee, result.FixedFee)
+	assert.Equal(t, entities.NewWei(1), result.MinValue)
+	assert.Equal(t, config.TimeForDeposit, result.TimeForDeposit)
+	assert.Nil(t, result.FeeTiers)
+	assert.Nil(t, result.FeeSurcharges)
+	// the original configuration must not be modified
+	assert.Equal(t, testFeeTiers, config.FeeTiers)
+}
+
+func TestPegoutConfiguration_WithPartnerTerms(t *testing.T) {
+	config := liquidity_provider.DefaultPegoutConfiguration()
+	config.FeeTiers = testFeeTiers
+
+	assert.Equal(t, config, config.WithPartnerTerms(nil))
+
+	result := config.WithPartnerTerms(&liquidity_provider.PartnerTerms{FixedFee: entities.NewWei(7), QuoteValidity: 120})
+	assert.Equal(t, entities.NewWei(7), result.FixedFee)
+	assert.Equal(t, uint32(120), result.ExpireTime)
+	assert.Equal(t, config.TimeForDeposit, result.TimeForDeposit)
+	assert.Equal(t, config.FeePercentage, result.FeePercentage)
+	assert.Equal(t, config.MaxValue, result.MaxValue)
+	assert.Nil(t, result.FeeTiers)
+}
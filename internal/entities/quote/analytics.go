@@ -0,0 +1,79 @@
+package quote
+
+import (
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+)
+
+// AnalyticsPeriod is the length of the time buckets in which the quotes are grouped for the analytics
+type AnalyticsPeriod string
+
+const (
+	AnalyticsPeriodDay   AnalyticsPeriod = "day"
+	AnalyticsPeriodWeek  AnalyticsPeriod = "week"
+	AnalyticsPeriodMonth AnalyticsPeriod = "month"
+)
+
+func (period AnalyticsPeriod) IsValid() bool {
+	switch period {
+	case AnalyticsPeriodDay, AnalyticsPeriodWeek, AnalyticsPeriodMonth:
+		return true
+	default:
+		return false
+	}
+}
+
+// AnalyticsQuery describes how the quotes of one direction are aggregated. The quotes are selected by their
+// agreement timestamp and the states are used to decide which of them are considered paid, refunded or part
+// of the revenue of the LP
+type AnalyticsQuery[S ~string] struct {
+	Period         AnalyticsPeriod
+	StartDate      time.Time
+	EndDate        time.Time
+	PaidStates     []S
+	RefundedStates []S
+	RevenueStates  []S
+}
+
+// AnalyticsBucket holds the aggregated values of the quotes of a trusted account owner (empty if the quote
+// wasn't accepted by a trusted account) agreed in the period that starts at PeriodStart. Weeks start on monday
+// and all the periods are in UTC.
+//
+// The amounts follow the same rules as the summaries and revenue reports: AcceptedQuotesAmount and PaidQuotesAmount
+// are value + gas fee, RefundedQuotesAmount also includes the call fee, CallFees, GasFeesCollected, GasSpent and
+// BtcFeesPaid only include the quotes in a revenue state and the penalizations include every accepted quote.
+// GasSpent only includes the RSK gas, the BTC fee paid by the LP in pegouts is in BtcFeesPaid
+type AnalyticsBucket struct {
+	PeriodStart          time.Time
+	OwnerAccountAddress  string
+	TotalQuotesCount     int64
+	AcceptedQuotesCount  int64
+	AcceptedQuotesAmount *entities.Wei
+	PaidQuotesCount      int64
+	PaidQuotesAmount     *entities.Wei
+	RefundedQuotesCount  int64
+	RefundedQuotesAmount *entities.Wei
+	PenalizationsCount   int64
+	PenalizationsAmount  *entities.Wei
+	CallFees             *entities.Wei
+	GasFeesCollected     *entities.Wei
+	GasSpent             *entities.Wei
+	BtcFeesPaid          *entities.Wei
+}
+
+// NetProfit returns call fees + gas collected - gas spent - BTC fees - penalizations
+func (bucket AnalyticsBucket) NetProfit() *entities.Wei {
+	profit := entities.NewWei(0)
+	for _, income := range []*entities.Wei{bucket.CallFees, bucket.GasFeesCollected} {
+		if income != nil {
+			profit.Add(profit, income)
+		}
+	}
+	for _, expense := range []*entities.Wei{bucket.GasSpent, bucket.BtcFeesPaid, bucket.PenalizationsAmount} {
+		if expense != nil {
+			profit.Sub(profit, expense)
+		}
+	}
+	return profit
+}
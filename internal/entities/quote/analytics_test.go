@@ -0,0 +1,44 @@
+package quote_test
+
+import (
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyticsPeriod_IsValid(t *testing.T) {
+	assert.True(t, quote.AnalyticsPeriodDay.IsValid())
+	assert.True(t, quote.AnalyticsPeriodWeek.IsValid())
+	assert.True(t, quote.AnalyticsPeriodMonth.IsValid())
+	assert.False(t, quote.AnalyticsPeriod("year").IsValid())
+	assert.False(t, quote.AnalyticsPeriod("").IsValid())
+}
+
+func TestAnalyticsBucket_NetProfit(t *testing.T) {
+	t.Run("should subtract the expenses from the income", func(t *testing.T) {
+		bucket := quote.AnalyticsBucket{
+			CallFees:            entities.NewWei(1000),
+			GasFeesCollected:    entities.NewWei(500),
+			GasSpent:            entities.NewWei(300),
+			BtcFeesPaid:         entities.NewWei(100),
+			PenalizationsAmount: entities.NewWei(50),
+		}
+		assert.Equal(t, entities.NewWei(1050), bucket.NetProfit())
+	})
+	t.Run("should return a negative profit if the expenses are greater than the income", func(t *testing.T) {
+		bucket := quote.AnalyticsBucket{
+			CallFees:            entities.NewWei(100),
+			GasFeesCollected:    entities.NewWei(0),
+			GasSpent:            entities.NewWei(50),
+			BtcFeesPaid:         entities.NewWei(0),
+			PenalizationsAmount: entities.NewWei(200),
+		}
+		assert.Equal(t, entities.NewWei(-150), bucket.NetProfit())
+	})
+	t.Run("should ignore missing amounts", func(t *testing.T) {
+		bucket := quote.AnalyticsBucket{CallFees: entities.NewWei(100), GasSpent: entities.NewWei(30)}
+		assert.Equal(t, entities.NewWei(70), bucket.NetProfit())
+	})
+}
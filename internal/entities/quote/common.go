@@ -13,6 +13,15 @@ import (
 type AcceptedQuote struct {
 	Signature      string `json:"signature"`
 	DepositAddress string `json:"depositAddress"`
+	// Created is false if the quote had already been accepted by a previous request
+	Created bool `json:"-"`
+}
+
+// DepositConfirmations is the progress of the confirmations of the transaction that the user did to pay a quote
+type DepositConfirmations struct {
+	TxHash                string
+	Confirmations         uint64
+	RequiredConfirmations uint64
 }
 
 type Fees struct {
@@ -12,6 +12,7 @@ const (
 	AcceptedPeginQuoteEventId     entities.EventId = "AcceptedPeginQuote"
 	CallForUserCompletedEventId   entities.EventId = "CallForUserCompleted"
 	RegisterPeginCompletedEventId entities.EventId = "RegisterPeginCompleted"
+	PeginStateUpdatedEventId      entities.EventId = "PeginStateUpdated"
 )
 
 type PeginState string
@@ -41,6 +42,7 @@ type PeginQuoteRepository interface {
 	ListQuotesByDateRange(ctx context.Context, startDate, endDate time.Time, page, perPage int) ([]PeginQuoteWithRetained, int, error)
 	GetRetainedQuotesForAddress(ctx context.Context, address string, states ...PeginState) ([]RetainedPeginQuote, error)
 	GetQuotesWithRetainedByStateAndDate(ctx context.Context, states []PeginState, startDate, endDate time.Time) ([]PeginQuoteWithRetained, error)
+	GetAnalyticsBuckets(ctx context.Context, query AnalyticsQuery[PeginState]) ([]AnalyticsBucket, error)
 }
 
 type PeginQuoteWithRetained struct {
@@ -99,6 +101,12 @@ func (quote *PeginQuote) IsExpired() bool {
 	return time.Now().After(quote.ExpireTime())
 }
 
+// HoldExpireTime returns the latest time in which the LP might need to call for the user. After that time the
+// liquidity reserved for the quote is no longer held.
+func (quote *PeginQuote) HoldExpireTime() time.Time {
+	return quote.ExpireTime().Add(time.Duration(quote.LpCallTime) * time.Second)
+}
+
 func (quote *PeginQuote) Total() *entities.Wei {
 	if quote.Value == nil {
 		quote.Value = entities.NewWei(0)
@@ -173,3 +181,10 @@ type RegisterPeginCompletedEvent struct {
 	RetainedQuote RetainedPeginQuote
 	Error         error
 }
+
+// PeginStateUpdatedEvent is published when the state of a quote changes in a transition that
+// doesn't have its own specific event
+type PeginStateUpdatedEvent struct {
+	entities.Event
+	RetainedQuote RetainedPeginQuote
+}
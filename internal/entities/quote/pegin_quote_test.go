@@ -115,6 +115,11 @@ func TestPeginQuote_IsExpired(t *testing.T) {
 	})
 }
 
+func TestPeginQuote_HoldExpireTime(t *testing.T) {
+	peginQuote := quote.PeginQuote{AgreementTimestamp: 1700000000, TimeForDeposit: 3600, LpCallTime: 7200}
+	assert.Equal(t, time.Unix(1700010800, 0), peginQuote.HoldExpireTime())
+}
+
 //nolint:funlen
 func TestRetainedPeginQuote_FillZeroValues(t *testing.T) {
 	testCases := []struct {
@@ -15,6 +15,7 @@ const (
 	AcceptedPegoutQuoteEventId  entities.EventId = "AcceptedPegoutQuote"
 	PegoutBtcSentEventId        entities.EventId = "PegoutBtcSent"
 	PegoutQuoteCompletedEventId entities.EventId = "PegoutQuoteCompleted"
+	PegoutStateUpdatedEventId   entities.EventId = "PegoutStateUpdated"
 )
 
 type PegoutState string
@@ -52,6 +53,7 @@ type PegoutQuoteRepository interface {
 	GetRetainedQuotesForAddress(ctx context.Context, address string, states ...PegoutState) ([]RetainedPegoutQuote, error)
 	GetRetainedQuotesInBatch(ctx context.Context, batch rootstock.BatchPegOut) ([]RetainedPegoutQuote, error)
 	GetQuotesWithRetainedByStateAndDate(ctx context.Context, states []PegoutState, startDate, endDate time.Time) ([]PegoutQuoteWithRetained, error)
+	GetAnalyticsBuckets(ctx context.Context, query AnalyticsQuery[PegoutState]) ([]AnalyticsBucket, error)
 }
 
 type CreatedPegoutQuote struct {
@@ -106,6 +108,12 @@ func (quote *PegoutQuote) IsExpired() bool {
 	return time.Now().After(quote.ExpireTime())
 }
 
+// HoldExpireTime returns the latest time in which the LP might need to send the BTC to the user. After that
+// time the liquidity reserved for the quote is no longer held.
+func (quote *PegoutQuote) HoldExpireTime() time.Time {
+	return quote.ExpireTime().Add(time.Duration(quote.TransferTime) * time.Second)
+}
+
 func GetCreationBlock(pegoutConfig liquidity_provider.PegoutConfiguration, pegoutQuote PegoutQuote) uint64 {
 	return utils.SafeSub(uint64(pegoutQuote.ExpireBlock), pegoutConfig.ExpireBlocks)
 }
@@ -144,6 +152,15 @@ type RetainedPegoutQuote struct {
 	SendPegoutBtcFee     *entities.Wei `json:"sendPegoutBtcFee" bson:"send_pegout_btc_fee"`
 	BtcReleaseTxHash     string        `json:"btcReleaseTxHash" bson:"btc_release_tx_hash"`
 	OwnerAccountAddress  string        `json:"ownerAccountAddress" bson:"owner_account_address"`
+	// LpBtcReplacedTxs are the transactions of the LP that were replaced by LpBtcTxHash to bump their fee
+	LpBtcReplacedTxs []ReplacedBtcTransaction `json:"lpBtcReplacedTxs,omitempty" bson:"lp_btc_replaced_txs,omitempty"`
+}
+
+// ReplacedBtcTransaction is a BTC transaction sent by the LP that was replaced by another one with a higher fee.
+// It is kept since it might still be the one that gets confirmed
+type ReplacedBtcTransaction struct {
+	TxHash string        `json:"txHash" bson:"tx_hash"`
+	Fee    *entities.Wei `json:"fee" bson:"fee"`
 }
 
 // FillZeroValues ensures that gas-related Wei fields have zero values instead of nil
@@ -160,6 +177,42 @@ func (quote *RetainedPegoutQuote) FillZeroValues() {
 	}
 }
 
+// ReplaceLpBtcTransaction sets a new transaction as the LP BTC transaction of the quote and keeps the previous one
+// in the list of replaced transactions
+func (quote *RetainedPegoutQuote) ReplaceLpBtcTransaction(txHash string, fee *entities.Wei) {
+	quote.LpBtcReplacedTxs = append(quote.LpBtcReplacedTxs, ReplacedBtcTransaction{TxHash: quote.LpBtcTxHash, Fee: quote.SendPegoutBtcFee})
+	quote.LpBtcTxHash = txHash
+	quote.SendPegoutBtcFee = fee
+}
+
+// LpBtcTxHashes returns the hashes of all the transactions that the LP sent to pay the quote, the current
+// one first. Only one of them can be confirmed
+func (quote *RetainedPegoutQuote) LpBtcTxHashes() []string {
+	hashes := []string{quote.LpBtcTxHash}
+	for _, replaced := range quote.LpBtcReplacedTxs {
+		hashes = append(hashes, replaced.TxHash)
+	}
+	return hashes
+}
+
+// UseLpBtcTransaction sets one of the replaced transactions as the LP BTC transaction of the quote. This is used
+// when a replaced transaction is confirmed instead of its replacement. Returns false if the transaction doesn't
+// belong to the quote
+func (quote *RetainedPegoutQuote) UseLpBtcTransaction(txHash string) bool {
+	if quote.LpBtcTxHash == txHash {
+		return true
+	}
+	for i, replaced := range quote.LpBtcReplacedTxs {
+		if replaced.TxHash == txHash {
+			quote.LpBtcReplacedTxs[i] = ReplacedBtcTransaction{TxHash: quote.LpBtcTxHash, Fee: quote.SendPegoutBtcFee}
+			quote.LpBtcTxHash = replaced.TxHash
+			quote.SendPegoutBtcFee = replaced.Fee
+			return true
+		}
+	}
+	return false
+}
+
 type WatchedPegoutQuote struct {
 	PegoutQuote   PegoutQuote
 	RetainedQuote RetainedPegoutQuote
@@ -199,6 +252,13 @@ type PegoutQuoteCompletedEvent struct {
 	Error         error
 }
 
+// PegoutStateUpdatedEvent is published when the state of a quote changes in a transition that
+// doesn't have its own specific event
+type PegoutStateUpdatedEvent struct {
+	entities.Event
+	RetainedQuote RetainedPegoutQuote
+}
+
 type PegoutBtcSentToUserEvent struct {
 	entities.Event
 	PegoutQuote   PegoutQuote
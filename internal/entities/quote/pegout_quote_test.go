@@ -10,6 +10,7 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 type LpMock struct {
@@ -121,6 +122,11 @@ func TestPegoutQuote_IsExpired(t *testing.T) {
 	})
 }
 
+func TestPegoutQuote_HoldExpireTime(t *testing.T) {
+	pegoutQuote := quote.PegoutQuote{ExpireDate: 1700000000, TransferTime: 3600}
+	assert.Equal(t, time.Unix(1700003600, 0), pegoutQuote.HoldExpireTime())
+}
+
 func TestGetCreationBlock(t *testing.T) {
 	pegoutConfig := liquidity_provider.PegoutConfiguration{
 		ExpireBlocks: 40,
@@ -342,3 +348,43 @@ func TestRetainedPegoutQuote_FillZeroValues(t *testing.T) {
 		assert.NotNil(t, secondCallResult.SendPegoutBtcFee)
 	})
 }
+
+func TestRetainedPegoutQuote_ReplaceLpBtcTransaction(t *testing.T) {
+	retainedQuote := quote.RetainedPegoutQuote{LpBtcTxHash: "tx1", SendPegoutBtcFee: entities.NewWei(100)}
+	retainedQuote.ReplaceLpBtcTransaction("tx2", entities.NewWei(200))
+	retainedQuote.ReplaceLpBtcTransaction("tx3", entities.NewWei(300))
+	assert.Equal(t, "tx3", retainedQuote.LpBtcTxHash)
+	assert.Equal(t, entities.NewWei(300), retainedQuote.SendPegoutBtcFee)
+	assert.Equal(t, []quote.ReplacedBtcTransaction{
+		{TxHash: "tx1", Fee: entities.NewWei(100)},
+		{TxHash: "tx2", Fee: entities.NewWei(200)},
+	}, retainedQuote.LpBtcReplacedTxs)
+	assert.Equal(t, []string{"tx3", "tx1", "tx2"}, retainedQuote.LpBtcTxHashes())
+}
+
+func TestRetainedPegoutQuote_UseLpBtcTransaction(t *testing.T) {
+	newQuote := func() quote.RetainedPegoutQuote {
+		return quote.RetainedPegoutQuote{
+			LpBtcTxHash:      "tx2",
+			SendPegoutBtcFee: entities.NewWei(200),
+			LpBtcReplacedTxs: []quote.ReplacedBtcTransaction{{TxHash: "tx1", Fee: entities.NewWei(100)}},
+		}
+	}
+	t.Run("should swap the current transaction with the replaced one", func(t *testing.T) {
+		retainedQuote := newQuote()
+		require.True(t, retainedQuote.UseLpBtcTransaction("tx1"))
+		assert.Equal(t, "tx1", retainedQuote.LpBtcTxHash)
+		assert.Equal(t, entities.NewWei(100), retainedQuote.SendPegoutBtcFee)
+		assert.Equal(t, []quote.ReplacedBtcTransaction{{TxHash: "tx2", Fee: entities.NewWei(200)}}, retainedQuote.LpBtcReplacedTxs)
+	})
+	t.Run("should not change the quote if the transaction is the current one", func(t *testing.T) {
+		retainedQuote := newQuote()
+		require.True(t, retainedQuote.UseLpBtcTransaction("tx2"))
+		assert.Equal(t, newQuote(), retainedQuote)
+	})
+	t.Run("should return false if the transaction doesn't belong to the quote", func(t *testing.T) {
+		retainedQuote := newQuote()
+		require.False(t, retainedQuote.UseLpBtcTransaction("tx3"))
+		assert.Equal(t, newQuote(), retainedQuote)
+	})
+}
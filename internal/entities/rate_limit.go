@@ -0,0 +1,76 @@
+package entities
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// RateLimit is the configuration of a token bucket. The bucket holds up to Burst tokens and is refilled
+// at a rate of PerMinute tokens per minute, every request consumes the amount of tokens of its cost.
+type RateLimit struct {
+	PerMinute uint64
+	Burst     uint64
+}
+
+// TokenBucket is the state of the rate limit of a single key
+type TokenBucket struct {
+	Tokens    float64   `json:"tokens" bson:"tokens"`
+	UpdatedAt time.Time `json:"updatedAt" bson:"updated_at"`
+}
+
+type RateLimitResult struct {
+	Allowed bool
+	// RetryAfter is the time until the tokens of the request are available, only set if the request wasn't allowed
+	RetryAfter time.Duration
+}
+
+// RateLimiter keeps the token buckets of the rate limited keys
+type RateLimiter interface {
+	// Take consumes the tokens of a request of the given cost from the bucket of the key
+	Take(ctx context.Context, key string, limit RateLimit, cost uint64) (RateLimitResult, error)
+}
+
+// RefillRate returns the amount of tokens added to the bucket per second
+func (limit RateLimit) RefillRate() float64 {
+	return float64(limit.PerMinute) / float64(time.Minute/time.Second)
+}
+
+// FillTime returns the time that an empty bucket takes to be full again, after that time the bucket
+// state is the same as if the key was never used so it can be discarded
+func (limit RateLimit) FillTime() time.Duration {
+	if limit.PerMinute == 0 {
+		return 0
+	}
+	return time.Duration(float64(limit.Burst) / limit.RefillRate() * float64(time.Second))
+}
+
+// Take refills the bucket with the tokens accumulated since its last update and consumes the tokens of a request of
+// the given cost if available. A nil bucket is considered full.
+func (limit RateLimit) Take(bucket *TokenBucket, now time.Time, cost uint64) (TokenBucket, RateLimitResult) {
+	tokens := float64(limit.Burst)
+	if bucket != nil {
+		elapsed := math.Max(now.Sub(bucket.UpdatedAt).Seconds(), 0)
+		tokens = math.Min(tokens, bucket.Tokens+elapsed*limit.RefillRate())
+	}
+	required := limit.TokenCost(cost)
+	if tokens >= required {
+		return TokenBucket{Tokens: tokens - required, UpdatedAt: now}, RateLimitResult{Allowed: true}
+	}
+	return TokenBucket{Tokens: tokens, UpdatedAt: now}, RateLimitResult{Allowed: false, RetryAfter: limit.RetryAfter(tokens, cost)}
+}
+
+// TokenCost returns the tokens consumed by a request of the given cost. Every request consumes at least one token,
+// and at most the burst, otherwise the requests that cost more than the burst would never be allowed
+func (limit RateLimit) TokenCost(cost uint64) float64 {
+	return float64(max(min(cost, limit.Burst), 1))
+}
+
+// RetryAfter returns the time until the bucket has the tokens of a request of the given cost if it currently has
+// the given amount of tokens
+func (limit RateLimit) RetryAfter(tokens float64, cost uint64) time.Duration {
+	if limit.PerMinute == 0 {
+		return time.Minute
+	}
+	return time.Duration((limit.TokenCost(cost) - tokens) / limit.RefillRate() * float64(time.Second))
+}
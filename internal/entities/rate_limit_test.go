@@ -0,0 +1,74 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit_Take(t *testing.T) {
+	limit := entities.RateLimit{PerMinute: 60, Burst: 2}
+	now := time.Unix(1700000000, 0)
+	t.Run("should start with a full bucket", func(t *testing.T) {
+		bucket, result := limit.Take(nil, now, 1)
+		assert.True(t, result.Allowed)
+		assert.InDelta(t, 1, bucket.Tokens, 1e-9)
+		assert.Equal(t, now, bucket.UpdatedAt)
+	})
+	t.Run("should reject when the bucket is empty", func(t *testing.T) {
+		bucket, result := limit.Take(&entities.TokenBucket{Tokens: 0.25, UpdatedAt: now}, now, 1)
+		assert.False(t, result.Allowed)
+		assert.Equal(t, 750*time.Millisecond, result.RetryAfter)
+		assert.InDelta(t, 0.25, bucket.Tokens, 1e-9)
+	})
+	t.Run("should refill the bucket with the elapsed time", func(t *testing.T) {
+		bucket, result := limit.Take(&entities.TokenBucket{Tokens: 0.5, UpdatedAt: now}, now.Add(500*time.Millisecond), 1)
+		assert.True(t, result.Allowed)
+		assert.InDelta(t, 0, bucket.Tokens, 1e-9)
+	})
+	t.Run("should not refill over the burst", func(t *testing.T) {
+		bucket, result := limit.Take(&entities.TokenBucket{Tokens: 0, UpdatedAt: now}, now.Add(time.Hour), 1)
+		assert.True(t, result.Allowed)
+		assert.InDelta(t, 1, bucket.Tokens, 1e-9)
+	})
+	t.Run("should ignore updates in the future", func(t *testing.T) {
+		bucket, result := limit.Take(&entities.TokenBucket{Tokens: 0.5, UpdatedAt: now.Add(time.Minute)}, now, 1)
+		assert.False(t, result.Allowed)
+		assert.InDelta(t, 0.5, bucket.Tokens, 1e-9)
+	})
+	t.Run("should consume the tokens of the cost", func(t *testing.T) {
+		bucket, result := limit.Take(&entities.TokenBucket{Tokens: 1.5, UpdatedAt: now}, now, 2)
+		assert.False(t, result.Allowed)
+		assert.Equal(t, 500*time.Millisecond, result.RetryAfter)
+		assert.InDelta(t, 1.5, bucket.Tokens, 1e-9)
+		bucket, result = limit.Take(nil, now, 2)
+		assert.True(t, result.Allowed)
+		assert.InDelta(t, 0, bucket.Tokens, 1e-9)
+	})
+	t.Run("should not consume more than the burst", func(t *testing.T) {
+		bucket, result := limit.Take(nil, now, 10)
+		assert.True(t, result.Allowed)
+		assert.InDelta(t, 0, bucket.Tokens, 1e-9)
+	})
+}
+
+func TestRateLimit_TokenCost(t *testing.T) {
+	limit := entities.RateLimit{PerMinute: 60, Burst: 5}
+	assert.InDelta(t, 1, limit.TokenCost(0), 1e-9)
+	assert.InDelta(t, 1, limit.TokenCost(1), 1e-9)
+	assert.InDelta(t, 3, limit.TokenCost(3), 1e-9)
+	assert.InDelta(t, 5, limit.TokenCost(8), 1e-9)
+}
+
+func TestRateLimit_FillTime(t *testing.T) {
+	assert.Equal(t, 5*time.Minute, entities.RateLimit{PerMinute: 2, Burst: 10}.FillTime())
+	assert.Equal(t, time.Duration(0), entities.RateLimit{Burst: 10}.FillTime())
+}
+
+func TestRateLimit_RetryAfter(t *testing.T) {
+	assert.Equal(t, 30*time.Second, entities.RateLimit{PerMinute: 1, Burst: 1}.RetryAfter(0.5, 1))
+	assert.Equal(t, 90*time.Second, entities.RateLimit{PerMinute: 1, Burst: 2}.RetryAfter(0.5, 2))
+	assert.Equal(t, time.Minute, entities.RateLimit{Burst: 1}.RetryAfter(0, 1))
+}
@@ -0,0 +1,95 @@
+package reporting
+
+import (
+	"context"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+)
+
+// AssetHistoryInterval is the size of the buckets used to downsample the asset history. Only the last snapshot
+// of each bucket is kept
+type AssetHistoryInterval string
+
+const (
+	AssetHistoryIntervalRaw    AssetHistoryInterval = "raw"
+	AssetHistoryIntervalMinute AssetHistoryInterval = "minute"
+	AssetHistoryIntervalHour   AssetHistoryInterval = "hour"
+	AssetHistoryIntervalDay    AssetHistoryInterval = "day"
+)
+
+func (interval AssetHistoryInterval) IsValid() bool {
+	switch interval {
+	case AssetHistoryIntervalRaw, AssetHistoryIntervalMinute, AssetHistoryIntervalHour, AssetHistoryIntervalDay:
+		return true
+	default:
+		return false
+	}
+}
+
+// MaxRawAssetHistoryRange is the longest range whose snapshots can be requested without downsampling them
+const MaxRawAssetHistoryRange = 24 * time.Hour
+
+// Duration returns the size of the buckets of the interval, zero means that the snapshots are not downsampled
+func (interval AssetHistoryInterval) Duration() time.Duration {
+	switch interval {
+	case AssetHistoryIntervalMinute:
+		return time.Minute
+	case AssetHistoryIntervalHour:
+		return time.Hour
+	case AssetHistoryIntervalDay:
+		return 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// AssetLocation is where the assets of the LP are. The BTC wallet location only applies to the BTC assets
+type AssetLocation struct {
+	BtcWallet  *entities.Wei `json:"btcWallet,omitempty" bson:"btc_wallet,omitempty"`
+	Federation *entities.Wei `json:"federation" bson:"federation"`
+	RskWallet  *entities.Wei `json:"rskWallet" bson:"rsk_wallet"`
+	Lbc        *entities.Wei `json:"lbc" bson:"lbc"`
+}
+
+// AssetAllocation is how the assets of the LP are committed
+type AssetAllocation struct {
+	ReservedForUsers *entities.Wei `json:"reservedForUsers" bson:"reserved_for_users"`
+	WaitingForRefund *entities.Wei `json:"waitingForRefund" bson:"waiting_for_refund"`
+	Available        *entities.Wei `json:"available" bson:"available"`
+}
+
+type AssetBalance struct {
+	Total      *entities.Wei   `json:"total" bson:"total"`
+	Location   AssetLocation   `json:"location" bson:"location"`
+	Allocation AssetAllocation `json:"allocation" bson:"allocation"`
+}
+
+// AssetSnapshot is the position of the LP assets at a given moment. The BTC and RBTC totals don't overlap, so they
+// can be added to get the total assets of the LP
+type AssetSnapshot struct {
+	Timestamp time.Time    `json:"timestamp" bson:"timestamp"`
+	Btc       AssetBalance `json:"btc" bson:"btc"`
+	Rbtc      AssetBalance `json:"rbtc" bson:"rbtc"`
+}
+
+// Total returns the sum of the BTC and RBTC assets of the snapshot
+func (snapshot AssetSnapshot) Total() *entities.Wei {
+	total := entities.NewWei(0)
+	if snapshot.Btc.Total != nil {
+		total.Add(total, snapshot.Btc.Total)
+	}
+	if snapshot.Rbtc.Total != nil {
+		total.Add(total, snapshot.Rbtc.Total)
+	}
+	return total
+}
+
+type AssetSnapshotRepository interface {
+	InsertAssetSnapshot(ctx context.Context, snapshot AssetSnapshot) error
+	// GetAssetSnapshots returns the snapshots taken between the provided dates sorted by timestamp. If the interval
+	// has a duration, only the last snapshot of each bucket of that duration is returned
+	GetAssetSnapshots(ctx context.Context, startDate, endDate time.Time, interval AssetHistoryInterval) ([]AssetSnapshot, error)
+	// GetLastAssetSnapshot returns the most recent snapshot taken at or before the provided date, or nil if there is none
+	GetLastAssetSnapshot(ctx context.Context, before time.Time) (*AssetSnapshot, error)
+}
@@ -0,0 +1,99 @@
+package reporting
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var DuplicateSnapshotError = errors.New("report snapshot already exists")
+
+// Schedule is the frequency in which the report snapshots are generated
+type Schedule string
+
+const (
+	ScheduleDaily  Schedule = "daily"
+	ScheduleWeekly Schedule = "weekly"
+)
+
+func (schedule Schedule) IsValid() bool {
+	switch schedule {
+	case ScheduleDaily, ScheduleWeekly:
+		return true
+	default:
+		return false
+	}
+}
+
+// LastCompletedPeriod returns the first and the last instant of the most recent period of the schedule that
+// has already finished at the provided time. The periods are in UTC and the weeks start on monday
+func (schedule Schedule) LastCompletedPeriod(now time.Time) (time.Time, time.Time) {
+	const daysPerWeek = 7
+	now = now.UTC()
+	periodEnd := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	periodStart := periodEnd.AddDate(0, 0, -1)
+	if schedule == ScheduleWeekly {
+		daysSinceMonday := (int(periodEnd.Weekday()) + daysPerWeek - 1) % daysPerWeek
+		periodEnd = periodEnd.AddDate(0, 0, -daysSinceMonday)
+		periodStart = periodEnd.AddDate(0, 0, -daysPerWeek)
+	}
+	return periodStart, periodEnd.Add(-time.Nanosecond)
+}
+
+// ReportType is the report stored in a snapshot
+type ReportType string
+
+const (
+	ReportTypeSummaries ReportType = "summaries"
+	ReportTypeRevenue   ReportType = "revenue"
+	ReportTypeAssets    ReportType = "assets"
+)
+
+func (reportType ReportType) IsValid() bool {
+	switch reportType {
+	case ReportTypeSummaries, ReportTypeRevenue, ReportTypeAssets:
+		return true
+	default:
+		return false
+	}
+}
+
+// Snapshot is the immutable result of a report generated for a period of a schedule. The content is the JSON
+// representation of the report. The asset report doesn't depend on the period, it is the position of the
+// LP at the moment the snapshot was created. DeliveredAt is the only field that changes after the creation,
+// it is nil until the snapshot is delivered
+type Snapshot struct {
+	Id          string          `json:"id" bson:"id"`
+	Schedule    Schedule        `json:"schedule" bson:"schedule"`
+	ReportType  ReportType      `json:"reportType" bson:"report_type"`
+	PeriodStart time.Time       `json:"periodStart" bson:"period_start"`
+	PeriodEnd   time.Time       `json:"periodEnd" bson:"period_end"`
+	CreatedAt   time.Time       `json:"createdAt" bson:"created_at"`
+	Content     json.RawMessage `json:"content" bson:"content"`
+	DeliveredAt *time.Time      `json:"deliveredAt,omitempty" bson:"delivered_at,omitempty"`
+}
+
+// SnapshotId returns the identifier of the snapshot of a report for a period, there can be only one snapshot
+// with the same id
+func SnapshotId(schedule Schedule, reportType ReportType, periodStart time.Time) string {
+	return fmt.Sprintf("%s:%s:%s", schedule, reportType, periodStart.UTC().Format(time.DateOnly))
+}
+
+type SnapshotRepository interface {
+	// InsertSnapshot stores a snapshot, it returns DuplicateSnapshotError if there is already a snapshot with the same id
+	InsertSnapshot(ctx context.Context, snapshot Snapshot) error
+	// GetSnapshots returns the snapshots of a report whose period starts between the provided dates sorted by period
+	GetSnapshots(ctx context.Context, reportType ReportType, startDate, endDate time.Time) ([]Snapshot, error)
+	// GetUndeliveredSnapshots returns the snapshots created after the provided date that were not delivered yet
+	// sorted by period
+	GetUndeliveredSnapshots(ctx context.Context, createdAfter time.Time) ([]Snapshot, error)
+	// MarkSnapshotsDelivered sets the delivery date of the snapshots with the provided ids
+	MarkSnapshotsDelivered(ctx context.Context, ids []string, deliveredAt time.Time) error
+}
+
+// SnapshotSender delivers the snapshots to an external system
+type SnapshotSender interface {
+	SendSnapshot(ctx context.Context, snapshot Snapshot) error
+}
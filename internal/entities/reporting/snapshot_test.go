@@ -0,0 +1,114 @@
+package reporting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedule_IsValid(t *testing.T) {
+	assert.True(t, reporting.ScheduleDaily.IsValid())
+	assert.True(t, reporting.ScheduleWeekly.IsValid())
+	assert.False(t, reporting.Schedule("monthly").IsValid())
+	assert.False(t, reporting.Schedule("").IsValid())
+}
+
+func TestReportType_IsValid(t *testing.T) {
+	assert.True(t, reporting.ReportTypeSummaries.IsValid())
+	assert.True(t, reporting.ReportTypeRevenue.IsValid())
+	assert.True(t, reporting.ReportTypeAssets.IsValid())
+	assert.False(t, reporting.ReportType("pegin").IsValid())
+}
+
+func TestSchedule_LastCompletedPeriod(t *testing.T) {
+	endOfDay := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 23, 59, 59, 999999999, time.UTC)
+	}
+	tests := []struct {
+		name     string
+		schedule reporting.Schedule
+		now      time.Time
+		start    time.Time
+		end      time.Time
+	}{
+		{
+			name:     "daily in the middle of the day",
+			schedule: reporting.ScheduleDaily,
+			now:      time.Date(2024, 3, 13, 15, 30, 0, 0, time.UTC),
+			start:    time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC),
+			end:      endOfDay(2024, 3, 12),
+		},
+		{
+			name:     "daily at midnight",
+			schedule: reporting.ScheduleDaily,
+			now:      time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			start:    time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			end:      endOfDay(2024, 2, 29),
+		},
+		{
+			name:     "daily converts to UTC",
+			schedule: reporting.ScheduleDaily,
+			now:      time.Date(2024, 3, 13, 22, 0, 0, 0, time.FixedZone("UTC-3", -3*60*60)),
+			start:    time.Date(2024, 3, 13, 0, 0, 0, 0, time.UTC),
+			end:      endOfDay(2024, 3, 13),
+		},
+		{
+			name:     "weekly on wednesday",
+			schedule: reporting.ScheduleWeekly,
+			now:      time.Date(2024, 3, 13, 15, 30, 0, 0, time.UTC),
+			start:    time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+			end:      endOfDay(2024, 3, 10),
+		},
+		{
+			name:     "weekly on monday",
+			schedule: reporting.ScheduleWeekly,
+			now:      time.Date(2024, 3, 11, 0, 5, 0, 0, time.UTC),
+			start:    time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+			end:      endOfDay(2024, 3, 10),
+		},
+		{
+			name:     "weekly on sunday",
+			schedule: reporting.ScheduleWeekly,
+			now:      time.Date(2024, 3, 10, 23, 0, 0, 0, time.UTC),
+			start:    time.Date(2024, 2, 26, 0, 0, 0, 0, time.UTC),
+			end:      endOfDay(2024, 3, 3),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end := tc.schedule.LastCompletedPeriod(tc.now)
+			assert.Equal(t, tc.start, start)
+			assert.Equal(t, tc.end, end)
+		})
+	}
+}
+
+func TestSnapshotId(t *testing.T) {
+	periodStart := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "weekly:revenue:2024-03-04", reporting.SnapshotId(reporting.ScheduleWeekly, reporting.ReportTypeRevenue, periodStart))
+	assert.Equal(t, "daily:assets:2024-03-04", reporting.SnapshotId(reporting.ScheduleDaily, reporting.ReportTypeAssets, periodStart))
+}
+
+func TestAssetHistoryInterval(t *testing.T) {
+	assert.True(t, reporting.AssetHistoryIntervalRaw.IsValid())
+	assert.True(t, reporting.AssetHistoryIntervalMinute.IsValid())
+	assert.True(t, reporting.AssetHistoryIntervalHour.IsValid())
+	assert.True(t, reporting.AssetHistoryIntervalDay.IsValid())
+	assert.False(t, reporting.AssetHistoryInterval("week").IsValid())
+	assert.Zero(t, reporting.AssetHistoryIntervalRaw.Duration())
+	assert.Equal(t, time.Minute, reporting.AssetHistoryIntervalMinute.Duration())
+	assert.Equal(t, time.Hour, reporting.AssetHistoryIntervalHour.Duration())
+	assert.Equal(t, 24*time.Hour, reporting.AssetHistoryIntervalDay.Duration())
+}
+
+func TestAssetSnapshot_Total(t *testing.T) {
+	snapshot := reporting.AssetSnapshot{
+		Btc:  reporting.AssetBalance{Total: entities.NewWei(300)},
+		Rbtc: reporting.AssetBalance{Total: entities.NewWei(700)},
+	}
+	assert.Equal(t, entities.NewWei(1000), snapshot.Total())
+	assert.Equal(t, entities.NewWei(0), reporting.AssetSnapshot{}.Total())
+}
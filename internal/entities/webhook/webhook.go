@@ -0,0 +1,137 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+)
+
+var (
+	WebhookNotFoundError    = errors.New("webhook not found")
+	DuplicateWebhookError   = errors.New("webhook already registered")
+	InvalidCallbackUrlError = errors.New("invalid callback url")
+)
+
+// SignatureType indicates how the body of a notification was signed, so the integrator knows how to
+// verify it
+type SignatureType string
+
+const (
+	// SignatureTypeHmac is used when the webhook has a shared secret, the signature is the HMAC-SHA256
+	// of the body using the secret as key
+	SignatureTypeHmac SignatureType = "hmac-sha256"
+	// SignatureTypeProvider is used when the webhook doesn't have a shared secret, the signature is done
+	// with the liquidity provider RSK key over the keccak256 hash of the body
+	SignatureTypeProvider SignatureType = "lp-signature"
+)
+
+type Operation string
+
+const (
+	OperationPegin  Operation = "pegin"
+	OperationPegout Operation = "pegout"
+)
+
+// Webhook is an URL registered by an integrator to receive the state transitions of the quotes. If the
+// QuoteHash is empty the webhook is global and receives the notifications of every quote, otherwise it
+// only receives the notifications of that quote. Callback webhooks are the ones registered by the users
+// when accepting a quote, they can only be delivered to public hosts
+type Webhook struct {
+	Id        string    `json:"id" bson:"id"`
+	Url       string    `json:"url" bson:"url"`
+	QuoteHash string    `json:"quoteHash,omitempty" bson:"quote_hash"`
+	Secret    string    `json:"-" bson:"secret,omitempty"`
+	Callback  bool      `json:"callback" bson:"callback"`
+	CreatedAt time.Time `json:"createdAt" bson:"created_at"`
+}
+
+func (webhook Webhook) IsGlobal() bool {
+	return webhook.QuoteHash == ""
+}
+
+func (webhook Webhook) SignatureType() SignatureType {
+	if webhook.Secret == "" {
+		return SignatureTypeProvider
+	}
+	return SignatureTypeHmac
+}
+
+// ValidateCallbackUrl checks that the callback URL of an accept request uses https and that its host is
+// not a loopback, private or link-local address. Hostnames are checked again when the notification is
+// delivered, as they can resolve to any address
+func ValidateCallbackUrl(callbackUrl string) error {
+	parsedUrl, err := url.Parse(callbackUrl)
+	if err != nil {
+		return errors.Join(InvalidCallbackUrlError, err)
+	}
+	if parsedUrl.Scheme != "https" {
+		return errors.Join(InvalidCallbackUrlError, errors.New("only https urls are allowed"))
+	}
+	host := strings.ToLower(parsedUrl.Hostname())
+	if host == "" || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errors.Join(InvalidCallbackUrlError, errors.New("invalid host"))
+	}
+	if ip := net.ParseIP(host); ip != nil && !IsPublicIp(ip) {
+		return errors.Join(InvalidCallbackUrlError, errors.New("non public hosts are not allowed"))
+	}
+	return nil
+}
+
+// IsPublicIp returns false for the loopback, private, link-local, multicast and unspecified addresses
+func IsPublicIp(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !ip.IsUnspecified()
+}
+
+// Notification is the body sent to the webhooks every time a quote changes its state
+type Notification struct {
+	QuoteHash string    `json:"quoteHash"`
+	Operation Operation `json:"operation"`
+	State     string    `json:"state"`
+	Timestamp time.Time `json:"timestamp"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// finalStates are the states after which a quote doesn't have more state transitions
+var finalStates = map[Operation][]string{
+	OperationPegin: {
+		string(quote.PeginStateTimeForDepositElapsed),
+		string(quote.PeginStateCallForUserFailed),
+		string(quote.PeginStateRegisterPegInSucceeded),
+		string(quote.PeginStateRegisterPegInFailed),
+	},
+	OperationPegout: {
+		string(quote.PegoutStateTimeForDepositElapsed),
+		string(quote.PegoutStateSendPegoutFailed),
+		string(quote.PegoutStateRefundPegOutFailed),
+		string(quote.PegoutStateBridgeTxFailed),
+		string(quote.PegoutStateBtcReleased),
+	},
+}
+
+// IsFinal returns true if the notification is the last state transition of the quote
+func (notification Notification) IsFinal() bool {
+	return slices.Contains(finalStates[notification.Operation], notification.State)
+}
+
+type WebhookRepository interface {
+	// InsertWebhook stores a webhook, it returns DuplicateWebhookError if there is already a webhook
+	// with the same URL for the same quote (or a global one with the same URL)
+	InsertWebhook(ctx context.Context, webhook Webhook) error
+	// GetWebhooks returns the global webhooks and, if a quote hash is provided, the webhooks of that quote
+	GetWebhooks(ctx context.Context, quoteHash string) ([]Webhook, error)
+	GetGlobalWebhooks(ctx context.Context) ([]Webhook, error)
+	DeleteWebhook(ctx context.Context, id string) error
+	// DeleteQuoteWebhooks removes the webhooks registered for a quote, the global webhooks are not affected
+	DeleteQuoteWebhooks(ctx context.Context, quoteHash string) error
+}
+
+type NotificationSender interface {
+	SendNotification(ctx context.Context, webhook Webhook, notification Notification) error
+}
@@ -0,0 +1,75 @@
+package webhook_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCallbackUrl(t *testing.T) {
+	validUrls := []string{
+		"https://integrator.example.com/callback",
+		"https://integrator.example.com:8443/callback?id=1",
+		"https://8.8.8.8/callback",
+	}
+	for _, callbackUrl := range validUrls {
+		require.NoError(t, webhook.ValidateCallbackUrl(callbackUrl), callbackUrl)
+	}
+	invalidUrls := []string{
+		"http://integrator.example.com/callback",
+		"ftp://integrator.example.com/callback",
+		"https:///callback",
+		"https://localhost/callback",
+		"https://api.localhost/callback",
+		"https://127.0.0.1/callback",
+		"https://10.0.0.5/callback",
+		"https://192.168.1.1/callback",
+		"https://172.16.0.1/callback",
+		"https://169.254.169.254/latest/meta-data",
+		"https://[::1]/callback",
+		"https://[fe80::1]/callback",
+		"https://[fd00::1]/callback",
+		"https://0.0.0.0/callback",
+		"://invalid",
+	}
+	for _, callbackUrl := range invalidUrls {
+		require.ErrorIs(t, webhook.ValidateCallbackUrl(callbackUrl), webhook.InvalidCallbackUrlError, callbackUrl)
+	}
+}
+
+func TestIsPublicIp(t *testing.T) {
+	assert.True(t, webhook.IsPublicIp(net.ParseIP("8.8.8.8")))
+	assert.True(t, webhook.IsPublicIp(net.ParseIP("2001:4860:4860::8888")))
+	assert.False(t, webhook.IsPublicIp(net.ParseIP("127.0.0.1")))
+	assert.False(t, webhook.IsPublicIp(net.ParseIP("10.1.2.3")))
+	assert.False(t, webhook.IsPublicIp(net.ParseIP("169.254.1.1")))
+	assert.False(t, webhook.IsPublicIp(net.ParseIP("224.0.0.1")))
+	assert.False(t, webhook.IsPublicIp(net.ParseIP("::")))
+}
+
+func TestNotification_IsFinal(t *testing.T) {
+	finalNotifications := []webhook.Notification{
+		{Operation: webhook.OperationPegin, State: "RegisterPegInSucceeded"},
+		{Operation: webhook.OperationPegin, State: "CallForUserFailed"},
+		{Operation: webhook.OperationPegin, State: "TimeForDepositElapsed"},
+		{Operation: webhook.OperationPegout, State: "BtcReleased"},
+		{Operation: webhook.OperationPegout, State: "SendPegoutFailed"},
+		{Operation: webhook.OperationPegout, State: "BridgeTxFailed"},
+	}
+	for _, notification := range finalNotifications {
+		assert.True(t, notification.IsFinal(), notification.State)
+	}
+	nonFinalNotifications := []webhook.Notification{
+		{Operation: webhook.OperationPegin, State: "WaitingForDeposit"},
+		{Operation: webhook.OperationPegin, State: "CallForUserSucceeded"},
+		{Operation: webhook.OperationPegout, State: "SendPegoutSucceeded"},
+		{Operation: webhook.OperationPegout, State: "RegisterPegInSucceeded"},
+		{Operation: "unknown", State: "BtcReleased"},
+	}
+	for _, notification := range nonFinalNotifications {
+		assert.False(t, notification.IsFinal(), notification.State)
+	}
+}
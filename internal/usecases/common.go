@@ -1,12 +1,15 @@
 package usecases
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	log "github.com/sirupsen/logrus"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
@@ -22,59 +25,83 @@ type UseCaseId string
 const EthereumSignedMessagePrefix = "\x19Ethereum Signed Message:\n32"
 
 const (
-	GetPeginQuoteId            UseCaseId = "GetPeginQuote"
-	GetPegoutQuoteId           UseCaseId = "GetPegoutQuote"
-	AcceptPeginQuoteId         UseCaseId = "AcceptPeginQuote"
-	AcceptPegoutQuoteId        UseCaseId = "AcceptPegoutQuote"
-	ProviderDetailId           UseCaseId = "ProviderDetail"
-	GetProvidersId             UseCaseId = "GetProviders"
-	GetUserQuotesId            UseCaseId = "GetUserQuotes"
-	ProviderResignId           UseCaseId = "ProviderResign"
-	ChangeProviderStatusId     UseCaseId = "ChangeProviderStatus"
-	GetCollateralId            UseCaseId = "GetCollateral"
-	GetPegoutCollateralId      UseCaseId = "GetPegoutCollateral"
-	AddCollateralId            UseCaseId = "AddCollateral"
-	AddPegoutCollateralId      UseCaseId = "AddPegoutCollateral"
-	WithdrawCollateralId       UseCaseId = "WithdrawCollateral"
-	WithdrawPegoutCollateralId UseCaseId = "WithdrawPegoutCollateral"
-	CallForUserId              UseCaseId = "CallForUser"
-	RegisterPeginId            UseCaseId = "RegisterPegin"
-	SendPegoutId               UseCaseId = "SendPegout"
-	RefundPegoutId             UseCaseId = "RefundPegout"
-	ProviderRegistrationId     UseCaseId = "ProviderRegistration"
-	GetWatchedPeginQuoteId     UseCaseId = "GetWatchedPeginQuote"
-	GetWatchedPegoutQuoteId    UseCaseId = "GetWatchedPegoutQuote"
-	ExpiredPeginQuoteId        UseCaseId = "ExpiredPeginQuote"
-	ExpiredPegoutQuoteId       UseCaseId = "ExpiredPegoutQuote"
-	UpdatePegoutDepositId      UseCaseId = "UpdatePegoutDeposit"
-	InitPegoutDepositCacheId   UseCaseId = "InitPegoutDepositCache"
-	CheckLiquidityId           UseCaseId = "CheckLiquidity"
-	PenalizationId             UseCaseId = "Penalization"
-	SetPeginConfigId           UseCaseId = "SetPeginConfigUseCase"
-	SetPegoutConfigId          UseCaseId = "SetPegoutConfigUseCase"
-	SetGeneralConfigId         UseCaseId = "SetGeneralConfigUseCase"
-	UpdateTrustedAccountId     UseCaseId = "UpdateTrustedAccountUseCase"
-	AddTrustedAccountId        UseCaseId = "AddTrustedAccountUseCase"
-	DeleteTrustedAccountId     UseCaseId = "DeleteTrustedAccountUseCase"
-	LoginId                    UseCaseId = "Login"
-	ChangeCredentialsId        UseCaseId = "ChangeCredentials"
-	DefaultCredentialsId       UseCaseId = "GenerateDefaultCredentials"
-	GetManagementUiId          UseCaseId = "GetManagementUi"
-	BridgePegoutId             UseCaseId = "BridgePegout"
-	PeginQuoteStatusId         UseCaseId = "PeginQuoteStatus"
-	PegoutQuoteStatusId        UseCaseId = "PegoutQuoteStatus"
-	GetAvailableLiquidityId    UseCaseId = "GetAvailableLiquidity"
-	UpdatePeginDepositId       UseCaseId = "UpdatePeginDeposit"
-	ServerInfoId               UseCaseId = "ServerInfo"
-	SummariesUseCaseId         UseCaseId = "Summaries"
-	GetPeginReportId           UseCaseId = "GetPeginReport"
-	GetPegoutReportId          UseCaseId = "GetPegoutReport"
-	GetRevenueReportId         UseCaseId = "GetRevenueReport"
-	GetTransactionsReportId    UseCaseId = "GetTransactionsReport"
-	EclipseCheckId             UseCaseId = "EclipseCheck"
-	UpdateBtcReleaseId         UseCaseId = "UpdateBtcRelease"
-	RecommendedPegoutId        UseCaseId = "RecommendedPegout"
-	RecommendedPeginId         UseCaseId = "RecommendedPegin"
+	GetPeginQuoteId              UseCaseId = "GetPeginQuote"
+	GetPegoutQuoteId             UseCaseId = "GetPegoutQuote"
+	AcceptPeginQuoteId           UseCaseId = "AcceptPeginQuote"
+	AcceptPegoutQuoteId          UseCaseId = "AcceptPegoutQuote"
+	ProviderDetailId             UseCaseId = "ProviderDetail"
+	GetProvidersId               UseCaseId = "GetProviders"
+	GetUserQuotesId              UseCaseId = "GetUserQuotes"
+	ProviderResignId             UseCaseId = "ProviderResign"
+	ChangeProviderStatusId       UseCaseId = "ChangeProviderStatus"
+	GetCollateralId              UseCaseId = "GetCollateral"
+	GetPegoutCollateralId        UseCaseId = "GetPegoutCollateral"
+	AddCollateralId              UseCaseId = "AddCollateral"
+	AddPegoutCollateralId        UseCaseId = "AddPegoutCollateral"
+	WithdrawCollateralId         UseCaseId = "WithdrawCollateral"
+	WithdrawPegoutCollateralId   UseCaseId = "WithdrawPegoutCollateral"
+	CallForUserId                UseCaseId = "CallForUser"
+	RegisterPeginId              UseCaseId = "RegisterPegin"
+	SendPegoutId                 UseCaseId = "SendPegout"
+	RefundPegoutId               UseCaseId = "RefundPegout"
+	ProviderRegistrationId       UseCaseId = "ProviderRegistration"
+	GetWatchedPeginQuoteId       UseCaseId = "GetWatchedPeginQuote"
+	GetWatchedPegoutQuoteId      UseCaseId = "GetWatchedPegoutQuote"
+	ExpiredPeginQuoteId          UseCaseId = "ExpiredPeginQuote"
+	ExpiredPegoutQuoteId         UseCaseId = "ExpiredPegoutQuote"
+	UpdatePegoutDepositId        UseCaseId = "UpdatePegoutDeposit"
+	InitPegoutDepositCacheId     UseCaseId = "InitPegoutDepositCache"
+	CheckLiquidityId             UseCaseId = "CheckLiquidity"
+	PenalizationId               UseCaseId = "Penalization"
+	SetPeginConfigId             UseCaseId = "SetPeginConfigUseCase"
+	SetPegoutConfigId            UseCaseId = "SetPegoutConfigUseCase"
+	SetGeneralConfigId           UseCaseId = "SetGeneralConfigUseCase"
+	UpdateTrustedAccountId       UseCaseId = "UpdateTrustedAccountUseCase"
+	AddTrustedAccountId          UseCaseId = "AddTrustedAccountUseCase"
+	DeleteTrustedAccountId       UseCaseId = "DeleteTrustedAccountUseCase"
+	LoginId                      UseCaseId = "Login"
+	ChangeCredentialsId          UseCaseId = "ChangeCredentials"
+	DefaultCredentialsId         UseCaseId = "GenerateDefaultCredentials"
+	GetManagementUiId            UseCaseId = "GetManagementUi"
+	BridgePegoutId               UseCaseId = "BridgePegout"
+	PeginQuoteStatusId           UseCaseId = "PeginQuoteStatus"
+	PegoutQuoteStatusId          UseCaseId = "PegoutQuoteStatus"
+	GetAvailableLiquidityId      UseCaseId = "GetAvailableLiquidity"
+	UpdatePeginDepositId         UseCaseId = "UpdatePeginDeposit"
+	ServerInfoId                 UseCaseId = "ServerInfo"
+	SummariesUseCaseId           UseCaseId = "Summaries"
+	GetPeginReportId             UseCaseId = "GetPeginReport"
+	GetPegoutReportId            UseCaseId = "GetPegoutReport"
+	GetRevenueReportId           UseCaseId = "GetRevenueReport"
+	GetRevenueJournalId          UseCaseId = "GetRevenueJournal"
+	GetAnalyticsReportId         UseCaseId = "GetAnalyticsReport"
+	GetQuotePnlId                UseCaseId = "GetQuotePnl"
+	CreateReportSnapshotsId      UseCaseId = "CreateReportSnapshots"
+	GetReportSnapshotsId         UseCaseId = "GetReportSnapshots"
+	CreateAssetSnapshotId        UseCaseId = "CreateAssetSnapshot"
+	GetAssetHistoryId            UseCaseId = "GetAssetHistory"
+	CheckAssetDriftId            UseCaseId = "CheckAssetDrift"
+	GetTransactionsReportId      UseCaseId = "GetTransactionsReport"
+	EclipseCheckId               UseCaseId = "EclipseCheck"
+	UpdateBtcReleaseId           UseCaseId = "UpdateBtcRelease"
+	RecommendedPegoutId          UseCaseId = "RecommendedPegout"
+	RecommendedPeginId           UseCaseId = "RecommendedPegin"
+	GetRecentAlertsId            UseCaseId = "GetRecentAlerts"
+	RegisterWebhookId            UseCaseId = "RegisterWebhook"
+	GetWebhooksId                UseCaseId = "GetWebhooks"
+	DeleteWebhookId              UseCaseId = "DeleteWebhook"
+	NotifyQuoteStateId           UseCaseId = "NotifyQuoteState"
+	PeginDepositConfirmationsId  UseCaseId = "PeginDepositConfirmations"
+	PegoutDepositConfirmationsId UseCaseId = "PegoutDepositConfirmations"
+	GetLiquidityHoldsId          UseCaseId = "GetLiquidityHolds"
+	ReleaseLiquidityHoldId       UseCaseId = "ReleaseLiquidityHold"
+	ReconcileLiquidityLedgerId   UseCaseId = "ReconcileLiquidityLedger"
+	GetPeginQuotesId             UseCaseId = "GetPeginQuotes"
+	GetPegoutQuotesId            UseCaseId = "GetPegoutQuotes"
+	EstimatePeginQuoteId         UseCaseId = "EstimatePeginQuote"
+	EstimatePegoutQuoteId        UseCaseId = "EstimatePegoutQuote"
+	BumpPegoutFeeId              UseCaseId = "BumpPegoutFee"
+	SendBatchPegoutId            UseCaseId = "SendBatchPegout"
 )
 
 var (
@@ -95,6 +122,9 @@ var (
 	NonPositiveWeiError             = errors.New("wei value must be positive")
 	EmptyConfirmationsMapError      = errors.New("confirmations map cannot be empty")
 	NonPositiveConfirmationKeyError = errors.New("confirmation amount key must be positive")
+	InvalidPartnerAuthError         = errors.New("invalid partner authentication")
+	QuoteBatchSizeError             = errors.New("invalid quote batch size")
+	AssetHistoryRangeError          = errors.New("asset history range too long for the interval")
 )
 
 type RecommendedOperationResult struct {
@@ -274,6 +304,94 @@ func ValidateConfirmations(useCase UseCaseId, confirmations liquidity_provider.C
 	return nil
 }
 
+// GetFeeConditions returns the conditions used to resolve the fees of a quote created at this moment. The available
+// liquidity is only fetched when the configured surcharges depend on it
+func GetFeeConditions(
+	ctx context.Context,
+	surcharges *liquidity_provider.FeeSurcharges,
+	availableLiquidity func(ctx context.Context) (*entities.Wei, error),
+) (liquidity_provider.FeeConditions, error) {
+	conditions := liquidity_provider.FeeConditions{Time: time.Now()}
+	if !surcharges.DependsOnLiquidity() {
+		return conditions, nil
+	}
+	liquidity, err := availableLiquidity(ctx)
+	if err != nil {
+		return liquidity_provider.FeeConditions{}, fmt.Errorf("error getting available liquidity for fee surcharges: %w", err)
+	}
+	conditions.AvailableLiquidity = liquidity
+	return conditions, nil
+}
+
+// PartnerAuthenticationValidity is the maximum difference between the timestamp of a partner authentication and the
+// moment it is verified. Inside this window each authentication can only be used once
+const PartnerAuthenticationValidity = 5 * time.Minute
+
+// PartnerAuthentication is the proof that a quote request was made by a trusted account. Signature is an Ethereum
+// signed message (personal_sign) of the Keccak256 hash of the decimal Unix Timestamp concatenated with the Payload,
+// which is the raw body of the request.
+type PartnerAuthentication struct {
+	Signature string
+	Timestamp int64
+	Payload   []byte
+}
+
+func (auth PartnerAuthentication) hash() []byte {
+	messageHash := crypto.Keccak256([]byte(strconv.FormatInt(auth.Timestamp, 10)), auth.Payload)
+	return crypto.Keccak256([]byte(EthereumSignedMessagePrefix), messageHash)
+}
+
+// id identifies the authentication by its signer and signed message instead of the signature, since the same message
+// can have more than one valid signature
+func (auth PartnerAuthentication) id(address string) string {
+	return strings.ToLower(address) + ":" + hex.EncodeToString(auth.hash())
+}
+
+// Signer returns the address that signed the authentication if its timestamp is inside the validity window. It
+// doesn't check if the signer is a trusted account nor if the authentication was already used
+func (auth PartnerAuthentication) Signer() (string, error) {
+	elapsed := time.Since(time.Unix(auth.Timestamp, 0))
+	if elapsed > PartnerAuthenticationValidity || elapsed < -PartnerAuthenticationValidity {
+		return "", fmt.Errorf("%w: timestamp out of the validity window", InvalidPartnerAuthError)
+	}
+	address, err := RecoverSignerAddress(auth.Signature, func() ([]byte, error) { return auth.hash(), nil })
+	if err != nil {
+		return "", errors.Join(InvalidPartnerAuthError, err)
+	}
+	return address, nil
+}
+
+// GetPartnerAccount returns the trusted account that signed the partner authentication. The integrity of the
+// trusted account is verified before returning it, and the authentication is registered so it can't be replayed.
+func GetPartnerAccount(
+	ctx context.Context,
+	auth PartnerAuthentication,
+	signer entities.Signer,
+	hashFunction entities.HashFunction,
+	repository liquidity_provider.TrustedAccountRepository,
+) (liquidity_provider.TrustedAccountDetails, error) {
+	address, err := auth.Signer()
+	if err != nil {
+		return liquidity_provider.TrustedAccountDetails{}, err
+	}
+	account, err := liquidity_provider.ValidateConfiguration(signer, hashFunction, func() (*entities.Signed[liquidity_provider.TrustedAccountDetails], error) {
+		return repository.GetTrustedAccount(ctx, address)
+	})
+	if errors.Is(err, liquidity_provider.TrustedAccountNotFoundError) {
+		return liquidity_provider.TrustedAccountDetails{}, fmt.Errorf("%w: %s is not a trusted account", InvalidPartnerAuthError, address)
+	} else if err != nil {
+		return liquidity_provider.TrustedAccountDetails{}, errors.Join(liquidity_provider.TamperedTrustedAccountError, err)
+	}
+	expireAt := time.Unix(auth.Timestamp, 0).Add(PartnerAuthenticationValidity)
+	err = repository.RegisterPartnerAuthentication(ctx, auth.id(address), expireAt)
+	if errors.Is(err, liquidity_provider.UsedPartnerAuthError) {
+		return liquidity_provider.TrustedAccountDetails{}, errors.Join(InvalidPartnerAuthError, err)
+	} else if err != nil {
+		return liquidity_provider.TrustedAccountDetails{}, err
+	}
+	return account.Value, nil
+}
+
 func CheckPauseState(contracts ...blockchain.Pausable) error {
 	var err error
 	for _, contract := range contracts {
@@ -302,3 +420,11 @@ func checkPauseState(contract blockchain.Pausable) error {
 		pausedStatus.Since,
 	)
 }
+
+// ReleaseFailedAcceptHold releases the hold of a quote that couldn't be accepted after placing it. If the
+// release fails the hold is released by the ledger reconciliation, since the quote won't be retained.
+func ReleaseFailedAcceptHold(ctx context.Context, ledger liquidity_provider.LiquidityLedger, quoteHash string) {
+	if _, err := ledger.ReleaseHold(ctx, quoteHash, liquidity_provider.HoldReleasedByAcceptFailure); err != nil {
+		log.Errorf("Error releasing liquidity hold of quote %s: %v", quoteHash, err)
+	}
+}
@@ -1,11 +1,15 @@
 package usecases_test
 
 import (
+	"context"
 	"encoding/hex"
+	"encoding/json"
 	"math/big"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/rootstock"
 
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/bitcoin"
@@ -418,3 +422,153 @@ func TestCheckPauseState(t *testing.T) {
 		require.Contains(t, err.Error(), "5")
 	})
 }
+
+func TestGetFeeConditions(t *testing.T) {
+	utilizationSurcharges := &liquidity_provider.FeeSurcharges{
+		Utilization: []liquidity_provider.UtilizationSurcharge{
+			{Threshold: liquidity_provider.LiquidityThreshold{Amount: entities.NewWei(1)}, FeePercentage: utils.NewBigFloat64(1)},
+		},
+	}
+	t.Run("should not get the liquidity when surcharges don't depend on it", func(t *testing.T) {
+		for _, surcharges := range []*liquidity_provider.FeeSurcharges{nil, {TimeOfDay: []liquidity_provider.TimeOfDaySurcharge{{}}}} {
+			conditions, err := u.GetFeeConditions(context.Background(), surcharges, func(ctx context.Context) (*entities.Wei, error) {
+				t.Fatal("available liquidity should not be requested")
+				return nil, nil
+			})
+			require.NoError(t, err)
+			assert.Nil(t, conditions.AvailableLiquidity)
+			assert.WithinDuration(t, time.Now(), conditions.Time, time.Minute)
+		}
+	})
+	t.Run("should get the liquidity when there are utilization surcharges", func(t *testing.T) {
+		conditions, err := u.GetFeeConditions(context.Background(), utilizationSurcharges, func(ctx context.Context) (*entities.Wei, error) {
+			return entities.NewWei(500), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(500), conditions.AvailableLiquidity)
+		assert.WithinDuration(t, time.Now(), conditions.Time, time.Minute)
+	})
+	t.Run("should handle error getting the liquidity", func(t *testing.T) {
+		conditions, err := u.GetFeeConditions(context.Background(), utilizationSurcharges, func(ctx context.Context) (*entities.Wei, error) {
+			return nil, assert.AnError
+		})
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Empty(t, conditions)
+	})
+}
+
+// nolint:funlen
+func TestGetPartnerAccount(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	account := liquidity_provider.TrustedAccountDetails{
+		Address:        address,
+		Name:           "partner",
+		BtcLockingCap:  entities.NewWei(100),
+		RbtcLockingCap: entities.NewWei(100),
+		PeginTerms:     &liquidity_provider.PartnerTerms{FeePercentage: utils.NewBigFloat64(0.1)},
+	}
+	accountBytes, err := json.Marshal(account)
+	require.NoError(t, err)
+	signedAccount := &entities.Signed[liquidity_provider.TrustedAccountDetails]{
+		Value:     account,
+		Hash:      hex.EncodeToString(crypto.Keccak256(accountBytes)),
+		Signature: "01",
+	}
+	payload := []byte(`{"valueToTransfer":5000}`)
+	now := time.Now().Unix()
+
+	t.Run("should return the account that signed the request", func(t *testing.T) {
+		repository := &mocks.TrustedAccountRepositoryMock{}
+		repository.EXPECT().GetTrustedAccount(mock.Anything, address).Return(signedAccount, nil).Once()
+		repository.EXPECT().RegisterPartnerAuthentication(mock.Anything, mock.MatchedBy(func(id string) bool {
+			return strings.HasPrefix(id, strings.ToLower(address)+":")
+		}), time.Unix(now, 0).Add(u.PartnerAuthenticationValidity)).Return(nil).Once()
+		signer := &mocks.SignerMock{}
+		signer.On("Validate", signedAccount.Signature, signedAccount.Hash).Return(true).Once()
+		auth := u.PartnerAuthentication{Signature: test.SignPartnerRequest(t, key, now, payload), Timestamp: now, Payload: payload}
+		result, err := u.GetPartnerAccount(context.Background(), auth, signer, crypto.Keccak256, repository)
+		require.NoError(t, err)
+		assert.Equal(t, account, result)
+		repository.AssertExpectations(t)
+		signer.AssertExpectations(t)
+	})
+	t.Run("should reject an expired authentication", func(t *testing.T) {
+		timestamp := time.Now().Add(-u.PartnerAuthenticationValidity - time.Minute).Unix()
+		auth := u.PartnerAuthentication{Signature: test.SignPartnerRequest(t, key, timestamp, payload), Timestamp: timestamp, Payload: payload}
+		result, err := u.GetPartnerAccount(context.Background(), auth, &mocks.SignerMock{}, crypto.Keccak256, &mocks.TrustedAccountRepositoryMock{})
+		require.ErrorIs(t, err, u.InvalidPartnerAuthError)
+		assert.Empty(t, result)
+	})
+	t.Run("should reject an invalid signature", func(t *testing.T) {
+		auth := u.PartnerAuthentication{Signature: "0102", Timestamp: now, Payload: payload}
+		result, err := u.GetPartnerAccount(context.Background(), auth, &mocks.SignerMock{}, crypto.Keccak256, &mocks.TrustedAccountRepositoryMock{})
+		require.ErrorIs(t, err, u.InvalidPartnerAuthError)
+		assert.Empty(t, result)
+	})
+	t.Run("should reject a signature over a different payload", func(t *testing.T) {
+		repository := &mocks.TrustedAccountRepositoryMock{}
+		repository.EXPECT().GetTrustedAccount(mock.Anything, mock.MatchedBy(func(recovered string) bool {
+			return recovered != address
+		})).Return(nil, liquidity_provider.TrustedAccountNotFoundError).Once()
+		auth := u.PartnerAuthentication{Signature: test.SignPartnerRequest(t, key, now, payload), Timestamp: now, Payload: []byte(`{"valueToTransfer":1}`)}
+		result, err := u.GetPartnerAccount(context.Background(), auth, &mocks.SignerMock{}, crypto.Keccak256, repository)
+		require.ErrorIs(t, err, u.InvalidPartnerAuthError)
+		assert.Empty(t, result)
+		repository.AssertExpectations(t)
+	})
+	t.Run("should reject a tampered account", func(t *testing.T) {
+		repository := &mocks.TrustedAccountRepositoryMock{}
+		repository.EXPECT().GetTrustedAccount(mock.Anything, address).Return(signedAccount, nil).Once()
+		signer := &mocks.SignerMock{}
+		signer.On("Validate", signedAccount.Signature, signedAccount.Hash).Return(false).Once()
+		auth := u.PartnerAuthentication{Signature: test.SignPartnerRequest(t, key, now, payload), Timestamp: now, Payload: payload}
+		result, err := u.GetPartnerAccount(context.Background(), auth, signer, crypto.Keccak256, repository)
+		require.ErrorIs(t, err, liquidity_provider.TamperedTrustedAccountError)
+		assert.Empty(t, result)
+	})
+	t.Run("should reject a replayed authentication", func(t *testing.T) {
+		var firstId string
+		repository := &mocks.TrustedAccountRepositoryMock{}
+		repository.EXPECT().GetTrustedAccount(mock.Anything, address).Return(signedAccount, nil).Twice()
+		repository.EXPECT().RegisterPartnerAuthentication(mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, id string, _ time.Time) error {
+				if firstId == id {
+					return liquidity_provider.UsedPartnerAuthError
+				}
+				firstId = id
+				return nil
+			}).Twice()
+		signer := &mocks.SignerMock{}
+		signer.On("Validate", signedAccount.Signature, signedAccount.Hash).Return(true).Twice()
+		auth := u.PartnerAuthentication{Signature: test.SignPartnerRequest(t, key, now, payload), Timestamp: now, Payload: payload}
+		_, err := u.GetPartnerAccount(context.Background(), auth, signer, crypto.Keccak256, repository)
+		require.NoError(t, err)
+		result, err := u.GetPartnerAccount(context.Background(), auth, signer, crypto.Keccak256, repository)
+		require.ErrorIs(t, err, u.InvalidPartnerAuthError)
+		require.ErrorIs(t, err, liquidity_provider.UsedPartnerAuthError)
+		assert.Empty(t, result)
+		repository.AssertExpectations(t)
+	})
+	t.Run("should propagate errors registering the authentication", func(t *testing.T) {
+		repository := &mocks.TrustedAccountRepositoryMock{}
+		repository.EXPECT().GetTrustedAccount(mock.Anything, address).Return(signedAccount, nil).Once()
+		repository.EXPECT().RegisterPartnerAuthentication(mock.Anything, mock.Anything, mock.Anything).Return(assert.AnError).Once()
+		signer := &mocks.SignerMock{}
+		signer.On("Validate", signedAccount.Signature, signedAccount.Hash).Return(true).Once()
+		auth := u.PartnerAuthentication{Signature: test.SignPartnerRequest(t, key, now, payload), Timestamp: now, Payload: payload}
+		result, err := u.GetPartnerAccount(context.Background(), auth, signer, crypto.Keccak256, repository)
+		require.ErrorIs(t, err, assert.AnError)
+		require.NotErrorIs(t, err, u.InvalidPartnerAuthError)
+		assert.Empty(t, result)
+	})
+	t.Run("should propagate repository errors", func(t *testing.T) {
+		repository := &mocks.TrustedAccountRepositoryMock{}
+		repository.EXPECT().GetTrustedAccount(mock.Anything, address).Return(nil, assert.AnError).Once()
+		auth := u.PartnerAuthentication{Signature: test.SignPartnerRequest(t, key, now, payload), Timestamp: now, Payload: payload}
+		result, err := u.GetPartnerAccount(context.Background(), auth, &mocks.SignerMock{}, crypto.Keccak256, repository)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Empty(t, result)
+	})
+}
@@ -27,6 +27,9 @@ func NewAddTrustedAccountUseCase(
 }
 
 func (useCase *AddTrustedAccountUseCase) Run(ctx context.Context, account liquidity_provider.TrustedAccountDetails) error {
+	if err := account.ValidateTerms(); err != nil {
+		return usecases.WrapUseCaseError(usecases.AddTrustedAccountId, err)
+	}
 	signedAccount, err := usecases.SignConfiguration(usecases.AddTrustedAccountId, useCase.signer, useCase.hashFunc, account)
 	if err != nil {
 		return err
@@ -115,4 +115,22 @@ func TestAddTrustedAccountUseCase_Run(t *testing.T) { //nolint:funlen
 		signer.AssertExpectations(t)
 		hashMock.AssertExpectations(t)
 	})
+	t.Run("Invalid partner terms error", func(t *testing.T) {
+		repo := &mocks.TrustedAccountRepositoryMock{}
+		signer := &mocks.TransactionSignerMock{}
+		hashMock := &mocks.HashMock{}
+		account := liquidity_provider.TrustedAccountDetails{
+			Address:        "0x123456",
+			Name:           "Test Account",
+			BtcLockingCap:  entities.NewWei(1000),
+			RbtcLockingCap: entities.NewWei(1000),
+			PeginTerms:     &liquidity_provider.PartnerTerms{MinValue: entities.NewWei(10), MaxValue: entities.NewWei(5)},
+		}
+		useCase := lp.NewAddTrustedAccountUseCase(repo, signer, hashMock.Hash)
+		err := useCase.Run(context.Background(), account)
+		require.ErrorIs(t, err, liquidity_provider.InvalidPartnerTermsError)
+		require.Contains(t, err.Error(), usecases.AddTrustedAccountId)
+		repo.AssertNotCalled(t, "AddTrustedAccount")
+		signer.AssertNotCalled(t, "SignBytes")
+	})
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
@@ -18,9 +20,68 @@ const (
 	PeginOperation  OperationType = "PegIn"
 	PegoutOperation OperationType = "PegOut"
 	MessageBody     string        = "You are out of liquidity to perform a %s. Please, do a deposit"
+	ResolvedBody    string        = "You have enough liquidity to perform a %s again"
+
+	LowLiquidityBody         string = "Your available liquidity to perform a %s is %v %s, below the %s threshold of %v %s"
+	LowLiquidityResolvedBody string = "Your available liquidity to perform a %s is above the %s threshold again"
+)
+
+func (operation OperationType) currency() string {
+	if operation == PegoutOperation {
+		return "BTC"
+	}
+	return "rBTC"
+}
+
+// LiquidityStatus is the available liquidity for an operation and its low liquidity thresholds in wei.
+// The thresholds are nil if they are not configured.
+type LiquidityStatus struct {
+	Available         *entities.Wei
+	WarningThreshold  *entities.Wei
+	CriticalThreshold *entities.Wei
+}
+
+type CheckLiquidityResult struct {
+	Pegin  LiquidityStatus
+	Pegout LiquidityStatus
+}
+
+type thresholdLevel struct {
+	name     string
+	severity alerts.AlertSeverity
+	kind     alerts.AlertKind
+	subject  string
+}
+
+var (
+	peginWarningLevel = thresholdLevel{
+		name:     "warning",
+		severity: alerts.AlertSeverityWarning,
+		kind:     alerts.AlertKindPeginLowLiquidityWarning,
+		subject:  alerts.AlertSubjectPeginLowLiquidityWarning,
+	}
+	peginCriticalLevel = thresholdLevel{
+		name:     "critical",
+		severity: alerts.AlertSeverityCritical,
+		kind:     alerts.AlertKindPeginLowLiquidityCritical,
+		subject:  alerts.AlertSubjectPeginLowLiquidityCritical,
+	}
+	pegoutWarningLevel = thresholdLevel{
+		name:     "warning",
+		severity: alerts.AlertSeverityWarning,
+		kind:     alerts.AlertKindPegoutLowLiquidityWarning,
+		subject:  alerts.AlertSubjectPegoutLowLiquidityWarning,
+	}
+	pegoutCriticalLevel = thresholdLevel{
+		name:     "critical",
+		severity: alerts.AlertSeverityCritical,
+		kind:     alerts.AlertKindPegoutLowLiquidityCritical,
+		subject:  alerts.AlertSubjectPegoutLowLiquidityCritical,
+	}
 )
 
 type CheckLiquidityUseCase struct {
+	lp             liquidity_provider.LiquidityProvider
 	peginProvider  liquidity_provider.PeginLiquidityProvider
 	pegoutProvider liquidity_provider.PegoutLiquidityProvider
 	contracts      blockchain.RskContracts
@@ -29,6 +90,7 @@ type CheckLiquidityUseCase struct {
 }
 
 func NewCheckLiquidityUseCase(
+	lp liquidity_provider.LiquidityProvider,
 	peginProvider liquidity_provider.PeginLiquidityProvider,
 	pegoutProvider liquidity_provider.PegoutLiquidityProvider,
 	contracts blockchain.RskContracts,
@@ -36,6 +98,7 @@ func NewCheckLiquidityUseCase(
 	recipient string,
 ) *CheckLiquidityUseCase {
 	return &CheckLiquidityUseCase{
+		lp:             lp,
 		peginProvider:  peginProvider,
 		pegoutProvider: pegoutProvider,
 		contracts:      contracts,
@@ -44,39 +107,125 @@ func NewCheckLiquidityUseCase(
 	}
 }
 
-func (useCase *CheckLiquidityUseCase) Run(ctx context.Context) error {
+func (useCase *CheckLiquidityUseCase) Run(ctx context.Context) (CheckLiquidityResult, error) {
 	minLockTxValueInWei, err := useCase.contracts.Bridge.GetMinimumLockTxValue()
 	if err != nil {
-		return usecases.WrapUseCaseError(usecases.CheckLiquidityId, err)
+		return CheckLiquidityResult{}, usecases.WrapUseCaseError(usecases.CheckLiquidityId, err)
 	}
 
 	err = useCase.peginProvider.HasPeginLiquidity(ctx, minLockTxValueInWei)
-	if errors.Is(err, usecases.NoLiquidityError) {
-		if err = useCase.alertSender.SendAlert(
-			ctx,
-			alerts.AlertSubjectPeginOutOfLiquidity,
-			fmt.Sprintf(MessageBody, PeginOperation),
-			[]string{useCase.recipient},
-		); err != nil {
-			log.Error("Error sending notification to liquidity provider: ", err)
-		}
-	} else if err != nil {
-		return usecases.WrapUseCaseError(usecases.CheckLiquidityId, err)
+	if err = useCase.notifyLiquidityStatus(ctx, err, alerts.AlertKindPeginOutOfLiquidity, alerts.AlertSubjectPeginOutOfLiquidity, PeginOperation, minLockTxValueInWei); err != nil {
+		return CheckLiquidityResult{}, usecases.WrapUseCaseError(usecases.CheckLiquidityId, err)
 	}
 
 	err = useCase.pegoutProvider.HasPegoutLiquidity(ctx, minLockTxValueInWei)
-	if errors.Is(err, usecases.NoLiquidityError) {
-		if err = useCase.alertSender.SendAlert(
-			ctx,
-			alerts.AlertSubjectPegoutOutOfLiquidity,
-			fmt.Sprintf(MessageBody, PegoutOperation),
-			[]string{useCase.recipient},
-		); err != nil {
-			log.Error("Error sending notification to liquidity provider: ", err)
-		}
-	} else if err != nil {
-		return usecases.WrapUseCaseError(usecases.CheckLiquidityId, err)
+	if err = useCase.notifyLiquidityStatus(ctx, err, alerts.AlertKindPegoutOutOfLiquidity, alerts.AlertSubjectPegoutOutOfLiquidity, PegoutOperation, minLockTxValueInWei); err != nil {
+		return CheckLiquidityResult{}, usecases.WrapUseCaseError(usecases.CheckLiquidityId, err)
+	}
+
+	thresholds := liquidity_provider.LiquidityThresholds{}
+	if configured := useCase.lp.GeneralConfiguration(ctx).LiquidityThresholds; configured != nil {
+		thresholds = *configured
 	}
 
+	peginLiquidity, err := useCase.peginProvider.AvailablePeginLiquidity(ctx)
+	if err != nil {
+		return CheckLiquidityResult{}, usecases.WrapUseCaseError(usecases.CheckLiquidityId, err)
+	}
+	pegoutLiquidity, err := useCase.pegoutProvider.AvailablePegoutLiquidity(ctx)
+	if err != nil {
+		return CheckLiquidityResult{}, usecases.WrapUseCaseError(usecases.CheckLiquidityId, err)
+	}
+
+	peginMaxValue := useCase.peginProvider.PeginConfiguration(ctx).MaxValue
+	pegoutMaxValue := useCase.pegoutProvider.PegoutConfiguration(ctx).MaxValue
+	return CheckLiquidityResult{
+		Pegin:  useCase.checkThresholds(ctx, PeginOperation, peginLiquidity, peginMaxValue, thresholds.Pegin, peginWarningLevel, peginCriticalLevel),
+		Pegout: useCase.checkThresholds(ctx, PegoutOperation, pegoutLiquidity, pegoutMaxValue, thresholds.Pegout, pegoutWarningLevel, pegoutCriticalLevel),
+	}, nil
+}
+
+func (useCase *CheckLiquidityUseCase) checkThresholds(
+	ctx context.Context,
+	operation OperationType,
+	available, maxValue *entities.Wei,
+	thresholds liquidity_provider.OperationLiquidityThresholds,
+	warningLevel, criticalLevel thresholdLevel,
+) LiquidityStatus {
+	status := LiquidityStatus{Available: available}
+	if thresholds.Warning != nil {
+		status.WarningThreshold = thresholds.Warning.ToWei(maxValue)
+		useCase.notifyThresholdStatus(ctx, operation, warningLevel, available, status.WarningThreshold)
+	}
+	if thresholds.Critical != nil {
+		status.CriticalThreshold = thresholds.Critical.ToWei(maxValue)
+		useCase.notifyThresholdStatus(ctx, operation, criticalLevel, available, status.CriticalThreshold)
+	}
+	return status
+}
+
+// notifyThresholdStatus sends a firing alert if the available liquidity is under the threshold or a resolved
+// alert otherwise, same as notifyLiquidityStatus the alert sender is responsible for discarding the repeated alerts
+func (useCase *CheckLiquidityUseCase) notifyThresholdStatus(
+	ctx context.Context,
+	operation OperationType,
+	level thresholdLevel,
+	available, threshold *entities.Wei,
+) {
+	alert := alerts.Alert{
+		Kind:     level.kind,
+		Subject:  level.subject,
+		Severity: level.severity,
+		Status:   alerts.AlertStatusFiring,
+		Message: fmt.Sprintf(
+			LowLiquidityBody, operation, available.ToRbtc(), operation.currency(),
+			level.name, threshold.ToRbtc(), operation.currency(),
+		),
+		Amounts: map[string]*entities.Wei{
+			alerts.AlertAmountAvailable: available,
+			alerts.AlertAmountThreshold: threshold,
+		},
+		Timestamp: time.Now(),
+	}
+	if available.Cmp(threshold) >= 0 {
+		alert.Severity = alerts.AlertSeverityInfo
+		alert.Status = alerts.AlertStatusResolved
+		alert.Message = fmt.Sprintf(LowLiquidityResolvedBody, operation, level.name)
+	}
+	if err := useCase.alertSender.SendAlert(ctx, alert, []string{useCase.recipient}); err != nil {
+		log.Error("Error sending low liquidity notification to liquidity provider: ", err)
+	}
+}
+
+// notifyLiquidityStatus sends a firing alert if the liquidity check failed because of lack of liquidity or
+// a resolved alert if the check succeeded, the alert sender is responsible for discarding the repeated alerts.
+// Any other liquidity check error is returned to the caller
+func (useCase *CheckLiquidityUseCase) notifyLiquidityStatus(
+	ctx context.Context,
+	checkErr error,
+	kind alerts.AlertKind,
+	subject string,
+	operation OperationType,
+	requiredLiquidity *entities.Wei,
+) error {
+	alert := alerts.Alert{
+		Kind:      kind,
+		Subject:   subject,
+		Severity:  alerts.AlertSeverityCritical,
+		Status:    alerts.AlertStatusFiring,
+		Message:   fmt.Sprintf(MessageBody, operation),
+		Amounts:   map[string]*entities.Wei{alerts.AlertAmountRequiredLiquidity: requiredLiquidity},
+		Timestamp: time.Now(),
+	}
+	if checkErr == nil {
+		alert.Severity = alerts.AlertSeverityInfo
+		alert.Status = alerts.AlertStatusResolved
+		alert.Message = fmt.Sprintf(ResolvedBody, operation)
+	} else if !errors.Is(checkErr, usecases.NoLiquidityError) {
+		return checkErr
+	}
+	if err := useCase.alertSender.SendAlert(ctx, alert, []string{useCase.recipient}); err != nil {
+		log.Error("Error sending notification to liquidity provider: ", err)
+	}
 	return nil
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	lp "github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/test"
@@ -18,19 +20,47 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func resolvedLiquidityAlert(t *testing.T, kind alerts.AlertKind, subject, message string) any {
+	return mock.MatchedBy(func(alert alerts.Alert) bool {
+		return alert.Kind == kind &&
+			assert.Equal(t, subject, alert.Subject) &&
+			assert.Equal(t, alerts.AlertStatusResolved, alert.Status) &&
+			assert.Equal(t, alerts.AlertSeverityInfo, alert.Severity) &&
+			assert.Equal(t, message, alert.Message) &&
+			assert.False(t, alert.Timestamp.IsZero())
+	})
+}
+
+func mockLiquidityWithoutThresholds(provider *mocks.ProviderMock) {
+	provider.On("GeneralConfiguration", test.AnyCtx).Return(lp.DefaultGeneralConfiguration()).Once()
+	provider.On("AvailablePeginLiquidity", test.AnyCtx).Return(entities.NewWei(5000), nil).Once()
+	provider.On("AvailablePegoutLiquidity", test.AnyCtx).Return(entities.NewWei(6000), nil).Once()
+	provider.On("PeginConfiguration", test.AnyCtx).Return(lp.DefaultPeginConfiguration()).Once()
+	provider.On("PegoutConfiguration", test.AnyCtx).Return(lp.DefaultPegoutConfiguration()).Once()
+}
+
 func TestCheckLiquidityUseCase_Run(t *testing.T) {
 	bridge := &mocks.BridgeMock{}
 	provider := &mocks.ProviderMock{}
 	alertSender := &mocks.AlertSenderMock{}
 	provider.On("HasPeginLiquidity", mock.Anything, mock.Anything).Return(nil).Once()
 	provider.On("HasPegoutLiquidity", mock.Anything, mock.Anything).Return(nil).Once()
+	mockLiquidityWithoutThresholds(provider)
 	bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(1000), nil).Once()
+	alertSender.On("SendAlert", test.AnyCtx,
+		resolvedLiquidityAlert(t, alerts.AlertKindPeginOutOfLiquidity, alerts.AlertSubjectPeginOutOfLiquidity, "You have enough liquidity to perform a PegIn again"),
+		[]string{"recipient"},
+	).Return(nil).Once()
+	alertSender.On("SendAlert", test.AnyCtx,
+		resolvedLiquidityAlert(t, alerts.AlertKindPegoutOutOfLiquidity, alerts.AlertSubjectPegoutOutOfLiquidity, "You have enough liquidity to perform a PegOut again"),
+		[]string{"recipient"},
+	).Return(nil).Once()
 	contracts := blockchain.RskContracts{Bridge: bridge}
-	useCase := liquidity_provider.NewCheckLiquidityUseCase(provider, provider, contracts, alertSender, "recipient")
-	err := useCase.Run(context.Background())
+	useCase := liquidity_provider.NewCheckLiquidityUseCase(provider, provider, provider, contracts, alertSender, "recipient")
+	_, err := useCase.Run(context.Background())
 	bridge.AssertExpectations(t)
 	provider.AssertExpectations(t)
-	alertSender.AssertNotCalled(t, "SendAlert")
+	alertSender.AssertExpectations(t)
 	require.NoError(t, err)
 }
 
@@ -41,16 +71,28 @@ func TestCheckLiquidityUseCase_Run_NoPeginLiquidity(t *testing.T) {
 	recipient := "recipient@test.com"
 	alertSender.On("SendAlert",
 		test.AnyCtx,
-		alerts.AlertSubjectPeginOutOfLiquidity,
-		"You are out of liquidity to perform a PegIn. Please, do a deposit",
+		mock.MatchedBy(func(alert alerts.Alert) bool {
+			return alert.Kind == alerts.AlertKindPeginOutOfLiquidity &&
+				assert.Equal(t, alerts.AlertSubjectPeginOutOfLiquidity, alert.Subject) &&
+				assert.Equal(t, alerts.AlertSeverityCritical, alert.Severity) &&
+				assert.Equal(t, alerts.AlertStatusFiring, alert.Status) &&
+				assert.Equal(t, "You are out of liquidity to perform a PegIn. Please, do a deposit", alert.Message) &&
+				assert.Equal(t, entities.NewWei(1000), alert.Amounts[alerts.AlertAmountRequiredLiquidity]) &&
+				assert.False(t, alert.Timestamp.IsZero())
+		}),
+		[]string{recipient},
+	).Return(nil).Once()
+	alertSender.On("SendAlert", test.AnyCtx,
+		resolvedLiquidityAlert(t, alerts.AlertKindPegoutOutOfLiquidity, alerts.AlertSubjectPegoutOutOfLiquidity, "You have enough liquidity to perform a PegOut again"),
 		[]string{recipient},
 	).Return(nil).Once()
 	provider.On("HasPeginLiquidity", mock.Anything, mock.Anything).Return(usecases.NoLiquidityError).Once()
 	provider.On("HasPegoutLiquidity", mock.Anything, mock.Anything).Return(nil).Once()
+	mockLiquidityWithoutThresholds(provider)
 	bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(1000), nil).Once()
 	contracts := blockchain.RskContracts{Bridge: bridge}
-	useCase := liquidity_provider.NewCheckLiquidityUseCase(provider, provider, contracts, alertSender, recipient)
-	err := useCase.Run(context.Background())
+	useCase := liquidity_provider.NewCheckLiquidityUseCase(provider, provider, provider, contracts, alertSender, recipient)
+	_, err := useCase.Run(context.Background())
 	bridge.AssertExpectations(t)
 	alertSender.AssertExpectations(t)
 	provider.AssertExpectations(t)
@@ -64,16 +106,28 @@ func TestCheckLiquidityUseCase_Run_NoPegoutLiquidity(t *testing.T) {
 	recipient := "recipient@test.com"
 	alertSender.On("SendAlert",
 		test.AnyCtx,
-		alerts.AlertSubjectPegoutOutOfLiquidity,
-		"You are out of liquidity to perform a PegOut. Please, do a deposit",
+		mock.MatchedBy(func(alert alerts.Alert) bool {
+			return alert.Kind == alerts.AlertKindPegoutOutOfLiquidity &&
+				assert.Equal(t, alerts.AlertSubjectPegoutOutOfLiquidity, alert.Subject) &&
+				assert.Equal(t, alerts.AlertSeverityCritical, alert.Severity) &&
+				assert.Equal(t, alerts.AlertStatusFiring, alert.Status) &&
+				assert.Equal(t, "You are out of liquidity to perform a PegOut. Please, do a deposit", alert.Message) &&
+				assert.Equal(t, entities.NewWei(1000), alert.Amounts[alerts.AlertAmountRequiredLiquidity]) &&
+				assert.False(t, alert.Timestamp.IsZero())
+		}),
+		[]string{recipient},
+	).Return(nil).Once()
+	alertSender.On("SendAlert", test.AnyCtx,
+		resolvedLiquidityAlert(t, alerts.AlertKindPeginOutOfLiquidity, alerts.AlertSubjectPeginOutOfLiquidity, "You have enough liquidity to perform a PegIn again"),
 		[]string{recipient},
 	).Return(nil).Once()
 	provider.On("HasPeginLiquidity", mock.Anything, mock.Anything).Return(nil).Once()
 	provider.On("HasPegoutLiquidity", mock.Anything, mock.Anything).Return(usecases.NoLiquidityError).Once()
+	mockLiquidityWithoutThresholds(provider)
 	bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(1000), nil).Once()
 	contracts := blockchain.RskContracts{Bridge: bridge}
-	useCase := liquidity_provider.NewCheckLiquidityUseCase(provider, provider, contracts, alertSender, recipient)
-	err := useCase.Run(context.Background())
+	useCase := liquidity_provider.NewCheckLiquidityUseCase(provider, provider, provider, contracts, alertSender, recipient)
+	_, err := useCase.Run(context.Background())
 	bridge.AssertExpectations(t)
 	provider.AssertExpectations(t)
 	alertSender.AssertExpectations(t)
@@ -99,6 +153,28 @@ func TestCheckLiquidityUseCase_Run_NoRecoverableErrorHandling(t *testing.T) {
 				bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(1000), nil).Once()
 				provider.On("HasPeginLiquidity", mock.Anything, mock.Anything).Return(nil).Once()
 				provider.On("HasPegoutLiquidity", mock.Anything, mock.Anything).Return(assert.AnError).Once()
+				sender.On("SendAlert", test.AnyCtx, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			Value: func(bridge *mocks.BridgeMock, provider *mocks.ProviderMock, sender *mocks.AlertSenderMock) {
+				bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(1000), nil).Once()
+				provider.On("HasPeginLiquidity", mock.Anything, mock.Anything).Return(nil).Once()
+				provider.On("HasPegoutLiquidity", mock.Anything, mock.Anything).Return(nil).Once()
+				provider.On("GeneralConfiguration", test.AnyCtx).Return(lp.DefaultGeneralConfiguration()).Once()
+				provider.On("AvailablePeginLiquidity", test.AnyCtx).Return((*entities.Wei)(nil), assert.AnError).Once()
+				sender.On("SendAlert", test.AnyCtx, mock.Anything, mock.Anything).Return(nil).Twice()
+			},
+		},
+		{
+			Value: func(bridge *mocks.BridgeMock, provider *mocks.ProviderMock, sender *mocks.AlertSenderMock) {
+				bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(1000), nil).Once()
+				provider.On("HasPeginLiquidity", mock.Anything, mock.Anything).Return(nil).Once()
+				provider.On("HasPegoutLiquidity", mock.Anything, mock.Anything).Return(nil).Once()
+				provider.On("GeneralConfiguration", test.AnyCtx).Return(lp.DefaultGeneralConfiguration()).Once()
+				provider.On("AvailablePeginLiquidity", test.AnyCtx).Return(entities.NewWei(1), nil).Once()
+				provider.On("AvailablePegoutLiquidity", test.AnyCtx).Return((*entities.Wei)(nil), assert.AnError).Once()
+				sender.On("SendAlert", test.AnyCtx, mock.Anything, mock.Anything).Return(nil).Twice()
 			},
 		},
 	}
@@ -108,8 +184,8 @@ func TestCheckLiquidityUseCase_Run_NoRecoverableErrorHandling(t *testing.T) {
 		sender := &mocks.AlertSenderMock{}
 		testCase.Value(bridge, provider, sender)
 		contracts := blockchain.RskContracts{Bridge: bridge}
-		useCase := liquidity_provider.NewCheckLiquidityUseCase(provider, provider, contracts, sender, recipient)
-		err := useCase.Run(context.Background())
+		useCase := liquidity_provider.NewCheckLiquidityUseCase(provider, provider, provider, contracts, sender, recipient)
+		_, err := useCase.Run(context.Background())
 		bridge.AssertExpectations(t)
 		provider.AssertExpectations(t)
 
@@ -126,7 +202,8 @@ func TestCheckLiquidityUseCase_Run_OnlyLogSendErrors(t *testing.T) {
 				bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(1000), nil).Once()
 				provider.On("HasPeginLiquidity", mock.Anything, mock.Anything).Return(usecases.NoLiquidityError).Once()
 				provider.On("HasPegoutLiquidity", mock.Anything, mock.Anything).Return(nil).Once()
-				sender.On("SendAlert", test.AnyCtx, mock.Anything, mock.Anything, mock.Anything).Return(assert.AnError).Once()
+				sender.On("SendAlert", test.AnyCtx, mock.Anything, mock.Anything).Return(assert.AnError).Twice()
+				mockLiquidityWithoutThresholds(provider)
 			},
 		},
 		{
@@ -134,7 +211,8 @@ func TestCheckLiquidityUseCase_Run_OnlyLogSendErrors(t *testing.T) {
 				bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(1000), nil).Once()
 				provider.On("HasPeginLiquidity", mock.Anything, mock.Anything).Return(nil).Once()
 				provider.On("HasPegoutLiquidity", mock.Anything, mock.Anything).Return(usecases.NoLiquidityError).Once()
-				sender.On("SendAlert", test.AnyCtx, mock.Anything, mock.Anything, mock.Anything).Return(assert.AnError).Once()
+				sender.On("SendAlert", test.AnyCtx, mock.Anything, mock.Anything).Return(assert.AnError).Twice()
+				mockLiquidityWithoutThresholds(provider)
 			},
 		},
 	}
@@ -147,8 +225,8 @@ func TestCheckLiquidityUseCase_Run_OnlyLogSendErrors(t *testing.T) {
 		testCase.Value(bridge, provider, sender)
 		log.SetOutput(buff)
 		contracts := blockchain.RskContracts{Bridge: bridge}
-		useCase := liquidity_provider.NewCheckLiquidityUseCase(provider, provider, contracts, sender, recipient)
-		err := useCase.Run(context.Background())
+		useCase := liquidity_provider.NewCheckLiquidityUseCase(provider, provider, provider, contracts, sender, recipient)
+		_, err := useCase.Run(context.Background())
 		assert.Positive(t, buff.Bytes())
 		bridge.AssertExpectations(t)
 		provider.AssertExpectations(t)
@@ -156,3 +234,120 @@ func TestCheckLiquidityUseCase_Run_OnlyLogSendErrors(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func lowLiquidityAlert(t *testing.T, kind alerts.AlertKind, subject string, severity alerts.AlertSeverity, message string, threshold *entities.Wei) any {
+	return mock.MatchedBy(func(alert alerts.Alert) bool {
+		return alert.Kind == kind &&
+			assert.Equal(t, subject, alert.Subject) &&
+			assert.Equal(t, alerts.AlertStatusFiring, alert.Status) &&
+			assert.Equal(t, severity, alert.Severity) &&
+			assert.Equal(t, message, alert.Message) &&
+			assert.Equal(t, threshold, alert.Amounts[alerts.AlertAmountThreshold]) &&
+			assert.NotNil(t, alert.Amounts[alerts.AlertAmountAvailable]) &&
+			assert.False(t, alert.Timestamp.IsZero())
+	})
+}
+
+func TestCheckLiquidityUseCase_Run_LiquidityThresholds(t *testing.T) {
+	recipient := "recipient@test.com"
+	generalConfig := lp.DefaultGeneralConfiguration()
+	generalConfig.LiquidityThresholds = &lp.LiquidityThresholds{
+		Pegin: lp.OperationLiquidityThresholds{
+			Warning:  &lp.LiquidityThreshold{MaxValueMultiplier: utils.NewBigFloat64(10)},
+			Critical: &lp.LiquidityThreshold{MaxValueMultiplier: utils.NewBigFloat64(2)},
+		},
+		Pegout: lp.OperationLiquidityThresholds{
+			Warning: &lp.LiquidityThreshold{Amount: entities.NewWei(500000000000000000)},
+		},
+	}
+	peginConfig := lp.DefaultPeginConfiguration()
+	peginConfig.MaxValue = entities.NewWei(100000000000000000)
+	pegoutConfig := lp.DefaultPegoutConfiguration()
+
+	bridge := &mocks.BridgeMock{}
+	provider := &mocks.ProviderMock{}
+	alertSender := &mocks.AlertSenderMock{}
+	bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(1000), nil).Once()
+	provider.On("HasPeginLiquidity", mock.Anything, mock.Anything).Return(nil).Once()
+	provider.On("HasPegoutLiquidity", mock.Anything, mock.Anything).Return(nil).Once()
+	provider.On("GeneralConfiguration", test.AnyCtx).Return(generalConfig).Once()
+	provider.On("AvailablePeginLiquidity", test.AnyCtx).Return(entities.NewWei(500000000000000000), nil).Once()
+	provider.On("AvailablePegoutLiquidity", test.AnyCtx).Return(entities.NewWei(600000000000000000), nil).Once()
+	provider.On("PeginConfiguration", test.AnyCtx).Return(peginConfig).Once()
+	provider.On("PegoutConfiguration", test.AnyCtx).Return(pegoutConfig).Once()
+	alertSender.On("SendAlert", test.AnyCtx, mock.MatchedBy(func(alert alerts.Alert) bool {
+		return alert.Kind == alerts.AlertKindPeginOutOfLiquidity || alert.Kind == alerts.AlertKindPegoutOutOfLiquidity
+	}), []string{recipient}).Return(nil).Twice()
+	alertSender.On("SendAlert", test.AnyCtx,
+		lowLiquidityAlert(t, alerts.AlertKindPeginLowLiquidityWarning, alerts.AlertSubjectPeginLowLiquidityWarning, alerts.AlertSeverityWarning,
+			"Your available liquidity to perform a PegIn is 0.5 rBTC, below the warning threshold of 1 rBTC", entities.NewWei(1000000000000000000)),
+		[]string{recipient},
+	).Return(nil).Once()
+	alertSender.On("SendAlert", test.AnyCtx,
+		resolvedLiquidityAlert(t, alerts.AlertKindPeginLowLiquidityCritical, alerts.AlertSubjectPeginLowLiquidityCritical, "Your available liquidity to perform a PegIn is above the critical threshold again"),
+		[]string{recipient},
+	).Return(nil).Once()
+	alertSender.On("SendAlert", test.AnyCtx,
+		resolvedLiquidityAlert(t, alerts.AlertKindPegoutLowLiquidityWarning, alerts.AlertSubjectPegoutLowLiquidityWarning, "Your available liquidity to perform a PegOut is above the warning threshold again"),
+		[]string{recipient},
+	).Return(nil).Once()
+
+	contracts := blockchain.RskContracts{Bridge: bridge}
+	useCase := liquidity_provider.NewCheckLiquidityUseCase(provider, provider, provider, contracts, alertSender, recipient)
+	result, err := useCase.Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, liquidity_provider.CheckLiquidityResult{
+		Pegin: liquidity_provider.LiquidityStatus{
+			Available:         entities.NewWei(500000000000000000),
+			WarningThreshold:  entities.NewWei(1000000000000000000),
+			CriticalThreshold: entities.NewWei(200000000000000000),
+		},
+		Pegout: liquidity_provider.LiquidityStatus{
+			Available:        entities.NewWei(600000000000000000),
+			WarningThreshold: entities.NewWei(500000000000000000),
+		},
+	}, result)
+	bridge.AssertExpectations(t)
+	provider.AssertExpectations(t)
+	alertSender.AssertExpectations(t)
+}
+
+func TestCheckLiquidityUseCase_Run_LiquidityThresholdsCritical(t *testing.T) {
+	recipient := "recipient@test.com"
+	generalConfig := lp.DefaultGeneralConfiguration()
+	generalConfig.LiquidityThresholds = &lp.LiquidityThresholds{
+		Pegout: lp.OperationLiquidityThresholds{
+			Critical: &lp.LiquidityThreshold{Amount: entities.NewWei(300000000000000000)},
+		},
+	}
+	bridge := &mocks.BridgeMock{}
+	provider := &mocks.ProviderMock{}
+	alertSender := &mocks.AlertSenderMock{}
+	bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(1000), nil).Once()
+	provider.On("HasPeginLiquidity", mock.Anything, mock.Anything).Return(nil).Once()
+	provider.On("HasPegoutLiquidity", mock.Anything, mock.Anything).Return(nil).Once()
+	provider.On("GeneralConfiguration", test.AnyCtx).Return(generalConfig).Once()
+	provider.On("AvailablePeginLiquidity", test.AnyCtx).Return(entities.NewWei(500000000000000000), nil).Once()
+	provider.On("AvailablePegoutLiquidity", test.AnyCtx).Return(entities.NewWei(250000000000000000), nil).Once()
+	provider.On("PeginConfiguration", test.AnyCtx).Return(lp.DefaultPeginConfiguration()).Once()
+	provider.On("PegoutConfiguration", test.AnyCtx).Return(lp.DefaultPegoutConfiguration()).Once()
+	alertSender.On("SendAlert", test.AnyCtx, mock.MatchedBy(func(alert alerts.Alert) bool {
+		return alert.Kind == alerts.AlertKindPeginOutOfLiquidity || alert.Kind == alerts.AlertKindPegoutOutOfLiquidity
+	}), []string{recipient}).Return(nil).Twice()
+	alertSender.On("SendAlert", test.AnyCtx,
+		lowLiquidityAlert(t, alerts.AlertKindPegoutLowLiquidityCritical, alerts.AlertSubjectPegoutLowLiquidityCritical, alerts.AlertSeverityCritical,
+			"Your available liquidity to perform a PegOut is 0.25 BTC, below the critical threshold of 0.3 BTC", entities.NewWei(300000000000000000)),
+		[]string{recipient},
+	).Return(assert.AnError).Once()
+
+	contracts := blockchain.RskContracts{Bridge: bridge}
+	useCase := liquidity_provider.NewCheckLiquidityUseCase(provider, provider, provider, contracts, alertSender, recipient)
+	result, err := useCase.Run(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, result.Pegin.WarningThreshold)
+	assert.Nil(t, result.Pegin.CriticalThreshold)
+	assert.Equal(t, entities.NewWei(300000000000000000), result.Pegout.CriticalThreshold)
+	bridge.AssertExpectations(t)
+	provider.AssertExpectations(t)
+	alertSender.AssertExpectations(t)
+}
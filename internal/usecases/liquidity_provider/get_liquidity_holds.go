@@ -0,0 +1,24 @@
+package liquidity_provider
+
+import (
+	"context"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+type GetLiquidityHoldsUseCase struct {
+	ledger liquidity_provider.LiquidityLedger
+}
+
+func NewGetLiquidityHoldsUseCase(ledger liquidity_provider.LiquidityLedger) *GetLiquidityHoldsUseCase {
+	return &GetLiquidityHoldsUseCase{ledger: ledger}
+}
+
+func (useCase *GetLiquidityHoldsUseCase) Run(ctx context.Context, filter liquidity_provider.LiquidityHoldFilter) ([]liquidity_provider.LiquidityHold, error) {
+	holds, err := useCase.ledger.GetHolds(ctx, filter)
+	if err != nil {
+		return nil, usecases.WrapUseCaseError(usecases.GetLiquidityHoldsId, err)
+	}
+	return holds, nil
+}
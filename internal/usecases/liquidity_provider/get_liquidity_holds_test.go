@@ -0,0 +1,36 @@
+package liquidity_provider_test
+
+import (
+	"context"
+	"testing"
+
+	lpEntity "github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLiquidityHoldsUseCase_Run(t *testing.T) {
+	filter := lpEntity.LiquidityHoldFilter{Operation: lpEntity.PeginHold, Statuses: []lpEntity.HoldStatus{lpEntity.HoldStatusActive}}
+	t.Run("should return the holds", func(t *testing.T) {
+		holds := []lpEntity.LiquidityHold{{QuoteHash: "0x01"}, {QuoteHash: "0x02"}}
+		ledger := mocks.NewLiquidityLedgerMock(t)
+		ledger.EXPECT().GetHolds(test.AnyCtx, filter).Return(holds, nil).Once()
+		useCase := liquidity_provider.NewGetLiquidityHoldsUseCase(ledger)
+		result, err := useCase.Run(context.Background(), filter)
+		require.NoError(t, err)
+		assert.Equal(t, holds, result)
+	})
+	t.Run("should handle ledger error", func(t *testing.T) {
+		ledger := mocks.NewLiquidityLedgerMock(t)
+		ledger.EXPECT().GetHolds(test.AnyCtx, filter).Return(nil, assert.AnError).Once()
+		useCase := liquidity_provider.NewGetLiquidityHoldsUseCase(ledger)
+		result, err := useCase.Run(context.Background(), filter)
+		require.ErrorIs(t, err, assert.AnError)
+		require.ErrorContains(t, err, string(usecases.GetLiquidityHoldsId))
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,39 @@
+package liquidity_provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+const (
+	DefaultRecentAlertsLimit = 50
+	MaxRecentAlertsLimit     = 500
+)
+
+var InvalidAlertsLimitError = fmt.Errorf("alerts limit must be between 1 and %d", MaxRecentAlertsLimit)
+
+type GetRecentAlertsUseCase struct {
+	alertRepository alerts.AlertRepository
+}
+
+func NewGetRecentAlertsUseCase(alertRepository alerts.AlertRepository) *GetRecentAlertsUseCase {
+	return &GetRecentAlertsUseCase{alertRepository: alertRepository}
+}
+
+// Run returns the most recent entries of the alert history, newest first. If limit is zero, the
+// DefaultRecentAlertsLimit is used
+func (useCase *GetRecentAlertsUseCase) Run(ctx context.Context, limit uint64) ([]alerts.AlertRecord, error) {
+	if limit == 0 {
+		limit = DefaultRecentAlertsLimit
+	} else if limit > MaxRecentAlertsLimit {
+		return nil, usecases.WrapUseCaseError(usecases.GetRecentAlertsId, InvalidAlertsLimitError)
+	}
+	records, err := useCase.alertRepository.GetRecentAlerts(ctx, int64(limit))
+	if err != nil {
+		return nil, usecases.WrapUseCaseError(usecases.GetRecentAlertsId, err)
+	}
+	return records, nil
+}
@@ -0,0 +1,51 @@
+package liquidity_provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRecentAlertsUseCase_Run(t *testing.T) {
+	records := []alerts.AlertRecord{
+		{Alert: alerts.Alert{Subject: alerts.AlertSubjectEclipseAttack, Status: alerts.AlertStatusResolved}, Delivered: true},
+		{Alert: alerts.Alert{Subject: alerts.AlertSubjectEclipseAttack, Status: alerts.AlertStatusFiring}, Delivered: true},
+	}
+	t.Run("should return recent alerts", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		repository.EXPECT().GetRecentAlerts(mock.Anything, int64(10)).Return(records, nil).Once()
+		useCase := liquidity_provider.NewGetRecentAlertsUseCase(repository)
+		result, err := useCase.Run(context.Background(), 10)
+		require.NoError(t, err)
+		assert.Equal(t, records, result)
+	})
+	t.Run("should use default limit", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		repository.EXPECT().GetRecentAlerts(mock.Anything, int64(liquidity_provider.DefaultRecentAlertsLimit)).Return(records, nil).Once()
+		useCase := liquidity_provider.NewGetRecentAlertsUseCase(repository)
+		result, err := useCase.Run(context.Background(), 0)
+		require.NoError(t, err)
+		assert.Equal(t, records, result)
+	})
+	t.Run("should fail if limit is too big", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		useCase := liquidity_provider.NewGetRecentAlertsUseCase(repository)
+		result, err := useCase.Run(context.Background(), liquidity_provider.MaxRecentAlertsLimit+1)
+		require.ErrorIs(t, err, liquidity_provider.InvalidAlertsLimitError)
+		assert.Nil(t, result)
+	})
+	t.Run("should handle repository error", func(t *testing.T) {
+		repository := mocks.NewAlertRepositoryMock(t)
+		repository.EXPECT().GetRecentAlerts(mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		useCase := liquidity_provider.NewGetRecentAlertsUseCase(repository)
+		result, err := useCase.Run(context.Background(), 1)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, result)
+	})
+}
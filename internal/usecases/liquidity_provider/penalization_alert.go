@@ -3,7 +3,9 @@ package liquidity_provider
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/penalization"
@@ -33,7 +35,6 @@ func NewPenalizationAlertUseCase(
 }
 
 func (useCase *PenalizationAlertUseCase) Run(ctx context.Context, fromBlock, toBlock uint64) error {
-	var body string
 	events, err := useCase.contracts.CollateralManagement.GetPenalizedEvents(ctx, fromBlock, &toBlock)
 	if err != nil {
 		return usecases.WrapUseCaseError(usecases.PenalizationId, err)
@@ -44,8 +45,17 @@ func (useCase *PenalizationAlertUseCase) Run(ctx context.Context, fromBlock, toB
 		if err != nil {
 			log.Error(usecases.PenalizationId, err)
 		}
-		body = fmt.Sprintf("You were punished in %v rBTC for the quoteHash %s", event.Penalty.ToRbtc(), event.QuoteHash)
-		if err = useCase.sender.SendAlert(ctx, alerts.AlertSubjectPenalization, body, []string{useCase.recipient}); err != nil {
+		alert := alerts.Alert{
+			Kind:      alerts.AlertKindPenalization,
+			Subject:   alerts.AlertSubjectPenalization,
+			Severity:  alerts.AlertSeverityCritical,
+			Status:    alerts.AlertStatusFiring,
+			Message:   fmt.Sprintf("You were punished in %v rBTC for the quoteHash %s", event.Penalty.ToRbtc(), event.QuoteHash),
+			QuoteHash: event.QuoteHash,
+			Amounts:   map[string]*entities.Wei{alerts.AlertAmountPenalty: event.Penalty},
+			Timestamp: time.Now(),
+		}
+		if err = useCase.sender.SendAlert(ctx, alert, []string{useCase.recipient}); err != nil {
 			log.Error("Error sending punishment alert: ", err)
 		}
 	}
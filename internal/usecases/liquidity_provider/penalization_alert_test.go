@@ -51,11 +51,19 @@ func TestPenalizationAlertUseCase_Run(t *testing.T) {
 	recipient := "recipient@test.com"
 
 	for i := 0; i < 3; i++ {
+		event := events[i]
 		sender.On(
 			"SendAlert",
 			test.AnyCtx,
-			alerts.AlertSubjectPenalization,
-			fmt.Sprintf("You were punished in %v rBTC for the quoteHash %s", events[i].Penalty.ToRbtc(), events[i].QuoteHash),
+			mock.MatchedBy(func(alert alerts.Alert) bool {
+				return alert.QuoteHash == event.QuoteHash &&
+					assert.Equal(t, alerts.AlertKindPenalization, alert.Kind) &&
+					assert.Equal(t, alerts.AlertSubjectPenalization, alert.Subject) &&
+					assert.Equal(t, alerts.AlertSeverityCritical, alert.Severity) &&
+					assert.Equal(t, fmt.Sprintf("You were punished in %v rBTC for the quoteHash %s", event.Penalty.ToRbtc(), event.QuoteHash), alert.Message) &&
+					assert.Equal(t, event.Penalty, alert.Amounts[alerts.AlertAmountPenalty]) &&
+					assert.False(t, alert.Timestamp.IsZero())
+			}),
 			[]string{recipient},
 		).Return(nil).Once()
 	}
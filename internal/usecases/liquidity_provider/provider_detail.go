@@ -53,6 +53,8 @@ func (useCase *GetDetailUseCase) Run(ctx context.Context) (FullLiquidityProvider
 			MinTransactionValue:   peginConfig.MinValue,
 			MaxTransactionValue:   peginConfig.MaxValue,
 			RequiredConfirmations: generalConfiguration.BtcConfirmations.Max(),
+			FeeTiers:              peginConfig.FeeTiers,
+			FeeSurcharges:         peginConfig.FeeSurcharges,
 		},
 		Pegout: liquidity_provider.LiquidityProviderDetail{
 			FixedFee:              pegoutConfig.FixedFee,
@@ -60,6 +62,8 @@ func (useCase *GetDetailUseCase) Run(ctx context.Context) (FullLiquidityProvider
 			MinTransactionValue:   pegoutConfig.MinValue,
 			MaxTransactionValue:   pegoutConfig.MaxValue,
 			RequiredConfirmations: generalConfiguration.RskConfirmations.Max(),
+			FeeTiers:              pegoutConfig.FeeTiers,
+			FeeSurcharges:         pegoutConfig.FeeSurcharges,
 		},
 	}
 
@@ -41,6 +41,29 @@ func TestGetDetailUseCase_Run(t *testing.T) {
 	}, result)
 }
 
+func TestGetDetailUseCase_Run_FeeSchedule(t *testing.T) {
+	provider := &mocks.ProviderMock{}
+	ctx := context.Background()
+	prepareDetailMock(provider)
+	peginConfig := provider.PeginConfiguration(ctx)
+	peginConfig.FeeTiers = lp.FeeTiers{
+		{MaxAmount: entities.NewWei(5000), FixedFee: entities.NewWei(50), FeePercentage: utils.NewBigFloat64(1)},
+	}
+	pegoutConfig := provider.PegoutConfiguration(ctx)
+	pegoutConfig.FeeSurcharges = &lp.FeeSurcharges{
+		TimeOfDay: []lp.TimeOfDaySurcharge{{StartHour: 22, EndHour: 6, FeePercentage: utils.NewBigFloat64(0.5)}},
+	}
+	provider.On("PeginConfiguration", test.AnyCtx).Return(peginConfig).Once()
+	provider.On("PegoutConfiguration", test.AnyCtx).Return(pegoutConfig).Once()
+	useCase := liquidity_provider.NewGetDetailUseCase("testKey", false, provider, provider, provider)
+	result, err := useCase.Run(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, peginConfig.FeeTiers, result.Pegin.FeeTiers)
+	assert.Nil(t, result.Pegin.FeeSurcharges)
+	assert.Empty(t, result.Pegout.FeeTiers)
+	assert.Equal(t, pegoutConfig.FeeSurcharges, result.Pegout.FeeSurcharges)
+}
+
 func TestGetDetailUseCase_Run_InvalidCaptchaKey(t *testing.T) {
 	provider := &mocks.ProviderMock{}
 	captchaKey := ""
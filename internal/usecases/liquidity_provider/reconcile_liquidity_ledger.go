@@ -0,0 +1,191 @@
+package liquidity_provider
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	log "github.com/sirupsen/logrus"
+)
+
+type LiquidityLedgerReconciliation struct {
+	// Created are the hashes of the quotes waiting for the LP to pay them that didn't have a hold
+	Created []string `json:"created"`
+	// Released are the hashes of the quotes that had an active hold but were no longer waiting for the LP to pay them
+	Released []string `json:"released"`
+	// Expired is the amount of holds that were still active after their expiration time when their quote was no
+	// longer waiting for the LP to pay it
+	Expired int64 `json:"expired"`
+}
+
+// ReconcileLiquidityLedgerUseCase makes the liquidity ledger consistent with the retained quotes. This fixes the
+// holds of the quotes accepted before the ledger existed and the ones whose state transition was missed.
+type ReconcileLiquidityLedgerUseCase struct {
+	ledger               liquidity_provider.LiquidityLedger
+	peginRepository      quote.PeginQuoteRepository
+	pegoutRepository     quote.PegoutQuoteRepository
+	peginLiquidityMutex  sync.Locker
+	pegoutLiquidityMutex sync.Locker
+}
+
+// pendingQuote is a retained quote that still requires liquidity, regardless of its operation
+type pendingQuote struct {
+	quoteHash         string
+	requiredLiquidity *entities.Wei
+}
+
+func NewReconcileLiquidityLedgerUseCase(
+	ledger liquidity_provider.LiquidityLedger,
+	peginRepository quote.PeginQuoteRepository,
+	pegoutRepository quote.PegoutQuoteRepository,
+	peginLiquidityMutex sync.Locker,
+	pegoutLiquidityMutex sync.Locker,
+) *ReconcileLiquidityLedgerUseCase {
+	return &ReconcileLiquidityLedgerUseCase{
+		ledger:               ledger,
+		peginRepository:      peginRepository,
+		pegoutRepository:     pegoutRepository,
+		peginLiquidityMutex:  peginLiquidityMutex,
+		pegoutLiquidityMutex: pegoutLiquidityMutex,
+	}
+}
+
+func (useCase *ReconcileLiquidityLedgerUseCase) Run(ctx context.Context) (LiquidityLedgerReconciliation, error) {
+	var err error
+	result := LiquidityLedgerReconciliation{Created: make([]string, 0), Released: make([]string, 0)}
+	if err = useCase.reconcilePegin(ctx, &result); err != nil {
+		return LiquidityLedgerReconciliation{}, usecases.WrapUseCaseError(usecases.ReconcileLiquidityLedgerId, err)
+	}
+	if err = useCase.reconcilePegout(ctx, &result); err != nil {
+		return LiquidityLedgerReconciliation{}, usecases.WrapUseCaseError(usecases.ReconcileLiquidityLedgerId, err)
+	}
+	return result, nil
+}
+
+// reconcilePegin holds the same mutex as the pegin accept use case, so the quotes being accepted are not
+// considered as quotes with a stale hold
+func (useCase *ReconcileLiquidityLedgerUseCase) reconcilePegin(ctx context.Context, result *LiquidityLedgerReconciliation) error {
+	useCase.peginLiquidityMutex.Lock()
+	defer useCase.peginLiquidityMutex.Unlock()
+	retainedQuotes, err := useCase.peginRepository.GetRetainedQuoteByState(ctx,
+		quote.PeginStateWaitingForDeposit, quote.PeginStateWaitingForDepositConfirmations,
+	)
+	if err != nil {
+		return err
+	}
+	pending := make([]pendingQuote, 0, len(retainedQuotes))
+	for _, retainedQuote := range retainedQuotes {
+		pending = append(pending, pendingQuote{quoteHash: retainedQuote.QuoteHash, requiredLiquidity: retainedQuote.RequiredLiquidity})
+	}
+	return useCase.reconcileOperation(ctx, liquidity_provider.PeginHold, pending, func(quoteHash string) (*time.Time, error) {
+		peginQuote, err := useCase.peginRepository.GetQuote(ctx, quoteHash)
+		if err != nil || peginQuote == nil {
+			return nil, err
+		}
+		expireTime := peginQuote.HoldExpireTime()
+		return &expireTime, nil
+	}, result)
+}
+
+// reconcilePegout holds the same mutex as the pegout accept use case, so the quotes being accepted are not
+// considered as quotes with a stale hold
+func (useCase *ReconcileLiquidityLedgerUseCase) reconcilePegout(ctx context.Context, result *LiquidityLedgerReconciliation) error {
+	useCase.pegoutLiquidityMutex.Lock()
+	defer useCase.pegoutLiquidityMutex.Unlock()
+	retainedQuotes, err := useCase.pegoutRepository.GetRetainedQuoteByState(ctx,
+		quote.PegoutStateWaitingForDeposit, quote.PegoutStateWaitingForDepositConfirmations,
+	)
+	if err != nil {
+		return err
+	}
+	pending := make([]pendingQuote, 0, len(retainedQuotes))
+	for _, retainedQuote := range retainedQuotes {
+		pending = append(pending, pendingQuote{quoteHash: retainedQuote.QuoteHash, requiredLiquidity: retainedQuote.RequiredLiquidity})
+	}
+	return useCase.reconcileOperation(ctx, liquidity_provider.PegoutHold, pending, func(quoteHash string) (*time.Time, error) {
+		pegoutQuote, err := useCase.pegoutRepository.GetQuote(ctx, quoteHash)
+		if err != nil || pegoutQuote == nil {
+			return nil, err
+		}
+		expireTime := pegoutQuote.HoldExpireTime()
+		return &expireTime, nil
+	}, result)
+}
+
+// reconcileOperation creates the holds missing for the pending quotes and releases the active holds that don't
+// belong to any pending quote, or expires them if they are overdue. The holds of the pending quotes are kept even
+// after their expiration time, since the LP still has to pay them. The getHoldExpireTime function returns nil if
+// the quote doesn't exist.
+func (useCase *ReconcileLiquidityLedgerUseCase) reconcileOperation(
+	ctx context.Context,
+	operation liquidity_provider.HoldOperation,
+	pending []pendingQuote,
+	getHoldExpireTime func(quoteHash string) (*time.Time, error),
+	result *LiquidityLedgerReconciliation,
+) error {
+	holds, err := useCase.ledger.GetActiveHolds(ctx, operation)
+	if err != nil {
+		return err
+	}
+	heldQuotes := make(map[string]struct{}, len(holds))
+	for _, hold := range holds {
+		heldQuotes[hold.QuoteHash] = struct{}{}
+	}
+	pendingQuotes := make(map[string]struct{}, len(pending))
+	for _, pendingQuote := range pending {
+		pendingQuotes[pendingQuote.quoteHash] = struct{}{}
+		if _, ok := heldQuotes[pendingQuote.quoteHash]; ok {
+			continue
+		}
+		expireTime, err := getHoldExpireTime(pendingQuote.quoteHash)
+		if err != nil {
+			return err
+		} else if expireTime == nil {
+			log.Warnf("Retained %s quote %s has no quote, its liquidity hold can't be created", operation, pendingQuote.quoteHash)
+			continue
+		}
+		hold := liquidity_provider.NewLiquidityHold(pendingQuote.quoteHash, operation, pendingQuote.requiredLiquidity, *expireTime)
+		if err = useCase.ledger.PlaceHold(ctx, hold); err != nil {
+			return err
+		}
+		result.Created = append(result.Created, pendingQuote.quoteHash)
+	}
+	now := time.Now()
+	for _, hold := range holds {
+		if _, ok := pendingQuotes[hold.QuoteHash]; ok {
+			continue
+		}
+		if err = useCase.removeHold(ctx, hold, now, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (useCase *ReconcileLiquidityLedgerUseCase) removeHold(
+	ctx context.Context,
+	hold liquidity_provider.LiquidityHold,
+	now time.Time,
+	result *LiquidityLedgerReconciliation,
+) error {
+	if hold.IsOverdue(now) {
+		expired, err := useCase.ledger.ExpireHold(ctx, hold.QuoteHash)
+		if err != nil {
+			return err
+		} else if expired {
+			result.Expired++
+		}
+		return nil
+	}
+	released, err := useCase.ledger.ReleaseHold(ctx, hold.QuoteHash, liquidity_provider.HoldReleasedByReconciliation)
+	if err != nil {
+		return err
+	} else if released {
+		result.Released = append(result.Released, hold.QuoteHash)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package liquidity_provider_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	lpEntity "github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestReconcileLiquidityLedgerUseCase_Run(t *testing.T) {
+	now := time.Now()
+	activePeginQuote := quote.PeginQuote{AgreementTimestamp: uint32(now.Unix()), TimeForDeposit: 3600, LpCallTime: 3600}
+	expiredPeginQuote := quote.PeginQuote{AgreementTimestamp: uint32(now.Unix() - 10000), TimeForDeposit: 3600, LpCallTime: 3600}
+	activePegoutQuote := quote.PegoutQuote{ExpireDate: uint32(now.Unix() + 600), TransferTime: 600}
+	holdExpiration := now.Add(time.Hour)
+	overdueExpiration := now.Add(-time.Hour)
+
+	t.Run("should create missing holds, keep the ones of the waiting quotes and release or expire the stale ones", func(t *testing.T) {
+		ledger := mocks.NewLiquidityLedgerMock(t)
+		peginRepository := mocks.NewPeginQuoteRepositoryMock(t)
+		pegoutRepository := mocks.NewPegoutQuoteRepositoryMock(t)
+		peginMutex, pegoutMutex := new(mocks.MutexMock), new(mocks.MutexMock)
+		peginMutex.On("Lock").Return().Once().On("Unlock").Return().Once()
+		pegoutMutex.On("Lock").Return().Once().On("Unlock").Return().Once()
+
+		peginRepository.EXPECT().GetRetainedQuoteByState(test.AnyCtx, quote.PeginStateWaitingForDeposit, quote.PeginStateWaitingForDepositConfirmations).
+			Return([]quote.RetainedPeginQuote{
+				{QuoteHash: "held", RequiredLiquidity: entities.NewWei(1)},
+				{QuoteHash: "held-overdue", RequiredLiquidity: entities.NewWei(1)},
+				{QuoteHash: "missing", RequiredLiquidity: entities.NewWei(2)},
+				{QuoteHash: "expired", RequiredLiquidity: entities.NewWei(3)},
+				{QuoteHash: "deleted", RequiredLiquidity: entities.NewWei(4)},
+			}, nil).Once()
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, lpEntity.PeginHold).Return([]lpEntity.LiquidityHold{
+			{QuoteHash: "held", Operation: lpEntity.PeginHold, Status: lpEntity.HoldStatusActive, ExpiresAt: holdExpiration},
+			{QuoteHash: "held-overdue", Operation: lpEntity.PeginHold, Status: lpEntity.HoldStatusActive, ExpiresAt: overdueExpiration},
+			{QuoteHash: "stale", Operation: lpEntity.PeginHold, Status: lpEntity.HoldStatusActive, ExpiresAt: holdExpiration},
+			{QuoteHash: "stale-overdue", Operation: lpEntity.PeginHold, Status: lpEntity.HoldStatusActive, ExpiresAt: overdueExpiration},
+		}, nil).Once()
+		peginRepository.EXPECT().GetQuote(test.AnyCtx, "missing").Return(&activePeginQuote, nil).Once()
+		peginRepository.EXPECT().GetQuote(test.AnyCtx, "expired").Return(&expiredPeginQuote, nil).Once()
+		peginRepository.EXPECT().GetQuote(test.AnyCtx, "deleted").Return(nil, nil).Once()
+		ledger.EXPECT().PlaceHold(test.AnyCtx, mock.MatchedBy(func(hold lpEntity.LiquidityHold) bool {
+			return hold.QuoteHash == "missing" && hold.Operation == lpEntity.PeginHold && hold.Amount.Cmp(entities.NewWei(2)) == 0 &&
+				hold.Status == lpEntity.HoldStatusActive && hold.ExpiresAt.Equal(activePeginQuote.HoldExpireTime())
+		})).Return(nil).Once()
+		// the quote is still waiting, so the hold is created even if its expiration time has passed
+		ledger.EXPECT().PlaceHold(test.AnyCtx, mock.MatchedBy(func(hold lpEntity.LiquidityHold) bool {
+			return hold.QuoteHash == "expired" && hold.Amount.Cmp(entities.NewWei(3)) == 0 && hold.ExpiresAt.Equal(expiredPeginQuote.HoldExpireTime())
+		})).Return(nil).Once()
+		ledger.EXPECT().ReleaseHold(test.AnyCtx, "stale", lpEntity.HoldReleasedByReconciliation).Return(true, nil).Once()
+		ledger.EXPECT().ExpireHold(test.AnyCtx, "stale-overdue").Return(true, nil).Once()
+
+		pegoutRepository.EXPECT().GetRetainedQuoteByState(test.AnyCtx, quote.PegoutStateWaitingForDeposit, quote.PegoutStateWaitingForDepositConfirmations).
+			Return([]quote.RetainedPegoutQuote{{QuoteHash: "pegout", RequiredLiquidity: entities.NewWei(5)}}, nil).Once()
+		ledger.EXPECT().GetActiveHolds(test.AnyCtx, lpEntity.PegoutHold).Return([]lpEntity.LiquidityHold{
+			{QuoteHash: "released", Operation: lpEntity.PegoutHold, Status: lpEntity.HoldStatusActive, ExpiresAt: holdExpiration},
+			{QuoteHash: "expired-pegout", Operation: lpEntity.PegoutHold, Status: lpEntity.HoldStatusActive, ExpiresAt: overdueExpiration},
+		}, nil).Once()
+		pegoutRepository.EXPECT().GetQuote(test.AnyCtx, "pegout").Return(&activePegoutQuote, nil).Once()
+		ledger.EXPECT().PlaceHold(test.AnyCtx, mock.MatchedBy(func(hold lpEntity.LiquidityHold) bool {
+			return hold.QuoteHash == "pegout" && hold.Operation == lpEntity.PegoutHold && hold.ExpiresAt.Equal(activePegoutQuote.HoldExpireTime())
+		})).Return(nil).Once()
+		// the hold was released after the query, so it is not reported
+		ledger.EXPECT().ReleaseHold(test.AnyCtx, "released", lpEntity.HoldReleasedByReconciliation).Return(false, nil).Once()
+		ledger.EXPECT().ExpireHold(test.AnyCtx, "expired-pegout").Return(false, nil).Once()
+
+		useCase := liquidity_provider.NewReconcileLiquidityLedgerUseCase(ledger, peginRepository, pegoutRepository, peginMutex, pegoutMutex)
+		result, err := useCase.Run(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, liquidity_provider.LiquidityLedgerReconciliation{
+			Created:  []string{"missing", "expired", "pegout"},
+			Released: []string{"stale"},
+			Expired:  1,
+		}, result)
+		peginMutex.AssertExpectations(t)
+		pegoutMutex.AssertExpectations(t)
+	})
+
+	t.Run("should handle errors", func(t *testing.T) {
+		type setupFunc func(ledger *mocks.LiquidityLedgerMock, peginRepository *mocks.PeginQuoteRepositoryMock, pegoutRepository *mocks.PegoutQuoteRepositoryMock)
+		noPeginQuotes := func(peginRepository *mocks.PeginQuoteRepositoryMock) {
+			peginRepository.EXPECT().GetRetainedQuoteByState(test.AnyCtx, mock.Anything, mock.Anything).Return([]quote.RetainedPeginQuote{}, nil).Once()
+		}
+		setups := []setupFunc{
+			func(ledger *mocks.LiquidityLedgerMock, peginRepository *mocks.PeginQuoteRepositoryMock, _ *mocks.PegoutQuoteRepositoryMock) {
+				peginRepository.EXPECT().GetRetainedQuoteByState(test.AnyCtx, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+			},
+			func(ledger *mocks.LiquidityLedgerMock, peginRepository *mocks.PeginQuoteRepositoryMock, _ *mocks.PegoutQuoteRepositoryMock) {
+				noPeginQuotes(peginRepository)
+				ledger.EXPECT().GetActiveHolds(test.AnyCtx, lpEntity.PeginHold).Return(nil, assert.AnError).Once()
+			},
+			func(ledger *mocks.LiquidityLedgerMock, peginRepository *mocks.PeginQuoteRepositoryMock, _ *mocks.PegoutQuoteRepositoryMock) {
+				peginRepository.EXPECT().GetRetainedQuoteByState(test.AnyCtx, mock.Anything, mock.Anything).
+					Return([]quote.RetainedPeginQuote{{QuoteHash: "missing"}}, nil).Once()
+				ledger.EXPECT().GetActiveHolds(test.AnyCtx, lpEntity.PeginHold).Return([]lpEntity.LiquidityHold{}, nil).Once()
+				peginRepository.EXPECT().GetQuote(test.AnyCtx, "missing").Return(nil, assert.AnError).Once()
+			},
+			func(ledger *mocks.LiquidityLedgerMock, peginRepository *mocks.PeginQuoteRepositoryMock, _ *mocks.PegoutQuoteRepositoryMock) {
+				peginRepository.EXPECT().GetRetainedQuoteByState(test.AnyCtx, mock.Anything, mock.Anything).
+					Return([]quote.RetainedPeginQuote{{QuoteHash: "missing"}}, nil).Once()
+				ledger.EXPECT().GetActiveHolds(test.AnyCtx, lpEntity.PeginHold).Return([]lpEntity.LiquidityHold{}, nil).Once()
+				peginRepository.EXPECT().GetQuote(test.AnyCtx, "missing").Return(&activePeginQuote, nil).Once()
+				ledger.EXPECT().PlaceHold(test.AnyCtx, mock.Anything).Return(assert.AnError).Once()
+			},
+			func(ledger *mocks.LiquidityLedgerMock, peginRepository *mocks.PeginQuoteRepositoryMock, _ *mocks.PegoutQuoteRepositoryMock) {
+				noPeginQuotes(peginRepository)
+				ledger.EXPECT().GetActiveHolds(test.AnyCtx, lpEntity.PeginHold).Return([]lpEntity.LiquidityHold{{QuoteHash: "stale", ExpiresAt: holdExpiration}}, nil).Once()
+				ledger.EXPECT().ReleaseHold(test.AnyCtx, "stale", mock.Anything).Return(false, assert.AnError).Once()
+			},
+			func(ledger *mocks.LiquidityLedgerMock, peginRepository *mocks.PeginQuoteRepositoryMock, _ *mocks.PegoutQuoteRepositoryMock) {
+				noPeginQuotes(peginRepository)
+				ledger.EXPECT().GetActiveHolds(test.AnyCtx, lpEntity.PeginHold).
+					Return([]lpEntity.LiquidityHold{{QuoteHash: "stale", ExpiresAt: overdueExpiration}}, nil).Once()
+				ledger.EXPECT().ExpireHold(test.AnyCtx, "stale").Return(false, assert.AnError).Once()
+			},
+			func(ledger *mocks.LiquidityLedgerMock, peginRepository *mocks.PeginQuoteRepositoryMock, pegoutRepository *mocks.PegoutQuoteRepositoryMock) {
+				noPeginQuotes(peginRepository)
+				ledger.EXPECT().GetActiveHolds(test.AnyCtx, lpEntity.PeginHold).Return([]lpEntity.LiquidityHold{}, nil).Once()
+				pegoutRepository.EXPECT().GetRetainedQuoteByState(test.AnyCtx, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+			},
+		}
+		for _, setup := range setups {
+			ledger := mocks.NewLiquidityLedgerMock(t)
+			peginRepository := mocks.NewPeginQuoteRepositoryMock(t)
+			pegoutRepository := mocks.NewPegoutQuoteRepositoryMock(t)
+			mutex := new(mocks.MutexMock)
+			mutex.On("Lock").Return().On("Unlock").Return()
+			setup(ledger, peginRepository, pegoutRepository)
+			useCase := liquidity_provider.NewReconcileLiquidityLedgerUseCase(ledger, peginRepository, pegoutRepository, mutex, mutex)
+			result, err := useCase.Run(context.Background())
+			require.ErrorIs(t, err, assert.AnError)
+			assert.Empty(t, result)
+		}
+	})
+}
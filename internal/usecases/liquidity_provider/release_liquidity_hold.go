@@ -0,0 +1,43 @@
+package liquidity_provider
+
+import (
+	"context"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+// ReleaseLiquidityHoldUseCase releases the liquidity held for a quote once the quote reaches a state in which
+// the LP no longer has to pay it, either because it was already paid, the payment failed or the quote expired
+type ReleaseLiquidityHoldUseCase struct {
+	ledger liquidity_provider.LiquidityLedger
+}
+
+func NewReleaseLiquidityHoldUseCase(ledger liquidity_provider.LiquidityLedger) *ReleaseLiquidityHoldUseCase {
+	return &ReleaseLiquidityHoldUseCase{ledger: ledger}
+}
+
+// RunPegin releases the hold of the quote if its state doesn't require liquidity. Returns true if a hold was released
+func (useCase *ReleaseLiquidityHoldUseCase) RunPegin(ctx context.Context, retainedQuote quote.RetainedPeginQuote) (bool, error) {
+	if retainedQuote.State == quote.PeginStateWaitingForDeposit || retainedQuote.State == quote.PeginStateWaitingForDepositConfirmations {
+		return false, nil
+	}
+	return useCase.release(ctx, retainedQuote.QuoteHash, string(retainedQuote.State))
+}
+
+// RunPegout releases the hold of the quote if its state doesn't require liquidity. Returns true if a hold was released
+func (useCase *ReleaseLiquidityHoldUseCase) RunPegout(ctx context.Context, retainedQuote quote.RetainedPegoutQuote) (bool, error) {
+	if retainedQuote.State == quote.PegoutStateWaitingForDeposit || retainedQuote.State == quote.PegoutStateWaitingForDepositConfirmations {
+		return false, nil
+	}
+	return useCase.release(ctx, retainedQuote.QuoteHash, string(retainedQuote.State))
+}
+
+func (useCase *ReleaseLiquidityHoldUseCase) release(ctx context.Context, quoteHash, reason string) (bool, error) {
+	released, err := useCase.ledger.ReleaseHold(ctx, quoteHash, reason)
+	if err != nil {
+		return false, usecases.WrapUseCaseErrorArgs(usecases.ReleaseLiquidityHoldId, err, usecases.ErrorArg("quoteHash", quoteHash))
+	}
+	return released, nil
+}
@@ -0,0 +1,73 @@
+package liquidity_provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseLiquidityHoldUseCase_RunPegin(t *testing.T) {
+	t.Run("should release the hold of the quotes that no longer require liquidity", func(t *testing.T) {
+		for _, state := range []quote.PeginState{quote.PeginStateCallForUserSucceeded, quote.PeginStateCallForUserFailed, quote.PeginStateTimeForDepositElapsed} {
+			ledger := mocks.NewLiquidityLedgerMock(t)
+			ledger.EXPECT().ReleaseHold(test.AnyCtx, "0x01", string(state)).Return(true, nil).Once()
+			useCase := liquidity_provider.NewReleaseLiquidityHoldUseCase(ledger)
+			released, err := useCase.RunPegin(context.Background(), quote.RetainedPeginQuote{QuoteHash: "0x01", State: state})
+			require.NoError(t, err)
+			assert.True(t, released)
+		}
+	})
+	t.Run("should not release the hold of the quotes waiting for deposit", func(t *testing.T) {
+		for _, state := range []quote.PeginState{quote.PeginStateWaitingForDeposit, quote.PeginStateWaitingForDepositConfirmations} {
+			ledger := mocks.NewLiquidityLedgerMock(t)
+			useCase := liquidity_provider.NewReleaseLiquidityHoldUseCase(ledger)
+			released, err := useCase.RunPegin(context.Background(), quote.RetainedPeginQuote{QuoteHash: "0x01", State: state})
+			require.NoError(t, err)
+			assert.False(t, released)
+		}
+	})
+	t.Run("should handle ledger error", func(t *testing.T) {
+		ledger := mocks.NewLiquidityLedgerMock(t)
+		ledger.EXPECT().ReleaseHold(test.AnyCtx, "0x01", string(quote.PeginStateCallForUserSucceeded)).Return(false, assert.AnError).Once()
+		useCase := liquidity_provider.NewReleaseLiquidityHoldUseCase(ledger)
+		released, err := useCase.RunPegin(context.Background(), quote.RetainedPeginQuote{QuoteHash: "0x01", State: quote.PeginStateCallForUserSucceeded})
+		require.ErrorIs(t, err, assert.AnError)
+		assert.False(t, released)
+	})
+}
+
+func TestReleaseLiquidityHoldUseCase_RunPegout(t *testing.T) {
+	t.Run("should release the hold of the quotes that no longer require liquidity", func(t *testing.T) {
+		for _, state := range []quote.PegoutState{quote.PegoutStateSendPegoutSucceeded, quote.PegoutStateSendPegoutFailed, quote.PegoutStateTimeForDepositElapsed} {
+			ledger := mocks.NewLiquidityLedgerMock(t)
+			ledger.EXPECT().ReleaseHold(test.AnyCtx, "0x02", string(state)).Return(true, nil).Once()
+			useCase := liquidity_provider.NewReleaseLiquidityHoldUseCase(ledger)
+			released, err := useCase.RunPegout(context.Background(), quote.RetainedPegoutQuote{QuoteHash: "0x02", State: state})
+			require.NoError(t, err)
+			assert.True(t, released)
+		}
+	})
+	t.Run("should not release the hold of the quotes waiting for deposit", func(t *testing.T) {
+		for _, state := range []quote.PegoutState{quote.PegoutStateWaitingForDeposit, quote.PegoutStateWaitingForDepositConfirmations} {
+			ledger := mocks.NewLiquidityLedgerMock(t)
+			useCase := liquidity_provider.NewReleaseLiquidityHoldUseCase(ledger)
+			released, err := useCase.RunPegout(context.Background(), quote.RetainedPegoutQuote{QuoteHash: "0x02", State: state})
+			require.NoError(t, err)
+			assert.False(t, released)
+		}
+	})
+	t.Run("should handle ledger error", func(t *testing.T) {
+		ledger := mocks.NewLiquidityLedgerMock(t)
+		ledger.EXPECT().ReleaseHold(test.AnyCtx, "0x02", string(quote.PegoutStateSendPegoutFailed)).Return(false, assert.AnError).Once()
+		useCase := liquidity_provider.NewReleaseLiquidityHoldUseCase(ledger)
+		released, err := useCase.RunPegout(context.Background(), quote.RetainedPegoutQuote{QuoteHash: "0x02", State: quote.PegoutStateSendPegoutFailed})
+		require.ErrorIs(t, err, assert.AnError)
+		assert.False(t, released)
+	})
+}
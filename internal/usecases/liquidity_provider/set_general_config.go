@@ -9,17 +9,27 @@ import (
 )
 
 type SetGeneralConfigUseCase struct {
-	lpRepository liquidity_provider.LiquidityProviderRepository
-	signer       entities.Signer
-	hashFunc     entities.HashFunction
+	lpRepository   liquidity_provider.LiquidityProviderRepository
+	signer         entities.Signer
+	hashFunc       entities.HashFunction
+	peginProvider  liquidity_provider.PeginLiquidityProvider
+	pegoutProvider liquidity_provider.PegoutLiquidityProvider
 }
 
 func NewSetGeneralConfigUseCase(
 	lpRepository liquidity_provider.LiquidityProviderRepository,
 	signer entities.Signer,
 	hashFunc entities.HashFunction,
+	peginProvider liquidity_provider.PeginLiquidityProvider,
+	pegoutProvider liquidity_provider.PegoutLiquidityProvider,
 ) *SetGeneralConfigUseCase {
-	return &SetGeneralConfigUseCase{lpRepository: lpRepository, signer: signer, hashFunc: hashFunc}
+	return &SetGeneralConfigUseCase{
+		lpRepository:   lpRepository,
+		signer:         signer,
+		hashFunc:       hashFunc,
+		peginProvider:  peginProvider,
+		pegoutProvider: pegoutProvider,
+	}
 }
 
 func (useCase *SetGeneralConfigUseCase) Run(ctx context.Context, config liquidity_provider.GeneralConfiguration) error {
@@ -29,6 +39,14 @@ func (useCase *SetGeneralConfigUseCase) Run(ctx context.Context, config liquidit
 	if err := usecases.ValidateConfirmations(usecases.SetGeneralConfigId, config.BtcConfirmations); err != nil {
 		return err
 	}
+	if config.LiquidityThresholds != nil {
+		if err := config.LiquidityThresholds.Pegin.Validate(useCase.peginProvider.PeginConfiguration(ctx).MaxValue); err != nil {
+			return usecases.WrapUseCaseError(usecases.SetGeneralConfigId, err)
+		}
+		if err := config.LiquidityThresholds.Pegout.Validate(useCase.pegoutProvider.PegoutConfiguration(ctx).MaxValue); err != nil {
+			return usecases.WrapUseCaseError(usecases.SetGeneralConfigId, err)
+		}
+	}
 	signedConfig, err := usecases.SignConfiguration(usecases.SetGeneralConfigId, useCase.signer, useCase.hashFunc, config)
 	if err != nil {
 		return err
@@ -6,6 +6,7 @@ import (
 
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	lp "github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
 	"github.com/rsksmart/liquidity-provider-server/test"
@@ -32,7 +33,7 @@ func TestSetGeneralConfigUseCase_Run(t *testing.T) {
 	hashMock := &mocks.HashMock{}
 	hashMock.On("Hash", mock.Anything).Return([]byte{4, 5, 6})
 
-	useCase := liquidity_provider.NewSetGeneralConfigUseCase(lpRepository, walletMock, hashMock.Hash)
+	useCase := liquidity_provider.NewSetGeneralConfigUseCase(lpRepository, walletMock, hashMock.Hash, &mocks.ProviderMock{}, &mocks.ProviderMock{})
 
 	err := useCase.Run(context.Background(), config.Value)
 	require.NoError(t, err)
@@ -68,7 +69,7 @@ func TestSetGeneralConfigUseCase_Run_ErrorHandling(t *testing.T) {
 		lpRepository := &mocks.LiquidityProviderRepositoryMock{}
 		walletMock := &mocks.RskWalletMock{}
 		errorSetup(lpRepository, walletMock)
-		useCase := liquidity_provider.NewSetGeneralConfigUseCase(lpRepository, walletMock, hashMock.Hash)
+		useCase := liquidity_provider.NewSetGeneralConfigUseCase(lpRepository, walletMock, hashMock.Hash, &mocks.ProviderMock{}, &mocks.ProviderMock{})
 		err := useCase.Run(context.Background(), config.Value)
 		require.Error(t, err)
 		lpRepository.AssertExpectations(t)
@@ -105,7 +106,7 @@ func TestSetGeneralConfigUseCase_Run_ValidateConfirmations(t *testing.T) {
 
 	for _, cfg := range invalidConfigs {
 		lpRepository := &mocks.LiquidityProviderRepositoryMock{}
-		useCase := liquidity_provider.NewSetGeneralConfigUseCase(lpRepository, walletMock, hashMock.Hash)
+		useCase := liquidity_provider.NewSetGeneralConfigUseCase(lpRepository, walletMock, hashMock.Hash, &mocks.ProviderMock{}, &mocks.ProviderMock{})
 		err := useCase.Run(context.Background(), cfg)
 		require.Error(t, err)
 		if len(cfg.RskConfirmations) == 0 || len(cfg.BtcConfirmations) == 0 {
@@ -115,3 +116,72 @@ func TestSetGeneralConfigUseCase_Run_ValidateConfirmations(t *testing.T) {
 		}
 	}
 }
+
+func TestSetGeneralConfigUseCase_Run_ValidateLiquidityThresholds(t *testing.T) {
+	multiplier := utils.NewBigFloat64(2)
+	validThresholds := &lp.LiquidityThresholds{
+		Pegin: lp.OperationLiquidityThresholds{
+			Warning:  &lp.LiquidityThreshold{MaxValueMultiplier: multiplier},
+			Critical: &lp.LiquidityThreshold{Amount: entities.NewWei(100)},
+		},
+		Pegout: lp.OperationLiquidityThresholds{
+			Critical: &lp.LiquidityThreshold{MaxValueMultiplier: multiplier},
+		},
+	}
+	t.Run("should store valid thresholds", func(t *testing.T) {
+		config := lp.GeneralConfiguration{
+			RskConfirmations:    map[string]uint16{"5": 10},
+			BtcConfirmations:    map[string]uint16{"10": 20},
+			LiquidityThresholds: validThresholds,
+		}
+		provider := &mocks.ProviderMock{}
+		provider.On("PeginConfiguration", test.AnyCtx).Return(lp.PeginConfiguration{MaxValue: entities.NewWei(1000)}).Once()
+		provider.On("PegoutConfiguration", test.AnyCtx).Return(lp.PegoutConfiguration{MaxValue: entities.NewWei(1000)}).Once()
+		lpRepository := &mocks.LiquidityProviderRepositoryMock{}
+		lpRepository.On("UpsertGeneralConfiguration", test.AnyCtx, mock.MatchedBy(func(signed entities.Signed[lp.GeneralConfiguration]) bool {
+			return assert.Equal(t, config, signed.Value)
+		})).Return(nil).Once()
+		walletMock := &mocks.RskWalletMock{}
+		walletMock.On("SignBytes", mock.Anything).Return([]byte{1, 2, 3}, nil)
+		hashMock := &mocks.HashMock{}
+		hashMock.On("Hash", mock.Anything).Return([]byte{4, 5, 6})
+		useCase := liquidity_provider.NewSetGeneralConfigUseCase(lpRepository, walletMock, hashMock.Hash, provider, provider)
+		err := useCase.Run(context.Background(), config)
+		require.NoError(t, err)
+		provider.AssertExpectations(t)
+		lpRepository.AssertExpectations(t)
+	})
+	t.Run("should reject critical threshold above warning threshold", func(t *testing.T) {
+		config := lp.GeneralConfiguration{
+			RskConfirmations:    map[string]uint16{"5": 10},
+			BtcConfirmations:    map[string]uint16{"10": 20},
+			LiquidityThresholds: validThresholds,
+		}
+		provider := &mocks.ProviderMock{}
+		provider.On("PeginConfiguration", test.AnyCtx).Return(lp.PeginConfiguration{MaxValue: entities.NewWei(10)}).Once()
+		lpRepository := &mocks.LiquidityProviderRepositoryMock{}
+		useCase := liquidity_provider.NewSetGeneralConfigUseCase(lpRepository, &mocks.RskWalletMock{}, (&mocks.HashMock{}).Hash, provider, provider)
+		err := useCase.Run(context.Background(), config)
+		require.ErrorIs(t, err, lp.InvalidLiquidityThresholdError)
+		provider.AssertExpectations(t)
+		lpRepository.AssertNotCalled(t, "UpsertGeneralConfiguration")
+	})
+	t.Run("should reject invalid threshold", func(t *testing.T) {
+		config := lp.GeneralConfiguration{
+			RskConfirmations: map[string]uint16{"5": 10},
+			BtcConfirmations: map[string]uint16{"10": 20},
+			LiquidityThresholds: &lp.LiquidityThresholds{
+				Pegout: lp.OperationLiquidityThresholds{Warning: &lp.LiquidityThreshold{}},
+			},
+		}
+		provider := &mocks.ProviderMock{}
+		provider.On("PeginConfiguration", test.AnyCtx).Return(lp.PeginConfiguration{MaxValue: entities.NewWei(10)}).Once()
+		provider.On("PegoutConfiguration", test.AnyCtx).Return(lp.PegoutConfiguration{MaxValue: entities.NewWei(10)}).Once()
+		lpRepository := &mocks.LiquidityProviderRepositoryMock{}
+		useCase := liquidity_provider.NewSetGeneralConfigUseCase(lpRepository, &mocks.RskWalletMock{}, (&mocks.HashMock{}).Hash, provider, provider)
+		err := useCase.Run(context.Background(), config)
+		require.ErrorIs(t, err, lp.InvalidLiquidityThresholdError)
+		provider.AssertExpectations(t)
+		lpRepository.AssertNotCalled(t, "UpsertGeneralConfiguration")
+	})
+}
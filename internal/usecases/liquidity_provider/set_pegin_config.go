@@ -39,6 +39,9 @@ func (useCase *SetPeginConfigUseCase) Run(ctx context.Context, config liquidity_
 	if err := usecases.ValidateMinLockValue(usecases.SetPeginConfigId, useCase.contracts.Bridge, config.MinValue); err != nil {
 		return err
 	}
+	if err := config.ValidateFeeSchedule(); err != nil {
+		return usecases.WrapUseCaseError(usecases.SetPeginConfigId, err)
+	}
 	signedConfig, err := usecases.SignConfiguration(usecases.SetPeginConfigId, useCase.signer, useCase.hashFunc, config)
 	if err != nil {
 		return err
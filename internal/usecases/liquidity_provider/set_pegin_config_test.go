@@ -222,3 +222,25 @@ func TestSetPegoutConfigUseCase_Run_ValidatePositiveWei_EachField(t *testing.T)
 		bridge.AssertExpectations(t)
 	}
 }
+
+func TestSetPeginConfigUseCase_Run_InvalidFeeSchedule(t *testing.T) {
+	lpRepository := &mocks.LiquidityProviderRepositoryMock{}
+	walletMock := &mocks.RskWalletMock{}
+	bridge := &mocks.BridgeMock{}
+	bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(1), nil)
+	contracts := blockchain.RskContracts{Bridge: bridge}
+
+	cfg := peginConfigMock.Value
+	cfg.FeeTiers = lp.FeeTiers{{MaxAmount: entities.NewWei(0), FixedFee: entities.NewWei(1), FeePercentage: utils.NewBigFloat64(1)}}
+	useCase := liquidity_provider.NewSetPeginConfigUseCase(lpRepository, walletMock, (&mocks.HashMock{}).Hash, contracts)
+	err := useCase.Run(context.Background(), cfg)
+	require.ErrorIs(t, err, lp.InvalidFeeScheduleError)
+
+	cfg = peginConfigMock.Value
+	cfg.FeeSurcharges = &lp.FeeSurcharges{TimeOfDay: []lp.TimeOfDaySurcharge{{StartHour: 5, EndHour: 5, FeePercentage: utils.NewBigFloat64(1)}}}
+	err = useCase.Run(context.Background(), cfg)
+	require.ErrorIs(t, err, lp.InvalidFeeScheduleError)
+
+	lpRepository.AssertNotCalled(t, "UpsertPeginConfiguration", mock.Anything, mock.Anything)
+	walletMock.AssertNotCalled(t, "SignBytes", mock.Anything)
+}
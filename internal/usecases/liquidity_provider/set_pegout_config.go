@@ -41,7 +41,9 @@ func (useCase *SetPegoutConfigUseCase) Run(ctx context.Context, config liquidity
 	if err = usecases.ValidateMinLockValue(usecases.SetPegoutConfigId, useCase.contracts.Bridge, config.BridgeTransactionMin); err != nil {
 		return err
 	}
-
+	if err = config.ValidateFeeSchedule(); err != nil {
+		return usecases.WrapUseCaseError(usecases.SetPegoutConfigId, err)
+	}
 	signedConfig, err := usecases.SignConfiguration(usecases.SetPegoutConfigId, useCase.signer, useCase.hashFunc, config)
 	if err != nil {
 		return err
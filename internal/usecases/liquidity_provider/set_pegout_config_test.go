@@ -201,3 +201,22 @@ func TestSetPeginConfigUseCase_Run_ValidatePositiveWei_EachField(t *testing.T) {
 		bridge.AssertNotCalled(t, "GetMinimumLockTxValue")
 	}
 }
+
+func TestSetPegoutConfigUseCase_Run_InvalidFeeSchedule(t *testing.T) {
+	lpRepository := &mocks.LiquidityProviderRepositoryMock{}
+	walletMock := &mocks.RskWalletMock{}
+	hashMock := &mocks.HashMock{}
+	bridge := &mocks.BridgeMock{}
+	bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(1), nil)
+	contracts := blockchain.RskContracts{Bridge: bridge}
+
+	cfg := pegoutConfigMock.Value
+	cfg.FeeSurcharges = &lp.FeeSurcharges{
+		Utilization: []lp.UtilizationSurcharge{{Threshold: lp.LiquidityThreshold{}, FeePercentage: utils.NewBigFloat64(1)}},
+	}
+	useCase := liquidity_provider.NewSetPegoutConfigUseCase(lpRepository, walletMock, hashMock.Hash, contracts)
+	err := useCase.Run(context.Background(), cfg)
+	require.ErrorIs(t, err, lp.InvalidFeeScheduleError)
+	lpRepository.AssertNotCalled(t, "UpsertPegoutConfiguration", mock.Anything, mock.Anything)
+	walletMock.AssertNotCalled(t, "SignBytes", mock.Anything)
+}
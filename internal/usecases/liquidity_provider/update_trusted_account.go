@@ -27,6 +27,9 @@ func NewUpdateTrustedAccountUseCase(
 }
 
 func (useCase *UpdateTrustedAccountUseCase) Run(ctx context.Context, account liquidity_provider.TrustedAccountDetails) error {
+	if err := account.ValidateTerms(); err != nil {
+		return usecases.WrapUseCaseError(usecases.UpdateTrustedAccountId, err)
+	}
 	signedAccount, err := usecases.SignConfiguration(usecases.UpdateTrustedAccountId, useCase.signer, useCase.hashFunc, account)
 	if err != nil {
 		return err
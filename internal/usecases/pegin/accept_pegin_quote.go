@@ -21,6 +21,7 @@ type AcceptQuoteUseCase struct {
 	peginLp                  liquidity_provider.PeginLiquidityProvider
 	eventBus                 entities.EventBus
 	peginLiquidityMutex      sync.Locker
+	ledger                   liquidity_provider.LiquidityLedger
 	trustedAccountRepository liquidity_provider.TrustedAccountRepository
 	hashFunction             entities.HashFunction
 }
@@ -33,6 +34,7 @@ func NewAcceptQuoteUseCase(
 	peginLp liquidity_provider.PeginLiquidityProvider,
 	eventBus entities.EventBus,
 	peginLiquidityMutex sync.Locker,
+	ledger liquidity_provider.LiquidityLedger,
 	trustedAccountRepository liquidity_provider.TrustedAccountRepository,
 	hashFunction entities.HashFunction,
 ) *AcceptQuoteUseCase {
@@ -44,6 +46,7 @@ func NewAcceptQuoteUseCase(
 		peginLp:                  peginLp,
 		eventBus:                 eventBus,
 		peginLiquidityMutex:      peginLiquidityMutex,
+		ledger:                   ledger,
 		trustedAccountRepository: trustedAccountRepository,
 		hashFunction:             hashFunction,
 	}
@@ -93,7 +96,12 @@ func (useCase *AcceptQuoteUseCase) Run(ctx context.Context, quoteHash, signature
 	if retainedQuote, err = useCase.buildRetainedQuote(ctx, quoteHash, peginQuote, trustedAccount.Address); err != nil {
 		return quote.AcceptedQuote{}, err
 	}
+	hold := liquidity_provider.NewLiquidityHold(quoteHash, liquidity_provider.PeginHold, retainedQuote.RequiredLiquidity, peginQuote.HoldExpireTime())
+	if err = useCase.ledger.PlaceHold(ctx, hold); err != nil {
+		return quote.AcceptedQuote{}, usecases.WrapUseCaseError(usecases.AcceptPeginQuoteId, err)
+	}
 	if err = useCase.quoteRepository.InsertRetainedQuote(ctx, *retainedQuote); err != nil {
+		usecases.ReleaseFailedAcceptHold(ctx, useCase.ledger, quoteHash)
 		return quote.AcceptedQuote{}, usecases.WrapUseCaseError(usecases.AcceptPeginQuoteId, err)
 	}
 
@@ -109,6 +117,7 @@ func (useCase *AcceptQuoteUseCase) Run(ctx context.Context, quoteHash, signature
 	return quote.AcceptedQuote{
 		Signature:      retainedQuote.Signature,
 		DepositAddress: retainedQuote.DepositAddress,
+		Created:        true,
 	}, nil
 }
 
@@ -110,16 +110,23 @@ func TestAcceptQuoteUseCase_Run(t *testing.T) {
 	})).Once()
 	mutex := new(mocks.MutexMock)
 	mutex.On("Lock").Return().On("Unlock").Return()
+	ledger := new(mocks.LiquidityLedgerMock)
+	ledger.EXPECT().PlaceHold(test.AnyCtx, mock.MatchedBy(func(hold liquidity_provider.LiquidityHold) bool {
+		return assert.Equal(t, acceptPeginQuoteHash, hold.QuoteHash) && assert.Equal(t, liquidity_provider.PeginHold, hold.Operation) &&
+			assert.Equal(t, requiredLiquidity, hold.Amount) && assert.Equal(t, liquidity_provider.HoldStatusActive, hold.Status) &&
+			assert.True(t, testPeginQuote.HoldExpireTime().Equal(hold.ExpiresAt))
+	})).Return(nil).Once()
 	rsk := new(mocks.RootstockRpcServerMock)
 	rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(50), nil)
 	peginContract := new(mocks.PeginContractMock)
 	peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil)
 
 	contracts := blockchain.RskContracts{Bridge: bridge, PegIn: peginContract}
-	useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, blockchain.Rpc{Rsk: rsk, Btc: btc}, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+	useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, blockchain.Rpc{Rsk: rsk, Btc: btc}, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 	result, err := useCase.Run(context.Background(), acceptPeginQuoteHash, "")
 
 	rsk.AssertExpectations(t)
+	ledger.AssertExpectations(t)
 	quoteRepository.AssertExpectations(t)
 	bridge.AssertExpectations(t)
 	btc.AssertExpectations(t)
@@ -131,6 +138,7 @@ func TestAcceptQuoteUseCase_Run(t *testing.T) {
 	assert.NotEmpty(t, result)
 	assert.Equal(t, acceptPeginDerivationAddress, result.DepositAddress)
 	assert.Equal(t, acceptPeginSignature, result.Signature)
+	assert.True(t, result.Created)
 }
 
 // nolint:funlen
@@ -160,6 +168,8 @@ func TestAcceptQuoteUseCase_Run_WithoutCaptcha(t *testing.T) {
 	bridge := new(mocks.BridgeMock)
 	eventBus := new(mocks.EventBusMock)
 	mutex := new(mocks.MutexMock)
+	ledger := new(mocks.LiquidityLedgerMock)
+	ledger.EXPECT().PlaceHold(test.AnyCtx, mock.AnythingOfType("liquidity_provider.LiquidityHold")).Return(nil).Once()
 	rsk := new(mocks.RootstockRpcServerMock)
 	lp := new(mocks.ProviderMock)
 	lp.On("GetSigner").Return(signerMock)
@@ -213,7 +223,7 @@ func TestAcceptQuoteUseCase_Run_WithoutCaptcha(t *testing.T) {
 		mutex.On("Lock").Return().On("Unlock").Return()
 		rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(50), nil)
 
-		useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, blockchain.Rpc{Rsk: rsk, Btc: btc}, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+		useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, blockchain.Rpc{Rsk: rsk, Btc: btc}, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 		result, err := useCase.Run(context.Background(), acceptPeginQuoteHash, acceptPeginQuoteHashSignature)
 
 		rsk.AssertExpectations(t)
@@ -243,7 +253,7 @@ func TestAcceptQuoteUseCase_Run_WithoutCaptcha(t *testing.T) {
 		// We don't expect these to be called because signature validation should fail first
 		quoteRepository.On("GetRetainedQuote", mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 
-		useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, blockchain.Rpc{Rsk: rsk, Btc: btc}, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+		useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, blockchain.Rpc{Rsk: rsk, Btc: btc}, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 
 		result, err := useCase.Run(context.Background(), acceptPeginQuoteHash, invalidSignature)
 
@@ -292,7 +302,7 @@ func TestAcceptQuoteUseCase_Run_WithoutCaptcha(t *testing.T) {
 		peginContract.EXPECT().HashPeginQuoteEIP712(newQuote).Return(utils.To32Bytes(hexutil.MustDecode(utils.Prepend0x(acceptPeginQuoteEip712Hash))), nil).Once()
 		lp.On("SignPeginQuote", mock.Anything, acceptPeginQuoteHash).Return(acceptPeginSignature, nil).Once()
 
-		useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, blockchain.Rpc{Rsk: rsk, Btc: btc}, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+		useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, blockchain.Rpc{Rsk: rsk, Btc: btc}, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 		result, err := useCase.Run(context.Background(), acceptPeginQuoteHash, acceptPeginQuoteHashSignature)
 
 		require.Error(t, err)
@@ -306,7 +316,7 @@ func TestAcceptQuoteUseCase_Run_WithoutCaptcha(t *testing.T) {
 		contract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil)
 		contract.EXPECT().HashPeginQuoteEIP712(testPeginQuote).Return([32]byte{}, assert.AnError).Once()
 
-		useCase := pegin.NewAcceptQuoteUseCase(repo, blockchain.RskContracts{PegIn: contract}, blockchain.Rpc{Rsk: rsk, Btc: btc}, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+		useCase := pegin.NewAcceptQuoteUseCase(repo, blockchain.RskContracts{PegIn: contract}, blockchain.Rpc{Rsk: rsk, Btc: btc}, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 		result, err := useCase.Run(context.Background(), acceptPeginQuoteHash, acceptPeginQuoteHashSignature)
 
 		require.Error(t, err)
@@ -336,13 +346,14 @@ func TestAcceptQuoteUseCase_Run_AlreadyAccepted(t *testing.T) {
 	mutex := new(mocks.MutexMock)
 	mutex.On("Lock").Return()
 	mutex.On("Unlock").Return()
+	ledger := new(mocks.LiquidityLedgerMock)
 	rsk := new(mocks.RootstockRpcServerMock)
 	peginContract := new(mocks.PeginContractMock)
 	peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil)
 
 	contracts := blockchain.RskContracts{Bridge: bridge, PegIn: peginContract}
 	rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
-	useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, rpc, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+	useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, rpc, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 	result, err := useCase.Run(context.Background(), acceptPeginQuoteHash, "")
 
 	rsk.AssertNotCalled(t, "GasPrice")
@@ -359,6 +370,7 @@ func TestAcceptQuoteUseCase_Run_AlreadyAccepted(t *testing.T) {
 	assert.NotEmpty(t, result)
 	assert.Equal(t, acceptPeginDerivationAddress, result.DepositAddress)
 	assert.Equal(t, acceptPeginSignature, result.Signature)
+	assert.False(t, result.Created)
 }
 
 func TestAcceptQuoteUseCase_Run_Paused(t *testing.T) {
@@ -368,6 +380,7 @@ func TestAcceptQuoteUseCase_Run_Paused(t *testing.T) {
 	lp := new(mocks.ProviderMock)
 	eventBus := new(mocks.EventBusMock)
 	mutex := new(mocks.MutexMock)
+	ledger := new(mocks.LiquidityLedgerMock)
 	rsk := new(mocks.RootstockRpcServerMock)
 	peginContract := new(mocks.PeginContractMock)
 	peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: true, Since: 5, Reason: "test"}, nil)
@@ -375,7 +388,7 @@ func TestAcceptQuoteUseCase_Run_Paused(t *testing.T) {
 
 	contracts := blockchain.RskContracts{Bridge: bridge, PegIn: peginContract}
 	rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
-	useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, rpc, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+	useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, rpc, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 	result, err := useCase.Run(context.Background(), acceptPeginQuoteHash, "")
 	assert.Empty(t, result)
 	require.ErrorIs(t, err, blockchain.ContractPausedError)
@@ -390,13 +403,14 @@ func TestAcceptQuoteUseCase_Run_QuoteNotFound(t *testing.T) {
 	lp := new(mocks.ProviderMock)
 	eventBus := new(mocks.EventBusMock)
 	mutex := new(mocks.MutexMock)
+	ledger := new(mocks.LiquidityLedgerMock)
 	rsk := new(mocks.RootstockRpcServerMock)
 	peginContract := new(mocks.PeginContractMock)
 	peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil)
 
 	contracts := blockchain.RskContracts{Bridge: bridge, PegIn: peginContract}
 	rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
-	useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, rpc, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+	useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, rpc, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 	result, err := useCase.Run(context.Background(), acceptPeginQuoteHash, "")
 
 	rsk.AssertNotCalled(t, "GasPrice")
@@ -425,13 +439,14 @@ func TestAcceptQuoteUseCase_Run_ExpiredQuote(t *testing.T) {
 	lp := new(mocks.ProviderMock)
 	eventBus := new(mocks.EventBusMock)
 	mutex := new(mocks.MutexMock)
+	ledger := new(mocks.LiquidityLedgerMock)
 	rsk := new(mocks.RootstockRpcServerMock)
 	peginContract := new(mocks.PeginContractMock)
 	peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil)
 
 	contracts := blockchain.RskContracts{Bridge: bridge, PegIn: peginContract}
 	rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
-	useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, rpc, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+	useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, rpc, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 	result, err := useCase.Run(context.Background(), acceptPeginQuoteHash, "")
 
 	rsk.AssertNotCalled(t, "GasPrice")
@@ -468,6 +483,7 @@ func TestAcceptQuoteUseCase_Run_NoLiquidity(t *testing.T) {
 	mutex := new(mocks.MutexMock)
 	mutex.On("Lock").Return()
 	mutex.On("Unlock").Return()
+	ledger := new(mocks.LiquidityLedgerMock)
 	rsk := new(mocks.RootstockRpcServerMock)
 	rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(50), nil)
 	peginContract := new(mocks.PeginContractMock)
@@ -475,7 +491,7 @@ func TestAcceptQuoteUseCase_Run_NoLiquidity(t *testing.T) {
 
 	contracts := blockchain.RskContracts{Bridge: bridge, PegIn: peginContract}
 	rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
-	useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, rpc, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+	useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, rpc, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 	result, err := useCase.Run(context.Background(), acceptPeginQuoteHash, "")
 
 	rsk.AssertExpectations(t)
@@ -495,6 +511,9 @@ func TestAcceptQuoteUseCase_Run_ErrorHandling(t *testing.T) {
 	mutex := new(mocks.MutexMock)
 	mutex.On("Lock").Return()
 	mutex.On("Unlock").Return()
+	ledger := new(mocks.LiquidityLedgerMock)
+	ledger.EXPECT().PlaceHold(test.AnyCtx, mock.AnythingOfType("liquidity_provider.LiquidityHold")).Return(nil)
+	ledger.EXPECT().ReleaseHold(test.AnyCtx, mock.Anything, liquidity_provider.HoldReleasedByAcceptFailure).Return(true, nil)
 
 	setups := acceptQuoteUseCaseUnexpectedErrorSetups()
 	for _, setup := range setups {
@@ -509,7 +528,7 @@ func TestAcceptQuoteUseCase_Run_ErrorHandling(t *testing.T) {
 		setup(&caseHash, quoteRepository, bridge, btc, lp, rsk)
 		contracts := blockchain.RskContracts{Bridge: bridge, PegIn: peginContract}
 		rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
-		useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, rpc, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+		useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, rpc, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 		result, err := useCase.Run(context.Background(), caseHash, "")
 
 		rsk.AssertExpectations(t)
@@ -636,3 +655,55 @@ func acceptQuoteUseCaseUnexpectedErrorSetups() []func(quoteHash *string, quoteRe
 		},
 	}
 }
+
+func TestAcceptQuoteUseCase_Run_LiquidityHold(t *testing.T) {
+	requiredLiquidity := entities.NewWei(9280000)
+	setupMocks := func() (*mocks.PeginQuoteRepositoryMock, blockchain.RskContracts, blockchain.Rpc, *mocks.ProviderMock, *mocks.MutexMock) {
+		quoteRepository := new(mocks.PeginQuoteRepositoryMock)
+		quoteRepository.On("GetQuote", test.AnyCtx, acceptPeginQuoteHash).Return(&testPeginQuote, nil)
+		quoteRepository.On("GetRetainedQuote", test.AnyCtx, acceptPeginQuoteHash).Return(nil, nil)
+		bridge := new(mocks.BridgeMock)
+		bridge.On("FetchFederationInfo").Return(federationInfo, nil)
+		bridge.On("GetFlyoverDerivationAddress", mock.Anything).Return(rootstock.FlyoverDerivation{Address: acceptPeginDerivationAddress, RedeemScript: anyScript}, nil)
+		btc := new(mocks.BtcRpcMock)
+		btc.On("DecodeAddress", mock.Anything).Return([]byte{4, 5, 6}, nil)
+		lp := new(mocks.ProviderMock)
+		lp.On("HasPeginLiquidity", test.AnyCtx, requiredLiquidity).Return(nil)
+		lp.On("SignPeginQuote", mock.Anything, acceptPeginQuoteHash).Return(acceptPeginSignature, nil)
+		mutex := new(mocks.MutexMock)
+		mutex.On("Lock").Return().On("Unlock").Return()
+		rsk := new(mocks.RootstockRpcServerMock)
+		rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(50), nil)
+		peginContract := new(mocks.PeginContractMock)
+		peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil)
+		return quoteRepository, blockchain.RskContracts{Bridge: bridge, PegIn: peginContract}, blockchain.Rpc{Rsk: rsk, Btc: btc}, lp, mutex
+	}
+	t.Run("should not retain the quote if the hold can't be placed", func(t *testing.T) {
+		quoteRepository, contracts, rpc, lp, mutex := setupMocks()
+		eventBus := new(mocks.EventBusMock)
+		ledger := new(mocks.LiquidityLedgerMock)
+		ledger.EXPECT().PlaceHold(test.AnyCtx, mock.AnythingOfType("liquidity_provider.LiquidityHold")).Return(assert.AnError).Once()
+		useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, rpc, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
+		result, err := useCase.Run(context.Background(), acceptPeginQuoteHash, "")
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Empty(t, result)
+		ledger.AssertExpectations(t)
+		quoteRepository.AssertNotCalled(t, "InsertRetainedQuote", mock.Anything, mock.Anything)
+		eventBus.AssertNotCalled(t, "Publish", mock.Anything)
+	})
+	t.Run("should release the hold if the quote can't be retained", func(t *testing.T) {
+		quoteRepository, contracts, rpc, lp, mutex := setupMocks()
+		quoteRepository.On("InsertRetainedQuote", test.AnyCtx, mock.Anything).Return(assert.AnError).Once()
+		eventBus := new(mocks.EventBusMock)
+		ledger := new(mocks.LiquidityLedgerMock)
+		ledger.EXPECT().PlaceHold(test.AnyCtx, mock.AnythingOfType("liquidity_provider.LiquidityHold")).Return(nil).Once()
+		ledger.EXPECT().ReleaseHold(test.AnyCtx, acceptPeginQuoteHash, liquidity_provider.HoldReleasedByAcceptFailure).Return(false, assert.AnError).Once()
+		useCase := pegin.NewAcceptQuoteUseCase(quoteRepository, contracts, rpc, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
+		result, err := useCase.Run(context.Background(), acceptPeginQuoteHash, "")
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Empty(t, result)
+		ledger.AssertExpectations(t)
+		quoteRepository.AssertExpectations(t)
+		eventBus.AssertNotCalled(t, "Publish", mock.Anything)
+	})
+}
@@ -42,7 +42,7 @@ func NewCallForUserUseCase(
 }
 
 func (useCase *CallForUserUseCase) Run(ctx context.Context, retainedQuote quote.RetainedPeginQuote) error {
-	var valueToSend *entities.Wei
+	var valueToSend, gasPrice *entities.Wei
 	var peginQuote *quote.PeginQuote
 	var creationData quote.PeginCreationData
 	var err error
@@ -73,7 +73,11 @@ func (useCase *CallForUserUseCase) Run(ctx context.Context, retainedQuote quote.
 		return err
 	}
 
-	retainedQuote, err = useCase.performCallForUser(valueToSend, peginQuote, retainedQuote, creationData)
+	if gasPrice, err = useCase.rpc.Rsk.GasPrice(ctx); err != nil {
+		return useCase.publishErrorEvent(ctx, retainedQuote, *peginQuote, err, true)
+	}
+
+	retainedQuote, err = useCase.performCallForUser(valueToSend, gasPrice, peginQuote, retainedQuote, creationData)
 
 	if updateError := useCase.quoteRepository.UpdateRetainedQuote(ctx, retainedQuote); updateError != nil {
 		err = errors.Join(err, updateError)
@@ -139,7 +143,7 @@ func (useCase *CallForUserUseCase) calculateValueToSend(
 }
 
 func (useCase *CallForUserUseCase) performCallForUser(
-	valueToSend *entities.Wei,
+	valueToSend, gasPrice *entities.Wei,
 	peginQuote *quote.PeginQuote,
 	retainedQuote quote.RetainedPeginQuote,
 	creationData quote.PeginCreationData,
@@ -148,7 +152,7 @@ func (useCase *CallForUserUseCase) performCallForUser(
 	var receipt blockchain.TransactionReceipt
 	var err error
 
-	config := blockchain.NewTransactionConfig(valueToSend, uint64(peginQuote.GasLimit+CallForUserExtraGas), nil)
+	config := blockchain.NewTransactionConfig(valueToSend, uint64(peginQuote.GasLimit+CallForUserExtraGas), gasPrice)
 	if receipt, err = useCase.contracts.PegIn.CallForUser(config, *peginQuote); err != nil {
 		quoteState = quote.PeginStateCallForUserFailed
 	} else {
@@ -70,7 +70,7 @@ func TestCallForUserUseCase_Run(t *testing.T) {
 	peginContract := new(mocks.PeginContractMock)
 	peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil).Once()
 	peginContract.On("GetBalance", testPeginQuote.LpRskAddress).Return(entities.NewWei(50000), nil).Once()
-	txConfig := blockchain.NewTransactionConfig(entities.NewWei(0), uint64(testPeginQuote.GasLimit+pegin.CallForUserExtraGas), nil)
+	txConfig := blockchain.NewTransactionConfig(entities.NewWei(0), uint64(testPeginQuote.GasLimit+pegin.CallForUserExtraGas), entities.NewWei(1000000000))
 	callForUserReceipt := blockchain.TransactionReceipt{
 		TransactionHash:   callForUserTxHash,
 		BlockHash:         "0xblock123",
@@ -108,6 +108,7 @@ func TestCallForUserUseCase_Run(t *testing.T) {
 	})).Return(nil).Once()
 	quoteRepository.EXPECT().GetPeginCreationData(test.AnyCtx, retainedPeginQuote.QuoteHash).Return(creationData).Once()
 	rsk := new(mocks.RootstockRpcServerMock)
+	rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(1000000000), nil).Once()
 
 	contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
 	rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
@@ -149,7 +150,7 @@ func TestCallForUserUseCase_Run_AddExtraAmountDuringCall(t *testing.T) {
 	peginContract := new(mocks.PeginContractMock)
 	peginContract.On("GetBalance", testPeginQuote.LpRskAddress).Return(entities.NewWei(600), nil).Once()
 	peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil).Once()
-	txConfig := blockchain.NewTransactionConfig(entities.NewWei(29400), uint64(testPeginQuote.GasLimit+pegin.CallForUserExtraGas), nil)
+	txConfig := blockchain.NewTransactionConfig(entities.NewWei(29400), uint64(testPeginQuote.GasLimit+pegin.CallForUserExtraGas), entities.NewWei(1000000000))
 	callForUserReceipt := blockchain.TransactionReceipt{
 		TransactionHash:   callForUserTxHash,
 		BlockHash:         "0xblock123",
@@ -188,6 +189,7 @@ func TestCallForUserUseCase_Run_AddExtraAmountDuringCall(t *testing.T) {
 
 	rsk := new(mocks.RootstockRpcServerMock)
 	rsk.On("GetBalance", test.AnyCtx, lpRskAddress).Return(entities.NewWei(80000), nil).Once()
+	rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(1000000000), nil).Once()
 	contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
 	useCase := pegin.NewCallForUserUseCase(contracts, quoteRepository, blockchain.Rpc{Rsk: rsk, Btc: btc}, lp, eventBus, mutex)
 	err := useCase.Run(context.Background(), retainedPeginQuote)
@@ -336,6 +338,24 @@ func callForUserRecoverableErrorSetups() []func(caseRetainedQuote *quote.Retaine
 			btc.On("GetRawTransaction", mock.Anything).Return([]byte{0x01}, nil).Once()
 			rsk.On("GetBalance", mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
 		},
+		func(caseRetainedQuote *quote.RetainedPeginQuote, rsk *mocks.RootstockRpcServerMock, peginContract *mocks.PeginContractMock, btc *mocks.BtcRpcMock, quoteRepository *mocks.PeginQuoteRepositoryMock, bridge *mocks.BridgeMock) {
+			quoteRepository.On("GetQuote", test.AnyCtx, mock.Anything).
+				Return(&peginQuote, nil).Once()
+			btc.On("GetTransactionInfo", mock.Anything).Return(blockchain.BitcoinTransactionInformation{
+				Hash:          "0x1d1e",
+				Confirmations: 10,
+				Outputs:       map[string][]*entities.Wei{test.AnyAddress: {entities.NewWei(1700)}},
+			}, nil).Once()
+			btc.On("GetTransactionBlockInfo", mock.Anything).Return(blockchain.BitcoinBlockInformation{
+				Hash:   [32]byte{1, 2, 3},
+				Height: big.NewInt(5),
+				Time:   time.Now(),
+			}, nil).Once()
+			bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(1000), nil).Once()
+			peginContract.On("GetBalance", mock.Anything).Return(entities.NewWei(5000), nil).Once()
+			btc.On("GetRawTransaction", mock.Anything).Return([]byte{0x01}, nil).Once()
+			rsk.On("GasPrice", mock.Anything).Return(nil, assert.AnError).Once()
+		},
 		func(caseRetainedQuote *quote.RetainedPeginQuote, rsk *mocks.RootstockRpcServerMock, peginContract *mocks.PeginContractMock, btc *mocks.BtcRpcMock, quoteRepository *mocks.PeginQuoteRepositoryMock, bridge *mocks.BridgeMock) {
 			quoteRepository.On("GetQuote", test.AnyCtx, mock.Anything).
 				Return(&peginQuote, nil).Once()
@@ -659,7 +679,7 @@ func TestCallForUserUseCase_Run_CallForUserFail(t *testing.T) {
 	peginContract := new(mocks.PeginContractMock)
 	peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil).Once()
 	peginContract.On("GetBalance", testPeginQuote.LpRskAddress).Return(entities.NewWei(600), nil).Once()
-	txConfig := blockchain.NewTransactionConfig(entities.NewWei(29400), uint64(testPeginQuote.GasLimit+pegin.CallForUserExtraGas), nil)
+	txConfig := blockchain.NewTransactionConfig(entities.NewWei(29400), uint64(testPeginQuote.GasLimit+pegin.CallForUserExtraGas), entities.NewWei(1000000000))
 	callForUserReceipt := blockchain.TransactionReceipt{
 		TransactionHash:   callForUserTxHash,
 		BlockHash:         "0xblock123",
@@ -698,6 +718,7 @@ func TestCallForUserUseCase_Run_CallForUserFail(t *testing.T) {
 
 	rsk := new(mocks.RootstockRpcServerMock)
 	rsk.On("GetBalance", test.AnyCtx, lpRskAddress).Return(entities.NewWei(80000), nil).Once()
+	rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(1000000000), nil).Once()
 	contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
 	rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
 	useCase := pegin.NewCallForUserUseCase(contracts, quoteRepository, rpc, lp, eventBus, mutex)
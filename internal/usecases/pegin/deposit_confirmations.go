@@ -0,0 +1,49 @@
+package pegin
+
+import (
+	"context"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+type DepositConfirmationsUseCase struct {
+	quoteRepository quote.PeginQuoteRepository
+	rpc             blockchain.Rpc
+}
+
+func NewDepositConfirmationsUseCase(quoteRepository quote.PeginQuoteRepository, rpc blockchain.Rpc) *DepositConfirmationsUseCase {
+	return &DepositConfirmationsUseCase{quoteRepository: quoteRepository, rpc: rpc}
+}
+
+// Run returns the confirmations of the BTC transaction that the user did to pay the quote. If the deposit
+// hasn't been detected yet, the result doesn't have a transaction hash and has zero confirmations
+func (useCase *DepositConfirmationsUseCase) Run(ctx context.Context, quoteHash string) (quote.DepositConfirmations, error) {
+	peginQuote, err := useCase.quoteRepository.GetQuote(ctx, quoteHash)
+	if err != nil {
+		return quote.DepositConfirmations{}, usecases.WrapUseCaseError(usecases.PeginDepositConfirmationsId, err)
+	} else if peginQuote == nil {
+		return quote.DepositConfirmations{}, usecases.WrapUseCaseError(usecases.PeginDepositConfirmationsId, usecases.QuoteNotFoundError)
+	}
+	retainedQuote, err := useCase.quoteRepository.GetRetainedQuote(ctx, quoteHash)
+	if err != nil {
+		return quote.DepositConfirmations{}, usecases.WrapUseCaseError(usecases.PeginDepositConfirmationsId, err)
+	} else if retainedQuote == nil {
+		return quote.DepositConfirmations{}, usecases.WrapUseCaseError(usecases.PeginDepositConfirmationsId, usecases.QuoteNotAcceptedError)
+	}
+
+	result := quote.DepositConfirmations{
+		TxHash:                retainedQuote.UserBtcTxHash,
+		RequiredConfirmations: uint64(peginQuote.Confirmations),
+	}
+	if result.TxHash == "" {
+		return result, nil
+	}
+	txInfo, err := useCase.rpc.Btc.GetTransactionInfo(result.TxHash)
+	if err != nil {
+		return quote.DepositConfirmations{}, usecases.WrapUseCaseError(usecases.PeginDepositConfirmationsId, err)
+	}
+	result.Confirmations = txInfo.Confirmations
+	return result, nil
+}
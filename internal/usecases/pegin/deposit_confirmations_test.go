@@ -0,0 +1,85 @@
+package pegin_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegin"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestDepositConfirmationsUseCase_Run(t *testing.T) {
+	const quoteHash = "quoteHash"
+	retainedQuote := quote.RetainedPeginQuote{QuoteHash: quoteHash, State: quote.PeginStateWaitingForDepositConfirmations, UserBtcTxHash: "btc tx hash"}
+	t.Run("Return the confirmations of the user deposit", func(t *testing.T) {
+		repo := new(mocks.PeginQuoteRepositoryMock)
+		btc := new(mocks.BtcRpcMock)
+		repo.On("GetQuote", context.Background(), quoteHash).Return(&testPeginQuote, nil).Once()
+		repo.On("GetRetainedQuote", context.Background(), quoteHash).Return(&retainedQuote, nil).Once()
+		btc.On("GetTransactionInfo", "btc tx hash").Return(blockchain.BitcoinTransactionInformation{Confirmations: 3}, nil).Once()
+		useCase := pegin.NewDepositConfirmationsUseCase(repo, blockchain.Rpc{Btc: btc})
+		result, err := useCase.Run(context.Background(), quoteHash)
+		require.NoError(t, err)
+		assert.Equal(t, quote.DepositConfirmations{TxHash: "btc tx hash", Confirmations: 3, RequiredConfirmations: 10}, result)
+		repo.AssertExpectations(t)
+		btc.AssertExpectations(t)
+	})
+	t.Run("Return zero confirmations when the deposit wasn't detected", func(t *testing.T) {
+		repo := new(mocks.PeginQuoteRepositoryMock)
+		btc := new(mocks.BtcRpcMock)
+		waitingQuote := quote.RetainedPeginQuote{QuoteHash: quoteHash, State: quote.PeginStateWaitingForDeposit}
+		repo.On("GetQuote", context.Background(), quoteHash).Return(&testPeginQuote, nil).Once()
+		repo.On("GetRetainedQuote", context.Background(), quoteHash).Return(&waitingQuote, nil).Once()
+		useCase := pegin.NewDepositConfirmationsUseCase(repo, blockchain.Rpc{Btc: btc})
+		result, err := useCase.Run(context.Background(), quoteHash)
+		require.NoError(t, err)
+		assert.Equal(t, quote.DepositConfirmations{RequiredConfirmations: 10}, result)
+		btc.AssertNotCalled(t, "GetTransactionInfo")
+	})
+	t.Run("Return not found error", func(t *testing.T) {
+		repo := new(mocks.PeginQuoteRepositoryMock)
+		repo.On("GetQuote", context.Background(), quoteHash).Return(nil, nil).Once()
+		useCase := pegin.NewDepositConfirmationsUseCase(repo, blockchain.Rpc{})
+		_, err := useCase.Run(context.Background(), quoteHash)
+		require.ErrorIs(t, err, usecases.QuoteNotFoundError)
+	})
+	t.Run("Return not accepted error", func(t *testing.T) {
+		repo := new(mocks.PeginQuoteRepositoryMock)
+		repo.On("GetQuote", context.Background(), quoteHash).Return(&testPeginQuote, nil).Once()
+		repo.On("GetRetainedQuote", context.Background(), quoteHash).Return(nil, nil).Once()
+		useCase := pegin.NewDepositConfirmationsUseCase(repo, blockchain.Rpc{})
+		_, err := useCase.Run(context.Background(), quoteHash)
+		require.ErrorIs(t, err, usecases.QuoteNotAcceptedError)
+	})
+	t.Run("Handle errors", func(t *testing.T) {
+		setups := []func(repo *mocks.PeginQuoteRepositoryMock, btc *mocks.BtcRpcMock){
+			func(repo *mocks.PeginQuoteRepositoryMock, btc *mocks.BtcRpcMock) {
+				repo.On("GetQuote", context.Background(), quoteHash).Return(nil, assert.AnError).Once()
+			},
+			func(repo *mocks.PeginQuoteRepositoryMock, btc *mocks.BtcRpcMock) {
+				repo.On("GetQuote", context.Background(), quoteHash).Return(&testPeginQuote, nil).Once()
+				repo.On("GetRetainedQuote", context.Background(), quoteHash).Return(nil, assert.AnError).Once()
+			},
+			func(repo *mocks.PeginQuoteRepositoryMock, btc *mocks.BtcRpcMock) {
+				repo.On("GetQuote", context.Background(), quoteHash).Return(&testPeginQuote, nil).Once()
+				repo.On("GetRetainedQuote", context.Background(), quoteHash).Return(&retainedQuote, nil).Once()
+				btc.On("GetTransactionInfo", "btc tx hash").Return(blockchain.BitcoinTransactionInformation{}, assert.AnError).Once()
+			},
+		}
+		for _, setup := range setups {
+			repo := new(mocks.PeginQuoteRepositoryMock)
+			btc := new(mocks.BtcRpcMock)
+			setup(repo, btc)
+			useCase := pegin.NewDepositConfirmationsUseCase(repo, blockchain.Rpc{Btc: btc})
+			result, err := useCase.Run(context.Background(), quoteHash)
+			require.ErrorIs(t, err, assert.AnError)
+			assert.Empty(t, result)
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package pegin_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegin"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateQuoteUseCase_Run(t *testing.T) {
+	quoteValue := entities.NewWei(5000)
+	quoteData := []byte{1}
+	rsk := new(mocks.RootstockRpcServerMock)
+	rsk.On("EstimateGas", mock.Anything, getPeginTestUserAddress, quoteValue, quoteData).Return(entities.NewWei(100), nil).Once()
+	rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(100), nil).Once()
+	rsk.EXPECT().ChainId(mock.Anything).Return(31, nil).Once()
+	bridge := new(mocks.BridgeMock)
+	bridge.On("GetFedAddress").Return(fedAddress, nil).Once()
+	bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(200), nil).Once()
+	peginContract := new(mocks.PeginContractMock)
+	peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil).Once()
+	peginContract.On("GetAddress").Return(lbcAddress)
+	peginQuoteRepository := new(mocks.PeginQuoteRepositoryMock)
+	lp := new(mocks.ProviderMock)
+	lp.On("PeginConfiguration", test.AnyCtx).Return(getPeginConfiguration()).Once()
+	lp.On("GeneralConfiguration", test.AnyCtx).Return(getGeneralConfiguration()).Once()
+	lp.On("RskAddress").Return("0x4b5b6b")
+	lp.On("BtcAddress").Return(getPeginTestBtcAddress)
+	btc := new(mocks.BtcRpcMock)
+	btc.On("NetworkName").Return(testnetNetworkName).Once()
+	contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
+	rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
+	useCase := pegin.NewEstimateQuoteUseCase(pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp, nil, nil))
+
+	result, err := useCase.Run(context.Background(), pegin.NewQuoteRequest(getPeginTestUserAddress, quoteData, quoteValue, getPeginTestUserAddress))
+	require.NoError(t, err)
+	assert.Equal(t, quoteValue, result.Value)
+	assert.Equal(t, entities.NewWei(10000), result.GasFee)
+	assert.Equal(t, fedAddress, result.FedBtcAddress)
+	assert.NotNil(t, result.CallFee)
+	assert.NotNil(t, result.PenaltyFee)
+
+	peginContract.AssertNotCalled(t, "HashPeginQuote", mock.Anything)
+	peginQuoteRepository.AssertNotCalled(t, "InsertQuote", mock.Anything, mock.Anything)
+	rsk.AssertExpectations(t)
+	bridge.AssertExpectations(t)
+	peginContract.AssertExpectations(t)
+	lp.AssertExpectations(t)
+	btc.AssertExpectations(t)
+}
+
+func TestEstimateQuoteUseCase_Run_ErrorHandling(t *testing.T) {
+	t.Run("should return error if the protocol is paused", func(t *testing.T) {
+		peginContract := new(mocks.PeginContractMock)
+		peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: true, Since: 5, Reason: "test"}, nil).Once()
+		peginContract.EXPECT().GetAddress().Return("test-contract")
+		useCase := pegin.NewEstimateQuoteUseCase(pegin.NewGetQuoteUseCase(blockchain.Rpc{}, blockchain.RskContracts{PegIn: peginContract}, nil, nil, nil, nil, nil))
+		result, err := useCase.Run(context.Background(), pegin.NewQuoteRequest(getPeginTestUserAddress, []byte{}, entities.NewWei(1), getPeginTestUserAddress))
+		require.ErrorIs(t, err, blockchain.ContractPausedError)
+		assert.Equal(t, quote.PeginQuote{}, result)
+	})
+	t.Run("should return error if the request is invalid", func(t *testing.T) {
+		peginContract := new(mocks.PeginContractMock)
+		peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil).Once()
+		lp := new(mocks.ProviderMock)
+		lp.On("PeginConfiguration", test.AnyCtx).Return(getPeginConfiguration()).Once()
+		useCase := pegin.NewEstimateQuoteUseCase(pegin.NewGetQuoteUseCase(blockchain.Rpc{}, blockchain.RskContracts{PegIn: peginContract}, nil, lp, lp, nil, nil))
+		result, err := useCase.Run(context.Background(), pegin.NewQuoteRequest("invalid", []byte{}, entities.NewWei(5000), getPeginTestUserAddress))
+		require.ErrorIs(t, err, usecases.RskAddressNotSupportedError)
+		assert.Equal(t, quote.PeginQuote{}, result)
+	})
+}
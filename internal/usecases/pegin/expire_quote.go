@@ -2,16 +2,18 @@ package pegin
 
 import (
 	"context"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
 )
 
 type ExpiredPeginQuoteUseCase struct {
 	peginRepository quote.PeginQuoteRepository
+	eventBus        entities.EventBus
 }
 
-func NewExpiredPeginQuoteUseCase(peginRepository quote.PeginQuoteRepository) *ExpiredPeginQuoteUseCase {
-	return &ExpiredPeginQuoteUseCase{peginRepository: peginRepository}
+func NewExpiredPeginQuoteUseCase(peginRepository quote.PeginQuoteRepository, eventBus entities.EventBus) *ExpiredPeginQuoteUseCase {
+	return &ExpiredPeginQuoteUseCase{peginRepository: peginRepository, eventBus: eventBus}
 }
 
 func (useCase *ExpiredPeginQuoteUseCase) Run(ctx context.Context, peginQuote quote.RetainedPeginQuote) error {
@@ -20,5 +22,9 @@ func (useCase *ExpiredPeginQuoteUseCase) Run(ctx context.Context, peginQuote quo
 	if err != nil {
 		return usecases.WrapUseCaseError(usecases.ExpiredPeginQuoteId, err)
 	}
+	useCase.eventBus.Publish(quote.PeginStateUpdatedEvent{
+		Event:         entities.NewBaseEvent(quote.PeginStateUpdatedEventId),
+		RetainedQuote: peginQuote,
+	})
 	return nil
 }
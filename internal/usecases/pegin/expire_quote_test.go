@@ -25,9 +25,14 @@ func TestExpiredPeginQuoteUseCase_Run(t *testing.T) {
 	expectedRetainedQuote.State = quote.PeginStateTimeForDepositElapsed
 	peginQuoteRepository := new(mocks.PeginQuoteRepositoryMock)
 	peginQuoteRepository.On("UpdateRetainedQuote", mock.AnythingOfType("context.backgroundCtx"), expectedRetainedQuote).Return(nil)
-	useCase := pegin.NewExpiredPeginQuoteUseCase(peginQuoteRepository)
+	eventBus := new(mocks.EventBusMock)
+	eventBus.On("Publish", mock.MatchedBy(func(event quote.PeginStateUpdatedEvent) bool {
+		return assert.Equal(t, expectedRetainedQuote, event.RetainedQuote) && assert.Equal(t, quote.PeginStateUpdatedEventId, event.Id())
+	})).Return().Once()
+	useCase := pegin.NewExpiredPeginQuoteUseCase(peginQuoteRepository, eventBus)
 	err := useCase.Run(context.Background(), retainedQuote)
 	peginQuoteRepository.AssertExpectations(t)
+	eventBus.AssertExpectations(t)
 	require.NoError(t, err)
 }
 
@@ -41,8 +46,10 @@ func TestExpiredPeginQuoteUseCase_Run_ErrorHandling(t *testing.T) {
 	}
 	peginQuoteRepository := new(mocks.PeginQuoteRepositoryMock)
 	peginQuoteRepository.On("UpdateRetainedQuote", mock.AnythingOfType("context.backgroundCtx"), mock.Anything).Return(assert.AnError)
-	useCase := pegin.NewExpiredPeginQuoteUseCase(peginQuoteRepository)
+	eventBus := new(mocks.EventBusMock)
+	useCase := pegin.NewExpiredPeginQuoteUseCase(peginQuoteRepository, eventBus)
 	err := useCase.Run(context.Background(), retainedQuote)
 	peginQuoteRepository.AssertExpectations(t)
+	eventBus.AssertNotCalled(t, "Publish", mock.Anything)
 	require.Error(t, err)
 }
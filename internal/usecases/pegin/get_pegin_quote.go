@@ -11,15 +11,18 @@ import (
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
 	"strconv"
+	"strings"
 	"time"
 )
 
 type GetQuoteUseCase struct {
-	rpc                  blockchain.Rpc
-	contracts            blockchain.RskContracts
-	peginQuoteRepository quote.PeginQuoteRepository
-	lp                   liquidity_provider.LiquidityProvider
-	peginLp              liquidity_provider.PeginLiquidityProvider
+	rpc                      blockchain.Rpc
+	contracts                blockchain.RskContracts
+	peginQuoteRepository     quote.PeginQuoteRepository
+	lp                       liquidity_provider.LiquidityProvider
+	peginLp                  liquidity_provider.PeginLiquidityProvider
+	trustedAccountRepository liquidity_provider.TrustedAccountRepository
+	hashFunction             entities.HashFunction
 }
 
 func NewGetQuoteUseCase(
@@ -28,13 +31,17 @@ func NewGetQuoteUseCase(
 	peginQuoteRepository quote.PeginQuoteRepository,
 	lp liquidity_provider.LiquidityProvider,
 	peginLp liquidity_provider.PeginLiquidityProvider,
+	trustedAccountRepository liquidity_provider.TrustedAccountRepository,
+	hashFunction entities.HashFunction,
 ) *GetQuoteUseCase {
 	return &GetQuoteUseCase{
-		rpc:                  rpc,
-		contracts:            contracts,
-		peginQuoteRepository: peginQuoteRepository,
-		lp:                   lp,
-		peginLp:              peginLp,
+		rpc:                      rpc,
+		contracts:                contracts,
+		peginQuoteRepository:     peginQuoteRepository,
+		lp:                       lp,
+		peginLp:                  peginLp,
+		trustedAccountRepository: trustedAccountRepository,
+		hashFunction:             hashFunction,
 	}
 }
 
@@ -43,6 +50,7 @@ type QuoteRequest struct {
 	callContractArguments    []byte
 	valueToTransfer          *entities.Wei
 	rskRefundAddress         string
+	partnerAuth              *usecases.PartnerAuthentication
 }
 
 func NewQuoteRequest(
@@ -59,39 +67,87 @@ func NewQuoteRequest(
 	}
 }
 
+// WithPartnerAuthentication returns a copy of the request authenticated by a trusted account, so the quote
+// is created with the terms of that account
+func (request QuoteRequest) WithPartnerAuthentication(auth usecases.PartnerAuthentication) QuoteRequest {
+	request.partnerAuth = &auth
+	return request
+}
+
 type GetPeginQuoteResult struct {
 	PeginQuote quote.PeginQuote
 	Hash       string
 }
 
+// quoteBatch keeps the values that are shared by all the quotes created in the same request, so they are
+// fetched only once per batch
+type quoteBatch struct {
+	configuration  liquidity_provider.PeginConfiguration
+	feeConditions  *liquidity_provider.FeeConditions
+	fedAddress     string
+	gasPrice       *entities.Wei
+	gasEstimations map[string]*entities.Wei
+}
+
 func (useCase *GetQuoteUseCase) Run(ctx context.Context, request QuoteRequest) (GetPeginQuoteResult, error) {
+	batch, err := useCase.newQuoteBatch(ctx, request.partnerAuth)
+	if err != nil {
+		return GetPeginQuoteResult{}, usecases.WrapUseCaseError(usecases.GetPeginQuoteId, err)
+	}
+	return useCase.runInBatch(ctx, batch, request)
+}
+
+func (useCase *GetQuoteUseCase) newQuoteBatch(ctx context.Context, partnerAuth *usecases.PartnerAuthentication) (*quoteBatch, error) {
+	var err error
+	if err = usecases.CheckPauseState(useCase.contracts.PegIn); err != nil {
+		return nil, err
+	}
+	configuration := useCase.peginLp.PeginConfiguration(ctx)
+	if partnerAuth != nil {
+		if configuration, err = useCase.applyPartnerTerms(ctx, configuration, *partnerAuth); err != nil {
+			return nil, err
+		}
+	}
+	return &quoteBatch{configuration: configuration, gasEstimations: make(map[string]*entities.Wei)}, nil
+}
+
+func (useCase *GetQuoteUseCase) runInBatch(ctx context.Context, batch *quoteBatch, request QuoteRequest) (GetPeginQuoteResult, error) {
+	peginQuote, creationData, err := useCase.buildQuote(ctx, batch, request)
+	if err != nil {
+		return GetPeginQuoteResult{}, err
+	}
+	return useCase.storeResult(ctx, peginQuote, creationData)
+}
+
+// buildQuote computes the quote for a request without storing it
+func (useCase *GetQuoteUseCase) buildQuote(ctx context.Context, batch *quoteBatch, request QuoteRequest) (quote.PeginQuote, quote.PeginCreationData, error) {
 	var peginQuote quote.PeginQuote
 	var creationData quote.PeginCreationData
 	var fedAddress string
 	var errorArgs usecases.ErrorArgs
 	var err error
 	var estimatedCallGas *entities.Wei
+	var feeConditions liquidity_provider.FeeConditions
 
-	if err = usecases.CheckPauseState(useCase.contracts.PegIn); err != nil {
-		return GetPeginQuoteResult{}, usecases.WrapUseCaseError(usecases.GetPeginQuoteId, err)
+	if errorArgs, err = useCase.validateRequest(batch.configuration, request); err != nil {
+		return quote.PeginQuote{}, quote.PeginCreationData{}, usecases.WrapUseCaseErrorArgs(usecases.GetPeginQuoteId, err, errorArgs)
 	}
 
-	peginConfiguration := useCase.peginLp.PeginConfiguration(ctx)
-	if errorArgs, err = useCase.validateRequest(peginConfiguration, request); err != nil {
-		return GetPeginQuoteResult{}, usecases.WrapUseCaseErrorArgs(usecases.GetPeginQuoteId, err, errorArgs)
+	if estimatedCallGas, err = useCase.estimateCallGas(ctx, batch, request); err != nil {
+		return quote.PeginQuote{}, quote.PeginCreationData{}, usecases.WrapUseCaseError(usecases.GetPeginQuoteId, err)
 	}
 
-	estimatedCallGas, err = useCase.rpc.Rsk.EstimateGas(ctx, request.callEoaOrContractAddress, request.valueToTransfer, request.callContractArguments)
-	if err != nil {
-		return GetPeginQuoteResult{}, usecases.WrapUseCaseError(usecases.GetPeginQuoteId, err)
+	if fedAddress, err = useCase.getFederationAddress(batch); err != nil {
+		return quote.PeginQuote{}, quote.PeginCreationData{}, usecases.WrapUseCaseError(usecases.GetPeginQuoteId, err)
 	}
 
-	if fedAddress, err = useCase.getFederationAddress(); err != nil {
-		return GetPeginQuoteResult{}, usecases.WrapUseCaseError(usecases.GetPeginQuoteId, err)
+	if feeConditions, err = useCase.getFeeConditions(ctx, batch); err != nil {
+		return quote.PeginQuote{}, quote.PeginCreationData{}, usecases.WrapUseCaseError(usecases.GetPeginQuoteId, err)
 	}
+	peginConfiguration := batch.configuration.ForQuote(request.valueToTransfer, feeConditions)
 
-	if creationData, err = useCase.buildCreationData(ctx, peginConfiguration); err != nil {
-		return GetPeginQuoteResult{}, err
+	if creationData, err = useCase.buildCreationData(ctx, batch, peginConfiguration); err != nil {
+		return quote.PeginQuote{}, quote.PeginCreationData{}, err
 	}
 
 	generalConfiguration := useCase.lp.GeneralConfiguration(ctx)
@@ -101,14 +157,53 @@ func (useCase *GetQuoteUseCase) Run(ctx context.Context, request QuoteRequest) (
 		PenaltyFee: peginConfiguration.PenaltyFee,
 	}
 	if peginQuote, err = useCase.buildPeginQuote(ctx, generalConfiguration, peginConfiguration, request, fedAddress, estimatedCallGas, fees); err != nil {
-		return GetPeginQuoteResult{}, err
+		return quote.PeginQuote{}, quote.PeginCreationData{}, err
 	}
 
 	if err = usecases.ValidateMinLockValue(usecases.GetPeginQuoteId, useCase.contracts.Bridge, peginQuote.Value); err != nil {
-		return GetPeginQuoteResult{}, err
+		return quote.PeginQuote{}, quote.PeginCreationData{}, err
 	}
 
-	return useCase.storeResult(ctx, peginQuote, creationData)
+	return peginQuote, creationData, nil
+}
+
+// estimateCallGas estimates the gas of the call to the user, requests with the same destination, value and data
+// in the same batch share the estimation
+func (useCase *GetQuoteUseCase) estimateCallGas(ctx context.Context, batch *quoteBatch, request QuoteRequest) (*entities.Wei, error) {
+	key := strings.ToLower(request.callEoaOrContractAddress) + ":" + request.valueToTransfer.String() + ":" + hex.EncodeToString(request.callContractArguments)
+	if estimation, ok := batch.gasEstimations[key]; ok {
+		return estimation, nil
+	}
+	estimation, err := useCase.rpc.Rsk.EstimateGas(ctx, request.callEoaOrContractAddress, request.valueToTransfer, request.callContractArguments)
+	if err != nil {
+		return nil, err
+	}
+	batch.gasEstimations[key] = estimation
+	return estimation, nil
+}
+
+func (useCase *GetQuoteUseCase) getFeeConditions(ctx context.Context, batch *quoteBatch) (liquidity_provider.FeeConditions, error) {
+	if batch.feeConditions != nil {
+		return *batch.feeConditions, nil
+	}
+	feeConditions, err := usecases.GetFeeConditions(ctx, batch.configuration.FeeSurcharges, useCase.peginLp.AvailablePeginLiquidity)
+	if err != nil {
+		return liquidity_provider.FeeConditions{}, err
+	}
+	batch.feeConditions = &feeConditions
+	return feeConditions, nil
+}
+
+func (useCase *GetQuoteUseCase) applyPartnerTerms(
+	ctx context.Context,
+	configuration liquidity_provider.PeginConfiguration,
+	auth usecases.PartnerAuthentication,
+) (liquidity_provider.PeginConfiguration, error) {
+	partner, err := usecases.GetPartnerAccount(ctx, auth, useCase.lp.GetSigner(), useCase.hashFunction, useCase.trustedAccountRepository)
+	if err != nil {
+		return liquidity_provider.PeginConfiguration{}, err
+	}
+	return configuration.WithPartnerTerms(partner.PeginTerms), nil
 }
 
 func (useCase *GetQuoteUseCase) storeResult(
@@ -208,27 +303,34 @@ func (useCase *GetQuoteUseCase) buildPeginQuote(
 	return peginQuote, nil
 }
 
-func (useCase *GetQuoteUseCase) getFederationAddress() (string, error) {
+func (useCase *GetQuoteUseCase) getFederationAddress(batch *quoteBatch) (string, error) {
 	var fedAddress string
 	var err error
+	if batch.fedAddress != "" {
+		return batch.fedAddress, nil
+	}
 	if fedAddress, err = useCase.contracts.Bridge.GetFedAddress(); err != nil {
 		return "", err
 	} else if !blockchain.IsBtcP2SHAddress(fedAddress) {
 		return "", errors.New("only P2SH addresses are supported for federation address")
 	}
+	batch.fedAddress = fedAddress
 	return fedAddress, nil
 }
 
 func (useCase *GetQuoteUseCase) buildCreationData(
 	ctx context.Context,
+	batch *quoteBatch,
 	configuration liquidity_provider.PeginConfiguration,
 ) (quote.PeginCreationData, error) {
-	var gasPrice *entities.Wei
 	var err error
 
-	if gasPrice, err = useCase.rpc.Rsk.GasPrice(ctx); err != nil {
-		return quote.PeginCreationData{}, usecases.WrapUseCaseError(usecases.GetPeginQuoteId, err)
+	if batch.gasPrice == nil {
+		if batch.gasPrice, err = useCase.rpc.Rsk.QuoteGasPrice(ctx); err != nil {
+			return quote.PeginCreationData{}, usecases.WrapUseCaseError(usecases.GetPeginQuoteId, err)
+		}
 	}
+	gasPrice := batch.gasPrice
 	creationData := quote.PeginCreationData{
 		GasPrice:      gasPrice,
 		FeePercentage: configuration.FeePercentage,
@@ -3,8 +3,11 @@ package pegin_test
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
 	lpEntity "github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
@@ -40,7 +43,7 @@ func TestGetQuoteUseCase_Run_Paused(t *testing.T) {
 	peginContract.EXPECT().GetAddress().Return("test-contract")
 	contracts := blockchain.RskContracts{Bridge: bridge, PegIn: peginContract}
 	rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
-	useCase := pegin.NewGetQuoteUseCase(rpc, contracts, quoteRepository, lp, lp)
+	useCase := pegin.NewGetQuoteUseCase(rpc, contracts, quoteRepository, lp, lp, nil, nil)
 	result, err := useCase.Run(context.Background(), request)
 	assert.Empty(t, result)
 	require.ErrorIs(t, err, blockchain.ContractPausedError)
@@ -69,7 +72,7 @@ func TestGetQuoteUseCase_Run(t *testing.T) {
 
 	rsk := new(mocks.RootstockRpcServerMock)
 	rsk.On("EstimateGas", mock.Anything, userRskAddress, quoteValue, quoteData).Return(gasLimit, nil).Once()
-	rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(100), nil).Once()
+	rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(100), nil).Once()
 	rsk.EXPECT().ChainId(mock.Anything).Return(31, nil).Once()
 	peginContract := new(mocks.PeginContractMock)
 	bridge := new(mocks.BridgeMock)
@@ -93,7 +96,7 @@ func TestGetQuoteUseCase_Run(t *testing.T) {
 	btc.On("NetworkName").Return(testnetNetworkName).Once()
 	contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
 	rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
-	useCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp)
+	useCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp, nil, nil)
 	result, err := useCase.Run(context.Background(), request)
 
 	rsk.AssertExpectations(t)
@@ -122,7 +125,7 @@ func TestGetQuoteUseCase_Run_ValidateRequest(t *testing.T) {
 		btc := new(mocks.BtcRpcMock)
 		contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
 		rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
-		useCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp)
+		useCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp, nil, nil)
 		result, err := useCase.Run(context.Background(), testCase.Value(btc))
 		assert.Equal(t, pegin.GetPeginQuoteResult{}, result)
 		require.Error(t, err)
@@ -133,7 +136,7 @@ func TestGetQuoteUseCase_Run_ValidateRequest(t *testing.T) {
 func TestGetQuoteUseCase_Run_ValidateFedAddress(t *testing.T) {
 	rsk := new(mocks.RootstockRpcServerMock)
 	rsk.On("EstimateGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entities.NewWei(100), nil)
-	rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(100), nil)
+	rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(100), nil)
 	lp := new(mocks.ProviderMock)
 	lp.On("PeginConfiguration", test.AnyCtx).Return(getPeginConfiguration())
 	lp.On("GeneralConfiguration", test.AnyCtx).Return(getGeneralConfiguration())
@@ -146,7 +149,7 @@ func TestGetQuoteUseCase_Run_ValidateFedAddress(t *testing.T) {
 	btc.On("ValidateAddress", mock.Anything).Return(nil)
 	contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
 	rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
-	useCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp)
+	useCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp, nil, nil)
 	result, err := useCase.Run(context.Background(), pegin.NewQuoteRequest(getPeginTestUserAddress, []byte{1}, entities.NewWei(5000), getPeginTestUserAddress))
 	assert.Empty(t, result)
 	require.ErrorContains(t, err, "only P2SH addresses are supported for federation address")
@@ -212,10 +215,10 @@ func TestGetQuoteUseCase_Run_BridgeMinimum(t *testing.T) {
 	lp.On("RskAddress").Return(test.AnyAddress).Once()
 	lp.On("BtcAddress").Return(test.AnyAddress).Once()
 	rsk.EXPECT().EstimateGas(test.AnyCtx, mock.Anything, mock.Anything, mock.Anything).Return(entities.NewWei(100), nil).Once()
-	rsk.EXPECT().GasPrice(test.AnyCtx).Return(entities.NewWei(10), nil).Once()
+	rsk.EXPECT().QuoteGasPrice(test.AnyCtx).Return(entities.NewWei(10), nil).Once()
 	rsk.EXPECT().ChainId(mock.Anything).Return(31, nil).Once()
 	bridge.On("GetFedAddress").Return(fedAddress, nil).Once()
-	useCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp)
+	useCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp, nil, nil)
 	t.Run("Should compare bridge minimum against quote value", func(t *testing.T) {
 		// we compare 1999 of the quote value with the 2000 of the minimum, so the total is higher than the minimum due to the fees
 		quoteValue := entities.NewWei(1999)
@@ -251,7 +254,7 @@ func TestGetQuoteUseCase_Run_ErrorHandling(t *testing.T) {
 		setup(rsk, bridge, peginContract, lp, peginQuoteRepository)
 		contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
 		rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
-		useCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp)
+		useCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp, nil, nil)
 		result, err := useCase.Run(context.Background(), request)
 		rsk.AssertExpectations(t)
 		bridge.AssertExpectations(t)
@@ -283,12 +286,12 @@ func getQuoteUseCaseUnexpectedErrorSetups() []func(
 			lp.On("PeginConfiguration", test.AnyCtx).Return(getPeginConfiguration())
 			rsk.On("EstimateGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entities.NewWei(100), nil)
 			bridge.On("GetFedAddress").Return(fedAddress, nil)
-			rsk.On("GasPrice", test.AnyCtx).Return(nil, assert.AnError)
+			rsk.On("QuoteGasPrice", test.AnyCtx).Return(nil, assert.AnError)
 		},
 		func(rsk *mocks.RootstockRpcServerMock, bridge *mocks.BridgeMock,
 			peginContract *mocks.PeginContractMock, lp *mocks.ProviderMock, peginQuoteRepository *mocks.PeginQuoteRepositoryMock) {
 			rsk.On("EstimateGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entities.NewWei(100), nil)
-			rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(10), nil)
+			rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(10), nil)
 			bridge.On("GetFedAddress").Return(fedAddress, nil)
 			bridge.On("GetMinimumLockTxValue").Return(nil, assert.AnError)
 			peginContract.On("GetAddress").Return(lbcAddress)
@@ -301,7 +304,7 @@ func getQuoteUseCaseUnexpectedErrorSetups() []func(
 		func(rsk *mocks.RootstockRpcServerMock, bridge *mocks.BridgeMock,
 			peginContract *mocks.PeginContractMock, lp *mocks.ProviderMock, peginQuoteRepository *mocks.PeginQuoteRepositoryMock) {
 			rsk.On("EstimateGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entities.NewWei(100), nil)
-			rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(10), nil)
+			rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(10), nil)
 			bridge.On("GetFedAddress").Return(fedAddress, nil)
 			lp.On("PeginConfiguration", test.AnyCtx).Return(getPeginConfiguration())
 			lp.On("GeneralConfiguration", test.AnyCtx).Return(getGeneralConfiguration())
@@ -310,7 +313,7 @@ func getQuoteUseCaseUnexpectedErrorSetups() []func(
 		func(rsk *mocks.RootstockRpcServerMock, bridge *mocks.BridgeMock,
 			peginContract *mocks.PeginContractMock, lp *mocks.ProviderMock, peginQuoteRepository *mocks.PeginQuoteRepositoryMock) {
 			rsk.On("EstimateGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entities.NewWei(100), nil)
-			rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(10), nil)
+			rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(10), nil)
 			bridge.On("GetFedAddress").Return(fedAddress, nil)
 			bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(200), nil)
 			peginContract.On("HashPeginQuote", mock.Anything).Return("", assert.AnError)
@@ -324,7 +327,7 @@ func getQuoteUseCaseUnexpectedErrorSetups() []func(
 		func(rsk *mocks.RootstockRpcServerMock, bridge *mocks.BridgeMock,
 			peginContract *mocks.PeginContractMock, lp *mocks.ProviderMock, peginQuoteRepository *mocks.PeginQuoteRepositoryMock) {
 			rsk.On("EstimateGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entities.NewWei(100), nil)
-			rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(10), nil)
+			rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(10), nil)
 			bridge.On("GetFedAddress").Return(fedAddress, nil)
 			bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(200), nil)
 			peginContract.On("HashPeginQuote", mock.Anything).Return("any hash", nil)
@@ -339,7 +342,7 @@ func getQuoteUseCaseUnexpectedErrorSetups() []func(
 		func(rsk *mocks.RootstockRpcServerMock, bridge *mocks.BridgeMock,
 			peginContract *mocks.PeginContractMock, lp *mocks.ProviderMock, peginQuoteRepository *mocks.PeginQuoteRepositoryMock) {
 			rsk.On("EstimateGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entities.NewWei(100), nil)
-			rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(10), nil)
+			rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(10), nil)
 			bridge.On("GetFedAddress").Return(fedAddress, nil)
 			peginContract.On("GetAddress").Return("")
 			peginConfig := getPeginConfiguration()
@@ -389,7 +392,7 @@ func TestGetQuoteUseCase_Run_RefundAddress(t *testing.T) {
 
 	rsk := new(mocks.RootstockRpcServerMock)
 	rsk.On("EstimateGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entities.NewWei(100), nil).Twice()
-	rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(100), nil).Twice()
+	rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(100), nil).Twice()
 	rsk.EXPECT().ChainId(mock.Anything).Return(31, nil).Twice()
 	peginContract := new(mocks.PeginContractMock)
 	bridge := new(mocks.BridgeMock)
@@ -411,7 +414,7 @@ func TestGetQuoteUseCase_Run_RefundAddress(t *testing.T) {
 		btc.On("NetworkName").Return(mainnetNetworkName).Once()
 		contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
 		rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
-		useCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp)
+		useCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp, nil, nil)
 		result, err := useCase.Run(context.Background(), request)
 		btc.AssertExpectations(t)
 		require.NoError(t, err)
@@ -422,7 +425,7 @@ func TestGetQuoteUseCase_Run_RefundAddress(t *testing.T) {
 		btc.On("NetworkName").Return(testnetNetworkName).Once()
 		contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
 		rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
-		useCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp)
+		useCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp, nil, nil)
 		result, err := useCase.Run(context.Background(), request)
 		btc.AssertExpectations(t)
 		require.NoError(t, err)
@@ -435,3 +438,94 @@ func TestGetQuoteUseCase_Run_RefundAddress(t *testing.T) {
 	peginQuoteRepository.AssertExpectations(t)
 	lp.AssertExpectations(t)
 }
+
+// nolint:funlen
+func TestGetQuoteUseCase_Run_PartnerTerms(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	partnerAddress := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	partner := lpEntity.TrustedAccountDetails{
+		Address:        partnerAddress,
+		Name:           "partner",
+		BtcLockingCap:  entities.NewWei(100000),
+		RbtcLockingCap: entities.NewWei(100000),
+		PeginTerms: &lpEntity.PartnerTerms{
+			FixedFee:      entities.NewWei(10),
+			FeePercentage: utils.NewBigFloat64(0.5),
+			MaxValue:      entities.NewWei(50000),
+			QuoteValidity: 3600,
+		},
+	}
+	partnerBytes, err := json.Marshal(partner)
+	require.NoError(t, err)
+	signedPartner := &entities.Signed[lpEntity.TrustedAccountDetails]{
+		Value: partner, Hash: hex.EncodeToString(crypto.Keccak256(partnerBytes)), Signature: "01",
+	}
+	quoteValue := entities.NewWei(20000)
+	payload := []byte(`{"valueToTransfer":20000}`)
+	now := time.Now().Unix()
+	request := pegin.NewQuoteRequest(getPeginTestUserAddress, []byte{}, quoteValue, getPeginTestUserAddress).
+		WithPartnerAuthentication(usecases.PartnerAuthentication{Signature: test.SignPartnerRequest(t, key, now, payload), Timestamp: now, Payload: payload})
+
+	t.Run("should create the quote with the partner terms", func(t *testing.T) {
+		signer := &mocks.SignerMock{}
+		signer.On("Validate", signedPartner.Signature, signedPartner.Hash).Return(true).Once()
+		trustedAccountRepository := &mocks.TrustedAccountRepositoryMock{}
+		trustedAccountRepository.EXPECT().GetTrustedAccount(mock.Anything, partnerAddress).Return(signedPartner, nil).Once()
+		trustedAccountRepository.EXPECT().RegisterPartnerAuthentication(mock.Anything, mock.Anything, time.Unix(now, 0).Add(usecases.PartnerAuthenticationValidity)).Return(nil).Once()
+		rsk := new(mocks.RootstockRpcServerMock)
+		rsk.On("EstimateGas", mock.Anything, getPeginTestUserAddress, quoteValue, []byte{}).Return(entities.NewWei(100), nil).Once()
+		rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(100), nil).Once()
+		rsk.EXPECT().ChainId(mock.Anything).Return(31, nil).Once()
+		bridge := new(mocks.BridgeMock)
+		bridge.On("GetFedAddress").Return(fedAddress, nil).Once()
+		bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(200), nil).Once()
+		peginContract := new(mocks.PeginContractMock)
+		peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil).Once()
+		peginContract.On("GetAddress").Return(lbcAddress)
+		peginContract.On("HashPeginQuote", mock.MatchedBy(func(q quote.PeginQuote) bool {
+			return q.CallFee.Cmp(entities.NewWei(110)) == 0 && q.TimeForDeposit == 3600 && q.Value.Cmp(quoteValue) == 0
+		})).Return("0x1234", nil).Once()
+		peginQuoteRepository := new(mocks.PeginQuoteRepositoryMock)
+		peginQuoteRepository.On("InsertQuote", test.AnyCtx, mock.MatchedBy(func(createdPeginQuote quote.CreatedPeginQuote) bool {
+			return createdPeginQuote.CreationData.FixedFee.Cmp(entities.NewWei(10)) == 0 &&
+				createdPeginQuote.CreationData.FeePercentage.Native().Cmp(utils.NewBigFloat64(0.5).Native()) == 0
+		})).Return(nil).Once()
+		lp := new(mocks.ProviderMock)
+		lp.On("GetSigner").Return(signer).Once()
+		lp.On("PeginConfiguration", test.AnyCtx).Return(getPeginConfiguration()).Once()
+		lp.On("GeneralConfiguration", test.AnyCtx).Return(getGeneralConfiguration()).Once()
+		lp.On("RskAddress").Return("0x4b5b6b")
+		lp.On("BtcAddress").Return(getPeginTestBtcAddress)
+		btc := new(mocks.BtcRpcMock)
+		btc.On("NetworkName").Return(testnetNetworkName).Once()
+		contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
+		useCase := pegin.NewGetQuoteUseCase(blockchain.Rpc{Rsk: rsk, Btc: btc}, contracts, peginQuoteRepository, lp, lp, trustedAccountRepository, crypto.Keccak256)
+
+		result, err := useCase.Run(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, "0x1234", result.Hash)
+		trustedAccountRepository.AssertExpectations(t)
+		peginContract.AssertExpectations(t)
+		peginQuoteRepository.AssertExpectations(t)
+		lp.AssertExpectations(t)
+		signer.AssertExpectations(t)
+	})
+	t.Run("should fail if the partner authentication is not valid", func(t *testing.T) {
+		trustedAccountRepository := &mocks.TrustedAccountRepositoryMock{}
+		trustedAccountRepository.EXPECT().GetTrustedAccount(mock.Anything, partnerAddress).Return(nil, lpEntity.TrustedAccountNotFoundError).Once()
+		peginContract := new(mocks.PeginContractMock)
+		peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil).Once()
+		lp := new(mocks.ProviderMock)
+		lp.On("GetSigner").Return(&mocks.SignerMock{}).Once()
+		lp.On("PeginConfiguration", test.AnyCtx).Return(getPeginConfiguration()).Once()
+		contracts := blockchain.RskContracts{PegIn: peginContract}
+		useCase := pegin.NewGetQuoteUseCase(blockchain.Rpc{}, contracts, new(mocks.PeginQuoteRepositoryMock), lp, lp, trustedAccountRepository, crypto.Keccak256)
+
+		result, err := useCase.Run(context.Background(), request)
+		require.ErrorIs(t, err, usecases.InvalidPartnerAuthError)
+		assert.Empty(t, result)
+		trustedAccountRepository.AssertExpectations(t)
+		lp.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,97 @@
+package pegin_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegin"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestGetQuotesUseCase_Run(t *testing.T) {
+	quoteValue := entities.NewWei(5000)
+	quoteData := []byte{1}
+	rsk := new(mocks.RootstockRpcServerMock)
+	rsk.On("EstimateGas", mock.Anything, getPeginTestUserAddress, quoteValue, quoteData).Return(entities.NewWei(100), nil).Once()
+	rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(100), nil).Once()
+	rsk.EXPECT().ChainId(mock.Anything).Return(31, nil).Twice()
+	bridge := new(mocks.BridgeMock)
+	bridge.On("GetFedAddress").Return(fedAddress, nil).Once()
+	bridge.On("GetMinimumLockTxValue").Return(entities.NewWei(200), nil).Twice()
+	peginContract := new(mocks.PeginContractMock)
+	peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil).Once()
+	peginContract.On("GetAddress").Return(lbcAddress)
+	peginContract.On("HashPeginQuote", mock.Anything).Return("0x9876543210", nil).Twice()
+	peginQuoteRepository := new(mocks.PeginQuoteRepositoryMock)
+	peginQuoteRepository.On("InsertQuote", test.AnyCtx, mock.AnythingOfType("quote.CreatedPeginQuote")).Return(nil).Twice()
+	lp := new(mocks.ProviderMock)
+	lp.On("PeginConfiguration", test.AnyCtx).Return(getPeginConfiguration()).Once()
+	lp.On("GeneralConfiguration", test.AnyCtx).Return(getGeneralConfiguration()).Twice()
+	lp.On("RskAddress").Return("0x4b5b6b")
+	lp.On("BtcAddress").Return(getPeginTestBtcAddress)
+	btc := new(mocks.BtcRpcMock)
+	btc.On("NetworkName").Return(testnetNetworkName).Twice()
+	contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
+	rpc := blockchain.Rpc{Rsk: rsk, Btc: btc}
+	useCase := pegin.NewGetQuotesUseCase(pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp, nil, nil), 3)
+
+	request := pegin.NewBatchQuoteRequest([]pegin.QuoteRequest{
+		pegin.NewQuoteRequest(getPeginTestUserAddress, quoteData, quoteValue, getPeginTestUserAddress),
+		pegin.NewQuoteRequest("invalid", quoteData, quoteValue, getPeginTestUserAddress),
+		pegin.NewQuoteRequest(getPeginTestUserAddress, quoteData, quoteValue, getPeginTestUserAddress),
+	})
+	results, err := useCase.Run(context.Background(), request)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.NoError(t, results[0].Error)
+	assert.Equal(t, "0x9876543210", results[0].Result.Hash)
+	assert.Equal(t, fedAddress, results[0].Result.PeginQuote.FedBtcAddress)
+	assert.Equal(t, entities.NewWei(10000), results[0].Result.PeginQuote.GasFee)
+	require.ErrorIs(t, results[1].Error, usecases.RskAddressNotSupportedError)
+	assert.Equal(t, pegin.GetPeginQuoteResult{}, results[1].Result)
+	require.NoError(t, results[2].Error)
+	assert.Equal(t, results[0].Result.PeginQuote.GasLimit, results[2].Result.PeginQuote.GasLimit)
+	assert.NotEqual(t, results[0].Result.PeginQuote.Nonce, results[2].Result.PeginQuote.Nonce)
+
+	rsk.AssertExpectations(t)
+	bridge.AssertExpectations(t)
+	peginContract.AssertExpectations(t)
+	peginQuoteRepository.AssertExpectations(t)
+	lp.AssertExpectations(t)
+	btc.AssertExpectations(t)
+}
+
+func TestGetQuotesUseCase_Run_BatchSize(t *testing.T) {
+	peginContract := new(mocks.PeginContractMock)
+	contracts := blockchain.RskContracts{PegIn: peginContract}
+	useCase := pegin.NewGetQuotesUseCase(pegin.NewGetQuoteUseCase(blockchain.Rpc{}, contracts, nil, nil, nil, nil, nil), 2)
+	request := pegin.NewQuoteRequest(getPeginTestUserAddress, []byte{}, entities.NewWei(1), getPeginTestUserAddress)
+	for _, requests := range [][]pegin.QuoteRequest{{}, {request, request, request}} {
+		results, err := useCase.Run(context.Background(), pegin.NewBatchQuoteRequest(requests))
+		require.ErrorIs(t, err, usecases.QuoteBatchSizeError)
+		assert.Nil(t, results)
+	}
+	peginContract.AssertNotCalled(t, "PausedStatus")
+}
+
+func TestGetQuotesUseCase_Run_Paused(t *testing.T) {
+	peginContract := new(mocks.PeginContractMock)
+	peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: true, Since: 5, Reason: "test"}, nil).Once()
+	peginContract.EXPECT().GetAddress().Return("test-contract")
+	contracts := blockchain.RskContracts{PegIn: peginContract}
+	quoteRepository := new(mocks.PeginQuoteRepositoryMock)
+	useCase := pegin.NewGetQuotesUseCase(pegin.NewGetQuoteUseCase(blockchain.Rpc{}, contracts, quoteRepository, nil, nil, nil, nil), 2)
+	request := pegin.NewQuoteRequest(getPeginTestUserAddress, []byte{}, entities.NewWei(1), getPeginTestUserAddress)
+	results, err := useCase.Run(context.Background(), pegin.NewBatchQuoteRequest([]pegin.QuoteRequest{request}))
+	require.ErrorIs(t, err, blockchain.ContractPausedError)
+	assert.Nil(t, results)
+	quoteRepository.AssertNotCalled(t, "InsertQuote", mock.Anything, mock.Anything)
+}
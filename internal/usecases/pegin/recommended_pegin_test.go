@@ -25,7 +25,7 @@ func TestRecommendedPeginUseCase_Run(t *testing.T) {
 	request := pegin.NewQuoteRequest(test.AnyRskAddress, data, amount, test.AnyRskAddress)
 	rsk := new(mocks.RootstockRpcServerMock)
 	rsk.EXPECT().EstimateGas(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entities.NewWei(20000), nil)
-	rsk.EXPECT().GasPrice(mock.Anything).Return(entities.NewWei(100), nil)
+	rsk.EXPECT().QuoteGasPrice(mock.Anything).Return(entities.NewWei(100), nil)
 	rsk.EXPECT().ChainId(mock.Anything).Return(31, nil)
 	peginContract := new(mocks.PeginContractMock)
 	bridge := new(mocks.BridgeMock)
@@ -48,7 +48,7 @@ func TestRecommendedPeginUseCase_Run(t *testing.T) {
 	btc.On("NetworkName").Return(testnetNetworkName)
 	contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
 	rpc := blockchain.Rpc{Btc: btc, Rsk: rsk}
-	getQuoteUseCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp)
+	getQuoteUseCase := pegin.NewGetQuoteUseCase(rpc, contracts, peginQuoteRepository, lp, lp, nil, nil)
 	createdQuote, err := getQuoteUseCase.Run(context.Background(), request)
 	require.NoError(t, err)
 	t.Run("should be consistent with get pegin quote calculation", func(t *testing.T) {
@@ -128,7 +128,7 @@ func recommendedPeginErrorSetups() []func(peginContract *mocks.PeginContractMock
 		},
 		func(peginContract *mocks.PeginContractMock, rsk *mocks.RootstockRpcServerMock) {
 			rsk.EXPECT().EstimateGas(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entities.NewWei(1), nil).Once()
-			rsk.EXPECT().GasPrice(mock.Anything).Return(nil, assert.AnError).Once()
+			rsk.EXPECT().QuoteGasPrice(mock.Anything).Return(nil, assert.AnError).Once()
 		},
 	}
 }
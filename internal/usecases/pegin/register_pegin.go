@@ -59,7 +59,7 @@ func (useCase *RegisterPeginUseCase) Run(ctx context.Context, retainedQuote quot
 		return err
 	}
 
-	if params, err = useCase.buildRegisterPeginParams(*peginQuote, retainedQuote); err != nil {
+	if params, err = useCase.buildRegisterPeginParams(ctx, *peginQuote, retainedQuote); err != nil {
 		return useCase.publishErrorEvent(ctx, retainedQuote, err, true)
 	}
 
@@ -89,9 +89,14 @@ func (useCase *RegisterPeginUseCase) publishErrorEvent(ctx context.Context, reta
 	return wrappedError
 }
 
-func (useCase *RegisterPeginUseCase) buildRegisterPeginParams(peginQuote quote.PeginQuote, retainedQuote quote.RetainedPeginQuote) (blockchain.RegisterPeginParams, error) {
+func (useCase *RegisterPeginUseCase) buildRegisterPeginParams(
+	ctx context.Context,
+	peginQuote quote.PeginQuote,
+	retainedQuote quote.RetainedPeginQuote,
+) (blockchain.RegisterPeginParams, error) {
 	var quoteSignature, rawBtcTx, pmt []byte
 	var block blockchain.BitcoinBlockInformation
+	var gasPrice *entities.Wei
 	var err error
 
 	if quoteSignature, err = hex.DecodeString(retainedQuote.Signature); err != nil {
@@ -110,12 +115,17 @@ func (useCase *RegisterPeginUseCase) buildRegisterPeginParams(peginQuote quote.P
 		return blockchain.RegisterPeginParams{}, err
 	}
 
+	if gasPrice, err = useCase.rpc.Rsk.GasPrice(ctx); err != nil {
+		return blockchain.RegisterPeginParams{}, err
+	}
+
 	return blockchain.RegisterPeginParams{
 		QuoteSignature:        quoteSignature,
 		BitcoinRawTransaction: rawBtcTx,
 		PartialMerkleTree:     pmt,
 		BlockHeight:           block.Height,
 		Quote:                 peginQuote,
+		GasPrice:              gasPrice,
 	}, nil
 }
 
@@ -31,6 +31,14 @@ var (
 	cfuTx           = "cfu tx hash"
 )
 
+const registerPeginTestGasPrice = 65164000
+
+func registerPeginTestRsk() *mocks.RootstockRpcServerMock {
+	rsk := new(mocks.RootstockRpcServerMock)
+	rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(registerPeginTestGasPrice), nil)
+	return rsk
+}
+
 func TestRegisterPeginUseCase_Run_Paused(t *testing.T) {
 	retainedPeginQuote := quote.RetainedPeginQuote{
 		QuoteHash:         "101b1c",
@@ -93,6 +101,7 @@ func TestRegisterPeginUseCase_Run(t *testing.T) {
 		PartialMerkleTree:     pmtMock,
 		BlockHeight:           btcBlockInfoMock.Height,
 		Quote:                 testPeginQuote,
+		GasPrice:              entities.NewWei(registerPeginTestGasPrice),
 	}).Return(registerPeginReceipt, nil).Once()
 	quoteRepository := new(mocks.PeginQuoteRepositoryMock)
 	quoteRepository.On("GetQuote", test.AnyCtx, retainedPeginQuote.QuoteHash).Return(&testPeginQuote, nil).Once()
@@ -120,7 +129,7 @@ func TestRegisterPeginUseCase_Run(t *testing.T) {
 	mutex.On("Unlock").Return().Once()
 
 	contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
-	rpc := blockchain.Rpc{Btc: btc}
+	rpc := blockchain.Rpc{Btc: btc, Rsk: registerPeginTestRsk()}
 	useCase := pegin.NewRegisterPeginUseCase(contracts, quoteRepository, eventBus, rpc, mutex)
 	err := useCase.Run(context.Background(), retainedPeginQuote)
 
@@ -164,7 +173,7 @@ func TestRegisterPeginUseCase_Run_DontPublishRecoverableErrors(t *testing.T) {
 		caseQuote := retainedPeginQuote
 		setup(&caseQuote, peginContract, quoteRepository, btc)
 		contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
-		rpc := blockchain.Rpc{Btc: btc}
+		rpc := blockchain.Rpc{Btc: btc, Rsk: registerPeginTestRsk()}
 		useCase := pegin.NewRegisterPeginUseCase(contracts, quoteRepository, eventBus, rpc, mutex)
 		err := useCase.Run(context.Background(), caseQuote)
 
@@ -272,7 +281,7 @@ func TestRegisterPeginUseCase_Run_QuoteNotFound(t *testing.T) {
 	mutex := new(mocks.MutexMock)
 
 	contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
-	rpc := blockchain.Rpc{Btc: btc}
+	rpc := blockchain.Rpc{Btc: btc, Rsk: registerPeginTestRsk()}
 	useCase := pegin.NewRegisterPeginUseCase(contracts, quoteRepository, eventBus, rpc, mutex)
 
 	err := useCase.Run(context.Background(), retainedPeginQuote)
@@ -327,6 +336,7 @@ func TestRegisterPeginUseCase_Run_RegisterPeginFailed(t *testing.T) {
 		PartialMerkleTree:     pmtMock,
 		BlockHeight:           btcBlockInfoMock.Height,
 		Quote:                 testPeginQuote,
+		GasPrice:              entities.NewWei(registerPeginTestGasPrice),
 	}).Return(registerPeginReceipt, assert.AnError).Once()
 
 	quoteRepository := new(mocks.PeginQuoteRepositoryMock)
@@ -356,7 +366,7 @@ func TestRegisterPeginUseCase_Run_RegisterPeginFailed(t *testing.T) {
 	mutex.On("Unlock").Return().Once()
 
 	contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
-	rpc := blockchain.Rpc{Btc: btc}
+	rpc := blockchain.Rpc{Btc: btc, Rsk: registerPeginTestRsk()}
 	useCase := pegin.NewRegisterPeginUseCase(contracts, quoteRepository, eventBus, rpc, mutex)
 	err := useCase.Run(context.Background(), retainedPeginQuote)
 	require.Error(t, err)
@@ -396,7 +406,7 @@ func TestRegisterPeginUseCase_Run_NotEnoughConfirmations(t *testing.T) {
 
 			testCase.setup(peginContract, quoteRepository, btc)
 			contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
-			rpc := blockchain.Rpc{Btc: btc}
+			rpc := blockchain.Rpc{Btc: btc, Rsk: registerPeginTestRsk()}
 			useCase := pegin.NewRegisterPeginUseCase(contracts, quoteRepository, eventBus, rpc, mutex)
 			err := useCase.Run(context.Background(), retainedPeginQuote)
 
@@ -451,6 +461,7 @@ func registerPeginNotEnoughConfirmationsSetups(retainedPeginQuote quote.Retained
 					PartialMerkleTree:     pmtMock,
 					BlockHeight:           btcBlockInfoMock.Height,
 					Quote:                 testPeginQuote,
+					GasPrice:              entities.NewWei(registerPeginTestGasPrice),
 				}).Return(blockchain.TransactionReceipt{}, fmt.Errorf("some wrapper: %w", blockchain.WaitingForBridgeError)).Once()
 			},
 			err: blockchain.WaitingForBridgeError,
@@ -508,6 +519,7 @@ func TestRegisterPeginUseCase_Run_UpdateError(t *testing.T) {
 		PartialMerkleTree:     pmtMock,
 		BlockHeight:           btcBlockInfoMock.Height,
 		Quote:                 testPeginQuote,
+		GasPrice:              entities.NewWei(registerPeginTestGasPrice),
 	}).Return(registerPeginReceipt, nil)
 
 	for _, setup := range setups {
@@ -516,7 +528,7 @@ func TestRegisterPeginUseCase_Run_UpdateError(t *testing.T) {
 
 		setup(quoteRepository, eventBus)
 		contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
-		rpc := blockchain.Rpc{Btc: btc}
+		rpc := blockchain.Rpc{Btc: btc, Rsk: registerPeginTestRsk()}
 		useCase := pegin.NewRegisterPeginUseCase(contracts, quoteRepository, eventBus, rpc, mutex)
 		err := useCase.Run(context.Background(), retainedPeginQuote)
 
@@ -568,3 +580,34 @@ func registerPeginUpdateErrorSetups(t *testing.T, registerPeginTx string, retain
 		},
 	}
 }
+
+func TestRegisterPeginUseCase_Run_GasPriceError(t *testing.T) {
+	retainedPeginQuote := quote.RetainedPeginQuote{
+		QuoteHash:     "101b1c",
+		Signature:     "0102031f1b",
+		State:         quote.PeginStateCallForUserSucceeded,
+		UserBtcTxHash: userBtcTx,
+	}
+	peginContract := new(mocks.PeginContractMock)
+	peginContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil).Once()
+	quoteRepository := new(mocks.PeginQuoteRepositoryMock)
+	quoteRepository.On("GetQuote", test.AnyCtx, retainedPeginQuote.QuoteHash).Return(&testPeginQuote, nil).Once()
+	bridge := new(mocks.BridgeMock)
+	bridge.On("GetRequiredTxConfirmations").Return(uint64(10))
+	btc := new(mocks.BtcRpcMock)
+	btc.On("GetTransactionInfo", retainedPeginQuote.UserBtcTxHash).Return(blockchain.BitcoinTransactionInformation{Confirmations: 11}, nil).Once()
+	btc.On("GetRawTransaction", retainedPeginQuote.UserBtcTxHash).Return(btcRawTxMock, nil).Once()
+	btc.On("GetPartialMerkleTree", retainedPeginQuote.UserBtcTxHash).Return(pmtMock, nil).Once()
+	btc.On("GetTransactionBlockInfo", retainedPeginQuote.UserBtcTxHash).Return(btcBlockInfoMock, nil).Once()
+	rsk := new(mocks.RootstockRpcServerMock)
+	rsk.On("GasPrice", test.AnyCtx).Return(nil, assert.AnError).Once()
+	eventBus := new(mocks.EventBusMock)
+	contracts := blockchain.RskContracts{PegIn: peginContract, Bridge: bridge}
+	useCase := pegin.NewRegisterPeginUseCase(contracts, quoteRepository, eventBus, blockchain.Rpc{Btc: btc, Rsk: rsk}, new(mocks.MutexMock))
+	err := useCase.Run(context.Background(), retainedPeginQuote)
+	require.ErrorIs(t, err, assert.AnError)
+	peginContract.AssertNotCalled(t, "RegisterPegin", mock.Anything)
+	eventBus.AssertNotCalled(t, "Publish")
+	rsk.AssertExpectations(t)
+	btc.AssertExpectations(t)
+}
@@ -18,6 +18,7 @@ type AcceptQuoteUseCase struct {
 	pegoutLp                 liquidity_provider.PegoutLiquidityProvider
 	eventBus                 entities.EventBus
 	pegoutLiquidityMutex     sync.Locker
+	ledger                   liquidity_provider.LiquidityLedger
 	trustedAccountRepository liquidity_provider.TrustedAccountRepository
 	hashFunction             entities.HashFunction
 }
@@ -29,6 +30,7 @@ func NewAcceptQuoteUseCase(
 	pegoutLp liquidity_provider.PegoutLiquidityProvider,
 	eventBus entities.EventBus,
 	pegoutLiquidityMutex sync.Locker,
+	ledger liquidity_provider.LiquidityLedger,
 	trustedAccountRepository liquidity_provider.TrustedAccountRepository,
 	hashFunction entities.HashFunction,
 ) *AcceptQuoteUseCase {
@@ -39,6 +41,7 @@ func NewAcceptQuoteUseCase(
 		pegoutLp:                 pegoutLp,
 		eventBus:                 eventBus,
 		pegoutLiquidityMutex:     pegoutLiquidityMutex,
+		ledger:                   ledger,
 		trustedAccountRepository: trustedAccountRepository,
 		hashFunction:             hashFunction,
 	}
@@ -100,6 +103,7 @@ func (useCase *AcceptQuoteUseCase) Run(ctx context.Context, quoteHash, signature
 	return quote.AcceptedQuote{
 		Signature:      retainedQuote.Signature,
 		DepositAddress: retainedQuote.DepositAddress,
+		Created:        true,
 	}, nil
 }
 
@@ -219,7 +223,12 @@ func (useCase *AcceptQuoteUseCase) publishQuote(
 	if err = entities.ValidateStruct(retainedQuote); err != nil {
 		return usecases.WrapUseCaseError(usecases.AcceptPegoutQuoteId, err)
 	}
+	hold := liquidity_provider.NewLiquidityHold(retainedQuote.QuoteHash, liquidity_provider.PegoutHold, retainedQuote.RequiredLiquidity, pegoutQuote.HoldExpireTime())
+	if err = useCase.ledger.PlaceHold(ctx, hold); err != nil {
+		return usecases.WrapUseCaseError(usecases.AcceptPegoutQuoteId, err)
+	}
 	if err = useCase.quoteRepository.InsertRetainedQuote(ctx, *retainedQuote); err != nil {
+		usecases.ReleaseFailedAcceptHold(ctx, useCase.ledger, retainedQuote.QuoteHash)
 		return usecases.WrapUseCaseError(usecases.AcceptPegoutQuoteId, err)
 	}
 
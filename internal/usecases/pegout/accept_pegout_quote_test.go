@@ -38,12 +38,13 @@ func TestAcceptQuoteUseCase_Run_Paused(t *testing.T) {
 	lp := new(mocks.ProviderMock)
 	eventBus := new(mocks.EventBusMock)
 	mutex := new(mocks.MutexMock)
+	ledger := new(mocks.LiquidityLedgerMock)
 	pegoutContract := new(mocks.PegoutContractMock)
 	pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: true, Since: 5, Reason: "test"}, nil)
 	pegoutContract.EXPECT().GetAddress().Return("test-contract")
 
 	contracts := blockchain.RskContracts{Bridge: bridge, PegOut: pegoutContract}
-	useCase := pegout.NewAcceptQuoteUseCase(quoteRepository, contracts, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+	useCase := pegout.NewAcceptQuoteUseCase(quoteRepository, contracts, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 	result, err := useCase.Run(context.Background(), acceptPegoutQuoteHash, "")
 	assert.Empty(t, result)
 	require.ErrorIs(t, err, blockchain.ContractPausedError)
@@ -98,10 +99,17 @@ func TestAcceptQuoteUseCase_Run(t *testing.T) {
 	mutex := new(mocks.MutexMock)
 	mutex.On("Lock").Once()
 	mutex.On("Unlock").Once()
+	ledger := new(mocks.LiquidityLedgerMock)
+	ledger.EXPECT().PlaceHold(test.AnyCtx, mock.MatchedBy(func(hold liquidity_provider.LiquidityHold) bool {
+		return assert.Equal(t, quoteHash, hold.QuoteHash) && assert.Equal(t, liquidity_provider.PegoutHold, hold.Operation) &&
+			assert.Equal(t, entities.NewWei(18), hold.Amount) && assert.Equal(t, liquidity_provider.HoldStatusActive, hold.Status) &&
+			assert.True(t, time.Unix(now.Unix()+1200, 0).Equal(hold.ExpiresAt))
+	})).Return(nil).Once()
 	contracts := blockchain.RskContracts{PegOut: pegoutContract}
-	useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+	useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 	result, err := useCase.Run(context.Background(), quoteHash, "")
 	quoteRepositoryMock.AssertExpectations(t)
+	ledger.AssertExpectations(t)
 	pegoutContract.AssertExpectations(t)
 	lp.AssertExpectations(t)
 	eventBus.AssertExpectations(t)
@@ -110,6 +118,7 @@ func TestAcceptQuoteUseCase_Run(t *testing.T) {
 	assert.NotEmpty(t, result)
 	assert.Equal(t, quoteMock.LbcAddress, result.DepositAddress)
 	assert.Equal(t, signature, result.Signature)
+	assert.True(t, result.Created)
 }
 
 // nolint:funlen
@@ -138,6 +147,8 @@ func TestAcceptQuoteUseCase_Run_WithoutCaptcha(t *testing.T) {
 	pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil)
 	eventBus := new(mocks.EventBusMock)
 	mutex := new(mocks.MutexMock)
+	ledger := new(mocks.LiquidityLedgerMock)
+	ledger.EXPECT().PlaceHold(test.AnyCtx, mock.AnythingOfType("liquidity_provider.LiquidityHold")).Return(nil).Once()
 	lp := new(mocks.ProviderMock)
 	lp.On("GetSigner").Return(signerMock)
 	contracts := blockchain.RskContracts{PegOut: pegoutContract}
@@ -197,7 +208,7 @@ func TestAcceptQuoteUseCase_Run_WithoutCaptcha(t *testing.T) {
 		})).Once()
 		mutex.On("Lock").Return().On("Unlock").Return()
 
-		useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+		useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 		result, err := useCase.Run(context.Background(), quoteHash, acceptPegoutQuoteHashSignature)
 
 		quoteRepositoryMock.AssertExpectations(t)
@@ -219,7 +230,7 @@ func TestAcceptQuoteUseCase_Run_WithoutCaptcha(t *testing.T) {
 
 		quoteRepositoryMock.On("GetQuote", mock.Anything, mock.Anything).Return(&quoteMock, nil)
 
-		useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+		useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 
 		result, err := useCase.Run(context.Background(), quoteHash, invalidSignature)
 
@@ -285,7 +296,7 @@ func TestAcceptQuoteUseCase_Run_WithoutCaptcha(t *testing.T) {
 		pegoutContract.EXPECT().HashPegoutQuoteEIP712(lockingCapQuote).Return(utils.To32Bytes(hexutil.MustDecode(utils.Prepend0x(acceptPegoutQuoteEip712Hash))), nil).Once()
 		lp.On("SignPegoutQuote", mock.Anything, acceptPegoutQuoteHash).Return(acceptPegoutQuoteHashSignature, nil)
 
-		useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+		useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 		result, err := useCase.Run(context.Background(), acceptPegoutQuoteHash, acceptPegoutQuoteHashSignature)
 
 		require.Error(t, err)
@@ -299,7 +310,7 @@ func TestAcceptQuoteUseCase_Run_WithoutCaptcha(t *testing.T) {
 		contract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil)
 		contract.EXPECT().HashPegoutQuoteEIP712(pegoutQuote).Return([32]byte{}, assert.AnError).Once()
 
-		useCase := pegout.NewAcceptQuoteUseCase(repo, blockchain.RskContracts{PegOut: contract}, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+		useCase := pegout.NewAcceptQuoteUseCase(repo, blockchain.RskContracts{PegOut: contract}, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 		result, err := useCase.Run(context.Background(), acceptPegoutQuoteHash, acceptPegoutQuoteHashSignature)
 
 		require.Error(t, err)
@@ -351,8 +362,9 @@ func TestAcceptQuoteUseCase_Run_AlreadyAcceptedQuote(t *testing.T) {
 	mutex := new(mocks.MutexMock)
 	mutex.On("Lock").Return()
 	mutex.On("Unlock").Return()
+	ledger := new(mocks.LiquidityLedgerMock)
 	contracts := blockchain.RskContracts{PegOut: pegoutContract}
-	useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+	useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 	result, err := useCase.Run(context.Background(), quoteHash, "")
 	quoteRepositoryMock.AssertExpectations(t)
 	pegoutContract.AssertNotCalled(t, "GetAddress")
@@ -364,6 +376,7 @@ func TestAcceptQuoteUseCase_Run_AlreadyAcceptedQuote(t *testing.T) {
 	assert.NotEmpty(t, result)
 	assert.Equal(t, quoteMock.LbcAddress, result.DepositAddress)
 	assert.Equal(t, "signature", result.Signature)
+	assert.False(t, result.Created)
 }
 
 func TestAcceptQuoteUseCase_Run_ExpiredQuote(t *testing.T) {
@@ -397,8 +410,9 @@ func TestAcceptQuoteUseCase_Run_ExpiredQuote(t *testing.T) {
 	lp := new(mocks.ProviderMock)
 	eventBus := new(mocks.EventBusMock)
 	mutex := new(mocks.MutexMock)
+	ledger := new(mocks.LiquidityLedgerMock)
 	contracts := blockchain.RskContracts{PegOut: pegoutContract}
-	useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+	useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 	result, err := useCase.Run(context.Background(), quoteHash, "")
 	quoteRepositoryMock.AssertExpectations(t)
 	quoteRepositoryMock.AssertNotCalled(t, "GetRetainedQuote")
@@ -421,8 +435,9 @@ func TestAcceptQuoteUseCase_Run_QuoteNotFound(t *testing.T) {
 	lp := new(mocks.ProviderMock)
 	eventBus := new(mocks.EventBusMock)
 	mutex := new(mocks.MutexMock)
+	ledger := new(mocks.LiquidityLedgerMock)
 	contracts := blockchain.RskContracts{PegOut: pegoutContract}
-	useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+	useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 	result, err := useCase.Run(context.Background(), quoteHash, "")
 	quoteRepositoryMock.AssertExpectations(t)
 	quoteRepositoryMock.AssertNotCalled(t, "GetRetainedQuote")
@@ -471,8 +486,9 @@ func TestAcceptQuoteUseCase_Run_NoLiquidity(t *testing.T) {
 	mutex := new(mocks.MutexMock)
 	mutex.On("Lock").Once()
 	mutex.On("Unlock").Once()
+	ledger := new(mocks.LiquidityLedgerMock)
 	contracts := blockchain.RskContracts{PegOut: pegoutContract}
-	useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+	useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 	result, err := useCase.Run(context.Background(), quoteHash, "")
 	quoteRepositoryMock.AssertExpectations(t)
 	lp.AssertExpectations(t)
@@ -530,6 +546,9 @@ func TestAcceptQuoteUseCase_Run_ErrorHandling(t *testing.T) {
 	mutex := new(mocks.MutexMock)
 	mutex.On("Lock")
 	mutex.On("Unlock")
+	ledger := new(mocks.LiquidityLedgerMock)
+	ledger.EXPECT().PlaceHold(test.AnyCtx, mock.AnythingOfType("liquidity_provider.LiquidityHold")).Return(nil)
+	ledger.EXPECT().ReleaseHold(test.AnyCtx, quoteHash, liquidity_provider.HoldReleasedByAcceptFailure).Return(true, nil)
 
 	cases := acceptQuoteUseCaseUnexpectedErrorSetups(&quoteMock, quoteHash, signature)
 
@@ -538,7 +557,7 @@ func TestAcceptQuoteUseCase_Run_ErrorHandling(t *testing.T) {
 		lp := new(mocks.ProviderMock)
 		c.Value(quoteRepositoryMock, lp)
 		contracts := blockchain.RskContracts{PegOut: pegoutContract}
-		useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+		useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 		result, err := useCase.Run(context.Background(), quoteHash, "")
 		quoteRepositoryMock.AssertExpectations(t)
 		lp.AssertExpectations(t)
@@ -627,15 +646,54 @@ func TestAcceptQuoteUseCase_Run_RetainedQuoteValidation(t *testing.T) {
 	mutex := new(mocks.MutexMock)
 	mutex.On("Lock").Once()
 	mutex.On("Unlock").Once()
+	ledger := new(mocks.LiquidityLedgerMock)
 	quoteRepositoryMock := new(mocks.PegoutQuoteRepositoryMock)
 	quoteRepositoryMock.On("GetQuote", test.AnyCtx, quoteHash).Return(&quoteMock, nil).Once()
 	quoteRepositoryMock.On("GetRetainedQuote", test.AnyCtx, quoteHash).Return(nil, nil).Once()
 	quoteRepositoryMock.EXPECT().GetPegoutCreationData(test.AnyCtx, quoteHash).Return(quote.PegoutCreationDataZeroValue()).Once()
 	contracts := blockchain.RskContracts{PegOut: pegoutContract}
-	useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, trustedAccountRepository, signingHashFunction)
+	useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
 	result, err := useCase.Run(context.Background(), quoteHash, "")
 	quoteRepositoryMock.AssertExpectations(t)
 	e := &validator.ValidationErrors{}
 	require.ErrorAs(t, err, e)
 	assert.Empty(t, result)
 }
+
+func TestAcceptQuoteUseCase_Run_PlaceHoldError(t *testing.T) {
+	now := time.Now()
+	quoteMock := quote.PegoutQuote{
+		LbcAddress:       "0xabcd01",
+		Value:            entities.NewWei(12),
+		CallFee:          entities.NewWei(5),
+		GasFee:           entities.NewWei(6),
+		DepositDateLimit: uint32(now.Unix() + 600),
+		ExpireDate:       uint32(now.Unix() + 600),
+		TransferTime:     600,
+	}
+	quoteRepositoryMock := new(mocks.PegoutQuoteRepositoryMock)
+	quoteRepositoryMock.On("GetQuote", test.AnyCtx, acceptPegoutQuoteHash).Return(&quoteMock, nil).Once()
+	quoteRepositoryMock.On("GetRetainedQuote", test.AnyCtx, acceptPegoutQuoteHash).Return(nil, nil).Once()
+	quoteRepositoryMock.EXPECT().GetPegoutCreationData(test.AnyCtx, acceptPegoutQuoteHash).Return(quote.PegoutCreationData{}).Once()
+	pegoutContract := new(mocks.PegoutContractMock)
+	pegoutContract.On("GetAddress").Return("0xabcd01").Once()
+	pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil)
+	lp := new(mocks.ProviderMock)
+	lp.On("HasPegoutLiquidity", test.AnyCtx, mock.Anything).Return(nil).Once()
+	lp.On("SignPegoutQuote", mock.Anything, acceptPegoutQuoteHash).Return(acceptPegoutQuoteHashSignature, nil)
+	eventBus := new(mocks.EventBusMock)
+	mutex := new(mocks.MutexMock)
+	mutex.On("Lock").Once()
+	mutex.On("Unlock").Once()
+	ledger := new(mocks.LiquidityLedgerMock)
+	ledger.EXPECT().PlaceHold(test.AnyCtx, mock.AnythingOfType("liquidity_provider.LiquidityHold")).Return(assert.AnError).Once()
+	contracts := blockchain.RskContracts{PegOut: pegoutContract}
+	useCase := pegout.NewAcceptQuoteUseCase(quoteRepositoryMock, contracts, lp, lp, eventBus, mutex, ledger, trustedAccountRepository, signingHashFunction)
+	result, err := useCase.Run(context.Background(), acceptPegoutQuoteHash, "")
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+	ledger.AssertExpectations(t)
+	quoteRepositoryMock.AssertExpectations(t)
+	quoteRepositoryMock.AssertNotCalled(t, "InsertRetainedQuote", mock.Anything, mock.Anything)
+	eventBus.AssertNotCalled(t, "Publish", mock.Anything)
+}
@@ -13,19 +13,17 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-const (
-	// BridgeConversionGasLimit see https://dev.rootstock.io/rsk/rbtc/conversion/networks/
-	BridgeConversionGasLimit = 100000
-	// BridgeConversionGasPrice see https://dev.rootstock.io/rsk/rbtc/conversion/networks/
-	BridgeConversionGasPrice = 60000000
-)
+// BridgeConversionGasLimit see https://dev.rootstock.io/rsk/rbtc/conversion/networks/
+const BridgeConversionGasLimit = 100000
 
 type BridgePegoutUseCase struct {
 	quoteRepository quote.PegoutQuoteRepository
 	pegoutProvider  liquidity_provider.PegoutLiquidityProvider
 	rskWallet       blockchain.RootstockWallet
 	contracts       blockchain.RskContracts
+	rpc             blockchain.Rpc
 	rskWalletMutex  sync.Locker
+	eventBus        entities.EventBus
 }
 
 func NewBridgePegoutUseCase(
@@ -33,20 +31,24 @@ func NewBridgePegoutUseCase(
 	pegoutProvider liquidity_provider.PegoutLiquidityProvider,
 	rskWallet blockchain.RootstockWallet,
 	contracts blockchain.RskContracts,
+	rpc blockchain.Rpc,
 	rskWalletMutex sync.Locker,
+	eventBus entities.EventBus,
 ) *BridgePegoutUseCase {
 	return &BridgePegoutUseCase{
 		quoteRepository: quoteRepository,
 		pegoutProvider:  pegoutProvider,
 		rskWallet:       rskWallet,
 		contracts:       contracts,
+		rpc:             rpc,
 		rskWalletMutex:  rskWalletMutex,
+		eventBus:        eventBus,
 	}
 }
 
 func (useCase *BridgePegoutUseCase) Run(ctx context.Context, watchedQuotes ...quote.WatchedPegoutQuote) error {
 	var err error
-	var balance, totalValue *entities.Wei
+	var balance, totalValue, gasPrice *entities.Wei
 
 	totalValue, err = useCase.calculateTotalToPegout(watchedQuotes)
 	if err != nil {
@@ -63,17 +65,22 @@ func (useCase *BridgePegoutUseCase) Run(ctx context.Context, watchedQuotes ...qu
 		return usecases.WrapUseCaseError(usecases.BridgePegoutId, usecases.TxBelowMinimumError)
 	}
 
+	if gasPrice, err = useCase.rpc.Rsk.GasPrice(ctx); err != nil {
+		return usecases.WrapUseCaseError(usecases.BridgePegoutId, err)
+	}
+
 	useCase.rskWalletMutex.Lock()
 	defer useCase.rskWalletMutex.Unlock()
 
-	requiredBalance := new(entities.Wei).Add(totalValue, entities.NewWei(BridgeConversionGasLimit*BridgeConversionGasPrice))
+	gasCost := new(entities.Wei).Mul(entities.NewUWei(BridgeConversionGasLimit), gasPrice)
+	requiredBalance := new(entities.Wei).Add(totalValue, gasCost)
 	if balance, err = useCase.rskWallet.GetBalance(ctx); err != nil {
 		return usecases.WrapUseCaseError(usecases.BridgePegoutId, err)
 	} else if balance.Cmp(requiredBalance) < 0 {
 		return usecases.WrapUseCaseError(usecases.BridgePegoutId, usecases.InsufficientAmountError)
 	}
 
-	config := blockchain.NewTransactionConfig(totalValue, BridgeConversionGasLimit, entities.NewWei(BridgeConversionGasPrice))
+	config := blockchain.NewTransactionConfig(totalValue, BridgeConversionGasLimit, gasPrice)
 	receipt, txErr := useCase.rskWallet.SendRbtc(ctx, config, useCase.contracts.Bridge.GetAddress())
 	if txErr == nil {
 		log.Debugf("%s: transaction sent to the bridge successfully (%s)", usecases.BridgePegoutId, receipt.TransactionHash)
@@ -109,7 +116,13 @@ func (useCase *BridgePegoutUseCase) updateQuotes(
 		retainedQuotes = append(retainedQuotes, watchedQuote.RetainedQuote)
 	}
 	if updateErr := useCase.quoteRepository.UpdateRetainedQuotes(ctx, retainedQuotes); updateErr != nil {
-		err = errors.Join(err, updateErr)
+		return errors.Join(err, updateErr)
+	}
+	for _, retainedQuote := range retainedQuotes {
+		useCase.eventBus.Publish(quote.PegoutStateUpdatedEvent{
+			Event:         entities.NewBaseEvent(quote.PegoutStateUpdatedEventId),
+			RetainedQuote: retainedQuote,
+		})
 	}
 	return err
 }
@@ -19,6 +19,14 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+const bridgePegoutTestGasPrice = 65000000
+
+func bridgePegoutTestRpc() blockchain.Rpc {
+	rsk := &mocks.RootstockRpcServerMock{}
+	rsk.On("GasPrice", mock.Anything).Return(entities.NewWei(bridgePegoutTestGasPrice), nil)
+	return blockchain.Rpc{Rsk: rsk}
+}
+
 var bridgePegoutTestWatchedQuotes = []quote.WatchedPegoutQuote{
 	{
 		RetainedQuote: quote.RetainedPegoutQuote{QuoteHash: "01", State: quote.PegoutStateSendPegoutFailed},
@@ -77,6 +85,9 @@ func TestBridgePegoutUseCase_Run(t *testing.T) {
 	t.Run("when some of the quotes have not been refunded", func(t *testing.T) {
 		testBridgePegoutUseCaseQuotesNotRefunded(t)
 	})
+	t.Run("error getting gas price", func(t *testing.T) {
+		testBridgePegoutUseCaseGasPriceError(t)
+	})
 	t.Run("error getting wallet balance", func(t *testing.T) {
 		testBridgePegoutUseCaseWalletBalanceError(t)
 	})
@@ -95,7 +106,7 @@ func testBridgePegoutUseCaseSuccess(t *testing.T) {
 	pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
 	pegoutLp := &mocks.ProviderMock{}
 	wallet := &mocks.RskWalletMock{}
-	walletBalance := new(entities.Wei).Add(entities.NewWei(1000), entities.NewWei(pegout.BridgeConversionGasLimit*pegout.BridgeConversionGasPrice))
+	walletBalance := new(entities.Wei).Add(entities.NewWei(1000), entities.NewWei(pegout.BridgeConversionGasLimit*bridgePegoutTestGasPrice))
 	wallet.On("GetBalance", mock.Anything).Return(walletBalance, nil).Once()
 	sendRbtcReceipt := blockchain.TransactionReceipt{
 		TransactionHash:   test.AnyHash,
@@ -106,12 +117,12 @@ func testBridgePegoutUseCaseSuccess(t *testing.T) {
 		CumulativeGasUsed: big.NewInt(21000),
 		GasUsed:           big.NewInt(21000),
 		Value:             entities.NewWei(558),
-		GasPrice:          entities.NewWei(pegout.BridgeConversionGasPrice),
+		GasPrice:          entities.NewWei(bridgePegoutTestGasPrice),
 	}
 	wallet.On("SendRbtc", mock.Anything, mock.MatchedBy(func(config blockchain.TransactionConfig) bool {
 		return config.Value.Cmp(entities.NewWei(558)) == 0 &&
 			*config.GasLimit == pegout.BridgeConversionGasLimit &&
-			config.GasPrice.Cmp(entities.NewWei(pegout.BridgeConversionGasPrice)) == 0
+			config.GasPrice.Cmp(entities.NewWei(bridgePegoutTestGasPrice)) == 0
 	}), test.AnyAddress).Return(sendRbtcReceipt, nil).Once()
 	mutex := &mocks.MutexMock{}
 	mutex.On("Lock").Return().Once()
@@ -126,13 +137,17 @@ func testBridgePegoutUseCaseSuccess(t *testing.T) {
 			if !(q.State == quote.PegoutStateBridgeTxSucceeded &&
 				q.BridgeRefundTxHash == test.AnyHash &&
 				q.BridgeRefundGasUsed == uint64(21000) &&
-				q.BridgeRefundGasPrice != nil && q.BridgeRefundGasPrice.Cmp(entities.NewWei(pegout.BridgeConversionGasPrice)) == 0) {
+				q.BridgeRefundGasPrice != nil && q.BridgeRefundGasPrice.Cmp(entities.NewWei(bridgePegoutTestGasPrice)) == 0) {
 				return false
 			}
 		}
 		return true
 	})).Return(nil).Once()
-	useCase := pegout.NewBridgePegoutUseCase(pegoutRepository, pegoutLp, wallet, blockchain.RskContracts{Bridge: bridge}, mutex)
+	eventBus := &mocks.EventBusMock{}
+	eventBus.On("Publish", mock.MatchedBy(func(event quote.PegoutStateUpdatedEvent) bool {
+		return event.RetainedQuote.State == quote.PegoutStateBridgeTxSucceeded && event.Id() == quote.PegoutStateUpdatedEventId
+	})).Return().Times(3)
+	useCase := pegout.NewBridgePegoutUseCase(pegoutRepository, pegoutLp, wallet, blockchain.RskContracts{Bridge: bridge}, bridgePegoutTestRpc(), mutex, eventBus)
 	testQuotes := make([]quote.WatchedPegoutQuote, len(bridgePegoutTestWatchedQuotes))
 	copy(testQuotes, bridgePegoutTestWatchedQuotes)
 	err := useCase.Run(
@@ -147,6 +162,7 @@ func testBridgePegoutUseCaseSuccess(t *testing.T) {
 	wallet.AssertExpectations(t)
 	mutex.AssertExpectations(t)
 	bridge.AssertExpectations(t)
+	eventBus.AssertExpectations(t)
 }
 
 func testBridgePegoutUseCaseValueBelowMinimum(t *testing.T) {
@@ -158,7 +174,8 @@ func testBridgePegoutUseCaseValueBelowMinimum(t *testing.T) {
 	pegoutLp.On("PegoutConfiguration", mock.Anything).Return(liquidity_provider.PegoutConfiguration{
 		BridgeTransactionMin: entities.NewWei(5000),
 	}).Once()
-	useCase := pegout.NewBridgePegoutUseCase(pegoutRepository, pegoutLp, wallet, blockchain.RskContracts{Bridge: bridge}, mutex)
+	eventBus := &mocks.EventBusMock{}
+	useCase := pegout.NewBridgePegoutUseCase(pegoutRepository, pegoutLp, wallet, blockchain.RskContracts{Bridge: bridge}, bridgePegoutTestRpc(), mutex, eventBus)
 	testQuotes := make([]quote.WatchedPegoutQuote, len(bridgePegoutTestWatchedQuotes))
 	copy(testQuotes, bridgePegoutTestWatchedQuotes)
 	err := useCase.Run(
@@ -183,7 +200,8 @@ func testBridgePegoutUseCaseQuotesNotRefunded(t *testing.T) {
 	wallet := &mocks.RskWalletMock{}
 	mutex := &mocks.MutexMock{}
 	bridge := &mocks.BridgeMock{}
-	useCase := pegout.NewBridgePegoutUseCase(pegoutRepository, pegoutLp, wallet, blockchain.RskContracts{Bridge: bridge}, mutex)
+	eventBus := &mocks.EventBusMock{}
+	useCase := pegout.NewBridgePegoutUseCase(pegoutRepository, pegoutLp, wallet, blockchain.RskContracts{Bridge: bridge}, bridgePegoutTestRpc(), mutex, eventBus)
 	err := useCase.Run(context.Background(), bridgePegoutTestWatchedQuotes...)
 	require.ErrorContains(t, err, "not all quotes were refunded successfully")
 	pegoutRepository.AssertNotCalled(t, "UpdateRetainedQuote")
@@ -207,7 +225,8 @@ func testBridgePegoutUseCaseWalletBalanceError(t *testing.T) {
 	pegoutLp.On("PegoutConfiguration", mock.Anything).Return(liquidity_provider.PegoutConfiguration{
 		BridgeTransactionMin: entities.NewWei(550),
 	}).Once()
-	useCase := pegout.NewBridgePegoutUseCase(pegoutRepository, pegoutLp, wallet, blockchain.RskContracts{Bridge: bridge}, mutex)
+	eventBus := &mocks.EventBusMock{}
+	useCase := pegout.NewBridgePegoutUseCase(pegoutRepository, pegoutLp, wallet, blockchain.RskContracts{Bridge: bridge}, bridgePegoutTestRpc(), mutex, eventBus)
 	testQuotes := make([]quote.WatchedPegoutQuote, len(bridgePegoutTestWatchedQuotes))
 	copy(testQuotes, bridgePegoutTestWatchedQuotes)
 	err := useCase.Run(
@@ -228,7 +247,7 @@ func testBridgePegoutUseCaseWalletWithoutBalance(t *testing.T) {
 	pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
 	pegoutLp := &mocks.ProviderMock{}
 	wallet := &mocks.RskWalletMock{}
-	walletBalance := new(entities.Wei).Add(entities.NewWei(500), entities.NewWei(pegout.BridgeConversionGasLimit*pegout.BridgeConversionGasPrice))
+	walletBalance := new(entities.Wei).Add(entities.NewWei(500), entities.NewWei(pegout.BridgeConversionGasLimit*bridgePegoutTestGasPrice))
 	wallet.On("GetBalance", mock.Anything).Return(walletBalance, nil).Once()
 	mutex := &mocks.MutexMock{}
 	mutex.On("Lock").Return().Once()
@@ -237,7 +256,8 @@ func testBridgePegoutUseCaseWalletWithoutBalance(t *testing.T) {
 	pegoutLp.On("PegoutConfiguration", mock.Anything).Return(liquidity_provider.PegoutConfiguration{
 		BridgeTransactionMin: entities.NewWei(550),
 	}).Once()
-	useCase := pegout.NewBridgePegoutUseCase(pegoutRepository, pegoutLp, wallet, blockchain.RskContracts{Bridge: bridge}, mutex)
+	eventBus := &mocks.EventBusMock{}
+	useCase := pegout.NewBridgePegoutUseCase(pegoutRepository, pegoutLp, wallet, blockchain.RskContracts{Bridge: bridge}, bridgePegoutTestRpc(), mutex, eventBus)
 	testQuotes := make([]quote.WatchedPegoutQuote, len(bridgePegoutTestWatchedQuotes))
 	copy(testQuotes, bridgePegoutTestWatchedQuotes)
 	err := useCase.Run(
@@ -258,7 +278,7 @@ func testBridgePegoutUseCaseTxFails(t *testing.T) {
 	pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
 	pegoutLp := &mocks.ProviderMock{}
 	wallet := &mocks.RskWalletMock{}
-	walletBalance := new(entities.Wei).Add(entities.NewWei(1000), entities.NewWei(pegout.BridgeConversionGasLimit*pegout.BridgeConversionGasPrice))
+	walletBalance := new(entities.Wei).Add(entities.NewWei(1000), entities.NewWei(pegout.BridgeConversionGasLimit*bridgePegoutTestGasPrice))
 	wallet.On("GetBalance", mock.Anything).Return(walletBalance, nil).Once()
 	emptyReceipt := blockchain.TransactionReceipt{}
 	wallet.On("SendRbtc", mock.Anything, mock.Anything, test.AnyAddress).Return(emptyReceipt, assert.AnError).Once()
@@ -281,7 +301,11 @@ func testBridgePegoutUseCaseTxFails(t *testing.T) {
 		}
 		return true
 	})).Return(nil).Once()
-	useCase := pegout.NewBridgePegoutUseCase(pegoutRepository, pegoutLp, wallet, blockchain.RskContracts{Bridge: bridge}, mutex)
+	eventBus := &mocks.EventBusMock{}
+	eventBus.On("Publish", mock.MatchedBy(func(event quote.PegoutStateUpdatedEvent) bool {
+		return event.RetainedQuote.State == quote.PegoutStateBridgeTxFailed && event.Id() == quote.PegoutStateUpdatedEventId
+	})).Return().Times(3)
+	useCase := pegout.NewBridgePegoutUseCase(pegoutRepository, pegoutLp, wallet, blockchain.RskContracts{Bridge: bridge}, bridgePegoutTestRpc(), mutex, eventBus)
 	testQuotes := make([]quote.WatchedPegoutQuote, len(bridgePegoutTestWatchedQuotes))
 	copy(testQuotes, bridgePegoutTestWatchedQuotes)
 	err := useCase.Run(
@@ -296,13 +320,14 @@ func testBridgePegoutUseCaseTxFails(t *testing.T) {
 	wallet.AssertExpectations(t)
 	mutex.AssertExpectations(t)
 	bridge.AssertExpectations(t)
+	eventBus.AssertExpectations(t)
 }
 
 func testBridgePegoutUseCaseUpdateFails(t *testing.T) {
 	pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
 	pegoutLp := &mocks.ProviderMock{}
 	wallet := &mocks.RskWalletMock{}
-	walletBalance := new(entities.Wei).Add(entities.NewWei(1000), entities.NewWei(pegout.BridgeConversionGasLimit*pegout.BridgeConversionGasPrice))
+	walletBalance := new(entities.Wei).Add(entities.NewWei(1000), entities.NewWei(pegout.BridgeConversionGasLimit*bridgePegoutTestGasPrice))
 	wallet.On("GetBalance", mock.Anything).Return(walletBalance, nil).Once()
 	successReceipt := blockchain.TransactionReceipt{
 		TransactionHash:   test.AnyHash,
@@ -313,7 +338,7 @@ func testBridgePegoutUseCaseUpdateFails(t *testing.T) {
 		CumulativeGasUsed: big.NewInt(21000),
 		GasUsed:           big.NewInt(21000),
 		Value:             entities.NewWei(0),
-		GasPrice:          entities.NewWei(pegout.BridgeConversionGasPrice),
+		GasPrice:          entities.NewWei(bridgePegoutTestGasPrice),
 	}
 	wallet.On("SendRbtc", mock.Anything, mock.Anything, test.AnyAddress).Return(successReceipt, nil).Once()
 	mutex := &mocks.MutexMock{}
@@ -325,7 +350,8 @@ func testBridgePegoutUseCaseUpdateFails(t *testing.T) {
 		BridgeTransactionMin: entities.NewWei(550),
 	}).Once()
 	pegoutRepository.On("UpdateRetainedQuotes", mock.Anything, mock.Anything).Return(errors.New("update error")).Once()
-	useCase := pegout.NewBridgePegoutUseCase(pegoutRepository, pegoutLp, wallet, blockchain.RskContracts{Bridge: bridge}, mutex)
+	eventBus := &mocks.EventBusMock{}
+	useCase := pegout.NewBridgePegoutUseCase(pegoutRepository, pegoutLp, wallet, blockchain.RskContracts{Bridge: bridge}, bridgePegoutTestRpc(), mutex, eventBus)
 	testQuotes := make([]quote.WatchedPegoutQuote, len(bridgePegoutTestWatchedQuotes))
 	copy(testQuotes, bridgePegoutTestWatchedQuotes)
 	err := useCase.Run(
@@ -340,4 +366,23 @@ func testBridgePegoutUseCaseUpdateFails(t *testing.T) {
 	wallet.AssertExpectations(t)
 	mutex.AssertExpectations(t)
 	bridge.AssertExpectations(t)
+	eventBus.AssertNotCalled(t, "Publish", mock.Anything)
+}
+
+func testBridgePegoutUseCaseGasPriceError(t *testing.T) {
+	pegoutLp := &mocks.ProviderMock{}
+	wallet := &mocks.RskWalletMock{}
+	mutex := &mocks.MutexMock{}
+	rsk := &mocks.RootstockRpcServerMock{}
+	rsk.On("GasPrice", mock.Anything).Return(nil, assert.AnError).Once()
+	pegoutLp.On("PegoutConfiguration", mock.Anything).Return(liquidity_provider.PegoutConfiguration{
+		BridgeTransactionMin: entities.NewWei(550),
+	}).Once()
+	useCase := pegout.NewBridgePegoutUseCase(&mocks.PegoutQuoteRepositoryMock{}, pegoutLp, wallet, blockchain.RskContracts{}, blockchain.Rpc{Rsk: rsk}, mutex, &mocks.EventBusMock{})
+	err := useCase.Run(context.Background(), bridgePegoutTestWatchedQuotes[1], bridgePegoutTestWatchedQuotes[2], bridgePegoutTestWatchedQuotes[4])
+	require.ErrorIs(t, err, assert.AnError)
+	rsk.AssertExpectations(t)
+	wallet.AssertNotCalled(t, "GetBalance")
+	wallet.AssertNotCalled(t, "SendRbtc")
+	mutex.AssertNotCalled(t, "Lock")
 }
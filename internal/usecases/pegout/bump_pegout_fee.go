@@ -0,0 +1,139 @@
+package pegout
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	log "github.com/sirupsen/logrus"
+)
+
+const maxFeeBumpThresholdPercentage = 100
+
+// BumpPegoutFeeUseCase replaces the BTC transaction sent by the LP to pay a pegout when it is still unconfirmed
+// after a percentage of the time that the LP has to make the transfer has elapsed. The replacement pays the current
+// fee rate (RBF) and is tracked in the retained quote so the refund is made with the transaction that gets confirmed
+type BumpPegoutFeeUseCase struct {
+	btcWallet           blockchain.BitcoinWallet
+	quoteRepository     quote.PegoutQuoteRepository
+	rpc                 blockchain.Rpc
+	btcWalletMutex      sync.Locker
+	maxFee              *entities.Wei
+	thresholdPercentage uint64
+}
+
+// NewBumpPegoutFeeUseCase creates the use case. A thresholdPercentage of 0 disables the fee bumping
+func NewBumpPegoutFeeUseCase(
+	btcWallet blockchain.BitcoinWallet,
+	quoteRepository quote.PegoutQuoteRepository,
+	rpc blockchain.Rpc,
+	btcWalletMutex sync.Locker,
+	maxFee *entities.Wei,
+	thresholdPercentage uint64,
+) *BumpPegoutFeeUseCase {
+	return &BumpPegoutFeeUseCase{
+		btcWallet:           btcWallet,
+		quoteRepository:     quoteRepository,
+		rpc:                 rpc,
+		btcWalletMutex:      btcWalletMutex,
+		maxFee:              maxFee,
+		thresholdPercentage: min(thresholdPercentage, maxFeeBumpThresholdPercentage),
+	}
+}
+
+// Run bumps the fee of the LP BTC transaction of the quote if it is due. It must be called only for transactions
+// without confirmations. Returns the updated quote, or the same quote if the transaction wasn't replaced
+func (useCase *BumpPegoutFeeUseCase) Run(ctx context.Context, watchedQuote quote.WatchedPegoutQuote) (quote.WatchedPegoutQuote, error) {
+	result, err := useCase.RunBatch(ctx, []quote.WatchedPegoutQuote{watchedQuote})
+	return result[0], err
+}
+
+// RunBatch bumps the fee of a LP BTC transaction that pays several pegouts (see SendBatchPegoutUseCase). All the
+// quotes must have the same LP BTC transaction, which is replaced once the bump is due for any of them. The fee
+// of the replacement is split between the quotes. Returns the updated quotes in the same order they were received
+func (useCase *BumpPegoutFeeUseCase) RunBatch(ctx context.Context, watchedQuotes []quote.WatchedPegoutQuote) ([]quote.WatchedPegoutQuote, error) {
+	var err error
+	var result blockchain.BitcoinTransactionResult
+
+	if useCase.thresholdPercentage == 0 || len(watchedQuotes) == 0 {
+		return watchedQuotes, nil
+	}
+	txHash := watchedQuotes[0].RetainedQuote.LpBtcTxHash
+	for _, watchedQuote := range watchedQuotes {
+		retainedQuote := watchedQuote.RetainedQuote
+		if retainedQuote.State != quote.PegoutStateSendPegoutSucceeded || retainedQuote.LpBtcTxHash == "" || retainedQuote.LpBtcTxHash != txHash {
+			return watchedQuotes, usecases.WrapUseCaseErrorArgs(usecases.BumpPegoutFeeId, usecases.WrongStateError, usecases.ErrorArg("quoteHash", retainedQuote.QuoteHash))
+		}
+	}
+
+	if due, dueErr := useCase.isBumpDue(ctx, watchedQuotes); dueErr != nil {
+		return watchedQuotes, usecases.WrapUseCaseError(usecases.BumpPegoutFeeId, dueErr)
+	} else if !due {
+		return watchedQuotes, nil
+	}
+
+	useCase.btcWalletMutex.Lock()
+	defer useCase.btcWalletMutex.Unlock()
+	result, err = useCase.btcWallet.BumpFee(txHash, useCase.maxFee)
+	if errors.Is(err, blockchain.BtcFeeAlreadyCurrentError) {
+		return watchedQuotes, nil
+	} else if err != nil {
+		return watchedQuotes, usecases.WrapUseCaseErrorArgs(usecases.BumpPegoutFeeId, err, usecases.ErrorArg("quoteHash", watchedQuotes[0].RetainedQuote.QuoteHash))
+	}
+
+	fees := splitBatchFee(result.Fee, len(watchedQuotes))
+	updatedQuotes := make([]quote.WatchedPegoutQuote, 0, len(watchedQuotes))
+	for i, watchedQuote := range watchedQuotes {
+		retainedQuote := watchedQuote.RetainedQuote
+		log.Infof("BumpPegoutFee: transaction %s of quote %s replaced by %s", txHash, retainedQuote.QuoteHash, result.Hash)
+		var fee *entities.Wei
+		if fees != nil {
+			fee = fees[i]
+		}
+		retainedQuote.ReplaceLpBtcTransaction(result.Hash, fee)
+		watchedQuote.RetainedQuote = retainedQuote
+		updatedQuotes = append(updatedQuotes, watchedQuote)
+		if updateErr := useCase.quoteRepository.UpdateRetainedQuote(ctx, retainedQuote); updateErr != nil {
+			err = errors.Join(err, updateErr)
+		}
+	}
+	if err != nil {
+		// the replacement was already broadcast, so the updated quotes are returned to keep tracking them
+		return updatedQuotes, usecases.WrapUseCaseError(usecases.BumpPegoutFeeId, err)
+	}
+	return updatedQuotes, nil
+}
+
+// isBumpDue returns true if the bump time of any of the quotes has already passed
+func (useCase *BumpPegoutFeeUseCase) isBumpDue(ctx context.Context, watchedQuotes []quote.WatchedPegoutQuote) (bool, error) {
+	now := time.Now()
+	for _, watchedQuote := range watchedQuotes {
+		bumpTime, err := useCase.getBumpTime(ctx, watchedQuote)
+		if err != nil {
+			return false, err
+		} else if !now.Before(bumpTime) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getBumpTime returns the moment after which the transaction should be replaced, calculated as a percentage
+// of the transfer time counted since the block of the user deposit
+func (useCase *BumpPegoutFeeUseCase) getBumpTime(ctx context.Context, watchedQuote quote.WatchedPegoutQuote) (time.Time, error) {
+	receipt, err := useCase.rpc.Rsk.GetTransactionReceipt(ctx, watchedQuote.RetainedQuote.UserRskTxHash)
+	if err != nil {
+		return time.Time{}, err
+	}
+	block, err := useCase.rpc.Rsk.GetBlockByHash(ctx, receipt.BlockHash)
+	if err != nil {
+		return time.Time{}, err
+	}
+	transferTime := time.Duration(watchedQuote.PegoutQuote.TransferTime) * time.Second
+	return block.Timestamp.Add(transferTime * time.Duration(useCase.thresholdPercentage) / maxFeeBumpThresholdPercentage), nil
+}
@@ -0,0 +1,191 @@
+package pegout_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegout"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	bumpPegoutFeeBlockHash = "0x1a2b3c"
+	bumpPegoutFeeNewTxHash = "0x3c2b1c"
+)
+
+var bumpPegoutFeeMaxFee = entities.NewWei(100000)
+
+func setupBumpPegoutFeeRpc(depositTime time.Time) *mocks.RootstockRpcServerMock {
+	rsk := new(mocks.RootstockRpcServerMock)
+	rsk.On("GetTransactionReceipt", test.AnyCtx, retainedQuote.UserRskTxHash).Return(blockchain.TransactionReceipt{BlockHash: bumpPegoutFeeBlockHash}, nil).Once()
+	rsk.On("GetBlockByHash", test.AnyCtx, bumpPegoutFeeBlockHash).Return(blockchain.BlockInfo{Timestamp: depositTime}, nil).Once()
+	return rsk
+}
+
+// nolint:funlen
+func TestBumpPegoutFeeUseCase_Run(t *testing.T) {
+	watchedQuote := quote.NewWatchedPegoutQuote(pegoutQuote, retainedQuote, quote.PegoutCreationData{})
+	watchedQuote.RetainedQuote.SendPegoutBtcFee = entities.NewWei(500)
+	// the transfer time of the quote is 60 seconds, so with a 50% threshold the bump is due after 30 seconds
+	const threshold = 50
+	t.Run("should replace the transaction after the threshold", func(t *testing.T) {
+		rsk := setupBumpPegoutFeeRpc(time.Now().Add(-40 * time.Second))
+		btcWallet := new(mocks.BitcoinWalletMock)
+		btcWallet.On("BumpFee", retainedQuote.LpBtcTxHash, bumpPegoutFeeMaxFee).Return(blockchain.BitcoinTransactionResult{Hash: bumpPegoutFeeNewTxHash, Fee: entities.NewWei(900)}, nil).Once()
+		expectedQuote := watchedQuote.RetainedQuote
+		expectedQuote.LpBtcTxHash = bumpPegoutFeeNewTxHash
+		expectedQuote.SendPegoutBtcFee = entities.NewWei(900)
+		expectedQuote.LpBtcReplacedTxs = []quote.ReplacedBtcTransaction{{TxHash: retainedQuote.LpBtcTxHash, Fee: entities.NewWei(500)}}
+		quoteRepository := new(mocks.PegoutQuoteRepositoryMock)
+		quoteRepository.On("UpdateRetainedQuote", test.AnyCtx, expectedQuote).Return(nil).Once()
+		mutex := new(mocks.MutexMock)
+		mutex.On("Lock").Return().Once()
+		mutex.On("Unlock").Return().Once()
+		useCase := pegout.NewBumpPegoutFeeUseCase(btcWallet, quoteRepository, blockchain.Rpc{Rsk: rsk}, mutex, bumpPegoutFeeMaxFee, threshold)
+		result, err := useCase.Run(context.Background(), watchedQuote)
+		require.NoError(t, err)
+		assert.Equal(t, expectedQuote, result.RetainedQuote)
+		assert.Equal(t, watchedQuote.PegoutQuote, result.PegoutQuote)
+		rsk.AssertExpectations(t)
+		btcWallet.AssertExpectations(t)
+		quoteRepository.AssertExpectations(t)
+		mutex.AssertExpectations(t)
+	})
+	t.Run("should not replace the transaction before the threshold", func(t *testing.T) {
+		rsk := setupBumpPegoutFeeRpc(time.Now().Add(-20 * time.Second))
+		btcWallet := new(mocks.BitcoinWalletMock)
+		quoteRepository := new(mocks.PegoutQuoteRepositoryMock)
+		useCase := pegout.NewBumpPegoutFeeUseCase(btcWallet, quoteRepository, blockchain.Rpc{Rsk: rsk}, new(mocks.MutexMock), bumpPegoutFeeMaxFee, threshold)
+		result, err := useCase.Run(context.Background(), watchedQuote)
+		require.NoError(t, err)
+		assert.Equal(t, watchedQuote, result)
+		btcWallet.AssertNotCalled(t, "BumpFee", mock.Anything, mock.Anything)
+		quoteRepository.AssertNotCalled(t, "UpdateRetainedQuote", mock.Anything, mock.Anything)
+	})
+	t.Run("should do nothing when the fee bumping is disabled", func(t *testing.T) {
+		rsk := new(mocks.RootstockRpcServerMock)
+		btcWallet := new(mocks.BitcoinWalletMock)
+		useCase := pegout.NewBumpPegoutFeeUseCase(btcWallet, new(mocks.PegoutQuoteRepositoryMock), blockchain.Rpc{Rsk: rsk}, new(mocks.MutexMock), bumpPegoutFeeMaxFee, 0)
+		result, err := useCase.Run(context.Background(), watchedQuote)
+		require.NoError(t, err)
+		assert.Equal(t, watchedQuote, result)
+		rsk.AssertNotCalled(t, "GetTransactionReceipt", mock.Anything, mock.Anything)
+		btcWallet.AssertNotCalled(t, "BumpFee", mock.Anything, mock.Anything)
+	})
+	t.Run("should not update the quote if the transaction already pays the current fee rate", func(t *testing.T) {
+		rsk := setupBumpPegoutFeeRpc(time.Now().Add(-40 * time.Second))
+		btcWallet := new(mocks.BitcoinWalletMock)
+		btcWallet.On("BumpFee", retainedQuote.LpBtcTxHash, bumpPegoutFeeMaxFee).Return(blockchain.BitcoinTransactionResult{}, blockchain.BtcFeeAlreadyCurrentError).Once()
+		quoteRepository := new(mocks.PegoutQuoteRepositoryMock)
+		mutex := new(mocks.MutexMock)
+		mutex.On("Lock").Return().Once()
+		mutex.On("Unlock").Return().Once()
+		useCase := pegout.NewBumpPegoutFeeUseCase(btcWallet, quoteRepository, blockchain.Rpc{Rsk: rsk}, mutex, bumpPegoutFeeMaxFee, threshold)
+		result, err := useCase.Run(context.Background(), watchedQuote)
+		require.NoError(t, err)
+		assert.Equal(t, watchedQuote, result)
+		quoteRepository.AssertNotCalled(t, "UpdateRetainedQuote", mock.Anything, mock.Anything)
+	})
+	t.Run("should fail if the quote is in the wrong state", func(t *testing.T) {
+		wrongStateQuote := watchedQuote
+		wrongStateQuote.RetainedQuote.State = quote.PegoutStateRefundPegOutSucceeded
+		useCase := pegout.NewBumpPegoutFeeUseCase(new(mocks.BitcoinWalletMock), new(mocks.PegoutQuoteRepositoryMock), blockchain.Rpc{}, new(mocks.MutexMock), bumpPegoutFeeMaxFee, threshold)
+		result, err := useCase.Run(context.Background(), wrongStateQuote)
+		require.ErrorIs(t, err, usecases.WrongStateError)
+		assert.Equal(t, wrongStateQuote, result)
+	})
+	t.Run("should handle rpc errors", func(t *testing.T) {
+		rsk := new(mocks.RootstockRpcServerMock)
+		rsk.On("GetTransactionReceipt", test.AnyCtx, retainedQuote.UserRskTxHash).Return(blockchain.TransactionReceipt{}, assert.AnError).Once()
+		btcWallet := new(mocks.BitcoinWalletMock)
+		useCase := pegout.NewBumpPegoutFeeUseCase(btcWallet, new(mocks.PegoutQuoteRepositoryMock), blockchain.Rpc{Rsk: rsk}, new(mocks.MutexMock), bumpPegoutFeeMaxFee, threshold)
+		result, err := useCase.Run(context.Background(), watchedQuote)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, watchedQuote, result)
+		btcWallet.AssertNotCalled(t, "BumpFee", mock.Anything, mock.Anything)
+	})
+	t.Run("should handle wallet errors", func(t *testing.T) {
+		rsk := setupBumpPegoutFeeRpc(time.Now().Add(-40 * time.Second))
+		btcWallet := new(mocks.BitcoinWalletMock)
+		btcWallet.On("BumpFee", retainedQuote.LpBtcTxHash, bumpPegoutFeeMaxFee).Return(blockchain.BitcoinTransactionResult{}, assert.AnError).Once()
+		quoteRepository := new(mocks.PegoutQuoteRepositoryMock)
+		mutex := new(mocks.MutexMock)
+		mutex.On("Lock").Return().Once()
+		mutex.On("Unlock").Return().Once()
+		useCase := pegout.NewBumpPegoutFeeUseCase(btcWallet, quoteRepository, blockchain.Rpc{Rsk: rsk}, mutex, bumpPegoutFeeMaxFee, threshold)
+		result, err := useCase.Run(context.Background(), watchedQuote)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, watchedQuote, result)
+		quoteRepository.AssertNotCalled(t, "UpdateRetainedQuote", mock.Anything, mock.Anything)
+	})
+	t.Run("should return the replaced quote even if it can't be updated", func(t *testing.T) {
+		rsk := setupBumpPegoutFeeRpc(time.Now().Add(-40 * time.Second))
+		btcWallet := new(mocks.BitcoinWalletMock)
+		btcWallet.On("BumpFee", retainedQuote.LpBtcTxHash, bumpPegoutFeeMaxFee).Return(blockchain.BitcoinTransactionResult{Hash: bumpPegoutFeeNewTxHash, Fee: entities.NewWei(900)}, nil).Once()
+		quoteRepository := new(mocks.PegoutQuoteRepositoryMock)
+		quoteRepository.On("UpdateRetainedQuote", test.AnyCtx, mock.Anything).Return(assert.AnError).Once()
+		mutex := new(mocks.MutexMock)
+		mutex.On("Lock").Return().Once()
+		mutex.On("Unlock").Return().Once()
+		useCase := pegout.NewBumpPegoutFeeUseCase(btcWallet, quoteRepository, blockchain.Rpc{Rsk: rsk}, mutex, bumpPegoutFeeMaxFee, threshold)
+		result, err := useCase.Run(context.Background(), watchedQuote)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, bumpPegoutFeeNewTxHash, result.RetainedQuote.LpBtcTxHash)
+	})
+}
+
+func TestBumpPegoutFeeUseCase_RunBatch(t *testing.T) {
+	firstQuote := quote.NewWatchedPegoutQuote(pegoutQuote, retainedQuote, quote.PegoutCreationData{})
+	firstQuote.RetainedQuote.SendPegoutBtcFee = entities.NewWei(250)
+	secondQuote := firstQuote
+	secondQuote.RetainedQuote.QuoteHash = "0d2c3b"
+	const threshold = 50
+	t.Run("should replace the shared transaction for all the quotes", func(t *testing.T) {
+		// the receipt of both quotes is the same, only the first one is needed since the bump is already due
+		rsk := setupBumpPegoutFeeRpc(time.Now().Add(-40 * time.Second))
+		btcWallet := new(mocks.BitcoinWalletMock)
+		btcWallet.On("BumpFee", retainedQuote.LpBtcTxHash, bumpPegoutFeeMaxFee).Return(blockchain.BitcoinTransactionResult{Hash: bumpPegoutFeeNewTxHash, Fee: entities.NewWei(901)}, nil).Once()
+		quoteRepository := new(mocks.PegoutQuoteRepositoryMock)
+		expectedFees := []*entities.Wei{entities.NewWei(451), entities.NewWei(450)}
+		for i, watchedQuote := range []quote.WatchedPegoutQuote{firstQuote, secondQuote} {
+			expected := watchedQuote.RetainedQuote
+			expected.LpBtcTxHash = bumpPegoutFeeNewTxHash
+			expected.SendPegoutBtcFee = expectedFees[i]
+			expected.LpBtcReplacedTxs = []quote.ReplacedBtcTransaction{{TxHash: retainedQuote.LpBtcTxHash, Fee: entities.NewWei(250)}}
+			quoteRepository.On("UpdateRetainedQuote", test.AnyCtx, expected).Return(nil).Once()
+		}
+		mutex := new(mocks.MutexMock)
+		mutex.On("Lock").Return().Once()
+		mutex.On("Unlock").Return().Once()
+		useCase := pegout.NewBumpPegoutFeeUseCase(btcWallet, quoteRepository, blockchain.Rpc{Rsk: rsk}, mutex, bumpPegoutFeeMaxFee, threshold)
+		result, err := useCase.RunBatch(context.Background(), []quote.WatchedPegoutQuote{firstQuote, secondQuote})
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, firstQuote.RetainedQuote.QuoteHash, result[0].RetainedQuote.QuoteHash)
+		assert.Equal(t, secondQuote.RetainedQuote.QuoteHash, result[1].RetainedQuote.QuoteHash)
+		for _, updated := range result {
+			assert.Equal(t, bumpPegoutFeeNewTxHash, updated.RetainedQuote.LpBtcTxHash)
+		}
+		btcWallet.AssertExpectations(t)
+		quoteRepository.AssertExpectations(t)
+	})
+	t.Run("should fail if the quotes don't share the transaction", func(t *testing.T) {
+		otherQuote := secondQuote
+		otherQuote.RetainedQuote.LpBtcTxHash = bumpPegoutFeeNewTxHash
+		btcWallet := new(mocks.BitcoinWalletMock)
+		useCase := pegout.NewBumpPegoutFeeUseCase(btcWallet, new(mocks.PegoutQuoteRepositoryMock), blockchain.Rpc{}, new(mocks.MutexMock), bumpPegoutFeeMaxFee, threshold)
+		result, err := useCase.RunBatch(context.Background(), []quote.WatchedPegoutQuote{firstQuote, otherQuote})
+		require.ErrorIs(t, err, usecases.WrongStateError)
+		assert.Equal(t, []quote.WatchedPegoutQuote{firstQuote, otherQuote}, result)
+		btcWallet.AssertNotCalled(t, "BumpFee", mock.Anything, mock.Anything)
+	})
+}
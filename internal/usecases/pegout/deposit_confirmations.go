@@ -0,0 +1,56 @@
+package pegout
+
+import (
+	"context"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+type DepositConfirmationsUseCase struct {
+	quoteRepository quote.PegoutQuoteRepository
+	rpc             blockchain.Rpc
+}
+
+func NewDepositConfirmationsUseCase(quoteRepository quote.PegoutQuoteRepository, rpc blockchain.Rpc) *DepositConfirmationsUseCase {
+	return &DepositConfirmationsUseCase{quoteRepository: quoteRepository, rpc: rpc}
+}
+
+// Run returns the confirmations of the RSK transaction that the user did to pay the quote. The confirmations
+// are counted the same way SendPegoutUseCase validates them (blocks mined after the one including the
+// transaction). If the deposit hasn't been detected yet, the result doesn't have a transaction hash
+func (useCase *DepositConfirmationsUseCase) Run(ctx context.Context, quoteHash string) (quote.DepositConfirmations, error) {
+	pegoutQuote, err := useCase.quoteRepository.GetQuote(ctx, quoteHash)
+	if err != nil {
+		return quote.DepositConfirmations{}, usecases.WrapUseCaseError(usecases.PegoutDepositConfirmationsId, err)
+	} else if pegoutQuote == nil {
+		return quote.DepositConfirmations{}, usecases.WrapUseCaseError(usecases.PegoutDepositConfirmationsId, usecases.QuoteNotFoundError)
+	}
+	retainedQuote, err := useCase.quoteRepository.GetRetainedQuote(ctx, quoteHash)
+	if err != nil {
+		return quote.DepositConfirmations{}, usecases.WrapUseCaseError(usecases.PegoutDepositConfirmationsId, err)
+	} else if retainedQuote == nil {
+		return quote.DepositConfirmations{}, usecases.WrapUseCaseError(usecases.PegoutDepositConfirmationsId, usecases.QuoteNotAcceptedError)
+	}
+
+	result := quote.DepositConfirmations{
+		TxHash:                retainedQuote.UserRskTxHash,
+		RequiredConfirmations: uint64(pegoutQuote.DepositConfirmations),
+	}
+	if result.TxHash == "" {
+		return result, nil
+	}
+	receipt, err := useCase.rpc.Rsk.GetTransactionReceipt(ctx, result.TxHash)
+	if err != nil {
+		return quote.DepositConfirmations{}, usecases.WrapUseCaseError(usecases.PegoutDepositConfirmationsId, err)
+	}
+	height, err := useCase.rpc.Rsk.GetHeight(ctx)
+	if err != nil {
+		return quote.DepositConfirmations{}, usecases.WrapUseCaseError(usecases.PegoutDepositConfirmationsId, err)
+	}
+	if height > receipt.BlockNumber {
+		result.Confirmations = height - receipt.BlockNumber
+	}
+	return result, nil
+}
@@ -0,0 +1,93 @@
+package pegout_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegout"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestDepositConfirmationsUseCase_Run(t *testing.T) {
+	const quoteHash = "quoteHash"
+	retainedQuote := quote.RetainedPegoutQuote{QuoteHash: quoteHash, State: quote.PegoutStateWaitingForDepositConfirmations, UserRskTxHash: "rsk tx hash"}
+	t.Run("Return the confirmations of the user deposit", func(t *testing.T) {
+		repo := new(mocks.PegoutQuoteRepositoryMock)
+		rsk := new(mocks.RootstockRpcServerMock)
+		repo.On("GetQuote", context.Background(), quoteHash).Return(&pegoutQuote, nil).Once()
+		repo.On("GetRetainedQuote", context.Background(), quoteHash).Return(&retainedQuote, nil).Once()
+		rsk.On("GetTransactionReceipt", context.Background(), "rsk tx hash").Return(blockchain.TransactionReceipt{BlockNumber: 100}, nil).Once()
+		rsk.On("GetHeight", context.Background()).Return(uint64(104), nil).Once()
+		useCase := pegout.NewDepositConfirmationsUseCase(repo, blockchain.Rpc{Rsk: rsk})
+		result, err := useCase.Run(context.Background(), quoteHash)
+		require.NoError(t, err)
+		assert.Equal(t, quote.DepositConfirmations{TxHash: "rsk tx hash", Confirmations: 4, RequiredConfirmations: 10}, result)
+		repo.AssertExpectations(t)
+		rsk.AssertExpectations(t)
+	})
+	t.Run("Return zero confirmations when the deposit wasn't detected", func(t *testing.T) {
+		repo := new(mocks.PegoutQuoteRepositoryMock)
+		rsk := new(mocks.RootstockRpcServerMock)
+		waitingQuote := quote.RetainedPegoutQuote{QuoteHash: quoteHash, State: quote.PegoutStateWaitingForDeposit}
+		repo.On("GetQuote", context.Background(), quoteHash).Return(&pegoutQuote, nil).Once()
+		repo.On("GetRetainedQuote", context.Background(), quoteHash).Return(&waitingQuote, nil).Once()
+		useCase := pegout.NewDepositConfirmationsUseCase(repo, blockchain.Rpc{Rsk: rsk})
+		result, err := useCase.Run(context.Background(), quoteHash)
+		require.NoError(t, err)
+		assert.Equal(t, quote.DepositConfirmations{RequiredConfirmations: 10}, result)
+		rsk.AssertNotCalled(t, "GetTransactionReceipt", mock.Anything, mock.Anything)
+	})
+	t.Run("Return not found error", func(t *testing.T) {
+		repo := new(mocks.PegoutQuoteRepositoryMock)
+		repo.On("GetQuote", context.Background(), quoteHash).Return(nil, nil).Once()
+		useCase := pegout.NewDepositConfirmationsUseCase(repo, blockchain.Rpc{})
+		_, err := useCase.Run(context.Background(), quoteHash)
+		require.ErrorIs(t, err, usecases.QuoteNotFoundError)
+	})
+	t.Run("Return not accepted error", func(t *testing.T) {
+		repo := new(mocks.PegoutQuoteRepositoryMock)
+		repo.On("GetQuote", context.Background(), quoteHash).Return(&pegoutQuote, nil).Once()
+		repo.On("GetRetainedQuote", context.Background(), quoteHash).Return(nil, nil).Once()
+		useCase := pegout.NewDepositConfirmationsUseCase(repo, blockchain.Rpc{})
+		_, err := useCase.Run(context.Background(), quoteHash)
+		require.ErrorIs(t, err, usecases.QuoteNotAcceptedError)
+	})
+	t.Run("Handle errors", func(t *testing.T) {
+		setups := []func(repo *mocks.PegoutQuoteRepositoryMock, rsk *mocks.RootstockRpcServerMock){
+			func(repo *mocks.PegoutQuoteRepositoryMock, rsk *mocks.RootstockRpcServerMock) {
+				repo.On("GetQuote", context.Background(), quoteHash).Return(nil, assert.AnError).Once()
+			},
+			func(repo *mocks.PegoutQuoteRepositoryMock, rsk *mocks.RootstockRpcServerMock) {
+				repo.On("GetQuote", context.Background(), quoteHash).Return(&pegoutQuote, nil).Once()
+				repo.On("GetRetainedQuote", context.Background(), quoteHash).Return(nil, assert.AnError).Once()
+			},
+			func(repo *mocks.PegoutQuoteRepositoryMock, rsk *mocks.RootstockRpcServerMock) {
+				repo.On("GetQuote", context.Background(), quoteHash).Return(&pegoutQuote, nil).Once()
+				repo.On("GetRetainedQuote", context.Background(), quoteHash).Return(&retainedQuote, nil).Once()
+				rsk.On("GetTransactionReceipt", context.Background(), "rsk tx hash").Return(blockchain.TransactionReceipt{}, assert.AnError).Once()
+			},
+			func(repo *mocks.PegoutQuoteRepositoryMock, rsk *mocks.RootstockRpcServerMock) {
+				repo.On("GetQuote", context.Background(), quoteHash).Return(&pegoutQuote, nil).Once()
+				repo.On("GetRetainedQuote", context.Background(), quoteHash).Return(&retainedQuote, nil).Once()
+				rsk.On("GetTransactionReceipt", context.Background(), "rsk tx hash").Return(blockchain.TransactionReceipt{BlockNumber: 100}, nil).Once()
+				rsk.On("GetHeight", context.Background()).Return(uint64(0), assert.AnError).Once()
+			},
+		}
+		for _, setup := range setups {
+			repo := new(mocks.PegoutQuoteRepositoryMock)
+			rsk := new(mocks.RootstockRpcServerMock)
+			setup(repo, rsk)
+			useCase := pegout.NewDepositConfirmationsUseCase(repo, blockchain.Rpc{Rsk: rsk})
+			result, err := useCase.Run(context.Background(), quoteHash)
+			require.ErrorIs(t, err, assert.AnError)
+			assert.Empty(t, result)
+		}
+	})
+}
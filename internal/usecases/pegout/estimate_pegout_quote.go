@@ -0,0 +1,31 @@
+package pegout
+
+import (
+	"context"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+// EstimateQuoteUseCase computes the same quote that GetQuoteUseCase would create for a request, but the quote is
+// not hashed nor stored, so it can't be accepted. It is meant to show the price of an operation to the user
+// before requesting the actual quote.
+type EstimateQuoteUseCase struct {
+	getQuoteUseCase *GetQuoteUseCase
+}
+
+func NewEstimateQuoteUseCase(getQuoteUseCase *GetQuoteUseCase) *EstimateQuoteUseCase {
+	return &EstimateQuoteUseCase{getQuoteUseCase: getQuoteUseCase}
+}
+
+func (useCase *EstimateQuoteUseCase) Run(ctx context.Context, request QuoteRequest) (quote.PegoutQuote, error) {
+	batch, err := useCase.getQuoteUseCase.newQuoteBatch(ctx, request.partnerAuth)
+	if err != nil {
+		return quote.PegoutQuote{}, usecases.WrapUseCaseError(usecases.EstimatePegoutQuoteId, err)
+	}
+	pegoutQuote, _, err := useCase.getQuoteUseCase.buildQuote(ctx, batch, request)
+	if err != nil {
+		return quote.PegoutQuote{}, usecases.WrapUseCaseError(usecases.EstimatePegoutQuoteId, err)
+	}
+	return pegoutQuote, nil
+}
@@ -0,0 +1,90 @@
+package pegout_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegout"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateQuoteUseCase_Run(t *testing.T) {
+	const (
+		toAddress        = "mvL2bVzGUeC9oqVyQWJ4PxQspFzKgjzAqe"
+		rskRefundAddress = "0x79568c2989232dCa1840087D73d403602364c0D4"
+	)
+	value := entities.NewWei(1000000000000000000)
+	rsk := new(mocks.RootstockRpcServerMock)
+	rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil).Once()
+	rsk.On("GetHeight", test.AnyCtx).Return(uint64(100), nil).Once()
+	rsk.EXPECT().ChainId(mock.Anything).Return(31, nil).Once()
+	pegoutContract := new(mocks.PegoutContractMock)
+	pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil).Once()
+	pegoutContract.On("GetAddress").Return("0x1234")
+	pegoutQuoteRepository := new(mocks.PegoutQuoteRepositoryMock)
+	lp := new(mocks.ProviderMock)
+	lp.On("PegoutConfiguration", test.AnyCtx).Return(getPegoutConfiguration()).Once()
+	lp.On("GeneralConfiguration", test.AnyCtx).Return(getGeneralConfiguration())
+	lp.On("RskAddress").Return("0x12ab")
+	lp.On("BtcAddress").Return("address")
+	btcWallet := new(mocks.BitcoinWalletMock)
+	btcWallet.On("EstimateTxFees", toAddress, value).Return(blockchain.BtcFeeEstimation{
+		Value:   entities.NewWei(1000000000000000),
+		FeeRate: utils.NewBigFloat64(25.333),
+	}, nil).Once()
+	btc := new(mocks.BtcRpcMock)
+	btc.On("ValidateAddress", mock.Anything).Return(nil)
+	contracts := blockchain.RskContracts{PegOut: pegoutContract}
+	rpc := blockchain.Rpc{Btc: btc, Rsk: rsk}
+	useCase := pegout.NewEstimateQuoteUseCase(pegout.NewGetQuoteUseCase(rpc, contracts, pegoutQuoteRepository, lp, lp, btcWallet, nil, nil))
+
+	result, err := useCase.Run(context.Background(), pegout.NewQuoteRequest(toAddress, value, rskRefundAddress))
+	require.NoError(t, err)
+	assert.Equal(t, value, result.Value)
+	assert.Equal(t, entities.NewWei(1000000000000000), result.GasFee)
+	assert.Equal(t, uint32(100+getPegoutConfiguration().ExpireBlocks), result.ExpireBlock)
+
+	pegoutContract.AssertNotCalled(t, "HashPegoutQuote", mock.Anything)
+	pegoutQuoteRepository.AssertNotCalled(t, "InsertQuote", mock.Anything, mock.Anything)
+	rsk.AssertExpectations(t)
+	pegoutContract.AssertExpectations(t)
+	lp.AssertExpectations(t)
+	btcWallet.AssertExpectations(t)
+}
+
+func TestEstimateQuoteUseCase_Run_ErrorHandling(t *testing.T) {
+	t.Run("should return error if the protocol is paused", func(t *testing.T) {
+		pegoutContract := new(mocks.PegoutContractMock)
+		pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: true, Since: 5, Reason: "test"}, nil).Once()
+		pegoutContract.EXPECT().GetAddress().Return("test-contract")
+		useCase := pegout.NewEstimateQuoteUseCase(pegout.NewGetQuoteUseCase(blockchain.Rpc{}, blockchain.RskContracts{PegOut: pegoutContract}, nil, nil, nil, nil, nil, nil))
+		result, err := useCase.Run(context.Background(), pegout.NewQuoteRequest("mvL2bVzGUeC9oqVyQWJ4PxQspFzKgjzAqe", entities.NewWei(1), "0x79568c2989232dCa1840087D73d403602364c0D4"))
+		require.ErrorIs(t, err, blockchain.ContractPausedError)
+		assert.Equal(t, quote.PegoutQuote{}, result)
+	})
+	t.Run("should return error if the fee estimation fails", func(t *testing.T) {
+		const toAddress = "mvL2bVzGUeC9oqVyQWJ4PxQspFzKgjzAqe"
+		value := entities.NewWei(1000000000000000000)
+		pegoutContract := new(mocks.PegoutContractMock)
+		pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil).Once()
+		lp := new(mocks.ProviderMock)
+		lp.On("PegoutConfiguration", test.AnyCtx).Return(getPegoutConfiguration()).Once()
+		btc := new(mocks.BtcRpcMock)
+		btc.On("ValidateAddress", mock.Anything).Return(nil)
+		btcWallet := new(mocks.BitcoinWalletMock)
+		btcWallet.On("EstimateTxFees", toAddress, value).Return(blockchain.BtcFeeEstimation{}, assert.AnError).Once()
+		rpc := blockchain.Rpc{Btc: btc}
+		useCase := pegout.NewEstimateQuoteUseCase(pegout.NewGetQuoteUseCase(rpc, blockchain.RskContracts{PegOut: pegoutContract}, nil, lp, lp, btcWallet, nil, nil))
+		result, err := useCase.Run(context.Background(), pegout.NewQuoteRequest(toAddress, value, "0x79568c2989232dCa1840087D73d403602364c0D4"))
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, quote.PegoutQuote{}, result)
+	})
+}
@@ -2,16 +2,18 @@ package pegout
 
 import (
 	"context"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
 )
 
 type ExpiredPegoutQuoteUseCase struct {
 	pegoutRepository quote.PegoutQuoteRepository
+	eventBus         entities.EventBus
 }
 
-func NewExpiredPegoutQuoteUseCase(pegoutRepository quote.PegoutQuoteRepository) *ExpiredPegoutQuoteUseCase {
-	return &ExpiredPegoutQuoteUseCase{pegoutRepository: pegoutRepository}
+func NewExpiredPegoutQuoteUseCase(pegoutRepository quote.PegoutQuoteRepository, eventBus entities.EventBus) *ExpiredPegoutQuoteUseCase {
+	return &ExpiredPegoutQuoteUseCase{pegoutRepository: pegoutRepository, eventBus: eventBus}
 }
 
 func (useCase *ExpiredPegoutQuoteUseCase) Run(ctx context.Context, pegoutQuote quote.RetainedPegoutQuote) error {
@@ -20,5 +22,9 @@ func (useCase *ExpiredPegoutQuoteUseCase) Run(ctx context.Context, pegoutQuote q
 	if err != nil {
 		return usecases.WrapUseCaseError(usecases.ExpiredPegoutQuoteId, err)
 	}
+	useCase.eventBus.Publish(quote.PegoutStateUpdatedEvent{
+		Event:         entities.NewBaseEvent(quote.PegoutStateUpdatedEventId),
+		RetainedQuote: pegoutQuote,
+	})
 	return nil
 }
@@ -25,9 +25,14 @@ func TestExpiredPegoutQuoteUseCase_Run(t *testing.T) {
 	expectedRetainedQuote.State = quote.PegoutStateTimeForDepositElapsed
 	pegoutQuoteRepository := new(mocks.PegoutQuoteRepositoryMock)
 	pegoutQuoteRepository.On("UpdateRetainedQuote", mock.AnythingOfType("context.backgroundCtx"), expectedRetainedQuote).Return(nil)
-	useCase := pegout.NewExpiredPegoutQuoteUseCase(pegoutQuoteRepository)
+	eventBus := new(mocks.EventBusMock)
+	eventBus.On("Publish", mock.MatchedBy(func(event quote.PegoutStateUpdatedEvent) bool {
+		return assert.Equal(t, expectedRetainedQuote, event.RetainedQuote) && assert.Equal(t, quote.PegoutStateUpdatedEventId, event.Id())
+	})).Return().Once()
+	useCase := pegout.NewExpiredPegoutQuoteUseCase(pegoutQuoteRepository, eventBus)
 	err := useCase.Run(context.Background(), retainedQuote)
 	pegoutQuoteRepository.AssertExpectations(t)
+	eventBus.AssertExpectations(t)
 	require.NoError(t, err)
 }
 
@@ -41,8 +46,10 @@ func TestExpiredPegoutQuoteUseCase_Run_ErrorHandling(t *testing.T) {
 	}
 	pegoutQuoteRepository := new(mocks.PegoutQuoteRepositoryMock)
 	pegoutQuoteRepository.On("UpdateRetainedQuote", mock.AnythingOfType("context.backgroundCtx"), mock.Anything).Return(assert.AnError)
-	useCase := pegout.NewExpiredPegoutQuoteUseCase(pegoutQuoteRepository)
+	eventBus := new(mocks.EventBusMock)
+	useCase := pegout.NewExpiredPegoutQuoteUseCase(pegoutQuoteRepository, eventBus)
 	err := useCase.Run(context.Background(), retainedQuote)
 	pegoutQuoteRepository.AssertExpectations(t)
+	eventBus.AssertNotCalled(t, "Publish", mock.Anything)
 	require.Error(t, err)
 }
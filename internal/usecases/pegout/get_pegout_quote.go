@@ -13,12 +13,14 @@ import (
 )
 
 type GetQuoteUseCase struct {
-	rpc                   blockchain.Rpc
-	contracts             blockchain.RskContracts
-	pegoutQuoteRepository quote.PegoutQuoteRepository
-	lp                    liquidity_provider.LiquidityProvider
-	pegoutLp              liquidity_provider.PegoutLiquidityProvider
-	btcWallet             blockchain.BitcoinWallet
+	rpc                      blockchain.Rpc
+	contracts                blockchain.RskContracts
+	pegoutQuoteRepository    quote.PegoutQuoteRepository
+	lp                       liquidity_provider.LiquidityProvider
+	pegoutLp                 liquidity_provider.PegoutLiquidityProvider
+	btcWallet                blockchain.BitcoinWallet
+	trustedAccountRepository liquidity_provider.TrustedAccountRepository
+	hashFunction             entities.HashFunction
 }
 
 func NewGetQuoteUseCase(
@@ -28,14 +30,18 @@ func NewGetQuoteUseCase(
 	lp liquidity_provider.LiquidityProvider,
 	pegoutLp liquidity_provider.PegoutLiquidityProvider,
 	btcWallet blockchain.BitcoinWallet,
+	trustedAccountRepository liquidity_provider.TrustedAccountRepository,
+	hashFunction entities.HashFunction,
 ) *GetQuoteUseCase {
 	return &GetQuoteUseCase{
-		rpc:                   rpc,
-		contracts:             contracts,
-		pegoutQuoteRepository: pegoutQuoteRepository,
-		lp:                    lp,
-		pegoutLp:              pegoutLp,
-		btcWallet:             btcWallet,
+		rpc:                      rpc,
+		contracts:                contracts,
+		pegoutQuoteRepository:    pegoutQuoteRepository,
+		lp:                       lp,
+		pegoutLp:                 pegoutLp,
+		btcWallet:                btcWallet,
+		trustedAccountRepository: trustedAccountRepository,
+		hashFunction:             hashFunction,
 	}
 }
 
@@ -43,6 +49,7 @@ type QuoteRequest struct {
 	to               string
 	valueToTransfer  *entities.Wei
 	rskRefundAddress string
+	partnerAuth      *usecases.PartnerAuthentication
 }
 
 func NewQuoteRequest(
@@ -57,37 +64,88 @@ func NewQuoteRequest(
 	}
 }
 
+// WithPartnerAuthentication returns a copy of the request authenticated by a trusted account, so the quote
+// is created with the terms of that account
+func (request QuoteRequest) WithPartnerAuthentication(auth usecases.PartnerAuthentication) QuoteRequest {
+	request.partnerAuth = &auth
+	return request
+}
+
 type GetPegoutQuoteResult struct {
 	PegoutQuote quote.PegoutQuote
 	Hash        string
 }
 
+// quoteBatch keeps the values that are shared by all the quotes created in the same request, so they are
+// fetched only once per batch
+type quoteBatch struct {
+	configuration     liquidity_provider.PegoutConfiguration
+	feeConditions     *liquidity_provider.FeeConditions
+	gasPrice          *entities.Wei
+	btcFeeEstimations map[string]blockchain.BtcFeeEstimation
+}
+
 func (useCase *GetQuoteUseCase) Run(ctx context.Context, request QuoteRequest) (GetPegoutQuoteResult, error) {
+	batch, err := useCase.newQuoteBatch(ctx, request.partnerAuth)
+	if err != nil {
+		return GetPegoutQuoteResult{}, usecases.WrapUseCaseError(usecases.GetPegoutQuoteId, err)
+	}
+	return useCase.runInBatch(ctx, batch, request)
+}
+
+func (useCase *GetQuoteUseCase) newQuoteBatch(ctx context.Context, partnerAuth *usecases.PartnerAuthentication) (*quoteBatch, error) {
+	var err error
+	if err = usecases.CheckPauseState(useCase.contracts.PegOut); err != nil {
+		return nil, err
+	}
+	configuration := useCase.pegoutLp.PegoutConfiguration(ctx)
+	if partnerAuth != nil {
+		if configuration, err = useCase.applyPartnerTerms(ctx, configuration, *partnerAuth); err != nil {
+			return nil, err
+		}
+	}
+	return &quoteBatch{configuration: configuration, btcFeeEstimations: make(map[string]blockchain.BtcFeeEstimation)}, nil
+}
+
+func (useCase *GetQuoteUseCase) runInBatch(ctx context.Context, batch *quoteBatch, request QuoteRequest) (GetPegoutQuoteResult, error) {
+	pegoutQuote, creationData, err := useCase.buildQuote(ctx, batch, request)
+	if err != nil {
+		return GetPegoutQuoteResult{}, err
+	}
+	hash, err := useCase.persistQuote(ctx, pegoutQuote, creationData)
+	if err != nil {
+		return GetPegoutQuoteResult{}, err
+	}
+	return GetPegoutQuoteResult{PegoutQuote: pegoutQuote, Hash: hash}, nil
+}
+
+// buildQuote computes the quote for a request without storing it
+func (useCase *GetQuoteUseCase) buildQuote(ctx context.Context, batch *quoteBatch, request QuoteRequest) (quote.PegoutQuote, quote.PegoutCreationData, error) {
 	var pegoutQuote quote.PegoutQuote
-	var hash string
 	var errorArgs usecases.ErrorArgs
 	var btcFeeEstimation blockchain.BtcFeeEstimation
 	var creationData quote.PegoutCreationData
+	var feeConditions liquidity_provider.FeeConditions
 	var err error
 
-	if err = usecases.CheckPauseState(useCase.contracts.PegOut); err != nil {
-		return GetPegoutQuoteResult{}, usecases.WrapUseCaseError(usecases.GetPegoutQuoteId, err)
+	if errorArgs, err = useCase.validateRequest(batch.configuration, request); err != nil {
+		return quote.PegoutQuote{}, quote.PegoutCreationData{}, usecases.WrapUseCaseErrorArgs(usecases.GetPegoutQuoteId, err, errorArgs)
 	}
 
-	configuration := useCase.pegoutLp.PegoutConfiguration(ctx)
-	if errorArgs, err = useCase.validateRequest(configuration, request); err != nil {
-		return GetPegoutQuoteResult{}, usecases.WrapUseCaseErrorArgs(usecases.GetPegoutQuoteId, err, errorArgs)
-	}
-
-	if btcFeeEstimation, err = useCase.btcWallet.EstimateTxFees(request.to, request.valueToTransfer); err != nil &&
+	if btcFeeEstimation, err = useCase.estimateTxFees(batch, request); err != nil &&
 		strings.Contains(strings.ToLower(err.Error()), "insufficient funds") {
-		return GetPegoutQuoteResult{}, usecases.WrapUseCaseError(usecases.GetPegoutQuoteId, usecases.NoLiquidityError)
+		return quote.PegoutQuote{}, quote.PegoutCreationData{}, usecases.WrapUseCaseError(usecases.GetPegoutQuoteId, usecases.NoLiquidityError)
 	} else if err != nil {
-		return GetPegoutQuoteResult{}, usecases.WrapUseCaseError(usecases.GetPegoutQuoteId, err)
+		return quote.PegoutQuote{}, quote.PegoutCreationData{}, usecases.WrapUseCaseError(usecases.GetPegoutQuoteId, err)
 	}
 
-	if creationData, err = useCase.buildCreationData(ctx, btcFeeEstimation, configuration); err != nil {
-		return GetPegoutQuoteResult{}, err
+	if feeConditions, err = useCase.getFeeConditions(ctx, batch); err != nil {
+		return quote.PegoutQuote{}, quote.PegoutCreationData{}, usecases.WrapUseCaseError(usecases.GetPegoutQuoteId, err)
+	}
+	configuration := batch.configuration.ForQuote(request.valueToTransfer, feeConditions)
+
+	if creationData, err = useCase.buildCreationData(ctx, batch, btcFeeEstimation, configuration); err != nil {
+		return quote.PegoutQuote{}, quote.PegoutCreationData{}, err
 	}
 
 	fees := quote.Fees{
@@ -96,14 +154,49 @@ func (useCase *GetQuoteUseCase) Run(ctx context.Context, request QuoteRequest) (
 		PenaltyFee: configuration.PenaltyFee,
 	}
 	if pegoutQuote, err = useCase.buildPegoutQuote(ctx, configuration, request, fees); err != nil {
-		return GetPegoutQuoteResult{}, err
+		return quote.PegoutQuote{}, quote.PegoutCreationData{}, err
 	}
 
-	if hash, err = useCase.persistQuote(ctx, pegoutQuote, creationData); err != nil {
-		return GetPegoutQuoteResult{}, err
+	return pegoutQuote, creationData, nil
+}
+
+// estimateTxFees estimates the fee of the BTC transaction to the user, requests with the same destination and
+// value in the same batch share the estimation
+func (useCase *GetQuoteUseCase) estimateTxFees(batch *quoteBatch, request QuoteRequest) (blockchain.BtcFeeEstimation, error) {
+	key := request.to + ":" + request.valueToTransfer.String()
+	if estimation, ok := batch.btcFeeEstimations[key]; ok {
+		return estimation, nil
 	}
+	estimation, err := useCase.btcWallet.EstimateTxFees(request.to, request.valueToTransfer)
+	if err != nil {
+		return blockchain.BtcFeeEstimation{}, err
+	}
+	batch.btcFeeEstimations[key] = estimation
+	return estimation, nil
+}
 
-	return GetPegoutQuoteResult{PegoutQuote: pegoutQuote, Hash: hash}, nil
+func (useCase *GetQuoteUseCase) getFeeConditions(ctx context.Context, batch *quoteBatch) (liquidity_provider.FeeConditions, error) {
+	if batch.feeConditions != nil {
+		return *batch.feeConditions, nil
+	}
+	feeConditions, err := usecases.GetFeeConditions(ctx, batch.configuration.FeeSurcharges, useCase.pegoutLp.AvailablePegoutLiquidity)
+	if err != nil {
+		return liquidity_provider.FeeConditions{}, err
+	}
+	batch.feeConditions = &feeConditions
+	return feeConditions, nil
+}
+
+func (useCase *GetQuoteUseCase) applyPartnerTerms(
+	ctx context.Context,
+	configuration liquidity_provider.PegoutConfiguration,
+	auth usecases.PartnerAuthentication,
+) (liquidity_provider.PegoutConfiguration, error) {
+	partner, err := usecases.GetPartnerAccount(ctx, auth, useCase.lp.GetSigner(), useCase.hashFunction, useCase.trustedAccountRepository)
+	if err != nil {
+		return liquidity_provider.PegoutConfiguration{}, err
+	}
+	return configuration.WithPartnerTerms(partner.PegoutTerms), nil
 }
 
 func (useCase *GetQuoteUseCase) validateRequest(configuration liquidity_provider.PegoutConfiguration, request QuoteRequest) (usecases.ErrorArgs, error) {
@@ -194,18 +287,20 @@ func (useCase *GetQuoteUseCase) persistQuote(ctx context.Context, pegoutQuote qu
 
 func (useCase *GetQuoteUseCase) buildCreationData(
 	ctx context.Context,
+	batch *quoteBatch,
 	btcFeeEstimation blockchain.BtcFeeEstimation,
 	configuration liquidity_provider.PegoutConfiguration,
 ) (quote.PegoutCreationData, error) {
-	var gasPrice *entities.Wei
 	var err error
 
-	if gasPrice, err = useCase.rpc.Rsk.GasPrice(ctx); err != nil {
-		return quote.PegoutCreationData{}, usecases.WrapUseCaseError(usecases.GetPegoutQuoteId, err)
+	if batch.gasPrice == nil {
+		if batch.gasPrice, err = useCase.rpc.Rsk.QuoteGasPrice(ctx); err != nil {
+			return quote.PegoutCreationData{}, usecases.WrapUseCaseError(usecases.GetPegoutQuoteId, err)
+		}
 	}
 	creationData := quote.PegoutCreationData{
 		FeeRate:       btcFeeEstimation.FeeRate,
-		GasPrice:      gasPrice,
+		GasPrice:      batch.gasPrice,
 		FeePercentage: configuration.FeePercentage,
 		FixedFee:      configuration.FixedFee,
 	}
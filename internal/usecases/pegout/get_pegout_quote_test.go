@@ -2,9 +2,13 @@ package pegout_test
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
 	lpEntity "github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
@@ -31,7 +35,7 @@ func TestGetQuoteUseCase_Run_Paused(t *testing.T) {
 	btc := new(mocks.BtcRpcMock)
 	contracts := blockchain.RskContracts{PegOut: pegoutContract, Bridge: bridge}
 	rpc := blockchain.Rpc{Btc: btc, Rsk: rsk}
-	useCase := pegout.NewGetQuoteUseCase(rpc, contracts, pegoutQuoteRepository, lp, lp, btcWallet)
+	useCase := pegout.NewGetQuoteUseCase(rpc, contracts, pegoutQuoteRepository, lp, lp, btcWallet, nil, nil)
 	request := pegout.NewQuoteRequest(test.AnyBtcAddress, entities.NewWei(1000000000000000000), test.AnyRskAddress)
 	result, err := useCase.Run(context.Background(), request)
 	assert.Empty(t, result)
@@ -48,7 +52,7 @@ func TestGetQuoteUseCase_Run(t *testing.T) {
 		lpBtcAddress     = "address"
 	)
 	rsk := new(mocks.RootstockRpcServerMock)
-	rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil)
+	rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil)
 	rsk.On("GetHeight", test.AnyCtx).Return(uint64(100), nil)
 	rsk.EXPECT().ChainId(mock.Anything).Return(31, nil).Once()
 	pegoutContract := new(mocks.PegoutContractMock)
@@ -76,7 +80,7 @@ func TestGetQuoteUseCase_Run(t *testing.T) {
 	btc.On("ValidateAddress", mock.Anything).Return(nil)
 	contracts := blockchain.RskContracts{PegOut: pegoutContract, Bridge: bridge}
 	rpc := blockchain.Rpc{Btc: btc, Rsk: rsk}
-	useCase := pegout.NewGetQuoteUseCase(rpc, contracts, pegoutQuoteRepository, lp, lp, btcWallet)
+	useCase := pegout.NewGetQuoteUseCase(rpc, contracts, pegoutQuoteRepository, lp, lp, btcWallet, nil, nil)
 	request := pegout.NewQuoteRequest(toAddress, entities.NewWei(1000000000000000000), rskRefundAddress)
 	result, err := useCase.Run(context.Background(), request)
 	rsk.AssertExpectations(t)
@@ -109,6 +113,55 @@ func TestGetQuoteUseCase_Run(t *testing.T) {
 	assert.Equal(t, lpRskAddress, result.PegoutQuote.LpRskAddress)
 }
 
+func TestGetQuoteUseCase_Run_FeeSchedule(t *testing.T) {
+	rsk := new(mocks.RootstockRpcServerMock)
+	rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil)
+	rsk.On("GetHeight", test.AnyCtx).Return(uint64(100), nil)
+	rsk.EXPECT().ChainId(mock.Anything).Return(31, nil).Once()
+	pegoutContract := new(mocks.PegoutContractMock)
+	pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil)
+	pegoutContract.On("GetAddress").Return("0x1234")
+	pegoutContract.On("HashPegoutQuote", mock.Anything).Return("0x9876543210", nil)
+	pegoutQuoteRepository := new(mocks.PegoutQuoteRepositoryMock)
+	pegoutQuoteRepository.On("InsertQuote", test.AnyCtx, mock.Anything).Return(nil)
+	configuration := getPegoutConfiguration()
+	configuration.FeeTiers = lpEntity.FeeTiers{
+		{MaxAmount: entities.NewWei(2000000000000000000), FixedFee: entities.NewWei(100), FeePercentage: utils.NewBigFloat64(1)},
+	}
+	configuration.FeeSurcharges = &lpEntity.FeeSurcharges{
+		Utilization: []lpEntity.UtilizationSurcharge{
+			{Threshold: lpEntity.LiquidityThreshold{Amount: entities.NewWei(5000000000000000000)}, FeePercentage: utils.NewBigFloat64(0.5)},
+		},
+	}
+	lp := new(mocks.ProviderMock)
+	lp.On("PegoutConfiguration", test.AnyCtx).Return(configuration)
+	lp.On("GeneralConfiguration", test.AnyCtx).Return(getGeneralConfiguration())
+	lp.On("AvailablePegoutLiquidity", test.AnyCtx).Return(entities.NewWei(1000000000000000000), nil).Once()
+	lp.On("RskAddress").Return("0x12ab")
+	lp.On("BtcAddress").Return("address")
+	btcWallet := new(mocks.BitcoinWalletMock)
+	btcWallet.On("EstimateTxFees", mock.Anything, mock.Anything).Return(blockchain.BtcFeeEstimation{
+		Value:   entities.NewWei(1000000000000000),
+		FeeRate: utils.NewBigFloat64(25.333),
+	}, nil)
+	btc := new(mocks.BtcRpcMock)
+	btc.On("ValidateAddress", mock.Anything).Return(nil)
+	contracts := blockchain.RskContracts{PegOut: pegoutContract, Bridge: new(mocks.BridgeMock)}
+	useCase := pegout.NewGetQuoteUseCase(blockchain.Rpc{Btc: btc, Rsk: rsk}, contracts, pegoutQuoteRepository, lp, lp, btcWallet, nil, nil)
+	request := pegout.NewQuoteRequest("mvL2bVzGUeC9oqVyQWJ4PxQspFzKgjzAqe", entities.NewWei(1000000000000000000), "0x79568c2989232dCa1840087D73d403602364c0D4")
+
+	result, err := useCase.Run(context.Background(), request)
+	require.NoError(t, err)
+	lp.AssertExpectations(t)
+	// tier fixed fee plus tier percentage (1%) and utilization surcharge (0.5%)
+	assert.Equal(t, entities.NewWei(15000000000000100), result.PegoutQuote.CallFee)
+
+	lp.On("AvailablePegoutLiquidity", test.AnyCtx).Return((*entities.Wei)(nil), assert.AnError).Once()
+	result, err = useCase.Run(context.Background(), request)
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
 func TestGetQuoteUseCase_Run_ValidateRequest(t *testing.T) {
 	rsk := new(mocks.RootstockRpcServerMock)
 	bridge := new(mocks.BridgeMock)
@@ -122,7 +175,7 @@ func TestGetQuoteUseCase_Run_ValidateRequest(t *testing.T) {
 		lp := new(mocks.ProviderMock)
 		contracts := blockchain.RskContracts{PegOut: pegoutContract, Bridge: bridge}
 		rpc := blockchain.Rpc{Btc: btc, Rsk: rsk}
-		useCase := pegout.NewGetQuoteUseCase(rpc, contracts, pegoutQuoteRepository, lp, lp, btcWallet)
+		useCase := pegout.NewGetQuoteUseCase(rpc, contracts, pegoutQuoteRepository, lp, lp, btcWallet, nil, nil)
 		result, err := useCase.Run(context.Background(), testCase.Value(btc, lp))
 		assert.Equal(t, pegout.GetPegoutQuoteResult{}, result)
 		require.Error(t, err)
@@ -207,7 +260,7 @@ func TestGetQuoteUseCase_Run_ErrorHandling(t *testing.T) {
 		btc.On("ValidateAddress", mock.Anything).Return(nil)
 		contracts := blockchain.RskContracts{PegOut: pegoutContract, Bridge: bridge}
 		rpc := blockchain.Rpc{Btc: btc, Rsk: rsk}
-		useCase := pegout.NewGetQuoteUseCase(rpc, contracts, pegoutQuoteRepository, lp, lp, btcWallet)
+		useCase := pegout.NewGetQuoteUseCase(rpc, contracts, pegoutQuoteRepository, lp, lp, btcWallet, nil, nil)
 		result, err := useCase.Run(context.Background(), request)
 		assert.Equal(t, pegout.GetPegoutQuoteResult{}, result)
 		require.Error(t, err)
@@ -232,7 +285,7 @@ func getQuoteUseCaseUnexpectedErrorSetups() test.Table[func(
 		{
 			Value: func(rsk *mocks.RootstockRpcServerMock, bridge *mocks.BridgeMock,
 				pegoutContract *mocks.PegoutContractMock, lp *mocks.ProviderMock, btcWallet *mocks.BitcoinWalletMock, pegoutQuoteRepository *mocks.PegoutQuoteRepositoryMock) {
-				rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(0), assert.AnError)
+				rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(0), assert.AnError)
 				btcWallet.On("EstimateTxFees", mock.Anything, mock.Anything).Return(feeEstimation, nil)
 			},
 		},
@@ -251,7 +304,7 @@ func getQuoteUseCaseUnexpectedErrorSetups() test.Table[func(
 		{
 			Value: func(rsk *mocks.RootstockRpcServerMock, bridge *mocks.BridgeMock,
 				pegoutContract *mocks.PegoutContractMock, lp *mocks.ProviderMock, btcWallet *mocks.BitcoinWalletMock, pegoutQuoteRepository *mocks.PegoutQuoteRepositoryMock) {
-				rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil)
+				rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil)
 				rsk.On("GetHeight", test.AnyCtx).Return(uint64(0), assert.AnError)
 				btcWallet.On("EstimateTxFees", mock.Anything, mock.Anything).Return(feeEstimation, nil)
 			},
@@ -259,7 +312,7 @@ func getQuoteUseCaseUnexpectedErrorSetups() test.Table[func(
 		{
 			Value: func(rsk *mocks.RootstockRpcServerMock, bridge *mocks.BridgeMock,
 				pegoutContract *mocks.PegoutContractMock, lp *mocks.ProviderMock, btcWallet *mocks.BitcoinWalletMock, pegoutQuoteRepository *mocks.PegoutQuoteRepositoryMock) {
-				rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil)
+				rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil)
 				rsk.On("GetHeight", test.AnyCtx).Return(uint64(100), nil)
 				rsk.EXPECT().ChainId(mock.Anything).Return(0, assert.AnError).Once()
 				btcWallet.On("EstimateTxFees", mock.Anything, mock.Anything).Return(feeEstimation, nil)
@@ -268,7 +321,7 @@ func getQuoteUseCaseUnexpectedErrorSetups() test.Table[func(
 		{
 			Value: func(rsk *mocks.RootstockRpcServerMock, bridge *mocks.BridgeMock,
 				pegoutContract *mocks.PegoutContractMock, lp *mocks.ProviderMock, btcWallet *mocks.BitcoinWalletMock, pegoutQuoteRepository *mocks.PegoutQuoteRepositoryMock) {
-				rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil)
+				rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil)
 				rsk.On("GetHeight", test.AnyCtx).Return(uint64(100), nil)
 				rsk.EXPECT().ChainId(mock.Anything).Return(31, nil).Once()
 				pegoutContract.On("GetAddress").Return("0x1234")
@@ -280,7 +333,7 @@ func getQuoteUseCaseUnexpectedErrorSetups() test.Table[func(
 		{
 			Value: func(rsk *mocks.RootstockRpcServerMock, bridge *mocks.BridgeMock,
 				pegoutContract *mocks.PegoutContractMock, lp *mocks.ProviderMock, btcWallet *mocks.BitcoinWalletMock, pegoutQuoteRepository *mocks.PegoutQuoteRepositoryMock) {
-				rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil)
+				rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil)
 				rsk.On("GetHeight", test.AnyCtx).Return(uint64(100), nil)
 				rsk.EXPECT().ChainId(mock.Anything).Return(31, nil).Once()
 				pegoutContract.On("GetAddress").Return("0x1234")
@@ -301,7 +354,7 @@ func getQuoteUseCaseUnexpectedErrorSetups() test.Table[func(
 		{
 			Value: func(rsk *mocks.RootstockRpcServerMock, bridge *mocks.BridgeMock,
 				pegoutContract *mocks.PegoutContractMock, lp *mocks.ProviderMock, btcWallet *mocks.BitcoinWalletMock, pegoutQuoteRepository *mocks.PegoutQuoteRepositoryMock) {
-				rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil)
+				rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil)
 				rsk.On("GetHeight", test.AnyCtx).Return(uint64(100), nil)
 				rsk.EXPECT().ChainId(mock.Anything).Return(31, nil).Once()
 				pegoutContract.On("GetAddress").Return("0x1234")
@@ -339,3 +392,95 @@ func getPegoutConfiguration() lpEntity.PegoutConfiguration {
 func getGeneralConfiguration() lpEntity.GeneralConfiguration {
 	return lpEntity.GeneralConfiguration{RskConfirmations: map[string]uint16{"1": 10}, BtcConfirmations: map[string]uint16{"1": 10}}
 }
+
+// nolint:funlen
+func TestGetQuoteUseCase_Run_PartnerTerms(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	partnerAddress := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	partner := lpEntity.TrustedAccountDetails{
+		Address:        partnerAddress,
+		Name:           "partner",
+		BtcLockingCap:  entities.NewWei(100000),
+		RbtcLockingCap: entities.NewWei(100000),
+		PegoutTerms: &lpEntity.PartnerTerms{
+			FixedFee:      entities.NewWei(100),
+			FeePercentage: utils.NewBigFloat64(1),
+			MinValue:      entities.NewWei(10000000000000000),
+			QuoteValidity: 1200,
+		},
+	}
+	partnerBytes, err := json.Marshal(partner)
+	require.NoError(t, err)
+	signedPartner := &entities.Signed[lpEntity.TrustedAccountDetails]{
+		Value: partner, Hash: hex.EncodeToString(crypto.Keccak256(partnerBytes)), Signature: "01",
+	}
+	quoteValue := entities.NewWei(50000000000000000)
+	payload := []byte(`{"valueToTransfer":50000000000000000}`)
+	now := time.Now().Unix()
+	request := pegout.NewQuoteRequest(test.AnyBtcAddress, quoteValue, test.AnyRskAddress).
+		WithPartnerAuthentication(usecases.PartnerAuthentication{Signature: test.SignPartnerRequest(t, key, now, payload), Timestamp: now, Payload: payload})
+
+	t.Run("should create the quote with the partner terms", func(t *testing.T) {
+		signer := &mocks.SignerMock{}
+		signer.On("Validate", signedPartner.Signature, signedPartner.Hash).Return(true).Once()
+		partnerRepository := &mocks.TrustedAccountRepositoryMock{}
+		partnerRepository.EXPECT().GetTrustedAccount(mock.Anything, partnerAddress).Return(signedPartner, nil).Once()
+		partnerRepository.EXPECT().RegisterPartnerAuthentication(mock.Anything, mock.Anything, time.Unix(now, 0).Add(usecases.PartnerAuthenticationValidity)).Return(nil).Once()
+		rsk := new(mocks.RootstockRpcServerMock)
+		rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil)
+		rsk.On("GetHeight", test.AnyCtx).Return(uint64(100), nil)
+		rsk.EXPECT().ChainId(mock.Anything).Return(31, nil).Once()
+		pegoutContract := new(mocks.PegoutContractMock)
+		pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil).Once()
+		pegoutContract.On("GetAddress").Return("0x1234")
+		pegoutContract.On("HashPegoutQuote", mock.Anything).Return("0x9876543210", nil).Once()
+		pegoutQuoteRepository := new(mocks.PegoutQuoteRepositoryMock)
+		pegoutQuoteRepository.On("InsertQuote", test.AnyCtx, mock.MatchedBy(func(createdPegoutQuote quote.CreatedPegoutQuote) bool {
+			return createdPegoutQuote.CreationData.FixedFee.Cmp(entities.NewWei(100)) == 0
+		})).Return(nil).Once()
+		lp := new(mocks.ProviderMock)
+		lp.On("GetSigner").Return(signer).Once()
+		lp.On("PegoutConfiguration", test.AnyCtx).Return(getPegoutConfiguration()).Once()
+		lp.On("GeneralConfiguration", test.AnyCtx).Return(getGeneralConfiguration())
+		lp.On("RskAddress").Return("0x12ab")
+		lp.On("BtcAddress").Return("address")
+		btcWallet := new(mocks.BitcoinWalletMock)
+		btcWallet.On("EstimateTxFees", test.AnyBtcAddress, quoteValue).Return(blockchain.BtcFeeEstimation{
+			Value:   entities.NewWei(1000000000000000),
+			FeeRate: utils.NewBigFloat64(25.333),
+		}, nil).Once()
+		btc := new(mocks.BtcRpcMock)
+		btc.On("ValidateAddress", test.AnyBtcAddress).Return(nil).Once()
+		contracts := blockchain.RskContracts{PegOut: pegoutContract}
+		useCase := pegout.NewGetQuoteUseCase(blockchain.Rpc{Btc: btc, Rsk: rsk}, contracts, pegoutQuoteRepository, lp, lp, btcWallet, partnerRepository, crypto.Keccak256)
+
+		result, err := useCase.Run(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(500000000000100), result.PegoutQuote.CallFee)
+		assert.Equal(t, uint32(1200), result.PegoutQuote.ExpireDate-result.PegoutQuote.AgreementTimestamp)
+		partnerRepository.AssertExpectations(t)
+		pegoutQuoteRepository.AssertExpectations(t)
+		lp.AssertExpectations(t)
+		signer.AssertExpectations(t)
+	})
+	t.Run("should fail if the partner account was tampered", func(t *testing.T) {
+		signer := &mocks.SignerMock{}
+		signer.On("Validate", signedPartner.Signature, signedPartner.Hash).Return(false).Once()
+		partnerRepository := &mocks.TrustedAccountRepositoryMock{}
+		partnerRepository.EXPECT().GetTrustedAccount(mock.Anything, partnerAddress).Return(signedPartner, nil).Once()
+		pegoutContract := new(mocks.PegoutContractMock)
+		pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil).Once()
+		lp := new(mocks.ProviderMock)
+		lp.On("GetSigner").Return(signer).Once()
+		lp.On("PegoutConfiguration", test.AnyCtx).Return(getPegoutConfiguration()).Once()
+		contracts := blockchain.RskContracts{PegOut: pegoutContract}
+		useCase := pegout.NewGetQuoteUseCase(blockchain.Rpc{}, contracts, new(mocks.PegoutQuoteRepositoryMock), lp, lp, nil, partnerRepository, crypto.Keccak256)
+
+		result, err := useCase.Run(context.Background(), request)
+		require.ErrorIs(t, err, lpEntity.TamperedTrustedAccountError)
+		assert.Empty(t, result)
+		partnerRepository.AssertExpectations(t)
+		lp.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,65 @@
+package pegout
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+type BatchQuoteRequest struct {
+	requests    []QuoteRequest
+	partnerAuth *usecases.PartnerAuthentication
+}
+
+func NewBatchQuoteRequest(requests []QuoteRequest) BatchQuoteRequest {
+	return BatchQuoteRequest{requests: requests}
+}
+
+// WithPartnerAuthentication returns a copy of the batch authenticated by a trusted account, so all the quotes
+// of the batch are created with the terms of that account
+func (request BatchQuoteRequest) WithPartnerAuthentication(auth usecases.PartnerAuthentication) BatchQuoteRequest {
+	request.partnerAuth = &auth
+	return request
+}
+
+// GetPegoutQuoteBatchResult is the result of one of the requests of a batch. Only one of Result and Error is set
+type GetPegoutQuoteBatchResult struct {
+	Result GetPegoutQuoteResult
+	Error  error
+}
+
+// GetQuotesUseCase creates the quotes of a batch of requests. It uses the same flow as GetQuoteUseCase, but the
+// values that don't depend on the request, like the gas price or the fee conditions, are fetched once per batch.
+type GetQuotesUseCase struct {
+	getQuoteUseCase *GetQuoteUseCase
+	maxBatchSize    uint64
+}
+
+func NewGetQuotesUseCase(getQuoteUseCase *GetQuoteUseCase, maxBatchSize uint64) *GetQuotesUseCase {
+	return &GetQuotesUseCase{getQuoteUseCase: getQuoteUseCase, maxBatchSize: maxBatchSize}
+}
+
+func (useCase *GetQuotesUseCase) MaxBatchSize() uint64 {
+	return useCase.maxBatchSize
+}
+
+// Run returns one result per request, in the same order of the requests. The error is only returned if the whole
+// batch failed, the errors of each request are included in its result.
+func (useCase *GetQuotesUseCase) Run(ctx context.Context, request BatchQuoteRequest) ([]GetPegoutQuoteBatchResult, error) {
+	if len(request.requests) == 0 || uint64(len(request.requests)) > useCase.maxBatchSize {
+		return nil, usecases.WrapUseCaseErrorArgs(usecases.GetPegoutQuotesId, usecases.QuoteBatchSizeError, usecases.ErrorArgs{
+			"size":    strconv.Itoa(len(request.requests)),
+			"maxSize": strconv.FormatUint(useCase.maxBatchSize, 10),
+		})
+	}
+	batch, err := useCase.getQuoteUseCase.newQuoteBatch(ctx, request.partnerAuth)
+	if err != nil {
+		return nil, usecases.WrapUseCaseError(usecases.GetPegoutQuotesId, err)
+	}
+	results := make([]GetPegoutQuoteBatchResult, len(request.requests))
+	for i, quoteRequest := range request.requests {
+		results[i].Result, results[i].Error = useCase.getQuoteUseCase.runInBatch(ctx, batch, quoteRequest)
+	}
+	return results, nil
+}
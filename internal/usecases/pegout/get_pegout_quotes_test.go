@@ -0,0 +1,100 @@
+package pegout_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegout"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestGetQuotesUseCase_Run(t *testing.T) {
+	const (
+		toAddress        = "mvL2bVzGUeC9oqVyQWJ4PxQspFzKgjzAqe"
+		otherAddress     = "n1zjV3WxJgA4dBhJ5mBn2Ffc5JJ4yeZ6kB"
+		rskRefundAddress = "0x79568c2989232dCa1840087D73d403602364c0D4"
+	)
+	value := entities.NewWei(1000000000000000000)
+	rsk := new(mocks.RootstockRpcServerMock)
+	rsk.On("QuoteGasPrice", test.AnyCtx).Return(entities.NewWei(50000000), nil).Once()
+	rsk.On("GetHeight", test.AnyCtx).Return(uint64(100), nil).Twice()
+	rsk.EXPECT().ChainId(mock.Anything).Return(31, nil).Twice()
+	pegoutContract := new(mocks.PegoutContractMock)
+	pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil).Once()
+	pegoutContract.On("GetAddress").Return("0x1234")
+	pegoutContract.On("HashPegoutQuote", mock.Anything).Return("0x9876543210", nil).Twice()
+	pegoutQuoteRepository := new(mocks.PegoutQuoteRepositoryMock)
+	pegoutQuoteRepository.On("InsertQuote", test.AnyCtx, mock.AnythingOfType("quote.CreatedPegoutQuote")).Return(nil).Twice()
+	lp := new(mocks.ProviderMock)
+	lp.On("PegoutConfiguration", test.AnyCtx).Return(getPegoutConfiguration()).Once()
+	lp.On("GeneralConfiguration", test.AnyCtx).Return(getGeneralConfiguration())
+	lp.On("RskAddress").Return("0x12ab")
+	lp.On("BtcAddress").Return("address")
+	btcWallet := new(mocks.BitcoinWalletMock)
+	btcWallet.On("EstimateTxFees", toAddress, value).Return(blockchain.BtcFeeEstimation{
+		Value:   entities.NewWei(1000000000000000),
+		FeeRate: utils.NewBigFloat64(25.333),
+	}, nil).Once()
+	btcWallet.On("EstimateTxFees", otherAddress, value).Return(blockchain.BtcFeeEstimation{}, assert.AnError).Once()
+	btc := new(mocks.BtcRpcMock)
+	btc.On("ValidateAddress", mock.Anything).Return(nil)
+	contracts := blockchain.RskContracts{PegOut: pegoutContract}
+	rpc := blockchain.Rpc{Btc: btc, Rsk: rsk}
+	useCase := pegout.NewGetQuotesUseCase(pegout.NewGetQuoteUseCase(rpc, contracts, pegoutQuoteRepository, lp, lp, btcWallet, nil, nil), 5)
+
+	request := pegout.NewBatchQuoteRequest([]pegout.QuoteRequest{
+		pegout.NewQuoteRequest(toAddress, value, rskRefundAddress),
+		pegout.NewQuoteRequest(otherAddress, value, rskRefundAddress),
+		pegout.NewQuoteRequest(toAddress, value, rskRefundAddress),
+	})
+	results, err := useCase.Run(context.Background(), request)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.NoError(t, results[0].Error)
+	assert.Equal(t, "0x9876543210", results[0].Result.Hash)
+	assert.Equal(t, entities.NewWei(1000000000000000), results[0].Result.PegoutQuote.GasFee)
+	require.ErrorIs(t, results[1].Error, assert.AnError)
+	assert.Equal(t, pegout.GetPegoutQuoteResult{}, results[1].Result)
+	require.NoError(t, results[2].Error)
+	assert.Equal(t, toAddress, results[2].Result.PegoutQuote.DepositAddress)
+
+	rsk.AssertExpectations(t)
+	pegoutContract.AssertExpectations(t)
+	pegoutQuoteRepository.AssertExpectations(t)
+	lp.AssertExpectations(t)
+	btcWallet.AssertExpectations(t)
+}
+
+func TestGetQuotesUseCase_Run_BatchSize(t *testing.T) {
+	pegoutContract := new(mocks.PegoutContractMock)
+	contracts := blockchain.RskContracts{PegOut: pegoutContract}
+	useCase := pegout.NewGetQuotesUseCase(pegout.NewGetQuoteUseCase(blockchain.Rpc{}, contracts, nil, nil, nil, nil, nil, nil), 1)
+	request := pegout.NewQuoteRequest("mvL2bVzGUeC9oqVyQWJ4PxQspFzKgjzAqe", entities.NewWei(1), "0x79568c2989232dCa1840087D73d403602364c0D4")
+	for _, requests := range [][]pegout.QuoteRequest{nil, {request, request}} {
+		results, err := useCase.Run(context.Background(), pegout.NewBatchQuoteRequest(requests))
+		require.ErrorIs(t, err, usecases.QuoteBatchSizeError)
+		assert.Nil(t, results)
+	}
+	pegoutContract.AssertNotCalled(t, "PausedStatus")
+}
+
+func TestGetQuotesUseCase_Run_Paused(t *testing.T) {
+	pegoutContract := new(mocks.PegoutContractMock)
+	pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: true, Since: 5, Reason: "test"}, nil).Once()
+	pegoutContract.EXPECT().GetAddress().Return("test-contract")
+	contracts := blockchain.RskContracts{PegOut: pegoutContract}
+	useCase := pegout.NewGetQuotesUseCase(pegout.NewGetQuoteUseCase(blockchain.Rpc{}, contracts, nil, nil, nil, nil, nil, nil), 1)
+	request := pegout.NewQuoteRequest("mvL2bVzGUeC9oqVyQWJ4PxQspFzKgjzAqe", entities.NewWei(1), "0x79568c2989232dCa1840087D73d403602364c0D4")
+	results, err := useCase.Run(context.Background(), pegout.NewBatchQuoteRequest([]pegout.QuoteRequest{request}))
+	require.ErrorIs(t, err, blockchain.ContractPausedError)
+	assert.Nil(t, results)
+}
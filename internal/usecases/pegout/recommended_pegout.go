@@ -46,26 +46,24 @@ func (useCase *RecommendedPegoutUseCase) Run(
 		destinationType = defaultBtcAddressType
 	}
 	config := useCase.pegoutProvider.PegoutConfiguration(ctx)
-	result := new(big.Int).Set(userBalance.AsBigInt())
 
-	// Percentage fees
-	scaledCallFeePercentage := useCase.getScaledCallFeePercentage(config)
+	feeConditions, err := usecases.GetFeeConditions(ctx, config.FeeSurcharges, useCase.pegoutProvider.AvailablePegoutLiquidity)
+	if err != nil {
+		return usecases.RecommendedOperationResult{}, usecases.WrapUseCaseError(usecases.RecommendedPegoutId, err)
+	}
 
-	// Fixed fees
 	gasFeeEstimation, err := useCase.getGasFee(destinationType, userBalance)
 	if err != nil {
 		return usecases.RecommendedOperationResult{}, usecases.WrapUseCaseError(usecases.RecommendedPegoutId, err)
 	}
-	fixedCallFeeEstimation := useCase.getFixedCallFee(config)
-
-	// Result calculation
-	totalPercentages := big.NewInt(0)
-	totalPercentages.Add(big.NewInt(useCase.scale), scaledCallFeePercentage)
 
-	result.Sub(result, gasFeeEstimation)
-	result.Sub(result, fixedCallFeeEstimation)
-	scaledBase := new(big.Int).Mul(big.NewInt(useCase.scale), result)
-	result.Quo(scaledBase, totalPercentages)
+	// The fees depend on the amount, so if the recommended value falls in a different
+	// fee tier than the user balance, the calculation is repeated with the fees of that tier
+	feeConfig := config.ForQuote(userBalance, feeConditions)
+	result, fixedCallFeeEstimation, scaledCallFeePercentage := useCase.calculateRecommendedValue(feeConfig, userBalance, gasFeeEstimation)
+	if resultFeeConfig := config.ForQuote(entities.NewBigWei(result), feeConditions); !sameFees(feeConfig, resultFeeConfig) {
+		result, fixedCallFeeEstimation, scaledCallFeePercentage = useCase.calculateRecommendedValue(resultFeeConfig, userBalance, gasFeeEstimation)
+	}
 
 	if err = useCase.validateRecommendedValue(ctx, config, result); err != nil {
 		return usecases.RecommendedOperationResult{}, err
@@ -80,6 +78,34 @@ func (useCase *RecommendedPegoutUseCase) Run(
 	}, nil
 }
 
+func (useCase *RecommendedPegoutUseCase) calculateRecommendedValue(
+	config liquidity_provider.PegoutConfiguration,
+	userBalance *entities.Wei,
+	gasFeeEstimation *big.Int,
+) (result *big.Int, fixedCallFee *big.Int, scaledCallFeePercentage *big.Int) {
+	result = new(big.Int).Set(userBalance.AsBigInt())
+
+	// Percentage fees
+	scaledCallFeePercentage = useCase.getScaledCallFeePercentage(config)
+
+	// Fixed fees
+	fixedCallFee = useCase.getFixedCallFee(config)
+
+	// Result calculation
+	totalPercentages := big.NewInt(0)
+	totalPercentages.Add(big.NewInt(useCase.scale), scaledCallFeePercentage)
+
+	result.Sub(result, gasFeeEstimation)
+	result.Sub(result, fixedCallFee)
+	scaledBase := new(big.Int).Mul(big.NewInt(useCase.scale), result)
+	result.Quo(scaledBase, totalPercentages)
+	return result, fixedCallFee, scaledCallFeePercentage
+}
+
+func sameFees(a, b liquidity_provider.PegoutConfiguration) bool {
+	return a.FixedFee.Cmp(b.FixedFee) == 0 && a.FeePercentage.Native().Cmp(b.FeePercentage.Native()) == 0
+}
+
 func (useCase *RecommendedPegoutUseCase) getScaledCallFeePercentage(
 	config liquidity_provider.PegoutConfiguration,
 ) *big.Int {
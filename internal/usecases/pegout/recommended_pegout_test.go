@@ -28,7 +28,7 @@ func TestRecommendedPegoutUseCase_Run(t *testing.T) {
 	amount := entities.NewWei(602247200000000000)
 	request := pegout.NewQuoteRequest(btcAddress, amount, rskAddress)
 	rsk := new(mocks.RootstockRpcServerMock)
-	rsk.EXPECT().GasPrice(mock.Anything).Return(entities.NewWei(1), nil)
+	rsk.EXPECT().QuoteGasPrice(mock.Anything).Return(entities.NewWei(1), nil)
 	rsk.EXPECT().GetHeight(mock.Anything).Return(uint64(100), nil)
 	rsk.EXPECT().EstimateGas(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entities.NewWei(4600), nil)
 	rsk.EXPECT().ChainId(mock.Anything).Return(31, nil).Once()
@@ -56,7 +56,7 @@ func TestRecommendedPegoutUseCase_Run(t *testing.T) {
 
 	contracts := blockchain.RskContracts{PegOut: pegoutContract, Bridge: bridge}
 	rpc := blockchain.Rpc{Btc: btc, Rsk: rsk}
-	getQuoteUseCase := pegout.NewGetQuoteUseCase(rpc, contracts, pegoutQuoteRepository, lp, lp, btcWallet)
+	getQuoteUseCase := pegout.NewGetQuoteUseCase(rpc, contracts, pegoutQuoteRepository, lp, lp, btcWallet, nil, nil)
 	createdQuote, err := getQuoteUseCase.Run(context.Background(), request)
 	require.NoError(t, err)
 	t.Run("should be consistent with get pegout quote calculation", func(t *testing.T) {
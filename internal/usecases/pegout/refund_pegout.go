@@ -44,6 +44,7 @@ func NewRefundPegoutUseCase(
 func (useCase *RefundPegoutUseCase) Run(ctx context.Context, retainedQuote quote.RetainedPegoutQuote) error {
 	var params blockchain.RefundPegoutParams
 	var pegoutQuote *quote.PegoutQuote
+	var gasPrice *entities.Wei
 	var err error
 
 	if err = usecases.CheckPauseState(useCase.contracts.PegOut); err != nil {
@@ -67,7 +68,10 @@ func (useCase *RefundPegoutUseCase) Run(ctx context.Context, retainedQuote quote
 	if params, err = useCase.buildRefundPegoutParams(ctx, retainedQuote); err != nil {
 		return err
 	}
-	txConfig := blockchain.NewTransactionConfig(nil, refundPegoutGasLimit, nil)
+	if gasPrice, err = useCase.rpc.Rsk.GasPrice(ctx); err != nil {
+		return useCase.publishErrorEvent(ctx, retainedQuote, err, true)
+	}
+	txConfig := blockchain.NewTransactionConfig(nil, refundPegoutGasLimit, gasPrice)
 
 	useCase.rskWalletMutex.Lock()
 	defer useCase.rskWalletMutex.Unlock()
@@ -78,9 +78,17 @@ var btcTxInfoMock = blockchain.BitcoinTransactionInformation{
 
 var btcRawTxMock = []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32}
 
+const refundPegoutTestGasPrice = 65164000
+
+func refundPegoutTestRsk() *mocks.RootstockRpcServerMock {
+	rsk := new(mocks.RootstockRpcServerMock)
+	rsk.On("GasPrice", test.AnyCtx).Return(entities.NewWei(refundPegoutTestGasPrice), nil)
+	return rsk
+}
+
 func TestRefundPegoutUseCase_Run_Paused(t *testing.T) {
 	btc := new(mocks.BtcRpcMock)
-	rpc := blockchain.Rpc{Btc: btc}
+	rpc := blockchain.Rpc{Btc: btc, Rsk: refundPegoutTestRsk()}
 	mutex := new(mocks.MutexMock)
 	bridge := new(mocks.BridgeMock)
 	pegoutContract := new(mocks.PegoutContractMock)
@@ -122,7 +130,9 @@ func TestRefundPegoutUseCase_Run(t *testing.T) {
 	quoteRepository.On("UpdateRetainedQuote", test.AnyCtx, expectedRetained).Return(nil).Once()
 	quoteRepository.On("GetQuote", test.AnyCtx, retainedQuote.QuoteHash).Return(&pegoutQuote, nil).Once()
 	pegoutContract := new(mocks.PegoutContractMock)
-	pegoutContract.On("RefundPegout", mock.Anything, mock.Anything).Return(refundPegoutReceipt, nil).Once()
+	pegoutContract.On("RefundPegout", mock.MatchedBy(func(txConfig blockchain.TransactionConfig) bool {
+		return txConfig.GasPrice.Cmp(entities.NewWei(refundPegoutTestGasPrice)) == 0 && txConfig.Value == nil
+	}), mock.Anything).Return(refundPegoutReceipt, nil).Once()
 	pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil)
 	eventBus := new(mocks.EventBusMock)
 	eventBus.On("Publish", mock.MatchedBy(func(event quote.PegoutQuoteCompletedEvent) bool {
@@ -145,7 +155,7 @@ func TestRefundPegoutUseCase_Run(t *testing.T) {
 	bridge := new(mocks.BridgeMock)
 
 	contracts := blockchain.RskContracts{PegOut: pegoutContract, Bridge: bridge}
-	rpc := blockchain.Rpc{Btc: btc}
+	rpc := blockchain.Rpc{Btc: btc, Rsk: refundPegoutTestRsk()}
 	useCase := pegout.NewRefundPegoutUseCase(quoteRepository, contracts, eventBus, rpc, mutex)
 	err := useCase.Run(context.Background(), retainedQuote)
 	quoteRepository.AssertExpectations(t)
@@ -195,7 +205,7 @@ func TestRefundPegoutUseCase_Run_UpdateError(t *testing.T) {
 	mutex.On("Unlock").Return().Once()
 
 	contracts := blockchain.RskContracts{PegOut: pegoutContract}
-	rpc := blockchain.Rpc{Btc: btc}
+	rpc := blockchain.Rpc{Btc: btc, Rsk: refundPegoutTestRsk()}
 	useCase := pegout.NewRefundPegoutUseCase(quoteRepository, contracts, eventBus, rpc, mutex)
 	err := useCase.Run(context.Background(), retainedQuote)
 
@@ -254,7 +264,7 @@ func TestRefundPegoutUseCase_Run_NotPublishRecoverableError(t *testing.T) {
 		btc := new(mocks.BtcRpcMock)
 		setup(quoteRepository, pegoutContract, btc)
 		contracts := blockchain.RskContracts{PegOut: pegoutContract}
-		rpc := blockchain.Rpc{Btc: btc}
+		rpc := blockchain.Rpc{Btc: btc, Rsk: refundPegoutTestRsk()}
 		useCase := pegout.NewRefundPegoutUseCase(quoteRepository, contracts, eventBus, rpc, mutex)
 		err := useCase.Run(context.Background(), retainedQuote)
 		pegoutContract.AssertExpectations(t)
@@ -313,7 +323,7 @@ func TestRefundPegoutUseCase_Run_PublishUnrecoverableError(t *testing.T) {
 				return assert.Equal(t, expected, q)
 			})).Return(nil).Once()
 		contracts := blockchain.RskContracts{PegOut: pegoutContract}
-		rpc := blockchain.Rpc{Btc: btc}
+		rpc := blockchain.Rpc{Btc: btc, Rsk: refundPegoutTestRsk()}
 		useCase := pegout.NewRefundPegoutUseCase(quoteRepository, contracts, eventBus, rpc, mutex)
 		err := useCase.Run(context.Background(), caseQuote)
 		pegoutContract.AssertExpectations(t)
@@ -337,7 +347,7 @@ func TestRefundPegoutUseCase_Run_NoConfirmations(t *testing.T) {
 	mutex := new(mocks.MutexMock)
 
 	contracts := blockchain.RskContracts{PegOut: pegoutContract}
-	rpc := blockchain.Rpc{Btc: btc}
+	rpc := blockchain.Rpc{Btc: btc, Rsk: refundPegoutTestRsk()}
 	useCase := pegout.NewRefundPegoutUseCase(quoteRepository, contracts, eventBus, rpc, mutex)
 	err := useCase.Run(context.Background(), retainedQuote)
 
@@ -362,7 +372,7 @@ func TestRefundPegoutUseCase_Run_WrongState(t *testing.T) {
 	mutex := new(mocks.MutexMock)
 
 	contracts := blockchain.RskContracts{PegOut: pegoutContract}
-	rpc := blockchain.Rpc{Btc: btc}
+	rpc := blockchain.Rpc{Btc: btc, Rsk: refundPegoutTestRsk()}
 	useCase := pegout.NewRefundPegoutUseCase(quoteRepository, contracts, eventBus, rpc, mutex)
 
 	err := useCase.Run(context.Background(), wrongStateQuote)
@@ -378,3 +388,26 @@ func TestRefundPegoutUseCase_Run_WrongState(t *testing.T) {
 	mutex.AssertNotCalled(t, "Unlock")
 	require.ErrorIs(t, err, usecases.WrongStateError)
 }
+
+func TestRefundPegoutUseCase_Run_GasPriceError(t *testing.T) {
+	quoteRepository := new(mocks.PegoutQuoteRepositoryMock)
+	quoteRepository.On("GetQuote", test.AnyCtx, retainedQuote.QuoteHash).Return(&pegoutQuote, nil).Once()
+	pegoutContract := new(mocks.PegoutContractMock)
+	pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil)
+	btc := new(mocks.BtcRpcMock)
+	btc.On("GetTransactionInfo", retainedQuote.LpBtcTxHash).Return(btcTxInfoMock, nil).Once()
+	btc.On("BuildMerkleBranch", mock.Anything).Return(merkleBranchMock, nil).Once()
+	btc.On("GetTransactionBlockInfo", mock.Anything).Return(btcBlockInfoMock, nil).Once()
+	btc.On("GetRawTransaction", mock.Anything).Return(btcRawTxMock, nil).Once()
+	rsk := new(mocks.RootstockRpcServerMock)
+	rsk.On("GasPrice", test.AnyCtx).Return(nil, assert.AnError).Once()
+	eventBus := new(mocks.EventBusMock)
+	contracts := blockchain.RskContracts{PegOut: pegoutContract}
+	useCase := pegout.NewRefundPegoutUseCase(quoteRepository, contracts, eventBus, blockchain.Rpc{Btc: btc, Rsk: rsk}, new(mocks.MutexMock))
+	err := useCase.Run(context.Background(), retainedQuote)
+	require.ErrorIs(t, err, assert.AnError)
+	pegoutContract.AssertNotCalled(t, "RefundPegout", mock.Anything, mock.Anything)
+	eventBus.AssertNotCalled(t, "Publish", mock.Anything)
+	rsk.AssertExpectations(t)
+	btc.AssertExpectations(t)
+}
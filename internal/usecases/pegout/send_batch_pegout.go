@@ -0,0 +1,210 @@
+package pegout
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	log "github.com/sirupsen/logrus"
+)
+
+// SendBatchPegoutUseCase pays several pegouts in a single BTC transaction. The transaction has one payment
+// output followed by the OP_RETURN output with its quote hash per pegout, so each pair has the same shape
+// as the ones sent by SendPegoutUseCase. Before sending, the transaction is validated against the contract
+// for every quote; if the contract doesn't accept it for any of them, or the wallet can't send it, the quotes
+// are paid individually.
+type SendBatchPegoutUseCase struct {
+	sendPegoutUseCase *SendPegoutUseCase
+}
+
+type batchPegoutItem struct {
+	retainedQuote  quote.RetainedPegoutQuote
+	pegoutQuote    *quote.PegoutQuote
+	quoteHashBytes []byte
+}
+
+func NewSendBatchPegoutUseCase(sendPegoutUseCase *SendPegoutUseCase) *SendBatchPegoutUseCase {
+	return &SendBatchPegoutUseCase{sendPegoutUseCase: sendPegoutUseCase}
+}
+
+// Run sends the pegouts of the provided quotes and returns the result of each one of them indexed by quote hash.
+// A nil result means the pegout was sent successfully. The errors have the same semantics as the ones
+// returned by SendPegoutUseCase.Run
+func (useCase *SendBatchPegoutUseCase) Run(ctx context.Context, retainedQuotes []quote.RetainedPegoutQuote) map[string]error {
+	results := make(map[string]error, len(retainedQuotes))
+	if len(retainedQuotes) < 2 {
+		useCase.sendIndividually(ctx, retainedQuotes, results)
+		return results
+	}
+
+	single := useCase.sendPegoutUseCase
+	if err := usecases.CheckPauseState(single.contracts.PegOut); err != nil {
+		useCase.sendIndividually(ctx, retainedQuotes, results)
+		return results
+	}
+
+	items := make([]batchPegoutItem, 0, len(retainedQuotes))
+	for _, retainedQuote := range retainedQuotes {
+		item, err := useCase.validateItem(ctx, retainedQuote)
+		if err != nil {
+			results[retainedQuote.QuoteHash] = err
+			continue
+		}
+		items = append(items, item)
+	}
+
+	if len(items) < 2 {
+		useCase.sendIndividually(ctx, batchItemsQuotes(items), results)
+		return results
+	}
+
+	if err := useCase.validateBatchTransaction(items); err != nil {
+		log.Warnf("%s: batch transaction not accepted, sending pegouts individually: %v", usecases.SendBatchPegoutId, err)
+		useCase.sendIndividually(ctx, batchItemsQuotes(items), results)
+		return results
+	}
+
+	if err := useCase.sendBatch(ctx, items, results); err != nil {
+		log.Warnf("%s: couldn't send batch, sending pegouts individually: %v", usecases.SendBatchPegoutId, err)
+		useCase.sendIndividually(ctx, batchItemsQuotes(items), results)
+	}
+	return results
+}
+
+func (useCase *SendBatchPegoutUseCase) validateItem(ctx context.Context, retainedQuote quote.RetainedPegoutQuote) (batchPegoutItem, error) {
+	var err error
+	var pegoutQuote *quote.PegoutQuote
+	var quoteHashBytes []byte
+	single := useCase.sendPegoutUseCase
+
+	if err = single.validateRetainedQuote(ctx, retainedQuote); err != nil {
+		return batchPegoutItem{}, err
+	}
+	if pegoutQuote, err = single.getQuote(ctx, retainedQuote); err != nil {
+		return batchPegoutItem{}, err
+	}
+	if _, err = single.validateQuote(ctx, retainedQuote, pegoutQuote); err != nil {
+		return batchPegoutItem{}, err
+	}
+	if quoteHashBytes, err = hex.DecodeString(retainedQuote.QuoteHash); err != nil {
+		return batchPegoutItem{}, single.publishErrorEvent(ctx, retainedQuote, *pegoutQuote, err, false)
+	}
+	return batchPegoutItem{retainedQuote: retainedQuote, pegoutQuote: pegoutQuote, quoteHashBytes: quoteHashBytes}, nil
+}
+
+func (useCase *SendBatchPegoutUseCase) validateBatchTransaction(items []batchPegoutItem) error {
+	single := useCase.sendPegoutUseCase
+	rawTx, err := single.btcWallet.CreateUnfundedBatchTransactionWithOpReturn(batchItemsPayments(items))
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err = single.contracts.PegOut.ValidatePegout(item.retainedQuote.QuoteHash, rawTx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendBatch returns an error only if the batch couldn't be sent and the quotes can still be sent individually,
+// the results of the quotes included in the batch are stored in the results map
+func (useCase *SendBatchPegoutUseCase) sendBatch(ctx context.Context, items []batchPegoutItem, results map[string]error) error {
+	var err error
+	var balance *entities.Wei
+	var txResult blockchain.BitcoinTransactionResult
+	var newState quote.PegoutState
+	single := useCase.sendPegoutUseCase
+
+	single.btcWalletMutex.Lock()
+	defer single.btcWalletMutex.Unlock()
+	// revalidate quotes after acquiring the mutex to prevent double spends
+	for _, item := range items {
+		if err = single.revalidateRetainedQuote(ctx, item.retainedQuote); err != nil {
+			return err
+		}
+	}
+
+	requiredBalance := new(entities.Wei)
+	for _, item := range items {
+		requiredBalance.Add(requiredBalance, item.pegoutQuote.Value)
+		requiredBalance.Add(requiredBalance, item.pegoutQuote.GasFee)
+	}
+	if balance, err = single.btcWallet.GetBalance(); err != nil {
+		return err
+	} else if balance.Cmp(requiredBalance) < 0 {
+		return usecases.NoLiquidityError
+	}
+
+	if txResult, err = single.btcWallet.SendBatchWithOpReturn(batchItemsPayments(items)); err != nil && txResult.Hash == "" {
+		// the transaction wasn't broadcast, so the quotes can still be paid individually
+		return err
+	} else if err != nil {
+		newState = quote.PegoutStateSendPegoutFailed
+	} else {
+		newState = quote.PegoutStateSendPegoutSucceeded
+	}
+
+	fees := splitBatchFee(txResult.Fee, len(items))
+	for i, item := range items {
+		retainedQuote := item.retainedQuote
+		retainedQuote.LpBtcTxHash = txResult.Hash
+		if fees != nil {
+			retainedQuote.SendPegoutBtcFee = fees[i]
+		}
+		retainedQuote.State = newState
+		single.eventBus.Publish(quote.PegoutBtcSentToUserEvent{
+			Event:         entities.NewBaseEvent(quote.PegoutBtcSentEventId),
+			PegoutQuote:   *item.pegoutQuote,
+			RetainedQuote: retainedQuote,
+			CreationData:  single.quoteRepository.GetPegoutCreationData(ctx, retainedQuote.QuoteHash),
+			Error:         err,
+		})
+		results[retainedQuote.QuoteHash] = single.processSendPegoutResult(ctx, retainedQuote, err)
+	}
+	return nil
+}
+
+func (useCase *SendBatchPegoutUseCase) sendIndividually(ctx context.Context, retainedQuotes []quote.RetainedPegoutQuote, results map[string]error) {
+	for _, retainedQuote := range retainedQuotes {
+		results[retainedQuote.QuoteHash] = useCase.sendPegoutUseCase.Run(ctx, retainedQuote)
+	}
+}
+
+// splitBatchFee splits the fee of a batch transaction equally between its pegouts, the remainder is assigned to the first one
+func splitBatchFee(fee *entities.Wei, parts int) []*entities.Wei {
+	if fee == nil || parts == 0 {
+		return nil
+	}
+	result := make([]*entities.Wei, parts)
+	share := new(entities.Wei)
+	remainder := new(entities.Wei)
+	share.AsBigInt().QuoRem(fee.AsBigInt(), entities.NewWei(int64(parts)).AsBigInt(), remainder.AsBigInt())
+	for i := range result {
+		result[i] = share.Copy()
+	}
+	result[0].Add(result[0], remainder)
+	return result
+}
+
+func batchItemsPayments(items []batchPegoutItem) []blockchain.BitcoinPayment {
+	payments := make([]blockchain.BitcoinPayment, 0, len(items))
+	for _, item := range items {
+		payments = append(payments, blockchain.BitcoinPayment{
+			Address:         item.pegoutQuote.DepositAddress,
+			Value:           item.pegoutQuote.Value,
+			OpReturnContent: item.quoteHashBytes,
+		})
+	}
+	return payments
+}
+
+func batchItemsQuotes(items []batchPegoutItem) []quote.RetainedPegoutQuote {
+	retainedQuotes := make([]quote.RetainedPegoutQuote, 0, len(items))
+	for _, item := range items {
+		retainedQuotes = append(retainedQuotes, item.retainedQuote)
+	}
+	return retainedQuotes
+}
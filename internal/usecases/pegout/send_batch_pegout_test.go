@@ -0,0 +1,276 @@
+package pegout_test
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/pegout"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const batchPegoutTxHash = "0x7a7b7c"
+
+type sendBatchPegoutMocks struct {
+	btcWallet       *mocks.BitcoinWalletMock
+	rsk             *mocks.RootstockRpcServerMock
+	quoteRepository *mocks.PegoutQuoteRepositoryMock
+	pegoutContract  *mocks.PegoutContractMock
+	eventBus        *mocks.EventBusMock
+	mutex           *mocks.MutexMock
+}
+
+func newSendBatchPegoutMocks() sendBatchPegoutMocks {
+	m := sendBatchPegoutMocks{
+		btcWallet:       new(mocks.BitcoinWalletMock),
+		rsk:             new(mocks.RootstockRpcServerMock),
+		quoteRepository: new(mocks.PegoutQuoteRepositoryMock),
+		pegoutContract:  new(mocks.PegoutContractMock),
+		eventBus:        new(mocks.EventBusMock),
+		mutex:           new(mocks.MutexMock),
+	}
+	m.pegoutContract.EXPECT().PausedStatus().Return(blockchain.PauseStatus{IsPaused: false}, nil)
+	m.rsk.On("GetHeight", test.AnyCtx).Return(uint64(450), nil)
+	m.rsk.On("GetBlockByHash", test.AnyCtx, blockHash).Return(blockchain.BlockInfo{
+		Hash: blockHash, Number: blockNumber,
+		Timestamp: time.Unix(int64(now+10), 0), Nonce: 1,
+	}, nil)
+	m.mutex.On("Lock").Return()
+	m.mutex.On("Unlock").Return()
+	return m
+}
+
+func (m sendBatchPegoutMocks) useCase() *pegout.SendBatchPegoutUseCase {
+	return pegout.NewSendBatchPegoutUseCase(pegout.NewSendPegoutUseCase(
+		m.btcWallet, m.quoteRepository, blockchain.Rpc{Rsk: m.rsk}, m.eventBus,
+		blockchain.RskContracts{PegOut: m.pegoutContract}, m.mutex, rootstock.ParseDepositEvent,
+	))
+}
+
+func (m sendBatchPegoutMocks) addQuote(t *testing.T, retainedQuote quote.RetainedPegoutQuote, pegoutQuote quote.PegoutQuote) {
+	receipt := &blockchain.TransactionReceipt{
+		TransactionHash:   retainedQuote.UserRskTxHash,
+		BlockHash:         blockHash,
+		BlockNumber:       blockNumber,
+		From:              "0x1234",
+		To:                "0x5678",
+		CumulativeGasUsed: big.NewInt(500),
+		GasUsed:           big.NewInt(500),
+		Value:             entities.NewWei(8500),
+	}
+	receipt = test.AddDepositLogFromQuote(t, receipt, pegoutQuote, retainedQuote)
+	m.rsk.On("GetTransactionReceipt", test.AnyCtx, retainedQuote.UserRskTxHash).Return(*receipt, nil)
+	m.quoteRepository.On("GetQuote", test.AnyCtx, retainedQuote.QuoteHash).Return(&pegoutQuote, nil)
+	m.quoteRepository.On("GetRetainedQuote", test.AnyCtx, retainedQuote.QuoteHash).Return(&retainedQuote, nil)
+	m.quoteRepository.On("GetPegoutCreationData", test.AnyCtx, retainedQuote.QuoteHash).Return(quote.PegoutCreationDataZeroValue())
+	m.pegoutContract.On("IsPegOutQuoteCompleted", retainedQuote.QuoteHash).Return(false, nil)
+}
+
+func getBatchPegoutTestQuotes() ([]quote.RetainedPegoutQuote, []quote.PegoutQuote) {
+	secondRetainedQuote := sendPegoutRetainedQuote
+	secondRetainedQuote.QuoteHash = "f64215867af36cad04e8c2e3e8336618b358f68923529f2a1e5dbc6dd4af4df2"
+	secondRetainedQuote.UserRskTxHash = "0x3c2b1b"
+	secondRetainedQuote.DepositAddress = "0x654322"
+	secondQuote := sendPegoutTestQuote
+	secondQuote.DepositAddress = secondRetainedQuote.DepositAddress
+	secondQuote.Value = entities.NewWei(3000)
+	return []quote.RetainedPegoutQuote{sendPegoutRetainedQuote, secondRetainedQuote}, []quote.PegoutQuote{sendPegoutTestQuote, secondQuote}
+}
+
+func getBatchPegoutPayments(t *testing.T, retainedQuotes []quote.RetainedPegoutQuote, pegoutQuotes []quote.PegoutQuote) []blockchain.BitcoinPayment {
+	payments := make([]blockchain.BitcoinPayment, 0, len(retainedQuotes))
+	for i, retainedQuote := range retainedQuotes {
+		quoteHash, err := hex.DecodeString(retainedQuote.QuoteHash)
+		require.NoError(t, err)
+		payments = append(payments, blockchain.BitcoinPayment{Address: pegoutQuotes[i].DepositAddress, Value: pegoutQuotes[i].Value, OpReturnContent: quoteHash})
+	}
+	return payments
+}
+
+// nolint:funlen
+func TestSendBatchPegoutUseCase_Run(t *testing.T) {
+	retainedQuotes, pegoutQuotes := getBatchPegoutTestQuotes()
+	payments := getBatchPegoutPayments(t, retainedQuotes, pegoutQuotes)
+	unfundedTx := []byte{0x01, 0x02, 0x03, 0x04}
+
+	t.Run("should send all the pegouts in one transaction", func(t *testing.T) {
+		m := newSendBatchPegoutMocks()
+		for i := range retainedQuotes {
+			m.addQuote(t, retainedQuotes[i], pegoutQuotes[i])
+			m.pegoutContract.On("ValidatePegout", retainedQuotes[i].QuoteHash, unfundedTx).Return(nil).Once()
+		}
+		m.btcWallet.On("CreateUnfundedBatchTransactionWithOpReturn", payments).Return(unfundedTx, nil).Once()
+		m.btcWallet.On("GetBalance").Return(entities.NewWei(9000), nil).Once()
+		m.btcWallet.On("SendBatchWithOpReturn", payments).Return(blockchain.BitcoinTransactionResult{Hash: batchPegoutTxHash, Fee: entities.NewWei(5001)}, nil).Once()
+		expectedFees := []*entities.Wei{entities.NewWei(2501), entities.NewWei(2500)}
+		for i, retainedQuote := range retainedQuotes {
+			expected := retainedQuote
+			expected.LpBtcTxHash = batchPegoutTxHash
+			expected.SendPegoutBtcFee = expectedFees[i]
+			expected.State = quote.PegoutStateSendPegoutSucceeded
+			m.quoteRepository.On("UpdateRetainedQuote", test.AnyCtx, expected).Return(nil).Once()
+			m.eventBus.On("Publish", mock.MatchedBy(func(event quote.PegoutBtcSentToUserEvent) bool {
+				return event.RetainedQuote.QuoteHash == expected.QuoteHash && assert.Equal(t, expected, event.RetainedQuote) && event.Error == nil
+			})).Return().Once()
+		}
+		results := m.useCase().Run(context.Background(), retainedQuotes)
+		require.Len(t, results, 2)
+		for _, retainedQuote := range retainedQuotes {
+			require.NoError(t, results[retainedQuote.QuoteHash])
+		}
+		m.btcWallet.AssertExpectations(t)
+		m.btcWallet.AssertNotCalled(t, "SendWithOpReturn", mock.Anything, mock.Anything, mock.Anything)
+		m.quoteRepository.AssertExpectations(t)
+		m.eventBus.AssertExpectations(t)
+		m.pegoutContract.AssertExpectations(t)
+	})
+	t.Run("should send the pegouts individually if the contract rejects the batch transaction", func(t *testing.T) {
+		m := newSendBatchPegoutMocks()
+		singleTx := []byte{0x05}
+		for i := range retainedQuotes {
+			m.addQuote(t, retainedQuotes[i], pegoutQuotes[i])
+			m.btcWallet.On("CreateUnfundedTransactionWithOpReturn", pegoutQuotes[i].DepositAddress, pegoutQuotes[i].Value, payments[i].OpReturnContent).Return(singleTx, nil).Once()
+			m.btcWallet.On("SendWithOpReturn", pegoutQuotes[i].DepositAddress, pegoutQuotes[i].Value, payments[i].OpReturnContent).Return(blockchain.BitcoinTransactionResult{Hash: test.AnyHash, Fee: entities.NewWei(100)}, nil).Once()
+			m.pegoutContract.On("ValidatePegout", retainedQuotes[i].QuoteHash, singleTx).Return(nil).Once()
+		}
+		m.pegoutContract.On("ValidatePegout", retainedQuotes[0].QuoteHash, unfundedTx).Return(assert.AnError).Once()
+		m.btcWallet.On("CreateUnfundedBatchTransactionWithOpReturn", payments).Return(unfundedTx, nil).Once()
+		m.btcWallet.On("GetBalance").Return(entities.NewWei(9000), nil).Twice()
+		m.quoteRepository.On("UpdateRetainedQuote", test.AnyCtx, mock.Anything).Return(nil).Twice()
+		m.eventBus.On("Publish", mock.Anything).Return().Twice()
+		results := m.useCase().Run(context.Background(), retainedQuotes)
+		require.Len(t, results, 2)
+		for _, retainedQuote := range retainedQuotes {
+			require.NoError(t, results[retainedQuote.QuoteHash])
+		}
+		m.btcWallet.AssertExpectations(t)
+		m.btcWallet.AssertNotCalled(t, "SendBatchWithOpReturn", mock.Anything)
+		m.pegoutContract.AssertExpectations(t)
+	})
+	t.Run("should send the remaining pegout individually if the other quote is not valid", func(t *testing.T) {
+		m := newSendBatchPegoutMocks()
+		m.addQuote(t, retainedQuotes[0], pegoutQuotes[0])
+		m.pegoutContract.On("ValidatePegout", retainedQuotes[0].QuoteHash, unfundedTx).Return(nil).Once()
+		m.btcWallet.On("CreateUnfundedTransactionWithOpReturn", pegoutQuotes[0].DepositAddress, pegoutQuotes[0].Value, payments[0].OpReturnContent).Return(unfundedTx, nil).Once()
+		m.btcWallet.On("SendWithOpReturn", pegoutQuotes[0].DepositAddress, pegoutQuotes[0].Value, payments[0].OpReturnContent).Return(blockchain.BitcoinTransactionResult{Hash: test.AnyHash, Fee: entities.NewWei(100)}, nil).Once()
+		m.btcWallet.On("GetBalance").Return(entities.NewWei(9000), nil).Once()
+		invalidQuote := retainedQuotes[1]
+		invalidQuote.State = quote.PegoutStateWaitingForDeposit
+		m.quoteRepository.On("UpdateRetainedQuote", test.AnyCtx, mock.Anything).Return(nil).Once()
+		m.eventBus.On("Publish", mock.Anything).Return().Once()
+		results := m.useCase().Run(context.Background(), []quote.RetainedPegoutQuote{retainedQuotes[0], invalidQuote})
+		require.Len(t, results, 2)
+		require.NoError(t, results[retainedQuotes[0].QuoteHash])
+		require.ErrorIs(t, results[invalidQuote.QuoteHash], usecases.WrongStateError)
+		m.btcWallet.AssertExpectations(t)
+		m.btcWallet.AssertNotCalled(t, "CreateUnfundedBatchTransactionWithOpReturn", mock.Anything)
+	})
+	t.Run("should not send the batch without enough liquidity for all the pegouts", func(t *testing.T) {
+		m := newSendBatchPegoutMocks()
+		for i := range retainedQuotes {
+			m.addQuote(t, retainedQuotes[i], pegoutQuotes[i])
+			m.pegoutContract.On("ValidatePegout", retainedQuotes[i].QuoteHash, unfundedTx).Return(nil)
+			m.btcWallet.On("CreateUnfundedTransactionWithOpReturn", pegoutQuotes[i].DepositAddress, pegoutQuotes[i].Value, payments[i].OpReturnContent).Return(unfundedTx, nil).Maybe()
+		}
+		m.btcWallet.On("CreateUnfundedBatchTransactionWithOpReturn", payments).Return(unfundedTx, nil).Once()
+		m.btcWallet.On("GetBalance").Return(entities.NewWei(3000), nil)
+		results := m.useCase().Run(context.Background(), retainedQuotes)
+		require.Len(t, results, 2)
+		for _, retainedQuote := range retainedQuotes {
+			require.ErrorIs(t, results[retainedQuote.QuoteHash], usecases.NoLiquidityError)
+		}
+		m.btcWallet.AssertNotCalled(t, "SendBatchWithOpReturn", mock.Anything)
+		m.btcWallet.AssertNotCalled(t, "SendWithOpReturn", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("should send the pegouts individually if the wallet rejects the batch transaction", func(t *testing.T) {
+		m := newSendBatchPegoutMocks()
+		singleTx := []byte{0x05}
+		for i := range retainedQuotes {
+			m.addQuote(t, retainedQuotes[i], pegoutQuotes[i])
+			m.pegoutContract.On("ValidatePegout", retainedQuotes[i].QuoteHash, unfundedTx).Return(nil).Once()
+			m.pegoutContract.On("ValidatePegout", retainedQuotes[i].QuoteHash, singleTx).Return(nil).Once()
+			m.btcWallet.On("CreateUnfundedTransactionWithOpReturn", pegoutQuotes[i].DepositAddress, pegoutQuotes[i].Value, payments[i].OpReturnContent).Return(singleTx, nil).Once()
+			m.btcWallet.On("SendWithOpReturn", pegoutQuotes[i].DepositAddress, pegoutQuotes[i].Value, payments[i].OpReturnContent).Return(blockchain.BitcoinTransactionResult{Hash: test.AnyHash, Fee: entities.NewWei(100)}, nil).Once()
+		}
+		m.btcWallet.On("CreateUnfundedBatchTransactionWithOpReturn", payments).Return(unfundedTx, nil).Once()
+		m.btcWallet.On("GetBalance").Return(entities.NewWei(9000), nil).Times(3)
+		m.btcWallet.On("SendBatchWithOpReturn", payments).Return(blockchain.BitcoinTransactionResult{}, assert.AnError).Once()
+		m.quoteRepository.On("UpdateRetainedQuote", test.AnyCtx, mock.MatchedBy(func(retainedQuote quote.RetainedPegoutQuote) bool {
+			return retainedQuote.State == quote.PegoutStateSendPegoutSucceeded && retainedQuote.LpBtcTxHash == test.AnyHash
+		})).Return(nil).Twice()
+		m.eventBus.On("Publish", mock.MatchedBy(func(event quote.PegoutBtcSentToUserEvent) bool {
+			return event.Error == nil
+		})).Return().Twice()
+		results := m.useCase().Run(context.Background(), retainedQuotes)
+		require.Len(t, results, 2)
+		for _, retainedQuote := range retainedQuotes {
+			require.NoError(t, results[retainedQuote.QuoteHash])
+		}
+		m.btcWallet.AssertExpectations(t)
+		m.quoteRepository.AssertExpectations(t)
+		m.eventBus.AssertExpectations(t)
+		m.pegoutContract.AssertExpectations(t)
+	})
+	t.Run("should mark the quotes as failed if the individual pegouts fail after the batch is rejected", func(t *testing.T) {
+		m := newSendBatchPegoutMocks()
+		singleTx := []byte{0x05}
+		for i := range retainedQuotes {
+			m.addQuote(t, retainedQuotes[i], pegoutQuotes[i])
+			m.pegoutContract.On("ValidatePegout", retainedQuotes[i].QuoteHash, unfundedTx).Return(nil).Once()
+			m.pegoutContract.On("ValidatePegout", retainedQuotes[i].QuoteHash, singleTx).Return(nil).Once()
+			m.btcWallet.On("CreateUnfundedTransactionWithOpReturn", pegoutQuotes[i].DepositAddress, pegoutQuotes[i].Value, payments[i].OpReturnContent).Return(singleTx, nil).Once()
+			m.btcWallet.On("SendWithOpReturn", pegoutQuotes[i].DepositAddress, pegoutQuotes[i].Value, payments[i].OpReturnContent).Return(blockchain.BitcoinTransactionResult{}, assert.AnError).Once()
+		}
+		m.btcWallet.On("CreateUnfundedBatchTransactionWithOpReturn", payments).Return(unfundedTx, nil).Once()
+		m.btcWallet.On("GetBalance").Return(entities.NewWei(9000), nil).Times(3)
+		m.btcWallet.On("SendBatchWithOpReturn", payments).Return(blockchain.BitcoinTransactionResult{}, assert.AnError).Once()
+		m.quoteRepository.On("UpdateRetainedQuote", test.AnyCtx, mock.MatchedBy(func(retainedQuote quote.RetainedPegoutQuote) bool {
+			return retainedQuote.State == quote.PegoutStateSendPegoutFailed && retainedQuote.LpBtcTxHash == ""
+		})).Return(nil).Twice()
+		m.eventBus.On("Publish", mock.MatchedBy(func(event quote.PegoutBtcSentToUserEvent) bool {
+			return event.Error != nil
+		})).Return().Twice()
+		results := m.useCase().Run(context.Background(), retainedQuotes)
+		require.Len(t, results, 2)
+		for _, retainedQuote := range retainedQuotes {
+			require.ErrorIs(t, results[retainedQuote.QuoteHash], assert.AnError)
+		}
+		m.btcWallet.AssertExpectations(t)
+		m.quoteRepository.AssertExpectations(t)
+		m.eventBus.AssertExpectations(t)
+	})
+	t.Run("should mark all the quotes as failed if the batch transaction fails after being broadcast", func(t *testing.T) {
+		m := newSendBatchPegoutMocks()
+		for i := range retainedQuotes {
+			m.addQuote(t, retainedQuotes[i], pegoutQuotes[i])
+			m.pegoutContract.On("ValidatePegout", retainedQuotes[i].QuoteHash, unfundedTx).Return(nil).Once()
+		}
+		m.btcWallet.On("CreateUnfundedBatchTransactionWithOpReturn", payments).Return(unfundedTx, nil).Once()
+		m.btcWallet.On("GetBalance").Return(entities.NewWei(9000), nil).Once()
+		m.btcWallet.On("SendBatchWithOpReturn", payments).Return(blockchain.BitcoinTransactionResult{Hash: batchPegoutTxHash}, assert.AnError).Once()
+		m.quoteRepository.On("UpdateRetainedQuote", test.AnyCtx, mock.MatchedBy(func(retainedQuote quote.RetainedPegoutQuote) bool {
+			return retainedQuote.State == quote.PegoutStateSendPegoutFailed && retainedQuote.LpBtcTxHash == batchPegoutTxHash
+		})).Return(nil).Twice()
+		m.eventBus.On("Publish", mock.MatchedBy(func(event quote.PegoutBtcSentToUserEvent) bool {
+			return event.Error != nil
+		})).Return().Twice()
+		results := m.useCase().Run(context.Background(), retainedQuotes)
+		require.Len(t, results, 2)
+		for _, retainedQuote := range retainedQuotes {
+			require.ErrorIs(t, results[retainedQuote.QuoteHash], assert.AnError)
+		}
+		m.quoteRepository.AssertExpectations(t)
+		m.eventBus.AssertExpectations(t)
+	})
+}
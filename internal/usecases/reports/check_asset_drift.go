@@ -0,0 +1,133 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	AssetDriftBody = "The total assets of the LP changed by %v BTC between %s and %s, but the revenue of the quotes " +
+		"of that period only explains a change of %v BTC. There are %v BTC that can't be explained by the accounting, " +
+		"which is above the tolerance of %v BTC. Check for manual withdrawals, accounting discrepancies or unauthorized movements."
+	AssetDriftResolvedBody = "The change of the total assets of the LP is explained by the revenue of the quotes again."
+)
+
+// AssetDriftResult compares the change of the total assets between two snapshots with the change explained by
+// the revenue of the quotes. Drift is the expected change minus the actual change, so a positive drift means that
+// there are fewer assets than expected
+type AssetDriftResult struct {
+	Checked        bool
+	StartDate      time.Time
+	EndDate        time.Time
+	ExpectedChange *entities.Wei
+	ActualChange   *entities.Wei
+	Drift          *entities.Wei
+	Detected       bool
+}
+
+// CheckAssetDriftUseCase detects drops of the LP assets that are not explained by the call fees, the gas fees and the
+// penalizations of the quotes. The revenue report attributes the quotes to the period by their agreement date, so the
+// tolerance should cover the revenue of the quotes that were in progress when the snapshots were taken. The manual
+// deposits of the LP are not detected, as they increase the assets, but the manual withdrawals are reported as drift
+type CheckAssetDriftUseCase struct {
+	assetSnapshotRepository reporting.AssetSnapshotRepository
+	revenueReportUseCase    *GetRevenueReportUseCase
+	alertSender             alerts.AlertSender
+	alertRecipient          string
+	window                  time.Duration
+	tolerance               *entities.Wei
+}
+
+func NewCheckAssetDriftUseCase(
+	assetSnapshotRepository reporting.AssetSnapshotRepository,
+	revenueReportUseCase *GetRevenueReportUseCase,
+	alertSender alerts.AlertSender,
+	alertRecipient string,
+	window time.Duration,
+	tolerance *entities.Wei,
+) *CheckAssetDriftUseCase {
+	return &CheckAssetDriftUseCase{
+		assetSnapshotRepository: assetSnapshotRepository,
+		revenueReportUseCase:    revenueReportUseCase,
+		alertSender:             alertSender,
+		alertRecipient:          alertRecipient,
+		window:                  window,
+		tolerance:               tolerance,
+	}
+}
+
+// Run compares the latest asset snapshot with the last one taken at least one window before it. If there is not
+// enough history, the result is not checked. A firing alert is sent if the drift is above the tolerance and a resolved
+// one otherwise, the alert sender is responsible for discarding the repeated alerts
+func (useCase *CheckAssetDriftUseCase) Run(ctx context.Context) (AssetDriftResult, error) {
+	latest, err := useCase.assetSnapshotRepository.GetLastAssetSnapshot(ctx, time.Now())
+	if err != nil {
+		return AssetDriftResult{}, usecases.WrapUseCaseError(usecases.CheckAssetDriftId, err)
+	} else if latest == nil {
+		return AssetDriftResult{}, nil
+	}
+	previous, err := useCase.assetSnapshotRepository.GetLastAssetSnapshot(ctx, latest.Timestamp.Add(-useCase.window))
+	if err != nil {
+		return AssetDriftResult{}, usecases.WrapUseCaseError(usecases.CheckAssetDriftId, err)
+	} else if previous == nil {
+		return AssetDriftResult{}, nil
+	}
+
+	revenue, err := useCase.revenueReportUseCase.Run(ctx, previous.Timestamp, latest.Timestamp)
+	if err != nil {
+		return AssetDriftResult{}, usecases.WrapUseCaseError(usecases.CheckAssetDriftId, err)
+	}
+	expectedChange := entities.NewWei(0).Add(revenue.TotalQuoteCallFees, revenue.TotalGasFeesCollected)
+	expectedChange.Sub(expectedChange, revenue.TotalGasSpent)
+	expectedChange.Sub(expectedChange, revenue.TotalPenalizations)
+	actualChange := entities.NewWei(0).Sub(latest.Total(), previous.Total())
+	drift := entities.NewWei(0).Sub(expectedChange, actualChange)
+
+	result := AssetDriftResult{
+		Checked:        true,
+		StartDate:      previous.Timestamp,
+		EndDate:        latest.Timestamp,
+		ExpectedChange: expectedChange,
+		ActualChange:   actualChange,
+		Drift:          drift,
+		Detected:       drift.Cmp(useCase.tolerance) > 0,
+	}
+	useCase.notifyDrift(ctx, result)
+	return result, nil
+}
+
+func (useCase *CheckAssetDriftUseCase) notifyDrift(ctx context.Context, result AssetDriftResult) {
+	alert := alerts.Alert{
+		Kind:     alerts.AlertKindAssetDrift,
+		Subject:  alerts.AlertSubjectAssetDrift,
+		Severity: alerts.AlertSeverityCritical,
+		Status:   alerts.AlertStatusFiring,
+		Message: fmt.Sprintf(
+			AssetDriftBody, result.ActualChange.ToRbtc(), result.StartDate.Format(time.RFC3339),
+			result.EndDate.Format(time.RFC3339), result.ExpectedChange.ToRbtc(), result.Drift.ToRbtc(),
+			useCase.tolerance.ToRbtc(),
+		),
+		Amounts: map[string]*entities.Wei{
+			alerts.AlertAmountExpectedChange: result.ExpectedChange,
+			alerts.AlertAmountActualChange:   result.ActualChange,
+			alerts.AlertAmountDrift:          result.Drift,
+			alerts.AlertAmountThreshold:      useCase.tolerance,
+		},
+		Timestamp: time.Now(),
+	}
+	if !result.Detected {
+		alert.Severity = alerts.AlertSeverityInfo
+		alert.Status = alerts.AlertStatusResolved
+		alert.Message = AssetDriftResolvedBody
+	}
+	if err := useCase.alertSender.SendAlert(ctx, alert, []string{useCase.alertRecipient}); err != nil {
+		log.Error("Error sending asset drift notification: ", err)
+	}
+}
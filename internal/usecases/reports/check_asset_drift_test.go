@@ -0,0 +1,174 @@
+package reports_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/penalization"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestCheckAssetDriftUseCase_Run(t *testing.T) {
+	const (
+		recipient = "recipient@test.com"
+		window    = 24 * time.Hour
+	)
+	ctx := context.Background()
+	tolerance := entities.NewWei(1000)
+	latestTimestamp := time.Date(2024, 3, 12, 10, 0, 0, 0, time.UTC)
+	previous := &reporting.AssetSnapshot{
+		Timestamp: latestTimestamp.Add(-window).Add(-time.Minute),
+		Btc:       reporting.AssetBalance{Total: entities.NewWei(4000)},
+		Rbtc:      reporting.AssetBalance{Total: entities.NewWei(6000)},
+	}
+	latestWithTotal := func(total int64) *reporting.AssetSnapshot {
+		return &reporting.AssetSnapshot{
+			Timestamp: latestTimestamp,
+			Btc:       reporting.AssetBalance{Total: entities.NewWei(3000)},
+			Rbtc:      reporting.AssetBalance{Total: entities.NewWei(total - 3000)},
+		}
+	}
+	// expected change: 1000 call fee + 500 gas fee - 200 gas spent - 100 penalty = 1200
+	peginQuotes := []quote.PeginQuoteWithRetained{{
+		Quote: quote.PeginQuote{CallFee: entities.NewWei(1000), GasFee: entities.NewWei(500)},
+		RetainedQuote: quote.RetainedPeginQuote{
+			QuoteHash:             "pegin-hash",
+			CallForUserGasUsed:    10,
+			CallForUserGasPrice:   entities.NewWei(10),
+			RegisterPeginGasUsed:  10,
+			RegisterPeginGasPrice: entities.NewWei(10),
+		},
+	}}
+	setupRevenue := func(t *testing.T) *reports.GetRevenueReportUseCase {
+		peginRepository := mocks.NewPeginQuoteRepositoryMock(t)
+		pegoutRepository := mocks.NewPegoutQuoteRepositoryMock(t)
+		penalizationRepository := mocks.NewPenalizedEventRepositoryMock(t)
+		peginRepository.On("GetQuotesWithRetainedByStateAndDate", ctx, mock.Anything, previous.Timestamp, latestTimestamp).
+			Return(peginQuotes, nil).Once()
+		pegoutRepository.On("GetQuotesWithRetainedByStateAndDate", ctx, mock.Anything, previous.Timestamp, latestTimestamp).
+			Return([]quote.PegoutQuoteWithRetained{}, nil).Once()
+		penalizationRepository.On("GetPenalizationsByQuoteHashes", ctx, []string{"pegin-hash"}).
+			Return([]penalization.PenalizedEvent{{QuoteHash: "pegin-hash", Penalty: entities.NewWei(100)}}, nil).Once()
+		return reports.NewGetRevenueReportUseCase(peginRepository, pegoutRepository, penalizationRepository)
+	}
+	setupSnapshots := func(t *testing.T, latest *reporting.AssetSnapshot) *mocks.AssetSnapshotRepositoryMock {
+		repository := mocks.NewAssetSnapshotRepositoryMock(t)
+		repository.EXPECT().GetLastAssetSnapshot(ctx, mock.AnythingOfType("time.Time")).Return(latest, nil).Once()
+		repository.EXPECT().GetLastAssetSnapshot(ctx, latestTimestamp.Add(-window)).Return(previous, nil).Once()
+		return repository
+	}
+
+	t.Run("should alert when the assets dropped more than the revenue explains", func(t *testing.T) {
+		alertSender := &mocks.AlertSenderMock{}
+		alertSender.On("SendAlert", ctx, mock.MatchedBy(func(alert alerts.Alert) bool {
+			return alert.Kind == alerts.AlertKindAssetDrift && alert.Subject == alerts.AlertSubjectAssetDrift &&
+				alert.Severity == alerts.AlertSeverityCritical && alert.Status == alerts.AlertStatusFiring &&
+				strings.Contains(alert.Message, "There are 2.2e-15 BTC that can't be explained") &&
+				assert.Equal(t, map[string]*entities.Wei{
+					alerts.AlertAmountExpectedChange: entities.NewWei(1200),
+					alerts.AlertAmountActualChange:   entities.NewWei(-1000),
+					alerts.AlertAmountDrift:          entities.NewWei(2200),
+					alerts.AlertAmountThreshold:      tolerance,
+				}, alert.Amounts)
+		}), []string{recipient}).Return(nil).Once()
+		useCase := reports.NewCheckAssetDriftUseCase(setupSnapshots(t, latestWithTotal(9000)), setupRevenue(t), alertSender, recipient, window, tolerance)
+
+		result, err := useCase.Run(ctx)
+
+		require.NoError(t, err)
+		alertSender.AssertExpectations(t)
+		assert.Equal(t, reports.AssetDriftResult{
+			Checked:        true,
+			StartDate:      previous.Timestamp,
+			EndDate:        latestTimestamp,
+			ExpectedChange: entities.NewWei(1200),
+			ActualChange:   entities.NewWei(-1000),
+			Drift:          entities.NewWei(2200),
+			Detected:       true,
+		}, result)
+	})
+	t.Run("should resolve the alert when the drift is within the tolerance", func(t *testing.T) {
+		for _, total := range []int64{11200, 10200, 15000} {
+			alertSender := &mocks.AlertSenderMock{}
+			alertSender.On("SendAlert", ctx, mock.MatchedBy(func(alert alerts.Alert) bool {
+				return alert.Kind == alerts.AlertKindAssetDrift && alert.Severity == alerts.AlertSeverityInfo &&
+					alert.Status == alerts.AlertStatusResolved && alert.Message == reports.AssetDriftResolvedBody
+			}), []string{recipient}).Return(nil).Once()
+			useCase := reports.NewCheckAssetDriftUseCase(setupSnapshots(t, latestWithTotal(total)), setupRevenue(t), alertSender, recipient, window, tolerance)
+
+			result, err := useCase.Run(ctx)
+
+			require.NoError(t, err)
+			alertSender.AssertExpectations(t)
+			assert.True(t, result.Checked)
+			assert.False(t, result.Detected)
+		}
+	})
+	t.Run("should not check without enough history", func(t *testing.T) {
+		alertSender := &mocks.AlertSenderMock{}
+		repository := mocks.NewAssetSnapshotRepositoryMock(t)
+		repository.EXPECT().GetLastAssetSnapshot(ctx, mock.AnythingOfType("time.Time")).Return(nil, nil).Once()
+		useCase := reports.NewCheckAssetDriftUseCase(repository, nil, alertSender, recipient, window, tolerance)
+		result, err := useCase.Run(ctx)
+		require.NoError(t, err)
+		assert.False(t, result.Checked)
+
+		repository = mocks.NewAssetSnapshotRepositoryMock(t)
+		repository.EXPECT().GetLastAssetSnapshot(ctx, mock.AnythingOfType("time.Time")).Return(latestWithTotal(9000), nil).Once()
+		repository.EXPECT().GetLastAssetSnapshot(ctx, latestTimestamp.Add(-window)).Return(nil, nil).Once()
+		useCase = reports.NewCheckAssetDriftUseCase(repository, nil, alertSender, recipient, window, tolerance)
+		result, err = useCase.Run(ctx)
+		require.NoError(t, err)
+		assert.False(t, result.Checked)
+		alertSender.AssertNotCalled(t, "SendAlert", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("should not fail if the alert can't be sent", func(t *testing.T) {
+		alertSender := &mocks.AlertSenderMock{}
+		alertSender.On("SendAlert", ctx, mock.Anything, []string{recipient}).Return(assert.AnError).Once()
+		useCase := reports.NewCheckAssetDriftUseCase(setupSnapshots(t, latestWithTotal(9000)), setupRevenue(t), alertSender, recipient, window, tolerance)
+
+		result, err := useCase.Run(ctx)
+
+		require.NoError(t, err)
+		alertSender.AssertExpectations(t)
+		assert.True(t, result.Detected)
+	})
+	t.Run("should wrap repository errors", func(t *testing.T) {
+		repository := mocks.NewAssetSnapshotRepositoryMock(t)
+		repository.EXPECT().GetLastAssetSnapshot(ctx, mock.AnythingOfType("time.Time")).Return(nil, assert.AnError).Once()
+		useCase := reports.NewCheckAssetDriftUseCase(repository, nil, &mocks.AlertSenderMock{}, recipient, window, tolerance)
+
+		result, err := useCase.Run(ctx)
+
+		require.ErrorIs(t, err, assert.AnError)
+		require.ErrorContains(t, err, string(usecases.CheckAssetDriftId))
+		assert.Empty(t, result)
+	})
+	t.Run("should wrap revenue report errors", func(t *testing.T) {
+		peginRepository := mocks.NewPeginQuoteRepositoryMock(t)
+		peginRepository.On("GetQuotesWithRetainedByStateAndDate", ctx, mock.Anything, previous.Timestamp, latestTimestamp).
+			Return(nil, assert.AnError).Once()
+		revenueUseCase := reports.NewGetRevenueReportUseCase(peginRepository, mocks.NewPegoutQuoteRepositoryMock(t), mocks.NewPenalizedEventRepositoryMock(t))
+		alertSender := &mocks.AlertSenderMock{}
+		useCase := reports.NewCheckAssetDriftUseCase(setupSnapshots(t, latestWithTotal(9000)), revenueUseCase, alertSender, recipient, window, tolerance)
+
+		result, err := useCase.Run(ctx)
+
+		require.ErrorIs(t, err, assert.AnError)
+		require.ErrorContains(t, err, string(usecases.CheckAssetDriftId))
+		assert.Empty(t, result)
+		alertSender.AssertNotCalled(t, "SendAlert", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
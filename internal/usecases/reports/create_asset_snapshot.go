@@ -0,0 +1,62 @@
+package reports
+
+import (
+	"context"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+// CreateAssetSnapshotUseCase stores the result of an assets report so the position of the LP assets can be
+// tracked over time
+type CreateAssetSnapshotUseCase struct {
+	assetSnapshotRepository reporting.AssetSnapshotRepository
+}
+
+func NewCreateAssetSnapshotUseCase(assetSnapshotRepository reporting.AssetSnapshotRepository) *CreateAssetSnapshotUseCase {
+	return &CreateAssetSnapshotUseCase{assetSnapshotRepository: assetSnapshotRepository}
+}
+
+func (useCase *CreateAssetSnapshotUseCase) Run(ctx context.Context, report GetAssetsReportResult) (reporting.AssetSnapshot, error) {
+	// the database stores the dates with millisecond precision
+	snapshot := report.toAssetSnapshot(time.Now().UTC().Truncate(time.Millisecond))
+	if err := useCase.assetSnapshotRepository.InsertAssetSnapshot(ctx, snapshot); err != nil {
+		return reporting.AssetSnapshot{}, usecases.WrapUseCaseError(usecases.CreateAssetSnapshotId, err)
+	}
+	return snapshot, nil
+}
+
+func (result GetAssetsReportResult) toAssetSnapshot(timestamp time.Time) reporting.AssetSnapshot {
+	btc, rbtc := result.BtcAssetReport, result.RbtcAssetReport
+	return reporting.AssetSnapshot{
+		Timestamp: timestamp,
+		Btc: reporting.AssetBalance{
+			Total: btc.Total,
+			Location: reporting.AssetLocation{
+				BtcWallet:  btc.Location.BtcWallet,
+				Federation: btc.Location.Federation,
+				RskWallet:  btc.Location.RskWallet,
+				Lbc:        btc.Location.Lbc,
+			},
+			Allocation: reporting.AssetAllocation{
+				ReservedForUsers: btc.Allocation.ReservedForUsers,
+				WaitingForRefund: btc.Allocation.WaitingForRefund,
+				Available:        btc.Allocation.Available,
+			},
+		},
+		Rbtc: reporting.AssetBalance{
+			Total: rbtc.Total,
+			Location: reporting.AssetLocation{
+				Federation: rbtc.Location.Federation,
+				RskWallet:  rbtc.Location.RskWallet,
+				Lbc:        rbtc.Location.Lbc,
+			},
+			Allocation: reporting.AssetAllocation{
+				ReservedForUsers: rbtc.Allocation.ReservedForUsers,
+				WaitingForRefund: rbtc.Allocation.WaitingForRefund,
+				Available:        rbtc.Allocation.Available,
+			},
+		},
+	}
+}
@@ -0,0 +1,105 @@
+package reports_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAssetSnapshotUseCase_Run(t *testing.T) {
+	ctx := context.Background()
+	report := reports.GetAssetsReportResult{
+		BtcAssetReport: reports.BtcAssetReport{
+			Total: entities.NewWei(900),
+			Location: reports.BtcAssetLocation{
+				BtcWallet:  entities.NewWei(500),
+				Federation: entities.NewWei(200),
+				RskWallet:  entities.NewWei(100),
+				Lbc:        entities.NewWei(100),
+			},
+			Allocation: reports.BtcAssetAllocation{
+				ReservedForUsers: entities.NewWei(50),
+				WaitingForRefund: entities.NewWei(400),
+				Available:        entities.NewWei(450),
+			},
+		},
+		RbtcAssetReport: reports.RbtcAssetReport{
+			Total: entities.NewWei(1000),
+			Location: reports.RbtcAssetLocation{
+				RskWallet:  entities.NewWei(200),
+				Lbc:        entities.NewWei(500),
+				Federation: entities.NewWei(300),
+			},
+			Allocation: reports.RbtcAssetAllocation{
+				ReservedForUsers: entities.NewWei(100),
+				WaitingForRefund: entities.NewWei(300),
+				Available:        entities.NewWei(600),
+			},
+		},
+	}
+	expected := reporting.AssetSnapshot{
+		Btc: reporting.AssetBalance{
+			Total: entities.NewWei(900),
+			Location: reporting.AssetLocation{
+				BtcWallet:  entities.NewWei(500),
+				Federation: entities.NewWei(200),
+				RskWallet:  entities.NewWei(100),
+				Lbc:        entities.NewWei(100),
+			},
+			Allocation: reporting.AssetAllocation{
+				ReservedForUsers: entities.NewWei(50),
+				WaitingForRefund: entities.NewWei(400),
+				Available:        entities.NewWei(450),
+			},
+		},
+		Rbtc: reporting.AssetBalance{
+			Total: entities.NewWei(1000),
+			Location: reporting.AssetLocation{
+				Federation: entities.NewWei(300),
+				RskWallet:  entities.NewWei(200),
+				Lbc:        entities.NewWei(500),
+			},
+			Allocation: reporting.AssetAllocation{
+				ReservedForUsers: entities.NewWei(100),
+				WaitingForRefund: entities.NewWei(300),
+				Available:        entities.NewWei(600),
+			},
+		},
+	}
+
+	t.Run("should store the report as an asset snapshot", func(t *testing.T) {
+		before := time.Now().UTC().Truncate(time.Millisecond)
+		repository := mocks.NewAssetSnapshotRepositoryMock(t)
+		repository.EXPECT().InsertAssetSnapshot(ctx, mock.MatchedBy(func(snapshot reporting.AssetSnapshot) bool {
+			return !snapshot.Timestamp.Before(before) && snapshot.Timestamp.Equal(snapshot.Timestamp.Truncate(time.Millisecond))
+		})).Return(nil).Once()
+		useCase := reports.NewCreateAssetSnapshotUseCase(repository)
+
+		result, err := useCase.Run(ctx, report)
+
+		require.NoError(t, err)
+		assert.Equal(t, time.UTC, result.Timestamp.Location())
+		expected.Timestamp = result.Timestamp
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should wrap repository errors", func(t *testing.T) {
+		repository := mocks.NewAssetSnapshotRepositoryMock(t)
+		repository.EXPECT().InsertAssetSnapshot(ctx, mock.Anything).Return(assert.AnError).Once()
+		useCase := reports.NewCreateAssetSnapshotUseCase(repository)
+
+		result, err := useCase.Run(ctx, report)
+
+		require.ErrorIs(t, err, assert.AnError)
+		require.ErrorContains(t, err, string(usecases.CreateAssetSnapshotId))
+		assert.Empty(t, result)
+	})
+}
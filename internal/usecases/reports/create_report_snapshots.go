@@ -0,0 +1,194 @@
+package reports
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	log "github.com/sirupsen/logrus"
+)
+
+// snapshotReportTypes is the order in which the reports are stored and delivered
+var snapshotReportTypes = []reporting.ReportType{
+	reporting.ReportTypeSummaries,
+	reporting.ReportTypeRevenue,
+	reporting.ReportTypeAssets,
+}
+
+// snapshotDeliveryRetryWindow is how long after its creation the delivery of a snapshot is retried, so a sender
+// that was failing for a long time doesn't receive an unbounded backlog of old reports once it recovers
+const snapshotDeliveryRetryWindow = 7 * 24 * time.Hour
+
+// CreateReportSnapshotsUseCase stores the reports of a period of a schedule as immutable snapshots and delivers
+// them. The alert sender and the snapshot sender are optional, if they're nil the snapshots are only stored
+type CreateReportSnapshotsUseCase struct {
+	snapshotRepository reporting.SnapshotRepository
+	alertSender        alerts.AlertSender
+	alertRecipient     string
+	snapshotSender     reporting.SnapshotSender
+}
+
+func NewCreateReportSnapshotsUseCase(
+	snapshotRepository reporting.SnapshotRepository,
+	alertSender alerts.AlertSender,
+	alertRecipient string,
+	snapshotSender reporting.SnapshotSender,
+) *CreateReportSnapshotsUseCase {
+	return &CreateReportSnapshotsUseCase{
+		snapshotRepository: snapshotRepository,
+		alertSender:        alertSender,
+		alertRecipient:     alertRecipient,
+		snapshotSender:     snapshotSender,
+	}
+}
+
+// Run stores a snapshot of each one of the provided reports. The reports that already have a snapshot for the period
+// are skipped, so running it more than once for the same period doesn't duplicate the snapshots. After storing them,
+// all the snapshots that weren't delivered yet are delivered (see DeliverPending). The snapshots are stored even if
+// the delivery fails, in that case the created snapshots are returned along with the error
+func (useCase *CreateReportSnapshotsUseCase) Run(
+	ctx context.Context,
+	schedule reporting.Schedule,
+	periodStart, periodEnd time.Time,
+	reports map[reporting.ReportType]any,
+) ([]reporting.Snapshot, error) {
+	if !schedule.IsValid() {
+		return nil, usecases.WrapUseCaseError(usecases.CreateReportSnapshotsId, fmt.Errorf("invalid schedule %q", schedule))
+	}
+	createdAt := time.Now().UTC()
+	created := make([]reporting.Snapshot, 0, len(reports))
+	for _, reportType := range snapshotReportTypes {
+		report, ok := reports[reportType]
+		if !ok {
+			continue
+		}
+		content, err := json.Marshal(report)
+		if err != nil {
+			return created, usecases.WrapUseCaseError(usecases.CreateReportSnapshotsId, err)
+		}
+		snapshot := reporting.Snapshot{
+			Id:          reporting.SnapshotId(schedule, reportType, periodStart),
+			Schedule:    schedule,
+			ReportType:  reportType,
+			PeriodStart: periodStart.UTC(),
+			PeriodEnd:   periodEnd.UTC(),
+			CreatedAt:   createdAt,
+			Content:     content,
+		}
+		err = useCase.snapshotRepository.InsertSnapshot(ctx, snapshot)
+		if errors.Is(err, reporting.DuplicateSnapshotError) {
+			log.Debugf("Snapshot %s already exists", snapshot.Id)
+			continue
+		} else if err != nil {
+			return created, usecases.WrapUseCaseError(usecases.CreateReportSnapshotsId, err)
+		}
+		created = append(created, snapshot)
+	}
+
+	if err := useCase.deliverPending(ctx); err != nil {
+		return created, usecases.WrapUseCaseError(usecases.CreateReportSnapshotsId, err)
+	}
+	return created, nil
+}
+
+// DeliverPending delivers the snapshots created in the last snapshotDeliveryRetryWindow that weren't delivered
+// yet. The snapshots of the same period are sent in a single alert and one by one to the snapshot sender, a snapshot
+// is marked as delivered only if both deliveries succeed. If one of them fails the snapshot is retried in the next
+// execution, so the other destination might receive it more than once
+func (useCase *CreateReportSnapshotsUseCase) DeliverPending(ctx context.Context) error {
+	if err := useCase.deliverPending(ctx); err != nil {
+		return usecases.WrapUseCaseError(usecases.CreateReportSnapshotsId, err)
+	}
+	return nil
+}
+
+func (useCase *CreateReportSnapshotsUseCase) deliverPending(ctx context.Context) error {
+	if useCase.alertSender == nil && useCase.snapshotSender == nil {
+		return nil
+	}
+	pending, err := useCase.snapshotRepository.GetUndeliveredSnapshots(ctx, time.Now().Add(-snapshotDeliveryRetryWindow))
+	if err != nil {
+		return err
+	}
+	var deliveryErr error
+	for _, snapshots := range groupSnapshotsByPeriod(pending) {
+		delivered, err := useCase.deliver(ctx, snapshots)
+		deliveryErr = errors.Join(deliveryErr, err)
+		if len(delivered) == 0 {
+			continue
+		}
+		if err = useCase.snapshotRepository.MarkSnapshotsDelivered(ctx, delivered, time.Now().UTC()); err != nil {
+			deliveryErr = errors.Join(deliveryErr, fmt.Errorf("error marking snapshots as delivered: %w", err))
+		}
+	}
+	return deliveryErr
+}
+
+// deliver sends the snapshots of a period and returns the ids of the ones that were delivered to every sender
+func (useCase *CreateReportSnapshotsUseCase) deliver(ctx context.Context, snapshots []reporting.Snapshot) ([]string, error) {
+	var err error
+	if useCase.alertSender != nil {
+		if sendErr := useCase.alertSender.SendAlert(ctx, snapshotsAlert(snapshots[0].Schedule, snapshots), []string{useCase.alertRecipient}); sendErr != nil {
+			return nil, fmt.Errorf("error sending snapshots alert: %w", sendErr)
+		}
+	}
+	delivered := make([]string, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		if useCase.snapshotSender != nil {
+			if sendErr := useCase.snapshotSender.SendSnapshot(ctx, snapshot); sendErr != nil {
+				err = errors.Join(err, fmt.Errorf("error sending snapshot %s: %w", snapshot.Id, sendErr))
+				continue
+			}
+		}
+		delivered = append(delivered, snapshot.Id)
+	}
+	return delivered, err
+}
+
+// groupSnapshotsByPeriod splits the snapshots, sorted by period, in groups of the same schedule and period. The
+// snapshots of each group are sorted in the snapshotReportTypes order
+func groupSnapshotsByPeriod(snapshots []reporting.Snapshot) [][]reporting.Snapshot {
+	groups := make([][]reporting.Snapshot, 0)
+	for _, snapshot := range snapshots {
+		last := len(groups) - 1
+		if last >= 0 && groups[last][0].Schedule == snapshot.Schedule && groups[last][0].PeriodStart.Equal(snapshot.PeriodStart) {
+			groups[last] = append(groups[last], snapshot)
+		} else {
+			groups = append(groups, []reporting.Snapshot{snapshot})
+		}
+	}
+	for _, group := range groups {
+		slices.SortStableFunc(group, func(a, b reporting.Snapshot) int {
+			return slices.Index(snapshotReportTypes, a.ReportType) - slices.Index(snapshotReportTypes, b.ReportType)
+		})
+	}
+	return groups
+}
+
+func snapshotsAlert(schedule reporting.Schedule, snapshots []reporting.Snapshot) alerts.Alert {
+	kind, subject := alerts.AlertKindDailyReport, alerts.AlertSubjectDailyReport
+	if schedule == reporting.ScheduleWeekly {
+		kind, subject = alerts.AlertKindWeeklyReport, alerts.AlertSubjectWeeklyReport
+	}
+	first := snapshots[0]
+	message := new(strings.Builder)
+	fmt.Fprintf(message, "Reports from %s to %s (UTC). Amounts are in wei.",
+		first.PeriodStart.Format(time.DateOnly), first.PeriodEnd.Format(time.DateOnly))
+	for _, snapshot := range snapshots {
+		fmt.Fprintf(message, "\n\n%s:\n%s", snapshot.ReportType, snapshot.Content)
+	}
+	return alerts.Alert{
+		Kind:      kind,
+		Subject:   subject,
+		Severity:  alerts.AlertSeverityInfo,
+		Message:   message.String(),
+		Timestamp: first.CreatedAt,
+	}
+}
@@ -0,0 +1,234 @@
+package reports_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestCreateReportSnapshotsUseCase_Run(t *testing.T) {
+	const recipient = "recipient@test.com"
+	ctx := context.Background()
+	periodStart := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	periodEnd := time.Date(2024, 3, 10, 23, 59, 59, 999999999, time.UTC)
+	reportsToStore := map[reporting.ReportType]any{
+		reporting.ReportTypeRevenue: map[string]string{"totalQuoteCallFees": "1000"},
+		reporting.ReportTypeAssets:  map[string]string{"btcAssetReport": "2000"},
+	}
+	isSnapshot := func(reportType reporting.ReportType, content string) any {
+		return mock.MatchedBy(func(snapshot reporting.Snapshot) bool {
+			return snapshot.Id == reporting.SnapshotId(reporting.ScheduleWeekly, reportType, periodStart) &&
+				snapshot.Schedule == reporting.ScheduleWeekly && snapshot.ReportType == reportType &&
+				snapshot.PeriodStart.Equal(periodStart) && snapshot.PeriodEnd.Equal(periodEnd) &&
+				!snapshot.CreatedAt.IsZero() && string(snapshot.Content) == content
+		})
+	}
+
+	// storeInserted makes the repository return the inserted snapshots as the undelivered ones
+	storeInserted := func(repository *mocks.SnapshotRepositoryMock) {
+		pending := make([]reporting.Snapshot, 0)
+		repository.EXPECT().InsertSnapshot(ctx, mock.Anything).RunAndReturn(func(_ context.Context, snapshot reporting.Snapshot) error {
+			pending = append(pending, snapshot)
+			return nil
+		})
+		repository.EXPECT().GetUndeliveredSnapshots(ctx, mock.AnythingOfType("time.Time")).
+			RunAndReturn(func(context.Context, time.Time) ([]reporting.Snapshot, error) { return pending, nil }).Once()
+	}
+	revenueId := reporting.SnapshotId(reporting.ScheduleWeekly, reporting.ReportTypeRevenue, periodStart)
+	assetsId := reporting.SnapshotId(reporting.ScheduleWeekly, reporting.ReportTypeAssets, periodStart)
+
+	t.Run("should store and deliver the snapshots", func(t *testing.T) {
+		repository := mocks.NewSnapshotRepositoryMock(t)
+		snapshotSender := mocks.NewSnapshotSenderMock(t)
+		alertSender := &mocks.AlertSenderMock{}
+		storeInserted(repository)
+		snapshotSender.EXPECT().SendSnapshot(ctx, isSnapshot(reporting.ReportTypeRevenue, `{"totalQuoteCallFees":"1000"}`)).Return(nil).Once()
+		snapshotSender.EXPECT().SendSnapshot(ctx, isSnapshot(reporting.ReportTypeAssets, `{"btcAssetReport":"2000"}`)).Return(nil).Once()
+		alertSender.On("SendAlert", ctx, mock.MatchedBy(func(alert alerts.Alert) bool {
+			return alert.Kind == alerts.AlertKindWeeklyReport && alert.Subject == alerts.AlertSubjectWeeklyReport &&
+				alert.Severity == alerts.AlertSeverityInfo &&
+				strings.HasPrefix(alert.Message, "Reports from 2024-03-04 to 2024-03-10 (UTC).") &&
+				strings.Index(alert.Message, "revenue:") < strings.Index(alert.Message, "assets:")
+		}), []string{recipient}).Return(nil).Once()
+		repository.EXPECT().MarkSnapshotsDelivered(ctx, []string{revenueId, assetsId}, mock.AnythingOfType("time.Time")).Return(nil).Once()
+		useCase := reports.NewCreateReportSnapshotsUseCase(repository, alertSender, recipient, snapshotSender)
+
+		result, err := useCase.Run(ctx, reporting.ScheduleWeekly, periodStart, periodEnd, reportsToStore)
+
+		require.NoError(t, err)
+		alertSender.AssertExpectations(t)
+		require.Len(t, result, 2)
+		assert.Equal(t, reporting.ReportTypeRevenue, result[0].ReportType)
+		assert.Equal(t, reporting.ReportTypeAssets, result[1].ReportType)
+		assert.Equal(t, json.RawMessage(`{"btcAssetReport":"2000"}`), result[1].Content)
+	})
+	t.Run("should skip the snapshots that already exist", func(t *testing.T) {
+		repository := mocks.NewSnapshotRepositoryMock(t)
+		snapshotSender := mocks.NewSnapshotSenderMock(t)
+		repository.EXPECT().InsertSnapshot(ctx, isSnapshot(reporting.ReportTypeRevenue, `{"totalQuoteCallFees":"1000"}`)).
+			Return(reporting.DuplicateSnapshotError).Once()
+		repository.EXPECT().InsertSnapshot(ctx, isSnapshot(reporting.ReportTypeAssets, `{"btcAssetReport":"2000"}`)).Return(nil).Once()
+		useCase := reports.NewCreateReportSnapshotsUseCase(repository, nil, recipient, nil)
+
+		result, err := useCase.Run(ctx, reporting.ScheduleWeekly, periodStart, periodEnd, reportsToStore)
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, reporting.ReportTypeAssets, result[0].ReportType)
+		snapshotSender.AssertNotCalled(t, "SendSnapshot")
+	})
+	t.Run("should retry the undelivered snapshots even if all the snapshots already exist", func(t *testing.T) {
+		repository := mocks.NewSnapshotRepositoryMock(t)
+		snapshotSender := mocks.NewSnapshotSenderMock(t)
+		alertSender := &mocks.AlertSenderMock{}
+		previous := reporting.Snapshot{Id: "daily:revenue:2024-03-03", Schedule: reporting.ScheduleDaily, ReportType: reporting.ReportTypeRevenue,
+			PeriodStart: periodStart.AddDate(0, 0, -1), PeriodEnd: periodStart.Add(-time.Nanosecond), Content: json.RawMessage(`{}`)}
+		repository.EXPECT().InsertSnapshot(ctx, mock.Anything).Return(reporting.DuplicateSnapshotError).Twice()
+		repository.EXPECT().GetUndeliveredSnapshots(ctx, mock.AnythingOfType("time.Time")).Return([]reporting.Snapshot{previous}, nil).Once()
+		alertSender.On("SendAlert", ctx, mock.MatchedBy(func(alert alerts.Alert) bool {
+			return alert.Kind == alerts.AlertKindDailyReport
+		}), []string{recipient}).Return(nil).Once()
+		snapshotSender.EXPECT().SendSnapshot(ctx, previous).Return(nil).Once()
+		repository.EXPECT().MarkSnapshotsDelivered(ctx, []string{previous.Id}, mock.AnythingOfType("time.Time")).Return(nil).Once()
+		useCase := reports.NewCreateReportSnapshotsUseCase(repository, alertSender, recipient, snapshotSender)
+
+		result, err := useCase.Run(ctx, reporting.ScheduleWeekly, periodStart, periodEnd, reportsToStore)
+
+		require.NoError(t, err)
+		assert.Empty(t, result)
+		alertSender.AssertExpectations(t)
+	})
+	t.Run("should not deliver anything if there are no undelivered snapshots", func(t *testing.T) {
+		repository := mocks.NewSnapshotRepositoryMock(t)
+		snapshotSender := mocks.NewSnapshotSenderMock(t)
+		alertSender := &mocks.AlertSenderMock{}
+		repository.EXPECT().InsertSnapshot(ctx, mock.Anything).Return(reporting.DuplicateSnapshotError).Twice()
+		repository.EXPECT().GetUndeliveredSnapshots(ctx, mock.AnythingOfType("time.Time")).Return([]reporting.Snapshot{}, nil).Once()
+		useCase := reports.NewCreateReportSnapshotsUseCase(repository, alertSender, recipient, snapshotSender)
+
+		result, err := useCase.Run(ctx, reporting.ScheduleWeekly, periodStart, periodEnd, reportsToStore)
+
+		require.NoError(t, err)
+		assert.Empty(t, result)
+		alertSender.AssertNotCalled(t, "SendAlert")
+	})
+	t.Run("should only mark as delivered the snapshots sent to every destination", func(t *testing.T) {
+		repository := mocks.NewSnapshotRepositoryMock(t)
+		snapshotSender := mocks.NewSnapshotSenderMock(t)
+		alertSender := &mocks.AlertSenderMock{}
+		storeInserted(repository)
+		snapshotSender.EXPECT().SendSnapshot(ctx, isSnapshot(reporting.ReportTypeRevenue, `{"totalQuoteCallFees":"1000"}`)).Return(assert.AnError).Once()
+		snapshotSender.EXPECT().SendSnapshot(ctx, isSnapshot(reporting.ReportTypeAssets, `{"btcAssetReport":"2000"}`)).Return(nil).Once()
+		alertSender.On("SendAlert", ctx, mock.Anything, []string{recipient}).Return(nil).Once()
+		repository.EXPECT().MarkSnapshotsDelivered(ctx, []string{assetsId}, mock.AnythingOfType("time.Time")).Return(nil).Once()
+		useCase := reports.NewCreateReportSnapshotsUseCase(repository, alertSender, recipient, snapshotSender)
+
+		result, err := useCase.Run(ctx, reporting.ScheduleWeekly, periodStart, periodEnd, reportsToStore)
+
+		require.ErrorIs(t, err, assert.AnError)
+		require.ErrorContains(t, err, string(usecases.CreateReportSnapshotsId))
+		assert.Len(t, result, 2)
+		alertSender.AssertExpectations(t)
+	})
+	t.Run("should not mark any snapshot as delivered if the alert fails", func(t *testing.T) {
+		repository := mocks.NewSnapshotRepositoryMock(t)
+		snapshotSender := mocks.NewSnapshotSenderMock(t)
+		alertSender := &mocks.AlertSenderMock{}
+		storeInserted(repository)
+		alertSender.On("SendAlert", ctx, mock.Anything, []string{recipient}).Return(assert.AnError).Once()
+		useCase := reports.NewCreateReportSnapshotsUseCase(repository, alertSender, recipient, snapshotSender)
+
+		result, err := useCase.Run(ctx, reporting.ScheduleWeekly, periodStart, periodEnd, reportsToStore)
+
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Len(t, result, 2)
+		snapshotSender.AssertNotCalled(t, "SendSnapshot")
+		repository.AssertNotCalled(t, "MarkSnapshotsDelivered")
+	})
+	t.Run("should return error if the snapshot can't be stored", func(t *testing.T) {
+		repository := mocks.NewSnapshotRepositoryMock(t)
+		repository.EXPECT().InsertSnapshot(ctx, mock.Anything).Return(assert.AnError).Once()
+		useCase := reports.NewCreateReportSnapshotsUseCase(repository, nil, recipient, nil)
+
+		result, err := useCase.Run(ctx, reporting.ScheduleDaily, periodStart, periodEnd, reportsToStore)
+
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Empty(t, result)
+	})
+	t.Run("should return error if the schedule is invalid", func(t *testing.T) {
+		repository := mocks.NewSnapshotRepositoryMock(t)
+		useCase := reports.NewCreateReportSnapshotsUseCase(repository, nil, recipient, nil)
+
+		result, err := useCase.Run(ctx, "monthly", periodStart, periodEnd, reportsToStore)
+
+		require.ErrorContains(t, err, "invalid schedule")
+		assert.Nil(t, result)
+	})
+}
+
+func TestCreateReportSnapshotsUseCase_DeliverPending(t *testing.T) {
+	ctx := context.Background()
+	dailyStart := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	weeklyStart := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	pending := []reporting.Snapshot{
+		{Id: "weekly:assets:2024-03-04", Schedule: reporting.ScheduleWeekly, ReportType: reporting.ReportTypeAssets, PeriodStart: weeklyStart, Content: json.RawMessage(`{}`)},
+		{Id: "weekly:revenue:2024-03-04", Schedule: reporting.ScheduleWeekly, ReportType: reporting.ReportTypeRevenue, PeriodStart: weeklyStart, Content: json.RawMessage(`{}`)},
+		{Id: "daily:revenue:2024-03-10", Schedule: reporting.ScheduleDaily, ReportType: reporting.ReportTypeRevenue, PeriodStart: dailyStart, Content: json.RawMessage(`{}`)},
+	}
+
+	t.Run("should deliver the pending snapshots grouped by period", func(t *testing.T) {
+		repository := mocks.NewSnapshotRepositoryMock(t)
+		alertSender := &mocks.AlertSenderMock{}
+		repository.EXPECT().GetUndeliveredSnapshots(ctx, mock.MatchedBy(func(createdAfter time.Time) bool {
+			return time.Since(createdAfter) > 6*24*time.Hour && time.Since(createdAfter) < 8*24*time.Hour
+		})).Return(pending, nil).Once()
+		alertSender.On("SendAlert", ctx, mock.MatchedBy(func(alert alerts.Alert) bool {
+			return alert.Kind == alerts.AlertKindWeeklyReport &&
+				strings.Index(alert.Message, "revenue:") < strings.Index(alert.Message, "assets:")
+		}), []string{"recipient"}).Return(nil).Once()
+		alertSender.On("SendAlert", ctx, mock.MatchedBy(func(alert alerts.Alert) bool {
+			return alert.Kind == alerts.AlertKindDailyReport
+		}), []string{"recipient"}).Return(assert.AnError).Once()
+		repository.EXPECT().MarkSnapshotsDelivered(ctx, []string{"weekly:revenue:2024-03-04", "weekly:assets:2024-03-04"}, mock.AnythingOfType("time.Time")).
+			Return(nil).Once()
+		useCase := reports.NewCreateReportSnapshotsUseCase(repository, alertSender, "recipient", nil)
+
+		err := useCase.DeliverPending(ctx)
+
+		require.ErrorIs(t, err, assert.AnError)
+		require.ErrorContains(t, err, string(usecases.CreateReportSnapshotsId))
+		alertSender.AssertExpectations(t)
+	})
+	t.Run("should not query the snapshots if there is no sender", func(t *testing.T) {
+		repository := mocks.NewSnapshotRepositoryMock(t)
+		useCase := reports.NewCreateReportSnapshotsUseCase(repository, nil, "recipient", nil)
+		require.NoError(t, useCase.DeliverPending(ctx))
+	})
+	t.Run("should return error if the pending snapshots can't be read", func(t *testing.T) {
+		repository := mocks.NewSnapshotRepositoryMock(t)
+		repository.EXPECT().GetUndeliveredSnapshots(ctx, mock.Anything).Return(nil, assert.AnError).Once()
+		useCase := reports.NewCreateReportSnapshotsUseCase(repository, nil, "recipient", mocks.NewSnapshotSenderMock(t))
+		require.ErrorIs(t, useCase.DeliverPending(ctx), assert.AnError)
+	})
+	t.Run("should return error if the snapshots can't be marked as delivered", func(t *testing.T) {
+		repository := mocks.NewSnapshotRepositoryMock(t)
+		snapshotSender := mocks.NewSnapshotSenderMock(t)
+		repository.EXPECT().GetUndeliveredSnapshots(ctx, mock.Anything).Return(pending[2:], nil).Once()
+		snapshotSender.EXPECT().SendSnapshot(ctx, pending[2]).Return(nil).Once()
+		repository.EXPECT().MarkSnapshotsDelivered(ctx, []string{pending[2].Id}, mock.Anything).Return(assert.AnError).Once()
+		useCase := reports.NewCreateReportSnapshotsUseCase(repository, nil, "recipient", snapshotSender)
+		require.ErrorIs(t, useCase.DeliverPending(ctx), assert.AnError)
+	})
+}
@@ -0,0 +1,94 @@
+package reports
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+type AnalyticsDirection string
+
+const (
+	AnalyticsDirectionPegin  AnalyticsDirection = "pegin"
+	AnalyticsDirectionPegout AnalyticsDirection = "pegout"
+)
+
+type AnalyticsReportBucket struct {
+	quote.AnalyticsBucket
+	Direction AnalyticsDirection
+	NetProfit *entities.Wei
+}
+
+type GetAnalyticsReportResult struct {
+	Period  quote.AnalyticsPeriod
+	Buckets []AnalyticsReportBucket
+}
+
+// GetAnalyticsReportUseCase returns the aggregations of the summaries and revenue reports bucketed by period,
+// direction and trusted account owner. The aggregation is done by the repositories, so the quotes are not loaded in memory
+type GetAnalyticsReportUseCase struct {
+	peginQuoteRepository  quote.PeginQuoteRepository
+	pegoutQuoteRepository quote.PegoutQuoteRepository
+}
+
+func NewGetAnalyticsReportUseCase(
+	peginQuoteRepository quote.PeginQuoteRepository,
+	pegoutQuoteRepository quote.PegoutQuoteRepository,
+) *GetAnalyticsReportUseCase {
+	return &GetAnalyticsReportUseCase{
+		peginQuoteRepository:  peginQuoteRepository,
+		pegoutQuoteRepository: pegoutQuoteRepository,
+	}
+}
+
+func (useCase *GetAnalyticsReportUseCase) Run(
+	ctx context.Context,
+	period quote.AnalyticsPeriod,
+	startDate, endDate time.Time,
+) (GetAnalyticsReportResult, error) {
+	peginBuckets, err := useCase.peginQuoteRepository.GetAnalyticsBuckets(ctx, quote.AnalyticsQuery[quote.PeginState]{
+		Period:         period,
+		StartDate:      startDate,
+		EndDate:        endDate,
+		PaidStates:     slices.Sorted(maps.Keys(getPeginPaidStates())),
+		RefundedStates: slices.Sorted(maps.Keys(getPeginRefundedStates())),
+		RevenueStates:  getPeginRevenueStates(),
+	})
+	if err != nil {
+		return GetAnalyticsReportResult{}, usecases.WrapUseCaseError(usecases.GetAnalyticsReportId, err)
+	}
+
+	pegoutBuckets, err := useCase.pegoutQuoteRepository.GetAnalyticsBuckets(ctx, quote.AnalyticsQuery[quote.PegoutState]{
+		Period:         period,
+		StartDate:      startDate,
+		EndDate:        endDate,
+		PaidStates:     slices.Sorted(maps.Keys(getPegoutPaidStates())),
+		RefundedStates: slices.Sorted(maps.Keys(getPegoutRefundedStates())),
+		RevenueStates:  getPegoutRevenueStates(),
+	})
+	if err != nil {
+		return GetAnalyticsReportResult{}, usecases.WrapUseCaseError(usecases.GetAnalyticsReportId, err)
+	}
+
+	buckets := make([]AnalyticsReportBucket, 0, len(peginBuckets)+len(pegoutBuckets))
+	for _, bucket := range peginBuckets {
+		buckets = append(buckets, AnalyticsReportBucket{AnalyticsBucket: bucket, Direction: AnalyticsDirectionPegin, NetProfit: bucket.NetProfit()})
+	}
+	for _, bucket := range pegoutBuckets {
+		buckets = append(buckets, AnalyticsReportBucket{AnalyticsBucket: bucket, Direction: AnalyticsDirectionPegout, NetProfit: bucket.NetProfit()})
+	}
+	slices.SortStableFunc(buckets, func(a, b AnalyticsReportBucket) int {
+		return cmp.Or(
+			a.PeriodStart.Compare(b.PeriodStart),
+			cmp.Compare(a.Direction, b.Direction),
+			cmp.Compare(a.OwnerAccountAddress, b.OwnerAccountAddress),
+		)
+	})
+	return GetAnalyticsReportResult{Period: period, Buckets: buckets}, nil
+}
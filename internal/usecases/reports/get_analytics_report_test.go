@@ -0,0 +1,126 @@
+package reports_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func analyticsTestBucket(periodStart time.Time, owner string, callFees int64) quote.AnalyticsBucket {
+	return quote.AnalyticsBucket{
+		PeriodStart:          periodStart,
+		OwnerAccountAddress:  owner,
+		TotalQuotesCount:     2,
+		AcceptedQuotesCount:  1,
+		AcceptedQuotesAmount: entities.NewWei(1000),
+		PaidQuotesAmount:     entities.NewWei(0),
+		RefundedQuotesAmount: entities.NewWei(0),
+		PenalizationsAmount:  entities.NewWei(10),
+		CallFees:             entities.NewWei(callFees),
+		GasFeesCollected:     entities.NewWei(50),
+		GasSpent:             entities.NewWei(20),
+		BtcFeesPaid:          entities.NewWei(5),
+	}
+}
+
+// nolint:funlen
+func TestGetAnalyticsReportUseCase_Run(t *testing.T) {
+	ctx := context.Background()
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 2, 29, 23, 59, 59, 0, time.UTC)
+	january := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	february := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should merge the buckets of both directions sorted by period, direction and owner", func(t *testing.T) {
+		peginRepository := &mocks.PeginQuoteRepositoryMock{}
+		pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
+		peginRepository.EXPECT().GetAnalyticsBuckets(ctx, quote.AnalyticsQuery[quote.PeginState]{
+			Period:    quote.AnalyticsPeriodMonth,
+			StartDate: startDate,
+			EndDate:   endDate,
+			PaidStates: []quote.PeginState{
+				quote.PeginStateCallForUserSucceeded,
+				quote.PeginStateRegisterPegInFailed,
+				quote.PeginStateRegisterPegInSucceeded,
+			},
+			RefundedStates: []quote.PeginState{quote.PeginStateRegisterPegInSucceeded},
+			RevenueStates:  []quote.PeginState{quote.PeginStateRegisterPegInSucceeded},
+		}).Return([]quote.AnalyticsBucket{
+			analyticsTestBucket(january, test.AnyRskAddress, 100),
+			analyticsTestBucket(january, "", 200),
+			analyticsTestBucket(february, "", 300),
+		}, nil).Once()
+		pegoutRepository.EXPECT().GetAnalyticsBuckets(ctx, mock.MatchedBy(func(query quote.AnalyticsQuery[quote.PegoutState]) bool {
+			return query.Period == quote.AnalyticsPeriodMonth && query.StartDate.Equal(startDate) && query.EndDate.Equal(endDate) &&
+				assert.ElementsMatch(t, []quote.PegoutState{
+					quote.PegoutStateRefundPegOutSucceeded, quote.PegoutStateBridgeTxSucceeded, quote.PegoutStateBtcReleased,
+				}, query.RevenueStates) &&
+				assert.Len(t, query.PaidStates, 6) && assert.Len(t, query.RefundedStates, 4)
+		})).Return([]quote.AnalyticsBucket{
+			analyticsTestBucket(january, test.AnyRskAddress, 400),
+		}, nil).Once()
+		useCase := reports.NewGetAnalyticsReportUseCase(peginRepository, pegoutRepository)
+
+		result, err := useCase.Run(ctx, quote.AnalyticsPeriodMonth, startDate, endDate)
+
+		require.NoError(t, err)
+		peginRepository.AssertExpectations(t)
+		pegoutRepository.AssertExpectations(t)
+		assert.Equal(t, quote.AnalyticsPeriodMonth, result.Period)
+		require.Len(t, result.Buckets, 4)
+		expected := []struct {
+			period    time.Time
+			direction reports.AnalyticsDirection
+			owner     string
+			netProfit *entities.Wei
+		}{
+			{january, reports.AnalyticsDirectionPegin, "", entities.NewWei(215)},
+			{january, reports.AnalyticsDirectionPegin, test.AnyRskAddress, entities.NewWei(115)},
+			{january, reports.AnalyticsDirectionPegout, test.AnyRskAddress, entities.NewWei(415)},
+			{february, reports.AnalyticsDirectionPegin, "", entities.NewWei(315)},
+		}
+		for i, bucket := range result.Buckets {
+			assert.Equal(t, expected[i].period, bucket.PeriodStart)
+			assert.Equal(t, expected[i].direction, bucket.Direction)
+			assert.Equal(t, expected[i].owner, bucket.OwnerAccountAddress)
+			assert.Equal(t, expected[i].netProfit, bucket.NetProfit)
+		}
+	})
+
+	t.Run("should return an error if the pegin aggregation fails", func(t *testing.T) {
+		peginRepository := &mocks.PeginQuoteRepositoryMock{}
+		pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
+		peginRepository.EXPECT().GetAnalyticsBuckets(ctx, mock.Anything).Return(nil, assert.AnError).Once()
+		useCase := reports.NewGetAnalyticsReportUseCase(peginRepository, pegoutRepository)
+
+		result, err := useCase.Run(ctx, quote.AnalyticsPeriodDay, startDate, endDate)
+
+		require.ErrorIs(t, err, assert.AnError)
+		require.ErrorContains(t, err, string(usecases.GetAnalyticsReportId))
+		assert.Empty(t, result)
+		pegoutRepository.AssertNotCalled(t, "GetAnalyticsBuckets", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return an error if the pegout aggregation fails", func(t *testing.T) {
+		peginRepository := &mocks.PeginQuoteRepositoryMock{}
+		pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
+		peginRepository.EXPECT().GetAnalyticsBuckets(ctx, mock.Anything).Return([]quote.AnalyticsBucket{}, nil).Once()
+		pegoutRepository.EXPECT().GetAnalyticsBuckets(ctx, mock.Anything).Return(nil, assert.AnError).Once()
+		useCase := reports.NewGetAnalyticsReportUseCase(peginRepository, pegoutRepository)
+
+		result, err := useCase.Run(ctx, quote.AnalyticsPeriodWeek, startDate, endDate)
+
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Empty(t, result)
+	})
+}
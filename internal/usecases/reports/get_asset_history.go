@@ -0,0 +1,38 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+// GetAssetHistoryUseCase returns the asset snapshots of a range downsampled to the requested interval. The snapshots
+// are taken every minute, so the raw interval is limited to reporting.MaxRawAssetHistoryRange
+type GetAssetHistoryUseCase struct {
+	assetSnapshotRepository reporting.AssetSnapshotRepository
+}
+
+func NewGetAssetHistoryUseCase(assetSnapshotRepository reporting.AssetSnapshotRepository) *GetAssetHistoryUseCase {
+	return &GetAssetHistoryUseCase{assetSnapshotRepository: assetSnapshotRepository}
+}
+
+func (useCase *GetAssetHistoryUseCase) Run(
+	ctx context.Context,
+	startDate, endDate time.Time,
+	interval reporting.AssetHistoryInterval,
+) ([]reporting.AssetSnapshot, error) {
+	if !interval.IsValid() {
+		return nil, usecases.WrapUseCaseError(usecases.GetAssetHistoryId, fmt.Errorf("invalid interval %q", interval))
+	}
+	if interval == reporting.AssetHistoryIntervalRaw && endDate.Sub(startDate) > reporting.MaxRawAssetHistoryRange {
+		return nil, usecases.WrapUseCaseError(usecases.GetAssetHistoryId, usecases.AssetHistoryRangeError)
+	}
+	snapshots, err := useCase.assetSnapshotRepository.GetAssetSnapshots(ctx, startDate, endDate, interval)
+	if err != nil {
+		return nil, usecases.WrapUseCaseError(usecases.GetAssetHistoryId, err)
+	}
+	return snapshots, nil
+}
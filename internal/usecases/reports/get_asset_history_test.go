@@ -0,0 +1,76 @@
+package reports_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAssetHistoryUseCase_Run(t *testing.T) {
+	ctx := context.Background()
+	startDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 3, 31, 23, 59, 59, 0, time.UTC)
+
+	t.Run("should return the snapshots of the range", func(t *testing.T) {
+		snapshots := []reporting.AssetSnapshot{
+			{Timestamp: time.Date(2024, 3, 1, 23, 59, 0, 0, time.UTC), Btc: reporting.AssetBalance{Total: entities.NewWei(100)}},
+			{Timestamp: time.Date(2024, 3, 2, 23, 59, 0, 0, time.UTC), Btc: reporting.AssetBalance{Total: entities.NewWei(200)}},
+		}
+		repository := mocks.NewAssetSnapshotRepositoryMock(t)
+		repository.EXPECT().GetAssetSnapshots(ctx, startDate, endDate, reporting.AssetHistoryIntervalDay).Return(snapshots, nil).Once()
+		useCase := reports.NewGetAssetHistoryUseCase(repository)
+
+		result, err := useCase.Run(ctx, startDate, endDate, reporting.AssetHistoryIntervalDay)
+
+		require.NoError(t, err)
+		assert.Equal(t, snapshots, result)
+	})
+	t.Run("should return error if the interval is invalid", func(t *testing.T) {
+		repository := mocks.NewAssetSnapshotRepositoryMock(t)
+		useCase := reports.NewGetAssetHistoryUseCase(repository)
+
+		result, err := useCase.Run(ctx, startDate, endDate, "week")
+
+		require.ErrorContains(t, err, "invalid interval")
+		assert.Nil(t, result)
+	})
+	t.Run("should return error if the raw range is longer than the maximum", func(t *testing.T) {
+		repository := mocks.NewAssetSnapshotRepositoryMock(t)
+		useCase := reports.NewGetAssetHistoryUseCase(repository)
+
+		result, err := useCase.Run(ctx, startDate, startDate.Add(reporting.MaxRawAssetHistoryRange+time.Second), reporting.AssetHistoryIntervalRaw)
+
+		require.ErrorIs(t, err, usecases.AssetHistoryRangeError)
+		assert.Nil(t, result)
+	})
+	t.Run("should return the raw snapshots of a range up to the maximum", func(t *testing.T) {
+		rawEnd := startDate.Add(reporting.MaxRawAssetHistoryRange)
+		repository := mocks.NewAssetSnapshotRepositoryMock(t)
+		repository.EXPECT().GetAssetSnapshots(ctx, startDate, rawEnd, reporting.AssetHistoryIntervalRaw).Return([]reporting.AssetSnapshot{}, nil).Once()
+		useCase := reports.NewGetAssetHistoryUseCase(repository)
+
+		result, err := useCase.Run(ctx, startDate, rawEnd, reporting.AssetHistoryIntervalRaw)
+
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+	t.Run("should wrap repository errors", func(t *testing.T) {
+		repository := mocks.NewAssetSnapshotRepositoryMock(t)
+		repository.EXPECT().GetAssetSnapshots(ctx, startDate, endDate, reporting.AssetHistoryIntervalHour).Return(nil, assert.AnError).Once()
+		useCase := reports.NewGetAssetHistoryUseCase(repository)
+
+		result, err := useCase.Run(ctx, startDate, endDate, reporting.AssetHistoryIntervalHour)
+
+		require.ErrorIs(t, err, assert.AnError)
+		require.ErrorContains(t, err, string(usecases.GetAssetHistoryId))
+		assert.Nil(t, result)
+	})
+}
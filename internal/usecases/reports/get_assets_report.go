@@ -46,8 +46,8 @@ type RbtcAssetReport struct {
 	Allocation RbtcAssetAllocation `json:"allocation" validate:"required"`
 }
 type GetAssetsReportResult struct {
-	BtcAssetReport  BtcAssetReport
-	RbtcAssetReport RbtcAssetReport
+	BtcAssetReport  BtcAssetReport  `json:"btcAssetReport"`
+	RbtcAssetReport RbtcAssetReport `json:"rbtcAssetReport"`
 }
 
 type GetAssetsReportUseCase struct {
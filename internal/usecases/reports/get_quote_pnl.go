@@ -0,0 +1,199 @@
+package reports
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/penalization"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+type QuoteCostType string
+
+const (
+	QuoteCostCallForUser   QuoteCostType = "call_for_user"
+	QuoteCostRegisterPegin QuoteCostType = "register_pegin"
+	QuoteCostRefundPegout  QuoteCostType = "refund_pegout"
+	QuoteCostBridgeRefund  QuoteCostType = "bridge_refund"
+	QuoteCostSendPegoutBtc QuoteCostType = "send_pegout_btc"
+)
+
+// QuoteCost is a transaction paid by the LP to process a quote. The RSK transactions have the gas used and the gas
+// price, the BTC transaction only has the fee paid (Amount) and zero gas
+type QuoteCost struct {
+	Type     QuoteCostType
+	TxHash   string
+	GasUsed  uint64
+	GasPrice *entities.Wei
+	Amount   *entities.Wei
+}
+
+// QuotePnl is the profit and loss of a single quote. GasFeeMargin compares the gas fee quoted to the user with
+// the gas and BTC fees actually paid by the LP and NetMargin is what the LP earned with the quote after
+// subtracting the costs and the penalizations from the fees. The fees are only counted as income once the quote
+// reaches one of the states considered by the revenue report (Realized), before that NetMargin has only the costs
+type QuotePnl struct {
+	QuoteHash           string
+	Direction           AnalyticsDirection
+	State               string
+	OwnerAccountAddress string
+	AgreementDate       time.Time
+	Value               *entities.Wei
+	CallFee             *entities.Wei
+	GasFee              *entities.Wei
+	Costs               []QuoteCost
+	TotalGasSpent       *entities.Wei
+	BtcFeePaid          *entities.Wei
+	Penalizations       []penalization.PenalizedEvent
+	TotalPenalizations  *entities.Wei
+	GasFeeMargin        *entities.Wei
+	NetMargin           *entities.Wei
+	Realized            bool
+}
+
+// GetQuotePnlUseCase returns the profit and loss of a quote of any direction using the data stored when the quote was processed
+type GetQuotePnlUseCase struct {
+	peginQuoteRepository     quote.PeginQuoteRepository
+	pegoutQuoteRepository    quote.PegoutQuoteRepository
+	penalizedEventRepository penalization.PenalizedEventRepository
+}
+
+func NewGetQuotePnlUseCase(
+	peginQuoteRepository quote.PeginQuoteRepository,
+	pegoutQuoteRepository quote.PegoutQuoteRepository,
+	penalizedEventRepository penalization.PenalizedEventRepository,
+) *GetQuotePnlUseCase {
+	return &GetQuotePnlUseCase{
+		peginQuoteRepository:     peginQuoteRepository,
+		pegoutQuoteRepository:    pegoutQuoteRepository,
+		penalizedEventRepository: penalizedEventRepository,
+	}
+}
+
+func (useCase *GetQuotePnlUseCase) Run(ctx context.Context, quoteHash string) (QuotePnl, error) {
+	result, err := useCase.getQuote(ctx, quoteHash)
+	if err != nil {
+		return QuotePnl{}, usecases.WrapUseCaseError(usecases.GetQuotePnlId, err)
+	}
+
+	penalizations, err := useCase.penalizedEventRepository.GetPenalizationsByQuoteHashes(ctx, []string{quoteHash})
+	if err != nil {
+		return QuotePnl{}, usecases.WrapUseCaseError(usecases.GetQuotePnlId, err)
+	}
+	result.Penalizations = penalizations
+	result.TotalPenalizations = entities.NewWei(0)
+	for _, event := range penalizations {
+		result.TotalPenalizations.Add(result.TotalPenalizations, event.Penalty)
+	}
+
+	result.TotalGasSpent = entities.NewWei(0)
+	result.BtcFeePaid = entities.NewWei(0)
+	for _, cost := range result.Costs {
+		if cost.Type == QuoteCostSendPegoutBtc {
+			result.BtcFeePaid.Add(result.BtcFeePaid, cost.Amount)
+		} else {
+			result.TotalGasSpent.Add(result.TotalGasSpent, cost.Amount)
+		}
+	}
+
+	result.GasFeeMargin = new(entities.Wei).Sub(result.GasFee, result.TotalGasSpent)
+	result.GasFeeMargin.Sub(result.GasFeeMargin, result.BtcFeePaid)
+	result.NetMargin = entities.NewWei(0)
+	if result.Realized {
+		result.NetMargin.Add(result.CallFee, result.GasFee)
+	}
+	result.NetMargin.Sub(result.NetMargin, result.TotalGasSpent)
+	result.NetMargin.Sub(result.NetMargin, result.BtcFeePaid)
+	result.NetMargin.Sub(result.NetMargin, result.TotalPenalizations)
+	return result, nil
+}
+
+// getQuote looks for the quote in the pegin quotes first and then in the pegout quotes and returns the fees
+// and costs of the one that matches the hash
+func (useCase *GetQuotePnlUseCase) getQuote(ctx context.Context, quoteHash string) (QuotePnl, error) {
+	peginQuote, err := useCase.peginQuoteRepository.GetQuote(ctx, quoteHash)
+	if err != nil {
+		return QuotePnl{}, err
+	} else if peginQuote != nil {
+		return useCase.getPeginPnl(ctx, quoteHash, *peginQuote)
+	}
+
+	pegoutQuote, err := useCase.pegoutQuoteRepository.GetQuote(ctx, quoteHash)
+	if err != nil {
+		return QuotePnl{}, err
+	} else if pegoutQuote != nil {
+		return useCase.getPegoutPnl(ctx, quoteHash, *pegoutQuote)
+	}
+	return QuotePnl{}, usecases.QuoteNotFoundError
+}
+
+func (useCase *GetQuotePnlUseCase) getPeginPnl(ctx context.Context, quoteHash string, peginQuote quote.PeginQuote) (QuotePnl, error) {
+	retainedQuote, err := useCase.peginQuoteRepository.GetRetainedQuote(ctx, quoteHash)
+	if err != nil {
+		return QuotePnl{}, err
+	} else if retainedQuote == nil {
+		return QuotePnl{}, usecases.QuoteNotAcceptedError
+	}
+	retainedQuote.FillZeroValues()
+	return QuotePnl{
+		QuoteHash:           quoteHash,
+		Direction:           AnalyticsDirectionPegin,
+		State:               string(retainedQuote.State),
+		OwnerAccountAddress: retainedQuote.OwnerAccountAddress,
+		AgreementDate:       time.Unix(int64(peginQuote.AgreementTimestamp), 0).UTC(),
+		Value:               weiOrZero(peginQuote.Value),
+		CallFee:             weiOrZero(peginQuote.CallFee),
+		GasFee:              weiOrZero(peginQuote.GasFee),
+		Realized:            slices.Contains(getPeginRevenueStates(), retainedQuote.State),
+		Costs: []QuoteCost{
+			newGasCost(QuoteCostCallForUser, retainedQuote.CallForUserTxHash, retainedQuote.CallForUserGasUsed, retainedQuote.CallForUserGasPrice),
+			newGasCost(QuoteCostRegisterPegin, retainedQuote.RegisterPeginTxHash, retainedQuote.RegisterPeginGasUsed, retainedQuote.RegisterPeginGasPrice),
+		},
+	}, nil
+}
+
+func (useCase *GetQuotePnlUseCase) getPegoutPnl(ctx context.Context, quoteHash string, pegoutQuote quote.PegoutQuote) (QuotePnl, error) {
+	retainedQuote, err := useCase.pegoutQuoteRepository.GetRetainedQuote(ctx, quoteHash)
+	if err != nil {
+		return QuotePnl{}, err
+	} else if retainedQuote == nil {
+		return QuotePnl{}, usecases.QuoteNotAcceptedError
+	}
+	retainedQuote.FillZeroValues()
+	return QuotePnl{
+		QuoteHash:           quoteHash,
+		Direction:           AnalyticsDirectionPegout,
+		State:               string(retainedQuote.State),
+		OwnerAccountAddress: retainedQuote.OwnerAccountAddress,
+		AgreementDate:       time.Unix(int64(pegoutQuote.AgreementTimestamp), 0).UTC(),
+		Value:               weiOrZero(pegoutQuote.Value),
+		CallFee:             weiOrZero(pegoutQuote.CallFee),
+		GasFee:              weiOrZero(pegoutQuote.GasFee),
+		Realized:            slices.Contains(getPegoutRevenueStates(), retainedQuote.State),
+		Costs: []QuoteCost{
+			{Type: QuoteCostSendPegoutBtc, TxHash: retainedQuote.LpBtcTxHash, GasPrice: entities.NewWei(0), Amount: retainedQuote.SendPegoutBtcFee.Copy()},
+			newGasCost(QuoteCostRefundPegout, retainedQuote.RefundPegoutTxHash, retainedQuote.RefundPegoutGasUsed, retainedQuote.RefundPegoutGasPrice),
+			newGasCost(QuoteCostBridgeRefund, retainedQuote.BridgeRefundTxHash, retainedQuote.BridgeRefundGasUsed, retainedQuote.BridgeRefundGasPrice),
+		},
+	}, nil
+}
+
+func newGasCost(costType QuoteCostType, txHash string, gasUsed uint64, gasPrice *entities.Wei) QuoteCost {
+	return QuoteCost{
+		Type:     costType,
+		TxHash:   txHash,
+		GasUsed:  gasUsed,
+		GasPrice: gasPrice,
+		Amount:   new(entities.Wei).Mul(entities.NewUWei(gasUsed), gasPrice),
+	}
+}
+
+func weiOrZero(value *entities.Wei) *entities.Wei {
+	if value == nil {
+		return entities.NewWei(0)
+	}
+	return value
+}
@@ -0,0 +1,223 @@
+package reports_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/penalization"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/test"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestGetQuotePnlUseCase_Run(t *testing.T) {
+	ctx := context.Background()
+	const agreementTimestamp = 1704067200
+
+	t.Run("should return the profit and loss of a pegin quote", func(t *testing.T) {
+		peginRepository := &mocks.PeginQuoteRepositoryMock{}
+		pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
+		penalizedRepository := &mocks.PenalizedEventRepositoryMock{}
+		peginRepository.EXPECT().GetQuote(ctx, test.AnyHash).Return(&quote.PeginQuote{
+			Value:              entities.NewWei(1000000),
+			CallFee:            entities.NewWei(5000),
+			GasFee:             entities.NewWei(3000),
+			AgreementTimestamp: agreementTimestamp,
+		}, nil).Once()
+		peginRepository.EXPECT().GetRetainedQuote(ctx, test.AnyHash).Return(&quote.RetainedPeginQuote{
+			QuoteHash:             test.AnyHash,
+			State:                 quote.PeginStateRegisterPegInSucceeded,
+			OwnerAccountAddress:   test.AnyRskAddress,
+			CallForUserTxHash:     "0x01",
+			CallForUserGasUsed:    100,
+			CallForUserGasPrice:   entities.NewWei(10),
+			RegisterPeginTxHash:   "0x02",
+			RegisterPeginGasUsed:  50,
+			RegisterPeginGasPrice: entities.NewWei(20),
+		}, nil).Once()
+		penalizedRepository.EXPECT().GetPenalizationsByQuoteHashes(ctx, []string{test.AnyHash}).Return([]penalization.PenalizedEvent{
+			{LiquidityProvider: test.AnyRskAddress, Penalty: entities.NewWei(700), QuoteHash: test.AnyHash},
+		}, nil).Once()
+		useCase := reports.NewGetQuotePnlUseCase(peginRepository, pegoutRepository, penalizedRepository)
+
+		result, err := useCase.Run(ctx, test.AnyHash)
+
+		require.NoError(t, err)
+		peginRepository.AssertExpectations(t)
+		pegoutRepository.AssertNotCalled(t, "GetQuote")
+		penalizedRepository.AssertExpectations(t)
+		assert.Equal(t, reports.QuotePnl{
+			QuoteHash:           test.AnyHash,
+			Direction:           reports.AnalyticsDirectionPegin,
+			State:               string(quote.PeginStateRegisterPegInSucceeded),
+			OwnerAccountAddress: test.AnyRskAddress,
+			AgreementDate:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			Value:               entities.NewWei(1000000),
+			CallFee:             entities.NewWei(5000),
+			GasFee:              entities.NewWei(3000),
+			Costs: []reports.QuoteCost{
+				{Type: reports.QuoteCostCallForUser, TxHash: "0x01", GasUsed: 100, GasPrice: entities.NewWei(10), Amount: entities.NewWei(1000)},
+				{Type: reports.QuoteCostRegisterPegin, TxHash: "0x02", GasUsed: 50, GasPrice: entities.NewWei(20), Amount: entities.NewWei(1000)},
+			},
+			TotalGasSpent: entities.NewWei(2000),
+			BtcFeePaid:    entities.NewWei(0),
+			Penalizations: []penalization.PenalizedEvent{
+				{LiquidityProvider: test.AnyRskAddress, Penalty: entities.NewWei(700), QuoteHash: test.AnyHash},
+			},
+			TotalPenalizations: entities.NewWei(700),
+			GasFeeMargin:       entities.NewWei(1000),
+			NetMargin:          entities.NewWei(5300),
+			Realized:           true,
+		}, result)
+	})
+
+	t.Run("should return the profit and loss of a pegout quote", func(t *testing.T) {
+		peginRepository := &mocks.PeginQuoteRepositoryMock{}
+		pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
+		penalizedRepository := &mocks.PenalizedEventRepositoryMock{}
+		peginRepository.EXPECT().GetQuote(ctx, test.AnyHash).Return(nil, nil).Once()
+		pegoutRepository.EXPECT().GetQuote(ctx, test.AnyHash).Return(&quote.PegoutQuote{
+			Value:              entities.NewWei(2000000),
+			CallFee:            entities.NewWei(4000),
+			GasFee:             entities.NewWei(1500),
+			AgreementTimestamp: agreementTimestamp,
+		}, nil).Once()
+		pegoutRepository.EXPECT().GetRetainedQuote(ctx, test.AnyHash).Return(&quote.RetainedPegoutQuote{
+			QuoteHash:            test.AnyHash,
+			State:                quote.PegoutStateBridgeTxSucceeded,
+			LpBtcTxHash:          "btcTx",
+			SendPegoutBtcFee:     entities.NewWei(1200),
+			RefundPegoutTxHash:   "0x03",
+			RefundPegoutGasUsed:  20,
+			RefundPegoutGasPrice: entities.NewWei(10),
+			BridgeRefundTxHash:   "0x04",
+			BridgeRefundGasUsed:  10,
+			BridgeRefundGasPrice: entities.NewWei(10),
+		}, nil).Once()
+		penalizedRepository.EXPECT().GetPenalizationsByQuoteHashes(ctx, []string{test.AnyHash}).
+			Return([]penalization.PenalizedEvent{}, nil).Once()
+		useCase := reports.NewGetQuotePnlUseCase(peginRepository, pegoutRepository, penalizedRepository)
+
+		result, err := useCase.Run(ctx, test.AnyHash)
+
+		require.NoError(t, err)
+		peginRepository.AssertExpectations(t)
+		pegoutRepository.AssertExpectations(t)
+		penalizedRepository.AssertExpectations(t)
+		assert.Equal(t, reports.AnalyticsDirectionPegout, result.Direction)
+		assert.Equal(t, string(quote.PegoutStateBridgeTxSucceeded), result.State)
+		assert.Empty(t, result.OwnerAccountAddress)
+		assert.Equal(t, []reports.QuoteCost{
+			{Type: reports.QuoteCostSendPegoutBtc, TxHash: "btcTx", GasPrice: entities.NewWei(0), Amount: entities.NewWei(1200)},
+			{Type: reports.QuoteCostRefundPegout, TxHash: "0x03", GasUsed: 20, GasPrice: entities.NewWei(10), Amount: entities.NewWei(200)},
+			{Type: reports.QuoteCostBridgeRefund, TxHash: "0x04", GasUsed: 10, GasPrice: entities.NewWei(10), Amount: entities.NewWei(100)},
+		}, result.Costs)
+		assert.Equal(t, entities.NewWei(300), result.TotalGasSpent)
+		assert.Equal(t, entities.NewWei(1200), result.BtcFeePaid)
+		assert.Equal(t, entities.NewWei(0), result.TotalPenalizations)
+		assert.Zero(t, result.GasFeeMargin.Cmp(entities.NewWei(0)))
+		assert.Equal(t, entities.NewWei(4000), result.NetMargin)
+		assert.True(t, result.Realized)
+	})
+
+	t.Run("should return a negative margin when the costs are greater than the fees", func(t *testing.T) {
+		peginRepository := &mocks.PeginQuoteRepositoryMock{}
+		penalizedRepository := &mocks.PenalizedEventRepositoryMock{}
+		peginRepository.EXPECT().GetQuote(ctx, test.AnyHash).Return(&quote.PeginQuote{
+			CallFee: entities.NewWei(100),
+			GasFee:  entities.NewWei(50),
+		}, nil).Once()
+		peginRepository.EXPECT().GetRetainedQuote(ctx, test.AnyHash).Return(&quote.RetainedPeginQuote{
+			State:                 quote.PeginStateRegisterPegInSucceeded,
+			CallForUserGasUsed:    10,
+			CallForUserGasPrice:   entities.NewWei(10),
+			RegisterPeginGasUsed:  1,
+			RegisterPeginGasPrice: entities.NewWei(20),
+		}, nil).Once()
+		penalizedRepository.EXPECT().GetPenalizationsByQuoteHashes(ctx, []string{test.AnyHash}).
+			Return([]penalization.PenalizedEvent{{Penalty: entities.NewWei(300)}}, nil).Once()
+		useCase := reports.NewGetQuotePnlUseCase(peginRepository, &mocks.PegoutQuoteRepositoryMock{}, penalizedRepository)
+
+		result, err := useCase.Run(ctx, test.AnyHash)
+
+		require.NoError(t, err)
+		assert.True(t, result.Realized)
+		assert.Equal(t, entities.NewWei(-70), result.GasFeeMargin)
+		assert.Equal(t, entities.NewWei(-270), result.NetMargin)
+	})
+
+	t.Run("should not count the fees of a quote that is not realized", func(t *testing.T) {
+		peginRepository := &mocks.PeginQuoteRepositoryMock{}
+		penalizedRepository := &mocks.PenalizedEventRepositoryMock{}
+		peginRepository.EXPECT().GetQuote(ctx, test.AnyHash).Return(&quote.PeginQuote{
+			CallFee: entities.NewWei(100),
+			GasFee:  entities.NewWei(50),
+		}, nil).Once()
+		peginRepository.EXPECT().GetRetainedQuote(ctx, test.AnyHash).Return(&quote.RetainedPeginQuote{
+			State:               quote.PeginStateCallForUserSucceeded,
+			CallForUserGasUsed:  10,
+			CallForUserGasPrice: entities.NewWei(10),
+		}, nil).Once()
+		penalizedRepository.EXPECT().GetPenalizationsByQuoteHashes(ctx, []string{test.AnyHash}).
+			Return([]penalization.PenalizedEvent{{Penalty: entities.NewWei(300)}}, nil).Once()
+		useCase := reports.NewGetQuotePnlUseCase(peginRepository, &mocks.PegoutQuoteRepositoryMock{}, penalizedRepository)
+
+		result, err := useCase.Run(ctx, test.AnyHash)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.NewWei(0), result.Costs[1].Amount)
+		assert.False(t, result.Realized)
+		assert.Equal(t, entities.NewWei(-50), result.GasFeeMargin)
+		assert.Equal(t, entities.NewWei(-400), result.NetMargin)
+	})
+
+	t.Run("should return QuoteNotFoundError if the quote doesn't exist", func(t *testing.T) {
+		peginRepository := &mocks.PeginQuoteRepositoryMock{}
+		pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
+		peginRepository.EXPECT().GetQuote(ctx, test.AnyHash).Return(nil, nil).Once()
+		pegoutRepository.EXPECT().GetQuote(ctx, test.AnyHash).Return(nil, nil).Once()
+		useCase := reports.NewGetQuotePnlUseCase(peginRepository, pegoutRepository, &mocks.PenalizedEventRepositoryMock{})
+
+		result, err := useCase.Run(ctx, test.AnyHash)
+
+		require.ErrorIs(t, err, usecases.QuoteNotFoundError)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should return QuoteNotAcceptedError if the quote wasn't accepted", func(t *testing.T) {
+		peginRepository := &mocks.PeginQuoteRepositoryMock{}
+		pegoutRepository := &mocks.PegoutQuoteRepositoryMock{}
+		peginRepository.EXPECT().GetQuote(ctx, test.AnyHash).Return(nil, nil).Once()
+		pegoutRepository.EXPECT().GetQuote(ctx, test.AnyHash).Return(&quote.PegoutQuote{}, nil).Once()
+		pegoutRepository.EXPECT().GetRetainedQuote(ctx, test.AnyHash).Return(nil, nil).Once()
+		useCase := reports.NewGetQuotePnlUseCase(peginRepository, pegoutRepository, &mocks.PenalizedEventRepositoryMock{})
+
+		_, err := useCase.Run(ctx, test.AnyHash)
+
+		require.ErrorIs(t, err, usecases.QuoteNotAcceptedError)
+	})
+
+	t.Run("should handle repository errors", func(t *testing.T) {
+		peginRepository := &mocks.PeginQuoteRepositoryMock{}
+		peginRepository.EXPECT().GetQuote(ctx, test.AnyHash).Return(nil, assert.AnError).Once()
+		useCase := reports.NewGetQuotePnlUseCase(peginRepository, &mocks.PegoutQuoteRepositoryMock{}, &mocks.PenalizedEventRepositoryMock{})
+		_, err := useCase.Run(ctx, test.AnyHash)
+		require.ErrorIs(t, err, assert.AnError)
+
+		peginRepository = &mocks.PeginQuoteRepositoryMock{}
+		penalizedRepository := &mocks.PenalizedEventRepositoryMock{}
+		peginRepository.EXPECT().GetQuote(ctx, test.AnyHash).Return(&quote.PeginQuote{}, nil).Once()
+		peginRepository.EXPECT().GetRetainedQuote(ctx, test.AnyHash).Return(&quote.RetainedPeginQuote{}, nil).Once()
+		penalizedRepository.EXPECT().GetPenalizationsByQuoteHashes(ctx, []string{test.AnyHash}).Return(nil, assert.AnError).Once()
+		useCase = reports.NewGetQuotePnlUseCase(peginRepository, &mocks.PegoutQuoteRepositoryMock{}, penalizedRepository)
+		_, err = useCase.Run(ctx, test.AnyHash)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
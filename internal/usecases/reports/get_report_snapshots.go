@@ -0,0 +1,34 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+// GetReportSnapshotsUseCase returns the snapshots stored by the scheduled report generation
+type GetReportSnapshotsUseCase struct {
+	snapshotRepository reporting.SnapshotRepository
+}
+
+func NewGetReportSnapshotsUseCase(snapshotRepository reporting.SnapshotRepository) *GetReportSnapshotsUseCase {
+	return &GetReportSnapshotsUseCase{snapshotRepository: snapshotRepository}
+}
+
+func (useCase *GetReportSnapshotsUseCase) Run(
+	ctx context.Context,
+	reportType reporting.ReportType,
+	startDate, endDate time.Time,
+) ([]reporting.Snapshot, error) {
+	if !reportType.IsValid() {
+		return nil, usecases.WrapUseCaseError(usecases.GetReportSnapshotsId, fmt.Errorf("invalid report type %q", reportType))
+	}
+	snapshots, err := useCase.snapshotRepository.GetSnapshots(ctx, reportType, startDate, endDate)
+	if err != nil {
+		return nil, usecases.WrapUseCaseError(usecases.GetReportSnapshotsId, err)
+	}
+	return snapshots, nil
+}
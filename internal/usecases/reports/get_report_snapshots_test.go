@@ -0,0 +1,55 @@
+package reports_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReportSnapshotsUseCase_Run(t *testing.T) {
+	ctx := context.Background()
+	startDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 3, 31, 23, 59, 59, 0, time.UTC)
+
+	t.Run("should return the snapshots of the period", func(t *testing.T) {
+		snapshots := []reporting.Snapshot{
+			{Id: "daily:revenue:2024-03-01", Schedule: reporting.ScheduleDaily, ReportType: reporting.ReportTypeRevenue},
+			{Id: "weekly:revenue:2024-03-04", Schedule: reporting.ScheduleWeekly, ReportType: reporting.ReportTypeRevenue},
+		}
+		repository := mocks.NewSnapshotRepositoryMock(t)
+		repository.EXPECT().GetSnapshots(ctx, reporting.ReportTypeRevenue, startDate, endDate).Return(snapshots, nil).Once()
+		useCase := reports.NewGetReportSnapshotsUseCase(repository)
+
+		result, err := useCase.Run(ctx, reporting.ReportTypeRevenue, startDate, endDate)
+
+		require.NoError(t, err)
+		assert.Equal(t, snapshots, result)
+	})
+	t.Run("should return error if the report type is invalid", func(t *testing.T) {
+		repository := mocks.NewSnapshotRepositoryMock(t)
+		useCase := reports.NewGetReportSnapshotsUseCase(repository)
+
+		result, err := useCase.Run(ctx, "pegin", startDate, endDate)
+
+		require.ErrorContains(t, err, "invalid report type")
+		assert.Nil(t, result)
+	})
+	t.Run("should wrap repository errors", func(t *testing.T) {
+		repository := mocks.NewSnapshotRepositoryMock(t)
+		repository.EXPECT().GetSnapshots(ctx, reporting.ReportTypeAssets, startDate, endDate).Return(nil, assert.AnError).Once()
+		useCase := reports.NewGetReportSnapshotsUseCase(repository)
+
+		result, err := useCase.Run(ctx, reporting.ReportTypeAssets, startDate, endDate)
+
+		require.ErrorIs(t, err, assert.AnError)
+		require.ErrorContains(t, err, string(usecases.GetReportSnapshotsId))
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,159 @@
+package reports
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/penalization"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+type JournalMovement string
+
+const (
+	JournalMovementCallFee      JournalMovement = "call_fee"
+	JournalMovementGasCollected JournalMovement = "gas_collected"
+	JournalMovementGasSpent     JournalMovement = "gas_spent"
+	JournalMovementBtcFee       JournalMovement = "btc_fee"
+	JournalMovementPenalty      JournalMovement = "penalty"
+)
+
+type JournalAccount string
+
+const (
+	JournalAccountLiquidity      JournalAccount = "Assets:Liquidity"
+	JournalAccountCallFeeIncome  JournalAccount = "Income:CallFees"
+	JournalAccountGasFeeIncome   JournalAccount = "Income:GasFees"
+	JournalAccountRskGasExpense  JournalAccount = "Expenses:RskGas"
+	JournalAccountBtcFeeExpense  JournalAccount = "Expenses:BtcFees"
+	JournalAccountPenaltyExpense JournalAccount = "Expenses:Penalizations"
+)
+
+const (
+	journalQuoteTypePegin          = "pegin"
+	journalQuoteTypePegout         = "pegout"
+	journalEntriesPerQuoteEstimate = 4
+)
+
+// JournalEntry is a double-entry movement of the revenue of the LP, the amount is debited from DebitAccount and credited to CreditAccount
+type JournalEntry struct {
+	Date          time.Time
+	QuoteHash     string
+	QuoteType     string
+	Movement      JournalMovement
+	DebitAccount  JournalAccount
+	CreditAccount JournalAccount
+	Amount        *entities.Wei
+}
+
+// GetRevenueJournalUseCase returns the movements that compose the revenue report as journal entries. The entries
+// include the same quotes as GetRevenueReportUseCase, so the sum of each movement matches the totals of the report,
+// except for the gas spent in pegouts, which is split between gas_spent (RSK gas) and btc_fee (BTC transaction fee)
+type GetRevenueJournalUseCase struct {
+	revenueReportUseCase *GetRevenueReportUseCase
+}
+
+func NewGetRevenueJournalUseCase(revenueReportUseCase *GetRevenueReportUseCase) *GetRevenueJournalUseCase {
+	return &GetRevenueJournalUseCase{revenueReportUseCase: revenueReportUseCase}
+}
+
+func (useCase *GetRevenueJournalUseCase) Run(ctx context.Context, startDate time.Time, endDate time.Time) ([]JournalEntry, error) {
+	peginResult, err := useCase.revenueReportUseCase.getPeginQuotes(ctx, startDate, endDate)
+	if err != nil {
+		return nil, usecases.WrapUseCaseError(usecases.GetRevenueJournalId, err)
+	}
+
+	pegoutResult, err := useCase.revenueReportUseCase.getPegoutQuotes(ctx, startDate, endDate)
+	if err != nil {
+		return nil, usecases.WrapUseCaseError(usecases.GetRevenueJournalId, err)
+	}
+
+	penalizations, err := useCase.revenueReportUseCase.getPenalizations(ctx, peginResult, pegoutResult)
+	if err != nil {
+		return nil, usecases.WrapUseCaseError(usecases.GetRevenueJournalId, err)
+	}
+
+	penalizationsByQuote := make(map[string][]penalization.PenalizedEvent)
+	for _, event := range penalizations {
+		penalizationsByQuote[event.QuoteHash] = append(penalizationsByQuote[event.QuoteHash], event)
+	}
+
+	entries := make([]JournalEntry, 0, (len(peginResult)+len(pegoutResult))*journalEntriesPerQuoteEstimate)
+	for _, pair := range peginResult {
+		entries = append(entries, useCase.peginEntries(pair, penalizationsByQuote[pair.RetainedQuote.QuoteHash])...)
+	}
+	for _, pair := range pegoutResult {
+		entries = append(entries, useCase.pegoutEntries(pair, penalizationsByQuote[pair.RetainedQuote.QuoteHash])...)
+	}
+	slices.SortStableFunc(entries, func(a, b JournalEntry) int {
+		return a.Date.Compare(b.Date)
+	})
+	return entries, nil
+}
+
+func (useCase *GetRevenueJournalUseCase) peginEntries(pair quote.PeginQuoteWithRetained, penalizations []penalization.PenalizedEvent) []JournalEntry {
+	gasSpent := entities.NewWei(0).Add(
+		entities.NewWei(0).Mul(entities.NewUWei(pair.RetainedQuote.CallForUserGasUsed), pair.RetainedQuote.CallForUserGasPrice),
+		entities.NewWei(0).Mul(entities.NewUWei(pair.RetainedQuote.RegisterPeginGasUsed), pair.RetainedQuote.RegisterPeginGasPrice),
+	)
+	builder := journalEntryBuilder{
+		date:      time.Unix(int64(pair.Quote.AgreementTimestamp), 0).UTC(),
+		quoteHash: pair.RetainedQuote.QuoteHash,
+		quoteType: journalQuoteTypePegin,
+	}
+	builder.add(JournalMovementCallFee, JournalAccountLiquidity, JournalAccountCallFeeIncome, pair.Quote.CallFee)
+	builder.add(JournalMovementGasCollected, JournalAccountLiquidity, JournalAccountGasFeeIncome, pair.Quote.GasFee)
+	builder.add(JournalMovementGasSpent, JournalAccountRskGasExpense, JournalAccountLiquidity, gasSpent)
+	builder.addPenalizations(penalizations)
+	return builder.entries
+}
+
+func (useCase *GetRevenueJournalUseCase) pegoutEntries(pair quote.PegoutQuoteWithRetained, penalizations []penalization.PenalizedEvent) []JournalEntry {
+	gasSpent := entities.NewWei(0).Add(
+		entities.NewWei(0).Mul(entities.NewUWei(pair.RetainedQuote.RefundPegoutGasUsed), pair.RetainedQuote.RefundPegoutGasPrice),
+		entities.NewWei(0).Mul(entities.NewUWei(pair.RetainedQuote.BridgeRefundGasUsed), pair.RetainedQuote.BridgeRefundGasPrice),
+	)
+	builder := journalEntryBuilder{
+		date:      time.Unix(int64(pair.Quote.AgreementTimestamp), 0).UTC(),
+		quoteHash: pair.RetainedQuote.QuoteHash,
+		quoteType: journalQuoteTypePegout,
+	}
+	builder.add(JournalMovementCallFee, JournalAccountLiquidity, JournalAccountCallFeeIncome, pair.Quote.CallFee)
+	builder.add(JournalMovementGasCollected, JournalAccountLiquidity, JournalAccountGasFeeIncome, pair.Quote.GasFee)
+	builder.add(JournalMovementGasSpent, JournalAccountRskGasExpense, JournalAccountLiquidity, gasSpent)
+	builder.add(JournalMovementBtcFee, JournalAccountBtcFeeExpense, JournalAccountLiquidity, pair.RetainedQuote.SendPegoutBtcFee)
+	builder.addPenalizations(penalizations)
+	return builder.entries
+}
+
+type journalEntryBuilder struct {
+	date      time.Time
+	quoteHash string
+	quoteType string
+	entries   []JournalEntry
+}
+
+// add appends an entry to the builder, the movements without amount are skipped
+func (builder *journalEntryBuilder) add(movement JournalMovement, debit, credit JournalAccount, amount *entities.Wei) {
+	if amount == nil || amount.Cmp(entities.NewWei(0)) == 0 {
+		return
+	}
+	builder.entries = append(builder.entries, JournalEntry{
+		Date:          builder.date,
+		QuoteHash:     builder.quoteHash,
+		QuoteType:     builder.quoteType,
+		Movement:      movement,
+		DebitAccount:  debit,
+		CreditAccount: credit,
+		Amount:        amount.Copy(),
+	})
+}
+
+func (builder *journalEntryBuilder) addPenalizations(penalizations []penalization.PenalizedEvent) {
+	for _, event := range penalizations {
+		builder.add(JournalMovementPenalty, JournalAccountPenaltyExpense, JournalAccountLiquidity, event.Penalty)
+	}
+}
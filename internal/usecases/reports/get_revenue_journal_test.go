@@ -0,0 +1,130 @@
+package reports_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/penalization"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint:funlen
+func TestGetRevenueJournalUseCase_Run(t *testing.T) {
+	ctx := context.Background()
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC)
+	peginDate := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	pegoutDate := time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC)
+
+	peginQuotes := []quote.PeginQuoteWithRetained{
+		{
+			Quote: quote.PeginQuote{
+				CallFee:            entities.NewWei(1000),
+				GasFee:             entities.NewWei(0),
+				AgreementTimestamp: uint32(peginDate.Unix()),
+			},
+			RetainedQuote: quote.RetainedPeginQuote{
+				QuoteHash:             "pegin-hash",
+				CallForUserGasUsed:    21000,
+				CallForUserGasPrice:   entities.NewWei(5),
+				RegisterPeginGasUsed:  50000,
+				RegisterPeginGasPrice: entities.NewWei(4),
+			},
+		},
+	}
+	pegoutQuotes := []quote.PegoutQuoteWithRetained{
+		{
+			Quote: quote.PegoutQuote{
+				CallFee:            entities.NewWei(1200),
+				GasFee:             entities.NewWei(280000),
+				AgreementTimestamp: uint32(pegoutDate.Unix()),
+			},
+			RetainedQuote: quote.RetainedPegoutQuote{
+				QuoteHash:            "pegout-hash",
+				RefundPegoutGasUsed:  40000,
+				RefundPegoutGasPrice: entities.NewWei(4),
+				BridgeRefundGasUsed:  30000,
+				BridgeRefundGasPrice: entities.NewWei(3),
+				SendPegoutBtcFee:     entities.NewWei(1000),
+			},
+		},
+	}
+	penalizations := []penalization.PenalizedEvent{
+		{QuoteHash: "pegin-hash", Penalty: entities.NewWei(50)},
+		{QuoteHash: "pegout-hash", Penalty: entities.NewWei(80)},
+	}
+
+	peginQuoteRepo := &mocks.PeginQuoteRepositoryMock{}
+	pegoutQuoteRepo := &mocks.PegoutQuoteRepositoryMock{}
+	penalizationRepo := &mocks.PenalizedEventRepositoryMock{}
+	peginQuoteRepo.On("GetQuotesWithRetainedByStateAndDate", ctx, []quote.PeginState{quote.PeginStateRegisterPegInSucceeded}, startDate, endDate).
+		Return(peginQuotes, nil).Once()
+	pegoutQuoteRepo.On("GetQuotesWithRetainedByStateAndDate", ctx, []quote.PegoutState{quote.PegoutStateRefundPegOutSucceeded, quote.PegoutStateBridgeTxSucceeded, quote.PegoutStateBtcReleased}, startDate, endDate).
+		Return(pegoutQuotes, nil).Once()
+	penalizationRepo.On("GetPenalizationsByQuoteHashes", ctx, []string{"pegin-hash", "pegout-hash"}).
+		Return(penalizations, nil).Once()
+
+	revenueReportUseCase := reports.NewGetRevenueReportUseCase(peginQuoteRepo, pegoutQuoteRepo, penalizationRepo)
+	useCase := reports.NewGetRevenueJournalUseCase(revenueReportUseCase)
+	result, err := useCase.Run(ctx, startDate, endDate)
+	require.NoError(t, err)
+
+	expected := []reports.JournalEntry{
+		{Date: pegoutDate, QuoteHash: "pegout-hash", QuoteType: "pegout", Movement: reports.JournalMovementCallFee, DebitAccount: reports.JournalAccountLiquidity, CreditAccount: reports.JournalAccountCallFeeIncome, Amount: entities.NewWei(1200)},
+		{Date: pegoutDate, QuoteHash: "pegout-hash", QuoteType: "pegout", Movement: reports.JournalMovementGasCollected, DebitAccount: reports.JournalAccountLiquidity, CreditAccount: reports.JournalAccountGasFeeIncome, Amount: entities.NewWei(280000)},
+		{Date: pegoutDate, QuoteHash: "pegout-hash", QuoteType: "pegout", Movement: reports.JournalMovementGasSpent, DebitAccount: reports.JournalAccountRskGasExpense, CreditAccount: reports.JournalAccountLiquidity, Amount: entities.NewWei(250000)},
+		{Date: pegoutDate, QuoteHash: "pegout-hash", QuoteType: "pegout", Movement: reports.JournalMovementBtcFee, DebitAccount: reports.JournalAccountBtcFeeExpense, CreditAccount: reports.JournalAccountLiquidity, Amount: entities.NewWei(1000)},
+		{Date: pegoutDate, QuoteHash: "pegout-hash", QuoteType: "pegout", Movement: reports.JournalMovementPenalty, DebitAccount: reports.JournalAccountPenaltyExpense, CreditAccount: reports.JournalAccountLiquidity, Amount: entities.NewWei(80)},
+		{Date: peginDate, QuoteHash: "pegin-hash", QuoteType: "pegin", Movement: reports.JournalMovementCallFee, DebitAccount: reports.JournalAccountLiquidity, CreditAccount: reports.JournalAccountCallFeeIncome, Amount: entities.NewWei(1000)},
+		{Date: peginDate, QuoteHash: "pegin-hash", QuoteType: "pegin", Movement: reports.JournalMovementGasSpent, DebitAccount: reports.JournalAccountRskGasExpense, CreditAccount: reports.JournalAccountLiquidity, Amount: entities.NewWei(305000)},
+		{Date: peginDate, QuoteHash: "pegin-hash", QuoteType: "pegin", Movement: reports.JournalMovementPenalty, DebitAccount: reports.JournalAccountPenaltyExpense, CreditAccount: reports.JournalAccountLiquidity, Amount: entities.NewWei(50)},
+	}
+	assert.Equal(t, expected, result)
+	peginQuoteRepo.AssertExpectations(t)
+	pegoutQuoteRepo.AssertExpectations(t)
+	penalizationRepo.AssertExpectations(t)
+}
+
+func TestGetRevenueJournalUseCase_Run_ErrorHandling(t *testing.T) {
+	ctx := context.Background()
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC)
+	peginQuotes := []quote.PeginQuoteWithRetained{{RetainedQuote: quote.RetainedPeginQuote{QuoteHash: "pegin-hash"}}}
+
+	setups := []func(peginRepo *mocks.PeginQuoteRepositoryMock, pegoutRepo *mocks.PegoutQuoteRepositoryMock, penalizationRepo *mocks.PenalizedEventRepositoryMock){
+		func(peginRepo *mocks.PeginQuoteRepositoryMock, pegoutRepo *mocks.PegoutQuoteRepositoryMock, penalizationRepo *mocks.PenalizedEventRepositoryMock) {
+			peginRepo.On("GetQuotesWithRetainedByStateAndDate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		},
+		func(peginRepo *mocks.PeginQuoteRepositoryMock, pegoutRepo *mocks.PegoutQuoteRepositoryMock, penalizationRepo *mocks.PenalizedEventRepositoryMock) {
+			peginRepo.On("GetQuotesWithRetainedByStateAndDate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(peginQuotes, nil).Once()
+			pegoutRepo.On("GetQuotesWithRetainedByStateAndDate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		},
+		func(peginRepo *mocks.PeginQuoteRepositoryMock, pegoutRepo *mocks.PegoutQuoteRepositoryMock, penalizationRepo *mocks.PenalizedEventRepositoryMock) {
+			peginRepo.On("GetQuotesWithRetainedByStateAndDate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(peginQuotes, nil).Once()
+			pegoutRepo.On("GetQuotesWithRetainedByStateAndDate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]quote.PegoutQuoteWithRetained{}, nil).Once()
+			penalizationRepo.On("GetPenalizationsByQuoteHashes", mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+		},
+	}
+	for _, setup := range setups {
+		peginRepo := &mocks.PeginQuoteRepositoryMock{}
+		pegoutRepo := &mocks.PegoutQuoteRepositoryMock{}
+		penalizationRepo := &mocks.PenalizedEventRepositoryMock{}
+		setup(peginRepo, pegoutRepo, penalizationRepo)
+		useCase := reports.NewGetRevenueJournalUseCase(reports.NewGetRevenueReportUseCase(peginRepo, pegoutRepo, penalizationRepo))
+		result, err := useCase.Run(ctx, startDate, endDate)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, string(usecases.GetRevenueJournalId))
+		assert.Nil(t, result)
+		peginRepo.AssertExpectations(t)
+		pegoutRepo.AssertExpectations(t)
+		penalizationRepo.AssertExpectations(t)
+	}
+}
@@ -29,10 +29,16 @@ func NewGetRevenueReportUseCase(
 }
 
 type GetRevenueReportResult struct {
-	TotalQuoteCallFees    *entities.Wei
-	TotalGasFeesCollected *entities.Wei
-	TotalGasSpent         *entities.Wei
-	TotalPenalizations    *entities.Wei
+	TotalQuoteCallFees    *entities.Wei `json:"totalQuoteCallFees"`
+	TotalGasFeesCollected *entities.Wei `json:"totalGasFeesCollected"`
+	TotalGasSpent         *entities.Wei `json:"totalGasSpent"`
+	TotalPenalizations    *entities.Wei `json:"totalPenalizations"`
+	// TotalPegoutBtcFeesQuoted is the BTC fee quoted to the users in the pegouts whose BTC was sent
+	TotalPegoutBtcFeesQuoted *entities.Wei `json:"totalPegoutBtcFeesQuoted"`
+	// TotalPegoutBtcFeesPaid is the BTC fee actually paid by the LP in the same pegouts
+	TotalPegoutBtcFeesPaid *entities.Wei `json:"totalPegoutBtcFeesPaid"`
+	// PegoutBtcFeesDifference is the quoted minus the paid BTC fees, negative if the LP paid more than quoted
+	PegoutBtcFeesDifference *entities.Wei `json:"pegoutBtcFeesDifference"`
 }
 
 type revenueTotals struct {
@@ -41,6 +47,11 @@ type revenueTotals struct {
 	GasSpent     *entities.Wei
 }
 
+type btcFeeTotals struct {
+	Quoted *entities.Wei
+	Paid   *entities.Wei
+}
+
 func (useCase *GetRevenueReportUseCase) Run(ctx context.Context, startDate time.Time, endDate time.Time) (GetRevenueReportResult, error) {
 	peginResult, err := useCase.getPeginQuotes(ctx, startDate, endDate)
 	if err != nil {
@@ -59,6 +70,7 @@ func (useCase *GetRevenueReportUseCase) Run(ctx context.Context, startDate time.
 
 	peginTotals := useCase.calculatePeginTotals(peginResult)
 	pegoutTotals := useCase.calculatePegoutTotals(pegoutResult)
+	btcFees := useCase.calculatePegoutBtcFeeTotals(pegoutResult)
 	totalPenalizations := useCase.calculateTotalPenalizations(penalizations)
 
 	totalQuoteCallFees := entities.NewWei(0).Add(peginTotals.CallFees, pegoutTotals.CallFees)
@@ -66,10 +78,13 @@ func (useCase *GetRevenueReportUseCase) Run(ctx context.Context, startDate time.
 	totalGasSpent := entities.NewWei(0).Add(peginTotals.GasSpent, pegoutTotals.GasSpent)
 
 	return GetRevenueReportResult{
-		TotalQuoteCallFees:    totalQuoteCallFees,
-		TotalGasFeesCollected: totalGasFeesCollected,
-		TotalGasSpent:         totalGasSpent,
-		TotalPenalizations:    totalPenalizations,
+		TotalQuoteCallFees:       totalQuoteCallFees,
+		TotalGasFeesCollected:    totalGasFeesCollected,
+		TotalGasSpent:            totalGasSpent,
+		TotalPenalizations:       totalPenalizations,
+		TotalPegoutBtcFeesQuoted: btcFees.Quoted,
+		TotalPegoutBtcFeesPaid:   btcFees.Paid,
+		PegoutBtcFeesDifference:  entities.NewWei(0).Sub(btcFees.Quoted, btcFees.Paid),
 	}, nil
 }
 
@@ -136,6 +151,22 @@ func (useCase *GetRevenueReportUseCase) calculatePegoutTotals(
 	}
 }
 
+// calculatePegoutBtcFeeTotals compares the BTC fee quoted to the user (GasFee) with the one paid by the LP
+// (SendPegoutBtcFee), only the pegouts with a recorded BTC fee are considered
+func (useCase *GetRevenueReportUseCase) calculatePegoutBtcFeeTotals(
+	pegoutResult []quote.PegoutQuoteWithRetained,
+) btcFeeTotals {
+	totals := btcFeeTotals{Quoted: entities.NewWei(0), Paid: entities.NewWei(0)}
+	for _, pair := range pegoutResult {
+		if pair.RetainedQuote.SendPegoutBtcFee == nil || pair.RetainedQuote.SendPegoutBtcFee.Cmp(entities.NewWei(0)) <= 0 {
+			continue
+		}
+		totals.Quoted = totals.Quoted.Add(totals.Quoted, pair.Quote.GasFee)
+		totals.Paid = totals.Paid.Add(totals.Paid, pair.RetainedQuote.SendPegoutBtcFee)
+	}
+	return totals
+}
+
 func (useCase *GetRevenueReportUseCase) calculateTotalPenalizations(
 	penalizations []penalization.PenalizedEvent,
 ) *entities.Wei {
@@ -146,13 +177,22 @@ func (useCase *GetRevenueReportUseCase) calculateTotalPenalizations(
 	return total
 }
 
+// getPeginRevenueStates returns the states of the pegins considered in the revenue of the LP
+func getPeginRevenueStates() []quote.PeginState {
+	return []quote.PeginState{quote.PeginStateRegisterPegInSucceeded}
+}
+
+// getPegoutRevenueStates returns the states of the pegouts considered in the revenue of the LP
+func getPegoutRevenueStates() []quote.PegoutState {
+	return []quote.PegoutState{quote.PegoutStateRefundPegOutSucceeded, quote.PegoutStateBridgeTxSucceeded, quote.PegoutStateBtcReleased}
+}
+
 func (useCase *GetRevenueReportUseCase) getPeginQuotes(
 	ctx context.Context,
 	startDate time.Time,
 	endDate time.Time,
 ) ([]quote.PeginQuoteWithRetained, error) {
-	peginStates := []quote.PeginState{quote.PeginStateRegisterPegInSucceeded}
-	quotes, err := useCase.peginQuoteRepository.GetQuotesWithRetainedByStateAndDate(ctx, peginStates, startDate, endDate)
+	quotes, err := useCase.peginQuoteRepository.GetQuotesWithRetainedByStateAndDate(ctx, getPeginRevenueStates(), startDate, endDate)
 	if err != nil {
 		return nil, err
 	}
@@ -175,8 +215,7 @@ func (useCase *GetRevenueReportUseCase) getPegoutQuotes(
 	startDate time.Time,
 	endDate time.Time,
 ) ([]quote.PegoutQuoteWithRetained, error) {
-	pegoutStates := []quote.PegoutState{quote.PegoutStateRefundPegOutSucceeded, quote.PegoutStateBridgeTxSucceeded, quote.PegoutStateBtcReleased}
-	quotes, err := useCase.pegoutQuoteRepository.GetQuotesWithRetainedByStateAndDate(ctx, pegoutStates, startDate, endDate)
+	quotes, err := useCase.pegoutQuoteRepository.GetQuotesWithRetainedByStateAndDate(ctx, getPegoutRevenueStates(), startDate, endDate)
 	if err != nil {
 		return nil, err
 	}
@@ -478,6 +478,53 @@ func TestGetRevenueReportUseCase_Run_EmptyQuotes(t *testing.T) {
 	assert.Equal(t, entities.NewWei(0), result.TotalGasFeesCollected)
 	assert.Equal(t, entities.NewWei(0), result.TotalGasSpent)
 	assert.Equal(t, entities.NewWei(0), result.TotalPenalizations)
+	assert.Equal(t, entities.NewWei(0), result.TotalPegoutBtcFeesQuoted)
+	assert.Equal(t, entities.NewWei(0), result.TotalPegoutBtcFeesPaid)
+	assert.Equal(t, entities.NewWei(0), result.PegoutBtcFeesDifference)
+}
+
+func TestGetRevenueReportUseCase_Run_PegoutBtcFeesDifference(t *testing.T) {
+	ctx := context.Background()
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC)
+
+	pegoutQuotesWithRetained := []quote.PegoutQuoteWithRetained{
+		{
+			Quote:         quote.PegoutQuote{CallFee: entities.NewWei(400), GasFee: entities.NewWei(3000)},
+			RetainedQuote: quote.RetainedPegoutQuote{QuoteHash: "pegout-hash-1", RefundPegoutGasPrice: entities.NewWei(0), BridgeRefundGasPrice: entities.NewWei(0), SendPegoutBtcFee: entities.NewWei(2500)},
+		},
+		{
+			Quote:         quote.PegoutQuote{CallFee: entities.NewWei(400), GasFee: entities.NewWei(2000)},
+			RetainedQuote: quote.RetainedPegoutQuote{QuoteHash: "pegout-hash-2", RefundPegoutGasPrice: entities.NewWei(0), BridgeRefundGasPrice: entities.NewWei(0), SendPegoutBtcFee: entities.NewWei(2700)},
+		},
+		{
+			// quotes without a recorded BTC fee are not compared
+			Quote:         quote.PegoutQuote{CallFee: entities.NewWei(400), GasFee: entities.NewWei(1000)},
+			RetainedQuote: quote.RetainedPegoutQuote{QuoteHash: "pegout-hash-3", RefundPegoutGasPrice: entities.NewWei(0), BridgeRefundGasPrice: entities.NewWei(0), SendPegoutBtcFee: entities.NewWei(0)},
+		},
+	}
+
+	peginQuoteRepo := &mocks.PeginQuoteRepositoryMock{}
+	pegoutQuoteRepo := &mocks.PegoutQuoteRepositoryMock{}
+	penalizationRepo := &mocks.PenalizedEventRepositoryMock{}
+	peginQuoteRepo.On("GetQuotesWithRetainedByStateAndDate", ctx, []quote.PeginState{quote.PeginStateRegisterPegInSucceeded}, startDate, endDate).
+		Return([]quote.PeginQuoteWithRetained{}, nil).Once()
+	pegoutQuoteRepo.On("GetQuotesWithRetainedByStateAndDate", ctx, []quote.PegoutState{quote.PegoutStateRefundPegOutSucceeded, quote.PegoutStateBridgeTxSucceeded, quote.PegoutStateBtcReleased}, startDate, endDate).
+		Return(pegoutQuotesWithRetained, nil).Once()
+	penalizationRepo.On("GetPenalizationsByQuoteHashes", ctx, []string{"pegout-hash-1", "pegout-hash-2", "pegout-hash-3"}).
+		Return([]penalization.PenalizedEvent{}, nil).Once()
+
+	useCase := reports.NewGetRevenueReportUseCase(peginQuoteRepo, pegoutQuoteRepo, penalizationRepo)
+	result, err := useCase.Run(ctx, startDate, endDate)
+
+	require.NoError(t, err)
+	assert.Equal(t, entities.NewWei(5000), result.TotalPegoutBtcFeesQuoted)
+	assert.Equal(t, entities.NewWei(5200), result.TotalPegoutBtcFeesPaid)
+	assert.Equal(t, entities.NewWei(-200), result.PegoutBtcFeesDifference)
+	assert.Equal(t, entities.NewWei(6000), result.TotalGasFeesCollected)
+	peginQuoteRepo.AssertExpectations(t)
+	pegoutQuoteRepo.AssertExpectations(t)
+	penalizationRepo.AssertExpectations(t)
 }
 
 func TestGetRevenueReportUseCase_Run_ErrorFetchingPeginQuotes(t *testing.T) {
@@ -91,6 +91,39 @@ func (useCase *GetTransactionsUseCase) Run(ctx context.Context, transactionType
 	return response, nil
 }
 
+// RunAll returns every transaction of the date range in a single page. It is meant for the
+// file exports, which must not be truncated to the page requested by the client.
+func (useCase *GetTransactionsUseCase) RunAll(ctx context.Context, transactionType string, startTime, endTime time.Time) (GetTransactionsResult, error) {
+	var transactions []TransactionItem
+	var totalCount int
+	var err error
+
+	// page=0 and perPage=0 make the repositories return all the quotes of the range
+	switch transactionType {
+	case "pegin":
+		transactions, totalCount, err = useCase.getPeginTransactions(ctx, startTime, endTime, 0, 0)
+	case "pegout":
+		transactions, totalCount, err = useCase.getPegoutTransactions(ctx, startTime, endTime, 0, 0)
+	default:
+		return GetTransactionsResult{}, usecases.WrapUseCaseError(usecases.GetTransactionsReportId,
+			errors.New("invalid transaction type: must be 'pegin' or 'pegout'"))
+	}
+
+	if err != nil {
+		return GetTransactionsResult{}, usecases.WrapUseCaseError(usecases.GetTransactionsReportId, err)
+	}
+
+	return GetTransactionsResult{
+		Data: transactions,
+		Pagination: PaginationMetadata{
+			Total:      totalCount,
+			PerPage:    totalCount,
+			TotalPages: 1,
+			Page:       1,
+		},
+	}, nil
+}
+
 func (useCase *GetTransactionsUseCase) getPeginTransactions(ctx context.Context, startTime, endTime time.Time, page, perPage int) ([]TransactionItem, int, error) {
 	quotePairs, totalCount, err := useCase.peginRepo.ListQuotesByDateRange(ctx, startTime, endTime, page, perPage)
 	if err != nil {
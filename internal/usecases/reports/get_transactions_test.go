@@ -2,6 +2,7 @@ package reports_test
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
@@ -155,6 +156,64 @@ func TestGetTransactionsUseCase_Run_EmptyResults(t *testing.T) {
 	peginRepo.AssertExpectations(t)
 }
 
+func TestGetTransactionsUseCase_RunAll(t *testing.T) {
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2023, 1, 31, 23, 59, 59, 999999999, time.UTC)
+
+	t.Run("should return all the pegin transactions of the range in a single page", func(t *testing.T) {
+		peginRepo := mocks.NewPeginQuoteRepositoryMock(t)
+		pegoutRepo := mocks.NewPegoutQuoteRepositoryMock(t)
+		useCase := reports.NewGetTransactionsUseCase(peginRepo, pegoutRepo)
+		quotePairs := make([]quote.PeginQuoteWithRetained, 0, 150)
+		for i := 0; i < 150; i++ {
+			quotePairs = append(quotePairs, quote.PeginQuoteWithRetained{
+				Quote:         quote.PeginQuote{Value: entities.NewWei(1), CallFee: entities.NewWei(2), GasFee: entities.NewWei(3)},
+				RetainedQuote: quote.RetainedPeginQuote{QuoteHash: "0x" + strconv.Itoa(i), State: quote.PeginStateRegisterPegInSucceeded},
+			})
+		}
+		quotePairs = append(quotePairs, quote.PeginQuoteWithRetained{Quote: quote.PeginQuote{Value: entities.NewWei(1)}})
+		peginRepo.EXPECT().ListQuotesByDateRange(mock.Anything, startTime, endTime, 0, 0).Return(quotePairs, len(quotePairs), nil).Once()
+
+		result, err := useCase.RunAll(context.Background(), "pegin", startTime, endTime)
+
+		require.NoError(t, err)
+		assert.Len(t, result.Data, 150)
+		assert.Equal(t, "0x149", result.Data[149].QuoteHash)
+		assert.Equal(t, reports.PaginationMetadata{Total: 150, PerPage: 150, TotalPages: 1, Page: 1}, result.Pagination)
+	})
+
+	t.Run("should return all the pegout transactions of the range in a single page", func(t *testing.T) {
+		peginRepo := mocks.NewPeginQuoteRepositoryMock(t)
+		pegoutRepo := mocks.NewPegoutQuoteRepositoryMock(t)
+		useCase := reports.NewGetTransactionsUseCase(peginRepo, pegoutRepo)
+		quotePairs := []quote.PegoutQuoteWithRetained{{
+			Quote:         quote.PegoutQuote{Value: entities.NewWei(1), CallFee: entities.NewWei(2), GasFee: entities.NewWei(3)},
+			RetainedQuote: quote.RetainedPegoutQuote{QuoteHash: "0x789", State: quote.PegoutStateBridgeTxSucceeded},
+		}}
+		pegoutRepo.EXPECT().ListQuotesByDateRange(mock.Anything, startTime, endTime, 0, 0).Return(quotePairs, 1, nil).Once()
+
+		result, err := useCase.RunAll(context.Background(), "pegout", startTime, endTime)
+
+		require.NoError(t, err)
+		assert.Len(t, result.Data, 1)
+		assert.Equal(t, reports.PaginationMetadata{Total: 1, PerPage: 1, TotalPages: 1, Page: 1}, result.Pagination)
+	})
+
+	t.Run("should return an error for an invalid type", func(t *testing.T) {
+		useCase := reports.NewGetTransactionsUseCase(mocks.NewPeginQuoteRepositoryMock(t), mocks.NewPegoutQuoteRepositoryMock(t))
+		_, err := useCase.RunAll(context.Background(), "invalid", startTime, endTime)
+		require.ErrorContains(t, err, "invalid transaction type")
+	})
+
+	t.Run("should return the repository error", func(t *testing.T) {
+		peginRepo := mocks.NewPeginQuoteRepositoryMock(t)
+		useCase := reports.NewGetTransactionsUseCase(peginRepo, mocks.NewPegoutQuoteRepositoryMock(t))
+		peginRepo.EXPECT().ListQuotesByDateRange(mock.Anything, startTime, endTime, 0, 0).Return(nil, 0, assert.AnError).Once()
+		_, err := useCase.RunAll(context.Background(), "pegin", startTime, endTime)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
+
 func TestCalculatePaginationMetadata(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -16,9 +16,9 @@ import (
 )
 
 var (
-	NodeEclipseDetectedError = errors.New("node eclipse detected")
-	EclipseAlertSubject      = "Node Eclipse Detected"
-	EclipseAlertBodyTemplate = "Your %s node is under eclipse attack. Please, check your node's connectivity and synchronization."
+	NodeEclipseDetectedError         = errors.New("node eclipse detected")
+	EclipseAlertBodyTemplate         = "Your %s node is under eclipse attack. Please, check your node's connectivity and synchronization."
+	EclipseResolvedAlertBodyTemplate = "Your %s node is synchronized with the external sources again."
 )
 
 type EclipseCheckConfig struct {
@@ -89,6 +89,8 @@ func (useCase *EclipseCheckUseCase) Run(ctx context.Context, nodeType entities.N
 
 	if errors.Is(err, NodeEclipseDetectedError) {
 		alertErr = useCase.triggerEclipseAlert(ctx, nodeType)
+	} else if err == nil {
+		useCase.resolveEclipseAlert(ctx, nodeType)
 	}
 	err = errors.Join(err, alertErr)
 	if err != nil {
@@ -98,20 +100,44 @@ func (useCase *EclipseCheckUseCase) Run(ctx context.Context, nodeType entities.N
 }
 
 func (useCase *EclipseCheckUseCase) triggerEclipseAlert(ctx context.Context, nodeType entities.NodeType) error {
+	detectionTime := time.Now()
 	useCase.eventBus.Publish(blockchain.NodeEclipseEvent{
 		BaseEvent:           entities.NewBaseEvent(blockchain.NodeEclipseEventId),
 		NodeType:            nodeType,
 		EclipsedBlockNumber: useCase.eclipsedBlock.Number,
 		EclipsedBlockHash:   useCase.eclipsedBlock.Hash,
-		DetectionTime:       time.Now(),
+		DetectionTime:       detectionTime,
 	})
+	alert := alerts.Alert{
+		Kind:        alerts.AlertKindNodeEclipse,
+		Subject:     alerts.AlertSubjectEclipseAttack,
+		Severity:    alerts.AlertSeverityCritical,
+		Status:      alerts.AlertStatusFiring,
+		Message:     fmt.Sprintf(EclipseAlertBodyTemplate, nodeType),
+		NodeType:    nodeType,
+		BlockNumber: useCase.eclipsedBlock.Number,
+		BlockHash:   useCase.eclipsedBlock.Hash,
+		Timestamp:   detectionTime,
+	}
 	useCase.eclipsedBlock = blockIds{}
-	return useCase.alertSender.SendAlert(
-		ctx,
-		EclipseAlertSubject,
-		fmt.Sprintf(EclipseAlertBodyTemplate, nodeType),
-		[]string{useCase.alertRecipient},
-	)
+	return useCase.alertSender.SendAlert(ctx, alert, []string{useCase.alertRecipient})
+}
+
+// resolveEclipseAlert notifies that the node is not eclipsed, the alert sender is responsible for
+// discarding this notification if there wasn't a previous eclipse alert for the node
+func (useCase *EclipseCheckUseCase) resolveEclipseAlert(ctx context.Context, nodeType entities.NodeType) {
+	alert := alerts.Alert{
+		Kind:      alerts.AlertKindNodeEclipse,
+		Subject:   alerts.AlertSubjectEclipseAttack,
+		Severity:  alerts.AlertSeverityInfo,
+		Status:    alerts.AlertStatusResolved,
+		Message:   fmt.Sprintf(EclipseResolvedAlertBodyTemplate, nodeType),
+		NodeType:  nodeType,
+		Timestamp: time.Now(),
+	}
+	if err := useCase.alertSender.SendAlert(ctx, alert, []string{useCase.alertRecipient}); err != nil {
+		log.Error("Error sending eclipse resolved notification: ", err)
+	}
 }
 
 func (useCase *EclipseCheckUseCase) checkBtcNode(ctx context.Context) error {
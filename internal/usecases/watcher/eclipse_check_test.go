@@ -3,6 +3,7 @@ package watcher_test
 import (
 	"context"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
 	w "github.com/rsksmart/liquidity-provider-server/internal/usecases/watcher"
 	"github.com/rsksmart/liquidity-provider-server/test"
@@ -16,6 +17,23 @@ import (
 	"time"
 )
 
+func resolvedEclipseAlert(t *testing.T, nodeType entities.NodeType) any {
+	return mock.MatchedBy(func(alert alerts.Alert) bool {
+		return assert.Equal(t, alerts.AlertKindNodeEclipse, alert.Kind) &&
+			assert.Equal(t, alerts.AlertSubjectEclipseAttack, alert.Subject) &&
+			assert.Equal(t, alerts.AlertStatusResolved, alert.Status) &&
+			assert.Equal(t, nodeType, alert.NodeType) &&
+			assert.Equal(t, "Your "+nodeType+" node is synchronized with the external sources again.", alert.Message) &&
+			assert.False(t, alert.Timestamp.IsZero())
+	})
+}
+
+func resolvedAlertSender(t *testing.T, nodeType entities.NodeType) *mocks.AlertSenderMock {
+	alertSender := &mocks.AlertSenderMock{}
+	alertSender.On("SendAlert", mock.Anything, resolvedEclipseAlert(t, nodeType), mock.Anything).Return(nil).Once()
+	return alertSender
+}
+
 // nolint:funlen
 func TestEclipseCheckUseCase_Run_Rootstock(t *testing.T) {
 	const (
@@ -33,7 +51,7 @@ func TestEclipseCheckUseCase_Run_Rootstock(t *testing.T) {
 		BtcMaxMsWaitForBlock:     0,
 		BtcWaitPollingMsInterval: 0,
 	}
-	t.Run("should not trigger the alert if no eclipse attack is detected", func(t *testing.T) {
+	t.Run("should only send resolved notification if no eclipse attack is detected", func(t *testing.T) {
 		rskRpc := &mocks.RootstockRpcServerMock{}
 		btcRpc := &mocks.BtcRpcMock{}
 		eventBus := &mocks.EventBusMock{}
@@ -63,6 +81,7 @@ func TestEclipseCheckUseCase_Run_Rootstock(t *testing.T) {
 		mutex := &mocks.MutexMock{}
 		mutex.On("Lock").Return()
 		mutex.On("Unlock").Return()
+		alertSender.On("SendAlert", mock.Anything, resolvedEclipseAlert(t, entities.NodeTypeRootstock), []string{recipient}).Return(nil).Once()
 		useCase := w.NewEclipseCheckUseCase(
 			config,
 			blockchain.Rpc{
@@ -83,7 +102,7 @@ func TestEclipseCheckUseCase_Run_Rootstock(t *testing.T) {
 			rskExtra.AssertExpectations(t)
 		}
 		eventBus.AssertNotCalled(t, "Publish")
-		alertSender.AssertNotCalled(t, "SendAlert")
+		alertSender.AssertExpectations(t)
 		mutex.AssertExpectations(t)
 	})
 	t.Run("should trigger the alert if eclipse attack is detected", func(t *testing.T) {
@@ -114,8 +133,16 @@ func TestEclipseCheckUseCase_Run_Rootstock(t *testing.T) {
 		alertSender.On(
 			"SendAlert",
 			mock.Anything,
-			w.EclipseAlertSubject,
-			"Your rootstock node is under eclipse attack. Please, check your node's connectivity and synchronization.",
+			mock.MatchedBy(func(alert alerts.Alert) bool {
+				return assert.Equal(t, alerts.AlertKindNodeEclipse, alert.Kind) &&
+					assert.Equal(t, alerts.AlertSubjectEclipseAttack, alert.Subject) &&
+					assert.Equal(t, alerts.AlertSeverityCritical, alert.Severity) &&
+					assert.Equal(t, "Your rootstock node is under eclipse attack. Please, check your node's connectivity and synchronization.", alert.Message) &&
+					assert.Equal(t, entities.NodeTypeRootstock, alert.NodeType) &&
+					assert.Equal(t, uint64(blocks), alert.BlockNumber) &&
+					assert.Equal(t, test.AnyHash, alert.BlockHash) &&
+					assert.False(t, alert.Timestamp.IsZero())
+			}),
 			[]string{recipient},
 		).Return(nil)
 		eventBus.On("Publish", mock.MatchedBy(func(e blockchain.NodeEclipseEvent) bool {
@@ -146,7 +173,7 @@ func TestEclipseCheckUseCase_Run_Rootstock(t *testing.T) {
 		alertSender.AssertExpectations(t)
 		mutex.AssertExpectations(t)
 	})
-	t.Run("should not trigger alert if the node syncs during the tolerance threshold", func(t *testing.T) {
+	t.Run("should only send resolved notification if the node syncs during the tolerance threshold", func(t *testing.T) {
 		rskRpc := &mocks.RootstockRpcServerMock{}
 		btcRpc := &mocks.BtcRpcMock{}
 		eventBus := &mocks.EventBusMock{}
@@ -183,6 +210,7 @@ func TestEclipseCheckUseCase_Run_Rootstock(t *testing.T) {
 		mutex := &mocks.MutexMock{}
 		mutex.On("Lock").Return()
 		mutex.On("Unlock").Return()
+		alertSender.On("SendAlert", mock.Anything, resolvedEclipseAlert(t, entities.NodeTypeRootstock), []string{recipient}).Return(nil).Once()
 		useCase := w.NewEclipseCheckUseCase(
 			config,
 			blockchain.Rpc{
@@ -203,7 +231,7 @@ func TestEclipseCheckUseCase_Run_Rootstock(t *testing.T) {
 			rskExtra.AssertExpectations(t)
 		}
 		eventBus.AssertNotCalled(t, "Publish")
-		alertSender.AssertNotCalled(t, "SendAlert")
+		alertSender.AssertExpectations(t)
 		mutex.AssertExpectations(t)
 	})
 }
@@ -224,7 +252,7 @@ func TestEclipseCheckUseCase_Run_Bitcoin(t *testing.T) {
 		BtcMaxMsWaitForBlock:     1000,
 		BtcWaitPollingMsInterval: 100,
 	}
-	t.Run("should not trigger the alert if no eclipse attack is detected", func(t *testing.T) {
+	t.Run("should only send resolved notification if no eclipse attack is detected", func(t *testing.T) {
 		rskRpc := &mocks.RootstockRpcServerMock{}
 		btcRpc := &mocks.BtcRpcMock{}
 		btcExtra1 := &mocks.BtcRpcMock{}
@@ -254,6 +282,7 @@ func TestEclipseCheckUseCase_Run_Bitcoin(t *testing.T) {
 		mutex := &mocks.MutexMock{}
 		mutex.On("Lock").Return()
 		mutex.On("Unlock").Return()
+		alertSender.On("SendAlert", mock.Anything, resolvedEclipseAlert(t, entities.NodeTypeBitcoin), []string{recipient}).Return(nil).Once()
 		useCase := w.NewEclipseCheckUseCase(
 			config,
 			blockchain.Rpc{
@@ -274,7 +303,7 @@ func TestEclipseCheckUseCase_Run_Bitcoin(t *testing.T) {
 			btcExtra.AssertExpectations(t)
 		}
 		eventBus.AssertNotCalled(t, "Publish")
-		alertSender.AssertNotCalled(t, "SendAlert")
+		alertSender.AssertExpectations(t)
 		mutex.AssertExpectations(t)
 	})
 	t.Run("should trigger the alert if eclipse attack is detected", func(t *testing.T) {
@@ -305,8 +334,16 @@ func TestEclipseCheckUseCase_Run_Bitcoin(t *testing.T) {
 		alertSender.On(
 			"SendAlert",
 			mock.Anything,
-			w.EclipseAlertSubject,
-			"Your bitcoin node is under eclipse attack. Please, check your node's connectivity and synchronization.",
+			mock.MatchedBy(func(alert alerts.Alert) bool {
+				return assert.Equal(t, alerts.AlertKindNodeEclipse, alert.Kind) &&
+					assert.Equal(t, alerts.AlertSubjectEclipseAttack, alert.Subject) &&
+					assert.Equal(t, alerts.AlertSeverityCritical, alert.Severity) &&
+					assert.Equal(t, "Your bitcoin node is under eclipse attack. Please, check your node's connectivity and synchronization.", alert.Message) &&
+					assert.Equal(t, entities.NodeTypeBitcoin, alert.NodeType) &&
+					assert.Equal(t, uint64(blocks), alert.BlockNumber) &&
+					assert.Equal(t, test.AnyHash, alert.BlockHash) &&
+					assert.False(t, alert.Timestamp.IsZero())
+			}),
 			[]string{recipient},
 		).Return(nil)
 		eventBus.On("Publish", mock.MatchedBy(func(e blockchain.NodeEclipseEvent) bool {
@@ -337,7 +374,7 @@ func TestEclipseCheckUseCase_Run_Bitcoin(t *testing.T) {
 		alertSender.AssertExpectations(t)
 		mutex.AssertExpectations(t)
 	})
-	t.Run("should not trigger alert if the node syncs during the tolerance threshold", func(t *testing.T) {
+	t.Run("should only send resolved notification if the node syncs during the tolerance threshold", func(t *testing.T) {
 		rskRpc := &mocks.RootstockRpcServerMock{}
 		btcRpc := &mocks.BtcRpcMock{}
 		btcExtra1 := &mocks.BtcRpcMock{}
@@ -368,6 +405,7 @@ func TestEclipseCheckUseCase_Run_Bitcoin(t *testing.T) {
 		mutex := &mocks.MutexMock{}
 		mutex.On("Lock").Return()
 		mutex.On("Unlock").Return()
+		alertSender.On("SendAlert", mock.Anything, resolvedEclipseAlert(t, entities.NodeTypeBitcoin), []string{recipient}).Return(nil).Once()
 		useCase := w.NewEclipseCheckUseCase(
 			config,
 			blockchain.Rpc{
@@ -388,7 +426,7 @@ func TestEclipseCheckUseCase_Run_Bitcoin(t *testing.T) {
 			btcExtra.AssertExpectations(t)
 		}
 		eventBus.AssertNotCalled(t, "Publish")
-		alertSender.AssertNotCalled(t, "SendAlert")
+		alertSender.AssertExpectations(t)
 		mutex.AssertExpectations(t)
 	})
 }
@@ -474,7 +512,7 @@ func TestEclipseCheckUseCase_Run_ErrorCases(t *testing.T) {
 			[]blockchain.BitcoinNetwork{},
 			[]blockchain.RootstockRpcServer{extra},
 			&mocks.EventBusMock{},
-			&mocks.AlertSenderMock{},
+			resolvedAlertSender(t, entities.NodeTypeRootstock),
 			recipient,
 			&sync.Mutex{},
 		)
@@ -503,7 +541,7 @@ func TestEclipseCheckUseCase_Run_ErrorCases(t *testing.T) {
 			[]blockchain.BitcoinNetwork{extra},
 			[]blockchain.RootstockRpcServer{},
 			&mocks.EventBusMock{},
-			&mocks.AlertSenderMock{},
+			resolvedAlertSender(t, entities.NodeTypeBitcoin),
 			recipient,
 			&sync.Mutex{},
 		)
@@ -570,12 +608,42 @@ func TestEclipseCheckUseCase_Run_ErrorCases(t *testing.T) {
 			[]blockchain.BitcoinNetwork{},
 			[]blockchain.RootstockRpcServer{},
 			&mocks.EventBusMock{},
-			&mocks.AlertSenderMock{},
+			resolvedAlertSender(t, entities.NodeTypeBitcoin),
+			recipient,
+			&sync.Mutex{},
+		)
+		err := useCase.Run(context.Background(), entities.NodeTypeBitcoin)
+		require.NoError(t, err)
+		btc.AssertExpectations(t)
+	})
+	t.Run("should only log error sending resolved notification", func(t *testing.T) {
+		btc := &mocks.BtcRpcMock{}
+		btc.On("GetBlockchainInfo").Return(blockchain.BitcoinBlockchainInfo{
+			NetworkName:      "mainnet",
+			ValidatedBlocks:  big.NewInt(123),
+			ValidatedHeaders: big.NewInt(123),
+			BestBlockHash:    test.AnyHash,
+		}, nil)
+		alertSender := &mocks.AlertSenderMock{}
+		alertSender.On("SendAlert", mock.Anything, resolvedEclipseAlert(t, entities.NodeTypeBitcoin), []string{recipient}).
+			Return(assert.AnError).Once()
+		useCase := w.NewEclipseCheckUseCase(
+			w.EclipseCheckConfig{},
+			blockchain.Rpc{
+				Btc: btc,
+				Rsk: &mocks.RootstockRpcServerMock{},
+			},
+			[]blockchain.BitcoinNetwork{},
+			[]blockchain.RootstockRpcServer{},
+			&mocks.EventBusMock{},
+			alertSender,
 			recipient,
 			&sync.Mutex{},
 		)
+		defer test.AssertLogContains(t, "Error sending eclipse resolved notification:")()
 		err := useCase.Run(context.Background(), entities.NodeTypeBitcoin)
 		require.NoError(t, err)
 		btc.AssertExpectations(t)
+		alertSender.AssertExpectations(t)
 	})
 }
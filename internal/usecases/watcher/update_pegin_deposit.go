@@ -3,6 +3,7 @@ package watcher
 import (
 	"context"
 	"errors"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
@@ -10,10 +11,11 @@ import (
 
 type UpdatePeginDepositUseCase struct {
 	peginRepository quote.PeginQuoteRepository
+	eventBus        entities.EventBus
 }
 
-func NewUpdatePeginDepositUseCase(peginRepository quote.PeginQuoteRepository) *UpdatePeginDepositUseCase {
-	return &UpdatePeginDepositUseCase{peginRepository: peginRepository}
+func NewUpdatePeginDepositUseCase(peginRepository quote.PeginQuoteRepository, eventBus entities.EventBus) *UpdatePeginDepositUseCase {
+	return &UpdatePeginDepositUseCase{peginRepository: peginRepository, eventBus: eventBus}
 }
 
 func (useCase *UpdatePeginDepositUseCase) Run(
@@ -38,5 +40,9 @@ func (useCase *UpdatePeginDepositUseCase) Run(
 	if err := useCase.peginRepository.UpdateRetainedQuote(ctx, watchedQuote.RetainedQuote); err != nil {
 		return quote.WatchedPeginQuote{}, usecases.WrapUseCaseError(usecases.UpdatePeginDepositId, err)
 	}
+	useCase.eventBus.Publish(quote.PeginStateUpdatedEvent{
+		Event:         entities.NewBaseEvent(quote.PeginStateUpdatedEventId),
+		RetainedQuote: watchedQuote.RetainedQuote,
+	})
 	return watchedQuote, nil
 }
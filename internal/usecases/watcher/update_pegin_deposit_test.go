@@ -55,9 +55,14 @@ func TestUpdatePeginDepositUseCase_Run(t *testing.T) {
 			return q.UserBtcTxHash == test.AnyString && q.State == quote.PeginStateWaitingForDepositConfirmations
 		}),
 	).Return(nil)
-	useCase := watcher.NewUpdatePeginDepositUseCase(quoteRepository)
+	eventBus := new(mocks.EventBusMock)
+	eventBus.On("Publish", mock.MatchedBy(func(event quote.PeginStateUpdatedEvent) bool {
+		return event.RetainedQuote.State == quote.PeginStateWaitingForDepositConfirmations && event.Id() == quote.PeginStateUpdatedEventId
+	})).Return().Once()
+	useCase := watcher.NewUpdatePeginDepositUseCase(quoteRepository, eventBus)
 	watchedPeginQuote, err := useCase.Run(context.Background(), quote.NewWatchedPeginQuote(peginQuote, retainedQuote, creationData), block, tx)
 	quoteRepository.AssertExpectations(t)
+	eventBus.AssertExpectations(t)
 	require.NoError(t, err)
 	assert.Equal(t, quote.PeginStateWaitingForDepositConfirmations, watchedPeginQuote.RetainedQuote.State)
 	assert.Equal(t, tx.Hash, watchedPeginQuote.RetainedQuote.UserBtcTxHash)
@@ -83,7 +88,7 @@ func TestUpdatePeginDepositUseCase_Run_ErrorHandling(t *testing.T) {
 		for _, state := range states {
 			retainedQuote := quote.RetainedPeginQuote{State: state, DepositAddress: test.AnyAddress}
 			creationData := quote.PeginCreationDataZeroValue()
-			useCase := watcher.NewUpdatePeginDepositUseCase(quoteRepository)
+			useCase := watcher.NewUpdatePeginDepositUseCase(quoteRepository, new(mocks.EventBusMock))
 			watchedPeginQuote, err := useCase.Run(context.Background(), quote.NewWatchedPeginQuote(peginQuote, retainedQuote, creationData), block, tx)
 			require.ErrorIs(t, err, usecases.IllegalQuoteStateError)
 			assert.Empty(t, watchedPeginQuote)
@@ -94,7 +99,7 @@ func TestUpdatePeginDepositUseCase_Run_ErrorHandling(t *testing.T) {
 		block := blockchain.BitcoinBlockInformation{Time: time.Unix(510, 0)}
 		tx := blockchain.BitcoinTransactionInformation{Outputs: map[string][]*entities.Wei{test.AnyAddress: {entities.NewWei(5)}}}
 		retainedQuote := quote.RetainedPeginQuote{State: quote.PeginStateWaitingForDeposit, DepositAddress: test.AnyAddress}
-		useCase := watcher.NewUpdatePeginDepositUseCase(quoteRepository)
+		useCase := watcher.NewUpdatePeginDepositUseCase(quoteRepository, new(mocks.EventBusMock))
 		creationData := quote.PeginCreationDataZeroValue()
 		watchedPeginQuote, err := useCase.Run(context.Background(), quote.NewWatchedPeginQuote(peginQuote, retainedQuote, creationData), block, tx)
 		require.ErrorContains(t, err, bitcoinTxErrorMsg)
@@ -105,7 +110,7 @@ func TestUpdatePeginDepositUseCase_Run_ErrorHandling(t *testing.T) {
 		block := blockchain.BitcoinBlockInformation{Time: time.Unix(2000, 0)}
 		tx := blockchain.BitcoinTransactionInformation{Outputs: map[string][]*entities.Wei{test.AnyAddress: {entities.NewWei(10)}}}
 		retainedQuote := quote.RetainedPeginQuote{State: quote.PeginStateWaitingForDeposit, DepositAddress: test.AnyAddress}
-		useCase := watcher.NewUpdatePeginDepositUseCase(quoteRepository)
+		useCase := watcher.NewUpdatePeginDepositUseCase(quoteRepository, new(mocks.EventBusMock))
 		creationData := quote.PeginCreationDataZeroValue()
 		watchedPeginQuote, err := useCase.Run(context.Background(), quote.NewWatchedPeginQuote(peginQuote, retainedQuote, creationData), block, tx)
 		require.ErrorContains(t, err, bitcoinTxErrorMsg)
@@ -117,7 +122,7 @@ func TestUpdatePeginDepositUseCase_Run_ErrorHandling(t *testing.T) {
 		block := blockchain.BitcoinBlockInformation{Time: time.Unix(510, 0)}
 		tx := blockchain.BitcoinTransactionInformation{Outputs: map[string][]*entities.Wei{test.AnyAddress: {entities.NewWei(10)}}}
 		retainedQuote := quote.RetainedPeginQuote{State: quote.PeginStateWaitingForDeposit, DepositAddress: test.AnyAddress}
-		useCase := watcher.NewUpdatePeginDepositUseCase(quoteRepository)
+		useCase := watcher.NewUpdatePeginDepositUseCase(quoteRepository, new(mocks.EventBusMock))
 		creationData := quote.PeginCreationDataZeroValue()
 		watchedPeginQuote, err := useCase.Run(context.Background(), quote.NewWatchedPeginQuote(peginQuote, retainedQuote, creationData), block, tx)
 		require.Error(t, err)
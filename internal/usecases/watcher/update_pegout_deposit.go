@@ -3,16 +3,18 @@ package watcher
 import (
 	"context"
 	"errors"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
 )
 
 type UpdatePegoutQuoteDepositUseCase struct {
 	pegoutRepository quote.PegoutQuoteRepository
+	eventBus         entities.EventBus
 }
 
-func NewUpdatePegoutQuoteDepositUseCase(pegoutRepository quote.PegoutQuoteRepository) *UpdatePegoutQuoteDepositUseCase {
-	return &UpdatePegoutQuoteDepositUseCase{pegoutRepository: pegoutRepository}
+func NewUpdatePegoutQuoteDepositUseCase(pegoutRepository quote.PegoutQuoteRepository, eventBus entities.EventBus) *UpdatePegoutQuoteDepositUseCase {
+	return &UpdatePegoutQuoteDepositUseCase{pegoutRepository: pegoutRepository, eventBus: eventBus}
 }
 
 func (useCase *UpdatePegoutQuoteDepositUseCase) Run(ctx context.Context, watchedQuote quote.WatchedPegoutQuote, deposit quote.PegoutDeposit) (quote.WatchedPegoutQuote, error) {
@@ -30,5 +32,9 @@ func (useCase *UpdatePegoutQuoteDepositUseCase) Run(ctx context.Context, watched
 	if err = useCase.pegoutRepository.UpsertPegoutDeposit(ctx, deposit); err != nil {
 		return quote.WatchedPegoutQuote{}, usecases.WrapUseCaseError(usecases.UpdatePegoutDepositId, err)
 	}
+	useCase.eventBus.Publish(quote.PegoutStateUpdatedEvent{
+		Event:         entities.NewBaseEvent(quote.PegoutStateUpdatedEventId),
+		RetainedQuote: watchedQuote.RetainedQuote,
+	})
 	return watchedQuote, nil
 }
@@ -77,9 +77,14 @@ func TestUpdatePegoutQuoteDepositUseCase_Run(t *testing.T) {
 		}),
 	).Return(nil)
 	quoteReporitory.On("UpsertPegoutDeposit", test.AnyCtx, deposit).Return(nil)
-	useCase := watcher.NewUpdatePegoutQuoteDepositUseCase(quoteReporitory)
+	eventBus := new(mocks.EventBusMock)
+	eventBus.On("Publish", mock.MatchedBy(func(event quote.PegoutStateUpdatedEvent) bool {
+		return event.RetainedQuote.State == quote.PegoutStateWaitingForDepositConfirmations && event.Id() == quote.PegoutStateUpdatedEventId
+	})).Return().Once()
+	useCase := watcher.NewUpdatePegoutQuoteDepositUseCase(quoteReporitory, eventBus)
 	watchedPegoutQuote, err := useCase.Run(context.Background(), quote.NewWatchedPegoutQuote(depositedPegoutQuote, depositedRetainedQuote, depositedPegoutCreationData), deposit)
 	quoteReporitory.AssertExpectations(t)
+	eventBus.AssertExpectations(t)
 	require.NoError(t, err)
 	assert.Equal(t, quote.PegoutStateWaitingForDepositConfirmations, watchedPegoutQuote.RetainedQuote.State)
 	assert.Equal(t, deposit.TxHash, watchedPegoutQuote.RetainedQuote.UserRskTxHash)
@@ -128,7 +133,7 @@ func TestUpdatePegoutQuoteDepositUseCase_Run_NotValid(t *testing.T) {
 	for _, testCase := range cases {
 		t.Run(testCase.name, func(t *testing.T) {
 			quoteReporitory := new(mocks.PegoutQuoteRepositoryMock)
-			useCase := watcher.NewUpdatePegoutQuoteDepositUseCase(quoteReporitory)
+			useCase := watcher.NewUpdatePegoutQuoteDepositUseCase(quoteReporitory, new(mocks.EventBusMock))
 			creationData := quote.PegoutCreationDataZeroValue()
 			watchedPegoutQuote, err := useCase.Run(context.Background(), quote.NewWatchedPegoutQuote(depositedPegoutQuote, depositedRetainedQuote, creationData), testCase.deposit)
 			quoteReporitory.AssertNotCalled(t, "UpdateRetainedQuote")
@@ -159,7 +164,7 @@ func TestUpdatePegoutQuoteDepositUseCase_Run_IllegalState(t *testing.T) {
 	}
 	for _, retainedQuote := range quotes {
 		quoteReporitory := new(mocks.PegoutQuoteRepositoryMock)
-		useCase := watcher.NewUpdatePegoutQuoteDepositUseCase(quoteReporitory)
+		useCase := watcher.NewUpdatePegoutQuoteDepositUseCase(quoteReporitory, new(mocks.EventBusMock))
 		creationData := quote.PegoutCreationDataZeroValue()
 		watchedPegoutQuote, err := useCase.Run(context.Background(), quote.NewWatchedPegoutQuote(depositedPegoutQuote, retainedQuote, creationData), deposit)
 		quoteReporitory.AssertNotCalled(t, "UpdateRetainedQuote")
@@ -193,7 +198,7 @@ func TestUpdatePegoutQuoteDepositUseCase_Run_ErrorHandling(t *testing.T) {
 	for _, setup := range setups {
 		quoteReporitory := new(mocks.PegoutQuoteRepositoryMock)
 		setup(quoteReporitory)
-		useCase := watcher.NewUpdatePegoutQuoteDepositUseCase(quoteReporitory)
+		useCase := watcher.NewUpdatePegoutQuoteDepositUseCase(quoteReporitory, new(mocks.EventBusMock))
 		creationData := quote.PegoutCreationDataZeroValue()
 		watchedPegoutQuote, err := useCase.Run(context.Background(), quote.NewWatchedPegoutQuote(depositedPegoutQuote, depositedRetainedQuote, creationData), deposit)
 		quoteReporitory.AssertExpectations(t)
@@ -0,0 +1,23 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+type DeleteWebhookUseCase struct {
+	webhookRepository webhook.WebhookRepository
+}
+
+func NewDeleteWebhookUseCase(webhookRepository webhook.WebhookRepository) *DeleteWebhookUseCase {
+	return &DeleteWebhookUseCase{webhookRepository: webhookRepository}
+}
+
+func (useCase *DeleteWebhookUseCase) Run(ctx context.Context, id string) error {
+	if err := useCase.webhookRepository.DeleteWebhook(ctx, id); err != nil {
+		return usecases.WrapUseCaseError(usecases.DeleteWebhookId, err)
+	}
+	return nil
+}
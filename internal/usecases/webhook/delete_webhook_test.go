@@ -0,0 +1,29 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	w "github.com/rsksmart/liquidity-provider-server/internal/usecases/webhook"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteWebhookUseCase_Run(t *testing.T) {
+	t.Run("should delete webhook", func(t *testing.T) {
+		repository := mocks.NewWebhookRepositoryMock(t)
+		repository.EXPECT().DeleteWebhook(mock.Anything, "1").Return(nil).Once()
+		useCase := w.NewDeleteWebhookUseCase(repository)
+		err := useCase.Run(context.Background(), "1")
+		require.NoError(t, err)
+	})
+	t.Run("should handle not found error", func(t *testing.T) {
+		repository := mocks.NewWebhookRepositoryMock(t)
+		repository.EXPECT().DeleteWebhook(mock.Anything, "1").Return(webhook.WebhookNotFoundError).Once()
+		useCase := w.NewDeleteWebhookUseCase(repository)
+		err := useCase.Run(context.Background(), "1")
+		require.ErrorIs(t, err, webhook.WebhookNotFoundError)
+	})
+}
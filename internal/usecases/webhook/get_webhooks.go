@@ -0,0 +1,25 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+type GetWebhooksUseCase struct {
+	webhookRepository webhook.WebhookRepository
+}
+
+func NewGetWebhooksUseCase(webhookRepository webhook.WebhookRepository) *GetWebhooksUseCase {
+	return &GetWebhooksUseCase{webhookRepository: webhookRepository}
+}
+
+// Run returns the global webhooks, the webhooks registered for a single quote are not included
+func (useCase *GetWebhooksUseCase) Run(ctx context.Context) ([]webhook.Webhook, error) {
+	webhooks, err := useCase.webhookRepository.GetGlobalWebhooks(ctx)
+	if err != nil {
+		return nil, usecases.WrapUseCaseError(usecases.GetWebhooksId, err)
+	}
+	return webhooks, nil
+}
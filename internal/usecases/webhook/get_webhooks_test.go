@@ -0,0 +1,33 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	w "github.com/rsksmart/liquidity-provider-server/internal/usecases/webhook"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWebhooksUseCase_Run(t *testing.T) {
+	t.Run("should return global webhooks", func(t *testing.T) {
+		webhooks := []webhook.Webhook{{Id: "1", Url: testUrl}, {Id: "2", Url: testUrl + "/other"}}
+		repository := mocks.NewWebhookRepositoryMock(t)
+		repository.EXPECT().GetGlobalWebhooks(mock.Anything).Return(webhooks, nil).Once()
+		useCase := w.NewGetWebhooksUseCase(repository)
+		result, err := useCase.Run(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, webhooks, result)
+	})
+	t.Run("should handle repository error", func(t *testing.T) {
+		repository := mocks.NewWebhookRepositoryMock(t)
+		repository.EXPECT().GetGlobalWebhooks(mock.Anything).Return(nil, assert.AnError).Once()
+		useCase := w.NewGetWebhooksUseCase(repository)
+		result, err := useCase.Run(context.Background())
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+	log "github.com/sirupsen/logrus"
+)
+
+type NotifyQuoteStateUseCase struct {
+	webhookRepository  webhook.WebhookRepository
+	notificationSender webhook.NotificationSender
+}
+
+func NewNotifyQuoteStateUseCase(
+	webhookRepository webhook.WebhookRepository,
+	notificationSender webhook.NotificationSender,
+) *NotifyQuoteStateUseCase {
+	return &NotifyQuoteStateUseCase{
+		webhookRepository:  webhookRepository,
+		notificationSender: notificationSender,
+	}
+}
+
+// Run sends the notification to the global webhooks and to the webhooks registered for the quote of the
+// notification. A failure in one webhook doesn't prevent the notification from being sent to the rest. After
+// notifying the final state of a quote, the webhooks registered for that quote are removed
+func (useCase *NotifyQuoteStateUseCase) Run(ctx context.Context, notification webhook.Notification) error {
+	webhooks, err := useCase.webhookRepository.GetWebhooks(ctx, notification.QuoteHash)
+	if err != nil {
+		return usecases.WrapUseCaseError(usecases.NotifyQuoteStateId, err)
+	}
+	errs := make([]error, 0)
+	for _, hook := range webhooks {
+		if err = useCase.notificationSender.SendNotification(ctx, hook, notification); err != nil {
+			log.Errorf("Error notifying state %s of quote %s to webhook %s: %v", notification.State, notification.QuoteHash, hook.Id, err)
+			errs = append(errs, err)
+		}
+	}
+	if notification.IsFinal() {
+		if err = useCase.webhookRepository.DeleteQuoteWebhooks(ctx, notification.QuoteHash); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return usecases.WrapUseCaseError(usecases.NotifyQuoteStateId, errors.Join(errs...))
+	}
+	return nil
+}
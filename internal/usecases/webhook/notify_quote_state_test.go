@@ -0,0 +1,99 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	w "github.com/rsksmart/liquidity-provider-server/internal/usecases/webhook"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testNotification = webhook.Notification{
+	QuoteHash: testQuoteHash,
+	Operation: webhook.OperationPegout,
+	State:     "SendPegoutSucceeded",
+	Timestamp: time.Unix(1700000000, 0).UTC(),
+}
+
+func TestNotifyQuoteStateUseCase_Run(t *testing.T) {
+	globalWebhook := webhook.Webhook{Id: "1", Url: testUrl}
+	quoteWebhook := webhook.Webhook{Id: "2", Url: testUrl + "/quote", QuoteHash: testQuoteHash}
+	t.Run("should notify all the webhooks of the quote", func(t *testing.T) {
+		repository := mocks.NewWebhookRepositoryMock(t)
+		repository.EXPECT().GetWebhooks(mock.Anything, testQuoteHash).Return([]webhook.Webhook{globalWebhook, quoteWebhook}, nil).Once()
+		sender := mocks.NewNotificationSenderMock(t)
+		sender.EXPECT().SendNotification(mock.Anything, globalWebhook, testNotification).Return(nil).Once()
+		sender.EXPECT().SendNotification(mock.Anything, quoteWebhook, testNotification).Return(nil).Once()
+		useCase := w.NewNotifyQuoteStateUseCase(repository, sender)
+		err := useCase.Run(context.Background(), testNotification)
+		require.NoError(t, err)
+	})
+	t.Run("should keep notifying when one webhook fails", func(t *testing.T) {
+		repository := mocks.NewWebhookRepositoryMock(t)
+		repository.EXPECT().GetWebhooks(mock.Anything, testQuoteHash).Return([]webhook.Webhook{globalWebhook, quoteWebhook}, nil).Once()
+		sender := mocks.NewNotificationSenderMock(t)
+		sender.EXPECT().SendNotification(mock.Anything, globalWebhook, testNotification).Return(assert.AnError).Once()
+		sender.EXPECT().SendNotification(mock.Anything, quoteWebhook, testNotification).Return(nil).Once()
+		useCase := w.NewNotifyQuoteStateUseCase(repository, sender)
+		err := useCase.Run(context.Background(), testNotification)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+	t.Run("should not send anything when there are no webhooks", func(t *testing.T) {
+		repository := mocks.NewWebhookRepositoryMock(t)
+		repository.EXPECT().GetWebhooks(mock.Anything, testQuoteHash).Return([]webhook.Webhook{}, nil).Once()
+		sender := mocks.NewNotificationSenderMock(t)
+		useCase := w.NewNotifyQuoteStateUseCase(repository, sender)
+		err := useCase.Run(context.Background(), testNotification)
+		require.NoError(t, err)
+	})
+	t.Run("should handle repository error", func(t *testing.T) {
+		repository := mocks.NewWebhookRepositoryMock(t)
+		repository.EXPECT().GetWebhooks(mock.Anything, testQuoteHash).Return(nil, assert.AnError).Once()
+		sender := mocks.NewNotificationSenderMock(t)
+		useCase := w.NewNotifyQuoteStateUseCase(repository, sender)
+		err := useCase.Run(context.Background(), testNotification)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+	t.Run("should remove the webhooks of the quote after notifying the final state", func(t *testing.T) {
+		finalNotification := testNotification
+		finalNotification.State = "BtcReleased"
+		repository := mocks.NewWebhookRepositoryMock(t)
+		repository.EXPECT().GetWebhooks(mock.Anything, testQuoteHash).Return([]webhook.Webhook{globalWebhook, quoteWebhook}, nil).Once()
+		repository.EXPECT().DeleteQuoteWebhooks(mock.Anything, testQuoteHash).Return(nil).Once()
+		sender := mocks.NewNotificationSenderMock(t)
+		sender.EXPECT().SendNotification(mock.Anything, globalWebhook, finalNotification).Return(nil).Once()
+		sender.EXPECT().SendNotification(mock.Anything, quoteWebhook, finalNotification).Return(nil).Once()
+		useCase := w.NewNotifyQuoteStateUseCase(repository, sender)
+		err := useCase.Run(context.Background(), finalNotification)
+		require.NoError(t, err)
+	})
+	t.Run("should remove the webhooks of the quote even if the final notification fails", func(t *testing.T) {
+		finalNotification := testNotification
+		finalNotification.Operation = webhook.OperationPegin
+		finalNotification.State = "RegisterPegInSucceeded"
+		repository := mocks.NewWebhookRepositoryMock(t)
+		repository.EXPECT().GetWebhooks(mock.Anything, testQuoteHash).Return([]webhook.Webhook{quoteWebhook}, nil).Once()
+		repository.EXPECT().DeleteQuoteWebhooks(mock.Anything, testQuoteHash).Return(nil).Once()
+		sender := mocks.NewNotificationSenderMock(t)
+		sender.EXPECT().SendNotification(mock.Anything, quoteWebhook, finalNotification).Return(assert.AnError).Once()
+		useCase := w.NewNotifyQuoteStateUseCase(repository, sender)
+		err := useCase.Run(context.Background(), finalNotification)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+	t.Run("should handle error removing the webhooks of the quote", func(t *testing.T) {
+		finalNotification := testNotification
+		finalNotification.State = "TimeForDepositElapsed"
+		repository := mocks.NewWebhookRepositoryMock(t)
+		repository.EXPECT().GetWebhooks(mock.Anything, testQuoteHash).Return([]webhook.Webhook{}, nil).Once()
+		repository.EXPECT().DeleteQuoteWebhooks(mock.Anything, testQuoteHash).Return(assert.AnError).Once()
+		sender := mocks.NewNotificationSenderMock(t)
+		useCase := w.NewNotifyQuoteStateUseCase(repository, sender)
+		err := useCase.Run(context.Background(), finalNotification)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/rootstock"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	log "github.com/sirupsen/logrus"
+)
+
+// QuoteStateEventIds are the ids of the events published when a quote changes its state
+var QuoteStateEventIds = []entities.EventId{
+	quote.AcceptedPeginQuoteEventId,
+	quote.CallForUserCompletedEventId,
+	quote.RegisterPeginCompletedEventId,
+	quote.PeginStateUpdatedEventId,
+	quote.AcceptedPegoutQuoteEventId,
+	quote.PegoutBtcSentEventId,
+	quote.PegoutQuoteCompletedEventId,
+	quote.PegoutStateUpdatedEventId,
+	rootstock.BatchPegOutUpdatedEventId,
+}
+
+// NotificationsFromEvent converts a quote state event into the notifications of the state transitions
+// it represents. Most events represent the transition of a single quote, but a batch pegout releases
+// the BTC of several quotes at once
+// nolint:cyclop
+func NotificationsFromEvent(event entities.Event) []webhook.Notification {
+	pegin := func(retained quote.RetainedPeginQuote, err error) []webhook.Notification {
+		return []webhook.Notification{newNotification(event, webhook.OperationPegin, retained.QuoteHash, string(retained.State), err)}
+	}
+	pegout := func(retained quote.RetainedPegoutQuote, err error) []webhook.Notification {
+		return []webhook.Notification{newNotification(event, webhook.OperationPegout, retained.QuoteHash, string(retained.State), err)}
+	}
+	switch parsedEvent := event.(type) {
+	case quote.AcceptedPeginQuoteEvent:
+		return pegin(parsedEvent.RetainedQuote, nil)
+	case quote.CallForUserCompletedEvent:
+		return pegin(parsedEvent.RetainedQuote, parsedEvent.Error)
+	case quote.RegisterPeginCompletedEvent:
+		return pegin(parsedEvent.RetainedQuote, parsedEvent.Error)
+	case quote.PeginStateUpdatedEvent:
+		return pegin(parsedEvent.RetainedQuote, nil)
+	case quote.AcceptedPegoutQuoteEvent:
+		return pegout(parsedEvent.RetainedQuote, nil)
+	case quote.PegoutBtcSentToUserEvent:
+		return pegout(parsedEvent.RetainedQuote, parsedEvent.Error)
+	case quote.PegoutQuoteCompletedEvent:
+		return pegout(parsedEvent.RetainedQuote, parsedEvent.Error)
+	case quote.PegoutStateUpdatedEvent:
+		return pegout(parsedEvent.RetainedQuote, nil)
+	case rootstock.BatchPegOutUpdatedEvent:
+		notifications := make([]webhook.Notification, 0, len(parsedEvent.QuoteHashes))
+		for _, quoteHash := range parsedEvent.QuoteHashes {
+			notifications = append(notifications, newNotification(event, webhook.OperationPegout, quoteHash, string(quote.PegoutStateBtcReleased), nil))
+		}
+		return notifications
+	default:
+		log.Errorf("Unexpected quote state event %s", event.Id())
+		return nil
+	}
+}
+
+func newNotification(event entities.Event, operation webhook.Operation, quoteHash, state string, err error) webhook.Notification {
+	notification := webhook.Notification{
+		QuoteHash: quoteHash,
+		Operation: operation,
+		State:     state,
+		Timestamp: event.CreationTimestamp().UTC(),
+	}
+	if err != nil {
+		notification.Error = err.Error()
+	}
+	return notification
+}
@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
+)
+
+const webhookIdBytes = 16
+
+type RegisterWebhookUseCase struct {
+	webhookRepository webhook.WebhookRepository
+}
+
+func NewRegisterWebhookUseCase(webhookRepository webhook.WebhookRepository) *RegisterWebhookUseCase {
+	return &RegisterWebhookUseCase{webhookRepository: webhookRepository}
+}
+
+// Run registers a webhook to receive the state transitions of the quotes. If quoteHash is empty, the webhook
+// is global and is notified about every quote. If secret is empty, the notifications are signed with the
+// liquidity provider key instead of using HMAC
+func (useCase *RegisterWebhookUseCase) Run(ctx context.Context, url, quoteHash, secret string) (webhook.Webhook, error) {
+	return useCase.register(ctx, webhook.Webhook{Url: url, QuoteHash: quoteHash, Secret: secret})
+}
+
+// RunCallback registers the callback URL sent by the user that accepted a quote as a webhook of that quote. The
+// URL must be public, since the users of the public API are not trusted
+func (useCase *RegisterWebhookUseCase) RunCallback(ctx context.Context, url, quoteHash string) (webhook.Webhook, error) {
+	if err := webhook.ValidateCallbackUrl(url); err != nil {
+		return webhook.Webhook{}, usecases.WrapUseCaseError(usecases.RegisterWebhookId, err)
+	}
+	return useCase.register(ctx, webhook.Webhook{Url: url, QuoteHash: quoteHash, Callback: true})
+}
+
+func (useCase *RegisterWebhookUseCase) register(ctx context.Context, hook webhook.Webhook) (webhook.Webhook, error) {
+	id, err := utils.GetRandomBytes(webhookIdBytes)
+	if err != nil {
+		return webhook.Webhook{}, usecases.WrapUseCaseError(usecases.RegisterWebhookId, err)
+	}
+	hook.Id = hex.EncodeToString(id)
+	hook.CreatedAt = time.Now().UTC()
+	if err = useCase.webhookRepository.InsertWebhook(ctx, hook); err != nil {
+		return webhook.Webhook{}, usecases.WrapUseCaseError(usecases.RegisterWebhookId, err)
+	}
+	return hook, nil
+}
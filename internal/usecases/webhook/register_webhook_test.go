@@ -0,0 +1,82 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	w "github.com/rsksmart/liquidity-provider-server/internal/usecases/webhook"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testUrl       = "https://integrator.example.com/callback"
+	testQuoteHash = "0x0102"
+)
+
+func TestRegisterWebhookUseCase_Run(t *testing.T) {
+	t.Run("should register webhook", func(t *testing.T) {
+		repository := mocks.NewWebhookRepositoryMock(t)
+		repository.EXPECT().InsertWebhook(mock.Anything, mock.MatchedBy(func(hook webhook.Webhook) bool {
+			return assert.Len(t, hook.Id, 32) &&
+				assert.Equal(t, testUrl, hook.Url) &&
+				assert.Equal(t, testQuoteHash, hook.QuoteHash) &&
+				assert.Equal(t, "secret", hook.Secret) &&
+				assert.WithinDuration(t, time.Now(), hook.CreatedAt, time.Minute)
+		})).Return(nil).Once()
+		useCase := w.NewRegisterWebhookUseCase(repository)
+		result, err := useCase.Run(context.Background(), testUrl, testQuoteHash, "secret")
+		require.NoError(t, err)
+		assert.Equal(t, testUrl, result.Url)
+		assert.NotEmpty(t, result.Id)
+	})
+	t.Run("should generate different ids", func(t *testing.T) {
+		repository := mocks.NewWebhookRepositoryMock(t)
+		repository.EXPECT().InsertWebhook(mock.Anything, mock.Anything).Return(nil).Twice()
+		useCase := w.NewRegisterWebhookUseCase(repository)
+		first, err := useCase.Run(context.Background(), testUrl, "", "")
+		require.NoError(t, err)
+		second, err := useCase.Run(context.Background(), testUrl, "", "")
+		require.NoError(t, err)
+		assert.NotEqual(t, first.Id, second.Id)
+	})
+	t.Run("should handle repository error", func(t *testing.T) {
+		repository := mocks.NewWebhookRepositoryMock(t)
+		repository.EXPECT().InsertWebhook(mock.Anything, mock.Anything).Return(webhook.DuplicateWebhookError).Once()
+		useCase := w.NewRegisterWebhookUseCase(repository)
+		result, err := useCase.Run(context.Background(), testUrl, testQuoteHash, "")
+		require.ErrorIs(t, err, webhook.DuplicateWebhookError)
+		assert.Empty(t, result)
+	})
+}
+
+func TestRegisterWebhookUseCase_RunCallback(t *testing.T) {
+	t.Run("should register the callback as a webhook of the quote", func(t *testing.T) {
+		repository := mocks.NewWebhookRepositoryMock(t)
+		repository.EXPECT().InsertWebhook(mock.Anything, mock.MatchedBy(func(hook webhook.Webhook) bool {
+			return assert.Len(t, hook.Id, 32) &&
+				assert.Equal(t, testUrl, hook.Url) &&
+				assert.Equal(t, testQuoteHash, hook.QuoteHash) &&
+				assert.Empty(t, hook.Secret) &&
+				assert.True(t, hook.Callback) &&
+				assert.WithinDuration(t, time.Now(), hook.CreatedAt, time.Minute)
+		})).Return(nil).Once()
+		useCase := w.NewRegisterWebhookUseCase(repository)
+		result, err := useCase.RunCallback(context.Background(), testUrl, testQuoteHash)
+		require.NoError(t, err)
+		assert.True(t, result.Callback)
+	})
+	t.Run("should reject non public callback urls", func(t *testing.T) {
+		repository := mocks.NewWebhookRepositoryMock(t)
+		useCase := w.NewRegisterWebhookUseCase(repository)
+		for _, url := range []string{"http://integrator.example.com/callback", "https://127.0.0.1/callback", "https://169.254.169.254/"} {
+			result, err := useCase.RunCallback(context.Background(), url, testQuoteHash)
+			require.ErrorIs(t, err, webhook.InvalidCallbackUrlError)
+			assert.Empty(t, result)
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	log "github.com/sirupsen/logrus"
+)
+
+const quoteStateStreamBufferSize = 10
+
+// StreamQuoteStateUseCase delivers the state transitions of a quote to the clients that follow its status in
+// real time. The event bus doesn't support removing subscriptions, so the use case subscribes to it only once
+// (when the first client arrives) and fans out the notifications to the subscribers of each quote. A subscriber
+// that doesn't keep up with the notifications is disconnected instead of blocking the rest of them
+type StreamQuoteStateUseCase struct {
+	eventBus    entities.EventBus
+	once        sync.Once
+	mutex       sync.Mutex
+	subscribers map[string]map[chan webhook.Notification]struct{}
+}
+
+func NewStreamQuoteStateUseCase(eventBus entities.EventBus) *StreamQuoteStateUseCase {
+	return &StreamQuoteStateUseCase{
+		eventBus:    eventBus,
+		subscribers: make(map[string]map[chan webhook.Notification]struct{}),
+	}
+}
+
+// Run returns a channel that receives the state transitions of the quote. The channel is closed when
+// the context is done or if the subscriber is disconnected for being too slow
+func (useCase *StreamQuoteStateUseCase) Run(ctx context.Context, quoteHash string) <-chan webhook.Notification {
+	useCase.once.Do(useCase.subscribe)
+	channel := make(chan webhook.Notification, quoteStateStreamBufferSize)
+	useCase.mutex.Lock()
+	if _, ok := useCase.subscribers[quoteHash]; !ok {
+		useCase.subscribers[quoteHash] = make(map[chan webhook.Notification]struct{})
+	}
+	useCase.subscribers[quoteHash][channel] = struct{}{}
+	useCase.mutex.Unlock()
+	go func() {
+		<-ctx.Done()
+		useCase.mutex.Lock()
+		defer useCase.mutex.Unlock()
+		useCase.unsubscribe(quoteHash, channel)
+	}()
+	return channel
+}
+
+func (useCase *StreamQuoteStateUseCase) subscribe() {
+	for _, id := range QuoteStateEventIds {
+		go useCase.dispatch(useCase.eventBus.Subscribe(id))
+	}
+}
+
+func (useCase *StreamQuoteStateUseCase) dispatch(events <-chan entities.Event) {
+	for event := range events {
+		if event == nil {
+			continue
+		}
+		for _, notification := range NotificationsFromEvent(event) {
+			useCase.notify(notification)
+		}
+	}
+}
+
+func (useCase *StreamQuoteStateUseCase) notify(notification webhook.Notification) {
+	useCase.mutex.Lock()
+	defer useCase.mutex.Unlock()
+	for channel := range useCase.subscribers[notification.QuoteHash] {
+		select {
+		case channel <- notification:
+		default:
+			log.Warnf("Disconnecting slow status stream subscriber of quote %s", notification.QuoteHash)
+			useCase.unsubscribe(notification.QuoteHash, channel)
+		}
+	}
+}
+
+// unsubscribe must be called holding the mutex
+func (useCase *StreamQuoteStateUseCase) unsubscribe(quoteHash string, channel chan webhook.Notification) {
+	channels, ok := useCase.subscribers[quoteHash]
+	if !ok {
+		return
+	}
+	if _, ok = channels[channel]; !ok {
+		return
+	}
+	delete(channels, channel)
+	close(channel)
+	if len(channels) == 0 {
+		delete(useCase.subscribers, quoteHash)
+	}
+}
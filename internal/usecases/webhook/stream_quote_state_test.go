@@ -0,0 +1,100 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/rootstock"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+	w "github.com/rsksmart/liquidity-provider-server/internal/usecases/webhook"
+	"github.com/rsksmart/liquidity-provider-server/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func receiveNotification(t *testing.T, channel <-chan webhook.Notification) (webhook.Notification, bool) {
+	select {
+	case notification, ok := <-channel:
+		return notification, ok
+	case <-time.After(time.Second):
+		require.Fail(t, "Notification not received")
+		return webhook.Notification{}, false
+	}
+}
+
+// nolint:funlen
+func TestStreamQuoteStateUseCase_Run(t *testing.T) {
+	channels := make(map[entities.EventId]chan entities.Event)
+	eventBus := &mocks.EventBusMock{}
+	for _, id := range w.QuoteStateEventIds {
+		channels[id] = make(chan entities.Event)
+		eventBus.On("Subscribe", id).Return((<-chan entities.Event)(channels[id])).Once()
+	}
+	useCase := w.NewStreamQuoteStateUseCase(eventBus)
+	timestamp := time.Unix(1700000000, 0)
+
+	t.Run("should only deliver the transitions of the subscribed quote", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stream := useCase.Run(ctx, testQuoteHash)
+		channels[quote.PeginStateUpdatedEventId] <- quote.PeginStateUpdatedEvent{
+			Event:         entities.BaseEvent{EventId: quote.PeginStateUpdatedEventId, Timestamp: timestamp},
+			RetainedQuote: quote.RetainedPeginQuote{QuoteHash: "other", State: quote.PeginStateTimeForDepositElapsed},
+		}
+		channels[quote.CallForUserCompletedEventId] <- quote.CallForUserCompletedEvent{
+			Event:         entities.BaseEvent{EventId: quote.CallForUserCompletedEventId, Timestamp: timestamp},
+			RetainedQuote: quote.RetainedPeginQuote{QuoteHash: testQuoteHash, State: quote.PeginStateCallForUserSucceeded},
+		}
+		notification, ok := receiveNotification(t, stream)
+		require.True(t, ok)
+		assert.Equal(t, webhook.Notification{
+			QuoteHash: testQuoteHash,
+			Operation: webhook.OperationPegin,
+			State:     string(quote.PeginStateCallForUserSucceeded),
+			Timestamp: timestamp.UTC(),
+		}, notification)
+	})
+	t.Run("should deliver the transitions to every subscriber of the quote", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		first := useCase.Run(ctx, testQuoteHash)
+		second := useCase.Run(ctx, testQuoteHash)
+		channels[rootstock.BatchPegOutUpdatedEventId] <- rootstock.BatchPegOutUpdatedEvent{
+			Event:       entities.BaseEvent{EventId: rootstock.BatchPegOutUpdatedEventId, Timestamp: timestamp},
+			QuoteHashes: []string{"other", testQuoteHash},
+		}
+		for _, stream := range []<-chan webhook.Notification{first, second} {
+			notification, ok := receiveNotification(t, stream)
+			require.True(t, ok)
+			assert.Equal(t, string(quote.PegoutStateBtcReleased), notification.State)
+		}
+	})
+	t.Run("should close the stream when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream := useCase.Run(ctx, testQuoteHash)
+		cancel()
+		_, ok := receiveNotification(t, stream)
+		assert.False(t, ok)
+	})
+	t.Run("should disconnect the subscribers that don't keep up", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stream := useCase.Run(ctx, testQuoteHash)
+		const sent = 15
+		for i := 0; i < sent; i++ {
+			channels[quote.PegoutStateUpdatedEventId] <- quote.PegoutStateUpdatedEvent{
+				Event:         entities.BaseEvent{EventId: quote.PegoutStateUpdatedEventId, Timestamp: timestamp},
+				RetainedQuote: quote.RetainedPegoutQuote{QuoteHash: testQuoteHash, State: quote.PegoutStateBridgeTxSucceeded},
+			}
+		}
+		received := 0
+		for range stream {
+			received++
+		}
+		assert.Less(t, received, sent)
+	})
+	eventBus.AssertExpectations(t)
+}
@@ -0,0 +1,63 @@
+package pkg
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/alerts"
+)
+
+type AlertDTO struct {
+	Kind        string              `json:"kind" example:"pegin_out_of_liquidity" description:"Condition that triggered the alert" required:""`
+	Subject     string              `json:"subject" example:"PegIn: Out of liquidity" description:"Subject of the alert" required:""`
+	Severity    string              `json:"severity" example:"critical" description:"Severity of the alert" required:""`
+	Status      string              `json:"status" example:"firing" description:"firing if the condition is present, resolved if it was cleared" required:""`
+	Message     string              `json:"message" example:"You are out of liquidity to perform a PegIn. Please, do a deposit" description:"Alert message" required:""`
+	QuoteHash   string              `json:"quoteHash,omitempty" example:"0x1234" description:"Hash of the quote related to the alert"`
+	Amounts     map[string]*big.Int `json:"amounts,omitempty" description:"Amounts in wei related to the alert"`
+	NodeType    string              `json:"nodeType,omitempty" example:"rootstock" description:"Node related to the alert"`
+	BlockNumber uint64              `json:"blockNumber,omitempty" example:"500" description:"Block number related to the alert"`
+	BlockHash   string              `json:"blockHash,omitempty" example:"0x1234" description:"Block hash related to the alert"`
+	Timestamp   time.Time           `json:"timestamp" example:"2024-01-02T03:04:05Z" description:"Time when the alert was emitted" required:""`
+	Recipients  []string            `json:"recipients" description:"Recipients of the alert" required:""`
+	Delivered   bool                `json:"delivered" example:"true" description:"Whether the alert was delivered successfully" required:""`
+	Error       string              `json:"error,omitempty" description:"Delivery error, if any"`
+}
+
+type RecentAlertsResponse struct {
+	Alerts []AlertDTO `json:"alerts"`
+}
+
+func ToAlertDTO(record alerts.AlertRecord) AlertDTO {
+	var amounts map[string]*big.Int
+	if len(record.Amounts) > 0 {
+		amounts = make(map[string]*big.Int, len(record.Amounts))
+		for name, amount := range record.Amounts {
+			amounts[name] = amount.AsBigInt()
+		}
+	}
+	return AlertDTO{
+		Kind:        string(record.Kind),
+		Subject:     record.Subject,
+		Severity:    string(record.Severity),
+		Status:      string(record.Status),
+		Message:     record.Message,
+		QuoteHash:   record.QuoteHash,
+		Amounts:     amounts,
+		NodeType:    record.NodeType,
+		BlockNumber: record.BlockNumber,
+		BlockHash:   record.BlockHash,
+		Timestamp:   record.Timestamp,
+		Recipients:  record.Recipients,
+		Delivered:   record.Delivered,
+		Error:       record.Error,
+	}
+}
+
+func ToRecentAlertsResponse(records []alerts.AlertRecord) RecentAlertsResponse {
+	result := make([]AlertDTO, len(records))
+	for i, record := range records {
+		result[i] = ToAlertDTO(record)
+	}
+	return RecentAlertsResponse{Alerts: result}
+}
@@ -1,17 +1,26 @@
 package pkg
 
 import (
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
 	"github.com/rsksmart/liquidity-provider-server/internal/usecases"
 	"math/big"
 )
 
 type AcceptQuoteRequest struct {
-	QuoteHash string `json:"quoteHash" required:"" validate:"required" example:"0x0" description:"QuoteHash"`
+	QuoteHash   string `json:"quoteHash" required:"" validate:"required" example:"0x0" description:"QuoteHash"`
+	CallbackUrl string `json:"callbackUrl,omitempty" validate:"omitempty,http_url" example:"https://integrator.example.com/callback" description:"HTTPS URL to notify the state transitions of the quote, it must have a public host"`
 }
 
 type AcceptAuthenticatedQuoteRequest struct {
-	QuoteHash string `json:"quoteHash" required:"" validate:"required" example:"0x0" description:"QuoteHash"`
-	Signature string `json:"signature" required:"" validate:"required" example:"0x0" description:"Signature from a trusted account"`
+	QuoteHash   string `json:"quoteHash" required:"" validate:"required" example:"0x0" description:"QuoteHash"`
+	Signature   string `json:"signature" required:"" validate:"required" example:"0x0" description:"Signature from a trusted account"`
+	CallbackUrl string `json:"callbackUrl,omitempty" validate:"omitempty,http_url" example:"https://integrator.example.com/callback" description:"HTTPS URL to notify the state transitions of the quote, it must have a public host"`
+}
+
+type QuoteBatchErrorDTO struct {
+	Message     string         `json:"message" required:"" example:"invalid request" description:"Description of the error"`
+	Details     map[string]any `json:"details" required:"" description:"Details of the error"`
+	Recoverable bool           `json:"recoverable" required:"" description:"Whether the request can succeed if it is sent again or fixed"`
 }
 
 type GetCollateralResponse struct {
@@ -50,3 +59,19 @@ func ToRecommendedOperationDTO(domain usecases.RecommendedOperationResult) Recom
 		EstimatedGasFee:       domain.EstimatedGasFee.AsBigInt(),
 	}
 }
+
+type DepositConfirmationsDTO struct {
+	QuoteHash             string `json:"quoteHash" example:"0x1234" description:"Hash of the quote" required:""`
+	TxHash                string `json:"txHash,omitempty" example:"0x1234" description:"Hash of the user deposit transaction, empty if it wasn't detected yet"`
+	Confirmations         uint64 `json:"confirmations" example:"2" description:"Confirmations of the user deposit" required:""`
+	RequiredConfirmations uint64 `json:"requiredConfirmations" example:"6" description:"Confirmations required by the liquidity provider to process the deposit" required:""`
+}
+
+func ToDepositConfirmationsDTO(quoteHash string, confirmations quote.DepositConfirmations) DepositConfirmationsDTO {
+	return DepositConfirmationsDTO{
+		QuoteHash:             quoteHash,
+		TxHash:                confirmations.TxHash,
+		Confirmations:         confirmations.Confirmations,
+		RequiredConfirmations: confirmations.RequiredConfirmations,
+	}
+}
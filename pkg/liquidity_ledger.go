@@ -0,0 +1,78 @@
+package pkg
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	usecase "github.com/rsksmart/liquidity-provider-server/internal/usecases/liquidity_provider"
+)
+
+type LiquidityHoldDTO struct {
+	QuoteHash     string     `json:"quoteHash" example:"0x1234" description:"Hash of the quote that reserved the liquidity" required:""`
+	Operation     string     `json:"operation" example:"pegin" description:"Operation of the quote: pegin or pegout" required:""`
+	Amount        *big.Int   `json:"amount" example:"5000000000000000" description:"Reserved amount in wei" required:""`
+	Status        string     `json:"status" example:"active" description:"Status of the hold: active, released or expired" required:""`
+	CreatedAt     time.Time  `json:"createdAt" example:"2024-01-02T03:04:05Z" description:"Time when the hold was placed" required:""`
+	ExpiresAt     time.Time  `json:"expiresAt" example:"2024-01-02T05:04:05Z" description:"Latest time in which the LP was expected to pay the quote, the hold is kept while the quote is still waiting for the deposit" required:""`
+	ReleasedAt    *time.Time `json:"releasedAt,omitempty" example:"2024-01-02T04:04:05Z" description:"Time when the hold was released"`
+	ReleaseReason string     `json:"releaseReason,omitempty" example:"CallForUserSucceeded" description:"Quote state or reason that released the hold"`
+}
+
+type LiquidityHoldsResponse struct {
+	Holds      []LiquidityHoldDTO `json:"holds" required:""`
+	PeginHeld  *big.Int           `json:"peginHeld" example:"5000000000000000" description:"Sum in wei of the active pegin holds in the response" required:""`
+	PegoutHeld *big.Int           `json:"pegoutHeld" example:"5000000000000000" description:"Sum in wei of the active pegout holds in the response" required:""`
+}
+
+type LiquidityLedgerReconciliationResponse struct {
+	Created  []string `json:"created" description:"Hashes of the pending quotes that didn't have a hold" required:""`
+	Released []string `json:"released" description:"Hashes of the quotes whose hold was released because they were no longer pending" required:""`
+	Expired  int64    `json:"expired" example:"2" description:"Number of holds whose quote was no longer waiting after their expiration time" required:""`
+}
+
+func ToLiquidityHoldDTO(hold liquidity_provider.LiquidityHold) LiquidityHoldDTO {
+	dto := LiquidityHoldDTO{
+		QuoteHash:     hold.QuoteHash,
+		Operation:     string(hold.Operation),
+		Status:        string(hold.Status),
+		CreatedAt:     hold.CreatedAt,
+		ExpiresAt:     hold.ExpiresAt,
+		ReleaseReason: hold.ReleaseReason,
+	}
+	if hold.Amount != nil {
+		dto.Amount = hold.Amount.AsBigInt()
+	}
+	if !hold.ReleasedAt.IsZero() {
+		releasedAt := hold.ReleasedAt
+		dto.ReleasedAt = &releasedAt
+	}
+	return dto
+}
+
+func ToLiquidityHoldsResponse(holds []liquidity_provider.LiquidityHold) LiquidityHoldsResponse {
+	result := make([]LiquidityHoldDTO, len(holds))
+	peginHolds := make([]liquidity_provider.LiquidityHold, 0)
+	pegoutHolds := make([]liquidity_provider.LiquidityHold, 0)
+	for i, hold := range holds {
+		result[i] = ToLiquidityHoldDTO(hold)
+		if hold.Operation == liquidity_provider.PeginHold {
+			peginHolds = append(peginHolds, hold)
+		} else {
+			pegoutHolds = append(pegoutHolds, hold)
+		}
+	}
+	return LiquidityHoldsResponse{
+		Holds:      result,
+		PeginHeld:  liquidity_provider.TotalHeld(peginHolds).AsBigInt(),
+		PegoutHeld: liquidity_provider.TotalHeld(pegoutHolds).AsBigInt(),
+	}
+}
+
+func ToLiquidityLedgerReconciliationResponse(result usecase.LiquidityLedgerReconciliation) LiquidityLedgerReconciliationResponse {
+	return LiquidityLedgerReconciliationResponse{
+		Created:  result.Created,
+		Released: result.Released,
+		Expired:  result.Expired,
+	}
+}
@@ -1,6 +1,7 @@
 package pkg
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -9,18 +10,21 @@ import (
 
 	"github.com/rsksmart/liquidity-provider-server/internal/entities"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/reporting"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/utils"
 	reports "github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
 )
 
 type ProviderDetail struct {
 	// Deprecated: Fee is deprecated, use FixedFee and FeePercentage instead
-	Fee                   *big.Int `json:"fee" required:""`
-	FixedFee              *big.Int `json:"fixedFee"  required:""`
-	FeePercentage         float64  `json:"feePercentage"  required:""`
-	MinTransactionValue   *big.Int `json:"minTransactionValue"  required:""`
-	MaxTransactionValue   *big.Int `json:"maxTransactionValue"  required:""`
-	RequiredConfirmations uint16   `json:"requiredConfirmations"  required:""`
+	Fee                   *big.Int          `json:"fee" required:""`
+	FixedFee              *big.Int          `json:"fixedFee"  required:""`
+	FeePercentage         float64           `json:"feePercentage"  required:""`
+	MinTransactionValue   *big.Int          `json:"minTransactionValue"  required:""`
+	MaxTransactionValue   *big.Int          `json:"maxTransactionValue"  required:""`
+	RequiredConfirmations uint16            `json:"requiredConfirmations"  required:""`
+	FeeTiers              []FeeTierDTO      `json:"feeTiers,omitempty" description:"Amount based fee tiers that replace fixedFee and feePercentage for the quotes they cover"`
+	FeeSurcharges         *FeeSurchargesDTO `json:"feeSurcharges,omitempty" description:"Dynamic increments of the fee percentage"`
 }
 
 type ProviderDetailResponse struct {
@@ -48,13 +52,15 @@ type PeginConfigurationRequest struct {
 }
 
 type PeginConfigurationDTO struct {
-	TimeForDeposit uint32  `json:"timeForDeposit" validate:"required"`
-	CallTime       uint32  `json:"callTime" validate:"required"`
-	PenaltyFee     string  `json:"penaltyFee" validate:"required,numeric,positive_string"`
-	FixedFee       string  `json:"fixedFee" validate:"required,numeric,min=0"`
-	FeePercentage  float64 `json:"feePercentage" validate:"numeric,gte=0,lte=100,max_decimal_places=2"`
-	MaxValue       string  `json:"maxValue" validate:"required,numeric,positive_string"`
-	MinValue       string  `json:"minValue" validate:"required,numeric,positive_string"`
+	TimeForDeposit uint32            `json:"timeForDeposit" validate:"required"`
+	CallTime       uint32            `json:"callTime" validate:"required"`
+	PenaltyFee     string            `json:"penaltyFee" validate:"required,numeric,positive_string"`
+	FixedFee       string            `json:"fixedFee" validate:"required,numeric,min=0"`
+	FeePercentage  float64           `json:"feePercentage" validate:"numeric,gte=0,lte=100,max_decimal_places=2"`
+	MaxValue       string            `json:"maxValue" validate:"required,numeric,positive_string"`
+	MinValue       string            `json:"minValue" validate:"required,numeric,positive_string"`
+	FeeTiers       []FeeTierDTO      `json:"feeTiers,omitempty" validate:"omitempty,dive"`
+	FeeSurcharges  *FeeSurchargesDTO `json:"feeSurcharges,omitempty" validate:"omitempty"`
 }
 
 type PegoutConfigurationRequest struct {
@@ -62,15 +68,39 @@ type PegoutConfigurationRequest struct {
 }
 
 type PegoutConfigurationDTO struct {
-	TimeForDeposit       uint32  `json:"timeForDeposit" validate:"required"`
-	ExpireTime           uint32  `json:"expireTime" validate:"required"`
-	PenaltyFee           string  `json:"penaltyFee" validate:"required,numeric,positive_string"`
-	FixedFee             string  `json:"fixedFee" validate:"required,numeric,min=0"`
-	FeePercentage        float64 `json:"feePercentage" validate:"numeric,gte=0,lte=100,max_decimal_places=2"`
-	MaxValue             string  `json:"maxValue" validate:"required,numeric,positive_string"`
-	MinValue             string  `json:"minValue" validate:"required,numeric,positive_string"`
-	ExpireBlocks         uint64  `json:"expireBlocks" validate:"required"`
-	BridgeTransactionMin string  `json:"bridgeTransactionMin" validate:"required,numeric,positive_string"`
+	TimeForDeposit       uint32            `json:"timeForDeposit" validate:"required"`
+	ExpireTime           uint32            `json:"expireTime" validate:"required"`
+	PenaltyFee           string            `json:"penaltyFee" validate:"required,numeric,positive_string"`
+	FixedFee             string            `json:"fixedFee" validate:"required,numeric,min=0"`
+	FeePercentage        float64           `json:"feePercentage" validate:"numeric,gte=0,lte=100,max_decimal_places=2"`
+	MaxValue             string            `json:"maxValue" validate:"required,numeric,positive_string"`
+	MinValue             string            `json:"minValue" validate:"required,numeric,positive_string"`
+	ExpireBlocks         uint64            `json:"expireBlocks" validate:"required"`
+	BridgeTransactionMin string            `json:"bridgeTransactionMin" validate:"required,numeric,positive_string"`
+	FeeTiers             []FeeTierDTO      `json:"feeTiers,omitempty" validate:"omitempty,dive"`
+	FeeSurcharges        *FeeSurchargesDTO `json:"feeSurcharges,omitempty" validate:"omitempty"`
+}
+
+type FeeTierDTO struct {
+	MaxAmount     string  `json:"maxAmount" validate:"required,numeric,positive_string" example:"1000000000000000000" description:"Highest quote amount in wei covered by the tier"`
+	FixedFee      string  `json:"fixedFee" validate:"required,numeric,min=0" example:"100000000000000" description:"Fixed fee in wei of the tier"`
+	FeePercentage float64 `json:"feePercentage" validate:"numeric,gte=0,lte=100,max_decimal_places=2" example:"0.5" description:"Fee percentage of the tier"`
+}
+
+type FeeSurchargesDTO struct {
+	Utilization []UtilizationSurchargeDTO `json:"utilization,omitempty" validate:"omitempty,dive"`
+	TimeOfDay   []TimeOfDaySurchargeDTO   `json:"timeOfDay,omitempty" validate:"omitempty,dive"`
+}
+
+type UtilizationSurchargeDTO struct {
+	Threshold     LiquidityThresholdDTO `json:"threshold" validate:"required"`
+	FeePercentage float64               `json:"feePercentage" validate:"numeric,gte=0,lte=100,max_decimal_places=2" example:"0.25" description:"Percentage added to the fee percentage while the available liquidity is under the threshold"`
+}
+
+type TimeOfDaySurchargeDTO struct {
+	StartHour     uint8   `json:"startHour" validate:"lte=23" example:"18" description:"UTC hour when the surcharge starts (inclusive)"`
+	EndHour       uint8   `json:"endHour" validate:"lte=23" example:"6" description:"UTC hour when the surcharge ends (exclusive)"`
+	FeePercentage float64 `json:"feePercentage" validate:"numeric,gte=0,lte=100,max_decimal_places=2" example:"0.1" description:"Percentage added to the fee percentage during the time window"`
 }
 
 type GeneralConfigurationRequest struct {
@@ -78,9 +108,25 @@ type GeneralConfigurationRequest struct {
 }
 
 type GeneralConfigurationDTO struct {
-	RskConfirmations     map[string]uint16 `json:"rskConfirmations" validate:"required,confirmations_map"`
-	BtcConfirmations     map[string]uint16 `json:"btcConfirmations" validate:"required,confirmations_map"`
-	PublicLiquidityCheck bool              `json:"publicLiquidityCheck" validate:""`
+	RskConfirmations     map[string]uint16       `json:"rskConfirmations" validate:"required,confirmations_map"`
+	BtcConfirmations     map[string]uint16       `json:"btcConfirmations" validate:"required,confirmations_map"`
+	PublicLiquidityCheck bool                    `json:"publicLiquidityCheck" validate:""`
+	LiquidityThresholds  *LiquidityThresholdsDTO `json:"liquidityThresholds,omitempty" validate:"omitempty"`
+}
+
+type LiquidityThresholdsDTO struct {
+	Pegin  OperationLiquidityThresholdsDTO `json:"pegin" validate:""`
+	Pegout OperationLiquidityThresholdsDTO `json:"pegout" validate:""`
+}
+
+type OperationLiquidityThresholdsDTO struct {
+	Warning  *LiquidityThresholdDTO `json:"warning,omitempty" validate:"omitempty"`
+	Critical *LiquidityThresholdDTO `json:"critical,omitempty" validate:"omitempty"`
+}
+
+type LiquidityThresholdDTO struct {
+	Amount             *big.Int `json:"amount,omitempty" example:"5000000000000000000" description:"Absolute threshold in wei"`
+	MaxValueMultiplier *float64 `json:"maxValueMultiplier,omitempty" validate:"omitempty,gt=0" example:"10" description:"Threshold as a multiple of the operation maxValue"`
 }
 
 type LoginRequest struct {
@@ -181,6 +227,123 @@ type GetRevenueReportResponse struct {
 	TotalPenalizations    *big.Int `json:"totalPenalizations" validate:"required"`
 	TotalGasFeesCollected *big.Int `json:"totalGasFeesCollected" validate:"required"`
 	TotalGasSpent         *big.Int `json:"totalGasSpent" validate:"required"`
+	// TotalPegoutBtcFeesQuoted and TotalPegoutBtcFeesPaid only include the pegouts whose BTC was sent
+	TotalPegoutBtcFeesQuoted *big.Int `json:"totalPegoutBtcFeesQuoted" validate:"required"`
+	TotalPegoutBtcFeesPaid   *big.Int `json:"totalPegoutBtcFeesPaid" validate:"required"`
+	PegoutBtcFeesDifference  *big.Int `json:"pegoutBtcFeesDifference" validate:"required"`
+}
+
+type RevenueJournalEntryDTO struct {
+	Date          time.Time `json:"date" example:"2024-01-02T03:04:05Z" description:"Agreement date of the quote that originated the movement" required:""`
+	QuoteHash     string    `json:"quoteHash" description:"Hash of the quote that originated the movement" required:""`
+	QuoteType     string    `json:"quoteType" example:"pegin" description:"Type of the quote, pegin or pegout" required:""`
+	Movement      string    `json:"movement" example:"call_fee" description:"Movement type: call_fee, gas_collected, gas_spent, btc_fee or penalty" required:""`
+	DebitAccount  string    `json:"debitAccount" example:"Assets:Liquidity" description:"Account debited by the movement" required:""`
+	CreditAccount string    `json:"creditAccount" example:"Income:CallFees" description:"Account credited by the movement" required:""`
+	Amount        *big.Int  `json:"amount" example:"100000000000000" description:"Amount of the movement in wei" required:""`
+}
+
+type GetRevenueJournalResponse struct {
+	Entries []RevenueJournalEntryDTO `json:"entries" required:""`
+}
+
+type GetAnalyticsReportRequest struct {
+	DateRangeRequest
+	Period string `json:"period" validate:"required,oneof=day week month"`
+}
+
+type AnalyticsBucketDTO struct {
+	PeriodStart          time.Time `json:"periodStart" example:"2024-01-01T00:00:00Z" description:"Start of the period of the bucket in UTC, weeks start on monday" required:""`
+	Direction            string    `json:"direction" example:"pegin" description:"Direction of the quotes of the bucket, pegin or pegout" required:""`
+	OwnerAccountAddress  string    `json:"ownerAccountAddress" example:"0x79568c2989232dCa1840087D73d403602364c0D4" description:"Trusted account that accepted the quotes, empty for the rest of the quotes" required:""`
+	TotalQuotesCount     int64     `json:"totalQuotesCount" example:"10" description:"Number of quotes agreed in the period" required:""`
+	AcceptedQuotesCount  int64     `json:"acceptedQuotesCount" example:"8" description:"Number of accepted quotes" required:""`
+	AcceptedQuotesAmount *big.Int  `json:"acceptedQuotesAmount" example:"8000000000000000000" description:"Value plus gas fee of the accepted quotes in wei" required:""`
+	PaidQuotesCount      int64     `json:"paidQuotesCount" example:"7" description:"Number of quotes paid by the LP" required:""`
+	PaidQuotesAmount     *big.Int  `json:"paidQuotesAmount" example:"7000000000000000000" description:"Value plus gas fee of the paid quotes in wei" required:""`
+	RefundedQuotesCount  int64     `json:"refundedQuotesCount" example:"6" description:"Number of quotes refunded to the LP" required:""`
+	RefundedQuotesAmount *big.Int  `json:"refundedQuotesAmount" example:"6000000000000000000" description:"Value plus gas fee plus call fee of the refunded quotes in wei" required:""`
+	PenalizationsCount   int64     `json:"penalizationsCount" example:"0" description:"Number of penalizations of the accepted quotes" required:""`
+	PenalizationsAmount  *big.Int  `json:"penalizationsAmount" example:"0" description:"Amount of the penalizations of the accepted quotes in wei" required:""`
+	CallFees             *big.Int  `json:"callFees" example:"60000000000000000" description:"Call fees of the quotes included in the revenue report in wei" required:""`
+	GasFeesCollected     *big.Int  `json:"gasFeesCollected" example:"3000000000000000" description:"Gas fees collected from the quotes included in the revenue report in wei" required:""`
+	GasSpent             *big.Int  `json:"gasSpent" example:"2000000000000000" description:"RSK gas spent by the LP in the quotes included in the revenue report in wei" required:""`
+	BtcFeesPaid          *big.Int  `json:"btcFeesPaid" example:"500000000000000" description:"BTC fees paid by the LP in the pegouts included in the revenue report in wei" required:""`
+	NetProfit            *big.Int  `json:"netProfit" example:"60500000000000000" description:"Call fees + gas fees collected - gas spent - BTC fees - penalizations in wei" required:""`
+}
+
+type GetAnalyticsReportResponse struct {
+	Period  string               `json:"period" example:"day" description:"Length of the periods of the buckets: day, week or month" required:""`
+	Buckets []AnalyticsBucketDTO `json:"buckets" required:""`
+}
+
+type GetReportSnapshotsRequest struct {
+	DateRangeRequest
+	Type string `json:"type" validate:"required,oneof=summaries revenue assets"`
+}
+
+type ReportSnapshotDTO struct {
+	Id          string          `json:"id" example:"weekly:revenue:2024-01-01" description:"Identifier of the snapshot, composed by the schedule, the report type and the start of the period" required:""`
+	Schedule    string          `json:"schedule" example:"weekly" description:"Schedule that generated the snapshot: daily or weekly" required:""`
+	ReportType  string          `json:"reportType" example:"revenue" description:"Report stored in the snapshot: summaries, revenue or assets" required:""`
+	PeriodStart time.Time       `json:"periodStart" example:"2024-01-01T00:00:00Z" description:"Start of the period of the report in UTC, weeks start on monday" required:""`
+	PeriodEnd   time.Time       `json:"periodEnd" example:"2024-01-07T23:59:59.999999999Z" description:"End of the period of the report in UTC" required:""`
+	CreatedAt   time.Time       `json:"createdAt" example:"2024-01-08T00:10:00Z" description:"Date when the snapshot was generated, the assets report reflects the positions at this moment" required:""`
+	Content     json.RawMessage `json:"content" description:"Report as it was generated, with the same structure as the response of the corresponding report endpoint" required:""`
+	DeliveredAt *time.Time      `json:"deliveredAt,omitempty" example:"2024-01-08T00:10:05Z" description:"Date when the snapshot was delivered to all the configured destinations, missing if it wasn't delivered yet"`
+}
+
+type GetReportSnapshotsResponse struct {
+	Snapshots []ReportSnapshotDTO `json:"snapshots" required:""`
+}
+
+type GetAssetHistoryRequest struct {
+	DateRangeRequest
+	Interval string `json:"interval" validate:"required,oneof=raw minute hour day"`
+}
+
+type AssetSnapshotDTO struct {
+	Timestamp       time.Time          `json:"timestamp" example:"2024-01-01T10:00:00Z" description:"Date when the assets report was calculated" required:""`
+	Total           *big.Int           `json:"total" example:"17067500000000000000" description:"Sum of the BTC and RBTC assets in wei" required:""`
+	BtcAssetReport  BtcAssetReportDTO  `json:"btcAssetReport" description:"BTC assets at the moment of the snapshot" required:""`
+	RbtcAssetReport RbtcAssetReportDTO `json:"rbtcAssetReport" description:"RBTC assets at the moment of the snapshot" required:""`
+}
+
+type GetAssetHistoryResponse struct {
+	Interval  string             `json:"interval" example:"hour" description:"Interval used to downsample the snapshots: raw, minute, hour or day" required:""`
+	Snapshots []AssetSnapshotDTO `json:"snapshots" required:""`
+}
+
+type QuoteCostDTO struct {
+	Type     string   `json:"type" example:"call_for_user" description:"Transaction that caused the cost: call_for_user, register_pegin, refund_pegout, bridge_refund or send_pegout_btc" required:""`
+	TxHash   string   `json:"txHash" example:"0x0c6f5fbf5f3fa4a5b3a9a1a8b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6" description:"Hash of the transaction, empty if it wasn't sent" required:""`
+	GasUsed  uint64   `json:"gasUsed" example:"21000" description:"Gas used by the transaction, zero for the BTC transaction" required:""`
+	GasPrice *big.Int `json:"gasPrice" example:"60000000" description:"Gas price of the transaction in wei, zero for the BTC transaction" required:""`
+	Amount   *big.Int `json:"amount" example:"1260000000000" description:"Amount paid by the LP for the transaction in wei" required:""`
+}
+
+type QuotePenalizationDTO struct {
+	LiquidityProvider string   `json:"liquidityProvider" example:"0x9D93929A9099be4355fC2389FbF253982F9dF47c" description:"Address of the penalized liquidity provider" required:""`
+	Penalty           *big.Int `json:"penalty" example:"10000000000000" description:"Penalty amount in wei" required:""`
+}
+
+type QuotePnlResponse struct {
+	QuoteHash           string                 `json:"quoteHash" example:"c8e6d4ac4ee1bee4aa3d3b8b1f7b1f5b8a8e2a2a8f4b5c6d7e8f9a0b1c2d3e4f" description:"Hash of the quote" required:""`
+	Direction           string                 `json:"direction" example:"pegin" description:"Direction of the quote: pegin or pegout" required:""`
+	State               string                 `json:"state" example:"RegisterPegInSucceeded" description:"Current state of the quote" required:""`
+	OwnerAccountAddress string                 `json:"ownerAccountAddress" example:"0x79568c2989232dCa1840087D73d403602364c0D4" description:"Trusted account that accepted the quote, empty if it wasn't a trusted account" required:""`
+	AgreementDate       time.Time              `json:"agreementDate" example:"2024-01-01T00:00:00Z" description:"Date when the quote was agreed" required:""`
+	Value               *big.Int               `json:"value" example:"1000000000000000000" description:"Value of the quote in wei" required:""`
+	CallFee             *big.Int               `json:"callFee" example:"10000000000000000" description:"Call fee quoted to the user in wei" required:""`
+	GasFee              *big.Int               `json:"gasFee" example:"5000000000000" description:"Gas fee quoted to the user in wei" required:""`
+	Costs               []QuoteCostDTO         `json:"costs" description:"Transactions paid by the LP to process the quote" required:""`
+	TotalGasSpent       *big.Int               `json:"totalGasSpent" example:"2520000000000" description:"RSK gas paid by the LP in wei" required:""`
+	BtcFeePaid          *big.Int               `json:"btcFeePaid" example:"0" description:"BTC fee paid by the LP in the pegout transaction in wei" required:""`
+	Penalizations       []QuotePenalizationDTO `json:"penalizations" description:"Penalizations applied to the LP because of the quote" required:""`
+	TotalPenalizations  *big.Int               `json:"totalPenalizations" example:"0" description:"Sum of the penalizations in wei" required:""`
+	GasFeeMargin        *big.Int               `json:"gasFeeMargin" example:"2480000000000" description:"Gas fee quoted - gas spent - BTC fee paid in wei" required:""`
+	NetMargin           *big.Int               `json:"netMargin" example:"10002480000000000" description:"Call fee + gas fee margin - penalizations in wei. If the quote is not realized the fees are not counted, so it only has the costs and penalizations" required:""`
+	Realized            bool                   `json:"realized" example:"true" description:"Whether the quote reached a state where its fees are counted as revenue" required:""`
 }
 
 // BTC Asset Report structures
@@ -325,6 +488,41 @@ func ToRbtcAssetReportDTO(report reports.RbtcAssetReport) RbtcAssetReportDTO {
 	}
 }
 
+func ToAssetSnapshotDTO(snapshot reporting.AssetSnapshot) AssetSnapshotDTO {
+	btc, rbtc := snapshot.Btc, snapshot.Rbtc
+	return AssetSnapshotDTO{
+		Timestamp: snapshot.Timestamp,
+		Total:     snapshot.Total().AsBigInt(),
+		BtcAssetReport: BtcAssetReportDTO{
+			Total: btc.Total.AsBigInt(),
+			Location: BtcAssetLocationDTO{
+				BtcWallet:  btc.Location.BtcWallet.AsBigInt(),
+				Federation: btc.Location.Federation.AsBigInt(),
+				RskWallet:  btc.Location.RskWallet.AsBigInt(),
+				Lbc:        btc.Location.Lbc.AsBigInt(),
+			},
+			Allocation: BtcAssetAllocationDTO{
+				ReservedForUsers: btc.Allocation.ReservedForUsers.AsBigInt(),
+				WaitingForRefund: btc.Allocation.WaitingForRefund.AsBigInt(),
+				Available:        btc.Allocation.Available.AsBigInt(),
+			},
+		},
+		RbtcAssetReport: RbtcAssetReportDTO{
+			Total: rbtc.Total.AsBigInt(),
+			Location: RbtcAssetLocationDTO{
+				RskWallet:  rbtc.Location.RskWallet.AsBigInt(),
+				Lbc:        rbtc.Location.Lbc.AsBigInt(),
+				Federation: rbtc.Location.Federation.AsBigInt(),
+			},
+			Allocation: RbtcAssetAllocationDTO{
+				ReservedForUsers: rbtc.Allocation.ReservedForUsers.AsBigInt(),
+				WaitingForRefund: rbtc.Allocation.WaitingForRefund.AsBigInt(),
+				Available:        rbtc.Allocation.Available.AsBigInt(),
+			},
+		},
+	}
+}
+
 func ToGetAssetsReportResponse(result reports.GetAssetsReportResult) GetAssetsReportResponse {
 	return GetAssetsReportResponse{
 		BtcAssetReport:  ToBtcAssetReportDTO(result.BtcAssetReport),
@@ -333,17 +531,29 @@ func ToGetAssetsReportResponse(result reports.GetAssetsReportResult) GetAssetsRe
 }
 
 type TrustedAccountDTO struct {
-	Address        string   `json:"address" example:"0x1234567890abcdef" description:"Trusted account address" required:""`
-	Name           string   `json:"name" example:"Example Trusted Account" description:"Trusted account name" required:""`
-	BtcLockingCap  *big.Int `json:"btcLockingCap" example:"5000000000000000000" description:"Bitcoin locking capacity in wei" required:""`
-	RbtcLockingCap *big.Int `json:"rbtcLockingCap" example:"5000000000000000000" description:"RBTC locking capacity in wei" required:""`
+	Address        string           `json:"address" example:"0x1234567890abcdef" description:"Trusted account address" required:""`
+	Name           string           `json:"name" example:"Example Trusted Account" description:"Trusted account name" required:""`
+	BtcLockingCap  *big.Int         `json:"btcLockingCap" example:"5000000000000000000" description:"Bitcoin locking capacity in wei" required:""`
+	RbtcLockingCap *big.Int         `json:"rbtcLockingCap" example:"5000000000000000000" description:"RBTC locking capacity in wei" required:""`
+	PeginTerms     *PartnerTermsDTO `json:"peginTerms,omitempty" description:"Custom terms of the pegin quotes requested by the account"`
+	PegoutTerms    *PartnerTermsDTO `json:"pegoutTerms,omitempty" description:"Custom terms of the pegout quotes requested by the account"`
 }
 
 type TrustedAccountRequest struct {
-	Address        string   `json:"address" validate:"required,eth_addr"`
-	Name           string   `json:"name" validate:"required,max=100,min=1,not_blank"`
-	BtcLockingCap  *big.Int `json:"btcLockingCap" validate:"required,positive_integer_bigint"`
-	RbtcLockingCap *big.Int `json:"rbtcLockingCap" validate:"required,positive_integer_bigint"`
+	Address        string           `json:"address" validate:"required,eth_addr"`
+	Name           string           `json:"name" validate:"required,max=100,min=1,not_blank"`
+	BtcLockingCap  *big.Int         `json:"btcLockingCap" validate:"required,positive_integer_bigint"`
+	RbtcLockingCap *big.Int         `json:"rbtcLockingCap" validate:"required,positive_integer_bigint"`
+	PeginTerms     *PartnerTermsDTO `json:"peginTerms,omitempty" validate:"omitempty"`
+	PegoutTerms    *PartnerTermsDTO `json:"pegoutTerms,omitempty" validate:"omitempty"`
+}
+
+type PartnerTermsDTO struct {
+	FixedFee      *big.Int `json:"fixedFee,omitempty" example:"100000000000000" description:"Fixed fee in wei of the partner quotes"`
+	FeePercentage *float64 `json:"feePercentage,omitempty" validate:"omitempty,gte=0,lte=100,max_decimal_places=2" example:"0.1" description:"Fee percentage of the partner quotes"`
+	MinValue      *big.Int `json:"minValue,omitempty" validate:"omitempty,positive_integer_bigint" example:"5000000000000000" description:"Minimum value in wei of the partner quotes"`
+	MaxValue      *big.Int `json:"maxValue,omitempty" validate:"omitempty,positive_integer_bigint" example:"5000000000000000000" description:"Maximum value in wei of the partner quotes"`
+	QuoteValidity uint32   `json:"quoteValidity,omitempty" example:"3600" description:"Seconds that the partner quotes can be accepted"`
 }
 
 type TrustedAccountsResponse struct {
@@ -357,6 +567,20 @@ func ToAvailableLiquidityDTO(entity liquidity_provider.AvailableLiquidity) Avail
 	}
 }
 
+func ToProviderDetail(detail liquidity_provider.LiquidityProviderDetail) ProviderDetail {
+	feePercentage, _ := detail.FeePercentage.Native().Float64()
+	return ProviderDetail{
+		Fee:                   detail.FixedFee.AsBigInt(),
+		FixedFee:              detail.FixedFee.AsBigInt(),
+		FeePercentage:         feePercentage,
+		MinTransactionValue:   detail.MinTransactionValue.AsBigInt(),
+		MaxTransactionValue:   detail.MaxTransactionValue.AsBigInt(),
+		RequiredConfirmations: detail.RequiredConfirmations,
+		FeeTiers:              toFeeTiersDTO(detail.FeeTiers),
+		FeeSurcharges:         toFeeSurchargesDTO(detail.FeeSurcharges),
+	}
+}
+
 func FromPeginConfigurationDTO(dto PeginConfigurationDTO) liquidity_provider.PeginConfiguration {
 	const base = 10
 	penaltyFee := new(big.Int)
@@ -376,6 +600,8 @@ func FromPeginConfigurationDTO(dto PeginConfigurationDTO) liquidity_provider.Peg
 		FeePercentage:  utils.NewBigFloat64(dto.FeePercentage),
 		MaxValue:       entities.NewBigWei(maxValue),
 		MinValue:       entities.NewBigWei(minValue),
+		FeeTiers:       fromFeeTiersDTO(dto.FeeTiers),
+		FeeSurcharges:  fromFeeSurchargesDTO(dto.FeeSurcharges),
 	}
 }
 
@@ -402,6 +628,8 @@ func FromPegoutConfigurationDTO(dto PegoutConfigurationDTO) liquidity_provider.P
 		MinValue:             entities.NewBigWei(minValue),
 		ExpireBlocks:         dto.ExpireBlocks,
 		BridgeTransactionMin: entities.NewBigWei(bridgeTransactionMin),
+		FeeTiers:             fromFeeTiersDTO(dto.FeeTiers),
+		FeeSurcharges:        fromFeeSurchargesDTO(dto.FeeSurcharges),
 	}
 }
 
@@ -416,6 +644,8 @@ func ToPeginConfigurationDTO(config liquidity_provider.PeginConfiguration) Pegin
 		FeePercentage:  feePercentage,
 		MaxValue:       config.MaxValue.AsBigInt().String(),
 		MinValue:       config.MinValue.AsBigInt().String(),
+		FeeTiers:       toFeeTiersDTO(config.FeeTiers),
+		FeeSurcharges:  toFeeSurchargesDTO(config.FeeSurcharges),
 	}
 }
 
@@ -431,7 +661,94 @@ func ToPegoutConfigurationDTO(config liquidity_provider.PegoutConfiguration) Peg
 		MinValue:             config.MinValue.AsBigInt().String(),
 		ExpireBlocks:         config.ExpireBlocks,
 		BridgeTransactionMin: config.BridgeTransactionMin.AsBigInt().String(),
+		FeeTiers:             toFeeTiersDTO(config.FeeTiers),
+		FeeSurcharges:        toFeeSurchargesDTO(config.FeeSurcharges),
+	}
+}
+
+func fromFeeTiersDTO(dtos []FeeTierDTO) liquidity_provider.FeeTiers {
+	if len(dtos) == 0 {
+		return nil
+	}
+	const base = 10
+	tiers := make(liquidity_provider.FeeTiers, len(dtos))
+	for i, dto := range dtos {
+		maxAmount := new(big.Int)
+		maxAmount.SetString(dto.MaxAmount, base)
+		fixedFee := new(big.Int)
+		fixedFee.SetString(dto.FixedFee, base)
+		tiers[i] = liquidity_provider.FeeTier{
+			MaxAmount:     entities.NewBigWei(maxAmount),
+			FixedFee:      entities.NewBigWei(fixedFee),
+			FeePercentage: utils.NewBigFloat64(dto.FeePercentage),
+		}
 	}
+	return tiers
+}
+
+func toFeeTiersDTO(tiers liquidity_provider.FeeTiers) []FeeTierDTO {
+	if len(tiers) == 0 {
+		return nil
+	}
+	dtos := make([]FeeTierDTO, len(tiers))
+	for i, tier := range tiers {
+		feePercentage, _ := tier.FeePercentage.Native().Float64()
+		dtos[i] = FeeTierDTO{
+			MaxAmount:     tier.MaxAmount.AsBigInt().String(),
+			FixedFee:      tier.FixedFee.AsBigInt().String(),
+			FeePercentage: feePercentage,
+		}
+	}
+	return dtos
+}
+
+func fromFeeSurchargesDTO(dto *FeeSurchargesDTO) *liquidity_provider.FeeSurcharges {
+	if dto == nil || (len(dto.Utilization) == 0 && len(dto.TimeOfDay) == 0) {
+		return nil
+	}
+	surcharges := &liquidity_provider.FeeSurcharges{}
+	for _, utilization := range dto.Utilization {
+		surcharges.Utilization = append(surcharges.Utilization, liquidity_provider.UtilizationSurcharge{
+			Threshold:     *fromLiquidityThresholdDTO(&utilization.Threshold),
+			FeePercentage: utils.NewBigFloat64(utilization.FeePercentage),
+		})
+	}
+	for _, timeOfDay := range dto.TimeOfDay {
+		surcharges.TimeOfDay = append(surcharges.TimeOfDay, liquidity_provider.TimeOfDaySurcharge{
+			StartHour:     timeOfDay.StartHour,
+			EndHour:       timeOfDay.EndHour,
+			FeePercentage: utils.NewBigFloat64(timeOfDay.FeePercentage),
+		})
+	}
+	return surcharges
+}
+
+func toFeeSurchargesDTO(surcharges *liquidity_provider.FeeSurcharges) *FeeSurchargesDTO {
+	if surcharges == nil {
+		return nil
+	}
+	dto := &FeeSurchargesDTO{}
+	for _, utilization := range surcharges.Utilization {
+		feePercentage, _ := utilization.FeePercentage.Native().Float64()
+		threshold := LiquidityThresholdDTO{}
+		if utilization.Threshold.Amount != nil {
+			threshold.Amount = utilization.Threshold.Amount.AsBigInt()
+		}
+		if utilization.Threshold.MaxValueMultiplier != nil {
+			multiplier, _ := utilization.Threshold.MaxValueMultiplier.Native().Float64()
+			threshold.MaxValueMultiplier = &multiplier
+		}
+		dto.Utilization = append(dto.Utilization, UtilizationSurchargeDTO{Threshold: threshold, FeePercentage: feePercentage})
+	}
+	for _, timeOfDay := range surcharges.TimeOfDay {
+		feePercentage, _ := timeOfDay.FeePercentage.Native().Float64()
+		dto.TimeOfDay = append(dto.TimeOfDay, TimeOfDaySurchargeDTO{
+			StartHour:     timeOfDay.StartHour,
+			EndHour:       timeOfDay.EndHour,
+			FeePercentage: feePercentage,
+		})
+	}
+	return dto
 }
 
 func ToServerInfoDTO(entity liquidity_provider.ServerInfo) ServerInfoDTO {
@@ -447,9 +764,63 @@ func ToTrustedAccountDTO(entity liquidity_provider.TrustedAccountDetails) Truste
 		Name:           entity.Name,
 		BtcLockingCap:  entity.BtcLockingCap.AsBigInt(),
 		RbtcLockingCap: entity.RbtcLockingCap.AsBigInt(),
+		PeginTerms:     toPartnerTermsDTO(entity.PeginTerms),
+		PegoutTerms:    toPartnerTermsDTO(entity.PegoutTerms),
+	}
+}
+
+func FromTrustedAccountRequest(request TrustedAccountRequest) liquidity_provider.TrustedAccountDetails {
+	return liquidity_provider.TrustedAccountDetails{
+		Address:        request.Address,
+		Name:           request.Name,
+		BtcLockingCap:  entities.NewBigWei(request.BtcLockingCap),
+		RbtcLockingCap: entities.NewBigWei(request.RbtcLockingCap),
+		PeginTerms:     fromPartnerTermsDTO(request.PeginTerms),
+		PegoutTerms:    fromPartnerTermsDTO(request.PegoutTerms),
 	}
 }
 
+func fromPartnerTermsDTO(dto *PartnerTermsDTO) *liquidity_provider.PartnerTerms {
+	if dto == nil {
+		return nil
+	}
+	terms := &liquidity_provider.PartnerTerms{QuoteValidity: dto.QuoteValidity}
+	if dto.FixedFee != nil {
+		terms.FixedFee = entities.NewBigWei(dto.FixedFee)
+	}
+	if dto.FeePercentage != nil {
+		terms.FeePercentage = utils.NewBigFloat64(*dto.FeePercentage)
+	}
+	if dto.MinValue != nil {
+		terms.MinValue = entities.NewBigWei(dto.MinValue)
+	}
+	if dto.MaxValue != nil {
+		terms.MaxValue = entities.NewBigWei(dto.MaxValue)
+	}
+	return terms
+}
+
+func toPartnerTermsDTO(terms *liquidity_provider.PartnerTerms) *PartnerTermsDTO {
+	if terms == nil {
+		return nil
+	}
+	dto := &PartnerTermsDTO{QuoteValidity: terms.QuoteValidity}
+	if terms.FixedFee != nil {
+		dto.FixedFee = terms.FixedFee.AsBigInt()
+	}
+	if terms.FeePercentage != nil {
+		feePercentage, _ := terms.FeePercentage.Native().Float64()
+		dto.FeePercentage = &feePercentage
+	}
+	if terms.MinValue != nil {
+		dto.MinValue = terms.MinValue.AsBigInt()
+	}
+	if terms.MaxValue != nil {
+		dto.MaxValue = terms.MaxValue.AsBigInt()
+	}
+	return dto
+}
+
 func ToTrustedAccountsDTO(signedEntities []entities.Signed[liquidity_provider.TrustedAccountDetails]) []TrustedAccountDTO {
 	result := make([]TrustedAccountDTO, len(signedEntities))
 	for i, signedEntity := range signedEntities {
@@ -472,9 +843,37 @@ func FromGeneralConfigurationDTO(dto GeneralConfigurationDTO) (liquidity_provide
 			return liquidity_provider.GeneralConfiguration{}, fmt.Errorf("cannot deserialize BTC confirmations key %s", key)
 		}
 	}
-	return liquidity_provider.GeneralConfiguration{
+	config := liquidity_provider.GeneralConfiguration{
 		RskConfirmations:     dto.RskConfirmations,
 		BtcConfirmations:     dto.BtcConfirmations,
 		PublicLiquidityCheck: dto.PublicLiquidityCheck,
-	}, nil
+	}
+	if dto.LiquidityThresholds != nil {
+		config.LiquidityThresholds = &liquidity_provider.LiquidityThresholds{
+			Pegin:  fromOperationLiquidityThresholdsDTO(dto.LiquidityThresholds.Pegin),
+			Pegout: fromOperationLiquidityThresholdsDTO(dto.LiquidityThresholds.Pegout),
+		}
+	}
+	return config, nil
+}
+
+func fromOperationLiquidityThresholdsDTO(dto OperationLiquidityThresholdsDTO) liquidity_provider.OperationLiquidityThresholds {
+	return liquidity_provider.OperationLiquidityThresholds{
+		Warning:  fromLiquidityThresholdDTO(dto.Warning),
+		Critical: fromLiquidityThresholdDTO(dto.Critical),
+	}
+}
+
+func fromLiquidityThresholdDTO(dto *LiquidityThresholdDTO) *liquidity_provider.LiquidityThreshold {
+	if dto == nil {
+		return nil
+	}
+	threshold := &liquidity_provider.LiquidityThreshold{}
+	if dto.Amount != nil {
+		threshold.Amount = entities.NewBigWei(new(big.Int).Set(dto.Amount))
+	}
+	if dto.MaxValueMultiplier != nil {
+		threshold.MaxValueMultiplier = utils.NewBigFloat64(*dto.MaxValueMultiplier)
+	}
+	return threshold
 }
@@ -31,6 +31,44 @@ func TestToAvailableLiquidityDTO(t *testing.T) {
 	assert.Equal(t, "9876543210123456789", dto.PegoutLiquidityAmount.String())
 }
 
+func TestToProviderDetail(t *testing.T) {
+	multiplier := 2.0
+	detail := liquidity_provider.LiquidityProviderDetail{
+		FixedFee:              entities.NewWei(100),
+		FeePercentage:         utils.NewBigFloat64(1.5),
+		MinTransactionValue:   entities.NewWei(1000),
+		MaxTransactionValue:   entities.NewWei(10000),
+		RequiredConfirmations: 10,
+		FeeTiers: liquidity_provider.FeeTiers{
+			{MaxAmount: entities.NewWei(5000), FixedFee: entities.NewWei(50), FeePercentage: utils.NewBigFloat64(0.5)},
+		},
+		FeeSurcharges: &liquidity_provider.FeeSurcharges{
+			Utilization: []liquidity_provider.UtilizationSurcharge{{
+				Threshold:     liquidity_provider.LiquidityThreshold{MaxValueMultiplier: utils.NewBigFloat64(multiplier)},
+				FeePercentage: utils.NewBigFloat64(0.25),
+			}},
+		},
+	}
+	result := pkg.ToProviderDetail(detail)
+	// nolint:staticcheck
+	assert.Equal(t, big.NewInt(100), result.Fee)
+	assert.Equal(t, big.NewInt(100), result.FixedFee)
+	assert.InDelta(t, 1.5, result.FeePercentage, 1e-9)
+	assert.Equal(t, big.NewInt(1000), result.MinTransactionValue)
+	assert.Equal(t, big.NewInt(10000), result.MaxTransactionValue)
+	assert.Equal(t, uint16(10), result.RequiredConfirmations)
+	assert.Equal(t, []pkg.FeeTierDTO{{MaxAmount: "5000", FixedFee: "50", FeePercentage: 0.5}}, result.FeeTiers)
+	assert.Equal(t, &pkg.FeeSurchargesDTO{Utilization: []pkg.UtilizationSurchargeDTO{{
+		Threshold:     pkg.LiquidityThresholdDTO{MaxValueMultiplier: &multiplier},
+		FeePercentage: 0.25,
+	}}}, result.FeeSurcharges)
+
+	detail.FeeTiers, detail.FeeSurcharges = nil, nil
+	result = pkg.ToProviderDetail(detail)
+	assert.Nil(t, result.FeeTiers)
+	assert.Nil(t, result.FeeSurcharges)
+}
+
 func TestFromPeginConfigurationDTO(t *testing.T) {
 	dto := pkg.PeginConfigurationDTO{
 		TimeForDeposit: 10,
@@ -40,6 +78,8 @@ func TestFromPeginConfigurationDTO(t *testing.T) {
 		FeePercentage:  5.443321101,
 		MaxValue:       "7000000000000000000000",
 		MinValue:       "6000000000000000000000",
+		FeeTiers:       testFeeTiersDTO,
+		FeeSurcharges:  testFeeSurchargesDTO,
 	}
 	configuration := pkg.FromPeginConfigurationDTO(dto)
 	assert.Equal(t, uint32(10), configuration.TimeForDeposit)
@@ -49,7 +89,10 @@ func TestFromPeginConfigurationDTO(t *testing.T) {
 	assert.Equal(t, "5.443321101", configuration.FeePercentage.Native().String())
 	assert.Equal(t, "7000000000000000000000", configuration.MaxValue.AsBigInt().String())
 	assert.Equal(t, "6000000000000000000000", configuration.MinValue.AsBigInt().String())
+	assert.Equal(t, testFeeTiers, configuration.FeeTiers)
+	assert.Equal(t, testFeeSurcharges, configuration.FeeSurcharges)
 	test.AssertNonZeroValues(t, dto)
+	assert.Equal(t, dto, pkg.ToPeginConfigurationDTO(configuration))
 }
 
 func TestFromPegoutConfigurationDTO(t *testing.T) {
@@ -63,6 +106,8 @@ func TestFromPegoutConfigurationDTO(t *testing.T) {
 		MinValue:             "6000000000000000000000",
 		ExpireBlocks:         20,
 		BridgeTransactionMin: "8000000000000000000000",
+		FeeTiers:             testFeeTiersDTO,
+		FeeSurcharges:        testFeeSurchargesDTO,
 	}
 	configuration := pkg.FromPegoutConfigurationDTO(dto)
 	assert.Equal(t, uint32(10), configuration.TimeForDeposit)
@@ -74,9 +119,41 @@ func TestFromPegoutConfigurationDTO(t *testing.T) {
 	assert.Equal(t, "6000000000000000000000", configuration.MinValue.AsBigInt().String())
 	assert.Equal(t, uint64(20), configuration.ExpireBlocks)
 	assert.Equal(t, "8000000000000000000000", configuration.BridgeTransactionMin.AsBigInt().String())
+	assert.Equal(t, testFeeTiers, configuration.FeeTiers)
+	assert.Equal(t, testFeeSurcharges, configuration.FeeSurcharges)
 	test.AssertNonZeroValues(t, dto)
+	assert.Equal(t, dto, pkg.ToPegoutConfigurationDTO(configuration))
 }
 
+var (
+	testMultiplier  = 1.5
+	testFeeTiersDTO = []pkg.FeeTierDTO{
+		{MaxAmount: "1000000000000000000", FixedFee: "100", FeePercentage: 1.25},
+		{MaxAmount: "5000000000000000000", FixedFee: "0", FeePercentage: 0.75},
+	}
+	testFeeSurchargesDTO = &pkg.FeeSurchargesDTO{
+		Utilization: []pkg.UtilizationSurchargeDTO{
+			{Threshold: pkg.LiquidityThresholdDTO{Amount: big.NewInt(300)}, FeePercentage: 0.5},
+			{Threshold: pkg.LiquidityThresholdDTO{MaxValueMultiplier: &testMultiplier}, FeePercentage: 0.25},
+		},
+		TimeOfDay: []pkg.TimeOfDaySurchargeDTO{{StartHour: 22, EndHour: 4, FeePercentage: 0.1}},
+	}
+)
+
+var (
+	testFeeTiers = liquidity_provider.FeeTiers{
+		{MaxAmount: entities.NewWei(1000000000000000000), FixedFee: entities.NewWei(100), FeePercentage: utils.NewBigFloat64(1.25)},
+		{MaxAmount: entities.NewWei(5000000000000000000), FixedFee: entities.NewWei(0), FeePercentage: utils.NewBigFloat64(0.75)},
+	}
+	testFeeSurcharges = &liquidity_provider.FeeSurcharges{
+		Utilization: []liquidity_provider.UtilizationSurcharge{
+			{Threshold: liquidity_provider.LiquidityThreshold{Amount: entities.NewWei(300)}, FeePercentage: utils.NewBigFloat64(0.5)},
+			{Threshold: liquidity_provider.LiquidityThreshold{MaxValueMultiplier: utils.NewBigFloat64(testMultiplier)}, FeePercentage: utils.NewBigFloat64(0.25)},
+		},
+		TimeOfDay: []liquidity_provider.TimeOfDaySurcharge{{StartHour: 22, EndHour: 4, FeePercentage: utils.NewBigFloat64(0.1)}},
+	}
+)
+
 func TestToServerInfoDTO(t *testing.T) {
 	serverInfo := liquidity_provider.ServerInfo{
 		Version:  "1.0.0",
@@ -100,6 +177,8 @@ func TestLocalLiquidityProvider_ProviderDTOValidation(t *testing.T) {
 			MinValue:             "100000000000000000",
 			ExpireBlocks:         500,
 			BridgeTransactionMin: "50000000000000000",
+			FeeTiers:             testFeeTiersDTO,
+			FeeSurcharges:        testFeeSurchargesDTO,
 		}
 		penaltyFeeBigInt := new(big.Int)
 		penaltyFeeBigInt.SetString(dto.PenaltyFee, 10)
@@ -121,6 +200,8 @@ func TestLocalLiquidityProvider_ProviderDTOValidation(t *testing.T) {
 			MinValue:             entities.NewBigWei(minValueBigInt),
 			ExpireBlocks:         dto.ExpireBlocks,
 			BridgeTransactionMin: entities.NewBigWei(bridgeTransactionMinBigInt),
+			FeeTiers:             testFeeTiers,
+			FeeSurcharges:        testFeeSurcharges,
 		}
 		config := pkg.FromPegoutConfigurationDTO(dto)
 		assert.Equal(t, expectedConfig, config)
@@ -135,6 +216,8 @@ func TestLocalLiquidityProvider_ProviderDTOValidation(t *testing.T) {
 			FeePercentage:  utils.NewBigFloat64(1.5),
 			MaxValue:       entities.NewWei(1000000000000000000),
 			MinValue:       entities.NewWei(100000000000000000),
+			FeeTiers:       testFeeTiers,
+			FeeSurcharges:  testFeeSurcharges,
 		}
 		dto := pkg.ToPeginConfigurationDTO(config)
 		feePercentage, _ := config.FeePercentage.Native().Float64()
@@ -146,6 +229,8 @@ func TestLocalLiquidityProvider_ProviderDTOValidation(t *testing.T) {
 			FeePercentage:  feePercentage,
 			MaxValue:       config.MaxValue.AsBigInt().String(),
 			MinValue:       config.MinValue.AsBigInt().String(),
+			FeeTiers:       testFeeTiersDTO,
+			FeeSurcharges:  testFeeSurchargesDTO,
 		}
 		assert.Equal(t, expectedDTO, dto)
 		test.AssertNonZeroValues(t, dto)
@@ -580,6 +665,35 @@ func TestToTrustedAccountDTO(t *testing.T) {
 	assert.Equal(t, "7000000000000000000", dto.RbtcLockingCap.String())
 }
 
+func TestTrustedAccount_PartnerTerms(t *testing.T) {
+	feePercentage := 0.15
+	request := pkg.TrustedAccountRequest{
+		Address:        "0x1234567890abcdef",
+		Name:           "Partner",
+		BtcLockingCap:  big.NewInt(5),
+		RbtcLockingCap: big.NewInt(7),
+		PeginTerms: &pkg.PartnerTermsDTO{
+			FixedFee:      big.NewInt(100),
+			FeePercentage: &feePercentage,
+			MinValue:      big.NewInt(1000),
+			MaxValue:      big.NewInt(2000),
+			QuoteValidity: 600,
+		},
+	}
+	account := pkg.FromTrustedAccountRequest(request)
+	require.NotNil(t, account.PeginTerms)
+	assert.Nil(t, account.PegoutTerms)
+	assert.Equal(t, entities.NewWei(100), account.PeginTerms.FixedFee)
+	assert.Equal(t, utils.NewBigFloat64(0.15), account.PeginTerms.FeePercentage)
+	assert.Equal(t, entities.NewWei(1000), account.PeginTerms.MinValue)
+	assert.Equal(t, entities.NewWei(2000), account.PeginTerms.MaxValue)
+	assert.Equal(t, uint32(600), account.PeginTerms.QuoteValidity)
+
+	dto := pkg.ToTrustedAccountDTO(account)
+	assert.Equal(t, request.PeginTerms, dto.PeginTerms)
+	assert.Nil(t, dto.PegoutTerms)
+}
+
 func TestToTrustedAccountsDTO(t *testing.T) {
 	btcLockingCap1 := new(big.Int)
 	btcLockingCap1.SetString("5000000000000000000", 10)
@@ -629,6 +743,7 @@ func TestToTrustedAccountsDTO(t *testing.T) {
 
 func TestFromGeneralConfigurationDTO(t *testing.T) {
 	t.Run("converts valid configuration", func(t *testing.T) {
+		multiplier := 2.5
 		dto := pkg.GeneralConfigurationDTO{
 			RskConfirmations: map[string]uint16{
 				"1000000000000000000": 5,
@@ -639,6 +754,15 @@ func TestFromGeneralConfigurationDTO(t *testing.T) {
 				"4000000000000000000": 20,
 			},
 			PublicLiquidityCheck: true,
+			LiquidityThresholds: &pkg.LiquidityThresholdsDTO{
+				Pegin: pkg.OperationLiquidityThresholdsDTO{
+					Warning:  &pkg.LiquidityThresholdDTO{MaxValueMultiplier: &multiplier},
+					Critical: &pkg.LiquidityThresholdDTO{Amount: big.NewInt(500)},
+				},
+				Pegout: pkg.OperationLiquidityThresholdsDTO{
+					Warning: &pkg.LiquidityThresholdDTO{Amount: big.NewInt(1000)},
+				},
+			},
 		}
 
 		config, err := pkg.FromGeneralConfigurationDTO(dto)
@@ -647,9 +771,28 @@ func TestFromGeneralConfigurationDTO(t *testing.T) {
 		assert.Equal(t, dto.RskConfirmations, map[string]uint16(config.RskConfirmations))
 		assert.Equal(t, dto.BtcConfirmations, map[string]uint16(config.BtcConfirmations))
 		assert.Equal(t, dto.PublicLiquidityCheck, config.PublicLiquidityCheck)
+		assert.Equal(t, &liquidity_provider.LiquidityThresholds{
+			Pegin: liquidity_provider.OperationLiquidityThresholds{
+				Warning:  &liquidity_provider.LiquidityThreshold{MaxValueMultiplier: utils.NewBigFloat64(multiplier)},
+				Critical: &liquidity_provider.LiquidityThreshold{Amount: entities.NewWei(500)},
+			},
+			Pegout: liquidity_provider.OperationLiquidityThresholds{
+				Warning: &liquidity_provider.LiquidityThreshold{Amount: entities.NewWei(1000)},
+			},
+		}, config.LiquidityThresholds)
 		test.AssertNonZeroValues(t, dto)
 	})
 
+	t.Run("leaves thresholds empty if not provided", func(t *testing.T) {
+		dto := pkg.GeneralConfigurationDTO{
+			RskConfirmations: map[string]uint16{"1": 5},
+			BtcConfirmations: map[string]uint16{"1": 5},
+		}
+		config, err := pkg.FromGeneralConfigurationDTO(dto)
+		require.NoError(t, err)
+		assert.Nil(t, config.LiquidityThresholds)
+	})
+
 	t.Run("returns error on invalid numeric keys", func(t *testing.T) {
 		invalidBtc := pkg.GeneralConfigurationDTO{
 			RskConfirmations: map[string]uint16{
@@ -64,6 +64,12 @@ type GetPeginQuoteResponse struct {
 	QuoteHash string        `json:"quoteHash" required:"" description:"This is a 64 digit number that derives from a quote object"`
 }
 
+type PeginQuoteBatchItem struct {
+	Quote     *PeginQuoteDTO      `json:"quote,omitempty" description:"Detail of the quote, only present if the quote was created"`
+	QuoteHash string              `json:"quoteHash,omitempty" description:"Hash of the quote, only present if the quote was created"`
+	Error     *QuoteBatchErrorDTO `json:"error,omitempty" description:"Reason why the quote wasn't created"`
+}
+
 type AcceptPeginRespose struct {
 	Signature                 string `json:"signature" required:"" example:"0x0" description:"Signature of the quote"`
 	BitcoinDepositAddressHash string `json:"bitcoinDepositAddressHash" required:"" example:"0x0" description:"Hash of the deposit BTC address"`
@@ -128,6 +134,34 @@ func ToPeginQuoteDTO(entity quote.PeginQuote) PeginQuoteDTO {
 	}
 }
 
+type PeginQuoteEstimationDTO struct {
+	Value          *big.Int `json:"value" required:"" example:"5000000000000000" description:"Value to send in the call"`
+	CallFee        *big.Int `json:"callFee" required:"" example:"100000000000000" description:"Fee charged by the LP"`
+	GasFee         *big.Int `json:"gasFee" required:"" example:"100000000000" description:"Fee to pay for the gas of the call to the user"`
+	PenaltyFee     *big.Int `json:"penaltyFee" required:"" example:"10000000000000" description:"Penalty that the LP pays if it doesn't fulfill the quote"`
+	Total          *big.Int `json:"total" required:"" example:"5100100000000000" description:"Total amount that the user has to pay (value, call fee and gas fee)"`
+	GasLimit       uint32   `json:"gasLimit" required:"" example:"21000" description:"Gas limit of the call to the user"`
+	Confirmations  uint16   `json:"confirmations" required:"" example:"2" description:"Confirmations of the BTC deposit required by the LP"`
+	TimeForDeposit uint32   `json:"timeForDeposit" required:"" example:"3600" description:"Seconds that the user has to make the deposit once the quote is created"`
+	LpCallTime     uint32   `json:"lpCallTime" required:"" example:"7200" description:"Seconds that the LP has to make the call once the deposit is confirmed"`
+	ExpirationDate uint32   `json:"expirationDate" required:"" example:"1700003600" description:"Unix timestamp in seconds when a quote created now would expire"`
+}
+
+func ToPeginQuoteEstimationDTO(entity quote.PeginQuote) PeginQuoteEstimationDTO {
+	return PeginQuoteEstimationDTO{
+		Value:          entity.Value.AsBigInt(),
+		CallFee:        entity.CallFee.AsBigInt(),
+		GasFee:         entity.GasFee.AsBigInt(),
+		PenaltyFee:     entity.PenaltyFee.AsBigInt(),
+		Total:          entity.Total().AsBigInt(),
+		GasLimit:       entity.GasLimit,
+		Confirmations:  entity.Confirmations,
+		TimeForDeposit: entity.TimeForDeposit,
+		LpCallTime:     entity.LpCallTime,
+		ExpirationDate: entity.AgreementTimestamp + entity.TimeForDeposit,
+	}
+}
+
 func ToRetainedPeginQuoteDTO(entity quote.RetainedPeginQuote) RetainedPeginQuoteDTO {
 	return RetainedPeginQuoteDTO{
 		QuoteHash:           entity.QuoteHash,
@@ -154,3 +154,31 @@ func TestToPeginCreationDataDTO(t *testing.T) {
 	assert.Equal(t, expectedFields, test.CountNonZeroValues(dto))
 	assert.Equal(t, expectedFields, test.CountNonZeroValues(peginCreationData))
 }
+
+func TestToPeginQuoteEstimationDTO(t *testing.T) {
+	peginQuote := quote.PeginQuote{
+		CallFee:            entities.NewWei(5),
+		PenaltyFee:         entities.NewWei(10),
+		GasLimit:           15,
+		Value:              entities.NewWei(25),
+		AgreementTimestamp: 1000,
+		TimeForDeposit:     30,
+		LpCallTime:         35,
+		Confirmations:      40,
+		GasFee:             entities.NewWei(45),
+	}
+	dto := pkg.ToPeginQuoteEstimationDTO(peginQuote)
+
+	assert.Equal(t, peginQuote.Value.AsBigInt(), dto.Value)
+	assert.Equal(t, peginQuote.CallFee.AsBigInt(), dto.CallFee)
+	assert.Equal(t, peginQuote.GasFee.AsBigInt(), dto.GasFee)
+	assert.Equal(t, peginQuote.PenaltyFee.AsBigInt(), dto.PenaltyFee)
+	assert.Equal(t, big.NewInt(75), dto.Total)
+	assert.Equal(t, peginQuote.GasLimit, dto.GasLimit)
+	assert.Equal(t, peginQuote.Confirmations, dto.Confirmations)
+	assert.Equal(t, peginQuote.TimeForDeposit, dto.TimeForDeposit)
+	assert.Equal(t, peginQuote.LpCallTime, dto.LpCallTime)
+	assert.Equal(t, uint32(1030), dto.ExpirationDate)
+	const expectedFields = 10
+	assert.Equal(t, expectedFields, test.CountNonZeroValues(dto))
+}
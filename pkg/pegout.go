@@ -44,6 +44,7 @@ type RetainedPegoutQuoteDTO struct {
 	LpBtcTxHash        string   `json:"lpBtcTxHash" required:"" description:"The hash of the BTC transaction from the LP to the user"`
 	RefundPegoutTxHash string   `json:"refundPegoutTxHash" required:"" description:"The hash of the transaction from the LP to the LBC where the LP got the refund in RBTC"`
 	BridgeRefundTxHash string   `json:"bridgeRefundTxHash" required:"" description:"The hash of the transaction from the LP to the bridge to convert the refunded RBTC into BTC"`
+	LpBtcReplacedTxs   []string `json:"lpBtcReplacedTxs,omitempty" description:"The hashes of the BTC transactions from the LP to the user that were replaced by lpBtcTxHash to bump their fee"`
 }
 
 type PegoutCreationDataDTO struct {
@@ -83,7 +84,41 @@ func ToPegoutQuoteDTO(entity quote.PegoutQuote) PegoutQuoteDTO {
 	}
 }
 
+type PegoutQuoteEstimationDTO struct {
+	Value                 *big.Int `json:"value" required:"" example:"5000000000000000" description:"Value to transfer to the user in BTC"`
+	CallFee               *big.Int `json:"callFee" required:"" example:"100000000000000" description:"Fee charged by the LP"`
+	GasFee                *big.Int `json:"gasFee" required:"" example:"100000000000" description:"Fee to pay for the BTC transaction to the user"`
+	PenaltyFee            *big.Int `json:"penaltyFee" required:"" example:"10000000000000" description:"Penalty that the LP pays if it doesn't fulfill the quote"`
+	Total                 *big.Int `json:"total" required:"" example:"5100100000000000" description:"Total amount that the user has to pay (value, call fee and gas fee)"`
+	DepositConfirmations  uint16   `json:"depositConfirmations" required:"" example:"10" description:"Confirmations of the RSK deposit required by the LP"`
+	TransferConfirmations uint16   `json:"transferConfirmations" required:"" example:"2" description:"Confirmations of the BTC transfer to the user"`
+	TransferTime          uint32   `json:"transferTime" required:"" example:"3600" description:"Seconds that the LP has to make the BTC transfer once the deposit is confirmed"`
+	DepositDateLimit      uint32   `json:"depositDateLimit" required:"" example:"1700003600" description:"Unix timestamp in seconds until the user could make the deposit of a quote created now"`
+	ExpireDate            uint32   `json:"expireDate" required:"" example:"1700007200" description:"Unix timestamp in seconds when a quote created now would expire"`
+	ExpireBlocks          uint32   `json:"expireBlocks" required:"" example:"5000" description:"RSK block when a quote created now would expire"`
+}
+
+func ToPegoutQuoteEstimationDTO(entity quote.PegoutQuote) PegoutQuoteEstimationDTO {
+	return PegoutQuoteEstimationDTO{
+		Value:                 entity.Value.AsBigInt(),
+		CallFee:               entity.CallFee.AsBigInt(),
+		GasFee:                entity.GasFee.AsBigInt(),
+		PenaltyFee:            entity.PenaltyFee.AsBigInt(),
+		Total:                 entity.Total().AsBigInt(),
+		DepositConfirmations:  entity.DepositConfirmations,
+		TransferConfirmations: entity.TransferConfirmations,
+		TransferTime:          entity.TransferTime,
+		DepositDateLimit:      entity.DepositDateLimit,
+		ExpireDate:            entity.ExpireDate,
+		ExpireBlocks:          entity.ExpireBlock,
+	}
+}
+
 func ToRetainedPegoutQuoteDTO(entity quote.RetainedPegoutQuote) RetainedPegoutQuoteDTO {
+	var replacedTxs []string
+	for _, replaced := range entity.LpBtcReplacedTxs {
+		replacedTxs = append(replacedTxs, replaced.TxHash)
+	}
 	return RetainedPegoutQuoteDTO{
 		QuoteHash:          entity.QuoteHash,
 		Signature:          entity.Signature,
@@ -94,6 +129,7 @@ func ToRetainedPegoutQuoteDTO(entity quote.RetainedPegoutQuote) RetainedPegoutQu
 		LpBtcTxHash:        entity.LpBtcTxHash,
 		RefundPegoutTxHash: entity.RefundPegoutTxHash,
 		BridgeRefundTxHash: entity.BridgeRefundTxHash,
+		LpBtcReplacedTxs:   replacedTxs,
 	}
 }
 
@@ -113,6 +149,12 @@ type GetPegoutQuoteResponse struct {
 	QuoteHash string         `json:"quoteHash" required:"" description:"This is a 64 digit number that derives from a quote object"`
 }
 
+type PegoutQuoteBatchItem struct {
+	Quote     *PegoutQuoteDTO     `json:"quote,omitempty" description:"Detail of the quote, only present if the quote was created"`
+	QuoteHash string              `json:"quoteHash,omitempty" description:"Hash of the quote, only present if the quote was created"`
+	Error     *QuoteBatchErrorDTO `json:"error,omitempty" description:"Reason why the quote wasn't created"`
+}
+
 type AcceptPegoutResponse struct {
 	Signature  string `json:"signature" required:"" example:"0x0" description:"Signature of the quote"`
 	LbcAddress string `json:"lbcAddress" required:"" example:"0x0" description:"LBC address to execute depositPegout function"`
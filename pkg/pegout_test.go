@@ -70,6 +70,7 @@ func TestToRetainedPegoutQuoteDTO(t *testing.T) {
 		LpBtcTxHash:        "btc2",
 		RefundPegoutTxHash: "0x78",
 		BridgeRefundTxHash: "0x90",
+		LpBtcReplacedTxs:   []quote.ReplacedBtcTransaction{{TxHash: "btc3", Fee: entities.NewWei(1)}},
 	}
 
 	dto := pkg.ToRetainedPegoutQuoteDTO(pegoutQuote)
@@ -83,7 +84,8 @@ func TestToRetainedPegoutQuoteDTO(t *testing.T) {
 	assert.Equal(t, pegoutQuote.LpBtcTxHash, dto.LpBtcTxHash)
 	assert.Equal(t, pegoutQuote.RefundPegoutTxHash, dto.RefundPegoutTxHash)
 	assert.Equal(t, pegoutQuote.BridgeRefundTxHash, dto.BridgeRefundTxHash)
-	const expectedFields = 9
+	assert.Equal(t, []string{"btc3"}, dto.LpBtcReplacedTxs)
+	const expectedFields = 10
 	assert.Equal(t, expectedFields, test.CountNonZeroValues(dto))
 	assert.Equal(t, expectedFields, test.CountNonZeroValues(pegoutQuote))
 }
@@ -105,3 +107,34 @@ func TestToPegoutCreationDataDTO(t *testing.T) {
 	assert.Equal(t, pegoutCreationData.GasPrice.String(), dto.GasPrice.String())
 	assert.Equal(t, pegoutCreationData.FixedFee.String(), dto.FixedFee.String())
 }
+
+func TestToPegoutQuoteEstimationDTO(t *testing.T) {
+	pegoutQuote := quote.PegoutQuote{
+		CallFee:               entities.NewWei(5),
+		PenaltyFee:            entities.NewWei(10),
+		Value:                 entities.NewWei(20),
+		AgreementTimestamp:    25,
+		DepositDateLimit:      30,
+		DepositConfirmations:  35,
+		TransferConfirmations: 40,
+		TransferTime:          45,
+		ExpireDate:            50,
+		ExpireBlock:           55,
+		GasFee:                entities.NewWei(60),
+	}
+	dto := pkg.ToPegoutQuoteEstimationDTO(pegoutQuote)
+
+	assert.Equal(t, pegoutQuote.Value.AsBigInt(), dto.Value)
+	assert.Equal(t, pegoutQuote.CallFee.AsBigInt(), dto.CallFee)
+	assert.Equal(t, pegoutQuote.GasFee.AsBigInt(), dto.GasFee)
+	assert.Equal(t, pegoutQuote.PenaltyFee.AsBigInt(), dto.PenaltyFee)
+	assert.Equal(t, entities.NewWei(85).AsBigInt(), dto.Total)
+	assert.Equal(t, pegoutQuote.DepositConfirmations, dto.DepositConfirmations)
+	assert.Equal(t, pegoutQuote.TransferConfirmations, dto.TransferConfirmations)
+	assert.Equal(t, pegoutQuote.TransferTime, dto.TransferTime)
+	assert.Equal(t, pegoutQuote.DepositDateLimit, dto.DepositDateLimit)
+	assert.Equal(t, pegoutQuote.ExpireDate, dto.ExpireDate)
+	assert.Equal(t, pegoutQuote.ExpireBlock, dto.ExpireBlocks)
+	const expectedFields = 11
+	assert.Equal(t, expectedFields, test.CountNonZeroValues(dto))
+}
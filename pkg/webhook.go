@@ -0,0 +1,60 @@
+package pkg
+
+import (
+	"time"
+
+	"github.com/rsksmart/liquidity-provider-server/internal/entities/webhook"
+)
+
+type WebhookRequest struct {
+	Url    string `json:"url" required:"" validate:"required,http_url" example:"https://integrator.example.com/callback" description:"URL that will receive the notifications"`
+	Secret string `json:"secret,omitempty" validate:"omitempty,min=16,max=256" example:"0123456789abcdef" description:"Shared secret to sign the notifications with HMAC-SHA256. If not provided, the notifications are signed with the liquidity provider key"`
+}
+
+type WebhookDTO struct {
+	Id            string    `json:"id" example:"0a1b2c3d4e5f60718293a4b5c6d7e8f9" description:"Webhook identifier" required:""`
+	Url           string    `json:"url" example:"https://integrator.example.com/callback" description:"URL that receives the notifications" required:""`
+	QuoteHash     string    `json:"quoteHash,omitempty" example:"0x1234" description:"Hash of the quote notified by the webhook, empty for global webhooks"`
+	SignatureType string    `json:"signatureType" example:"hmac-sha256" description:"How the notifications are signed: hmac-sha256 or lp-signature" required:""`
+	CreatedAt     time.Time `json:"createdAt" example:"2024-01-02T03:04:05Z" description:"Time when the webhook was registered" required:""`
+}
+
+type WebhooksResponse struct {
+	Webhooks []WebhookDTO `json:"webhooks"`
+}
+
+func ToWebhookDTO(hook webhook.Webhook) WebhookDTO {
+	return WebhookDTO{
+		Id:            hook.Id,
+		Url:           hook.Url,
+		QuoteHash:     hook.QuoteHash,
+		SignatureType: string(hook.SignatureType()),
+		CreatedAt:     hook.CreatedAt,
+	}
+}
+
+func ToWebhooksResponse(hooks []webhook.Webhook) WebhooksResponse {
+	result := make([]WebhookDTO, len(hooks))
+	for i, hook := range hooks {
+		result[i] = ToWebhookDTO(hook)
+	}
+	return WebhooksResponse{Webhooks: result}
+}
+
+type QuoteStateDTO struct {
+	QuoteHash string    `json:"quoteHash" example:"0x1234" description:"Hash of the quote" required:""`
+	Operation string    `json:"operation" example:"pegin" description:"pegin or pegout" required:""`
+	State     string    `json:"state" example:"CallForUserSucceeded" description:"New state of the quote" required:""`
+	Timestamp time.Time `json:"timestamp" example:"2024-01-02T03:04:05Z" description:"Time of the state transition" required:""`
+	Error     string    `json:"error,omitempty" description:"Error that caused the state transition, if any"`
+}
+
+func ToQuoteStateDTO(notification webhook.Notification) QuoteStateDTO {
+	return QuoteStateDTO{
+		QuoteHash: notification.QuoteHash,
+		Operation: string(notification.Operation),
+		State:     notification.State,
+		Timestamp: notification.Timestamp,
+		Error:     notification.Error,
+	}
+}
@@ -0,0 +1,204 @@
+package fireblocks_stub
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ApiKey         = "test-api-key"
+	VaultAccountId = "0"
+	PendingStatus  = "PENDING_SIGNATURE"
+)
+
+// Server is an HTTP stand-in of the Fireblocks API. It validates the authentication of the requests and
+// signs the raw signing transactions with the keys registered for each asset. The transactions are
+// returned as pending the first time they're polled
+type Server struct {
+	*httptest.Server
+	t            *testing.T
+	apiKey       *rsa.PrivateKey
+	keys         map[string]*btcec.PrivateKey
+	mutex        sync.Mutex
+	transactions map[string]*transaction
+	// FinalStatus is the status of the transactions once they stop being pending, COMPLETED if empty
+	FinalStatus string
+	// Requests has the body of every transaction created
+	Requests []map[string]any
+}
+
+type transaction struct {
+	polls    int
+	messages []map[string]any
+	status   string
+}
+
+// NewServer starts a stand-in server that signs with the provided keys, indexed by asset id
+func NewServer(t *testing.T, keys map[string]*btcec.PrivateKey) *Server {
+	apiKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server := &Server{t: t, apiKey: apiKey, keys: keys, transactions: make(map[string]*transaction)}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.handle))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// PrivateKey returns the PEM encoded RSA key that must be used to authenticate against the server
+func (server *Server) PrivateKey() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: must(x509.MarshalPKCS8PrivateKey(server.apiKey))})
+}
+
+func (server *Server) handle(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	require.NoError(server.t, err)
+	if !server.authenticate(req, body) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message":"Unauthorized","code":-7}`))
+		return
+	}
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	path := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	switch {
+	case req.Method == http.MethodGet && len(path) == 8 && path[7] == "public_key_info":
+		server.publicKey(w, path[4])
+	case req.Method == http.MethodPost && req.URL.Path == "/v1/transactions":
+		server.createTransaction(w, body)
+	case req.Method == http.MethodGet && len(path) == 3 && path[1] == "transactions":
+		server.getTransaction(w, path[2])
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Not found","code":404}`))
+	}
+}
+
+func (server *Server) authenticate(req *http.Request, body []byte) bool {
+	parts := strings.Split(strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "), ".")
+	if req.Header.Get("X-API-Key") != ApiKey || len(parts) != 3 {
+		return false
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(server.t, err)
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if rsa.VerifyPKCS1v15(&server.apiKey.PublicKey, crypto.SHA256, hash[:], signature) != nil {
+		return false
+	}
+	var claims map[string]any
+	require.NoError(server.t, json.Unmarshal(must(base64.RawURLEncoding.DecodeString(parts[1])), &claims))
+	bodyHash := sha256.Sum256(body)
+	assert.Equal(server.t, req.URL.RequestURI(), claims["uri"])
+	assert.Equal(server.t, hex.EncodeToString(bodyHash[:]), claims["bodyHash"])
+	assert.Equal(server.t, ApiKey, claims["sub"])
+	assert.NotEmpty(server.t, claims["nonce"])
+	return true
+}
+
+func (server *Server) publicKey(w http.ResponseWriter, assetId string) {
+	key, ok := server.keys[assetId]
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"Asset not found","code":1006}`))
+		return
+	}
+	writeJson(w, map[string]any{
+		"algorithm":      "MPC_ECDSA_SECP256K1",
+		"derivationPath": []int{44, 0, 0, 0, 0},
+		"publicKey":      hex.EncodeToString(key.PubKey().SerializeCompressed()),
+	})
+}
+
+func (server *Server) createTransaction(w http.ResponseWriter, body []byte) {
+	var request struct {
+		Operation       string `json:"operation"`
+		AssetId         string `json:"assetId"`
+		ExtraParameters struct {
+			RawMessageData struct {
+				Messages []struct {
+					Content string `json:"content"`
+				} `json:"messages"`
+			} `json:"rawMessageData"`
+		} `json:"extraParameters"`
+	}
+	require.NoError(server.t, json.Unmarshal(body, &request))
+	var rawRequest map[string]any
+	require.NoError(server.t, json.Unmarshal(body, &rawRequest))
+	server.Requests = append(server.Requests, rawRequest)
+	key, ok := server.keys[request.AssetId]
+	if request.Operation != "RAW" || !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"Unsupported transaction","code":1427}`))
+		return
+	}
+	tx := &transaction{status: server.FinalStatus}
+	for _, message := range request.ExtraParameters.RawMessageData.Messages {
+		signature := ecdsa.SignCompact(key, must(hex.DecodeString(message.Content)), true)
+		tx.messages = append(tx.messages, map[string]any{
+			"content":   message.Content,
+			"algorithm": "MPC_ECDSA_SECP256K1",
+			"publicKey": hex.EncodeToString(key.PubKey().SerializeCompressed()),
+			"signature": map[string]any{
+				"fullSig": hex.EncodeToString(signature[1:]),
+				"r":       hex.EncodeToString(signature[1:33]),
+				"s":       hex.EncodeToString(signature[33:]),
+				"v":       signature[0] - 31, // compact signatures of compressed keys use 31 + recovery id
+			},
+		})
+	}
+	id := fmt.Sprintf("tx-%d", len(server.transactions)+1)
+	server.transactions[id] = tx
+	writeJson(w, map[string]any{"id": id, "status": "SUBMITTED"})
+}
+
+func (server *Server) getTransaction(w http.ResponseWriter, id string) {
+	tx, ok := server.transactions[id]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Transaction not found","code":1404}`))
+		return
+	}
+	tx.polls++
+	if tx.polls == 1 {
+		writeJson(w, map[string]any{"id": id, "status": PendingStatus})
+		return
+	}
+	status := tx.status
+	if status == "" {
+		status = "COMPLETED"
+	}
+	result := map[string]any{"id": id, "status": status, "subStatus": ""}
+	if status == "COMPLETED" {
+		result["signedMessages"] = tx.messages
+	}
+	writeJson(w, result)
+}
+
+func writeJson(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
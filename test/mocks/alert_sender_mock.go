@@ -12,7 +12,7 @@ type AlertSenderMock struct {
 	mock.Mock
 }
 
-func (m *AlertSenderMock) SendAlert(ctx context.Context, subject, body string, recipients []string) error {
-	args := m.Called(ctx, subject, body, recipients)
+func (m *AlertSenderMock) SendAlert(ctx context.Context, alert alerts.Alert, recipients []string) error {
+	args := m.Called(ctx, alert, recipients)
 	return args.Error(0)
 }
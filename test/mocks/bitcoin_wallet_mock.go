@@ -67,6 +67,121 @@ func (_c *BitcoinWalletMock_Address_Call) RunAndReturn(run func() string) *Bitco
 	return _c
 }
 
+// BumpFee provides a mock function with given fields: txHash, maxFee
+func (_m *BitcoinWalletMock) BumpFee(txHash string, maxFee *entities.Wei) (blockchain.BitcoinTransactionResult, error) {
+	ret := _m.Called(txHash, maxFee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BumpFee")
+	}
+
+	var r0 blockchain.BitcoinTransactionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *entities.Wei) (blockchain.BitcoinTransactionResult, error)); ok {
+		return rf(txHash, maxFee)
+	}
+	if rf, ok := ret.Get(0).(func(string, *entities.Wei) blockchain.BitcoinTransactionResult); ok {
+		r0 = rf(txHash, maxFee)
+	} else {
+		r0 = ret.Get(0).(blockchain.BitcoinTransactionResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *entities.Wei) error); ok {
+		r1 = rf(txHash, maxFee)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BitcoinWalletMock_BumpFee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BumpFee'
+type BitcoinWalletMock_BumpFee_Call struct {
+	*mock.Call
+}
+
+// BumpFee is a helper method to define mock.On call
+//   - txHash string
+//   - maxFee *entities.Wei
+func (_e *BitcoinWalletMock_Expecter) BumpFee(txHash interface{}, maxFee interface{}) *BitcoinWalletMock_BumpFee_Call {
+	return &BitcoinWalletMock_BumpFee_Call{Call: _e.mock.On("BumpFee", txHash, maxFee)}
+}
+
+func (_c *BitcoinWalletMock_BumpFee_Call) Run(run func(txHash string, maxFee *entities.Wei)) *BitcoinWalletMock_BumpFee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entities.Wei))
+	})
+	return _c
+}
+
+func (_c *BitcoinWalletMock_BumpFee_Call) Return(_a0 blockchain.BitcoinTransactionResult, _a1 error) *BitcoinWalletMock_BumpFee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BitcoinWalletMock_BumpFee_Call) RunAndReturn(run func(string, *entities.Wei) (blockchain.BitcoinTransactionResult, error)) *BitcoinWalletMock_BumpFee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUnfundedBatchTransactionWithOpReturn provides a mock function with given fields: payments
+func (_m *BitcoinWalletMock) CreateUnfundedBatchTransactionWithOpReturn(payments []blockchain.BitcoinPayment) ([]byte, error) {
+	ret := _m.Called(payments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUnfundedBatchTransactionWithOpReturn")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]blockchain.BitcoinPayment) ([]byte, error)); ok {
+		return rf(payments)
+	}
+	if rf, ok := ret.Get(0).(func([]blockchain.BitcoinPayment) []byte); ok {
+		r0 = rf(payments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]blockchain.BitcoinPayment) error); ok {
+		r1 = rf(payments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BitcoinWalletMock_CreateUnfundedBatchTransactionWithOpReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUnfundedBatchTransactionWithOpReturn'
+type BitcoinWalletMock_CreateUnfundedBatchTransactionWithOpReturn_Call struct {
+	*mock.Call
+}
+
+// CreateUnfundedBatchTransactionWithOpReturn is a helper method to define mock.On call
+//   - payments []blockchain.BitcoinPayment
+func (_e *BitcoinWalletMock_Expecter) CreateUnfundedBatchTransactionWithOpReturn(payments interface{}) *BitcoinWalletMock_CreateUnfundedBatchTransactionWithOpReturn_Call {
+	return &BitcoinWalletMock_CreateUnfundedBatchTransactionWithOpReturn_Call{Call: _e.mock.On("CreateUnfundedBatchTransactionWithOpReturn", payments)}
+}
+
+func (_c *BitcoinWalletMock_CreateUnfundedBatchTransactionWithOpReturn_Call) Run(run func(payments []blockchain.BitcoinPayment)) *BitcoinWalletMock_CreateUnfundedBatchTransactionWithOpReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]blockchain.BitcoinPayment))
+	})
+	return _c
+}
+
+func (_c *BitcoinWalletMock_CreateUnfundedBatchTransactionWithOpReturn_Call) Return(_a0 []byte, _a1 error) *BitcoinWalletMock_CreateUnfundedBatchTransactionWithOpReturn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BitcoinWalletMock_CreateUnfundedBatchTransactionWithOpReturn_Call) RunAndReturn(run func([]blockchain.BitcoinPayment) ([]byte, error)) *BitcoinWalletMock_CreateUnfundedBatchTransactionWithOpReturn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateUnfundedTransactionWithOpReturn provides a mock function with given fields: address, value, opReturnContent
 func (_m *BitcoinWalletMock) CreateUnfundedTransactionWithOpReturn(address string, value *entities.Wei, opReturnContent []byte) ([]byte, error) {
 	ret := _m.Called(address, value, opReturnContent)
@@ -345,6 +460,62 @@ func (_c *BitcoinWalletMock_ImportAddress_Call) RunAndReturn(run func(string) er
 	return _c
 }
 
+// SendBatchWithOpReturn provides a mock function with given fields: payments
+func (_m *BitcoinWalletMock) SendBatchWithOpReturn(payments []blockchain.BitcoinPayment) (blockchain.BitcoinTransactionResult, error) {
+	ret := _m.Called(payments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendBatchWithOpReturn")
+	}
+
+	var r0 blockchain.BitcoinTransactionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]blockchain.BitcoinPayment) (blockchain.BitcoinTransactionResult, error)); ok {
+		return rf(payments)
+	}
+	if rf, ok := ret.Get(0).(func([]blockchain.BitcoinPayment) blockchain.BitcoinTransactionResult); ok {
+		r0 = rf(payments)
+	} else {
+		r0 = ret.Get(0).(blockchain.BitcoinTransactionResult)
+	}
+
+	if rf, ok := ret.Get(1).(func([]blockchain.BitcoinPayment) error); ok {
+		r1 = rf(payments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BitcoinWalletMock_SendBatchWithOpReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendBatchWithOpReturn'
+type BitcoinWalletMock_SendBatchWithOpReturn_Call struct {
+	*mock.Call
+}
+
+// SendBatchWithOpReturn is a helper method to define mock.On call
+//   - payments []blockchain.BitcoinPayment
+func (_e *BitcoinWalletMock_Expecter) SendBatchWithOpReturn(payments interface{}) *BitcoinWalletMock_SendBatchWithOpReturn_Call {
+	return &BitcoinWalletMock_SendBatchWithOpReturn_Call{Call: _e.mock.On("SendBatchWithOpReturn", payments)}
+}
+
+func (_c *BitcoinWalletMock_SendBatchWithOpReturn_Call) Run(run func(payments []blockchain.BitcoinPayment)) *BitcoinWalletMock_SendBatchWithOpReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]blockchain.BitcoinPayment))
+	})
+	return _c
+}
+
+func (_c *BitcoinWalletMock_SendBatchWithOpReturn_Call) Return(_a0 blockchain.BitcoinTransactionResult, _a1 error) *BitcoinWalletMock_SendBatchWithOpReturn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BitcoinWalletMock_SendBatchWithOpReturn_Call) RunAndReturn(run func([]blockchain.BitcoinPayment) (blockchain.BitcoinTransactionResult, error)) *BitcoinWalletMock_SendBatchWithOpReturn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendWithOpReturn provides a mock function with given fields: address, value, opReturnContent
 func (_m *BitcoinWalletMock) SendWithOpReturn(address string, value *entities.Wei, opReturnContent []byte) (blockchain.BitcoinTransactionResult, error) {
 	ret := _m.Called(address, value, opReturnContent)
@@ -459,6 +459,71 @@ func (_c *CollectionBindingMock_FindOne_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// FindOneAndUpdate provides a mock function with given fields: ctx, filter, update, opts
+func (_m *CollectionBindingMock) FindOneAndUpdate(ctx context.Context, filter any, update any, opts ...*options.FindOneAndUpdateOptions) *mongo.SingleResult {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, filter, update)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindOneAndUpdate")
+	}
+
+	var r0 *mongo.SingleResult
+	if rf, ok := ret.Get(0).(func(context.Context, any, any, ...*options.FindOneAndUpdateOptions) *mongo.SingleResult); ok {
+		r0 = rf(ctx, filter, update, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*mongo.SingleResult)
+		}
+	}
+
+	return r0
+}
+
+// CollectionBindingMock_FindOneAndUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindOneAndUpdate'
+type CollectionBindingMock_FindOneAndUpdate_Call struct {
+	*mock.Call
+}
+
+// FindOneAndUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter any
+//   - update any
+//   - opts ...*options.FindOneAndUpdateOptions
+func (_e *CollectionBindingMock_Expecter) FindOneAndUpdate(ctx interface{}, filter interface{}, update interface{}, opts ...interface{}) *CollectionBindingMock_FindOneAndUpdate_Call {
+	return &CollectionBindingMock_FindOneAndUpdate_Call{Call: _e.mock.On("FindOneAndUpdate",
+		append([]interface{}{ctx, filter, update}, opts...)...)}
+}
+
+func (_c *CollectionBindingMock_FindOneAndUpdate_Call) Run(run func(ctx context.Context, filter any, update any, opts ...*options.FindOneAndUpdateOptions)) *CollectionBindingMock_FindOneAndUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]*options.FindOneAndUpdateOptions, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(*options.FindOneAndUpdateOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(any), args[2].(any), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *CollectionBindingMock_FindOneAndUpdate_Call) Return(_a0 *mongo.SingleResult) *CollectionBindingMock_FindOneAndUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CollectionBindingMock_FindOneAndUpdate_Call) RunAndReturn(run func(context.Context, any, any, ...*options.FindOneAndUpdateOptions) *mongo.SingleResult) *CollectionBindingMock_FindOneAndUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InsertMany provides a mock function with given fields: ctx, documents, opts
 func (_m *CollectionBindingMock) InsertMany(ctx context.Context, documents []any, opts ...*options.InsertManyOptions) (*mongo.InsertManyResult, error) {
 	_va := make([]interface{}, len(opts))
@@ -36,3 +36,12 @@ func (m *EventBusMock) Subscribe(eventId entities.EventId) <-chan entities.Event
 func (m *EventBusMock) Shutdown(shutdownChannel chan<- bool) {
 	m.Called(shutdownChannel)
 }
+
+type DurableEventBusMock struct {
+	EventBusMock
+}
+
+func (m *DurableEventBusMock) SubscribeDurable(subscriber string, eventId entities.EventId) <-chan entities.Event {
+	args := m.Called(subscriber, eventId)
+	return args.Get(0).(<-chan entities.Event)
+}
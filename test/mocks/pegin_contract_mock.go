@@ -353,6 +353,64 @@ func (_c *PeginContractMock_PausedStatus_Call) RunAndReturn(run func() (blockcha
 	return _c
 }
 
+// PeginQuoteTypedData provides a mock function with given fields: peginQuote
+func (_m *PeginContractMock) PeginQuoteTypedData(peginQuote quote.PeginQuote) ([]byte, error) {
+	ret := _m.Called(peginQuote)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PeginQuoteTypedData")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(quote.PeginQuote) ([]byte, error)); ok {
+		return rf(peginQuote)
+	}
+	if rf, ok := ret.Get(0).(func(quote.PeginQuote) []byte); ok {
+		r0 = rf(peginQuote)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(quote.PeginQuote) error); ok {
+		r1 = rf(peginQuote)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PeginContractMock_PeginQuoteTypedData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PeginQuoteTypedData'
+type PeginContractMock_PeginQuoteTypedData_Call struct {
+	*mock.Call
+}
+
+// PeginQuoteTypedData is a helper method to define mock.On call
+//   - peginQuote quote.PeginQuote
+func (_e *PeginContractMock_Expecter) PeginQuoteTypedData(peginQuote interface{}) *PeginContractMock_PeginQuoteTypedData_Call {
+	return &PeginContractMock_PeginQuoteTypedData_Call{Call: _e.mock.On("PeginQuoteTypedData", peginQuote)}
+}
+
+func (_c *PeginContractMock_PeginQuoteTypedData_Call) Run(run func(peginQuote quote.PeginQuote)) *PeginContractMock_PeginQuoteTypedData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(quote.PeginQuote))
+	})
+	return _c
+}
+
+func (_c *PeginContractMock_PeginQuoteTypedData_Call) Return(_a0 []byte, _a1 error) *PeginContractMock_PeginQuoteTypedData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PeginContractMock_PeginQuoteTypedData_Call) RunAndReturn(run func(quote.PeginQuote) ([]byte, error)) *PeginContractMock_PeginQuoteTypedData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RegisterPegin provides a mock function with given fields: params
 func (_m *PeginContractMock) RegisterPegin(params blockchain.RegisterPeginParams) (blockchain.TransactionReceipt, error) {
 	ret := _m.Called(params)
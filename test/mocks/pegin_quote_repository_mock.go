@@ -81,6 +81,65 @@ func (_c *PeginQuoteRepositoryMock_DeleteQuotes_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// GetAnalyticsBuckets provides a mock function with given fields: ctx, query
+func (_m *PeginQuoteRepositoryMock) GetAnalyticsBuckets(ctx context.Context, query quote.AnalyticsQuery[quote.PeginState]) ([]quote.AnalyticsBucket, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnalyticsBuckets")
+	}
+
+	var r0 []quote.AnalyticsBucket
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, quote.AnalyticsQuery[quote.PeginState]) ([]quote.AnalyticsBucket, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, quote.AnalyticsQuery[quote.PeginState]) []quote.AnalyticsBucket); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]quote.AnalyticsBucket)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, quote.AnalyticsQuery[quote.PeginState]) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PeginQuoteRepositoryMock_GetAnalyticsBuckets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnalyticsBuckets'
+type PeginQuoteRepositoryMock_GetAnalyticsBuckets_Call struct {
+	*mock.Call
+}
+
+// GetAnalyticsBuckets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query quote.AnalyticsQuery[quote.PeginState]
+func (_e *PeginQuoteRepositoryMock_Expecter) GetAnalyticsBuckets(ctx interface{}, query interface{}) *PeginQuoteRepositoryMock_GetAnalyticsBuckets_Call {
+	return &PeginQuoteRepositoryMock_GetAnalyticsBuckets_Call{Call: _e.mock.On("GetAnalyticsBuckets", ctx, query)}
+}
+
+func (_c *PeginQuoteRepositoryMock_GetAnalyticsBuckets_Call) Run(run func(ctx context.Context, query quote.AnalyticsQuery[quote.PeginState])) *PeginQuoteRepositoryMock_GetAnalyticsBuckets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(quote.AnalyticsQuery[quote.PeginState]))
+	})
+	return _c
+}
+
+func (_c *PeginQuoteRepositoryMock_GetAnalyticsBuckets_Call) Return(_a0 []quote.AnalyticsBucket, _a1 error) *PeginQuoteRepositoryMock_GetAnalyticsBuckets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PeginQuoteRepositoryMock_GetAnalyticsBuckets_Call) RunAndReturn(run func(context.Context, quote.AnalyticsQuery[quote.PeginState]) ([]quote.AnalyticsBucket, error)) *PeginQuoteRepositoryMock_GetAnalyticsBuckets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPeginCreationData provides a mock function with given fields: ctx, hash
 func (_m *PeginQuoteRepositoryMock) GetPeginCreationData(ctx context.Context, hash string) quote.PeginCreationData {
 	ret := _m.Called(ctx, hash)
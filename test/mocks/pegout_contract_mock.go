@@ -355,6 +355,64 @@ func (_c *PegoutContractMock_PausedStatus_Call) RunAndReturn(run func() (blockch
 	return _c
 }
 
+// PegoutQuoteTypedData provides a mock function with given fields: pegoutQuote
+func (_m *PegoutContractMock) PegoutQuoteTypedData(pegoutQuote quote.PegoutQuote) ([]byte, error) {
+	ret := _m.Called(pegoutQuote)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PegoutQuoteTypedData")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(quote.PegoutQuote) ([]byte, error)); ok {
+		return rf(pegoutQuote)
+	}
+	if rf, ok := ret.Get(0).(func(quote.PegoutQuote) []byte); ok {
+		r0 = rf(pegoutQuote)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(quote.PegoutQuote) error); ok {
+		r1 = rf(pegoutQuote)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PegoutContractMock_PegoutQuoteTypedData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PegoutQuoteTypedData'
+type PegoutContractMock_PegoutQuoteTypedData_Call struct {
+	*mock.Call
+}
+
+// PegoutQuoteTypedData is a helper method to define mock.On call
+//   - pegoutQuote quote.PegoutQuote
+func (_e *PegoutContractMock_Expecter) PegoutQuoteTypedData(pegoutQuote interface{}) *PegoutContractMock_PegoutQuoteTypedData_Call {
+	return &PegoutContractMock_PegoutQuoteTypedData_Call{Call: _e.mock.On("PegoutQuoteTypedData", pegoutQuote)}
+}
+
+func (_c *PegoutContractMock_PegoutQuoteTypedData_Call) Run(run func(pegoutQuote quote.PegoutQuote)) *PegoutContractMock_PegoutQuoteTypedData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(quote.PegoutQuote))
+	})
+	return _c
+}
+
+func (_c *PegoutContractMock_PegoutQuoteTypedData_Call) Return(_a0 []byte, _a1 error) *PegoutContractMock_PegoutQuoteTypedData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PegoutContractMock_PegoutQuoteTypedData_Call) RunAndReturn(run func(quote.PegoutQuote) ([]byte, error)) *PegoutContractMock_PegoutQuoteTypedData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefundPegout provides a mock function with given fields: txConfig, params
 func (_m *PegoutContractMock) RefundPegout(txConfig blockchain.TransactionConfig, params blockchain.RefundPegoutParams) (blockchain.TransactionReceipt, error) {
 	ret := _m.Called(txConfig, params)
@@ -83,6 +83,65 @@ func (_c *PegoutQuoteRepositoryMock_DeleteQuotes_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GetAnalyticsBuckets provides a mock function with given fields: ctx, query
+func (_m *PegoutQuoteRepositoryMock) GetAnalyticsBuckets(ctx context.Context, query quote.AnalyticsQuery[quote.PegoutState]) ([]quote.AnalyticsBucket, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnalyticsBuckets")
+	}
+
+	var r0 []quote.AnalyticsBucket
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, quote.AnalyticsQuery[quote.PegoutState]) ([]quote.AnalyticsBucket, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, quote.AnalyticsQuery[quote.PegoutState]) []quote.AnalyticsBucket); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]quote.AnalyticsBucket)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, quote.AnalyticsQuery[quote.PegoutState]) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PegoutQuoteRepositoryMock_GetAnalyticsBuckets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnalyticsBuckets'
+type PegoutQuoteRepositoryMock_GetAnalyticsBuckets_Call struct {
+	*mock.Call
+}
+
+// GetAnalyticsBuckets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query quote.AnalyticsQuery[quote.PegoutState]
+func (_e *PegoutQuoteRepositoryMock_Expecter) GetAnalyticsBuckets(ctx interface{}, query interface{}) *PegoutQuoteRepositoryMock_GetAnalyticsBuckets_Call {
+	return &PegoutQuoteRepositoryMock_GetAnalyticsBuckets_Call{Call: _e.mock.On("GetAnalyticsBuckets", ctx, query)}
+}
+
+func (_c *PegoutQuoteRepositoryMock_GetAnalyticsBuckets_Call) Run(run func(ctx context.Context, query quote.AnalyticsQuery[quote.PegoutState])) *PegoutQuoteRepositoryMock_GetAnalyticsBuckets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(quote.AnalyticsQuery[quote.PegoutState]))
+	})
+	return _c
+}
+
+func (_c *PegoutQuoteRepositoryMock_GetAnalyticsBuckets_Call) Return(_a0 []quote.AnalyticsBucket, _a1 error) *PegoutQuoteRepositoryMock_GetAnalyticsBuckets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PegoutQuoteRepositoryMock_GetAnalyticsBuckets_Call) RunAndReturn(run func(context.Context, quote.AnalyticsQuery[quote.PegoutState]) ([]quote.AnalyticsBucket, error)) *PegoutQuoteRepositoryMock_GetAnalyticsBuckets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPegoutCreationData provides a mock function with given fields: ctx, hash
 func (_m *PegoutQuoteRepositoryMock) GetPegoutCreationData(ctx context.Context, hash string) quote.PegoutCreationData {
 	ret := _m.Called(ctx, hash)
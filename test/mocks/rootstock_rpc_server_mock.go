@@ -487,6 +487,64 @@ func (_c *RootstockRpcServerMock_GetTransactionReceipt_Call) RunAndReturn(run fu
 	return _c
 }
 
+// QuoteGasPrice provides a mock function with given fields: ctx
+func (_m *RootstockRpcServerMock) QuoteGasPrice(ctx context.Context) (*entities.Wei, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuoteGasPrice")
+	}
+
+	var r0 *entities.Wei
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*entities.Wei, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *entities.Wei); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Wei)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootstockRpcServerMock_QuoteGasPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QuoteGasPrice'
+type RootstockRpcServerMock_QuoteGasPrice_Call struct {
+	*mock.Call
+}
+
+// QuoteGasPrice is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RootstockRpcServerMock_Expecter) QuoteGasPrice(ctx interface{}) *RootstockRpcServerMock_QuoteGasPrice_Call {
+	return &RootstockRpcServerMock_QuoteGasPrice_Call{Call: _e.mock.On("QuoteGasPrice", ctx)}
+}
+
+func (_c *RootstockRpcServerMock_QuoteGasPrice_Call) Run(run func(ctx context.Context)) *RootstockRpcServerMock_QuoteGasPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RootstockRpcServerMock_QuoteGasPrice_Call) Return(_a0 *entities.Wei, _a1 error) *RootstockRpcServerMock_QuoteGasPrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootstockRpcServerMock_QuoteGasPrice_Call) RunAndReturn(run func(context.Context) (*entities.Wei, error)) *RootstockRpcServerMock_QuoteGasPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRootstockRpcServerMock creates a new instance of RootstockRpcServerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRootstockRpcServerMock(t interface {
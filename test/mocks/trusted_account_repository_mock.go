@@ -9,6 +9,8 @@ import (
 	liquidity_provider "github.com/rsksmart/liquidity-provider-server/internal/entities/liquidity_provider"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // TrustedAccountRepositoryMock is an autogenerated mock type for the TrustedAccountRepository type
@@ -235,6 +237,54 @@ func (_c *TrustedAccountRepositoryMock_GetTrustedAccount_Call) RunAndReturn(run
 	return _c
 }
 
+// RegisterPartnerAuthentication provides a mock function with given fields: ctx, id, expireAt
+func (_m *TrustedAccountRepositoryMock) RegisterPartnerAuthentication(ctx context.Context, id string, expireAt time.Time) error {
+	ret := _m.Called(ctx, id, expireAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterPartnerAuthentication")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, id, expireAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TrustedAccountRepositoryMock_RegisterPartnerAuthentication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterPartnerAuthentication'
+type TrustedAccountRepositoryMock_RegisterPartnerAuthentication_Call struct {
+	*mock.Call
+}
+
+// RegisterPartnerAuthentication is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - expireAt time.Time
+func (_e *TrustedAccountRepositoryMock_Expecter) RegisterPartnerAuthentication(ctx interface{}, id interface{}, expireAt interface{}) *TrustedAccountRepositoryMock_RegisterPartnerAuthentication_Call {
+	return &TrustedAccountRepositoryMock_RegisterPartnerAuthentication_Call{Call: _e.mock.On("RegisterPartnerAuthentication", ctx, id, expireAt)}
+}
+
+func (_c *TrustedAccountRepositoryMock_RegisterPartnerAuthentication_Call) Run(run func(ctx context.Context, id string, expireAt time.Time)) *TrustedAccountRepositoryMock_RegisterPartnerAuthentication_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TrustedAccountRepositoryMock_RegisterPartnerAuthentication_Call) Return(_a0 error) *TrustedAccountRepositoryMock_RegisterPartnerAuthentication_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TrustedAccountRepositoryMock_RegisterPartnerAuthentication_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *TrustedAccountRepositoryMock_RegisterPartnerAuthentication_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTrustedAccount provides a mock function with given fields: ctx, account
 func (_m *TrustedAccountRepositoryMock) UpdateTrustedAccount(ctx context.Context, account entities.Signed[liquidity_provider.TrustedAccountDetails]) error {
 	ret := _m.Called(ctx, account)
@@ -13,6 +13,8 @@ import (
 	reports "github.com/rsksmart/liquidity-provider-server/internal/usecases/reports"
 
 	usecases "github.com/rsksmart/liquidity-provider-server/internal/usecases"
+
+	webhook "github.com/rsksmart/liquidity-provider-server/internal/usecases/webhook"
 )
 
 // UseCaseRegistryMock is an autogenerated mock type for the UseCaseRegistry type
@@ -263,6 +265,147 @@ func (_c *UseCaseRegistryMock_DeleteTrustedAccountUseCase_Call) RunAndReturn(run
 	return _c
 }
 
+// DeleteWebhookUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) DeleteWebhookUseCase() *webhook.DeleteWebhookUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhookUseCase")
+	}
+
+	var r0 *webhook.DeleteWebhookUseCase
+	if rf, ok := ret.Get(0).(func() *webhook.DeleteWebhookUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.DeleteWebhookUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_DeleteWebhookUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhookUseCase'
+type UseCaseRegistryMock_DeleteWebhookUseCase_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhookUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) DeleteWebhookUseCase() *UseCaseRegistryMock_DeleteWebhookUseCase_Call {
+	return &UseCaseRegistryMock_DeleteWebhookUseCase_Call{Call: _e.mock.On("DeleteWebhookUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_DeleteWebhookUseCase_Call) Run(run func()) *UseCaseRegistryMock_DeleteWebhookUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_DeleteWebhookUseCase_Call) Return(_a0 *webhook.DeleteWebhookUseCase) *UseCaseRegistryMock_DeleteWebhookUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_DeleteWebhookUseCase_Call) RunAndReturn(run func() *webhook.DeleteWebhookUseCase) *UseCaseRegistryMock_DeleteWebhookUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EstimatePeginQuoteUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) EstimatePeginQuoteUseCase() *pegin.EstimateQuoteUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for EstimatePeginQuoteUseCase")
+	}
+
+	var r0 *pegin.EstimateQuoteUseCase
+	if rf, ok := ret.Get(0).(func() *pegin.EstimateQuoteUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pegin.EstimateQuoteUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_EstimatePeginQuoteUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EstimatePeginQuoteUseCase'
+type UseCaseRegistryMock_EstimatePeginQuoteUseCase_Call struct {
+	*mock.Call
+}
+
+// EstimatePeginQuoteUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) EstimatePeginQuoteUseCase() *UseCaseRegistryMock_EstimatePeginQuoteUseCase_Call {
+	return &UseCaseRegistryMock_EstimatePeginQuoteUseCase_Call{Call: _e.mock.On("EstimatePeginQuoteUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_EstimatePeginQuoteUseCase_Call) Run(run func()) *UseCaseRegistryMock_EstimatePeginQuoteUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_EstimatePeginQuoteUseCase_Call) Return(_a0 *pegin.EstimateQuoteUseCase) *UseCaseRegistryMock_EstimatePeginQuoteUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_EstimatePeginQuoteUseCase_Call) RunAndReturn(run func() *pegin.EstimateQuoteUseCase) *UseCaseRegistryMock_EstimatePeginQuoteUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EstimatePegoutQuoteUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) EstimatePegoutQuoteUseCase() *pegout.EstimateQuoteUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for EstimatePegoutQuoteUseCase")
+	}
+
+	var r0 *pegout.EstimateQuoteUseCase
+	if rf, ok := ret.Get(0).(func() *pegout.EstimateQuoteUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pegout.EstimateQuoteUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_EstimatePegoutQuoteUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EstimatePegoutQuoteUseCase'
+type UseCaseRegistryMock_EstimatePegoutQuoteUseCase_Call struct {
+	*mock.Call
+}
+
+// EstimatePegoutQuoteUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) EstimatePegoutQuoteUseCase() *UseCaseRegistryMock_EstimatePegoutQuoteUseCase_Call {
+	return &UseCaseRegistryMock_EstimatePegoutQuoteUseCase_Call{Call: _e.mock.On("EstimatePegoutQuoteUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_EstimatePegoutQuoteUseCase_Call) Run(run func()) *UseCaseRegistryMock_EstimatePegoutQuoteUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_EstimatePegoutQuoteUseCase_Call) Return(_a0 *pegout.EstimateQuoteUseCase) *UseCaseRegistryMock_EstimatePegoutQuoteUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_EstimatePegoutQuoteUseCase_Call) RunAndReturn(run func() *pegout.EstimateQuoteUseCase) *UseCaseRegistryMock_EstimatePegoutQuoteUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenerateDefaultCredentialsUseCase provides a mock function with no fields
 func (_m *UseCaseRegistryMock) GenerateDefaultCredentialsUseCase() *liquidity_provider.GenerateDefaultCredentialsUseCase {
 	ret := _m.Called()
@@ -404,6 +547,100 @@ func (_c *UseCaseRegistryMock_GetAcceptPegoutQuoteUseCase_Call) RunAndReturn(run
 	return _c
 }
 
+// GetAnalyticsReportUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetAnalyticsReportUseCase() *reports.GetAnalyticsReportUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnalyticsReportUseCase")
+	}
+
+	var r0 *reports.GetAnalyticsReportUseCase
+	if rf, ok := ret.Get(0).(func() *reports.GetAnalyticsReportUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reports.GetAnalyticsReportUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_GetAnalyticsReportUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnalyticsReportUseCase'
+type UseCaseRegistryMock_GetAnalyticsReportUseCase_Call struct {
+	*mock.Call
+}
+
+// GetAnalyticsReportUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetAnalyticsReportUseCase() *UseCaseRegistryMock_GetAnalyticsReportUseCase_Call {
+	return &UseCaseRegistryMock_GetAnalyticsReportUseCase_Call{Call: _e.mock.On("GetAnalyticsReportUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_GetAnalyticsReportUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetAnalyticsReportUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetAnalyticsReportUseCase_Call) Return(_a0 *reports.GetAnalyticsReportUseCase) *UseCaseRegistryMock_GetAnalyticsReportUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetAnalyticsReportUseCase_Call) RunAndReturn(run func() *reports.GetAnalyticsReportUseCase) *UseCaseRegistryMock_GetAnalyticsReportUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAssetHistoryUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetAssetHistoryUseCase() *reports.GetAssetHistoryUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssetHistoryUseCase")
+	}
+
+	var r0 *reports.GetAssetHistoryUseCase
+	if rf, ok := ret.Get(0).(func() *reports.GetAssetHistoryUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reports.GetAssetHistoryUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_GetAssetHistoryUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAssetHistoryUseCase'
+type UseCaseRegistryMock_GetAssetHistoryUseCase_Call struct {
+	*mock.Call
+}
+
+// GetAssetHistoryUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetAssetHistoryUseCase() *UseCaseRegistryMock_GetAssetHistoryUseCase_Call {
+	return &UseCaseRegistryMock_GetAssetHistoryUseCase_Call{Call: _e.mock.On("GetAssetHistoryUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_GetAssetHistoryUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetAssetHistoryUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetAssetHistoryUseCase_Call) Return(_a0 *reports.GetAssetHistoryUseCase) *UseCaseRegistryMock_GetAssetHistoryUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetAssetHistoryUseCase_Call) RunAndReturn(run func() *reports.GetAssetHistoryUseCase) *UseCaseRegistryMock_GetAssetHistoryUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAssetsReportUseCase provides a mock function with no fields
 func (_m *UseCaseRegistryMock) GetAssetsReportUseCase() *reports.GetAssetsReportUseCase {
 	ret := _m.Called()
@@ -545,6 +782,53 @@ func (_c *UseCaseRegistryMock_GetConfigurationUseCase_Call) RunAndReturn(run fun
 	return _c
 }
 
+// GetLiquidityHoldsUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetLiquidityHoldsUseCase() *liquidity_provider.GetLiquidityHoldsUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLiquidityHoldsUseCase")
+	}
+
+	var r0 *liquidity_provider.GetLiquidityHoldsUseCase
+	if rf, ok := ret.Get(0).(func() *liquidity_provider.GetLiquidityHoldsUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*liquidity_provider.GetLiquidityHoldsUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_GetLiquidityHoldsUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLiquidityHoldsUseCase'
+type UseCaseRegistryMock_GetLiquidityHoldsUseCase_Call struct {
+	*mock.Call
+}
+
+// GetLiquidityHoldsUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetLiquidityHoldsUseCase() *UseCaseRegistryMock_GetLiquidityHoldsUseCase_Call {
+	return &UseCaseRegistryMock_GetLiquidityHoldsUseCase_Call{Call: _e.mock.On("GetLiquidityHoldsUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_GetLiquidityHoldsUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetLiquidityHoldsUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetLiquidityHoldsUseCase_Call) Return(_a0 *liquidity_provider.GetLiquidityHoldsUseCase) *UseCaseRegistryMock_GetLiquidityHoldsUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetLiquidityHoldsUseCase_Call) RunAndReturn(run func() *liquidity_provider.GetLiquidityHoldsUseCase) *UseCaseRegistryMock_GetLiquidityHoldsUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetManagementUiDataUseCase provides a mock function with no fields
 func (_m *UseCaseRegistryMock) GetManagementUiDataUseCase() *liquidity_provider.GetManagementUiDataUseCase {
 	ret := _m.Called()
@@ -639,6 +923,53 @@ func (_c *UseCaseRegistryMock_GetPeginCollateralUseCase_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetPeginDepositConfirmationsUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetPeginDepositConfirmationsUseCase() *pegin.DepositConfirmationsUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPeginDepositConfirmationsUseCase")
+	}
+
+	var r0 *pegin.DepositConfirmationsUseCase
+	if rf, ok := ret.Get(0).(func() *pegin.DepositConfirmationsUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pegin.DepositConfirmationsUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_GetPeginDepositConfirmationsUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPeginDepositConfirmationsUseCase'
+type UseCaseRegistryMock_GetPeginDepositConfirmationsUseCase_Call struct {
+	*mock.Call
+}
+
+// GetPeginDepositConfirmationsUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetPeginDepositConfirmationsUseCase() *UseCaseRegistryMock_GetPeginDepositConfirmationsUseCase_Call {
+	return &UseCaseRegistryMock_GetPeginDepositConfirmationsUseCase_Call{Call: _e.mock.On("GetPeginDepositConfirmationsUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_GetPeginDepositConfirmationsUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPeginDepositConfirmationsUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPeginDepositConfirmationsUseCase_Call) Return(_a0 *pegin.DepositConfirmationsUseCase) *UseCaseRegistryMock_GetPeginDepositConfirmationsUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPeginDepositConfirmationsUseCase_Call) RunAndReturn(run func() *pegin.DepositConfirmationsUseCase) *UseCaseRegistryMock_GetPeginDepositConfirmationsUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPeginQuoteUseCase provides a mock function with no fields
 func (_m *UseCaseRegistryMock) GetPeginQuoteUseCase() *pegin.GetQuoteUseCase {
 	ret := _m.Called()
@@ -686,63 +1017,110 @@ func (_c *UseCaseRegistryMock_GetPeginQuoteUseCase_Call) RunAndReturn(run func()
 	return _c
 }
 
-// GetPeginReportUseCase provides a mock function with no fields
-func (_m *UseCaseRegistryMock) GetPeginReportUseCase() *reports.GetPeginReportUseCase {
+// GetPeginQuotesUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetPeginQuotesUseCase() *pegin.GetQuotesUseCase {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPeginReportUseCase")
+		panic("no return value specified for GetPeginQuotesUseCase")
 	}
 
-	var r0 *reports.GetPeginReportUseCase
-	if rf, ok := ret.Get(0).(func() *reports.GetPeginReportUseCase); ok {
+	var r0 *pegin.GetQuotesUseCase
+	if rf, ok := ret.Get(0).(func() *pegin.GetQuotesUseCase); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*reports.GetPeginReportUseCase)
+			r0 = ret.Get(0).(*pegin.GetQuotesUseCase)
 		}
 	}
 
 	return r0
 }
 
-// UseCaseRegistryMock_GetPeginReportUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPeginReportUseCase'
-type UseCaseRegistryMock_GetPeginReportUseCase_Call struct {
+// UseCaseRegistryMock_GetPeginQuotesUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPeginQuotesUseCase'
+type UseCaseRegistryMock_GetPeginQuotesUseCase_Call struct {
 	*mock.Call
 }
 
-// GetPeginReportUseCase is a helper method to define mock.On call
-func (_e *UseCaseRegistryMock_Expecter) GetPeginReportUseCase() *UseCaseRegistryMock_GetPeginReportUseCase_Call {
-	return &UseCaseRegistryMock_GetPeginReportUseCase_Call{Call: _e.mock.On("GetPeginReportUseCase")}
+// GetPeginQuotesUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetPeginQuotesUseCase() *UseCaseRegistryMock_GetPeginQuotesUseCase_Call {
+	return &UseCaseRegistryMock_GetPeginQuotesUseCase_Call{Call: _e.mock.On("GetPeginQuotesUseCase")}
 }
 
-func (_c *UseCaseRegistryMock_GetPeginReportUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPeginReportUseCase_Call {
+func (_c *UseCaseRegistryMock_GetPeginQuotesUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPeginQuotesUseCase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetPeginReportUseCase_Call) Return(_a0 *reports.GetPeginReportUseCase) *UseCaseRegistryMock_GetPeginReportUseCase_Call {
+func (_c *UseCaseRegistryMock_GetPeginQuotesUseCase_Call) Return(_a0 *pegin.GetQuotesUseCase) *UseCaseRegistryMock_GetPeginQuotesUseCase_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetPeginReportUseCase_Call) RunAndReturn(run func() *reports.GetPeginReportUseCase) *UseCaseRegistryMock_GetPeginReportUseCase_Call {
+func (_c *UseCaseRegistryMock_GetPeginQuotesUseCase_Call) RunAndReturn(run func() *pegin.GetQuotesUseCase) *UseCaseRegistryMock_GetPeginQuotesUseCase_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPeginStatusUseCase provides a mock function with no fields
-func (_m *UseCaseRegistryMock) GetPeginStatusUseCase() *pegin.StatusUseCase {
+// GetPeginReportUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetPeginReportUseCase() *reports.GetPeginReportUseCase {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPeginStatusUseCase")
+		panic("no return value specified for GetPeginReportUseCase")
 	}
 
-	var r0 *pegin.StatusUseCase
-	if rf, ok := ret.Get(0).(func() *pegin.StatusUseCase); ok {
+	var r0 *reports.GetPeginReportUseCase
+	if rf, ok := ret.Get(0).(func() *reports.GetPeginReportUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reports.GetPeginReportUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_GetPeginReportUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPeginReportUseCase'
+type UseCaseRegistryMock_GetPeginReportUseCase_Call struct {
+	*mock.Call
+}
+
+// GetPeginReportUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetPeginReportUseCase() *UseCaseRegistryMock_GetPeginReportUseCase_Call {
+	return &UseCaseRegistryMock_GetPeginReportUseCase_Call{Call: _e.mock.On("GetPeginReportUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_GetPeginReportUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPeginReportUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPeginReportUseCase_Call) Return(_a0 *reports.GetPeginReportUseCase) *UseCaseRegistryMock_GetPeginReportUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPeginReportUseCase_Call) RunAndReturn(run func() *reports.GetPeginReportUseCase) *UseCaseRegistryMock_GetPeginReportUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPeginStatusUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetPeginStatusUseCase() *pegin.StatusUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPeginStatusUseCase")
+	}
+
+	var r0 *pegin.StatusUseCase
+	if rf, ok := ret.Get(0).(func() *pegin.StatusUseCase); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
@@ -758,306 +1136,588 @@ type UseCaseRegistryMock_GetPeginStatusUseCase_Call struct {
 	*mock.Call
 }
 
-// GetPeginStatusUseCase is a helper method to define mock.On call
-func (_e *UseCaseRegistryMock_Expecter) GetPeginStatusUseCase() *UseCaseRegistryMock_GetPeginStatusUseCase_Call {
-	return &UseCaseRegistryMock_GetPeginStatusUseCase_Call{Call: _e.mock.On("GetPeginStatusUseCase")}
+// GetPeginStatusUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetPeginStatusUseCase() *UseCaseRegistryMock_GetPeginStatusUseCase_Call {
+	return &UseCaseRegistryMock_GetPeginStatusUseCase_Call{Call: _e.mock.On("GetPeginStatusUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_GetPeginStatusUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPeginStatusUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPeginStatusUseCase_Call) Return(_a0 *pegin.StatusUseCase) *UseCaseRegistryMock_GetPeginStatusUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPeginStatusUseCase_Call) RunAndReturn(run func() *pegin.StatusUseCase) *UseCaseRegistryMock_GetPeginStatusUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPegoutCollateralUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetPegoutCollateralUseCase() *pegout.GetCollateralUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPegoutCollateralUseCase")
+	}
+
+	var r0 *pegout.GetCollateralUseCase
+	if rf, ok := ret.Get(0).(func() *pegout.GetCollateralUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pegout.GetCollateralUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_GetPegoutCollateralUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPegoutCollateralUseCase'
+type UseCaseRegistryMock_GetPegoutCollateralUseCase_Call struct {
+	*mock.Call
+}
+
+// GetPegoutCollateralUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetPegoutCollateralUseCase() *UseCaseRegistryMock_GetPegoutCollateralUseCase_Call {
+	return &UseCaseRegistryMock_GetPegoutCollateralUseCase_Call{Call: _e.mock.On("GetPegoutCollateralUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_GetPegoutCollateralUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPegoutCollateralUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPegoutCollateralUseCase_Call) Return(_a0 *pegout.GetCollateralUseCase) *UseCaseRegistryMock_GetPegoutCollateralUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPegoutCollateralUseCase_Call) RunAndReturn(run func() *pegout.GetCollateralUseCase) *UseCaseRegistryMock_GetPegoutCollateralUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPegoutDepositConfirmationsUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetPegoutDepositConfirmationsUseCase() *pegout.DepositConfirmationsUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPegoutDepositConfirmationsUseCase")
+	}
+
+	var r0 *pegout.DepositConfirmationsUseCase
+	if rf, ok := ret.Get(0).(func() *pegout.DepositConfirmationsUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pegout.DepositConfirmationsUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_GetPegoutDepositConfirmationsUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPegoutDepositConfirmationsUseCase'
+type UseCaseRegistryMock_GetPegoutDepositConfirmationsUseCase_Call struct {
+	*mock.Call
+}
+
+// GetPegoutDepositConfirmationsUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetPegoutDepositConfirmationsUseCase() *UseCaseRegistryMock_GetPegoutDepositConfirmationsUseCase_Call {
+	return &UseCaseRegistryMock_GetPegoutDepositConfirmationsUseCase_Call{Call: _e.mock.On("GetPegoutDepositConfirmationsUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_GetPegoutDepositConfirmationsUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPegoutDepositConfirmationsUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPegoutDepositConfirmationsUseCase_Call) Return(_a0 *pegout.DepositConfirmationsUseCase) *UseCaseRegistryMock_GetPegoutDepositConfirmationsUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPegoutDepositConfirmationsUseCase_Call) RunAndReturn(run func() *pegout.DepositConfirmationsUseCase) *UseCaseRegistryMock_GetPegoutDepositConfirmationsUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPegoutQuoteUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetPegoutQuoteUseCase() *pegout.GetQuoteUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPegoutQuoteUseCase")
+	}
+
+	var r0 *pegout.GetQuoteUseCase
+	if rf, ok := ret.Get(0).(func() *pegout.GetQuoteUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pegout.GetQuoteUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_GetPegoutQuoteUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPegoutQuoteUseCase'
+type UseCaseRegistryMock_GetPegoutQuoteUseCase_Call struct {
+	*mock.Call
+}
+
+// GetPegoutQuoteUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetPegoutQuoteUseCase() *UseCaseRegistryMock_GetPegoutQuoteUseCase_Call {
+	return &UseCaseRegistryMock_GetPegoutQuoteUseCase_Call{Call: _e.mock.On("GetPegoutQuoteUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_GetPegoutQuoteUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPegoutQuoteUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPegoutQuoteUseCase_Call) Return(_a0 *pegout.GetQuoteUseCase) *UseCaseRegistryMock_GetPegoutQuoteUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPegoutQuoteUseCase_Call) RunAndReturn(run func() *pegout.GetQuoteUseCase) *UseCaseRegistryMock_GetPegoutQuoteUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPegoutQuotesUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetPegoutQuotesUseCase() *pegout.GetQuotesUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPegoutQuotesUseCase")
+	}
+
+	var r0 *pegout.GetQuotesUseCase
+	if rf, ok := ret.Get(0).(func() *pegout.GetQuotesUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pegout.GetQuotesUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_GetPegoutQuotesUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPegoutQuotesUseCase'
+type UseCaseRegistryMock_GetPegoutQuotesUseCase_Call struct {
+	*mock.Call
+}
+
+// GetPegoutQuotesUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetPegoutQuotesUseCase() *UseCaseRegistryMock_GetPegoutQuotesUseCase_Call {
+	return &UseCaseRegistryMock_GetPegoutQuotesUseCase_Call{Call: _e.mock.On("GetPegoutQuotesUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_GetPegoutQuotesUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPegoutQuotesUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPegoutQuotesUseCase_Call) Return(_a0 *pegout.GetQuotesUseCase) *UseCaseRegistryMock_GetPegoutQuotesUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPegoutQuotesUseCase_Call) RunAndReturn(run func() *pegout.GetQuotesUseCase) *UseCaseRegistryMock_GetPegoutQuotesUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPegoutReportUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetPegoutReportUseCase() *reports.GetPegoutReportUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPegoutReportUseCase")
+	}
+
+	var r0 *reports.GetPegoutReportUseCase
+	if rf, ok := ret.Get(0).(func() *reports.GetPegoutReportUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reports.GetPegoutReportUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_GetPegoutReportUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPegoutReportUseCase'
+type UseCaseRegistryMock_GetPegoutReportUseCase_Call struct {
+	*mock.Call
+}
+
+// GetPegoutReportUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetPegoutReportUseCase() *UseCaseRegistryMock_GetPegoutReportUseCase_Call {
+	return &UseCaseRegistryMock_GetPegoutReportUseCase_Call{Call: _e.mock.On("GetPegoutReportUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_GetPegoutReportUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPegoutReportUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPegoutReportUseCase_Call) Return(_a0 *reports.GetPegoutReportUseCase) *UseCaseRegistryMock_GetPegoutReportUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetPegoutReportUseCase_Call) RunAndReturn(run func() *reports.GetPegoutReportUseCase) *UseCaseRegistryMock_GetPegoutReportUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPegoutStatusUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetPegoutStatusUseCase() *pegout.StatusUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPegoutStatusUseCase")
+	}
+
+	var r0 *pegout.StatusUseCase
+	if rf, ok := ret.Get(0).(func() *pegout.StatusUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pegout.StatusUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_GetPegoutStatusUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPegoutStatusUseCase'
+type UseCaseRegistryMock_GetPegoutStatusUseCase_Call struct {
+	*mock.Call
+}
+
+// GetPegoutStatusUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetPegoutStatusUseCase() *UseCaseRegistryMock_GetPegoutStatusUseCase_Call {
+	return &UseCaseRegistryMock_GetPegoutStatusUseCase_Call{Call: _e.mock.On("GetPegoutStatusUseCase")}
 }
 
-func (_c *UseCaseRegistryMock_GetPeginStatusUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPeginStatusUseCase_Call {
+func (_c *UseCaseRegistryMock_GetPegoutStatusUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPegoutStatusUseCase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetPeginStatusUseCase_Call) Return(_a0 *pegin.StatusUseCase) *UseCaseRegistryMock_GetPeginStatusUseCase_Call {
+func (_c *UseCaseRegistryMock_GetPegoutStatusUseCase_Call) Return(_a0 *pegout.StatusUseCase) *UseCaseRegistryMock_GetPegoutStatusUseCase_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetPeginStatusUseCase_Call) RunAndReturn(run func() *pegin.StatusUseCase) *UseCaseRegistryMock_GetPeginStatusUseCase_Call {
+func (_c *UseCaseRegistryMock_GetPegoutStatusUseCase_Call) RunAndReturn(run func() *pegout.StatusUseCase) *UseCaseRegistryMock_GetPegoutStatusUseCase_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPegoutCollateralUseCase provides a mock function with no fields
-func (_m *UseCaseRegistryMock) GetPegoutCollateralUseCase() *pegout.GetCollateralUseCase {
+// GetProviderDetailUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetProviderDetailUseCase() *liquidity_provider.GetDetailUseCase {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPegoutCollateralUseCase")
+		panic("no return value specified for GetProviderDetailUseCase")
 	}
 
-	var r0 *pegout.GetCollateralUseCase
-	if rf, ok := ret.Get(0).(func() *pegout.GetCollateralUseCase); ok {
+	var r0 *liquidity_provider.GetDetailUseCase
+	if rf, ok := ret.Get(0).(func() *liquidity_provider.GetDetailUseCase); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pegout.GetCollateralUseCase)
+			r0 = ret.Get(0).(*liquidity_provider.GetDetailUseCase)
 		}
 	}
 
 	return r0
 }
 
-// UseCaseRegistryMock_GetPegoutCollateralUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPegoutCollateralUseCase'
-type UseCaseRegistryMock_GetPegoutCollateralUseCase_Call struct {
+// UseCaseRegistryMock_GetProviderDetailUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProviderDetailUseCase'
+type UseCaseRegistryMock_GetProviderDetailUseCase_Call struct {
 	*mock.Call
 }
 
-// GetPegoutCollateralUseCase is a helper method to define mock.On call
-func (_e *UseCaseRegistryMock_Expecter) GetPegoutCollateralUseCase() *UseCaseRegistryMock_GetPegoutCollateralUseCase_Call {
-	return &UseCaseRegistryMock_GetPegoutCollateralUseCase_Call{Call: _e.mock.On("GetPegoutCollateralUseCase")}
+// GetProviderDetailUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetProviderDetailUseCase() *UseCaseRegistryMock_GetProviderDetailUseCase_Call {
+	return &UseCaseRegistryMock_GetProviderDetailUseCase_Call{Call: _e.mock.On("GetProviderDetailUseCase")}
 }
 
-func (_c *UseCaseRegistryMock_GetPegoutCollateralUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPegoutCollateralUseCase_Call {
+func (_c *UseCaseRegistryMock_GetProviderDetailUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetProviderDetailUseCase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetPegoutCollateralUseCase_Call) Return(_a0 *pegout.GetCollateralUseCase) *UseCaseRegistryMock_GetPegoutCollateralUseCase_Call {
+func (_c *UseCaseRegistryMock_GetProviderDetailUseCase_Call) Return(_a0 *liquidity_provider.GetDetailUseCase) *UseCaseRegistryMock_GetProviderDetailUseCase_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetPegoutCollateralUseCase_Call) RunAndReturn(run func() *pegout.GetCollateralUseCase) *UseCaseRegistryMock_GetPegoutCollateralUseCase_Call {
+func (_c *UseCaseRegistryMock_GetProviderDetailUseCase_Call) RunAndReturn(run func() *liquidity_provider.GetDetailUseCase) *UseCaseRegistryMock_GetProviderDetailUseCase_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPegoutQuoteUseCase provides a mock function with no fields
-func (_m *UseCaseRegistryMock) GetPegoutQuoteUseCase() *pegout.GetQuoteUseCase {
+// GetProvidersUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetProvidersUseCase() *liquidity_provider.GetProvidersUseCase {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPegoutQuoteUseCase")
+		panic("no return value specified for GetProvidersUseCase")
 	}
 
-	var r0 *pegout.GetQuoteUseCase
-	if rf, ok := ret.Get(0).(func() *pegout.GetQuoteUseCase); ok {
+	var r0 *liquidity_provider.GetProvidersUseCase
+	if rf, ok := ret.Get(0).(func() *liquidity_provider.GetProvidersUseCase); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pegout.GetQuoteUseCase)
+			r0 = ret.Get(0).(*liquidity_provider.GetProvidersUseCase)
 		}
 	}
 
 	return r0
 }
 
-// UseCaseRegistryMock_GetPegoutQuoteUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPegoutQuoteUseCase'
-type UseCaseRegistryMock_GetPegoutQuoteUseCase_Call struct {
+// UseCaseRegistryMock_GetProvidersUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProvidersUseCase'
+type UseCaseRegistryMock_GetProvidersUseCase_Call struct {
 	*mock.Call
 }
 
-// GetPegoutQuoteUseCase is a helper method to define mock.On call
-func (_e *UseCaseRegistryMock_Expecter) GetPegoutQuoteUseCase() *UseCaseRegistryMock_GetPegoutQuoteUseCase_Call {
-	return &UseCaseRegistryMock_GetPegoutQuoteUseCase_Call{Call: _e.mock.On("GetPegoutQuoteUseCase")}
+// GetProvidersUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetProvidersUseCase() *UseCaseRegistryMock_GetProvidersUseCase_Call {
+	return &UseCaseRegistryMock_GetProvidersUseCase_Call{Call: _e.mock.On("GetProvidersUseCase")}
 }
 
-func (_c *UseCaseRegistryMock_GetPegoutQuoteUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPegoutQuoteUseCase_Call {
+func (_c *UseCaseRegistryMock_GetProvidersUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetProvidersUseCase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetPegoutQuoteUseCase_Call) Return(_a0 *pegout.GetQuoteUseCase) *UseCaseRegistryMock_GetPegoutQuoteUseCase_Call {
+func (_c *UseCaseRegistryMock_GetProvidersUseCase_Call) Return(_a0 *liquidity_provider.GetProvidersUseCase) *UseCaseRegistryMock_GetProvidersUseCase_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetPegoutQuoteUseCase_Call) RunAndReturn(run func() *pegout.GetQuoteUseCase) *UseCaseRegistryMock_GetPegoutQuoteUseCase_Call {
+func (_c *UseCaseRegistryMock_GetProvidersUseCase_Call) RunAndReturn(run func() *liquidity_provider.GetProvidersUseCase) *UseCaseRegistryMock_GetProvidersUseCase_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPegoutReportUseCase provides a mock function with no fields
-func (_m *UseCaseRegistryMock) GetPegoutReportUseCase() *reports.GetPegoutReportUseCase {
+// GetQuotePnlUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetQuotePnlUseCase() *reports.GetQuotePnlUseCase {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPegoutReportUseCase")
+		panic("no return value specified for GetQuotePnlUseCase")
 	}
 
-	var r0 *reports.GetPegoutReportUseCase
-	if rf, ok := ret.Get(0).(func() *reports.GetPegoutReportUseCase); ok {
+	var r0 *reports.GetQuotePnlUseCase
+	if rf, ok := ret.Get(0).(func() *reports.GetQuotePnlUseCase); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*reports.GetPegoutReportUseCase)
+			r0 = ret.Get(0).(*reports.GetQuotePnlUseCase)
 		}
 	}
 
 	return r0
 }
 
-// UseCaseRegistryMock_GetPegoutReportUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPegoutReportUseCase'
-type UseCaseRegistryMock_GetPegoutReportUseCase_Call struct {
+// UseCaseRegistryMock_GetQuotePnlUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuotePnlUseCase'
+type UseCaseRegistryMock_GetQuotePnlUseCase_Call struct {
 	*mock.Call
 }
 
-// GetPegoutReportUseCase is a helper method to define mock.On call
-func (_e *UseCaseRegistryMock_Expecter) GetPegoutReportUseCase() *UseCaseRegistryMock_GetPegoutReportUseCase_Call {
-	return &UseCaseRegistryMock_GetPegoutReportUseCase_Call{Call: _e.mock.On("GetPegoutReportUseCase")}
+// GetQuotePnlUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetQuotePnlUseCase() *UseCaseRegistryMock_GetQuotePnlUseCase_Call {
+	return &UseCaseRegistryMock_GetQuotePnlUseCase_Call{Call: _e.mock.On("GetQuotePnlUseCase")}
 }
 
-func (_c *UseCaseRegistryMock_GetPegoutReportUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPegoutReportUseCase_Call {
+func (_c *UseCaseRegistryMock_GetQuotePnlUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetQuotePnlUseCase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetPegoutReportUseCase_Call) Return(_a0 *reports.GetPegoutReportUseCase) *UseCaseRegistryMock_GetPegoutReportUseCase_Call {
+func (_c *UseCaseRegistryMock_GetQuotePnlUseCase_Call) Return(_a0 *reports.GetQuotePnlUseCase) *UseCaseRegistryMock_GetQuotePnlUseCase_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetPegoutReportUseCase_Call) RunAndReturn(run func() *reports.GetPegoutReportUseCase) *UseCaseRegistryMock_GetPegoutReportUseCase_Call {
+func (_c *UseCaseRegistryMock_GetQuotePnlUseCase_Call) RunAndReturn(run func() *reports.GetQuotePnlUseCase) *UseCaseRegistryMock_GetQuotePnlUseCase_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPegoutStatusUseCase provides a mock function with no fields
-func (_m *UseCaseRegistryMock) GetPegoutStatusUseCase() *pegout.StatusUseCase {
+// GetRecentAlertsUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetRecentAlertsUseCase() *liquidity_provider.GetRecentAlertsUseCase {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPegoutStatusUseCase")
+		panic("no return value specified for GetRecentAlertsUseCase")
 	}
 
-	var r0 *pegout.StatusUseCase
-	if rf, ok := ret.Get(0).(func() *pegout.StatusUseCase); ok {
+	var r0 *liquidity_provider.GetRecentAlertsUseCase
+	if rf, ok := ret.Get(0).(func() *liquidity_provider.GetRecentAlertsUseCase); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pegout.StatusUseCase)
+			r0 = ret.Get(0).(*liquidity_provider.GetRecentAlertsUseCase)
 		}
 	}
 
 	return r0
 }
 
-// UseCaseRegistryMock_GetPegoutStatusUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPegoutStatusUseCase'
-type UseCaseRegistryMock_GetPegoutStatusUseCase_Call struct {
+// UseCaseRegistryMock_GetRecentAlertsUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentAlertsUseCase'
+type UseCaseRegistryMock_GetRecentAlertsUseCase_Call struct {
 	*mock.Call
 }
 
-// GetPegoutStatusUseCase is a helper method to define mock.On call
-func (_e *UseCaseRegistryMock_Expecter) GetPegoutStatusUseCase() *UseCaseRegistryMock_GetPegoutStatusUseCase_Call {
-	return &UseCaseRegistryMock_GetPegoutStatusUseCase_Call{Call: _e.mock.On("GetPegoutStatusUseCase")}
+// GetRecentAlertsUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetRecentAlertsUseCase() *UseCaseRegistryMock_GetRecentAlertsUseCase_Call {
+	return &UseCaseRegistryMock_GetRecentAlertsUseCase_Call{Call: _e.mock.On("GetRecentAlertsUseCase")}
 }
 
-func (_c *UseCaseRegistryMock_GetPegoutStatusUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetPegoutStatusUseCase_Call {
+func (_c *UseCaseRegistryMock_GetRecentAlertsUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetRecentAlertsUseCase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetPegoutStatusUseCase_Call) Return(_a0 *pegout.StatusUseCase) *UseCaseRegistryMock_GetPegoutStatusUseCase_Call {
+func (_c *UseCaseRegistryMock_GetRecentAlertsUseCase_Call) Return(_a0 *liquidity_provider.GetRecentAlertsUseCase) *UseCaseRegistryMock_GetRecentAlertsUseCase_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetPegoutStatusUseCase_Call) RunAndReturn(run func() *pegout.StatusUseCase) *UseCaseRegistryMock_GetPegoutStatusUseCase_Call {
+func (_c *UseCaseRegistryMock_GetRecentAlertsUseCase_Call) RunAndReturn(run func() *liquidity_provider.GetRecentAlertsUseCase) *UseCaseRegistryMock_GetRecentAlertsUseCase_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProviderDetailUseCase provides a mock function with no fields
-func (_m *UseCaseRegistryMock) GetProviderDetailUseCase() *liquidity_provider.GetDetailUseCase {
+// GetReportSnapshotsUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetReportSnapshotsUseCase() *reports.GetReportSnapshotsUseCase {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProviderDetailUseCase")
+		panic("no return value specified for GetReportSnapshotsUseCase")
 	}
 
-	var r0 *liquidity_provider.GetDetailUseCase
-	if rf, ok := ret.Get(0).(func() *liquidity_provider.GetDetailUseCase); ok {
+	var r0 *reports.GetReportSnapshotsUseCase
+	if rf, ok := ret.Get(0).(func() *reports.GetReportSnapshotsUseCase); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*liquidity_provider.GetDetailUseCase)
+			r0 = ret.Get(0).(*reports.GetReportSnapshotsUseCase)
 		}
 	}
 
 	return r0
 }
 
-// UseCaseRegistryMock_GetProviderDetailUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProviderDetailUseCase'
-type UseCaseRegistryMock_GetProviderDetailUseCase_Call struct {
+// UseCaseRegistryMock_GetReportSnapshotsUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReportSnapshotsUseCase'
+type UseCaseRegistryMock_GetReportSnapshotsUseCase_Call struct {
 	*mock.Call
 }
 
-// GetProviderDetailUseCase is a helper method to define mock.On call
-func (_e *UseCaseRegistryMock_Expecter) GetProviderDetailUseCase() *UseCaseRegistryMock_GetProviderDetailUseCase_Call {
-	return &UseCaseRegistryMock_GetProviderDetailUseCase_Call{Call: _e.mock.On("GetProviderDetailUseCase")}
+// GetReportSnapshotsUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetReportSnapshotsUseCase() *UseCaseRegistryMock_GetReportSnapshotsUseCase_Call {
+	return &UseCaseRegistryMock_GetReportSnapshotsUseCase_Call{Call: _e.mock.On("GetReportSnapshotsUseCase")}
 }
 
-func (_c *UseCaseRegistryMock_GetProviderDetailUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetProviderDetailUseCase_Call {
+func (_c *UseCaseRegistryMock_GetReportSnapshotsUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetReportSnapshotsUseCase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetProviderDetailUseCase_Call) Return(_a0 *liquidity_provider.GetDetailUseCase) *UseCaseRegistryMock_GetProviderDetailUseCase_Call {
+func (_c *UseCaseRegistryMock_GetReportSnapshotsUseCase_Call) Return(_a0 *reports.GetReportSnapshotsUseCase) *UseCaseRegistryMock_GetReportSnapshotsUseCase_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetProviderDetailUseCase_Call) RunAndReturn(run func() *liquidity_provider.GetDetailUseCase) *UseCaseRegistryMock_GetProviderDetailUseCase_Call {
+func (_c *UseCaseRegistryMock_GetReportSnapshotsUseCase_Call) RunAndReturn(run func() *reports.GetReportSnapshotsUseCase) *UseCaseRegistryMock_GetReportSnapshotsUseCase_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProvidersUseCase provides a mock function with no fields
-func (_m *UseCaseRegistryMock) GetProvidersUseCase() *liquidity_provider.GetProvidersUseCase {
+// GetRevenueJournalUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetRevenueJournalUseCase() *reports.GetRevenueJournalUseCase {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProvidersUseCase")
+		panic("no return value specified for GetRevenueJournalUseCase")
 	}
 
-	var r0 *liquidity_provider.GetProvidersUseCase
-	if rf, ok := ret.Get(0).(func() *liquidity_provider.GetProvidersUseCase); ok {
+	var r0 *reports.GetRevenueJournalUseCase
+	if rf, ok := ret.Get(0).(func() *reports.GetRevenueJournalUseCase); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*liquidity_provider.GetProvidersUseCase)
+			r0 = ret.Get(0).(*reports.GetRevenueJournalUseCase)
 		}
 	}
 
 	return r0
 }
 
-// UseCaseRegistryMock_GetProvidersUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProvidersUseCase'
-type UseCaseRegistryMock_GetProvidersUseCase_Call struct {
+// UseCaseRegistryMock_GetRevenueJournalUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevenueJournalUseCase'
+type UseCaseRegistryMock_GetRevenueJournalUseCase_Call struct {
 	*mock.Call
 }
 
-// GetProvidersUseCase is a helper method to define mock.On call
-func (_e *UseCaseRegistryMock_Expecter) GetProvidersUseCase() *UseCaseRegistryMock_GetProvidersUseCase_Call {
-	return &UseCaseRegistryMock_GetProvidersUseCase_Call{Call: _e.mock.On("GetProvidersUseCase")}
+// GetRevenueJournalUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetRevenueJournalUseCase() *UseCaseRegistryMock_GetRevenueJournalUseCase_Call {
+	return &UseCaseRegistryMock_GetRevenueJournalUseCase_Call{Call: _e.mock.On("GetRevenueJournalUseCase")}
 }
 
-func (_c *UseCaseRegistryMock_GetProvidersUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetProvidersUseCase_Call {
+func (_c *UseCaseRegistryMock_GetRevenueJournalUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetRevenueJournalUseCase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetProvidersUseCase_Call) Return(_a0 *liquidity_provider.GetProvidersUseCase) *UseCaseRegistryMock_GetProvidersUseCase_Call {
+func (_c *UseCaseRegistryMock_GetRevenueJournalUseCase_Call) Return(_a0 *reports.GetRevenueJournalUseCase) *UseCaseRegistryMock_GetRevenueJournalUseCase_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *UseCaseRegistryMock_GetProvidersUseCase_Call) RunAndReturn(run func() *liquidity_provider.GetProvidersUseCase) *UseCaseRegistryMock_GetProvidersUseCase_Call {
+func (_c *UseCaseRegistryMock_GetRevenueJournalUseCase_Call) RunAndReturn(run func() *reports.GetRevenueJournalUseCase) *UseCaseRegistryMock_GetRevenueJournalUseCase_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1297,6 +1957,53 @@ func (_c *UseCaseRegistryMock_GetUserDepositsUseCase_Call) RunAndReturn(run func
 	return _c
 }
 
+// GetWebhooksUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) GetWebhooksUseCase() *webhook.GetWebhooksUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhooksUseCase")
+	}
+
+	var r0 *webhook.GetWebhooksUseCase
+	if rf, ok := ret.Get(0).(func() *webhook.GetWebhooksUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.GetWebhooksUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_GetWebhooksUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhooksUseCase'
+type UseCaseRegistryMock_GetWebhooksUseCase_Call struct {
+	*mock.Call
+}
+
+// GetWebhooksUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) GetWebhooksUseCase() *UseCaseRegistryMock_GetWebhooksUseCase_Call {
+	return &UseCaseRegistryMock_GetWebhooksUseCase_Call{Call: _e.mock.On("GetWebhooksUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_GetWebhooksUseCase_Call) Run(run func()) *UseCaseRegistryMock_GetWebhooksUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetWebhooksUseCase_Call) Return(_a0 *webhook.GetWebhooksUseCase) *UseCaseRegistryMock_GetWebhooksUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_GetWebhooksUseCase_Call) RunAndReturn(run func() *webhook.GetWebhooksUseCase) *UseCaseRegistryMock_GetWebhooksUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HealthUseCase provides a mock function with no fields
 func (_m *UseCaseRegistryMock) HealthUseCase() *usecases.HealthUseCase {
 	ret := _m.Called()
@@ -1485,6 +2192,100 @@ func (_c *UseCaseRegistryMock_RecommendedPegoutUseCase_Call) RunAndReturn(run fu
 	return _c
 }
 
+// ReconcileLiquidityLedgerUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) ReconcileLiquidityLedgerUseCase() *liquidity_provider.ReconcileLiquidityLedgerUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReconcileLiquidityLedgerUseCase")
+	}
+
+	var r0 *liquidity_provider.ReconcileLiquidityLedgerUseCase
+	if rf, ok := ret.Get(0).(func() *liquidity_provider.ReconcileLiquidityLedgerUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*liquidity_provider.ReconcileLiquidityLedgerUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_ReconcileLiquidityLedgerUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReconcileLiquidityLedgerUseCase'
+type UseCaseRegistryMock_ReconcileLiquidityLedgerUseCase_Call struct {
+	*mock.Call
+}
+
+// ReconcileLiquidityLedgerUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) ReconcileLiquidityLedgerUseCase() *UseCaseRegistryMock_ReconcileLiquidityLedgerUseCase_Call {
+	return &UseCaseRegistryMock_ReconcileLiquidityLedgerUseCase_Call{Call: _e.mock.On("ReconcileLiquidityLedgerUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_ReconcileLiquidityLedgerUseCase_Call) Run(run func()) *UseCaseRegistryMock_ReconcileLiquidityLedgerUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_ReconcileLiquidityLedgerUseCase_Call) Return(_a0 *liquidity_provider.ReconcileLiquidityLedgerUseCase) *UseCaseRegistryMock_ReconcileLiquidityLedgerUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_ReconcileLiquidityLedgerUseCase_Call) RunAndReturn(run func() *liquidity_provider.ReconcileLiquidityLedgerUseCase) *UseCaseRegistryMock_ReconcileLiquidityLedgerUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterWebhookUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) RegisterWebhookUseCase() *webhook.RegisterWebhookUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterWebhookUseCase")
+	}
+
+	var r0 *webhook.RegisterWebhookUseCase
+	if rf, ok := ret.Get(0).(func() *webhook.RegisterWebhookUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.RegisterWebhookUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_RegisterWebhookUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterWebhookUseCase'
+type UseCaseRegistryMock_RegisterWebhookUseCase_Call struct {
+	*mock.Call
+}
+
+// RegisterWebhookUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) RegisterWebhookUseCase() *UseCaseRegistryMock_RegisterWebhookUseCase_Call {
+	return &UseCaseRegistryMock_RegisterWebhookUseCase_Call{Call: _e.mock.On("RegisterWebhookUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_RegisterWebhookUseCase_Call) Run(run func()) *UseCaseRegistryMock_RegisterWebhookUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_RegisterWebhookUseCase_Call) Return(_a0 *webhook.RegisterWebhookUseCase) *UseCaseRegistryMock_RegisterWebhookUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_RegisterWebhookUseCase_Call) RunAndReturn(run func() *webhook.RegisterWebhookUseCase) *UseCaseRegistryMock_RegisterWebhookUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResignationUseCase provides a mock function with no fields
 func (_m *UseCaseRegistryMock) ResignationUseCase() *liquidity_provider.ResignUseCase {
 	ret := _m.Called()
@@ -1720,6 +2521,53 @@ func (_c *UseCaseRegistryMock_SetPegoutConfigUseCase_Call) RunAndReturn(run func
 	return _c
 }
 
+// StreamQuoteStateUseCase provides a mock function with no fields
+func (_m *UseCaseRegistryMock) StreamQuoteStateUseCase() *webhook.StreamQuoteStateUseCase {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamQuoteStateUseCase")
+	}
+
+	var r0 *webhook.StreamQuoteStateUseCase
+	if rf, ok := ret.Get(0).(func() *webhook.StreamQuoteStateUseCase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.StreamQuoteStateUseCase)
+		}
+	}
+
+	return r0
+}
+
+// UseCaseRegistryMock_StreamQuoteStateUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamQuoteStateUseCase'
+type UseCaseRegistryMock_StreamQuoteStateUseCase_Call struct {
+	*mock.Call
+}
+
+// StreamQuoteStateUseCase is a helper method to define mock.On call
+func (_e *UseCaseRegistryMock_Expecter) StreamQuoteStateUseCase() *UseCaseRegistryMock_StreamQuoteStateUseCase_Call {
+	return &UseCaseRegistryMock_StreamQuoteStateUseCase_Call{Call: _e.mock.On("StreamQuoteStateUseCase")}
+}
+
+func (_c *UseCaseRegistryMock_StreamQuoteStateUseCase_Call) Run(run func()) *UseCaseRegistryMock_StreamQuoteStateUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_StreamQuoteStateUseCase_Call) Return(_a0 *webhook.StreamQuoteStateUseCase) *UseCaseRegistryMock_StreamQuoteStateUseCase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCaseRegistryMock_StreamQuoteStateUseCase_Call) RunAndReturn(run func() *webhook.StreamQuoteStateUseCase) *UseCaseRegistryMock_StreamQuoteStateUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SummariesUseCase provides a mock function with no fields
 func (_m *UseCaseRegistryMock) SummariesUseCase() *reports.SummariesUseCase {
 	ret := _m.Called()
@@ -0,0 +1,174 @@
+package remote_signer_stub
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/remote_signer"
+	"github.com/stretchr/testify/require"
+)
+
+// Server is a JSON-RPC stand-in of an external signer that manages a single account. It only exposes the
+// methods of the signer type it was created for, Clef's account namespace or Web3Signer's eth namespace
+type Server struct {
+	*httptest.Server
+	Key     *ecdsa.PrivateKey
+	Address common.Address
+	// ClefFormat makes the transaction signature return an object with the raw transaction instead of the raw transaction
+	ClefFormat bool
+	// TamperTransaction makes the transaction signature sign a transaction with a different value than the requested one
+	TamperTransaction bool
+	// Reject makes every signature request fail
+	Reject bool
+	// SignedMessages has every message signed as an EIP-191 personal message
+	SignedMessages [][]byte
+	// SignedTypedData has every EIP-712 typed data signed
+	SignedTypedData []apitypes.TypedData
+}
+
+func NewServer(t *testing.T, signerType remote_signer.SignerType) *Server {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	server := &Server{Key: key, Address: crypto.PubkeyToAddress(key.PublicKey), ClefFormat: signerType == remote_signer.ClefSigner}
+	rpcServer := rpc.NewServer()
+	switch signerType {
+	case remote_signer.ClefSigner:
+		require.NoError(t, rpcServer.RegisterName("account", &clefService{server: server}))
+	case remote_signer.Web3SignerSigner:
+		require.NoError(t, rpcServer.RegisterName("eth", &web3SignerService{server: server}))
+	default:
+		t.Fatalf("unsupported signer type %s", signerType)
+	}
+	server.Server = httptest.NewServer(rpcServer)
+	t.Cleanup(func() {
+		server.Close()
+		rpcServer.Stop()
+	})
+	return server
+}
+
+var rejectedError = errors.New("request denied")
+
+func (server *Server) signTransaction(args remote_signer.TransactionArgs) (any, error) {
+	if server.Reject {
+		return nil, rejectedError
+	}
+	value := args.Value.ToInt()
+	if server.TamperTransaction {
+		value = new(big.Int).Add(value, big.NewInt(1))
+	}
+	var txData geth.TxData
+	if args.GasPrice != nil {
+		txData = &geth.LegacyTx{Nonce: uint64(args.Nonce), GasPrice: args.GasPrice.ToInt(), Gas: uint64(args.Gas), To: args.To, Value: value, Data: args.Data}
+	} else {
+		txData = &geth.DynamicFeeTx{
+			ChainID:   args.ChainId.ToInt(),
+			Nonce:     uint64(args.Nonce),
+			GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+			GasFeeCap: args.MaxFeePerGas.ToInt(),
+			Gas:       uint64(args.Gas),
+			To:        args.To,
+			Value:     value,
+			Data:      args.Data,
+		}
+	}
+	signedTx, err := geth.SignNewTx(server.Key, geth.LatestSignerForChainID(args.ChainId.ToInt()), txData)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if server.ClefFormat {
+		return map[string]any{"raw": hexutil.Bytes(raw), "tx": signedTx}, nil
+	}
+	return hexutil.Bytes(raw), nil
+}
+
+func (server *Server) signTypedData(address common.Address, rawTypedData json.RawMessage) (hexutil.Bytes, error) {
+	if server.Reject || address != server.Address {
+		return nil, rejectedError
+	}
+	var typedData apitypes.TypedData
+	if err := json.Unmarshal(rawTypedData, &typedData); err != nil {
+		return nil, err
+	}
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	server.SignedTypedData = append(server.SignedTypedData, typedData)
+	return server.sign(hash)
+}
+
+func (server *Server) signMessage(address common.Address, message hexutil.Bytes) (hexutil.Bytes, error) {
+	if server.Reject || address != server.Address {
+		return nil, rejectedError
+	}
+	server.SignedMessages = append(server.SignedMessages, message)
+	return server.sign(accounts.TextHash(message))
+}
+
+func (server *Server) sign(hash []byte) (hexutil.Bytes, error) {
+	signature, err := crypto.Sign(hash, server.Key)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+type clefService struct {
+	server *Server
+}
+
+func (service *clefService) List() []common.Address {
+	return []common.Address{service.server.Address}
+}
+
+func (service *clefService) SignTransaction(args remote_signer.TransactionArgs) (any, error) {
+	return service.server.signTransaction(args)
+}
+
+func (service *clefService) SignTypedData(address common.Address, typedData json.RawMessage) (hexutil.Bytes, error) {
+	return service.server.signTypedData(address, typedData)
+}
+
+func (service *clefService) SignData(contentType string, address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	if contentType != "text/plain" {
+		return nil, errors.New("unsupported content type " + contentType)
+	}
+	return service.server.signMessage(address, data)
+}
+
+type web3SignerService struct {
+	server *Server
+}
+
+func (service *web3SignerService) Accounts() []common.Address {
+	return []common.Address{service.server.Address}
+}
+
+func (service *web3SignerService) SignTransaction(args remote_signer.TransactionArgs) (any, error) {
+	return service.server.signTransaction(args)
+}
+
+func (service *web3SignerService) SignTypedData(address common.Address, typedData json.RawMessage) (hexutil.Bytes, error) {
+	return service.server.signTypedData(address, typedData)
+}
+
+func (service *web3SignerService) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return service.server.signMessage(address, data)
+}
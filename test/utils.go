@@ -2,11 +2,13 @@ package test
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"encoding/hex"
 	"flag"
 	"fmt"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/rsksmart/liquidity-provider-server/internal/adapters/dataproviders/rootstock/account"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/blockchain"
 	"github.com/rsksmart/liquidity-provider-server/internal/entities/quote"
@@ -21,6 +23,7 @@ import (
 	"reflect"
 	"runtime"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -122,7 +125,7 @@ func AssertNoLog(t *testing.T) (assertFunc func()) {
 }
 
 func AssertLogContains(t *testing.T, expected string) (assertFunc func() bool) {
-	message := make([]byte, 2048)
+	message := make([]byte, 4096)
 	buff := new(ThreadSafeBuffer)
 	log.SetOutput(buff)
 	return func() bool {
@@ -288,3 +291,13 @@ func MustParseDate(s string) time.Time {
 	}
 	return parsed
 }
+
+// SignPartnerRequest creates the signature of the partner authentication of a quote request
+func SignPartnerRequest(t *testing.T, key *ecdsa.PrivateKey, timestamp int64, payload []byte) string {
+	messageHash := crypto.Keccak256([]byte(strconv.FormatInt(timestamp, 10)), payload)
+	hash := crypto.Keccak256([]byte("\x19Ethereum Signed Message:\n32"), messageHash)
+	signature, err := crypto.Sign(hash, key)
+	require.NoError(t, err)
+	signature[64] += 27
+	return hex.EncodeToString(signature)
+}